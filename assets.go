@@ -0,0 +1,33 @@
+package main
+
+import (
+	"embed"
+	"io/fs"
+	"os"
+)
+
+// embeddedAssets holds the built frontend and the API documentation so the
+// binary can run without the source tree or CDN access.
+//
+//go:embed all:public docs/swagger.json docs/redoc
+var embeddedAssets embed.FS
+
+// loadAssets returns the asset tree rooted at the repository layout.
+// In development fromDisk serves the working directory so frontend and
+// swagger rebuilds show up without recompiling.
+func loadAssets(fromDisk bool) fs.FS {
+	if fromDisk {
+		return os.DirFS(".")
+	}
+	return embeddedAssets
+}
+
+// subAssets narrows the asset tree to dir; the directories are fixed, so a
+// failure means the binary was built without them.
+func subAssets(assets fs.FS, dir string) fs.FS {
+	sub, err := fs.Sub(assets, dir)
+	if err != nil {
+		panic("missing embedded assets: " + err.Error())
+	}
+	return sub
+}
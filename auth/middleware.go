@@ -0,0 +1,88 @@
+package auth
+
+import (
+	"contoso/elasticlog"
+	"contoso/problem"
+	"contoso/repository"
+	"errors"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// Authenticator resolves the caller of each request.
+type Authenticator struct {
+	keys   repository.APIKeyRepository
+	jwt    *JWTVerifier
+	logger *elasticlog.Logger
+}
+
+// NewAuthenticator creates an Authenticator. verifier may be nil, in which
+// case only API keys are accepted.
+func NewAuthenticator(keys repository.APIKeyRepository, verifier *JWTVerifier, logger *elasticlog.Logger) *Authenticator {
+	return &Authenticator{keys: keys, jwt: verifier, logger: logger}
+}
+
+// Middleware requires an API key (X-API-Key header, or a bearer token with
+// the API key format) or a JWT bearer token, and stores the resulting
+// Principal on the request.
+func (a *Authenticator) Middleware() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		token := c.Get("X-API-Key")
+		if token == "" {
+			if scheme, value, ok := strings.Cut(c.Get(fiber.HeaderAuthorization), " "); ok && strings.EqualFold(scheme, "Bearer") {
+				token = strings.TrimSpace(value)
+			}
+		}
+		if token == "" {
+			return a.unauthorized(c, "missing credentials")
+		}
+		principal, reason, err := a.authenticate(token)
+		if err != nil {
+			// A failed lookup says nothing about the credentials, so it is
+			// a server error rather than a 401.
+			return err
+		}
+		if principal == nil {
+			a.logger.Warn("Authentication failed", map[string]interface{}{
+				"reason": reason,
+				"path":   c.Path(),
+				"client": c.IP(),
+			})
+			return a.unauthorized(c, "invalid credentials")
+		}
+		SetPrincipal(c, principal)
+		return c.Next()
+	}
+}
+
+// authenticate returns the principal for token, or the reason it was
+// rejected. err is only set when the token could not be checked.
+func (a *Authenticator) authenticate(token string) (*Principal, string, error) {
+	if IsAPIKey(token) {
+		key, err := a.keys.GetAPIKeyByHash(HashAPIKey(token))
+		if errors.Is(err, repository.ErrAPIKeyNotFound) {
+			return nil, "unknown api key", nil
+		}
+		if err != nil {
+			return nil, "", err
+		}
+		if key.RevokedAt != nil {
+			return nil, "revoked api key " + key.ID, nil
+		}
+		return &Principal{Subject: key.Name, Kind: KindAPIKey, Roles: key.Roles, KeyID: key.ID}, "", nil
+	}
+	if a.jwt == nil {
+		return nil, "jwt authentication not configured", nil
+	}
+	p, err := a.jwt.Verify(token)
+	if err != nil {
+		return nil, err.Error(), nil
+	}
+	return p, "", nil
+}
+
+func (a *Authenticator) unauthorized(c *fiber.Ctx, msg string) error {
+	c.Set(fiber.HeaderWWWAuthenticate, `Bearer realm="contoso"`)
+	return problem.Respond(c, fiber.StatusUnauthorized, problem.CodeUnauthorized, msg)
+}
@@ -0,0 +1,36 @@
+// Package auth identifies API callers from API keys or JWT bearer tokens.
+package auth
+
+import (
+	"github.com/gofiber/fiber/v2"
+)
+
+// Principal kinds.
+const (
+	KindAPIKey = "api_key"
+	KindJWT    = "jwt"
+)
+
+// Principal is the authenticated caller of a request.
+type Principal struct {
+	// Subject is the API key name or the JWT "sub" claim.
+	Subject string   `json:"subject"`
+	Kind    string   `json:"kind"`
+	Roles   []string `json:"roles"`
+	// KeyID is the stored API key ID, empty for JWTs.
+	KeyID string `json:"keyId,omitempty"`
+}
+
+const principalLocal = "auth.principal"
+
+// SetPrincipal attaches p to the request.
+func SetPrincipal(c *fiber.Ctx, p *Principal) {
+	c.Locals(principalLocal, p)
+}
+
+// PrincipalFrom returns the caller of the request, or nil when the request
+// was not authenticated.
+func PrincipalFrom(c *fiber.Ctx) *Principal {
+	p, _ := c.Locals(principalLocal).(*Principal)
+	return p
+}
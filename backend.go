@@ -0,0 +1,216 @@
+package main
+
+import (
+	"contoso/config"
+	"contoso/dbsetup"
+	"contoso/elasticlog"
+	"contoso/events"
+	"contoso/models"
+	"contoso/money"
+	"contoso/repository"
+	"contoso/search"
+	"contoso/webhooks"
+	"errors"
+)
+
+// backend bundles the repositories for the configured database type.
+type backend struct {
+	dbType  string
+	players repository.PlayerRepository
+	// copier writes players as they are, for migrate-data.
+	copier  repository.PlayerCopier
+	apiKeys repository.APIKeyRepository
+	wallets repository.WalletRepository
+	// transfers moves money between players' balances.
+	transfers repository.TransferRepository
+	// limits holds players' deposit and loss limits, which players enforces.
+	limits repository.LimitRepository
+	// idempotency stores responses to requests sent with an Idempotency-Key.
+	idempotency repository.IdempotencyRepository
+	// search is nil when no Elasticsearch is configured.
+	search *search.Index
+	// broker fans player changes out to live subscribers.
+	broker *events.Broker
+	// webhooks holds webhook subscriptions and their delivery queue, which
+	// dispatcher fills from player events and works off.
+	webhooks   repository.WebhookRepository
+	dispatcher *webhooks.Dispatcher
+	// outbox holds the events written with every player change, which
+	// relay publishes to the broker and the dispatcher.
+	outbox events.Outbox
+	relay  *events.Relay
+}
+
+// openBackend connects to the database selected by cfg.DBType. With
+// Elasticsearch configured, player writes are mirrored into the search index.
+// Every write also records an event in the outbox, from where the relay
+// publishes it to the event broker and subscribed webhooks.
+func openBackend(cfg *config.Config, logger *elasticlog.Logger) *backend {
+	b := openDatabase(cfg, logger)
+	openSearch(b, cfg, logger)
+	b.players = &breachLoggingRepository{PlayerRepository: b.players, logger: logger}
+	b.transfers = &breachLoggingTransferRepository{TransferRepository: b.transfers, logger: logger}
+	b.broker = events.NewBroker(maxEventSubscribers)
+	b.dispatcher = webhooks.NewDispatcher(b.webhooks, cfg.WebhookMaxAttempts, logger)
+	b.relay = events.NewRelay(b.outbox, events.Publishers{b.broker, b.dispatcher}, logger)
+	return b
+}
+
+// maxEventSubscribers caps concurrent live event streams.
+const maxEventSubscribers = 1000
+
+func openSearch(b *backend, cfg *config.Config, logger *elasticlog.Logger) {
+	if cfg.ElasticURL == "" {
+		return
+	}
+	es, err := search.NewClient(cfg.ElasticURL, cfg.ElasticUsername, cfg.ElasticPassword)
+	if err != nil {
+		logger.Warn("Search disabled", map[string]interface{}{"error": err.Error()})
+		return
+	}
+	b.search = search.NewIndex(es, cfg.SearchIndex)
+	synced := search.NewSyncedRepository(b.players, b.search, logger)
+	b.players = synced
+	b.wallets = search.NewSyncedWalletRepository(b.wallets, synced)
+	b.transfers = search.NewSyncedTransferRepository(b.transfers, synced)
+	// Search is optional; until the index exists, index updates fail and
+	// are logged, and `reindex` fills it in later.
+	if err := b.search.Ensure(); err != nil {
+		logger.Warn("Search index unavailable", map[string]interface{}{"error": err.Error()})
+	}
+}
+
+// breachLoggingRepository logs balance changes rejected for exceeding a
+// deposit or loss limit, which responsible-gaming reviews look for.
+type breachLoggingRepository struct {
+	repository.PlayerRepository
+	logger *elasticlog.Logger
+}
+
+func (r *breachLoggingRepository) AdjustBalance(id string, amount money.Money) (*models.Player, error) {
+	player, err := r.PlayerRepository.AdjustBalance(id, amount)
+	logBreach(r.logger, err, id)
+	return player, err
+}
+
+// breachLoggingTransferRepository is breachLoggingRepository for
+// transfers, which count towards the sender's loss limits and the
+// recipient's deposit limits.
+type breachLoggingTransferRepository struct {
+	repository.TransferRepository
+	logger *elasticlog.Logger
+}
+
+func (r *breachLoggingTransferRepository) Transfer(id, from, to string, amount money.Money) (*models.Transfer, bool, error) {
+	t, created, err := r.TransferRepository.Transfer(id, from, to, amount)
+	var exceeded *repository.LimitExceededError
+	if errors.As(err, &exceeded) && exceeded.Limit.Kind == models.LimitDeposit {
+		logBreach(r.logger, err, to)
+	} else {
+		logBreach(r.logger, err, from)
+	}
+	return t, created, err
+}
+
+// logBreach logs err if it is a *repository.LimitExceededError of playerID.
+func logBreach(logger *elasticlog.Logger, err error, playerID string) {
+	var exceeded *repository.LimitExceededError
+	if !errors.As(err, &exceeded) {
+		return
+	}
+	logger.Warn("Limit breach rejected", map[string]interface{}{
+		"playerId":  playerID,
+		"kind":      exceeded.Limit.Kind,
+		"period":    exceeded.Limit.Period,
+		"limit":     exceeded.Limit.Amount.String(),
+		"used":      exceeded.Used.String(),
+		"requested": exceeded.Requested.String(),
+	})
+}
+
+func openDatabase(cfg *config.Config, logger *elasticlog.Logger) *backend {
+	if cfg.DBType == "postgres" {
+		logger.Info("Using Postgres repository", nil)
+		players := repository.NewPostgresPlayerRepository(dbsetup.GetPostgresDB())
+		return &backend{
+			dbType:  cfg.DBType,
+			players: players,
+			copier:  players,
+			apiKeys: repository.NewPostgresAPIKeyRepository(dbsetup.GetPostgresDB()),
+			wallets: repository.NewPostgresWalletRepository(dbsetup.GetPostgresDB()),
+
+			idempotency: repository.NewPostgresIdempotencyRepository(dbsetup.GetPostgresDB()),
+			webhooks:    repository.NewPostgresWebhookRepository(dbsetup.GetPostgresDB()),
+			transfers:   repository.NewPostgresTransferRepository(dbsetup.GetPostgresDB()),
+			limits:      repository.NewPostgresLimitRepository(dbsetup.GetPostgresDB(), cfg.LimitCoolingOff),
+			outbox:      repository.NewPostgresOutboxRepository(dbsetup.GetPostgresDB()),
+		}
+	}
+	logger.Info("Using MongoDB repository", nil)
+	players := repository.NewMongoPlayerRepository(
+		dbsetup.GetMongoCollection(),
+		dbsetup.GetMongoDatabase().Collection("outbox"),
+		dbsetup.GetMongoDatabase().Collection("wallets"),
+		dbsetup.GetMongoDatabase().Collection("player_limits"),
+		dbsetup.GetMongoDatabase().Collection("balance_changes"),
+	)
+	return &backend{
+		dbType:  cfg.DBType,
+		players: players,
+		copier:  players,
+		apiKeys: repository.NewMongoAPIKeyRepository(dbsetup.GetMongoDatabase().Collection("api_keys")),
+		wallets: repository.NewMongoWalletRepository(
+			dbsetup.GetMongoCollection(),
+			dbsetup.GetMongoDatabase().Collection("wallets"),
+			dbsetup.GetMongoDatabase().Collection("outbox"),
+		),
+
+		idempotency: repository.NewMongoIdempotencyRepository(dbsetup.GetMongoDatabase().Collection("idempotency_keys")),
+		webhooks: repository.NewMongoWebhookRepository(
+			dbsetup.GetMongoDatabase().Collection("webhooks"),
+			dbsetup.GetMongoDatabase().Collection("webhook_deliveries"),
+		),
+		transfers: repository.NewMongoTransferRepository(
+			dbsetup.GetMongoCollection(),
+			dbsetup.GetMongoDatabase().Collection("transfer_legs"),
+			dbsetup.GetMongoDatabase().Collection("player_limits"),
+			dbsetup.GetMongoDatabase().Collection("balance_changes"),
+			dbsetup.GetMongoDatabase().Collection("outbox"),
+		),
+		limits: repository.NewMongoLimitRepository(
+			dbsetup.GetMongoCollection(),
+			dbsetup.GetMongoDatabase().Collection("player_limits"),
+			dbsetup.GetMongoDatabase().Collection("balance_changes"),
+			cfg.LimitCoolingOff,
+		),
+		outbox: repository.NewMongoOutboxRepository(dbsetup.GetMongoDatabase().Collection("outbox")),
+	}
+}
+
+// migrate applies pending schema migrations to the backend's database.
+func (b *backend) migrate(cfg *config.Config) ([]string, error) {
+	opts := dbsetup.Options{LegacyCurrency: cfg.LegacyCurrency}
+	if b.dbType == "postgres" {
+		return dbsetup.MigratePostgres(dbsetup.GetPostgresDB(), opts)
+	}
+	return dbsetup.MigrateMongo(dbsetup.GetMongoDatabase(), opts)
+}
+
+// openMigratedBackend opens the backend and brings its schema up to date,
+// for CLI commands that may run before the server ever has.
+func openMigratedBackend(cfg *config.Config) (*backend, error) {
+	b := openBackend(cfg, newLogger(cfg))
+	if _, err := b.migrate(cfg); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+func newLogger(cfg *config.Config) *elasticlog.Logger {
+	return elasticlog.NewLogger(
+		elasticlog.ParseLogLevel(cfg.LogLevel),
+		cfg.ElasticIndex,
+		cfg.ElasticUsername,
+		cfg.ElasticPassword,
+	)
+}
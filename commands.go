@@ -0,0 +1,424 @@
+package main
+
+import (
+	"contoso/auth"
+	"contoso/config"
+	"contoso/models"
+	"contoso/money"
+	"contoso/playerio"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"math"
+	"math/rand"
+	"os"
+	"strings"
+	"time"
+)
+
+// runMigrate applies pending schema migrations without starting the server.
+func runMigrate(args []string) error {
+	fs := flag.NewFlagSet("migrate", flag.ExitOnError)
+	cfg := config.Load()
+	fs.StringVar(&cfg.DBType, "db", cfg.DBType, "database type (mongo or postgres)")
+	_ = fs.Parse(args)
+
+	backend := openBackend(cfg, newLogger(cfg))
+	applied, err := backend.migrate(cfg)
+	for _, name := range applied {
+		fmt.Printf("applied: %s\n", name)
+	}
+	if err != nil {
+		return err
+	}
+	if len(applied) == 0 {
+		fmt.Println("schema is up to date")
+	}
+	return nil
+}
+
+var (
+	seedNames    = []string{"Alex", "Sam", "Jordan", "Taylor", "Morgan", "Casey", "Riley", "Jamie", "Avery", "Quinn", "Charlie", "Robin"}
+	seedSurnames = []string{"Smith", "Naidoo", "Garcia", "Müller", "Dubois", "Rossi", "Khumalo", "Nakamura", "Silva", "Kowalski", "Jansen", "O'Brien"}
+)
+
+// maxSeedBalance is the largest balance player validation accepts.
+const maxSeedBalance = 1e9
+
+// runSeed creates random players for demos and local testing.
+func runSeed(args []string) error {
+	fs := flag.NewFlagSet("seed", flag.ExitOnError)
+	cfg := config.Load()
+	fs.StringVar(&cfg.DBType, "db", cfg.DBType, "database type (mongo or postgres)")
+	count := fs.Int("count", 25, "number of players to create")
+	maxBalance := fs.Float64("max-balance", 10000, "upper bound for random balances")
+	currency := fs.String("currency", money.DefaultCurrency, "currency of the balances")
+	seed := fs.Int64("seed", 0, "random seed (0 uses the current time)")
+	_ = fs.Parse(args)
+
+	places, ok := money.Places(*currency)
+	if !ok {
+		return fmt.Errorf("unsupported currency %q", *currency)
+	}
+	// Also rejects NaN, and keeps maxUnits+1 below the int64 overflow at
+	// which rng.Int63n panics.
+	if !(*maxBalance >= 0 && *maxBalance <= maxSeedBalance) {
+		return fmt.Errorf("max-balance must be between 0 and %d", int64(maxSeedBalance))
+	}
+	// Balances are drawn in the currency's smallest unit, such as cents.
+	maxUnits := int64(*maxBalance * math.Pow10(int(places)))
+
+	if *seed == 0 {
+		*seed = time.Now().UnixNano()
+	}
+	rng := rand.New(rand.NewSource(*seed))
+	backend, err := openMigratedBackend(cfg)
+	if err != nil {
+		return err
+	}
+	for i := 0; i < *count; i++ {
+		player := &models.Player{
+			Name:    seedNames[rng.Intn(len(seedNames))],
+			Surname: seedSurnames[rng.Intn(len(seedSurnames))],
+			Balance: money.Money{Amount: money.New(rng.Int63n(maxUnits+1), -places), Currency: *currency},
+		}
+		if _, err := backend.players.CreatePlayer(player); err != nil {
+			return fmt.Errorf("after %d players: %w", i, err)
+		}
+	}
+	fmt.Printf("created %d players\n", *count)
+	return nil
+}
+
+// runExport writes every player to a file or stdout.
+func runExport(args []string) error {
+	fs := flag.NewFlagSet("export", flag.ExitOnError)
+	cfg := config.Load()
+	fs.StringVar(&cfg.DBType, "db", cfg.DBType, "database type (mongo or postgres)")
+	out := fs.String("out", "-", "output file, - for stdout")
+	format := fs.String("format", "", "json, ndjson or csv (default: from file extension)")
+	_ = fs.Parse(args)
+
+	f, err := resolveFormat(*format, *out)
+	if err != nil {
+		return err
+	}
+	var w io.Writer = os.Stdout
+	if *out != "-" {
+		file, err := os.Create(*out)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+		w = file
+	}
+	pw, err := playerio.NewWriter(w, f)
+	if err != nil {
+		return err
+	}
+
+	backend, err := openMigratedBackend(cfg)
+	if err != nil {
+		return err
+	}
+	count := 0
+	err = backend.players.StreamPlayers(func(player *models.Player) error {
+		count++
+		return pw.Write(player)
+	})
+	if err != nil {
+		return err
+	}
+	if err := pw.Close(); err != nil {
+		return err
+	}
+	fmt.Fprintf(os.Stderr, "exported %d players\n", count)
+	return nil
+}
+
+// runImport creates a new player for every record in a file or stdin.
+// IDs in the file are ignored; the backend assigns new ones. Nothing is
+// written unless every record is valid.
+func runImport(args []string) error {
+	fs := flag.NewFlagSet("import", flag.ExitOnError)
+	cfg := config.Load()
+	fs.StringVar(&cfg.DBType, "db", cfg.DBType, "database type (mongo or postgres)")
+	in := fs.String("in", "-", "input file, - for stdin")
+	dryRun := fs.Bool("dry-run", false, "only validate the input")
+	format := fs.String("format", "", "json, ndjson or csv (default: from file extension)")
+	_ = fs.Parse(args)
+
+	f, err := resolveFormat(*format, *in)
+	if err != nil {
+		return err
+	}
+	var r io.Reader = os.Stdin
+	if *in != "-" {
+		file, err := os.Open(*in)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+		r = file
+	}
+	pr, err := playerio.NewReader(r, f)
+	if err != nil {
+		return err
+	}
+
+	// Validate everything before writing anything
+	players, verr, err := playerio.ReadAll(pr, math.MaxInt)
+	if err != nil {
+		return err
+	}
+	if verr != nil {
+		for _, f := range verr.Fields {
+			fmt.Fprintf(os.Stderr, "%s: %s\n", f.Field, f.Message)
+		}
+		return fmt.Errorf("%d invalid fields, nothing imported", len(verr.Fields))
+	}
+	if *dryRun {
+		fmt.Fprintf(os.Stderr, "%d players are valid\n", len(players))
+		return nil
+	}
+
+	backend, err := openMigratedBackend(cfg)
+	if err != nil {
+		return err
+	}
+	result, err := playerio.Import(backend.players, players)
+	if err != nil {
+		return fmt.Errorf("imported %d players before failing: %w", result.Imported, err)
+	}
+	fmt.Fprintf(os.Stderr, "imported %d players\n", result.Imported)
+	if len(result.FailedRows) > 0 {
+		return fmt.Errorf("records %v could not be stored", result.FailedRows)
+	}
+	return nil
+}
+
+// resolveFormat prefers an explicit -format flag over the file extension.
+func resolveFormat(format, path string) (playerio.Format, error) {
+	if format != "" {
+		return playerio.ParseFormat(format)
+	}
+	if path == "-" {
+		return playerio.JSON, nil
+	}
+	return playerio.FormatFromPath(path), nil
+}
+
+// runAPIKey manages API keys: create, list and revoke.
+func runAPIKey(args []string) error {
+	if len(args) == 0 {
+		return errors.New("expected a subcommand: create, list or revoke")
+	}
+	sub, args := args[0], args[1:]
+	fs := flag.NewFlagSet("apikey "+sub, flag.ExitOnError)
+	cfg := config.Load()
+	fs.StringVar(&cfg.DBType, "db", cfg.DBType, "database type (mongo or postgres)")
+	name := fs.String("name", "", "key name, used as the principal subject (create)")
+	roles := fs.String("roles", "", "comma-separated roles (create)")
+	id := fs.String("id", "", "key ID (revoke)")
+	_ = fs.Parse(args)
+
+	backend, err := openMigratedBackend(cfg)
+	if err != nil {
+		return err
+	}
+	switch sub {
+	case "create":
+		var roleList []string
+		for _, r := range strings.Split(*roles, ",") {
+			if r = strings.TrimSpace(r); r != "" {
+				roleList = append(roleList, r)
+			}
+		}
+		key, plain, err := auth.CreateAPIKey(backend.apiKeys, *name, roleList)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("id:    %s\nname:  %s\nroles: %s\nkey:   %s\n", key.ID, key.Name, strings.Join(key.Roles, ","), plain)
+		fmt.Fprintln(os.Stderr, "Store the key now; it cannot be shown again.")
+	case "list":
+		keys, err := backend.apiKeys.ListAPIKeys()
+		if err != nil {
+			return err
+		}
+		for _, k := range keys {
+			status := "active"
+			if k.RevokedAt != nil {
+				status = "revoked " + k.RevokedAt.Format(time.RFC3339)
+			}
+			fmt.Printf("%s\t%s\t%s…\t%s\t%s\n", k.ID, k.Name, k.Prefix, strings.Join(k.Roles, ","), status)
+		}
+	case "revoke":
+		if *id == "" {
+			return errors.New("-id is required")
+		}
+		if err := backend.apiKeys.RevokeAPIKey(*id); err != nil {
+			return err
+		}
+		fmt.Printf("revoked %s\n", *id)
+	default:
+		return fmt.Errorf("unknown subcommand %q", sub)
+	}
+	return nil
+}
+
+// runReindex rebuilds the Elasticsearch players index from the database.
+func runReindex(args []string) error {
+	fs := flag.NewFlagSet("reindex", flag.ExitOnError)
+	cfg := config.Load()
+	fs.StringVar(&cfg.DBType, "db", cfg.DBType, "database type (mongo or postgres)")
+	_ = fs.Parse(args)
+
+	backend, err := openMigratedBackend(cfg)
+	if err != nil {
+		return err
+	}
+	if backend.search == nil {
+		return errors.New("ELASTICSEARCH_URL is not set")
+	}
+	n, err := backend.search.Reindex(backend.players)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(os.Stderr, "indexed %d players\n", n)
+	return nil
+}
+
+// runMigrateData copies every player from one database into the other, so
+// DB_TYPE can be switched without starting empty, and verifies the copy.
+// Progress and the mapping between old and new IDs are kept in a journal,
+// and running the command again resumes or catches up with later changes.
+// Only players are copied, not their wallets, limits, transfers or ledger.
+func runMigrateData(args []string) error {
+	fs := flag.NewFlagSet("migrate-data", flag.ExitOnError)
+	cfg := config.Load()
+	from := fs.String("from", cfg.DBType, "database to copy from (mongo or postgres)")
+	to := fs.String("to", "", "database to copy to (default: the other one)")
+	journalPath := fs.String("journal", "", "ID mapping and progress file (default: migrate-<from>-<to>.ndjson)")
+	verifyOnly := fs.Bool("verify", false, "only verify an earlier migration")
+	_ = fs.Parse(args)
+
+	if *to == "" {
+		*to = "postgres"
+		if *from == "postgres" {
+			*to = "mongo"
+		}
+	}
+	for _, db := range []string{*from, *to} {
+		if db != "mongo" && db != "postgres" {
+			return fmt.Errorf("unsupported database %q", db)
+		}
+	}
+	if *from == *to {
+		return errors.New("-from and -to must differ")
+	}
+	if *journalPath == "" {
+		*journalPath = fmt.Sprintf("migrate-%s-%s.ndjson", *from, *to)
+	}
+
+	// Copies are written through the target's PlayerCopier, which records
+	// no outbox events, so they are neither published nor indexed next to
+	// the players they were copied from; `reindex` rebuilds the index once
+	// DB_TYPE is switched.
+	logger := newLogger(cfg)
+	open := func(dbType string) (*backend, error) {
+		c := *cfg
+		c.DBType = dbType
+		b := openDatabase(&c, logger)
+		if _, err := b.migrate(&c); err != nil {
+			return nil, fmt.Errorf("%s: %w", dbType, err)
+		}
+		return b, nil
+	}
+	src, err := open(*from)
+	if err != nil {
+		return err
+	}
+	dst, err := open(*to)
+	if err != nil {
+		return err
+	}
+	journal, err := playerio.OpenJournal(*journalPath)
+	if err != nil {
+		return err
+	}
+	defer journal.Close()
+
+	if !*verifyOnly {
+		fmt.Fprintln(os.Stderr, "warning: only players are copied; wallets, limits, transfers and the balance change ledger are not")
+		fmt.Fprintf(os.Stderr, "copying players from %s to %s, %d already copied\n", *from, *to, journal.Len())
+		result, err := playerio.Migrate(src.players, dst.copier, journal, func(r *playerio.MigrationResult) {
+			if n := r.Created + r.Updated + r.Unchanged; n%1000 == 0 {
+				fmt.Fprintf(os.Stderr, "%d players processed\n", n)
+			}
+		})
+		if result != nil {
+			fmt.Fprintf(os.Stderr, "created %d, updated %d, unchanged %d\n", result.Created, result.Updated, result.Unchanged)
+		}
+		if err != nil {
+			return fmt.Errorf("%w; run again to resume", err)
+		}
+	}
+	report, err := playerio.Verify(src.players, dst.players, journal)
+	if err != nil {
+		return err
+	}
+	printVerificationReport(report)
+	if !report.OK() {
+		return errors.New("verification failed")
+	}
+	return nil
+}
+
+// maxReportedIDs caps the player IDs listed per verification finding.
+const maxReportedIDs = 20
+
+func printVerificationReport(r *playerio.VerificationReport) {
+	fmt.Printf("source players: %d\ntarget players: %d\nmatched:        %d\n", r.SourcePlayers, r.TargetPlayers, r.Matched)
+	for _, finding := range []struct {
+		name string
+		ids  []string
+	}{
+		{"missing (source IDs)", r.Missing},
+		{"mismatched (source IDs)", r.Mismatched},
+		{"orphaned (target IDs)", r.Orphaned},
+		{"unmapped (target IDs)", r.Unmapped},
+	} {
+		if len(finding.ids) == 0 {
+			continue
+		}
+		ids := finding.ids
+		if len(ids) > maxReportedIDs {
+			ids = ids[:maxReportedIDs]
+		}
+		fmt.Printf("%s: %d\n  %s", finding.name, len(finding.ids), strings.Join(ids, ", "))
+		if len(ids) < len(finding.ids) {
+			fmt.Print(", …")
+		}
+		fmt.Println()
+	}
+	fmt.Println("balance totals:")
+	targets := map[string]money.Money{}
+	for _, t := range r.TargetTotals {
+		targets[t.Currency] = t
+	}
+	for _, s := range r.SourceTotals {
+		t, ok := targets[s.Currency]
+		status := "ok"
+		if !ok || !t.Amount.Equal(s.Amount) {
+			status = "DIFFERS"
+		}
+		fmt.Printf("  %s: source %s, target %s  %s\n", s.Currency, s.Amount, t.Amount, status)
+		delete(targets, s.Currency)
+	}
+	for _, t := range r.TargetTotals {
+		if _, ok := targets[t.Currency]; ok {
+			fmt.Printf("  %s: source 0, target %s  DIFFERS\n", t.Currency, t.Amount)
+		}
+	}
+}
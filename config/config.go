@@ -0,0 +1,140 @@
+// Package config loads the backend configuration from environment variables.
+package config
+
+import (
+	"os"
+	"strconv"
+	"time"
+)
+
+// Config holds the settings shared by the server and the CLI commands.
+type Config struct {
+	DBType          string
+	Addr            string
+	LogLevel        string
+	ElasticURL      string
+	ElasticIndex    string
+	ElasticUsername string
+	ElasticPassword string
+	// SearchIndex is the alias of the players search index.
+	SearchIndex string
+
+	// TLS is enabled when both TLSCertFile and TLSKeyFile are set.
+	TLSCertFile       string
+	TLSKeyFile        string
+	TLSClientCAFile   string
+	TLSReloadInterval time.Duration
+	HTTPRedirectAddr  string
+
+	RateLimitEnabled bool
+	// RateLimits overrides per-route limits, e.g. "players.create=10/1m+1000/24h".
+	RateLimits string
+
+	// AuthEnabled requires an API key or JWT on every /api route except ping.
+	AuthEnabled   bool
+	JWTHMACSecret string
+	JWTJWKSFile   string
+	JWTIssuer     string
+	JWTAudience   string
+	// RBACPolicyFile replaces the built-in role policy when set.
+	RBACPolicyFile string
+
+	// IdempotencyTTL is how long responses are kept for Idempotency-Key replays.
+	IdempotencyTTL time.Duration
+
+	// WebhookMaxAttempts is how often a webhook delivery is tried before it
+	// is dead-lettered.
+	WebhookMaxAttempts int
+
+	// StatsCacheTTL is how long player statistics are reused; 0 disables
+	// the cache.
+	StatsCacheTTL time.Duration
+
+	// ExchangeRatesFile is the rate table for wallet conversions, which are
+	// unavailable when it is unset.
+	ExchangeRatesFile string
+
+	// LegacyCurrency is the currency of balances stored before players had
+	// one, which migration 7 needs to convert them.
+	LegacyCurrency string
+
+	// LimitCoolingOff is how long raising or removing a deposit or loss
+	// limit takes to apply.
+	LimitCoolingOff time.Duration
+}
+
+// TLSEnabled reports whether the server should listen with HTTPS.
+func (c *Config) TLSEnabled() bool {
+	return c.TLSCertFile != "" && c.TLSKeyFile != ""
+}
+
+// Load reads the configuration from the environment, applying defaults for unset values.
+func Load() *Config {
+	return &Config{
+		DBType:          getEnv("DB_TYPE", "mongo"),
+		Addr:            getEnv("ADDR", ":8080"),
+		LogLevel:        os.Getenv("LOG_LEVEL"),
+		ElasticURL:      os.Getenv("ELASTICSEARCH_URL"),
+		ElasticIndex:    getEnv("ELASTICSEARCH_INDEX", "contoso-"),
+		ElasticUsername: os.Getenv("ELASTICSEARCH_USERNAME"),
+		ElasticPassword: os.Getenv("ELASTICSEARCH_PASSWORD"),
+		SearchIndex:     getEnv("SEARCH_INDEX", "players"),
+
+		TLSCertFile:       os.Getenv("TLS_CERT_FILE"),
+		TLSKeyFile:        os.Getenv("TLS_KEY_FILE"),
+		TLSClientCAFile:   os.Getenv("TLS_CLIENT_CA_FILE"),
+		TLSReloadInterval: getDuration("TLS_RELOAD_INTERVAL", 30*time.Second),
+		HTTPRedirectAddr:  os.Getenv("HTTP_REDIRECT_ADDR"),
+
+		RateLimitEnabled: getBool("RATE_LIMIT_ENABLED", true),
+		RateLimits:       os.Getenv("RATE_LIMITS"),
+
+		AuthEnabled:   getBool("AUTH_ENABLED", true),
+		JWTHMACSecret: os.Getenv("JWT_HMAC_SECRET"),
+		JWTJWKSFile:   os.Getenv("JWT_JWKS_FILE"),
+		JWTIssuer:     os.Getenv("JWT_ISSUER"),
+		JWTAudience:   os.Getenv("JWT_AUDIENCE"),
+
+		RBACPolicyFile: os.Getenv("RBAC_POLICY_FILE"),
+
+		IdempotencyTTL: getDuration("IDEMPOTENCY_TTL", 24*time.Hour),
+
+		WebhookMaxAttempts: getInt("WEBHOOK_MAX_ATTEMPTS", 10),
+
+		StatsCacheTTL: getDuration("STATS_CACHE_TTL", 30*time.Second),
+
+		ExchangeRatesFile: os.Getenv("EXCHANGE_RATES_FILE"),
+
+		LegacyCurrency: os.Getenv("LEGACY_CURRENCY"),
+
+		LimitCoolingOff: getDuration("LIMIT_COOLING_OFF", 24*time.Hour),
+	}
+}
+
+func getEnv(key, def string) string {
+	if v := os.Getenv(key); v != "" {
+		return v
+	}
+	return def
+}
+
+func getDuration(key string, def time.Duration) time.Duration {
+	if d, err := time.ParseDuration(os.Getenv(key)); err == nil {
+		return d
+	}
+	return def
+}
+
+func getInt(key string, def int) int {
+	if n, err := strconv.Atoi(os.Getenv(key)); err == nil && n > 0 {
+		return n
+	}
+	return def
+}
+
+func getBool(key string, def bool) bool {
+	if b, err := strconv.ParseBool(os.Getenv(key)); err == nil {
+		return b
+	}
+	return def
+}
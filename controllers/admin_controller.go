@@ -0,0 +1,48 @@
+package controllers
+
+import (
+	"contoso/tlsconfig"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// TLSStatus describes the certificate the server is currently presenting.
+type TLSStatus struct {
+	Enabled   bool      `json:"enabled"`
+	Subject   string    `json:"subject,omitempty"`
+	Issuer    string    `json:"issuer,omitempty"`
+	DNSNames  []string  `json:"dnsNames,omitempty"`
+	NotBefore time.Time `json:"notBefore,omitzero"`
+	NotAfter  time.Time `json:"notAfter,omitzero"`
+	LoadedAt  time.Time `json:"loadedAt,omitzero"`
+}
+
+// GetTLSStatus godoc
+// @Summary Serving certificate status
+// @Description Returns the certificate currently in use, to confirm rotations were picked up
+// @Tags admin
+// @Produce json
+// @Success 200 {object} controllers.TLSStatus
+// @Failure 401 {object} problem.Details
+// @Failure 403 {object} problem.Details
+// @Security ApiKeyAuth
+// @Security BearerAuth
+// @Router /api/v1/admin/tls [get]
+func GetTLSStatus(certs *tlsconfig.CertReloader) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		if certs == nil {
+			return c.JSON(TLSStatus{})
+		}
+		leaf, loadedAt := certs.Leaf()
+		return c.JSON(TLSStatus{
+			Enabled:   true,
+			Subject:   leaf.Subject.String(),
+			Issuer:    leaf.Issuer.String(),
+			DNSNames:  leaf.DNSNames,
+			NotBefore: leaf.NotBefore,
+			NotAfter:  leaf.NotAfter,
+			LoadedAt:  loadedAt,
+		})
+	}
+}
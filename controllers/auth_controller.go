@@ -0,0 +1,27 @@
+package controllers
+
+import (
+	"contoso/auth"
+	"contoso/problem"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// GetCurrentPrincipal godoc
+// @Summary Current caller
+// @Description Returns the identity and roles the request was authenticated as
+// @Tags auth
+// @Produce json
+// @Security ApiKeyAuth
+// @Security BearerAuth
+// @Success 200 {object} auth.Principal
+// @Failure 401 {object} problem.Details
+// @Router /api/v1/me [get]
+// @Router /api/v2/me [get]
+func GetCurrentPrincipal(c *fiber.Ctx) error {
+	p := auth.PrincipalFrom(c)
+	if p == nil {
+		return problem.Respond(c, fiber.StatusUnauthorized, problem.CodeUnauthorized, "not authenticated")
+	}
+	return c.JSON(p)
+}
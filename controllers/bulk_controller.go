@@ -0,0 +1,187 @@
+package controllers
+
+import (
+	"contoso/models"
+	"contoso/problem"
+	"contoso/rbac"
+	"contoso/repository"
+	"contoso/validation"
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// BulkItemResult is the outcome of one operation of a bulk request.
+type BulkItemResult struct {
+	Index  int              `json:"index"`
+	Op     string           `json:"op"`
+	ID     string           `json:"id,omitempty"`
+	Status int              `json:"status"`
+	Player *models.Player   `json:"player,omitempty"`
+	Error  *problem.Details `json:"error,omitempty"`
+}
+
+// BulkResponse reports every operation of a bulk request in request order.
+type BulkResponse struct {
+	Mode      string           `json:"mode"`
+	Succeeded int              `json:"succeeded"`
+	Failed    int              `json:"failed"`
+	Results   []BulkItemResult `json:"results"`
+}
+
+// Authorize returns nil when the request may use permission and an error
+// response otherwise. A nil Authorize allows everything.
+type Authorize func(c *fiber.Ctx, permission string) error
+
+// authorizeOpeningBalances requires players:balance to create players with
+// money, which would otherwise be credited with players:write alone.
+func authorizeOpeningBalances(c *fiber.Ctx, authorize Authorize, players ...*models.Player) error {
+	if authorize == nil {
+		return nil
+	}
+	for _, p := range players {
+		if p != nil && !p.Balance.Amount.IsZero() {
+			return authorize(c, rbac.PlayersBalance)
+		}
+	}
+	return nil
+}
+
+// BulkPlayers godoc
+// @Summary Create, update and delete players in bulk
+// @Description Applies up to 1000 operations. In atomic mode (the default) either all operations are applied or none are; in best-effort mode each valid operation is applied on its own.
+// @Description The response lists a result per operation. The status is 200 when everything succeeded, 207 when a best-effort request partly failed and 409 when an atomic request was rolled back.
+// @Description Deletes additionally require the players:delete permission, and creates with a non-zero balance players:balance.
+// @Tags players
+// @Accept json
+// @Produce json
+// @Param request body models.BulkRequest true "Operations"
+// @Param Idempotency-Key header string false "Key that makes retries of this request safe"
+// @Success 200 {object} BulkResponse
+// @Success 207 {object} BulkResponse
+// @Failure 400 {object} problem.Details
+// @Failure 401 {object} problem.Details
+// @Failure 403 {object} problem.Details
+// @Failure 409 {object} BulkResponse
+// @Failure 422 {object} problem.Details
+// @Failure 429 {object} problem.Details
+// @Failure 500 {object} problem.Details
+// @Failure 501 {object} problem.Details
+// @Security ApiKeyAuth
+// @Security BearerAuth
+// @Router /api/v1/players/bulk [post]
+func BulkPlayers(repo repository.PlayerRepository, authorize Authorize) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		var req models.BulkRequest
+		if err := parseBody(c, &req); err != nil {
+			return err
+		}
+		if req.Mode == "" {
+			req.Mode = models.BulkAtomic
+		}
+		atomic := req.Mode == models.BulkAtomic
+		if authorize != nil {
+			for _, op := range req.Operations {
+				if op.Op == models.BulkDelete {
+					if err := authorize(c, rbac.PlayersDelete); err != nil {
+						return err
+					}
+					break
+				}
+			}
+			var created []*models.Player
+			for _, op := range req.Operations {
+				if op.Op == models.BulkCreate {
+					created = append(created, op.Player)
+				}
+			}
+			if err := authorizeOpeningBalances(c, authorize, created...); err != nil {
+				return err
+			}
+		}
+
+		// Invalid operations never reach the repository
+		results := make([]repository.BulkItemResult, len(req.Operations))
+		var valid []models.BulkOperation
+		var positions []int
+		for i := range req.Operations {
+			if err := validateBulkOperation(&req.Operations[i]); err != nil {
+				results[i].Err = err
+				continue
+			}
+			valid = append(valid, req.Operations[i])
+			positions = append(positions, i)
+		}
+		aborted := atomic && len(valid) < len(req.Operations)
+		switch {
+		case aborted:
+			for i := range results {
+				if results[i].Err == nil {
+					results[i].Err = repository.ErrNotExecuted
+				}
+			}
+		case len(valid) > 0:
+			applied, err := repo.BulkWrite(valid, atomic)
+			if err != nil && !errors.Is(err, repository.ErrBulkAborted) {
+				return err
+			}
+			aborted = err != nil
+			for n, i := range positions {
+				results[i] = applied[n]
+			}
+		}
+
+		resp := BulkResponse{Mode: req.Mode, Results: make([]BulkItemResult, len(results))}
+		for i, r := range results {
+			op := req.Operations[i]
+			item := BulkItemResult{Index: i, Op: op.Op, ID: op.ID, Player: r.Player}
+			if r.Err != nil {
+				item.Error, _ = problem.FromError(r.Err)
+				item.Status = item.Error.Status
+				resp.Failed++
+			} else {
+				item.Status = bulkSuccessStatus(op.Op)
+				if r.Player != nil {
+					item.ID = r.Player.ID
+				}
+				resp.Succeeded++
+			}
+			resp.Results[i] = item
+		}
+		status := fiber.StatusOK
+		switch {
+		case aborted:
+			status = fiber.StatusConflict
+		case resp.Failed > 0:
+			status = fiber.StatusMultiStatus
+		}
+		return c.Status(status).JSON(resp)
+	}
+}
+
+func bulkSuccessStatus(op string) int {
+	switch op {
+	case models.BulkCreate:
+		return fiber.StatusCreated
+	case models.BulkDelete:
+		return fiber.StatusNoContent
+	}
+	return fiber.StatusOK
+}
+
+// bulkUpdateFields are the fields of an update operation that are validated:
+// those of the player that updates read, as with PUT.
+var bulkUpdateFields = func() []string {
+	fields := []string{"Op", "ID"}
+	for _, f := range models.PlayerUpdateFields {
+		fields = append(fields, "Player."+f)
+	}
+	return fields
+}()
+
+func validateBulkOperation(op *models.BulkOperation) error {
+	if op.Op == models.BulkUpdate {
+		return validation.Partial(op, bulkUpdateFields...)
+	}
+	return validation.Struct(op)
+}
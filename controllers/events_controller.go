@@ -0,0 +1,187 @@
+package controllers
+
+import (
+	"bufio"
+	"contoso/events"
+	"contoso/problem"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gofiber/contrib/websocket"
+	"github.com/gofiber/fiber/v2"
+)
+
+// eventHeartbeat is how often an idle stream is pinged so proxies keep it
+// open and dead clients are noticed.
+const eventHeartbeat = 15 * time.Second
+
+// maxEventFilters bounds the player IDs one stream may follow.
+const maxEventFilters = 100
+
+const subscriptionLocal = "eventSubscription"
+
+// PlayerEvents godoc
+// @Summary Stream player changes
+// @Description Streams player.created, player.updated, player.deleted, player.balance_changed, player.currency_converted and player.status_changed events as they happen.
+// @Description Served as Server-Sent Events, or as JSON text messages when the request is a WebSocket upgrade.
+// @Description Clients that fall too far behind are disconnected and should reconnect and refetch.
+// @Tags players
+// @Produce text/event-stream
+// @Param playerId query []string false "Only events for these player IDs; repeat or comma-separate" collectionFormat(multi)
+// @Param type query []string false "Only these event types" collectionFormat(multi)
+// @Success 200 {object} events.Event
+// @Failure 400 {object} problem.Details
+// @Failure 401 {object} problem.Details
+// @Failure 403 {object} problem.Details
+// @Failure 429 {object} problem.Details
+// @Failure 503 {object} problem.Details
+// @Security ApiKeyAuth
+// @Security BearerAuth
+// @Router /api/v1/players/events [get]
+func PlayerEvents(broker *events.Broker) fiber.Handler {
+	upgrade := websocket.New(streamWebSocket)
+	return func(c *fiber.Ctx) error {
+		filter, err := eventFilter(c)
+		if err != nil {
+			return err
+		}
+		sub, err := broker.Subscribe(filter)
+		if errors.Is(err, events.ErrTooManySubscribers) {
+			c.Set(fiber.HeaderRetryAfter, "5")
+			return problem.New(fiber.StatusServiceUnavailable, problem.CodeServiceUnavailable, err.Error())
+		}
+		if err != nil {
+			return err
+		}
+
+		if websocket.IsWebSocketUpgrade(c) {
+			c.Locals(subscriptionLocal, sub)
+			if err := upgrade(c); err != nil {
+				sub.Close()
+				return err
+			}
+			return nil
+		}
+
+		c.Set(fiber.HeaderContentType, "text/event-stream")
+		c.Set(fiber.HeaderCacheControl, "no-cache")
+		c.Set(fiber.HeaderConnection, "keep-alive")
+		c.Set("X-Accel-Buffering", "no")
+		c.Context().SetBodyStreamWriter(func(w *bufio.Writer) {
+			defer sub.Close()
+			streamSSE(w, sub)
+		})
+		return nil
+	}
+}
+
+// eventFilter reads the playerId and type query parameters.
+func eventFilter(c *fiber.Ctx) (events.Filter, error) {
+	var filter events.Filter
+	for _, raw := range c.Context().QueryArgs().PeekMulti("playerId") {
+		for _, id := range strings.Split(string(raw), ",") {
+			if id = strings.TrimSpace(id); id != "" {
+				filter.PlayerIDs = append(filter.PlayerIDs, id)
+			}
+		}
+	}
+	if len(filter.PlayerIDs) > maxEventFilters {
+		return filter, problem.New(fiber.StatusBadRequest, problem.CodeInvalidQuery,
+			fmt.Sprintf("at most %d playerId values are allowed", maxEventFilters))
+	}
+	for _, raw := range c.Context().QueryArgs().PeekMulti("type") {
+		for _, t := range strings.Split(string(raw), ",") {
+			if t = strings.TrimSpace(t); t == "" {
+				continue
+			}
+			if !validEventType(t) {
+				return filter, problem.New(fiber.StatusBadRequest, problem.CodeInvalidQuery,
+					"type must be one of "+strings.Join(events.Types, ", "))
+			}
+			filter.Types = append(filter.Types, t)
+		}
+	}
+	return filter, nil
+}
+
+func validEventType(t string) bool {
+	for _, known := range events.Types {
+		if t == known {
+			return true
+		}
+	}
+	return false
+}
+
+// streamSSE writes events as Server-Sent Events until the subscription
+// ends or a write fails because the client went away.
+func streamSSE(w *bufio.Writer, sub *events.Subscription) {
+	heartbeat := time.NewTicker(eventHeartbeat)
+	defer heartbeat.Stop()
+
+	// Open the stream straight away so clients know they are subscribed.
+	fmt.Fprint(w, "retry: 3000\n\n")
+	if w.Flush() != nil {
+		return
+	}
+	for {
+		select {
+		case e, ok := <-sub.C:
+			if !ok {
+				return
+			}
+			data, err := json.Marshal(e)
+			if err != nil {
+				continue
+			}
+			fmt.Fprintf(w, "id: %s\nevent: %s\ndata: %s\n\n", e.ID, e.Type, data)
+		case <-heartbeat.C:
+			fmt.Fprint(w, ": heartbeat\n\n")
+		}
+		if w.Flush() != nil {
+			return
+		}
+	}
+}
+
+// streamWebSocket sends events as JSON text messages. Clients only listen;
+// anything they send is discarded, and reading is how a close is noticed.
+func streamWebSocket(conn *websocket.Conn) {
+	sub := conn.Locals(subscriptionLocal).(*events.Subscription)
+	defer sub.Close()
+
+	closed := make(chan struct{})
+	go func() {
+		defer close(closed)
+		for {
+			if _, _, err := conn.ReadMessage(); err != nil {
+				return
+			}
+		}
+	}()
+
+	heartbeat := time.NewTicker(eventHeartbeat)
+	defer heartbeat.Stop()
+	for {
+		var err error
+		select {
+		case e, ok := <-sub.C:
+			if !ok {
+				conn.WriteMessage(websocket.CloseMessage,
+					websocket.FormatCloseMessage(websocket.CloseTryAgainLater, "subscriber fell behind"))
+				return
+			}
+			err = conn.WriteJSON(e)
+		case <-heartbeat.C:
+			err = conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(eventHeartbeat))
+		case <-closed:
+			return
+		}
+		if err != nil {
+			return
+		}
+	}
+}
@@ -0,0 +1,145 @@
+package controllers
+
+import (
+	"bufio"
+	"bytes"
+	"contoso/elasticlog"
+	"contoso/models"
+	"contoso/playerio"
+	"contoso/problem"
+	"contoso/repository"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// importMaxRows bounds the records accepted by one import request.
+const importMaxRows = 100000
+
+// ImportReport is the response of POST /api/players/import.
+type ImportReport struct {
+	Format  string `json:"format"`
+	DryRun  bool   `json:"dryRun"`
+	Records int    `json:"records"`
+	playerio.ImportResult
+}
+
+// ExportPlayers godoc
+// @Summary Export all players
+// @Description Streams every player as CSV (the default), NDJSON or a JSON array without buffering the result.
+// @Tags players
+// @Produce text/csv
+// @Produce application/x-ndjson
+// @Produce json
+// @Param format query string false "csv, ndjson or json" Enums(csv, ndjson, json)
+// @Success 200 {file} file
+// @Failure 400 {object} problem.Details
+// @Failure 401 {object} problem.Details
+// @Failure 403 {object} problem.Details
+// @Failure 429 {object} problem.Details
+// @Security ApiKeyAuth
+// @Security BearerAuth
+// @Router /api/v1/players/export [get]
+func ExportPlayers(repo repository.PlayerRepository, logger *elasticlog.Logger) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		format, err := playerio.ParseFormat(c.Query("format", string(playerio.CSV)))
+		if err != nil {
+			return problem.New(fiber.StatusBadRequest, problem.CodeInvalidQuery, err.Error())
+		}
+		filename := "players-" + time.Now().UTC().Format("20060102") + "." + string(format)
+		// Attachment sets a type from the extension; ours carries the charset
+		c.Attachment(filename)
+		c.Set(fiber.HeaderContentType, format.ContentType())
+
+		// The stream runs after the handler returns, so copy what it needs.
+		requestID := problem.RequestID(c)
+		c.Context().SetBodyStreamWriter(func(w *bufio.Writer) {
+			pw, _ := playerio.NewWriter(w, format)
+			err := repo.StreamPlayers(func(player *models.Player) error {
+				return pw.Write(player)
+			})
+			if err == nil {
+				err = pw.Close()
+			}
+			// Headers are gone by now; the truncated body is all the client
+			// sees, so make sure the failure is at least logged.
+			if err != nil && logger != nil {
+				logger.Error("Player export failed", map[string]interface{}{
+					"error":     err.Error(),
+					"format":    string(format),
+					"requestId": requestID,
+				})
+			}
+		})
+		return nil
+	}
+}
+
+// ImportPlayers godoc
+// @Summary Import players
+// @Description Creates a player for every record of a CSV, NDJSON or JSON array body; ids in the input are ignored.
+// @Description Every record is validated first. If any fails, nothing is imported and the 422 response lists the failures as rows[N].field, N counting records from 1.
+// @Description With dryRun=true the input is only validated. Importing non-zero balances also requires the players:balance permission.
+// @Tags players
+// @Accept text/csv
+// @Accept application/x-ndjson
+// @Accept json
+// @Produce json
+// @Param format query string false "csv, ndjson or json; defaults to the Content-Type" Enums(csv, ndjson, json)
+// @Param dryRun query bool false "validate only"
+// @Param Idempotency-Key header string false "Key that makes retries of this request safe"
+// @Success 200 {object} ImportReport
+// @Failure 400 {object} problem.Details
+// @Failure 401 {object} problem.Details
+// @Failure 403 {object} problem.Details
+// @Failure 409 {object} problem.Details
+// @Failure 413 {object} problem.Details
+// @Failure 415 {object} problem.Details
+// @Failure 422 {object} problem.Details
+// @Failure 429 {object} problem.Details
+// @Failure 500 {object} problem.Details
+// @Security ApiKeyAuth
+// @Security BearerAuth
+// @Router /api/v1/players/import [post]
+func ImportPlayers(repo repository.PlayerRepository, authorize Authorize) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		format, ok := playerio.FormatFromContentType(c.Get(fiber.HeaderContentType))
+		if q := c.Query("format"); q != "" {
+			var err error
+			if format, err = playerio.ParseFormat(q); err != nil {
+				return problem.New(fiber.StatusBadRequest, problem.CodeInvalidQuery, err.Error())
+			}
+		} else if !ok {
+			return problem.New(fiber.StatusUnsupportedMediaType, problem.CodeUnsupportedMedia,
+				"use text/csv, application/x-ndjson or application/json, or pass format")
+		}
+		reader, err := playerio.NewReader(bytes.NewReader(c.Body()), format)
+		if err != nil {
+			return err
+		}
+		players, verr, err := playerio.ReadAll(reader, importMaxRows)
+		if err != nil {
+			return problem.New(fiber.StatusBadRequest, problem.CodeMalformedBody, err.Error())
+		}
+		if verr != nil {
+			return verr
+		}
+		report := ImportReport{Format: string(format), DryRun: c.QueryBool("dryRun"), Records: len(players)}
+		if report.DryRun {
+			return c.JSON(report)
+		}
+		opening := make([]*models.Player, len(players))
+		for i := range players {
+			opening[i] = &players[i]
+		}
+		if err := authorizeOpeningBalances(c, authorize, opening...); err != nil {
+			return err
+		}
+		result, err := playerio.Import(repo, players)
+		if err != nil {
+			return err
+		}
+		report.ImportResult = *result
+		return c.JSON(report)
+	}
+}
@@ -0,0 +1,84 @@
+package controllers
+
+import (
+	"contoso/models"
+	"contoso/problem"
+	"contoso/repository"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// Leaderboard paging limits. maxLeaderboardOffset keeps deep pages from
+// scanning most of the balance index.
+const (
+	defaultLeaderboardSize = 20
+	maxLeaderboardSize     = 100
+	maxLeaderboardOffset   = 10000
+)
+
+// GetLeaderboard godoc
+// @Summary Balance leaderboard
+// @Description Players with balances in the given currency by balance, highest first, one page at a time (up to the first 10,000 players). Players with equal balances share a rank.
+// @Tags players
+// @Produce json
+// @Param currency query string true "Currency of the ranked balances" example(EUR)
+// @Param page query int false "Page, from 1" default(1)
+// @Param size query int false "Players per page, at most 100" default(20)
+// @Success 200 {object} models.Leaderboard
+// @Failure 400 {object} problem.Details
+// @Failure 401 {object} problem.Details
+// @Failure 403 {object} problem.Details
+// @Failure 429 {object} problem.Details
+// @Failure 500 {object} problem.Details
+// @Security ApiKeyAuth
+// @Security BearerAuth
+// @Router /api/v1/leaderboard [get]
+func GetLeaderboard(repo repository.PlayerRepository) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		currency, err := currencyQuery(c)
+		if err != nil {
+			return err
+		}
+		page := c.QueryInt("page", 1)
+		size := c.QueryInt("size", defaultLeaderboardSize)
+		switch {
+		case page < 1:
+			return problem.New(fiber.StatusBadRequest, problem.CodeInvalidQuery, "page must be at least 1")
+		case size < 1 || size > maxLeaderboardSize:
+			return problem.New(fiber.StatusBadRequest, problem.CodeInvalidQuery, "size must be between 1 and 100")
+		case page > maxLeaderboardOffset/size:
+			return problem.New(fiber.StatusBadRequest, problem.CodeInvalidQuery, "only the first 10,000 players can be paged through")
+		}
+		entries, total, err := repo.Leaderboard(currency, (page-1)*size, size)
+		if err != nil {
+			return err
+		}
+		return c.JSON(models.Leaderboard{Currency: currency, Entries: entries, Page: page, Size: size, Total: total})
+	}
+}
+
+// GetPlayerRank godoc
+// @Summary Get a player's rank
+// @Description The player's place on the balance leaderboard of its currency: one more than the number of players with a higher balance in that currency.
+// @Tags players
+// @Produce json
+// @Param id path string true "Player ID"
+// @Success 200 {object} models.PlayerRank
+// @Failure 400 {object} problem.Details
+// @Failure 401 {object} problem.Details
+// @Failure 403 {object} problem.Details
+// @Failure 404 {object} problem.Details
+// @Failure 429 {object} problem.Details
+// @Failure 500 {object} problem.Details
+// @Security ApiKeyAuth
+// @Security BearerAuth
+// @Router /api/v1/players/{id}/rank [get]
+func GetPlayerRank(repo repository.PlayerRepository) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		rank, err := repo.Rank(c.Params("id"))
+		if err != nil {
+			return err
+		}
+		return c.JSON(rank)
+	}
+}
@@ -0,0 +1,116 @@
+package controllers
+
+import (
+	"contoso/models"
+	"contoso/problem"
+	"contoso/repository"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// ListLimits godoc
+// @Summary List a player's deposit and loss limits
+// @Description Returns the player's limits with what was used of each in the current period and when it resets. Periods are calendar days, weeks starting on Monday, and months in UTC.
+// @Tags limits
+// @Produce json
+// @Param id path string true "Player ID"
+// @Success 200 {array} models.Limit
+// @Failure 400 {object} problem.Details
+// @Failure 401 {object} problem.Details
+// @Failure 403 {object} problem.Details
+// @Failure 404 {object} problem.Details
+// @Failure 429 {object} problem.Details
+// @Failure 500 {object} problem.Details
+// @Security ApiKeyAuth
+// @Security BearerAuth
+// @Router /api/v1/players/{id}/limits [get]
+func ListLimits(repo repository.LimitRepository) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		limits, err := repo.ListLimits(c.Params("id"))
+		if err != nil {
+			return err
+		}
+		return c.JSON(limits)
+	}
+}
+
+// SetLimit godoc
+// @Summary Set a deposit or loss limit
+// @Description Sets the limit, which must be in the currency of the player's balance. New and lowered limits apply at once; raising a limit only takes effect after the cooling-off period and is shown as pending until then.
+// @Tags limits
+// @Accept json
+// @Produce json
+// @Param id path string true "Player ID"
+// @Param kind path string true "Limit kind" Enums(deposit, loss)
+// @Param period path string true "Limit period" Enums(daily, weekly, monthly)
+// @Param limit body models.LimitInput true "Limit amount"
+// @Success 200 {object} models.Limit
+// @Failure 400 {object} problem.Details
+// @Failure 401 {object} problem.Details
+// @Failure 403 {object} problem.Details
+// @Failure 404 {object} problem.Details
+// @Failure 422 {object} problem.Details
+// @Failure 429 {object} problem.Details
+// @Failure 500 {object} problem.Details
+// @Security ApiKeyAuth
+// @Security BearerAuth
+// @Router /api/v1/players/{id}/limits/{kind}/{period} [put]
+func SetLimit(repo repository.LimitRepository) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		kind, period, err := limitParams(c)
+		if err != nil {
+			return err
+		}
+		var input models.LimitInput
+		if err := parseBody(c, &input); err != nil {
+			return err
+		}
+		limit, err := repo.SetLimit(c.Params("id"), kind, period, input.Money())
+		if err != nil {
+			return err
+		}
+		return c.JSON(limit)
+	}
+}
+
+// RemoveLimit godoc
+// @Summary Remove a deposit or loss limit
+// @Description Schedules the removal of the limit, which stays in force, shown with a pending removal, until the cooling-off period has passed.
+// @Tags limits
+// @Produce json
+// @Param id path string true "Player ID"
+// @Param kind path string true "Limit kind" Enums(deposit, loss)
+// @Param period path string true "Limit period" Enums(daily, weekly, monthly)
+// @Success 200 {object} models.Limit
+// @Failure 400 {object} problem.Details
+// @Failure 401 {object} problem.Details
+// @Failure 403 {object} problem.Details
+// @Failure 404 {object} problem.Details
+// @Failure 429 {object} problem.Details
+// @Failure 500 {object} problem.Details
+// @Security ApiKeyAuth
+// @Security BearerAuth
+// @Router /api/v1/players/{id}/limits/{kind}/{period} [delete]
+func RemoveLimit(repo repository.LimitRepository) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		kind, period, err := limitParams(c)
+		if err != nil {
+			return err
+		}
+		limit, err := repo.RemoveLimit(c.Params("id"), kind, period)
+		if err != nil {
+			return err
+		}
+		return c.JSON(limit)
+	}
+}
+
+// limitParams returns the kind and period path parameters.
+func limitParams(c *fiber.Ctx) (kind, period string, err error) {
+	kind, period = c.Params("kind"), c.Params("period")
+	if !models.ValidLimit(kind, period) {
+		return "", "", problem.New(fiber.StatusNotFound, problem.CodeLimitNotFound,
+			"limits are deposit or loss and daily, weekly or monthly")
+	}
+	return kind, period, nil
+}
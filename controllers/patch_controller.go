@@ -0,0 +1,130 @@
+package controllers
+
+import (
+	"contoso/models"
+	"contoso/problem"
+	"contoso/repository"
+	"encoding/json"
+	"errors"
+	"mime"
+
+	jsonpatch "github.com/evanphx/json-patch/v5"
+	"github.com/gofiber/fiber/v2"
+)
+
+// Patch media types.
+const (
+	MergePatchJSON = "application/merge-patch+json"
+	JSONPatchJSON  = "application/json-patch+json"
+)
+
+// PatchPlayer godoc
+// @Summary Partially update a player
+// @Description Applies a JSON Merge Patch (RFC 7396) or JSON Patch (RFC 6902) to a player.
+// @Description Only the fields the patch changes are written. The balance and currency are changed with balance adjustments and transfers only; a patch that changes them returns 422.
+// @Description A JSON Patch "test" operation that fails returns 409 patch_test_failed, and a player changed by another request while the patch was applied returns 409 player_changed.
+// @Tags players
+// @Accept application/merge-patch+json,application/json-patch+json
+// @Produce json
+// @Param id path string true "Player ID"
+// @Param patch body object true "Merge patch object or JSON Patch operation array"
+// @Success 200 {object} models.PlayerV1
+// @Failure 400 {object} problem.Details
+// @Failure 401 {object} problem.Details
+// @Failure 403 {object} problem.Details
+// @Failure 404 {object} problem.Details
+// @Failure 409 {object} problem.Details
+// @Failure 415 {object} problem.Details
+// @Failure 422 {object} problem.Details
+// @Failure 429 {object} problem.Details
+// @Failure 500 {object} problem.Details
+// @Security ApiKeyAuth
+// @Security BearerAuth
+// @Router /api/v1/players/{id} [patch]
+func PatchPlayer(repo repository.PlayerRepository) fiber.Handler {
+	return patchPlayer(repo, v1Players)
+}
+
+// representation converts between stored players and the JSON of one API
+// version, so version-independent handlers can serve several versions.
+type representation struct {
+	encode func(p *models.Player) interface{}
+	decode func(data []byte) (*models.Player, error)
+}
+
+var v1Players = representation{
+	encode: func(p *models.Player) interface{} { return p },
+	decode: func(data []byte) (*models.Player, error) {
+		var p models.Player
+		if err := parseJSON(data, &p, models.PlayerUpdateFields...); err != nil {
+			return nil, err
+		}
+		return &p, nil
+	},
+}
+
+// patchPlayer applies a patch to the player as rep presents it.
+func patchPlayer(repo repository.PlayerRepository, rep representation) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		mediaType, _, _ := mime.ParseMediaType(c.Get(fiber.HeaderContentType))
+		if mediaType != MergePatchJSON && mediaType != JSONPatchJSON {
+			return problem.Respond(c, fiber.StatusUnsupportedMediaType, problem.CodeUnsupportedMedia,
+				"use "+MergePatchJSON+" or "+JSONPatchJSON)
+		}
+		id := c.Params("id")
+		current, err := repo.GetPlayer(id)
+		if err != nil {
+			return err
+		}
+		original, err := json.Marshal(rep.encode(current))
+		if err != nil {
+			return err
+		}
+		patched, err := applyPatch(mediaType, original, c.Body())
+		if err != nil {
+			return err
+		}
+		updated, err := rep.decode(patched)
+		if err != nil {
+			return err
+		}
+		if updated.ID != current.ID {
+			return problem.Respond(c, fiber.StatusUnprocessableEntity, problem.CodeImmutableField, "id cannot be changed")
+		}
+		if updated.Status != current.Status {
+			return problem.Respond(c, fiber.StatusUnprocessableEntity, problem.CodeImmutableField,
+				"status can only be changed with a status transition")
+		}
+		if !updated.Balance.Amount.Equal(current.Balance.Amount) || updated.Balance.Currency != current.Balance.Currency {
+			return problem.Respond(c, fiber.StatusUnprocessableEntity, problem.CodeImmutableField,
+				"balance can only be changed with a balance adjustment or transfer")
+		}
+		result, err := repo.PatchPlayer(id, current, current.Diff(updated))
+		if err != nil {
+			return err
+		}
+		return c.JSON(rep.encode(result))
+	}
+}
+
+func applyPatch(mediaType string, doc, body []byte) ([]byte, error) {
+	if mediaType == MergePatchJSON {
+		var obj map[string]interface{}
+		if err := json.Unmarshal(body, &obj); err != nil {
+			return nil, problem.New(fiber.StatusBadRequest, problem.CodeMalformedBody, "merge patch must be a JSON object")
+		}
+		return jsonpatch.MergePatch(doc, body)
+	}
+	patch, err := jsonpatch.DecodePatch(body)
+	if err != nil {
+		return nil, problem.New(fiber.StatusBadRequest, problem.CodeMalformedBody, err.Error())
+	}
+	out, err := patch.Apply(doc)
+	if err != nil {
+		if errors.Is(err, jsonpatch.ErrTestFailed) {
+			return nil, problem.New(fiber.StatusConflict, problem.CodePatchTestFailed, "a test operation did not match the current player")
+		}
+		return nil, problem.New(fiber.StatusUnprocessableEntity, problem.CodeInvalidPatch, err.Error())
+	}
+	return out, nil
+}
@@ -8,24 +8,35 @@ import (
 
 // CreatePlayer godoc
 // @Summary Create a new player
-// @Description Create a new player in the system
+// @Description Create a new player in the system. A non-zero opening balance also requires the players:balance permission.
 // @Tags players
 // @Accept json
 // @Produce json
-// @Param player body models.Player true "Player data"
-// @Success 201 {object} models.Player
-// @Failure 400 {object} map[string]string
-// @Failure 500 {object} map[string]string
-// @Router /api/players [post]
-func CreatePlayer(repo repository.PlayerRepository) fiber.Handler {
+// @Param player body models.PlayerV1 true "Player data"
+// @Param Idempotency-Key header string false "Key that makes retries of this request safe"
+// @Success 201 {object} models.PlayerV1
+// @Failure 400 {object} problem.Details
+// @Failure 401 {object} problem.Details
+// @Failure 403 {object} problem.Details
+// @Failure 409 {object} problem.Details
+// @Failure 422 {object} problem.Details
+// @Failure 429 {object} problem.Details
+// @Failure 500 {object} problem.Details
+// @Security ApiKeyAuth
+// @Security BearerAuth
+// @Router /api/v1/players [post]
+func CreatePlayer(repo repository.PlayerRepository, authorize Authorize) fiber.Handler {
 	return func(c *fiber.Ctx) error {
 		var player models.Player
-		if err := c.BodyParser(&player); err != nil {
-			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+		if err := parseBody(c, &player); err != nil {
+			return err
+		}
+		if err := authorizeOpeningBalances(c, authorize, &player); err != nil {
+			return err
 		}
 		created, err := repo.CreatePlayer(&player)
 		if err != nil {
-			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+			return err
 		}
 		return c.Status(fiber.StatusCreated).JSON(created)
 	}
@@ -36,14 +47,19 @@ func CreatePlayer(repo repository.PlayerRepository) fiber.Handler {
 // @Description Get a list of all players
 // @Tags players
 // @Produce json
-// @Success 200 {array} models.Player
-// @Failure 500 {object} map[string]string
-// @Router /api/players [get]
+// @Success 200 {array} models.PlayerV1
+// @Failure 401 {object} problem.Details
+// @Failure 403 {object} problem.Details
+// @Failure 429 {object} problem.Details
+// @Failure 500 {object} problem.Details
+// @Security ApiKeyAuth
+// @Security BearerAuth
+// @Router /api/v1/players [get]
 func GetPlayers(repo repository.PlayerRepository) fiber.Handler {
 	return func(c *fiber.Ctx) error {
 		players, err := repo.GetPlayers()
 		if err != nil {
-			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+			return err
 		}
 		return c.JSON(players)
 	}
@@ -55,15 +71,22 @@ func GetPlayers(repo repository.PlayerRepository) fiber.Handler {
 // @Tags players
 // @Produce json
 // @Param id path string true "Player ID"
-// @Success 200 {object} models.Player
-// @Failure 404 {object} map[string]string
-// @Router /api/players/{id} [get]
+// @Success 200 {object} models.PlayerV1
+// @Failure 400 {object} problem.Details
+// @Failure 401 {object} problem.Details
+// @Failure 403 {object} problem.Details
+// @Failure 404 {object} problem.Details
+// @Failure 429 {object} problem.Details
+// @Failure 500 {object} problem.Details
+// @Security ApiKeyAuth
+// @Security BearerAuth
+// @Router /api/v1/players/{id} [get]
 func GetPlayer(repo repository.PlayerRepository) fiber.Handler {
 	return func(c *fiber.Ctx) error {
 		id := c.Params("id")
 		player, err := repo.GetPlayer(id)
 		if err != nil {
-			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": err.Error()})
+			return err
 		}
 		return c.JSON(player)
 	}
@@ -71,26 +94,33 @@ func GetPlayer(repo repository.PlayerRepository) fiber.Handler {
 
 // UpdatePlayer godoc
 // @Summary Update a player
-// @Description Update a player's information
+// @Description Update a player's name and surname. The balance and status sent are ignored; they change through the balance, transfer and status endpoints.
 // @Tags players
 // @Accept json
 // @Produce json
 // @Param id path string true "Player ID"
-// @Param player body models.Player true "Player data"
-// @Success 200 {object} models.Player
-// @Failure 400 {object} map[string]string
-// @Failure 500 {object} map[string]string
-// @Router /api/players/{id} [put]
+// @Param player body models.PlayerV1 true "Player data"
+// @Success 200 {object} models.PlayerV1
+// @Failure 400 {object} problem.Details
+// @Failure 401 {object} problem.Details
+// @Failure 403 {object} problem.Details
+// @Failure 404 {object} problem.Details
+// @Failure 422 {object} problem.Details
+// @Failure 429 {object} problem.Details
+// @Failure 500 {object} problem.Details
+// @Security ApiKeyAuth
+// @Security BearerAuth
+// @Router /api/v1/players/{id} [put]
 func UpdatePlayer(repo repository.PlayerRepository) fiber.Handler {
 	return func(c *fiber.Ctx) error {
 		id := c.Params("id")
 		var input models.Player
-		if err := c.BodyParser(&input); err != nil {
-			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+		if err := parseBody(c, &input, models.PlayerUpdateFields...); err != nil {
+			return err
 		}
 		updated, err := repo.UpdatePlayer(id, &input)
 		if err != nil {
-			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+			return err
 		}
 		return c.JSON(updated)
 	}
@@ -102,15 +132,59 @@ func UpdatePlayer(repo repository.PlayerRepository) fiber.Handler {
 // @Tags players
 // @Param id path string true "Player ID"
 // @Success 204 {string} string "No Content"
-// @Failure 500 {object} map[string]string
-// @Router /api/players/{id} [delete]
+// @Failure 400 {object} problem.Details
+// @Failure 401 {object} problem.Details
+// @Failure 403 {object} problem.Details
+// @Failure 404 {object} problem.Details
+// @Failure 429 {object} problem.Details
+// @Failure 500 {object} problem.Details
+// @Security ApiKeyAuth
+// @Security BearerAuth
+// @Router /api/v1/players/{id} [delete]
+// @Router /api/v2/players/{id} [delete]
 func DeletePlayer(repo repository.PlayerRepository) fiber.Handler {
 	return func(c *fiber.Ctx) error {
 		id := c.Params("id")
 		err := repo.DeletePlayer(id)
 		if err != nil {
-			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+			return err
 		}
 		return c.SendStatus(fiber.StatusNoContent)
 	}
 }
+
+// AdjustBalance godoc
+// @Summary Credit or debit a player's balance
+// @Description Atomically adds amount to the balance; use a negative amount to debit. Debits that would leave the balance below zero are rejected with insufficient_funds. Credits count towards the player's deposit limits and debits towards their loss limits; changes beyond a limit are rejected with limit_exceeded.
+// @Tags players
+// @Accept json
+// @Produce json
+// @Param id path string true "Player ID"
+// @Param change body models.BalanceChange true "Balance change"
+// @Param Idempotency-Key header string false "Key that makes retries of this request safe"
+// @Success 200 {object} models.PlayerV1
+// @Failure 400 {object} problem.Details
+// @Failure 401 {object} problem.Details
+// @Failure 403 {object} problem.Details
+// @Failure 404 {object} problem.Details
+// @Failure 409 {object} problem.Details
+// @Failure 422 {object} problem.Details
+// @Failure 429 {object} problem.Details
+// @Failure 500 {object} problem.Details
+// @Security ApiKeyAuth
+// @Security BearerAuth
+// @Router /api/v1/players/{id}/balance [post]
+func AdjustBalance(repo repository.PlayerRepository) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		id := c.Params("id")
+		var change models.BalanceChange
+		if err := parseBody(c, &change); err != nil {
+			return err
+		}
+		updated, err := repo.AdjustBalance(id, change.Money())
+		if err != nil {
+			return err
+		}
+		return c.JSON(updated)
+	}
+}
@@ -0,0 +1,204 @@
+package controllers
+
+import (
+	"contoso/models"
+	"contoso/repository"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+var v2Players = representation{
+	encode: func(p *models.Player) interface{} { return models.NewPlayerV2(p) },
+	decode: func(data []byte) (*models.Player, error) {
+		var v models.PlayerV2
+		if err := parseJSON(data, &v, models.PlayerV2UpdateFields...); err != nil {
+			return nil, err
+		}
+		return v.Player(), nil
+	},
+}
+
+// GetPlayersV2 godoc
+// @Summary Get all players
+// @Description Get a list of all players
+// @Tags players-v2
+// @Produce json
+// @Success 200 {array} models.PlayerV2
+// @Failure 401 {object} problem.Details
+// @Failure 403 {object} problem.Details
+// @Failure 429 {object} problem.Details
+// @Failure 500 {object} problem.Details
+// @Security ApiKeyAuth
+// @Security BearerAuth
+// @Router /api/v2/players [get]
+func GetPlayersV2(repo repository.PlayerRepository) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		players, err := repo.GetPlayers()
+		if err != nil {
+			return err
+		}
+		out := make([]*models.PlayerV2, len(players))
+		for i := range players {
+			out[i] = models.NewPlayerV2(&players[i])
+		}
+		return c.JSON(out)
+	}
+}
+
+// GetPlayerV2 godoc
+// @Summary Get a player by ID
+// @Description Get details of a player by ID
+// @Tags players-v2
+// @Produce json
+// @Param id path string true "Player ID"
+// @Success 200 {object} models.PlayerV2
+// @Failure 400 {object} problem.Details
+// @Failure 401 {object} problem.Details
+// @Failure 403 {object} problem.Details
+// @Failure 404 {object} problem.Details
+// @Failure 429 {object} problem.Details
+// @Failure 500 {object} problem.Details
+// @Security ApiKeyAuth
+// @Security BearerAuth
+// @Router /api/v2/players/{id} [get]
+func GetPlayerV2(repo repository.PlayerRepository) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		player, err := repo.GetPlayer(c.Params("id"))
+		if err != nil {
+			return err
+		}
+		return c.JSON(models.NewPlayerV2(player))
+	}
+}
+
+// CreatePlayerV2 godoc
+// @Summary Create a new player
+// @Description Create a new player in the system. A non-zero opening balance also requires the players:balance permission.
+// @Tags players-v2
+// @Accept json
+// @Produce json
+// @Param player body models.PlayerV2 true "Player data"
+// @Param Idempotency-Key header string false "Key that makes retries of this request safe"
+// @Success 201 {object} models.PlayerV2
+// @Failure 400 {object} problem.Details
+// @Failure 401 {object} problem.Details
+// @Failure 403 {object} problem.Details
+// @Failure 409 {object} problem.Details
+// @Failure 422 {object} problem.Details
+// @Failure 429 {object} problem.Details
+// @Failure 500 {object} problem.Details
+// @Security ApiKeyAuth
+// @Security BearerAuth
+// @Router /api/v2/players [post]
+func CreatePlayerV2(repo repository.PlayerRepository, authorize Authorize) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		var input models.PlayerV2
+		if err := parseBody(c, &input); err != nil {
+			return err
+		}
+		player := input.Player()
+		if err := authorizeOpeningBalances(c, authorize, player); err != nil {
+			return err
+		}
+		created, err := repo.CreatePlayer(player)
+		if err != nil {
+			return err
+		}
+		return c.Status(fiber.StatusCreated).JSON(models.NewPlayerV2(created))
+	}
+}
+
+// UpdatePlayerV2 godoc
+// @Summary Update a player
+// @Description Update a player's name and surname. The balance and status sent are ignored; they change through the balance, transfer and status endpoints.
+// @Tags players-v2
+// @Accept json
+// @Produce json
+// @Param id path string true "Player ID"
+// @Param player body models.PlayerV2 true "Player data"
+// @Success 200 {object} models.PlayerV2
+// @Failure 400 {object} problem.Details
+// @Failure 401 {object} problem.Details
+// @Failure 403 {object} problem.Details
+// @Failure 404 {object} problem.Details
+// @Failure 422 {object} problem.Details
+// @Failure 429 {object} problem.Details
+// @Failure 500 {object} problem.Details
+// @Security ApiKeyAuth
+// @Security BearerAuth
+// @Router /api/v2/players/{id} [put]
+func UpdatePlayerV2(repo repository.PlayerRepository) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		var input models.PlayerV2
+		if err := parseBody(c, &input, models.PlayerV2UpdateFields...); err != nil {
+			return err
+		}
+		updated, err := repo.UpdatePlayer(c.Params("id"), input.Player())
+		if err != nil {
+			return err
+		}
+		return c.JSON(models.NewPlayerV2(updated))
+	}
+}
+
+// PatchPlayerV2 godoc
+// @Summary Partially update a player
+// @Description Applies a JSON Merge Patch (RFC 7396) or JSON Patch (RFC 6902) to the v2 representation of a player.
+// @Description A patch that changes the balance or status returns 422.
+// @Description A JSON Patch "test" operation that fails returns 409 patch_test_failed, and a player changed by another request while the patch was applied returns 409 player_changed.
+// @Tags players-v2
+// @Accept application/merge-patch+json,application/json-patch+json
+// @Produce json
+// @Param id path string true "Player ID"
+// @Param patch body object true "Merge patch object or JSON Patch operation array"
+// @Success 200 {object} models.PlayerV2
+// @Failure 400 {object} problem.Details
+// @Failure 401 {object} problem.Details
+// @Failure 403 {object} problem.Details
+// @Failure 404 {object} problem.Details
+// @Failure 409 {object} problem.Details
+// @Failure 415 {object} problem.Details
+// @Failure 422 {object} problem.Details
+// @Failure 429 {object} problem.Details
+// @Failure 500 {object} problem.Details
+// @Security ApiKeyAuth
+// @Security BearerAuth
+// @Router /api/v2/players/{id} [patch]
+func PatchPlayerV2(repo repository.PlayerRepository) fiber.Handler {
+	return patchPlayer(repo, v2Players)
+}
+
+// AdjustBalanceV2 godoc
+// @Summary Credit or debit a player's balance
+// @Description Atomically adds amount to the balance; use a negative amount to debit. Debits that would leave the balance below zero are rejected with insufficient_funds. Credits count towards the player's deposit limits and debits towards their loss limits; changes beyond a limit are rejected with limit_exceeded.
+// @Tags players-v2
+// @Accept json
+// @Produce json
+// @Param id path string true "Player ID"
+// @Param change body models.BalanceChange true "Balance change"
+// @Param Idempotency-Key header string false "Key that makes retries of this request safe"
+// @Success 200 {object} models.PlayerV2
+// @Failure 400 {object} problem.Details
+// @Failure 401 {object} problem.Details
+// @Failure 403 {object} problem.Details
+// @Failure 404 {object} problem.Details
+// @Failure 409 {object} problem.Details
+// @Failure 422 {object} problem.Details
+// @Failure 429 {object} problem.Details
+// @Failure 500 {object} problem.Details
+// @Security ApiKeyAuth
+// @Security BearerAuth
+// @Router /api/v2/players/{id}/balance [post]
+func AdjustBalanceV2(repo repository.PlayerRepository) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		var change models.BalanceChange
+		if err := parseBody(c, &change); err != nil {
+			return err
+		}
+		player, err := repo.AdjustBalance(c.Params("id"), change.Money())
+		if err != nil {
+			return err
+		}
+		return c.JSON(models.NewPlayerV2(player))
+	}
+}
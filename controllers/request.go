@@ -0,0 +1,50 @@
+package controllers
+
+import (
+	"contoso/money"
+	"contoso/problem"
+	"contoso/validation"
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// parseBody strictly decodes the JSON body into dst and validates it, or
+// only the named fields when there are any. The returned error is a problem
+// (400) or a *validation.Error (422) for the app's error handler to render.
+func parseBody(c *fiber.Ctx, dst interface{}, fields ...string) error {
+	return parseJSON(c.Body(), dst, fields...)
+}
+
+// parseJSON is parseBody for JSON that did not come straight from the body.
+func parseJSON(data []byte, dst interface{}, fields ...string) error {
+	err := validation.DecodeJSON(data, dst)
+	if err == nil {
+		if len(fields) > 0 {
+			err = validation.Partial(dst, fields...)
+		} else {
+			err = validation.Struct(dst)
+		}
+	}
+	if err != nil {
+		var verr *validation.Error
+		if errors.As(err, &verr) {
+			return verr
+		}
+		return problem.New(fiber.StatusBadRequest, problem.CodeMalformedBody, err.Error())
+	}
+	return nil
+}
+
+// currencyQuery reads the required currency query parameter of endpoints
+// that compare or add up balances, which is only meaningful per currency.
+func currencyQuery(c *fiber.Ctx) (string, error) {
+	currency := c.Query("currency")
+	if currency == "" {
+		return "", problem.New(fiber.StatusBadRequest, problem.CodeInvalidQuery, "currency is required")
+	}
+	if _, ok := money.Places(currency); !ok {
+		return "", problem.New(fiber.StatusBadRequest, problem.CodeInvalidQuery, "currency must be a supported ISO 4217 code")
+	}
+	return currency, nil
+}
@@ -0,0 +1,63 @@
+package controllers
+
+import (
+	"contoso/problem"
+	"contoso/search"
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// Search paging limits.
+const (
+	defaultSearchSize = 20
+	maxSearchSize     = 100
+	maxSearchLength   = 200
+)
+
+// SearchPlayers godoc
+// @Summary Search players by name
+// @Description Full-text search over names and surnames. Terms match as prefixes and tolerate typos; matches are highlighted with <em>.
+// @Tags players
+// @Produce json
+// @Param q query string true "Search text"
+// @Param page query int false "Page, from 1" default(1)
+// @Param size query int false "Hits per page, at most 100" default(20)
+// @Success 200 {object} search.Result
+// @Failure 400 {object} problem.Details
+// @Failure 401 {object} problem.Details
+// @Failure 403 {object} problem.Details
+// @Failure 429 {object} problem.Details
+// @Failure 500 {object} problem.Details
+// @Failure 503 {object} problem.Details
+// @Security ApiKeyAuth
+// @Security BearerAuth
+// @Router /api/v1/players/search [get]
+func SearchPlayers(index *search.Index) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		if index == nil {
+			return problem.New(fiber.StatusServiceUnavailable, problem.CodeServiceUnavailable, "search is not configured")
+		}
+		q := search.Query{
+			Text: c.Query("q"),
+			Page: c.QueryInt("page", 1),
+			Size: c.QueryInt("size", defaultSearchSize),
+		}
+		switch {
+		case q.Text == "" || len(q.Text) > maxSearchLength:
+			return problem.New(fiber.StatusBadRequest, problem.CodeInvalidQuery, "q must be 1 to 200 characters")
+		case q.Page < 1:
+			return problem.New(fiber.StatusBadRequest, problem.CodeInvalidQuery, "page must be at least 1")
+		case q.Size < 1 || q.Size > maxSearchSize:
+			return problem.New(fiber.StatusBadRequest, problem.CodeInvalidQuery, "size must be between 1 and 100")
+		}
+		result, err := index.Search(q)
+		if errors.Is(err, search.ErrWindowTooLarge) {
+			return problem.New(fiber.StatusBadRequest, problem.CodeInvalidQuery, err.Error())
+		}
+		if err != nil {
+			return err
+		}
+		return c.JSON(result)
+	}
+}
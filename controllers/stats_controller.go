@@ -0,0 +1,157 @@
+package controllers
+
+import (
+	"contoso/models"
+	"contoso/money"
+	"contoso/problem"
+	"contoso/repository"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// Statistics query limits.
+const (
+	maxStatsBounds       = 20
+	defaultStatsGroups   = 20
+	maxStatsGroups       = 100
+	maxStatsCacheEntries = 100
+)
+
+// defaultStatsBounds delimit the balance buckets when none are requested.
+var defaultStatsBounds = []money.Amount{money.New(100, 0), money.New(1000, 0), money.New(10000, 0), money.New(100000, 0)}
+
+// GetPlayerStats godoc
+// @Summary Player statistics
+// @Description Player count, total, average, minimum and maximum balance, and the number of players per balance bucket, aggregated in the database over the players with balances in the given currency.
+// @Description With groupBy, the same figures per name or surname, largest groups first.
+// @Description Results are cached briefly; generatedAt says when they were computed.
+// @Tags players
+// @Produce json
+// @Param currency query string true "Currency of the balances to aggregate" example(EUR)
+// @Param buckets query string false "Ascending bucket boundaries, comma-separated, at most 20" default(100,1000,10000,100000)
+// @Param groupBy query string false "Also aggregate per value of this field" Enums(name, surname)
+// @Param groups query int false "At most this many groups, up to 100" default(20)
+// @Success 200 {object} models.PlayerStats
+// @Failure 400 {object} problem.Details
+// @Failure 401 {object} problem.Details
+// @Failure 403 {object} problem.Details
+// @Failure 429 {object} problem.Details
+// @Failure 500 {object} problem.Details
+// @Security ApiKeyAuth
+// @Security BearerAuth
+// @Router /api/v1/players/stats [get]
+func GetPlayerStats(repo repository.PlayerRepository, ttl time.Duration) fiber.Handler {
+	cache := &statsCache{ttl: ttl, entries: make(map[string]statsCacheEntry)}
+	return func(c *fiber.Ctx) error {
+		query, err := statsQuery(c)
+		if err != nil {
+			return err
+		}
+		stats, err := cache.get(query, repo.Stats)
+		if err != nil {
+			return err
+		}
+		return c.JSON(stats)
+	}
+}
+
+// statsQuery reads the currency, buckets, groupBy and groups query
+// parameters.
+func statsQuery(c *fiber.Ctx) (models.PlayerStatsQuery, error) {
+	currency, err := currencyQuery(c)
+	if err != nil {
+		return models.PlayerStatsQuery{}, err
+	}
+	query := models.PlayerStatsQuery{
+		Currency:   currency,
+		Bounds:     defaultStatsBounds,
+		GroupBy:    c.Query("groupBy"),
+		GroupLimit: c.QueryInt("groups", defaultStatsGroups),
+	}
+	if raw := c.Query("buckets"); raw != "" {
+		parts := strings.Split(raw, ",")
+		if len(parts) > maxStatsBounds {
+			return query, problem.New(fiber.StatusBadRequest, problem.CodeInvalidQuery,
+				fmt.Sprintf("at most %d bucket boundaries are allowed", maxStatsBounds))
+		}
+		query.Bounds = make([]money.Amount, len(parts))
+		for i, part := range parts {
+			bound, err := money.Parse(strings.TrimSpace(part))
+			if err != nil {
+				return query, problem.New(fiber.StatusBadRequest, problem.CodeInvalidQuery,
+					"buckets must be comma-separated numbers")
+			}
+			query.Bounds[i] = bound
+		}
+		if !strictlyAscending(query.Bounds) {
+			return query, problem.New(fiber.StatusBadRequest, problem.CodeInvalidQuery,
+				"buckets must be strictly ascending")
+		}
+	}
+	if _, ok := models.PlayerGroupFields[query.GroupBy]; query.GroupBy != "" && !ok {
+		return query, problem.New(fiber.StatusBadRequest, problem.CodeInvalidQuery, "groupBy must be name or surname")
+	}
+	if query.GroupLimit < 1 || query.GroupLimit > maxStatsGroups {
+		return query, problem.New(fiber.StatusBadRequest, problem.CodeInvalidQuery,
+			fmt.Sprintf("groups must be between 1 and %d", maxStatsGroups))
+	}
+	return query, nil
+}
+
+func strictlyAscending(values []money.Amount) bool {
+	for i := 1; i < len(values); i++ {
+		if values[i].Cmp(values[i-1]) <= 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// statsCache keeps computed statistics for ttl per query, so dashboards
+// polling the endpoint do not each run the aggregation.
+type statsCache struct {
+	ttl     time.Duration
+	mu      sync.Mutex
+	entries map[string]statsCacheEntry
+}
+
+type statsCacheEntry struct {
+	stats   *models.PlayerStats
+	expires time.Time
+}
+
+func (c *statsCache) get(query models.PlayerStatsQuery, compute func(models.PlayerStatsQuery) (*models.PlayerStats, error)) (*models.PlayerStats, error) {
+	if c.ttl <= 0 {
+		return compute(query)
+	}
+	key := fmt.Sprint(query.Currency, query.Bounds, query.GroupBy, query.GroupLimit)
+	now := time.Now()
+	c.mu.Lock()
+	entry, ok := c.entries[key]
+	c.mu.Unlock()
+	if ok && now.Before(entry.expires) {
+		return entry.stats, nil
+	}
+	stats, err := compute(query)
+	if err != nil {
+		return nil, err
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if len(c.entries) >= maxStatsCacheEntries {
+		for k, e := range c.entries {
+			if !now.Before(e.expires) {
+				delete(c.entries, k)
+			}
+		}
+		if len(c.entries) >= maxStatsCacheEntries {
+			return stats, nil
+		}
+	}
+	c.entries[key] = statsCacheEntry{stats: stats, expires: now.Add(c.ttl)}
+	return stats, nil
+}
@@ -0,0 +1,42 @@
+package controllers
+
+import (
+	"contoso/models"
+	"contoso/repository"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// SetPlayerStatus godoc
+// @Summary Change a player's account status
+// @Description Moves the player to another status, recording the reason in the player.status_changed event. Allowed transitions: pending to active or closed; active to suspended, self_excluded or closed; suspended and self_excluded to active or closed. Closed accounts stay closed. Suspended and closed players' balances cannot change.
+// @Tags players
+// @Accept json
+// @Produce json
+// @Param id path string true "Player ID"
+// @Param change body models.StatusChange true "New status and reason"
+// @Success 200 {object} models.PlayerV1
+// @Failure 400 {object} problem.Details
+// @Failure 401 {object} problem.Details
+// @Failure 403 {object} problem.Details
+// @Failure 404 {object} problem.Details
+// @Failure 409 {object} problem.Details
+// @Failure 422 {object} problem.Details
+// @Failure 429 {object} problem.Details
+// @Failure 500 {object} problem.Details
+// @Security ApiKeyAuth
+// @Security BearerAuth
+// @Router /api/v1/players/{id}/status [post]
+func SetPlayerStatus(repo repository.PlayerRepository) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		var change models.StatusChange
+		if err := parseBody(c, &change); err != nil {
+			return err
+		}
+		updated, err := repo.SetStatus(c.Params("id"), change)
+		if err != nil {
+			return err
+		}
+		return c.JSON(updated)
+	}
+}
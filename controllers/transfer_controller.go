@@ -0,0 +1,91 @@
+package controllers
+
+import (
+	"contoso/models"
+	"contoso/problem"
+	"contoso/repository"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// Transfer history paging limits. maxTransferPage keeps the offset far
+// from overflowing.
+const (
+	defaultTransferPageSize = 20
+	maxTransferPageSize     = 100
+	maxTransferPage         = 100000
+)
+
+// CreateTransfer godoc
+// @Summary Transfer between players
+// @Description Atomically debits one player's balance and credits another's by amount, which must be in both players' currency. The sender's balance must not go below zero. Both legs are recorded in the players' transfer histories. The debit counts towards the sender's loss limits and the credit towards the recipient's deposit limits; transfers beyond a limit are rejected with limit_exceeded.
+// @Description The client chooses the transfer id. Repeating a transfer returns it again with 200 instead of moving the money twice; reusing its id for a different transfer returns 409 transfer_id_reused.
+// @Tags transfers
+// @Accept json
+// @Produce json
+// @Param transfer body models.TransferInput true "Transfer"
+// @Param Idempotency-Key header string false "Key that makes retries of this request safe"
+// @Success 200 {object} models.Transfer "Transfer made earlier with this id"
+// @Success 201 {object} models.Transfer
+// @Failure 400 {object} problem.Details
+// @Failure 401 {object} problem.Details
+// @Failure 403 {object} problem.Details
+// @Failure 404 {object} problem.Details
+// @Failure 409 {object} problem.Details
+// @Failure 422 {object} problem.Details
+// @Failure 429 {object} problem.Details
+// @Failure 500 {object} problem.Details
+// @Security ApiKeyAuth
+// @Security BearerAuth
+// @Router /api/v1/transfers [post]
+func CreateTransfer(repo repository.TransferRepository) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		var input models.TransferInput
+		if err := parseBody(c, &input); err != nil {
+			return err
+		}
+		transfer, created, err := repo.Transfer(input.ID, input.FromPlayerID, input.ToPlayerID, input.Money())
+		if err != nil {
+			return err
+		}
+		if !created {
+			return c.JSON(transfer)
+		}
+		return c.Status(fiber.StatusCreated).JSON(transfer)
+	}
+}
+
+// ListPlayerTransfers godoc
+// @Summary List a player's transfers
+// @Description The player's side of every transfer they sent or received, newest first: negative amounts were sent, positive ones received. The history is kept after players are deleted.
+// @Tags transfers
+// @Produce json
+// @Param id path string true "Player ID"
+// @Param page query int false "Page, from 1 to 100000" default(1)
+// @Param size query int false "Transfers per page, at most 100" default(20)
+// @Success 200 {object} models.TransferHistory
+// @Failure 400 {object} problem.Details
+// @Failure 401 {object} problem.Details
+// @Failure 403 {object} problem.Details
+// @Failure 429 {object} problem.Details
+// @Failure 500 {object} problem.Details
+// @Security ApiKeyAuth
+// @Security BearerAuth
+// @Router /api/v1/players/{id}/transfers [get]
+func ListPlayerTransfers(repo repository.TransferRepository) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		page := c.QueryInt("page", 1)
+		size := c.QueryInt("size", defaultTransferPageSize)
+		switch {
+		case page < 1 || page > maxTransferPage:
+			return problem.New(fiber.StatusBadRequest, problem.CodeInvalidQuery, "page must be between 1 and 100000")
+		case size < 1 || size > maxTransferPageSize:
+			return problem.New(fiber.StatusBadRequest, problem.CodeInvalidQuery, "size must be between 1 and 100")
+		}
+		legs, err := repo.ListTransfers(c.Params("id"), (page-1)*size, size)
+		if err != nil {
+			return err
+		}
+		return c.JSON(models.TransferHistory{Entries: legs, Page: page, Size: size})
+	}
+}
@@ -0,0 +1,133 @@
+package controllers
+
+import (
+	"contoso/models"
+	"contoso/money"
+	"contoso/problem"
+	"contoso/repository"
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// ListWallets godoc
+// @Summary List a player's wallets
+// @Description The player's balance in every currency they hold: the primary wallet, which is the player's balance, first and then the others by currency.
+// @Tags wallets
+// @Produce json
+// @Param id path string true "Player ID"
+// @Success 200 {array} models.Wallet
+// @Failure 400 {object} problem.Details
+// @Failure 401 {object} problem.Details
+// @Failure 403 {object} problem.Details
+// @Failure 404 {object} problem.Details
+// @Failure 429 {object} problem.Details
+// @Failure 500 {object} problem.Details
+// @Security ApiKeyAuth
+// @Security BearerAuth
+// @Router /api/v1/players/{id}/wallets [get]
+func ListWallets(repo repository.WalletRepository) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		wallets, err := repo.ListWallets(c.Params("id"))
+		if err != nil {
+			return err
+		}
+		return c.JSON(wallets)
+	}
+}
+
+// OpenWallet godoc
+// @Summary Open a wallet
+// @Description Opens an empty wallet in a currency the player does not hold yet.
+// @Tags wallets
+// @Accept json
+// @Produce json
+// @Param id path string true "Player ID"
+// @Param wallet body models.OpenWalletInput true "Wallet currency"
+// @Param Idempotency-Key header string false "Key that makes retries of this request safe"
+// @Success 201 {object} models.Wallet
+// @Failure 400 {object} problem.Details
+// @Failure 401 {object} problem.Details
+// @Failure 403 {object} problem.Details
+// @Failure 404 {object} problem.Details
+// @Failure 409 {object} problem.Details
+// @Failure 422 {object} problem.Details
+// @Failure 429 {object} problem.Details
+// @Failure 500 {object} problem.Details
+// @Security ApiKeyAuth
+// @Security BearerAuth
+// @Router /api/v1/players/{id}/wallets [post]
+func OpenWallet(repo repository.WalletRepository) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		var input models.OpenWalletInput
+		if err := parseBody(c, &input); err != nil {
+			return err
+		}
+		wallet, err := repo.OpenWallet(c.Params("id"), input.Currency)
+		if err != nil {
+			return err
+		}
+		return c.Status(fiber.StatusCreated).JSON(wallet)
+	}
+}
+
+// ConvertCurrency godoc
+// @Summary Convert between wallets
+// @Description Moves amount out of the from wallet and its value at the configured exchange rate, rounded down to the to currency's decimal places, into the to wallet. The from wallet must not go below zero.
+// @Tags wallets
+// @Accept json
+// @Produce json
+// @Param id path string true "Player ID"
+// @Param conversion body models.ConversionInput true "Conversion"
+// @Param Idempotency-Key header string false "Key that makes retries of this request safe"
+// @Success 200 {object} models.Conversion
+// @Failure 400 {object} problem.Details
+// @Failure 401 {object} problem.Details
+// @Failure 403 {object} problem.Details
+// @Failure 404 {object} problem.Details
+// @Failure 409 {object} problem.Details
+// @Failure 422 {object} problem.Details
+// @Failure 429 {object} problem.Details
+// @Failure 500 {object} problem.Details
+// @Failure 503 {object} problem.Details
+// @Security ApiKeyAuth
+// @Security BearerAuth
+// @Router /api/v1/players/{id}/wallets/convert [post]
+func ConvertCurrency(repo repository.WalletRepository, rates *money.Rates) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		if rates == nil {
+			return problem.New(fiber.StatusServiceUnavailable, problem.CodeServiceUnavailable, "exchange rates are not configured")
+		}
+		var input models.ConversionInput
+		if err := parseBody(c, &input); err != nil {
+			return err
+		}
+		debit := money.Money{Amount: input.Amount, Currency: input.From}
+		rate, err := rates.Rate(input.From, input.To)
+		if err != nil {
+			return rateError(err)
+		}
+		credit, err := rates.Convert(debit, input.To)
+		if err != nil {
+			return rateError(err)
+		}
+		if credit.Amount.IsZero() {
+			return problem.New(fiber.StatusUnprocessableEntity, problem.CodeConversionTooSmall,
+				"amount is worth less than the smallest unit of "+input.To)
+		}
+		wallets, err := repo.Convert(c.Params("id"), debit, credit)
+		if err != nil {
+			return err
+		}
+		return c.JSON(models.Conversion{Debited: debit, Credited: credit, Rate: rate, Wallets: wallets})
+	}
+}
+
+// rateError reports a currency missing from the rate table as unprocessable
+// rather than as an internal error.
+func rateError(err error) error {
+	if errors.Is(err, money.ErrNoRate) {
+		return problem.New(fiber.StatusUnprocessableEntity, problem.CodeRateUnavailable, err.Error())
+	}
+	return err
+}
@@ -0,0 +1,347 @@
+package controllers
+
+import (
+	"contoso/models"
+	"contoso/problem"
+	"contoso/repository"
+	"contoso/webhooks"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// Delivery log paging.
+const (
+	defaultDeliveryLimit = 50
+	maxDeliveryLimit     = 500
+)
+
+// CreatedWebhook is a newly created webhook with its signing secret, which
+// is not shown again.
+type CreatedWebhook struct {
+	models.Webhook
+	Secret string `json:"secret"`
+}
+
+// TestDelivery is the response of POST /api/webhooks/{id}/test.
+type TestDelivery struct {
+	EventID string `json:"eventId"`
+}
+
+// ListWebhooks godoc
+// @Summary List webhooks
+// @Tags webhooks
+// @Produce json
+// @Success 200 {array} models.Webhook
+// @Failure 401 {object} problem.Details
+// @Failure 403 {object} problem.Details
+// @Failure 429 {object} problem.Details
+// @Failure 500 {object} problem.Details
+// @Security ApiKeyAuth
+// @Security BearerAuth
+// @Router /api/v1/webhooks [get]
+func ListWebhooks(repo repository.WebhookRepository) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		list, err := repo.ListWebhooks()
+		if err != nil {
+			return err
+		}
+		return c.JSON(list)
+	}
+}
+
+// CreateWebhook godoc
+// @Summary Create a webhook
+// @Description Subscribes url to player events; no events means all of them. The response carries the secret that signs every delivery; it is not shown again.
+// @Tags webhooks
+// @Accept json
+// @Produce json
+// @Param webhook body models.WebhookInput true "Webhook"
+// @Success 201 {object} CreatedWebhook
+// @Failure 400 {object} problem.Details
+// @Failure 401 {object} problem.Details
+// @Failure 403 {object} problem.Details
+// @Failure 422 {object} problem.Details
+// @Failure 429 {object} problem.Details
+// @Failure 500 {object} problem.Details
+// @Security ApiKeyAuth
+// @Security BearerAuth
+// @Router /api/v1/webhooks [post]
+func CreateWebhook(repo repository.WebhookRepository, dispatcher *webhooks.Dispatcher) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		var input models.WebhookInput
+		if err := parseBody(c, &input); err != nil {
+			return err
+		}
+		secret, err := webhooks.NewSecret()
+		if err != nil {
+			return err
+		}
+		webhook := webhookFromInput(&input)
+		webhook.Secret = secret
+		webhook.CreatedAt = time.Now().UTC()
+		created, err := repo.CreateWebhook(webhook)
+		if err != nil {
+			return err
+		}
+		dispatcher.Invalidate()
+		return c.Status(fiber.StatusCreated).JSON(CreatedWebhook{Webhook: *created, Secret: secret})
+	}
+}
+
+// GetWebhook godoc
+// @Summary Get a webhook
+// @Tags webhooks
+// @Produce json
+// @Param id path string true "Webhook ID"
+// @Success 200 {object} models.Webhook
+// @Failure 400 {object} problem.Details
+// @Failure 401 {object} problem.Details
+// @Failure 403 {object} problem.Details
+// @Failure 404 {object} problem.Details
+// @Failure 429 {object} problem.Details
+// @Failure 500 {object} problem.Details
+// @Security ApiKeyAuth
+// @Security BearerAuth
+// @Router /api/v1/webhooks/{id} [get]
+func GetWebhook(repo repository.WebhookRepository) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		webhook, err := repo.GetWebhook(c.Params("id"))
+		if err != nil {
+			return err
+		}
+		return c.JSON(webhook)
+	}
+}
+
+// UpdateWebhook godoc
+// @Summary Update a webhook
+// @Description Replaces the URL, events, description and active flag. Deliveries already queued for a disabled webhook are moved to the dead letters.
+// @Tags webhooks
+// @Accept json
+// @Produce json
+// @Param id path string true "Webhook ID"
+// @Param webhook body models.WebhookInput true "Webhook"
+// @Success 200 {object} models.Webhook
+// @Failure 400 {object} problem.Details
+// @Failure 401 {object} problem.Details
+// @Failure 403 {object} problem.Details
+// @Failure 404 {object} problem.Details
+// @Failure 422 {object} problem.Details
+// @Failure 429 {object} problem.Details
+// @Failure 500 {object} problem.Details
+// @Security ApiKeyAuth
+// @Security BearerAuth
+// @Router /api/v1/webhooks/{id} [put]
+func UpdateWebhook(repo repository.WebhookRepository, dispatcher *webhooks.Dispatcher) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		var input models.WebhookInput
+		if err := parseBody(c, &input); err != nil {
+			return err
+		}
+		updated, err := repo.UpdateWebhook(c.Params("id"), webhookFromInput(&input))
+		if err != nil {
+			return err
+		}
+		dispatcher.Invalidate()
+		return c.JSON(updated)
+	}
+}
+
+// DeleteWebhook godoc
+// @Summary Delete a webhook
+// @Description Deletes the webhook together with its queued deliveries and delivery log.
+// @Tags webhooks
+// @Param id path string true "Webhook ID"
+// @Success 204 {string} string "No Content"
+// @Failure 400 {object} problem.Details
+// @Failure 401 {object} problem.Details
+// @Failure 403 {object} problem.Details
+// @Failure 404 {object} problem.Details
+// @Failure 429 {object} problem.Details
+// @Failure 500 {object} problem.Details
+// @Security ApiKeyAuth
+// @Security BearerAuth
+// @Router /api/v1/webhooks/{id} [delete]
+func DeleteWebhook(repo repository.WebhookRepository, dispatcher *webhooks.Dispatcher) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		if err := repo.DeleteWebhook(c.Params("id")); err != nil {
+			return err
+		}
+		dispatcher.Invalidate()
+		return c.SendStatus(fiber.StatusNoContent)
+	}
+}
+
+// TestWebhook godoc
+// @Summary Send a test event
+// @Description Queues a webhook.test event for this webhook, whatever its events, to check the receiver and its signature verification.
+// @Tags webhooks
+// @Produce json
+// @Param id path string true "Webhook ID"
+// @Success 202 {object} TestDelivery
+// @Failure 400 {object} problem.Details
+// @Failure 401 {object} problem.Details
+// @Failure 403 {object} problem.Details
+// @Failure 404 {object} problem.Details
+// @Failure 429 {object} problem.Details
+// @Failure 500 {object} problem.Details
+// @Security ApiKeyAuth
+// @Security BearerAuth
+// @Router /api/v1/webhooks/{id}/test [post]
+func TestWebhook(repo repository.WebhookRepository, dispatcher *webhooks.Dispatcher) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		webhook, err := repo.GetWebhook(c.Params("id"))
+		if err != nil {
+			return err
+		}
+		e, err := dispatcher.SendTest(webhook)
+		if err != nil {
+			return err
+		}
+		return c.Status(fiber.StatusAccepted).JSON(TestDelivery{EventID: e.ID})
+	}
+}
+
+// ListWebhookDeliveries godoc
+// @Summary List a webhook's deliveries
+// @Description The delivery log of one webhook, newest first, with every attempt. Succeeded deliveries are kept for 7 days.
+// @Tags webhooks
+// @Produce json
+// @Param id path string true "Webhook ID"
+// @Param status query string false "Only deliveries in this state" Enums(pending, succeeded, dead)
+// @Param limit query int false "At most this many, up to 500" default(50)
+// @Success 200 {array} models.WebhookDelivery
+// @Failure 400 {object} problem.Details
+// @Failure 401 {object} problem.Details
+// @Failure 403 {object} problem.Details
+// @Failure 404 {object} problem.Details
+// @Failure 429 {object} problem.Details
+// @Failure 500 {object} problem.Details
+// @Security ApiKeyAuth
+// @Security BearerAuth
+// @Router /api/v1/webhooks/{id}/deliveries [get]
+func ListWebhookDeliveries(repo repository.WebhookRepository) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		limit, err := deliveryLimit(c)
+		if err != nil {
+			return err
+		}
+		status := c.Query("status")
+		switch status {
+		case "", models.DeliveryPending, models.DeliverySucceeded, models.DeliveryDead:
+		default:
+			return problem.New(fiber.StatusBadRequest, problem.CodeInvalidQuery, "status must be pending, succeeded or dead")
+		}
+		webhook, err := repo.GetWebhook(c.Params("id"))
+		if err != nil {
+			return err
+		}
+		deliveries, err := repo.ListDeliveries(webhook.ID, status, limit)
+		if err != nil {
+			return err
+		}
+		return c.JSON(deliveries)
+	}
+}
+
+// ListDeadLetters godoc
+// @Summary List dead-lettered deliveries
+// @Description Deliveries of every webhook that used up their attempts, newest first. Redeliver them once the receiver is fixed.
+// @Tags webhooks
+// @Produce json
+// @Param limit query int false "At most this many, up to 500" default(50)
+// @Success 200 {array} models.WebhookDelivery
+// @Failure 400 {object} problem.Details
+// @Failure 401 {object} problem.Details
+// @Failure 403 {object} problem.Details
+// @Failure 429 {object} problem.Details
+// @Failure 500 {object} problem.Details
+// @Security ApiKeyAuth
+// @Security BearerAuth
+// @Router /api/v1/webhooks/dead-letters [get]
+func ListDeadLetters(repo repository.WebhookRepository) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		limit, err := deliveryLimit(c)
+		if err != nil {
+			return err
+		}
+		deliveries, err := repo.ListDeliveries("", models.DeliveryDead, limit)
+		if err != nil {
+			return err
+		}
+		return c.JSON(deliveries)
+	}
+}
+
+// GetWebhookDelivery godoc
+// @Summary Get a delivery
+// @Tags webhooks
+// @Produce json
+// @Param id path string true "Delivery ID"
+// @Success 200 {object} models.WebhookDelivery
+// @Failure 400 {object} problem.Details
+// @Failure 401 {object} problem.Details
+// @Failure 403 {object} problem.Details
+// @Failure 404 {object} problem.Details
+// @Failure 429 {object} problem.Details
+// @Failure 500 {object} problem.Details
+// @Security ApiKeyAuth
+// @Security BearerAuth
+// @Router /api/v1/webhooks/deliveries/{id} [get]
+func GetWebhookDelivery(repo repository.WebhookRepository) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		delivery, err := repo.GetDelivery(c.Params("id"))
+		if err != nil {
+			return err
+		}
+		return c.JSON(delivery)
+	}
+}
+
+// RedeliverWebhookDelivery godoc
+// @Summary Redeliver a delivery
+// @Description Queues the delivery to be sent again right away. A dead delivery gets one more attempt and returns to the dead letters if that fails too.
+// @Tags webhooks
+// @Produce json
+// @Param id path string true "Delivery ID"
+// @Success 202 {object} models.WebhookDelivery
+// @Failure 400 {object} problem.Details
+// @Failure 401 {object} problem.Details
+// @Failure 403 {object} problem.Details
+// @Failure 404 {object} problem.Details
+// @Failure 429 {object} problem.Details
+// @Failure 500 {object} problem.Details
+// @Security ApiKeyAuth
+// @Security BearerAuth
+// @Router /api/v1/webhooks/deliveries/{id}/redeliver [post]
+func RedeliverWebhookDelivery(repo repository.WebhookRepository) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		delivery, err := repo.Redeliver(c.Params("id"))
+		if err != nil {
+			return err
+		}
+		return c.Status(fiber.StatusAccepted).JSON(delivery)
+	}
+}
+
+func webhookFromInput(input *models.WebhookInput) *models.Webhook {
+	webhook := &models.Webhook{
+		URL:         input.URL,
+		Events:      input.Events,
+		Description: input.Description,
+		Active:      input.Active == nil || *input.Active,
+	}
+	if webhook.Events == nil {
+		webhook.Events = []string{}
+	}
+	return webhook
+}
+
+func deliveryLimit(c *fiber.Ctx) (int, error) {
+	limit := c.QueryInt("limit", defaultDeliveryLimit)
+	if limit < 1 || limit > maxDeliveryLimit {
+		return 0, problem.New(fiber.StatusBadRequest, problem.CodeInvalidQuery, "limit must be between 1 and 500")
+	}
+	return limit, nil
+}
@@ -0,0 +1,605 @@
+package dbsetup
+
+import (
+	"context"
+	"contoso/money"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// Options carries the settings migrations need to convert existing data.
+type Options struct {
+	// LegacyCurrency is the currency of balances stored before players had
+	// one. Migration 7 refuses to run on existing data without it.
+	LegacyCurrency string
+}
+
+// legacyCurrency returns opts.LegacyCurrency, which is required when there
+// is data to convert.
+func (opts Options) legacyCurrency(hasData bool) (string, error) {
+	if opts.LegacyCurrency == "" {
+		if hasData {
+			return "", errors.New("existing balances have no currency; set LEGACY_CURRENCY to the currency they are in")
+		}
+		return "", nil
+	}
+	if _, ok := money.Places(opts.LegacyCurrency); !ok {
+		return "", fmt.Errorf("unsupported LEGACY_CURRENCY %q", opts.LegacyCurrency)
+	}
+	return opts.LegacyCurrency, nil
+}
+
+// postgresMigration is a schema change applied once, in version order. Up,
+// if set, runs instead of SQL for changes that depend on Options.
+type postgresMigration struct {
+	Version int
+	Name    string
+	SQL     string
+	Up      func(ctx context.Context, tx *sql.Tx, opts Options) error
+}
+
+// mongoMigration is the MongoDB counterpart of postgresMigration.
+type mongoMigration struct {
+	Version int
+	Name    string
+	Up      func(ctx context.Context, db *mongo.Database, opts Options) error
+}
+
+// postgresMigrations must only ever be appended to.
+var postgresMigrations = []postgresMigration{
+	{
+		Version: 1,
+		Name:    "create players table",
+		SQL: `
+			CREATE TABLE IF NOT EXISTS players (
+				id SERIAL PRIMARY KEY,
+				name TEXT NOT NULL,
+				surname TEXT NOT NULL,
+				balance DOUBLE PRECISION NOT NULL
+			)
+		`,
+	},
+	{
+		Version: 2,
+		Name:    "create api_keys table",
+		SQL: `
+			CREATE TABLE api_keys (
+				id SERIAL PRIMARY KEY,
+				name TEXT NOT NULL,
+				prefix TEXT NOT NULL,
+				hash TEXT NOT NULL UNIQUE,
+				roles TEXT[] NOT NULL DEFAULT '{}',
+				created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+				revoked_at TIMESTAMPTZ
+			)
+		`,
+	},
+	{
+		Version: 3,
+		Name:    "create idempotency_keys table",
+		SQL: `
+			CREATE TABLE idempotency_keys (
+				key TEXT PRIMARY KEY,
+				fingerprint TEXT NOT NULL,
+				status INTEGER NOT NULL DEFAULT 0,
+				content_type TEXT NOT NULL DEFAULT '',
+				body BYTEA,
+				created_at TIMESTAMPTZ NOT NULL,
+				expires_at TIMESTAMPTZ NOT NULL
+			);
+			CREATE INDEX idempotency_keys_expires_at ON idempotency_keys (expires_at);
+		`,
+	},
+	{
+		Version: 4,
+		Name:    "create webhooks and webhook_deliveries tables",
+		SQL: `
+			CREATE TABLE webhooks (
+				id SERIAL PRIMARY KEY,
+				url TEXT NOT NULL,
+				events TEXT[] NOT NULL DEFAULT '{}',
+				description TEXT NOT NULL DEFAULT '',
+				active BOOLEAN NOT NULL DEFAULT true,
+				secret TEXT NOT NULL,
+				created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+			);
+			CREATE TABLE webhook_deliveries (
+				id BIGSERIAL PRIMARY KEY,
+				webhook_id INTEGER NOT NULL REFERENCES webhooks (id) ON DELETE CASCADE,
+				event_id TEXT NOT NULL,
+				event_type TEXT NOT NULL,
+				payload JSONB NOT NULL,
+				status TEXT NOT NULL,
+				attempts JSONB NOT NULL DEFAULT '[]',
+				next_attempt_at TIMESTAMPTZ,
+				created_at TIMESTAMPTZ NOT NULL,
+				updated_at TIMESTAMPTZ NOT NULL
+			);
+			CREATE INDEX webhook_deliveries_due ON webhook_deliveries (next_attempt_at) WHERE status = 'pending';
+			CREATE INDEX webhook_deliveries_webhook ON webhook_deliveries (webhook_id, id);
+			CREATE INDEX webhook_deliveries_status ON webhook_deliveries (status, updated_at);
+		`,
+	},
+	{
+		Version: 5,
+		Name:    "create outbox table",
+		SQL: `
+			CREATE TABLE outbox (
+				id TEXT PRIMARY KEY,
+				seq BIGSERIAL NOT NULL,
+				event_type TEXT NOT NULL,
+				payload JSONB NOT NULL,
+				occurred_at TIMESTAMPTZ NOT NULL,
+				next_attempt_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+				published_at TIMESTAMPTZ
+			);
+			CREATE INDEX outbox_pending ON outbox (seq) WHERE published_at IS NULL;
+			CREATE INDEX outbox_published ON outbox (published_at) WHERE published_at IS NOT NULL;
+			CREATE UNIQUE INDEX webhook_deliveries_event ON webhook_deliveries (webhook_id, event_id);
+		`,
+	},
+	{
+		Version: 6,
+		Name:    "index players by balance for the leaderboard",
+		SQL: `
+			CREATE INDEX players_leaderboard ON players (balance DESC, id);
+		`,
+	},
+	{
+		// Balances were validated to cents, so rounding the doubles to two
+		// places recovers the intended amounts. Pending outbox events are
+		// rewritten to the new amount format so the relay can still read them.
+		Version: 7,
+		Name:    "store balances as decimals with a currency",
+		Up: func(ctx context.Context, tx *sql.Tx, opts Options) error {
+			var hasData bool
+			err := tx.QueryRowContext(ctx,
+				"SELECT EXISTS (SELECT 1 FROM players) OR EXISTS (SELECT 1 FROM outbox WHERE published_at IS NULL)",
+			).Scan(&hasData)
+			if err != nil {
+				return err
+			}
+			currency, err := opts.legacyCurrency(hasData)
+			if err != nil {
+				return err
+			}
+			_, err = tx.ExecContext(ctx, `
+				ALTER TABLE players
+					ALTER COLUMN balance TYPE NUMERIC(20, 4) USING round(balance::numeric, 2),
+					ADD COLUMN currency TEXT`)
+			if err != nil {
+				return err
+			}
+			for _, stmt := range []string{`
+				UPDATE players SET currency = $1`, `
+				UPDATE outbox
+					SET payload = jsonb_set(payload, '{player,balance}',
+						jsonb_build_object('amount', payload #>> '{player,balance}', 'currency', $1::text))
+					WHERE published_at IS NULL AND jsonb_typeof(payload #> '{player,balance}') = 'number'`, `
+				UPDATE outbox
+					SET payload = jsonb_set(payload, '{amount}',
+						jsonb_build_object('amount', payload ->> 'amount', 'currency', $1::text))
+					WHERE published_at IS NULL AND jsonb_typeof(payload -> 'amount') = 'number'`,
+			} {
+				if _, err := tx.ExecContext(ctx, stmt, currency); err != nil {
+					return err
+				}
+			}
+			_, err = tx.ExecContext(ctx, "ALTER TABLE players ALTER COLUMN currency SET NOT NULL")
+			return err
+		},
+	},
+	{
+		Version: 8,
+		Name:    "create wallets table",
+		SQL: `
+			CREATE TABLE wallets (
+				player_id INTEGER NOT NULL REFERENCES players (id) ON DELETE CASCADE,
+				currency TEXT NOT NULL,
+				balance NUMERIC(20, 4) NOT NULL DEFAULT 0 CHECK (balance >= 0),
+				opened_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+				PRIMARY KEY (player_id, currency)
+			);
+		`,
+	},
+	{
+		// Legs are kept when their player is deleted, so there is no
+		// foreign key.
+		Version: 9,
+		Name:    "create transfer_legs table",
+		SQL: `
+			CREATE TABLE transfer_legs (
+				transfer_id TEXT NOT NULL,
+				player_id INTEGER NOT NULL,
+				counterparty_id INTEGER NOT NULL,
+				amount NUMERIC(20, 4) NOT NULL,
+				currency TEXT NOT NULL,
+				created_at TIMESTAMPTZ NOT NULL,
+				PRIMARY KEY (transfer_id, player_id)
+			);
+			CREATE INDEX transfer_legs_player ON transfer_legs (player_id, created_at DESC, transfer_id DESC);
+		`,
+	},
+	{
+		Version: 10,
+		Name:    "add player status",
+		SQL: `
+			ALTER TABLE players ADD COLUMN status TEXT NOT NULL DEFAULT 'active'
+				CHECK (status IN ('pending', 'active', 'suspended', 'self_excluded', 'closed'));
+		`,
+	},
+	{
+		Version: 11,
+		Name:    "create player_limits and balance_changes tables",
+		SQL: `
+			CREATE TABLE player_limits (
+				player_id INTEGER NOT NULL REFERENCES players (id) ON DELETE CASCADE,
+				kind TEXT NOT NULL CHECK (kind IN ('deposit', 'loss')),
+				period TEXT NOT NULL CHECK (period IN ('daily', 'weekly', 'monthly')),
+				amount NUMERIC(20, 4) NOT NULL CHECK (amount > 0),
+				currency TEXT NOT NULL,
+				pending_amount NUMERIC(20, 4),
+				pending_at TIMESTAMPTZ,
+				PRIMARY KEY (player_id, kind, period)
+			);
+			CREATE TABLE balance_changes (
+				id BIGSERIAL PRIMARY KEY,
+				player_id INTEGER NOT NULL REFERENCES players (id) ON DELETE CASCADE,
+				amount NUMERIC(20, 4) NOT NULL,
+				currency TEXT NOT NULL,
+				created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+			);
+			CREATE INDEX balance_changes_player ON balance_changes (player_id, created_at);
+		`,
+	},
+	{
+		Version: 12,
+		Name:    "index the leaderboard per currency",
+		SQL: `
+			DROP INDEX players_leaderboard;
+			CREATE INDEX players_leaderboard ON players (currency, balance DESC, id);
+		`,
+	},
+	{
+		// The client chooses the transfer id, which makes retried transfers
+		// idempotent. An id can then only ever name one transfer, whichever
+		// players it is between, so it is unique among the sent legs.
+		Version: 13,
+		Name:    "make transfer ids unique",
+		SQL: `
+			CREATE UNIQUE INDEX transfer_legs_sent ON transfer_legs (transfer_id) WHERE amount < 0;
+		`,
+	},
+	{
+		Version: 14,
+		Name:    "add idempotency heartbeats",
+		SQL: `
+			ALTER TABLE idempotency_keys ADD COLUMN heartbeat_at TIMESTAMPTZ;
+			UPDATE idempotency_keys SET heartbeat_at = created_at;
+			ALTER TABLE idempotency_keys ALTER COLUMN heartbeat_at SET NOT NULL;
+		`,
+	},
+}
+
+// mongoMigrations must only ever be appended to.
+var mongoMigrations = []mongoMigration{
+	{
+		Version: 2,
+		Name:    "index api_keys by hash",
+		Up: func(ctx context.Context, db *mongo.Database, opts Options) error {
+			_, err := db.Collection("api_keys").Indexes().CreateOne(ctx, mongo.IndexModel{
+				Keys:    bson.D{{Key: "hash", Value: 1}},
+				Options: options.Index().SetUnique(true),
+			})
+			return err
+		},
+	},
+	{
+		Version: 3,
+		Name:    "expire idempotency_keys",
+		Up: func(ctx context.Context, db *mongo.Database, opts Options) error {
+			_, err := db.Collection("idempotency_keys").Indexes().CreateOne(ctx, mongo.IndexModel{
+				Keys:    bson.D{{Key: "expires_at", Value: 1}},
+				Options: options.Index().SetExpireAfterSeconds(0),
+			})
+			return err
+		},
+	},
+	{
+		Version: 4,
+		Name:    "index webhook_deliveries",
+		Up: func(ctx context.Context, db *mongo.Database, opts Options) error {
+			_, err := db.Collection("webhook_deliveries").Indexes().CreateMany(ctx, []mongo.IndexModel{
+				{Keys: bson.D{{Key: "status", Value: 1}, {Key: "next_attempt_at", Value: 1}}},
+				{Keys: bson.D{{Key: "webhook_id", Value: 1}, {Key: "_id", Value: -1}}},
+				{Keys: bson.D{{Key: "status", Value: 1}, {Key: "updated_at", Value: 1}}},
+			})
+			return err
+		},
+	},
+	{
+		Version: 5,
+		Name:    "create outbox",
+		Up: func(ctx context.Context, db *mongo.Database, opts Options) error {
+			_, err := db.Collection("outbox").Indexes().CreateMany(ctx, []mongo.IndexModel{
+				{Keys: bson.D{{Key: "published_at", Value: 1}, {Key: "next_attempt_at", Value: 1}}},
+				{Keys: bson.D{{Key: "occurred_at", Value: 1}, {Key: "_id", Value: 1}}},
+			})
+			if err != nil {
+				return err
+			}
+			_, err = db.Collection("webhook_deliveries").Indexes().CreateOne(ctx, mongo.IndexModel{
+				Keys:    bson.D{{Key: "webhook_id", Value: 1}, {Key: "event_id", Value: 1}},
+				Options: options.Index().SetUnique(true),
+			})
+			return err
+		},
+	},
+	{
+		Version: 6,
+		Name:    "index players by balance for the leaderboard",
+		Up: func(ctx context.Context, db *mongo.Database, opts Options) error {
+			_, err := db.Collection("players").Indexes().CreateOne(ctx, mongo.IndexModel{
+				Keys: bson.D{{Key: "balance", Value: -1}, {Key: "_id", Value: 1}},
+			})
+			return err
+		},
+	},
+	{
+		Version: 7,
+		Name:    "store balances as decimals with a currency",
+		Up: func(ctx context.Context, db *mongo.Database, opts Options) error {
+			numeric := bson.M{"$type": bson.A{"double", "int", "long", "decimal"}}
+			players := db.Collection("players")
+			outbox := db.Collection("outbox")
+			outboxFields := []string{"player.balance", "amount"}
+			hasData := false
+			for _, q := range []struct {
+				col    *mongo.Collection
+				filter bson.M
+			}{
+				{players, bson.M{"balance": numeric}},
+				{outbox, bson.M{"published_at": nil, outboxFields[0]: numeric}},
+				{outbox, bson.M{"published_at": nil, outboxFields[1]: numeric}},
+			} {
+				n, err := q.col.CountDocuments(ctx, q.filter, options.Count().SetLimit(1))
+				if err != nil {
+					return err
+				}
+				hasData = hasData || n > 0
+			}
+			currency, err := opts.legacyCurrency(hasData)
+			if err != nil {
+				return err
+			}
+			_, err = players.UpdateMany(ctx, bson.M{"balance": numeric}, mongo.Pipeline{{{Key: "$set", Value: bson.M{
+				"balance": bson.M{
+					"amount":   bson.M{"$round": bson.A{bson.M{"$toDecimal": "$balance"}, 2}},
+					"currency": currency,
+				},
+			}}}})
+			if err != nil {
+				return err
+			}
+			for _, field := range outboxFields {
+				_, err := outbox.UpdateMany(ctx, bson.M{"published_at": nil, field: numeric}, mongo.Pipeline{{{Key: "$set", Value: bson.M{
+					field: bson.M{"amount": bson.M{"$toDecimal": "$" + field}, "currency": currency},
+				}}}})
+				if err != nil {
+					return err
+				}
+			}
+			_, err = players.Indexes().CreateOne(ctx, mongo.IndexModel{
+				Keys: bson.D{{Key: "balance.amount", Value: -1}, {Key: "_id", Value: 1}},
+			})
+			if err != nil {
+				return err
+			}
+			_, err = players.Indexes().DropOne(ctx, "balance_-1__id_1")
+			var cerr mongo.CommandError
+			if errors.As(err, &cerr) && cerr.Code == 27 { // IndexNotFound
+				return nil
+			}
+			return err
+		},
+	},
+	{
+		Version: 8,
+		Name:    "create wallets",
+		Up: func(ctx context.Context, db *mongo.Database, opts Options) error {
+			_, err := db.Collection("wallets").Indexes().CreateOne(ctx, mongo.IndexModel{
+				Keys:    bson.D{{Key: "player_id", Value: 1}, {Key: "balance.currency", Value: 1}},
+				Options: options.Index().SetUnique(true),
+			})
+			return err
+		},
+	},
+	{
+		Version: 9,
+		Name:    "create transfer_legs",
+		Up: func(ctx context.Context, db *mongo.Database, opts Options) error {
+			_, err := db.Collection("transfer_legs").Indexes().CreateMany(ctx, []mongo.IndexModel{
+				{
+					Keys:    bson.D{{Key: "transfer_id", Value: 1}, {Key: "player_id", Value: 1}},
+					Options: options.Index().SetUnique(true),
+				},
+				{Keys: bson.D{{Key: "player_id", Value: 1}, {Key: "created_at", Value: -1}, {Key: "transfer_id", Value: -1}}},
+			})
+			return err
+		},
+	},
+	{
+		Version: 10,
+		Name:    "add player status",
+		Up: func(ctx context.Context, db *mongo.Database, opts Options) error {
+			_, err := db.Collection("players").UpdateMany(ctx,
+				bson.M{"status": bson.M{"$exists": false}},
+				bson.M{"$set": bson.M{"status": "active"}},
+			)
+			return err
+		},
+	},
+	{
+		Version: 11,
+		Name:    "create player_limits and balance_changes",
+		Up: func(ctx context.Context, db *mongo.Database, opts Options) error {
+			_, err := db.Collection("player_limits").Indexes().CreateOne(ctx, mongo.IndexModel{
+				Keys:    bson.D{{Key: "player_id", Value: 1}, {Key: "kind", Value: 1}, {Key: "period", Value: 1}},
+				Options: options.Index().SetUnique(true),
+			})
+			if err != nil {
+				return err
+			}
+			_, err = db.Collection("balance_changes").Indexes().CreateOne(ctx, mongo.IndexModel{
+				Keys: bson.D{{Key: "player_id", Value: 1}, {Key: "created_at", Value: 1}},
+			})
+			return err
+		},
+	},
+	{
+		Version: 12,
+		Name:    "index the leaderboard per currency",
+		Up: func(ctx context.Context, db *mongo.Database, opts Options) error {
+			players := db.Collection("players")
+			_, err := players.Indexes().CreateOne(ctx, mongo.IndexModel{
+				Keys: bson.D{{Key: "balance.currency", Value: 1}, {Key: "balance.amount", Value: -1}, {Key: "_id", Value: 1}},
+			})
+			if err != nil {
+				return err
+			}
+			_, err = players.Indexes().DropOne(ctx, "balance.amount_-1__id_1")
+			var cerr mongo.CommandError
+			if errors.As(err, &cerr) && cerr.Code == 27 { // IndexNotFound
+				return nil
+			}
+			return err
+		},
+	},
+	{
+		Version: 13,
+		Name:    "make transfer ids unique",
+		Up: func(ctx context.Context, db *mongo.Database, opts Options) error {
+			_, err := db.Collection("transfer_legs").Indexes().CreateOne(ctx, mongo.IndexModel{
+				Keys: bson.D{{Key: "transfer_id", Value: 1}},
+				Options: options.Index().SetUnique(true).
+					SetPartialFilterExpression(bson.M{"amount.amount": bson.M{"$lt": 0}}),
+			})
+			return err
+		},
+	},
+	{
+		Version: 14,
+		Name:    "add idempotency heartbeats",
+		Up: func(ctx context.Context, db *mongo.Database, opts Options) error {
+			_, err := db.Collection("idempotency_keys").UpdateMany(ctx,
+				bson.M{"heartbeat_at": bson.M{"$exists": false}},
+				mongo.Pipeline{{{Key: "$set", Value: bson.M{"heartbeat_at": "$created_at"}}}},
+			)
+			return err
+		},
+	},
+}
+
+// migrationLockID serialises concurrent migration runs across instances.
+const migrationLockID = 7461032
+
+// MigratePostgres applies pending Postgres migrations and returns the names of those applied.
+func MigratePostgres(db *sql.DB, opts Options) ([]string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+	defer cancel()
+	conn, err := db.Conn(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	if _, err := conn.ExecContext(ctx, "SELECT pg_advisory_lock($1)", migrationLockID); err != nil {
+		return nil, err
+	}
+	defer conn.ExecContext(context.Background(), "SELECT pg_advisory_unlock($1)", migrationLockID)
+
+	_, err = conn.ExecContext(ctx, `
+		CREATE TABLE IF NOT EXISTS schema_migrations (
+			version INTEGER PRIMARY KEY,
+			name TEXT NOT NULL,
+			applied_at TIMESTAMPTZ NOT NULL DEFAULT now()
+		)
+	`)
+	if err != nil {
+		return nil, err
+	}
+	applied := map[int]bool{}
+	rows, err := conn.QueryContext(ctx, "SELECT version FROM schema_migrations")
+	if err != nil {
+		return nil, err
+	}
+	for rows.Next() {
+		var v int
+		if err := rows.Scan(&v); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		applied[v] = true
+	}
+	rows.Close()
+
+	var names []string
+	for _, m := range postgresMigrations {
+		if applied[m.Version] {
+			continue
+		}
+		tx, err := conn.BeginTx(ctx, nil)
+		if err != nil {
+			return names, err
+		}
+		if m.Up != nil {
+			err = m.Up(ctx, tx, opts)
+		} else {
+			_, err = tx.ExecContext(ctx, m.SQL)
+		}
+		if err != nil {
+			tx.Rollback()
+			return names, fmt.Errorf("migration %d (%s): %w", m.Version, m.Name, err)
+		}
+		if _, err := tx.ExecContext(ctx, "INSERT INTO schema_migrations (version, name) VALUES ($1, $2)", m.Version, m.Name); err != nil {
+			tx.Rollback()
+			return names, err
+		}
+		if err := tx.Commit(); err != nil {
+			return names, err
+		}
+		names = append(names, m.Name)
+	}
+	return names, nil
+}
+
+// MigrateMongo applies pending MongoDB migrations and returns the names of those applied.
+func MigrateMongo(db *mongo.Database, opts Options) ([]string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+	defer cancel()
+	col := db.Collection("schema_migrations")
+	var names []string
+	for _, m := range mongoMigrations {
+		n, err := col.CountDocuments(ctx, bson.M{"_id": m.Version})
+		if err != nil {
+			return names, err
+		}
+		if n > 0 {
+			continue
+		}
+		if err := m.Up(ctx, db, opts); err != nil {
+			return names, fmt.Errorf("migration %d (%s): %w", m.Version, m.Name, err)
+		}
+		_, err = col.InsertOne(ctx, bson.M{"_id": m.Version, "name": m.Name, "applied_at": time.Now()})
+		if err != nil {
+			return names, err
+		}
+		names = append(names, m.Name)
+	}
+	return names, nil
+}
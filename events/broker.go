@@ -0,0 +1,102 @@
+package events
+
+import (
+	"errors"
+	"sync"
+)
+
+// ErrTooManySubscribers is returned when the broker is at capacity.
+var ErrTooManySubscribers = errors.New("too many event subscribers")
+
+// subscriberBuffer is the number of events a subscriber may fall behind
+// before it is disconnected.
+const subscriberBuffer = 256
+
+// Filter selects the events a subscriber receives. Empty fields match
+// everything.
+type Filter struct {
+	PlayerIDs []string
+	Types     []string
+}
+
+func (f Filter) matches(e Event) bool {
+	return matchAny(f.PlayerIDs, e.PlayerID) && matchAny(f.Types, e.Type)
+}
+
+func matchAny(values []string, v string) bool {
+	if len(values) == 0 {
+		return true
+	}
+	for _, x := range values {
+		if x == v {
+			return true
+		}
+	}
+	return false
+}
+
+// Broker fans events out to in-process subscribers. Publishing never
+// blocks: a subscriber whose buffer is full is dropped, and its channel
+// closed, so one slow client cannot stall writes.
+type Broker struct {
+	mu   sync.Mutex
+	subs map[*Subscription]struct{}
+	max  int
+}
+
+// NewBroker creates a broker accepting up to max subscribers.
+func NewBroker(max int) *Broker {
+	return &Broker{subs: make(map[*Subscription]struct{}), max: max}
+}
+
+// Subscription receives matching events on C until it is closed.
+type Subscription struct {
+	C      <-chan Event
+	ch     chan Event
+	filter Filter
+	broker *Broker
+	once   sync.Once
+}
+
+// Subscribe registers a subscriber for events matching filter.
+func (b *Broker) Subscribe(filter Filter) (*Subscription, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if len(b.subs) >= b.max {
+		return nil, ErrTooManySubscribers
+	}
+	ch := make(chan Event, subscriberBuffer)
+	s := &Subscription{C: ch, ch: ch, filter: filter, broker: b}
+	b.subs[s] = struct{}{}
+	return s, nil
+}
+
+// Publish delivers e to every matching subscriber. It never fails.
+func (b *Broker) Publish(e Event) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for s := range b.subs {
+		if !s.filter.matches(e) {
+			continue
+		}
+		select {
+		case s.ch <- e:
+		default:
+			b.remove(s)
+		}
+	}
+	return nil
+}
+
+// Close unsubscribes and closes C.
+func (s *Subscription) Close() {
+	s.broker.mu.Lock()
+	defer s.broker.mu.Unlock()
+	s.broker.remove(s)
+}
+
+// remove must be called with b.mu held.
+func (b *Broker) remove(s *Subscription) {
+	delete(b.subs, s)
+	s.once.Do(func() { close(s.ch) })
+}
@@ -0,0 +1,77 @@
+// Package events describes player change events, relays them from the
+// outbox and distributes them to in-process subscribers.
+package events
+
+import (
+	"contoso/models"
+	"contoso/money"
+	"errors"
+	"time"
+
+	"github.com/gofiber/fiber/v2/utils"
+)
+
+// Event types.
+const (
+	PlayerCreated        = "player.created"
+	PlayerUpdated        = "player.updated"
+	PlayerDeleted        = "player.deleted"
+	PlayerBalanceChanged = "player.balance_changed"
+	// PlayerCurrencyConverted is a conversion between two of the player's
+	// wallets.
+	PlayerCurrencyConverted = "player.currency_converted"
+	// PlayerStatusChanged is a transition of the player's account status.
+	PlayerStatusChanged = "player.status_changed"
+)
+
+// Types lists every event type.
+var Types = []string{PlayerCreated, PlayerUpdated, PlayerDeleted, PlayerBalanceChanged, PlayerCurrencyConverted, PlayerStatusChanged}
+
+// Event is a change to one player. Player is the state after the change
+// and nil for deletes; Amount is set for balance changes and conversions,
+// where Converted is what Amount was converted into. TransferID marks the
+// balance changes made by a transfer. Status changes carry the
+// PreviousStatus and the Reason given.
+type Event struct {
+	ID             string         `json:"id" bson:"_id"`
+	Type           string         `json:"type" bson:"type"`
+	PlayerID       string         `json:"playerId" bson:"player_id"`
+	Player         *models.Player `json:"player,omitempty" bson:"player,omitempty"`
+	Amount         *money.Money   `json:"amount,omitempty" bson:"amount,omitempty"`
+	Converted      *money.Money   `json:"converted,omitempty" bson:"converted,omitempty"`
+	TransferID     string         `json:"transferId,omitempty" bson:"transfer_id,omitempty"`
+	PreviousStatus string         `json:"previousStatus,omitempty" bson:"previous_status,omitempty"`
+	Reason         string         `json:"reason,omitempty" bson:"reason,omitempty"`
+	OccurredAt     time.Time      `json:"occurredAt" bson:"occurred_at"`
+}
+
+// New creates an event with a fresh ID, stamped now.
+func New(eventType, playerID string, player *models.Player) Event {
+	return Event{
+		ID:         utils.UUIDv4(),
+		Type:       eventType,
+		PlayerID:   playerID,
+		Player:     player,
+		OccurredAt: time.Now().UTC(),
+	}
+}
+
+// Publisher delivers events somewhere. An error means e may not have
+// arrived and should be published again.
+type Publisher interface {
+	Publish(e Event) error
+}
+
+// Publishers publishes every event to each of its publishers in turn and
+// returns their errors joined.
+type Publishers []Publisher
+
+func (ps Publishers) Publish(e Event) error {
+	var errs []error
+	for _, p := range ps {
+		if err := p.Publish(e); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
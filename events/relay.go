@@ -0,0 +1,106 @@
+package events
+
+import (
+	"context"
+	"contoso/elasticlog"
+	"time"
+)
+
+// Outbox is the queue of events that the player repositories write in the
+// same transaction as the change they describe.
+type Outbox interface {
+	// ClaimEvents returns up to limit unpublished events that are due,
+	// oldest first, and hides them from other relays for lease.
+	ClaimEvents(limit int, lease time.Duration) ([]Event, error)
+	MarkPublished(ids []string) error
+	// DeletePublishedBefore purges events published before t and returns
+	// how many there were.
+	DeletePublishedBefore(t time.Time) (int64, error)
+}
+
+// Relay tuning.
+const (
+	relayBatchSize    = 100
+	relayPollInterval = 500 * time.Millisecond
+	// relayLease is how long a claimed event waits before it is claimed
+	// again when its relay failed to publish it or died.
+	relayLease = 30 * time.Second
+	// relayRetention is how long published events are kept.
+	relayRetention = 24 * time.Hour
+)
+
+// Relay moves events from the outbox to a publisher. An event is only
+// marked published once the publisher accepted it, so every event is
+// published at least once and may be published again after a failure.
+type Relay struct {
+	outbox    Outbox
+	publisher Publisher
+	logger    *elasticlog.Logger
+}
+
+// NewRelay creates a relay from outbox to publisher.
+func NewRelay(outbox Outbox, publisher Publisher, logger *elasticlog.Logger) *Relay {
+	return &Relay{outbox: outbox, publisher: publisher, logger: logger}
+}
+
+// Run relays events until ctx is cancelled. Several instances may run it
+// against the same database; each event is then relayed by one of them.
+func (r *Relay) Run(ctx context.Context) {
+	poll := time.NewTicker(relayPollInterval)
+	defer poll.Stop()
+	purge := time.NewTicker(time.Hour)
+	defer purge.Stop()
+	for {
+		// Keep draining while batches come back full.
+		for ctx.Err() == nil {
+			if r.relayBatch() < relayBatchSize {
+				break
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-purge.C:
+			r.purge()
+		case <-poll.C:
+		}
+	}
+}
+
+func (r *Relay) relayBatch() int {
+	claimed, err := r.outbox.ClaimEvents(relayBatchSize, relayLease)
+	if err != nil {
+		r.logger.Warn("Outbox poll failed", map[string]interface{}{"error": err.Error()})
+		return 0
+	}
+	published := make([]string, 0, len(claimed))
+	for _, e := range claimed {
+		if err := r.publisher.Publish(e); err != nil {
+			r.logger.Warn("Event publish failed", map[string]interface{}{
+				"error":   err.Error(),
+				"eventId": e.ID,
+				"type":    e.Type,
+			})
+			continue
+		}
+		published = append(published, e.ID)
+	}
+	if len(published) > 0 {
+		if err := r.outbox.MarkPublished(published); err != nil {
+			// The lease runs out and the events are published again.
+			r.logger.Warn("Outbox events not marked published", map[string]interface{}{"error": err.Error()})
+		}
+	}
+	return len(claimed)
+}
+
+func (r *Relay) purge() {
+	n, err := r.outbox.DeletePublishedBefore(time.Now().Add(-relayRetention))
+	if err != nil {
+		r.logger.Warn("Outbox purge failed", map[string]interface{}{"error": err.Error()})
+		return
+	}
+	if n > 0 {
+		r.logger.Debug("Outbox events purged", map[string]interface{}{"count": n})
+	}
+}
@@ -22,12 +22,15 @@ const messages = {
     name: 'Name',
     surname: 'Surname',
     balance: 'Balance',
+    currency: 'Currency',
     cancel: 'Cancel',
     save: 'Save',
     pingTab: 'Ping',
     id: 'ID',
     actions: 'Actions',
-    recordsPerPage: 'Records per page'
+    recordsPerPage: 'Records per page',
+    apiKey: 'API key',
+    apiKeyHint: 'Sent with every request; create one with go run . apikey create'
   },
   fr: {
     title: 'Application Quasar Contoso',
@@ -40,12 +43,15 @@ const messages = {
     name: 'Prénom',
     surname: 'Nom de famille',
     balance: 'Solde',
+    currency: 'Devise',
     cancel: 'Annuler',
     save: 'Enregistrer',
     pingTab: 'Ping',
     id: 'ID',
     actions: 'Actions',
-    recordsPerPage: 'Enregistrements par page'
+    recordsPerPage: 'Enregistrements par page',
+    apiKey: 'Clé d\'API',
+    apiKeyHint: 'Envoyée avec chaque requête ; créez-en une avec go run . apikey create'
   }
 }
 
@@ -6,6 +6,34 @@ export const usePortalStore = defineStore('portal', () => {
   const players = ref([])
   const player = ref(null)
 
+  // The API key is sent with every request and kept in localStorage across
+  // visits. unauthorized is set while the server rejects it.
+  const apiKey = ref(localStorage.getItem('apiKey') || '')
+  const unauthorized = ref(false)
+
+  async function api(path, options = {}) {
+    const headers = { ...options.headers }
+    if (apiKey.value) headers['X-API-Key'] = apiKey.value
+    const res = await fetch(path, { ...options, headers })
+    unauthorized.value = res.status === 401
+    return res
+  }
+
+  function setApiKey(key) {
+    apiKey.value = key.trim()
+    if (apiKey.value) {
+      localStorage.setItem('apiKey', apiKey.value)
+    } else {
+      localStorage.removeItem('apiKey')
+    }
+    unauthorized.value = false
+    // Reconnect with the new key; opening the stream reloads the list
+    if (watching) {
+      unwatchPlayers()
+      watchPlayers()
+    }
+  }
+
   async function pingBackend() {
     try {
       const res = await fetch('/api/ping')
@@ -22,17 +50,17 @@ export const usePortalStore = defineStore('portal', () => {
 
   // Player CRUD
   async function fetchPlayers() {
-    const res = await fetch('/api/players')
-    players.value = (await res.json()) || []
+    const res = await api('/api/v1/players')
+    players.value = res.ok ? (await res.json()) || [] : []
   }
 
   async function fetchPlayer(id) {
-    const res = await fetch(`/api/players/${id}`)
+    const res = await api(`/api/v1/players/${id}`)
     player.value = await res.json()
   }
 
   async function createPlayer(data) {
-    const res = await fetch('/api/players', {
+    const res = await api('/api/v1/players', {
       method: 'POST',
       headers: { 'Content-Type': 'application/json' },
       body: JSON.stringify(data)
@@ -41,7 +69,7 @@ export const usePortalStore = defineStore('portal', () => {
   }
 
   async function updatePlayer(id, data) {
-    const res = await fetch(`/api/players/${id}`, {
+    const res = await api(`/api/v1/players/${id}`, {
       method: 'PUT',
       headers: { 'Content-Type': 'application/json' },
       body: JSON.stringify(data)
@@ -50,7 +78,7 @@ export const usePortalStore = defineStore('portal', () => {
   }
 
   async function deletePlayer(id) {
-    const res = await fetch(`/api/players/${id}`, { method: 'DELETE' })
+    const res = await api(`/api/v1/players/${id}`, { method: 'DELETE' })
     if (res.status === 204) {
       // No Content, return empty object
       return {}
@@ -62,7 +90,93 @@ export const usePortalStore = defineStore('portal', () => {
     }
   }
 
+  // Live updates: apply player change events to the list as they arrive.
+  // EventSource cannot send the API key, so the stream is read with fetch;
+  // events holds the AbortController of the open stream.
+  let watching = false
+  let events = null
+  const eventTypes = ['player.created', 'player.updated', 'player.deleted', 'player.balance_changed']
+
+  function applyEvent(e) {
+    const list = players.value.filter(p => p.id !== e.playerId)
+    if (e.type !== 'player.deleted') {
+      const i = players.value.findIndex(p => p.id === e.playerId)
+      list.splice(i < 0 ? list.length : i, 0, e.player)
+    }
+    players.value = list
+  }
+
+  // readEvents calls fn with the type and data of each Server-Sent Event in
+  // the response, and with the reconnection delay the server asks for.
+  async function readEvents(res, fn) {
+    const reader = res.body.pipeThrough(new TextDecoderStream()).getReader()
+    let buffer = ''
+    for (;;) {
+      const { value, done } = await reader.read()
+      if (done) return
+      buffer += value.replace(/\r\n?/g, '\n')
+      let end
+      while ((end = buffer.indexOf('\n\n')) >= 0) {
+        const message = { type: 'message', data: [], retry: 0 }
+        for (const line of buffer.slice(0, end).split('\n')) {
+          const colon = line.indexOf(':')
+          if (colon === 0) continue // a comment, such as the heartbeat
+          const field = colon < 0 ? line : line.slice(0, colon)
+          const v = colon < 0 ? '' : line.slice(colon + 1).replace(/^ /, '')
+          if (field === 'event') message.type = v
+          else if (field === 'data') message.data.push(v)
+          else if (field === 'retry' && /^\d+$/.test(v)) message.retry = Number(v)
+        }
+        buffer = buffer.slice(end + 2)
+        fn(message.type, message.data.join('\n'), message.retry)
+      }
+    }
+  }
+
+  function watchPlayers() {
+    watching = true
+    connect()
+  }
+
+  function connect() {
+    if (events) return
+    const controller = new AbortController()
+    events = controller
+    let retry = 3000
+    const reconnect = () => {
+      if (events !== controller) return // closed or replaced meanwhile
+      events = null
+      setTimeout(() => watching && connect(), retry)
+    }
+    api('/api/v1/players/events', { signal: controller.signal, headers: { Accept: 'text/event-stream' } })
+      .then(async res => {
+        if (res.status === 401 || res.status === 403) {
+          // Wait for another key rather than retrying one that was refused
+          if (events === controller) events = null
+          return
+        }
+        if (!res.ok) return reconnect()
+        // Events missed while disconnected are lost, so reload
+        fetchPlayers()
+        await readEvents(res, (type, data, delay) => {
+          if (delay) retry = delay
+          if (eventTypes.includes(type)) applyEvent(JSON.parse(data))
+        })
+        reconnect()
+      })
+      .catch(reconnect)
+  }
+
+  function unwatchPlayers() {
+    watching = false
+    events?.abort()
+    events = null
+  }
+
   return {
+    apiKey,
+    unauthorized,
+    setApiKey,
     pingResult,
     pingBackend,
     clearResult,
@@ -71,6 +185,8 @@ export const usePortalStore = defineStore('portal', () => {
     fetchPlayers,
     createPlayer,
     updatePlayer,
-    deletePlayer
+    deletePlayer,
+    watchPlayers,
+    unwatchPlayers
   }
 })
@@ -0,0 +1,57 @@
+package main
+
+import (
+	"contoso/config"
+	"contoso/elasticlog"
+	"contoso/tlsconfig"
+	"crypto/tls"
+	"net"
+	"net/http"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// listen serves app on cfg.Addr, over HTTPS when certs is set. With TLS
+// enabled and cfg.HTTPRedirectAddr set, a plain HTTP listener redirects
+// every request to the HTTPS address.
+func listen(app *fiber.App, cfg *config.Config, certs *tlsconfig.CertReloader, logger *elasticlog.Logger) error {
+	if certs == nil {
+		return app.Listen(cfg.Addr)
+	}
+	tlsCfg, err := tlsconfig.ServerConfig(certs, cfg.TLSClientCAFile)
+	if err != nil {
+		return err
+	}
+	ln, err := net.Listen("tcp", cfg.Addr)
+	if err != nil {
+		return err
+	}
+	if cfg.HTTPRedirectAddr != "" {
+		go func() {
+			err := http.ListenAndServe(cfg.HTTPRedirectAddr, httpsRedirect(cfg.Addr))
+			logger.Error("HTTP redirect listener stopped", map[string]interface{}{"error": err.Error()})
+		}()
+	}
+	logger.Info("Serving HTTPS", map[string]interface{}{
+		"addr":       cfg.Addr,
+		"clientAuth": cfg.TLSClientCAFile != "",
+		"redirect":   cfg.HTTPRedirectAddr,
+	})
+	return app.Listener(tls.NewListener(ln, tlsCfg))
+}
+
+// httpsRedirect permanently redirects to the same host and URI on the port
+// of tlsAddr.
+func httpsRedirect(tlsAddr string) http.Handler {
+	_, port, _ := net.SplitHostPort(tlsAddr)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		host := r.Host
+		if h, _, err := net.SplitHostPort(host); err == nil {
+			host = h
+		}
+		if port != "" && port != "443" {
+			host = net.JoinHostPort(host, port)
+		}
+		http.Redirect(w, r, "https://"+host+r.URL.RequestURI(), http.StatusPermanentRedirect)
+	})
+}
@@ -0,0 +1,21 @@
+// Package middleware contains Fiber middleware shared by the API routes.
+package middleware
+
+import (
+	"contoso/problem"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// RequireClientCert rejects requests that did not present a client
+// certificate verified against the configured client CA during the TLS
+// handshake.
+func RequireClientCert() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		state := c.Context().TLSConnectionState()
+		if state == nil || len(state.VerifiedChains) == 0 {
+			return problem.Respond(c, fiber.StatusUnauthorized, problem.CodeClientCertRequired, "a client certificate signed by the configured CA is required")
+		}
+		return c.Next()
+	}
+}
@@ -0,0 +1,57 @@
+package middleware
+
+import (
+	"contoso/auth"
+	"contoso/elasticlog"
+	"contoso/problem"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// Deprecation describes a deprecated API version.
+type Deprecation struct {
+	// Since is when the version was deprecated.
+	Since time.Time
+	// Sunset is when the version will be removed; zero if not yet decided.
+	Sunset time.Time
+	// Successor is the path of the version clients should move to.
+	Successor string
+}
+
+// Deprecated returns middleware that announces d on every response with
+// the Deprecation (RFC 9745), Sunset (RFC 8594) and Link headers, and logs
+// each use so remaining consumers can be found before the sunset.
+func Deprecated(d Deprecation, logger *elasticlog.Logger) fiber.Handler {
+	deprecation := "@" + strconv.FormatInt(d.Since.Unix(), 10)
+	sunset := ""
+	if !d.Sunset.IsZero() {
+		sunset = d.Sunset.UTC().Format(http.TimeFormat)
+	}
+	return func(c *fiber.Ctx) error {
+		c.Set("Deprecation", deprecation)
+		if sunset != "" {
+			c.Set("Sunset", sunset)
+		}
+		if d.Successor != "" {
+			c.Append(fiber.HeaderLink, "<"+d.Successor+">; rel=\"successor-version\"")
+		}
+		if logger == nil {
+			return c.Next()
+		}
+		entry := map[string]interface{}{
+			"event":     "api.deprecated_use",
+			"method":    c.Method(),
+			"path":      c.Path(),
+			"requestId": problem.RequestID(c),
+		}
+		if p := auth.PrincipalFrom(c); p != nil {
+			entry["principal"] = p.Subject
+			entry["principalKind"] = p.Kind
+		}
+		logger.Info("Deprecated API version used", entry)
+		return c.Next()
+	}
+}
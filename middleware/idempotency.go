@@ -0,0 +1,160 @@
+package middleware
+
+import (
+	"contoso/elasticlog"
+	"contoso/problem"
+	"contoso/repository"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// IdempotencyKeyHeader carries the client-chosen key of a retryable request.
+const IdempotencyKeyHeader = "Idempotency-Key"
+
+// maxIdempotencyKeyLength bounds client keys; a UUID fits easily.
+const maxIdempotencyKeyLength = 255
+
+// idempotencyStaleAfter is how long a pending key may go without a
+// heartbeat before a retry assumes the first request died with its instance
+// and runs again. Requests that are still running beat every
+// idempotencyHeartbeat, however long they take.
+const (
+	idempotencyStaleAfter = time.Minute
+	idempotencyHeartbeat  = idempotencyStaleAfter / 4
+)
+
+// Idempotency replays the stored response of a request when it is retried
+// with the same Idempotency-Key, so retries after a timeout do not apply
+// a change twice. Keys are scoped to the caller and stored in the backend,
+// which makes replays work across instances.
+type Idempotency struct {
+	repo   repository.IdempotencyRepository
+	ttl    time.Duration
+	logger *elasticlog.Logger
+}
+
+// NewIdempotency keeps responses for ttl.
+func NewIdempotency(repo repository.IdempotencyRepository, ttl time.Duration, logger *elasticlog.Logger) *Idempotency {
+	return &Idempotency{repo: repo, ttl: ttl, logger: logger}
+}
+
+// Handler returns the middleware. Requests without the header pass
+// through. Responses of 5xx and errors are not stored, so those requests
+// can be retried with the same key.
+func (i *Idempotency) Handler() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		header := c.Get(IdempotencyKeyHeader)
+		if header == "" {
+			return c.Next()
+		}
+		if len(header) > maxIdempotencyKeyLength || !printableASCII(header) {
+			return problem.Respond(c, fiber.StatusBadRequest, problem.CodeIdempotencyKeyInvalid,
+				"Idempotency-Key must be 1 to 255 printable ASCII characters")
+		}
+		key := hashParts(ClientKey(c), header)
+		fingerprint := hashParts(c.Method(), c.OriginalURL(), string(c.Body()))
+
+		record, err := i.repo.Reserve(key, fingerprint, i.ttl, idempotencyStaleAfter)
+		if err != nil {
+			return err
+		}
+		if record != nil {
+			switch {
+			case record.Fingerprint != fingerprint:
+				return problem.Respond(c, fiber.StatusUnprocessableEntity, problem.CodeIdempotencyKeyReused,
+					"Idempotency-Key was already used for a different request")
+			case record.Pending():
+				c.Set(fiber.HeaderRetryAfter, "1")
+				return problem.Respond(c, fiber.StatusConflict, problem.CodeIdempotencyKeyInUse,
+					"a request with this Idempotency-Key is still being processed")
+			}
+			c.Set("Idempotent-Replayed", "true")
+			if record.ContentType != "" {
+				c.Set(fiber.HeaderContentType, record.ContentType)
+			}
+			return c.Status(record.Status).Send(record.Body)
+		}
+
+		stop := i.heartbeat(c, key)
+		err = c.Next()
+		stop()
+		status := c.Response().StatusCode()
+		if err != nil || status >= http.StatusInternalServerError {
+			if rerr := i.repo.Release(key); rerr != nil {
+				i.warn(c, "Could not release idempotency key", rerr)
+			}
+			return err
+		}
+		contentType := string(c.Response().Header.ContentType())
+		body := append([]byte(nil), c.Response().Body()...)
+		if cerr := i.repo.Complete(key, status, contentType, body); cerr != nil {
+			i.warn(c, "Could not store idempotent response", cerr)
+		}
+		return nil
+	}
+}
+
+// heartbeat keeps the reservation of key fresh until stop is called, which
+// must happen before the handler returns.
+func (i *Idempotency) heartbeat(c *fiber.Ctx, key string) (stop func()) {
+	// Fiber reuses c after the handler, so the goroutine gets copies.
+	path, requestID := strings.Clone(c.Path()), strings.Clone(problem.RequestID(c))
+	done := make(chan struct{})
+	stopped := make(chan struct{})
+	go func() {
+		defer close(stopped)
+		ticker := time.NewTicker(idempotencyHeartbeat)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+				if err := i.repo.Heartbeat(key); err != nil {
+					i.logger.Warn("Could not refresh idempotency key", map[string]interface{}{
+						"error":     err.Error(),
+						"path":      path,
+						"requestId": requestID,
+					})
+				}
+			}
+		}
+	}()
+	return func() {
+		close(done)
+		<-stopped
+	}
+}
+
+func (i *Idempotency) warn(c *fiber.Ctx, msg string, err error) {
+	i.logger.Warn(msg, map[string]interface{}{
+		"error":     err.Error(),
+		"path":      c.Path(),
+		"requestId": problem.RequestID(c),
+	})
+}
+
+// hashParts hashes parts with separators so that ("ab", "c") and
+// ("a", "bc") differ.
+func hashParts(parts ...string) string {
+	h := sha256.New()
+	for _, p := range parts {
+		h.Write([]byte(p))
+		h.Write([]byte{0})
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+func printableASCII(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < 0x20 || s[i] > 0x7e {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,199 @@
+package middleware
+
+import (
+	"contoso/auth"
+	"contoso/elasticlog"
+	"contoso/problem"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// Rate allows Requests per Period. It is enforced as a token bucket holding
+// up to Requests tokens that refills evenly over Period, so short bursts are
+// allowed while the long-run rate is capped. Long periods act as quotas.
+type Rate struct {
+	Requests int
+	Period   time.Duration
+}
+
+func (r Rate) String() string {
+	return fmt.Sprintf("%d/%s", r.Requests, r.Period)
+}
+
+// ParseRates parses overrides of the form
+// "players.create=10/1m+1000/24h,players.list=300/1m".
+func ParseRates(s string) (map[string][]Rate, error) {
+	out := map[string][]Rate{}
+	for _, entry := range strings.Split(s, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		name, spec, ok := strings.Cut(entry, "=")
+		if !ok {
+			return nil, fmt.Errorf("rate limit %q: expected name=requests/period", entry)
+		}
+		var rates []Rate
+		for _, part := range strings.Split(spec, "+") {
+			n, period, ok := strings.Cut(strings.TrimSpace(part), "/")
+			requests, err := strconv.Atoi(n)
+			if !ok || err != nil || requests <= 0 {
+				return nil, fmt.Errorf("rate limit %q: invalid request count", entry)
+			}
+			d, err := time.ParseDuration(period)
+			if err != nil || d <= 0 {
+				return nil, fmt.Errorf("rate limit %q: invalid period", entry)
+			}
+			rates = append(rates, Rate{Requests: requests, Period: d})
+		}
+		out[strings.TrimSpace(name)] = rates
+	}
+	return out, nil
+}
+
+type bucket struct {
+	tokens float64
+	last   time.Time
+}
+
+// take refills the bucket and consumes a token if one is available. It
+// returns the tokens left and how long until the next token and until the
+// bucket is full again.
+func (b *bucket) take(r Rate, now time.Time) (ok bool, remaining int, next, full time.Duration) {
+	perToken := r.Period / time.Duration(r.Requests)
+	capacity := float64(r.Requests)
+	b.tokens = math.Min(capacity, b.tokens+float64(now.Sub(b.last))/float64(perToken))
+	b.last = now
+	if b.tokens >= 1 {
+		b.tokens--
+		ok = true
+	}
+	if b.tokens < 1 {
+		next = time.Duration((1 - b.tokens) * float64(perToken))
+	}
+	full = time.Duration((capacity - b.tokens) * float64(perToken))
+	return ok, int(b.tokens), next, full
+}
+
+// RateLimiter keeps token buckets per client and route in memory.
+type RateLimiter struct {
+	limits map[string][]Rate
+	logger *elasticlog.Logger
+
+	mu        sync.Mutex
+	buckets   map[string]*bucket
+	lastSweep time.Time
+}
+
+// NewRateLimiter creates a limiter for the named route limits.
+func NewRateLimiter(limits map[string][]Rate, logger *elasticlog.Logger) *RateLimiter {
+	return &RateLimiter{
+		limits:    limits,
+		logger:    logger,
+		buckets:   map[string]*bucket{},
+		lastSweep: time.Now(),
+	}
+}
+
+// Route returns a handler enforcing the limits configured for name. Routes
+// without configured limits are not limited.
+func (l *RateLimiter) Route(name string) fiber.Handler {
+	rates := l.limits[name]
+	return func(c *fiber.Ctx) error {
+		if len(rates) == 0 {
+			return c.Next()
+		}
+		client := ClientKey(c)
+		now := time.Now()
+
+		allowed := true
+		var retryAfter time.Duration
+		// Report the most restrictive limit in the headers
+		var policy Rate
+		remaining, reset := math.MaxInt, time.Duration(0)
+		l.mu.Lock()
+		l.sweep(now)
+		for i, r := range rates {
+			key := name + "|" + strconv.Itoa(i) + "|" + client
+			b, found := l.buckets[key]
+			if !found {
+				b = &bucket{tokens: float64(r.Requests), last: now}
+				l.buckets[key] = b
+			}
+			ok, left, next, full := b.take(r, now)
+			if !ok {
+				allowed = false
+				retryAfter = max(retryAfter, next)
+			}
+			if left < remaining {
+				remaining, reset, policy = left, full, r
+			}
+		}
+		l.mu.Unlock()
+
+		c.Set("RateLimit-Limit", strconv.Itoa(policy.Requests))
+		c.Set("RateLimit-Remaining", strconv.Itoa(remaining))
+		c.Set("RateLimit-Reset", strconv.Itoa(ceilSeconds(reset)))
+		c.Set("RateLimit-Policy", fmt.Sprintf("%d;w=%d", policy.Requests, ceilSeconds(policy.Period)))
+		if !allowed {
+			c.Set(fiber.HeaderRetryAfter, strconv.Itoa(ceilSeconds(retryAfter)))
+			l.logger.Warn("Rate limit exceeded", map[string]interface{}{
+				"route":     name,
+				"client":    client,
+				"method":    c.Method(),
+				"path":      c.Path(),
+				"requestId": problem.RequestID(c),
+			})
+			return problem.Respond(c, fiber.StatusTooManyRequests, problem.CodeRateLimited,
+				fmt.Sprintf("rate limit exceeded, retry in %d seconds", ceilSeconds(retryAfter)))
+		}
+		return c.Next()
+	}
+}
+
+// sweep drops buckets that have been idle long enough to be full again, so
+// memory stays proportional to active clients. Callers hold l.mu.
+func (l *RateLimiter) sweep(now time.Time) {
+	if now.Sub(l.lastSweep) < time.Minute {
+		return
+	}
+	l.lastSweep = now
+	for key, b := range l.buckets {
+		name, _, _ := strings.Cut(key, "|")
+		idle := true
+		for _, r := range l.limits[name] {
+			if now.Sub(b.last) < r.Period {
+				idle = false
+			}
+		}
+		if idle {
+			delete(l.buckets, key)
+		}
+	}
+}
+
+// ClientKey identifies the caller for rate limiting: by authenticated
+// principal, then by API key when one is sent, otherwise by IP address. API
+// keys are hashed so they never appear in memory dumps or logs.
+func ClientKey(c *fiber.Ctx) string {
+	if p := auth.PrincipalFrom(c); p != nil {
+		return p.Kind + ":" + p.Subject
+	}
+	if key := c.Get("X-API-Key"); key != "" {
+		sum := sha256.Sum256([]byte(key))
+		return "key:" + hex.EncodeToString(sum[:8])
+	}
+	return "ip:" + c.IP()
+}
+
+func ceilSeconds(d time.Duration) int {
+	return int(math.Ceil(d.Seconds()))
+}
@@ -0,0 +1,15 @@
+package models
+
+import "time"
+
+// APIKey is a stored API key. Only the SHA-256 hash of the secret is kept;
+// Prefix is the non-secret start of the key, shown to identify it.
+type APIKey struct {
+	ID        string     `json:"id" bson:"_id,omitempty" db:"id"`
+	Name      string     `json:"name" bson:"name" db:"name"`
+	Prefix    string     `json:"prefix" bson:"prefix" db:"prefix"`
+	Hash      string     `json:"-" bson:"hash" db:"hash"`
+	Roles     []string   `json:"roles" bson:"roles" db:"roles"`
+	CreatedAt time.Time  `json:"createdAt" bson:"created_at" db:"created_at"`
+	RevokedAt *time.Time `json:"revokedAt,omitempty" bson:"revoked_at,omitempty" db:"revoked_at"`
+}
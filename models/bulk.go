@@ -0,0 +1,29 @@
+package models
+
+// Bulk operation kinds.
+const (
+	BulkCreate = "create"
+	BulkUpdate = "update"
+	BulkDelete = "delete"
+)
+
+// Bulk modes: atomic applies every operation or none; best-effort applies
+// each operation that can be applied.
+const (
+	BulkAtomic     = "atomic"
+	BulkBestEffort = "best-effort"
+)
+
+// BulkOperation is one item of a bulk request. Player is required for
+// create and update, ID for update and delete; a player's own id is ignored.
+type BulkOperation struct {
+	Op     string  `json:"op" validate:"oneof=create update delete"`
+	ID     string  `json:"id,omitempty" validate:"required_unless=Op create,excluded_if=Op create"`
+	Player *Player `json:"player,omitempty" validate:"required_unless=Op delete,excluded_if=Op delete"`
+}
+
+// BulkRequest is the body of POST /api/players/bulk.
+type BulkRequest struct {
+	Mode       string          `json:"mode" validate:"omitempty,oneof=atomic best-effort"`
+	Operations []BulkOperation `json:"operations" validate:"required,min=1,max=1000"`
+}
@@ -0,0 +1,22 @@
+package models
+
+import "time"
+
+// IdempotencyRecord is the stored outcome of a request sent with an
+// Idempotency-Key header. Status is 0 while the first request is still
+// being handled, and HeartbeatAt is when it was last known to be running.
+type IdempotencyRecord struct {
+	Key         string    `bson:"_id" db:"key"`
+	Fingerprint string    `bson:"fingerprint" db:"fingerprint"`
+	Status      int       `bson:"status" db:"status"`
+	ContentType string    `bson:"content_type" db:"content_type"`
+	Body        []byte    `bson:"body" db:"body"`
+	CreatedAt   time.Time `bson:"created_at" db:"created_at"`
+	HeartbeatAt time.Time `bson:"heartbeat_at" db:"heartbeat_at"`
+	ExpiresAt   time.Time `bson:"expires_at" db:"expires_at"`
+}
+
+// Pending reports whether the first request has not completed yet.
+func (r *IdempotencyRecord) Pending() bool {
+	return r.Status == 0
+}
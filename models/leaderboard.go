@@ -0,0 +1,28 @@
+package models
+
+// RankedPlayer is a player with its place on the balance leaderboard of
+// its currency.
+// Players with equal balances share a rank, and the next rank is skipped
+// for each of them, so ranks read 1, 2, 2, 4.
+type RankedPlayer struct {
+	Rank int64 `json:"rank"`
+	Player
+}
+
+// Leaderboard is one page of the balance leaderboard of Currency. Total is
+// the number of ranked players.
+type Leaderboard struct {
+	Currency string         `json:"currency" example:"EUR"`
+	Entries  []RankedPlayer `json:"entries"`
+	Page     int            `json:"page"`
+	Size     int            `json:"size"`
+	Total    int64          `json:"total"`
+}
+
+// PlayerRank is a player's place on the balance leaderboard of its
+// currency, out of Total players with balances in that currency.
+type PlayerRank struct {
+	Rank   int64  `json:"rank"`
+	Total  int64  `json:"total"`
+	Player Player `json:"player"`
+}
@@ -0,0 +1,123 @@
+package models
+
+import (
+	"contoso/money"
+	"time"
+)
+
+// Limit kinds: deposits are credits and losses debits made through balance
+// changes.
+const (
+	LimitDeposit = "deposit"
+	LimitLoss    = "loss"
+)
+
+// Limit periods, which are calendar periods in UTC; weeks start on Monday.
+const (
+	PeriodDaily   = "daily"
+	PeriodWeekly  = "weekly"
+	PeriodMonthly = "monthly"
+)
+
+// Limit caps what a player may deposit or lose per period. Lowering a limit
+// takes effect at once; raising or removing it only after a cooling-off
+// period, until which the change is Pending.
+type Limit struct {
+	Kind    string        `json:"kind" example:"deposit"`
+	Period  string        `json:"period" example:"daily"`
+	Amount  money.Money   `json:"amount"`
+	Pending *PendingLimit `json:"pending,omitempty"`
+	// Used is how much was deposited or lost in the current period, which
+	// ends at ResetsAt. Both are only set when listing limits.
+	Used     *money.Money `json:"used,omitempty"`
+	ResetsAt *time.Time   `json:"resetsAt,omitempty"`
+}
+
+// PendingLimit is a raise or, with a nil Amount, a removal of a limit that
+// takes effect at EffectiveAt.
+type PendingLimit struct {
+	Amount      *money.Amount `json:"amount" swaggertype:"string" example:"500.00"`
+	EffectiveAt time.Time     `json:"effectiveAt"`
+}
+
+// LimitInput is the body of set limit requests.
+type LimitInput struct {
+	Amount   money.Amount `json:"amount" swaggertype:"string" example:"250.00" validate:"nonzero,amountgte=0,amountlte=1000000000,places=Currency"`
+	Currency string       `json:"currency" example:"EUR" validate:"required,currency"`
+}
+
+// Money returns the limit amount.
+func (i *LimitInput) Money() money.Money {
+	return money.Money{Amount: i.Amount, Currency: i.Currency}
+}
+
+// LimitKindOf returns the kind of limit that applies to a balance change of
+// amount.
+func LimitKindOf(amount money.Amount) string {
+	if amount.Sign() > 0 {
+		return LimitDeposit
+	}
+	return LimitLoss
+}
+
+// ValidLimit reports whether kind and period name a limit.
+func ValidLimit(kind, period string) bool {
+	return (kind == LimitDeposit || kind == LimitLoss) &&
+		(period == PeriodDaily || period == PeriodWeekly || period == PeriodMonthly)
+}
+
+// PeriodStart returns the start of the period containing t.
+func PeriodStart(period string, t time.Time) time.Time {
+	t = t.UTC()
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	switch period {
+	case PeriodWeekly:
+		return day.AddDate(0, 0, -(int(day.Weekday())+6)%7)
+	case PeriodMonthly:
+		return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+	}
+	return day
+}
+
+// PeriodEnd returns the end of the period containing t.
+func PeriodEnd(period string, t time.Time) time.Time {
+	start := PeriodStart(period, t)
+	switch period {
+	case PeriodWeekly:
+		return start.AddDate(0, 0, 7)
+	case PeriodMonthly:
+		return start.AddDate(0, 1, 0)
+	}
+	return start.AddDate(0, 0, 1)
+}
+
+// Settle applies a pending change whose cooling-off period is over at now,
+// and reports whether the limit still exists.
+func (l *Limit) Settle(now time.Time) bool {
+	if l.Pending == nil || now.Before(l.Pending.EffectiveAt) {
+		return true
+	}
+	pending := l.Pending
+	l.Pending = nil
+	if pending.Amount == nil {
+		return false
+	}
+	l.Amount.Amount = *pending.Amount
+	return true
+}
+
+// Set changes the settled limit to amount: at once if that lowers it, and
+// after coolingOff from now otherwise.
+func (l *Limit) Set(amount money.Amount, now time.Time, coolingOff time.Duration) {
+	if amount.Cmp(l.Amount.Amount) <= 0 {
+		l.Amount.Amount, l.Pending = amount, nil
+		return
+	}
+	l.Pending = &PendingLimit{Amount: &amount, EffectiveAt: now.Add(coolingOff)}
+}
+
+// Remove schedules the removal of the settled limit after coolingOff from
+// now.
+func (l *Limit) Remove(now time.Time, coolingOff time.Duration) {
+	l.Pending = &PendingLimit{EffectiveAt: now.Add(coolingOff)}
+}
@@ -1,8 +1,58 @@
 package models
 
+import "contoso/money"
+
 type Player struct {
-	ID      string  `json:"id" bson:"_id,omitempty" db:"id"`
-	Name    string  `json:"name" bson:"name" db:"name"`
-	Surname string  `json:"surname" bson:"surname" db:"surname"`
-	Balance float64 `json:"balance" bson:"balance" db:"balance"`
+	ID      string `json:"id" bson:"_id,omitempty" db:"id"`
+	Name    string `json:"name" bson:"name" db:"name" validate:"required,max=100,personname"`
+	Surname string `json:"surname" bson:"surname" db:"surname" validate:"required,max=100,personname"`
+	// Balance is a JSON number, with its currency in a separate currency
+	// field; see PlayerV1. It is set when the player is created and then
+	// changed only by balance adjustments and transfers; the value sent
+	// with updates is ignored.
+	Balance money.Money `json:"balance" bson:"balance" db:"balance" swaggertype:"number" example:"12.5" validate:"amountgte=0,amountlte=1000000000"`
+	// Status is changed only through status transitions. New players are
+	// active unless created as pending; otherwise the value sent with
+	// creates and updates is ignored.
+	Status string `json:"status" bson:"status" db:"status" example:"active"`
+}
+
+// PlayerUpdateFields are the fields updates read, and so the only ones they
+// validate: a balance echoed back may be negative after debits.
+var PlayerUpdateFields = []string{"Name", "Surname", "Status"}
+
+// PlayerPatch lists the fields to change in a partial update; nil fields
+// are left untouched.
+type PlayerPatch struct {
+	Name    *string
+	Surname *string
+}
+
+// Diff returns the patch that turns p into updated.
+func (p *Player) Diff(updated *Player) *PlayerPatch {
+	patch := &PlayerPatch{}
+	if updated.Name != p.Name {
+		patch.Name = &updated.Name
+	}
+	if updated.Surname != p.Surname {
+		patch.Surname = &updated.Surname
+	}
+	return patch
+}
+
+// IsEmpty reports whether the patch changes nothing.
+func (p *PlayerPatch) IsEmpty() bool {
+	return p.Name == nil && p.Surname == nil
+}
+
+// BalanceChange credits (positive Amount) or debits (negative Amount) a
+// player's balance, which must be in Currency.
+type BalanceChange struct {
+	Amount   money.Amount `json:"amount" swaggertype:"string" example:"-5.00" validate:"nonzero,amountgte=-1000000000,amountlte=1000000000,places=Currency"`
+	Currency string       `json:"currency" example:"EUR" validate:"required,currency"`
+}
+
+// Money returns the change as an amount of money.
+func (c *BalanceChange) Money() money.Money {
+	return money.Money{Amount: c.Amount, Currency: c.Currency}
 }
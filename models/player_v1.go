@@ -0,0 +1,81 @@
+package models
+
+import (
+	"bytes"
+	"contoso/money"
+	"contoso/validation"
+	"encoding/json"
+	"errors"
+)
+
+// PlayerV1 is the JSON of a Player in API v1, events and export files.
+// Balance is a JSON number, as it was before balances had a currency,
+// which has its own field; numbers sent without one are in
+// money.DefaultCurrency. Balances sent as {"amount", "currency"} objects
+// are accepted too.
+type PlayerV1 struct {
+	ID       string          `json:"id"`
+	Name     string          `json:"name" binding:"required" maxLength:"100"`
+	Surname  string          `json:"surname" binding:"required" maxLength:"100"`
+	Balance  json.RawMessage `json:"balance" swaggertype:"number" example:"12.5"`
+	Currency string          `json:"currency,omitempty" example:"EUR"`
+	Status   string          `json:"status" example:"active"`
+}
+
+func (p *Player) v1() PlayerV1 {
+	return PlayerV1{
+		ID:       p.ID,
+		Name:     p.Name,
+		Surname:  p.Surname,
+		Balance:  json.RawMessage(p.Balance.Fixed()),
+		Currency: p.Balance.Currency,
+		Status:   p.Status,
+	}
+}
+
+// MarshalJSON writes p as a PlayerV1.
+func (p Player) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.v1())
+}
+
+// MarshalJSON writes r as a PlayerV1 with its rank, which the MarshalJSON
+// of the embedded Player would leave out.
+func (r RankedPlayer) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Rank int64 `json:"rank"`
+		PlayerV1
+	}{r.Rank, r.Player.v1()})
+}
+
+// UnmarshalJSON strictly reads a PlayerV1, reporting unknown fields and
+// values of the wrong type as a *validation.Error.
+func (p *Player) UnmarshalJSON(data []byte) error {
+	var v PlayerV1
+	if err := validation.DecodeJSON(data, &v); err != nil {
+		return err
+	}
+	balance := money.Money{Currency: v.Currency}
+	raw := bytes.TrimSpace(v.Balance)
+	switch {
+	case len(raw) == 0 || bytes.Equal(raw, []byte("null")):
+	case raw[0] == '{':
+		if err := validation.DecodeJSON(raw, &balance); err != nil {
+			var verr *validation.Error
+			if errors.As(err, &verr) {
+				for i := range verr.Fields {
+					verr.Fields[i].Field = "balance." + verr.Fields[i].Field
+				}
+			}
+			return err
+		}
+	default:
+		if err := json.Unmarshal(raw, &balance.Amount); err != nil {
+			return &validation.Error{Fields: []validation.FieldError{{Field: "balance", Rule: "type", Message: "must be a number"}}}
+		}
+	}
+	if balance.Currency == "" {
+		balance.Currency = money.DefaultCurrency
+	}
+	*p = Player{ID: v.ID, Name: v.Name, Surname: v.Surname, Balance: balance, Status: v.Status}
+	return nil
+}
@@ -0,0 +1,41 @@
+package models
+
+import "contoso/money"
+
+// PlayerV2 is the player representation of API v2. DisplayName is derived
+// and, like Status, ignored on input. Balance is only read on creates.
+type PlayerV2 struct {
+	ID          string      `json:"id"`
+	FirstName   string      `json:"firstName" validate:"required,max=100,personname"`
+	LastName    string      `json:"lastName" validate:"required,max=100,personname"`
+	DisplayName string      `json:"displayName"`
+	Balance     money.Money `json:"balance" validate:"amountgte=0,amountlte=1000000000"`
+	Status      string      `json:"status" example:"active"`
+}
+
+// PlayerV2UpdateFields are the fields of PlayerV2 that updates read; see
+// PlayerUpdateFields.
+var PlayerV2UpdateFields = []string{"FirstName", "LastName", "Status"}
+
+// NewPlayerV2 converts a stored player to its v2 representation.
+func NewPlayerV2(p *Player) *PlayerV2 {
+	return &PlayerV2{
+		ID:          p.ID,
+		FirstName:   p.Name,
+		LastName:    p.Surname,
+		DisplayName: p.Name + " " + p.Surname,
+		Balance:     p.Balance,
+		Status:      p.Status,
+	}
+}
+
+// Player converts v back to the stored player.
+func (v *PlayerV2) Player() *Player {
+	return &Player{
+		ID:      v.ID,
+		Name:    v.FirstName,
+		Surname: v.LastName,
+		Balance: v.Balance,
+		Status:  v.Status,
+	}
+}
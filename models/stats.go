@@ -0,0 +1,68 @@
+package models
+
+import (
+	"contoso/money"
+	"time"
+)
+
+// PlayerGroupFields maps the groupBy values accepted by the statistics
+// endpoint to the player field, which is named alike in both databases.
+var PlayerGroupFields = map[string]string{
+	"name":    "name",
+	"surname": "surname",
+}
+
+// PlayerStatsQuery selects the statistics to compute.
+type PlayerStatsQuery struct {
+	// Currency selects the players whose balances are in it, as amounts in
+	// different currencies cannot be added up or compared.
+	Currency string
+	// Bounds are the ascending balance bucket boundaries: n bounds make
+	// n+1 buckets, the first and last of them open-ended.
+	Bounds []money.Amount
+	// GroupBy is a PlayerGroupFields key, or empty for no groups.
+	GroupBy string
+	// GroupLimit caps the groups returned, largest first.
+	GroupLimit int
+}
+
+// PlayerStats summarises the players with balances in Currency. Averages are rounded to two decimal
+// places; averages, minimum and maximum are 0 when there are no players.
+type PlayerStats struct {
+	Currency       string             `json:"currency" example:"EUR"`
+	Count          int64              `json:"count"`
+	TotalBalance   money.Amount       `json:"totalBalance" swaggertype:"string"`
+	AverageBalance money.Amount       `json:"averageBalance" swaggertype:"string"`
+	MinBalance     money.Amount       `json:"minBalance" swaggertype:"string"`
+	MaxBalance     money.Amount       `json:"maxBalance" swaggertype:"string"`
+	Buckets        []BalanceBucket    `json:"buckets"`
+	Groups         []PlayerStatsGroup `json:"groups,omitempty"`
+	GeneratedAt    time.Time          `json:"generatedAt"`
+}
+
+// BalanceBucket counts the players with Min <= balance < Max. Min is nil
+// for the first bucket and Max for the last.
+type BalanceBucket struct {
+	Min   *money.Amount `json:"min" swaggertype:"string"`
+	Max   *money.Amount `json:"max" swaggertype:"string"`
+	Count int64         `json:"count"`
+}
+
+// PlayerStatsGroup summarises the players sharing one value of the
+// grouped field.
+type PlayerStatsGroup struct {
+	Key            string       `json:"key"`
+	Count          int64        `json:"count"`
+	TotalBalance   money.Amount `json:"totalBalance" swaggertype:"string"`
+	AverageBalance money.Amount `json:"averageBalance" swaggertype:"string"`
+}
+
+// NewBalanceBuckets returns the empty buckets delimited by bounds.
+func NewBalanceBuckets(bounds []money.Amount) []BalanceBucket {
+	buckets := make([]BalanceBucket, len(bounds)+1)
+	for i := range bounds {
+		buckets[i].Max = &bounds[i]
+		buckets[i+1].Min = &bounds[i]
+	}
+	return buckets
+}
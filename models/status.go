@@ -0,0 +1,66 @@
+package models
+
+// Player account statuses.
+const (
+	StatusPending      = "pending"
+	StatusActive       = "active"
+	StatusSuspended    = "suspended"
+	StatusSelfExcluded = "self_excluded"
+	StatusClosed       = "closed"
+)
+
+// statusTransitions lists the statuses each status may change to. Closed
+// accounts stay closed.
+var statusTransitions = map[string][]string{
+	StatusPending:      {StatusActive, StatusClosed},
+	StatusActive:       {StatusSuspended, StatusSelfExcluded, StatusClosed},
+	StatusSuspended:    {StatusActive, StatusClosed},
+	StatusSelfExcluded: {StatusActive, StatusClosed},
+	StatusClosed:       {},
+}
+
+// InitialStatus returns the status of a player created with status:
+// pending for players awaiting verification, and otherwise active.
+func InitialStatus(status string) string {
+	if status == StatusPending {
+		return StatusPending
+	}
+	return StatusActive
+}
+
+// StatusTransitions returns the statuses a player in status may change to.
+func StatusTransitions(status string) []string {
+	return statusTransitions[status]
+}
+
+// CanTransition reports whether a player may change from status from to
+// status to.
+func CanTransition(from, to string) bool {
+	for _, s := range statusTransitions[from] {
+		if s == to {
+			return true
+		}
+	}
+	return false
+}
+
+// BalanceLockedStatuses are the statuses in which a player's balance may
+// not change.
+var BalanceLockedStatuses = []string{StatusSuspended, StatusClosed}
+
+// BalanceLocked reports whether players in status may not have their
+// balance changed.
+func BalanceLocked(status string) bool {
+	for _, s := range BalanceLockedStatuses {
+		if s == status {
+			return true
+		}
+	}
+	return false
+}
+
+// StatusChange moves a player to Status, recording why.
+type StatusChange struct {
+	Status string `json:"status" example:"suspended" validate:"required,oneof=pending active suspended self_excluded closed"`
+	Reason string `json:"reason" example:"chargeback under review" validate:"required,max=500"`
+}
@@ -0,0 +1,66 @@
+package models
+
+import "testing"
+
+func TestCanTransition(t *testing.T) {
+	tests := []struct {
+		from, to string
+		want     bool
+	}{
+		{StatusPending, StatusActive, true},
+		{StatusPending, StatusClosed, true},
+		{StatusPending, StatusSuspended, false},
+		{StatusActive, StatusSuspended, true},
+		{StatusActive, StatusSelfExcluded, true},
+		{StatusActive, StatusClosed, true},
+		{StatusActive, StatusPending, false},
+		{StatusActive, StatusActive, false},
+		{StatusSuspended, StatusActive, true},
+		{StatusSuspended, StatusSelfExcluded, false},
+		{StatusSelfExcluded, StatusActive, true},
+		{StatusSelfExcluded, StatusClosed, true},
+		{StatusClosed, StatusActive, false},
+		{StatusClosed, StatusClosed, false},
+		{"unknown", StatusActive, false},
+	}
+	for _, tt := range tests {
+		if got := CanTransition(tt.from, tt.to); got != tt.want {
+			t.Errorf("CanTransition(%q, %q) = %v, want %v", tt.from, tt.to, got, tt.want)
+		}
+	}
+}
+
+func TestInitialStatus(t *testing.T) {
+	tests := []struct {
+		in, want string
+	}{
+		{"", StatusActive},
+		{StatusPending, StatusPending},
+		{StatusActive, StatusActive},
+		{StatusSuspended, StatusActive},
+		{StatusClosed, StatusActive},
+	}
+	for _, tt := range tests {
+		if got := InitialStatus(tt.in); got != tt.want {
+			t.Errorf("InitialStatus(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestBalanceLocked(t *testing.T) {
+	tests := []struct {
+		status string
+		want   bool
+	}{
+		{StatusPending, false},
+		{StatusActive, false},
+		{StatusSelfExcluded, false},
+		{StatusSuspended, true},
+		{StatusClosed, true},
+	}
+	for _, tt := range tests {
+		if got := BalanceLocked(tt.status); got != tt.want {
+			t.Errorf("BalanceLocked(%q) = %v, want %v", tt.status, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,48 @@
+package models
+
+import (
+	"contoso/money"
+	"time"
+)
+
+// TransferInput moves Amount in Currency from one player's balance to
+// another's. Both balances must be in Currency. ID is chosen by the client,
+// so a retried request is recognised and not made twice.
+type TransferInput struct {
+	ID           string       `json:"id" example:"3f1c9b52-7a0e-4d5f-9c61-0e2b8d4a7f10" validate:"required,uuid"`
+	FromPlayerID string       `json:"fromPlayerId" example:"42" validate:"required"`
+	ToPlayerID   string       `json:"toPlayerId" example:"43" validate:"required,nefield=FromPlayerID"`
+	Amount       money.Amount `json:"amount" swaggertype:"string" example:"10.00" validate:"nonzero,amountgte=0,amountlte=1000000000,places=Currency"`
+	Currency     string       `json:"currency" example:"EUR" validate:"required,currency"`
+}
+
+// Money returns the transferred amount of money.
+func (t *TransferInput) Money() money.Money {
+	return money.Money{Amount: t.Amount, Currency: t.Currency}
+}
+
+// Transfer is a completed transfer with both players after it, or as they
+// are now when a repeated request returns it again.
+type Transfer struct {
+	ID        string      `json:"id"`
+	Amount    money.Money `json:"amount"`
+	From      Player      `json:"from"`
+	To        Player      `json:"to"`
+	CreatedAt time.Time   `json:"createdAt"`
+}
+
+// TransferLeg is one side of a transfer in a player's history: Amount is
+// negative for the sender and positive for the recipient.
+type TransferLeg struct {
+	TransferID     string      `json:"transferId"`
+	CounterpartyID string      `json:"counterpartyId"`
+	Amount         money.Money `json:"amount"`
+	CreatedAt      time.Time   `json:"createdAt"`
+}
+
+// TransferHistory is one page of a player's transfer legs, newest first.
+type TransferHistory struct {
+	Entries []TransferLeg `json:"entries"`
+	Page    int           `json:"page"`
+	Size    int           `json:"size"`
+}
@@ -0,0 +1,38 @@
+package models
+
+import (
+	"contoso/money"
+	"time"
+)
+
+// Wallet is a player's balance in one currency. The player's own balance
+// is the primary wallet; the others are opened per currency.
+type Wallet struct {
+	Balance money.Money `json:"balance"`
+	Primary bool        `json:"primary"`
+	// OpenedAt is nil for the primary wallet.
+	OpenedAt *time.Time `json:"openedAt,omitempty"`
+}
+
+// OpenWalletInput is the body of open wallet requests.
+type OpenWalletInput struct {
+	Currency string `json:"currency" example:"USD" validate:"required,currency"`
+}
+
+// ConversionInput moves Amount in the From currency into the To wallet at
+// the current exchange rate.
+type ConversionInput struct {
+	From   string       `json:"from" example:"EUR" validate:"required,currency"`
+	To     string       `json:"to" example:"USD" validate:"required,currency,nefield=From"`
+	Amount money.Amount `json:"amount" swaggertype:"string" example:"25.00" validate:"nonzero,amountgte=0,amountlte=1000000000,places=From"`
+}
+
+// Conversion is the outcome of a currency conversion: Debited left the
+// From wallet and Credited, Debited times Rate rounded down, entered To.
+type Conversion struct {
+	Debited  money.Money  `json:"debited"`
+	Credited money.Money  `json:"credited"`
+	Rate     money.Amount `json:"rate" swaggertype:"string" example:"1.0842"`
+	// Wallets are the two wallets after the conversion, From first.
+	Wallets []Wallet `json:"wallets"`
+}
@@ -0,0 +1,78 @@
+package models
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// Webhook is a subscription that receives player events by HTTP POST. An
+// empty Events list subscribes to every event type. Secret signs the
+// payloads and is only shown when the webhook is created.
+type Webhook struct {
+	ID          string    `json:"id" bson:"_id,omitempty" db:"id"`
+	URL         string    `json:"url" bson:"url" db:"url"`
+	Events      []string  `json:"events" bson:"events" db:"events"`
+	Description string    `json:"description" bson:"description" db:"description"`
+	Active      bool      `json:"active" bson:"active" db:"active"`
+	Secret      string    `json:"-" bson:"secret" db:"secret"`
+	CreatedAt   time.Time `json:"createdAt" bson:"created_at" db:"created_at"`
+}
+
+// Subscribes reports whether the webhook wants events of eventType.
+func (w *Webhook) Subscribes(eventType string) bool {
+	if !w.Active {
+		return false
+	}
+	if len(w.Events) == 0 {
+		return true
+	}
+	for _, e := range w.Events {
+		if e == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+// WebhookInput is the body of webhook create and update requests. Active
+// defaults to true.
+type WebhookInput struct {
+	URL         string   `json:"url" validate:"required,max=2048,http_url"`
+	Events      []string `json:"events" validate:"max=6,dive,oneof=player.created player.updated player.deleted player.balance_changed player.currency_converted player.status_changed"`
+	Description string   `json:"description" validate:"max=200"`
+	Active      *bool    `json:"active"`
+}
+
+// Webhook delivery states. A delivery is dead once it has used up its
+// attempts; dead deliveries stay until redelivered or their webhook is
+// deleted.
+const (
+	DeliveryPending   = "pending"
+	DeliverySucceeded = "succeeded"
+	DeliveryDead      = "dead"
+)
+
+// WebhookDelivery is one event queued for one webhook, with the log of
+// every attempt to deliver it.
+type WebhookDelivery struct {
+	ID            string           `json:"id" bson:"_id,omitempty" db:"id"`
+	WebhookID     string           `json:"webhookId" bson:"webhook_id" db:"webhook_id"`
+	EventID       string           `json:"eventId" bson:"event_id" db:"event_id"`
+	EventType     string           `json:"eventType" bson:"event_type" db:"event_type"`
+	Payload       json.RawMessage  `json:"payload" bson:"payload" db:"payload" swaggertype:"object"`
+	Status        string           `json:"status" bson:"status" db:"status"`
+	Attempts      []WebhookAttempt `json:"attempts" bson:"attempts" db:"attempts"`
+	NextAttemptAt *time.Time       `json:"nextAttemptAt,omitempty" bson:"next_attempt_at,omitempty" db:"next_attempt_at"`
+	CreatedAt     time.Time        `json:"createdAt" bson:"created_at" db:"created_at"`
+	UpdatedAt     time.Time        `json:"updatedAt" bson:"updated_at" db:"updated_at"`
+}
+
+// WebhookAttempt records one delivery attempt. StatusCode is 0 when no
+// response was received; Response holds the start of the response body.
+type WebhookAttempt struct {
+	At         time.Time `json:"at" bson:"at"`
+	StatusCode int       `json:"statusCode,omitempty" bson:"status_code,omitempty"`
+	Response   string    `json:"response,omitempty" bson:"response,omitempty"`
+	Error      string    `json:"error,omitempty" bson:"error,omitempty"`
+	DurationMS int64     `json:"durationMs" bson:"duration_ms"`
+}
@@ -0,0 +1,72 @@
+package money
+
+import (
+	"database/sql/driver"
+	"fmt"
+
+	"github.com/shopspring/decimal"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/bsontype"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Value stores a as its decimal string, which Postgres reads into NUMERIC
+// exactly.
+func (a Amount) Value() (driver.Value, error) {
+	return a.String(), nil
+}
+
+// Scan reads a NUMERIC column.
+func (a *Amount) Scan(src interface{}) error {
+	switch v := src.(type) {
+	case []byte:
+		return a.scanString(string(v))
+	case string:
+		return a.scanString(v)
+	case int64:
+		*a = New(v, 0)
+		return nil
+	}
+	return fmt.Errorf("money: cannot scan %T into an amount", src)
+}
+
+func (a *Amount) scanString(s string) error {
+	parsed, err := Parse(s)
+	if err != nil {
+		return err
+	}
+	*a = parsed
+	return nil
+}
+
+// MarshalBSONValue stores a as a Decimal128.
+func (a Amount) MarshalBSONValue() (bsontype.Type, []byte, error) {
+	d, err := primitive.ParseDecimal128(a.String())
+	if err != nil {
+		return 0, nil, fmt.Errorf("money: %s does not fit a Decimal128: %w", a, err)
+	}
+	return bson.MarshalValue(d)
+}
+
+// UnmarshalBSONValue reads a Decimal128 and, for documents written before
+// amounts were decimals, doubles and integers.
+func (a *Amount) UnmarshalBSONValue(t bsontype.Type, data []byte) error {
+	v := bson.RawValue{Type: t, Value: data}
+	switch t {
+	case bsontype.Decimal128:
+		return a.scanString(v.Decimal128().String())
+	case bsontype.Double:
+		*a = Amount{d: decimal.NewFromFloat(v.Double())}
+		return nil
+	case bsontype.Int32:
+		*a = New(int64(v.Int32()), 0)
+		return nil
+	case bsontype.Int64:
+		*a = New(v.Int64(), 0)
+		return nil
+	case bsontype.Null:
+		*a = Amount{}
+		return nil
+	}
+	return fmt.Errorf("money: cannot decode BSON %s into an amount", t)
+}
@@ -0,0 +1,159 @@
+// Package money represents amounts of money exactly. Amounts are decimals
+// rather than floats, so sums never drift by fractions of a cent; they are
+// strings in JSON, NUMERIC in Postgres and Decimal128 in MongoDB.
+package money
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/shopspring/decimal"
+)
+
+// DefaultCurrency is the currency of seeded players, and of imported
+// records and v1 balances that name none.
+const DefaultCurrency = "EUR"
+
+// currencies maps the supported ISO 4217 codes to their decimal places.
+var currencies = map[string]int32{
+	"AUD": 2,
+	"BHD": 3,
+	"CAD": 2,
+	"CHF": 2,
+	"CZK": 2,
+	"DKK": 2,
+	"EUR": 2,
+	"GBP": 2,
+	"HUF": 2,
+	"JPY": 0,
+	"KWD": 3,
+	"NOK": 2,
+	"PLN": 2,
+	"SEK": 2,
+	"USD": 2,
+}
+
+// Places returns the number of decimal places of currency, and false if
+// the currency is not supported.
+func Places(currency string) (int32, bool) {
+	places, ok := currencies[currency]
+	return places, ok
+}
+
+// Amount is an exact decimal amount. The zero value is 0.
+type Amount struct {
+	d decimal.Decimal
+}
+
+// New returns value × 10^exp, so New(1050, -2) is 10.50.
+func New(value int64, exp int32) Amount {
+	return Amount{d: decimal.New(value, exp)}
+}
+
+// maxDigits bounds the digits and exponent of parsed amounts, which keeps
+// them within a Decimal128 and stops "1e999999999" from being expanded.
+const maxDigits = 34
+
+// Parse reads a decimal such as "-12.30". Exponents are accepted, but not
+// surrounding whitespace.
+func Parse(s string) (Amount, error) {
+	if s == "" || strings.TrimSpace(s) != s {
+		return Amount{}, fmt.Errorf("money: invalid amount %q", s)
+	}
+	d, err := decimal.NewFromString(s)
+	if err != nil {
+		return Amount{}, fmt.Errorf("money: invalid amount %q", s)
+	}
+	if d.NumDigits() > maxDigits || d.Exponent() > maxDigits || d.Exponent() < -maxDigits {
+		return Amount{}, fmt.Errorf("money: amount %q has too many digits", s)
+	}
+	return Amount{d: d}, nil
+}
+
+func (a Amount) Add(b Amount) Amount { return Amount{d: a.d.Add(b.d)} }
+func (a Amount) Sub(b Amount) Amount { return Amount{d: a.d.Sub(b.d)} }
+func (a Amount) Neg() Amount         { return Amount{d: a.d.Neg()} }
+
+// Round rounds a half away from zero to places decimal places.
+func (a Amount) Round(places int32) Amount { return Amount{d: a.d.Round(places)} }
+
+// Cmp returns -1, 0 or +1 as a is less than, equal to or greater than b.
+func (a Amount) Cmp(b Amount) int { return a.d.Cmp(b.d) }
+
+// Equal reports whether a and b are the same number, whatever their
+// trailing zeros.
+func (a Amount) Equal(b Amount) bool { return a.d.Equal(b.d) }
+
+// Sign returns -1, 0 or +1 by the sign of a.
+func (a Amount) Sign() int { return a.d.Sign() }
+
+func (a Amount) IsZero() bool { return a.d.IsZero() }
+
+// Places returns the decimal places needed to write a exactly.
+func (a Amount) Places() int32 {
+	_, frac, _ := strings.Cut(a.d.String(), ".")
+	return int32(len(frac))
+}
+
+// String writes a without an exponent or trailing zeros.
+func (a Amount) String() string { return a.d.String() }
+
+// StringFixed writes a with exactly places decimal places, rounding if
+// needed.
+func (a Amount) StringFixed(places int32) string { return a.d.StringFixed(places) }
+
+// MarshalJSON writes a as a string, which JSON clients cannot mistake for
+// a float.
+func (a Amount) MarshalJSON() ([]byte, error) {
+	return json.Marshal(a.String())
+}
+
+// UnmarshalJSON reads a string or, for older clients and documents, a
+// number; numbers are read from their literal digits, never via a float.
+func (a *Amount) UnmarshalJSON(data []byte) error {
+	s := string(data)
+	if s == "null" {
+		return nil
+	}
+	if strings.HasPrefix(s, `"`) {
+		if err := json.Unmarshal(data, &s); err != nil {
+			return err
+		}
+	}
+	parsed, err := Parse(s)
+	if err != nil {
+		return err
+	}
+	*a = parsed
+	return nil
+}
+
+// Money is an amount in a currency.
+type Money struct {
+	Amount   Amount `json:"amount" bson:"amount" swaggertype:"string" example:"12.50" validate:"places=Currency"`
+	Currency string `json:"currency" bson:"currency" example:"EUR" validate:"required,currency"`
+}
+
+// String writes m like "12.50 EUR".
+func (m Money) String() string {
+	return m.Fixed() + " " + m.Currency
+}
+
+// Fixed writes the amount with the currency's decimal places, unless that
+// would round it.
+func (m Money) Fixed() string {
+	if places, ok := Places(m.Currency); ok && m.Amount.Places() <= places {
+		return m.Amount.StringFixed(places)
+	}
+	return m.Amount.String()
+}
+
+// MarshalJSON writes the amount with the currency's decimal places, so
+// 12.5 EUR is {"amount":"12.50","currency":"EUR"}.
+func (m Money) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Amount   string `json:"amount"`
+		Currency string `json:"currency"`
+	}{m.Fixed(), m.Currency})
+}
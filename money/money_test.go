@@ -0,0 +1,119 @@
+package money
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    string
+		wantErr bool
+	}{
+		{in: "12.30", want: "12.3"},
+		{in: "-0.01", want: "-0.01"},
+		{in: "1e3", want: "1000"},
+		{in: "0", want: "0"},
+		{in: "", wantErr: true},
+		{in: " 1", wantErr: true},
+		{in: "1 ", wantErr: true},
+		{in: "abc", wantErr: true},
+		{in: "1e999999999", wantErr: true},
+		{in: "12345678901234567890123456789012345", wantErr: true},
+	}
+	for _, tt := range tests {
+		got, err := Parse(tt.in)
+		if tt.wantErr {
+			if err == nil {
+				t.Errorf("Parse(%q) = %s, want an error", tt.in, got)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("Parse(%q): %v", tt.in, err)
+			continue
+		}
+		if got.String() != tt.want {
+			t.Errorf("Parse(%q) = %s, want %s", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestAmountPlaces(t *testing.T) {
+	tests := []struct {
+		amount Amount
+		want   int32
+	}{
+		{New(1050, -2), 1},
+		{New(1, -3), 3},
+		{New(12, 0), 0},
+		{New(-125, -2), 2},
+	}
+	for _, tt := range tests {
+		if got := tt.amount.Places(); got != tt.want {
+			t.Errorf("%s.Places() = %d, want %d", tt.amount, got, tt.want)
+		}
+	}
+}
+
+func TestMoneyFixed(t *testing.T) {
+	tests := []struct {
+		money Money
+		want  string
+	}{
+		{Money{New(125, -1), "EUR"}, "12.50"},
+		{Money{New(100, 0), "JPY"}, "100"},
+		{Money{New(1, -3), "KWD"}, "0.001"},
+		// More places than the currency has are kept rather than rounded.
+		{Money{New(1005, -3), "EUR"}, "1.005"},
+		{Money{New(125, -1), "XXX"}, "12.5"},
+	}
+	for _, tt := range tests {
+		if got := tt.money.Fixed(); got != tt.want {
+			t.Errorf("%v.Fixed() = %s, want %s", tt.money.Amount, got, tt.want)
+		}
+	}
+}
+
+func TestAmountJSON(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    string
+		wantErr bool
+	}{
+		{in: `"12.50"`, want: "12.5"},
+		{in: `12.5`, want: "12.5"},
+		{in: `0.1`, want: "0.1"},
+		{in: `null`, want: "0"},
+		{in: `"12,50"`, wantErr: true},
+		{in: `true`, wantErr: true},
+	}
+	for _, tt := range tests {
+		var a Amount
+		err := json.Unmarshal([]byte(tt.in), &a)
+		if tt.wantErr {
+			if err == nil {
+				t.Errorf("unmarshal %s = %s, want an error", tt.in, a)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("unmarshal %s: %v", tt.in, err)
+			continue
+		}
+		if a.String() != tt.want {
+			t.Errorf("unmarshal %s = %s, want %s", tt.in, a, tt.want)
+		}
+	}
+}
+
+func TestMoneyMarshalJSON(t *testing.T) {
+	data, err := json.Marshal(Money{New(125, -1), "EUR"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := `{"amount":"12.50","currency":"EUR"}`; string(data) != want {
+		t.Errorf("marshal = %s, want %s", data, want)
+	}
+}
@@ -0,0 +1,89 @@
+package money
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/shopspring/decimal"
+)
+
+// ErrNoRate is returned when the rate table has no rate for a currency.
+var ErrNoRate = errors.New("no exchange rate for the currency")
+
+// rateDivisionPrecision is the decimal places kept when dividing by a rate,
+// well beyond any currency's places before the result is truncated.
+const rateDivisionPrecision = 16
+
+// Rates converts between currencies using how much of each currency one
+// unit of a base currency buys.
+type Rates struct {
+	base  string
+	rates map[string]decimal.Decimal
+}
+
+// LoadRates reads a rate table from a JSON file of the form
+// {"base": "EUR", "rates": {"USD": "1.0842", "JPY": "162.35"}}.
+func LoadRates(path string) (*Rates, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var file struct {
+		Base  string            `json:"base"`
+		Rates map[string]Amount `json:"rates"`
+	}
+	if err := json.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("exchange rates %s: %w", path, err)
+	}
+	if _, ok := Places(file.Base); !ok {
+		return nil, fmt.Errorf("exchange rates %s: unsupported base currency %q", path, file.Base)
+	}
+	r := &Rates{base: file.Base, rates: map[string]decimal.Decimal{file.Base: decimal.NewFromInt(1)}}
+	for currency, rate := range file.Rates {
+		if _, ok := Places(currency); !ok {
+			return nil, fmt.Errorf("exchange rates %s: unsupported currency %q", path, currency)
+		}
+		if rate.Sign() <= 0 {
+			return nil, fmt.Errorf("exchange rates %s: rate for %s must be positive", path, currency)
+		}
+		if currency != file.Base {
+			r.rates[currency] = rate.d
+		}
+	}
+	return r, nil
+}
+
+// Rate returns how much of to one unit of from buys.
+func (r *Rates) Rate(from, to string) (Amount, error) {
+	fromRate, toRate, err := r.pair(from, to)
+	if err != nil {
+		return Amount{}, err
+	}
+	return Amount{d: toRate.DivRound(fromRate, rateDivisionPrecision)}, nil
+}
+
+// Convert returns m in currency to, truncated to the currency's decimal
+// places so that a conversion never creates money.
+func (r *Rates) Convert(m Money, to string) (Money, error) {
+	fromRate, toRate, err := r.pair(m.Currency, to)
+	if err != nil {
+		return Money{}, err
+	}
+	places, _ := Places(to)
+	amount := m.Amount.d.Mul(toRate).DivRound(fromRate, rateDivisionPrecision).Truncate(places)
+	return Money{Amount: Amount{d: amount}, Currency: to}, nil
+}
+
+func (r *Rates) pair(from, to string) (decimal.Decimal, decimal.Decimal, error) {
+	fromRate, ok := r.rates[from]
+	if !ok {
+		return decimal.Decimal{}, decimal.Decimal{}, fmt.Errorf("%w %s", ErrNoRate, from)
+	}
+	toRate, ok := r.rates[to]
+	if !ok {
+		return decimal.Decimal{}, decimal.Decimal{}, fmt.Errorf("%w %s", ErrNoRate, to)
+	}
+	return fromRate, toRate, nil
+}
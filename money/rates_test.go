@@ -0,0 +1,97 @@
+package money
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func loadTestRates(t *testing.T, table string) (*Rates, error) {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "rates.json")
+	if err := os.WriteFile(path, []byte(table), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	return LoadRates(path)
+}
+
+func TestLoadRatesRejects(t *testing.T) {
+	tests := []struct {
+		name  string
+		table string
+	}{
+		{"malformed", `{"base": "EUR", "rates": `},
+		{"unsupported base", `{"base": "XXX", "rates": {}}`},
+		{"unsupported currency", `{"base": "EUR", "rates": {"XXX": "2"}}`},
+		{"zero rate", `{"base": "EUR", "rates": {"USD": "0"}}`},
+		{"negative rate", `{"base": "EUR", "rates": {"USD": "-1.08"}}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := loadTestRates(t, tt.table); err == nil {
+				t.Error("LoadRates succeeded, want an error")
+			}
+		})
+	}
+}
+
+func TestConvert(t *testing.T) {
+	rates, err := loadTestRates(t, `{"base": "EUR", "rates": {"USD": "1.0842", "JPY": "162.35"}}`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		from    Money
+		to      string
+		want    string
+		wantErr error
+	}{
+		{from: Money{New(10, 0), "EUR"}, to: "USD", want: "10.84"},
+		{from: Money{New(100, 0), "USD"}, to: "EUR", want: "92.23"},
+		{from: Money{New(1, 0), "USD"}, to: "JPY", want: "149"},
+		{from: Money{New(1250, -2), "EUR"}, to: "EUR", want: "12.5"},
+		{from: Money{New(1, 0), "EUR"}, to: "GBP", wantErr: ErrNoRate},
+		{from: Money{New(1, 0), "GBP"}, to: "EUR", wantErr: ErrNoRate},
+	}
+	for _, tt := range tests {
+		got, err := rates.Convert(tt.from, tt.to)
+		if tt.wantErr != nil {
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("Convert(%s, %s) error = %v, want %v", tt.from, tt.to, err, tt.wantErr)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("Convert(%s, %s): %v", tt.from, tt.to, err)
+			continue
+		}
+		if got.Currency != tt.to || got.Amount.String() != tt.want {
+			t.Errorf("Convert(%s, %s) = %s, want %s %s", tt.from, tt.to, got, tt.want, tt.to)
+		}
+	}
+}
+
+func TestRate(t *testing.T) {
+	rates, err := loadTestRates(t, `{"base": "EUR", "rates": {"USD": "1.25"}}`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		from, to, want string
+	}{
+		{"EUR", "USD", "1.25"},
+		{"USD", "EUR", "0.8"},
+		{"EUR", "EUR", "1"},
+	}
+	for _, tt := range tests {
+		got, err := rates.Rate(tt.from, tt.to)
+		if err != nil {
+			t.Errorf("Rate(%s, %s): %v", tt.from, tt.to, err)
+			continue
+		}
+		if got.String() != tt.want {
+			t.Errorf("Rate(%s, %s) = %s, want %s", tt.from, tt.to, got, tt.want)
+		}
+	}
+}
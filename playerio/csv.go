@@ -0,0 +1,133 @@
+package playerio
+
+import (
+	"contoso/models"
+	"contoso/money"
+	"contoso/validation"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// csvHeader is the column order written by exports. Imports accept the
+// columns in any order; id is optional and ignored, and currency defaults
+// to money.DefaultCurrency for files exported before it was a column.
+var csvHeader = []string{"id", "name", "surname", "balance", "currency"}
+
+type csvWriter struct {
+	w           *csv.Writer
+	wroteHeader bool
+}
+
+func newCSVWriter(w io.Writer) *csvWriter {
+	return &csvWriter{w: csv.NewWriter(w)}
+}
+
+func (c *csvWriter) writeHeader() error {
+	if c.wroteHeader {
+		return nil
+	}
+	c.wroteHeader = true
+	return c.w.Write(csvHeader)
+}
+
+func (c *csvWriter) Write(player *models.Player) error {
+	if err := c.writeHeader(); err != nil {
+		return err
+	}
+	return c.w.Write([]string{
+		player.ID,
+		player.Name,
+		player.Surname,
+		player.Balance.Amount.String(),
+		player.Balance.Currency,
+	})
+}
+
+func (c *csvWriter) Close() error {
+	if err := c.writeHeader(); err != nil {
+		return err
+	}
+	c.w.Flush()
+	return c.w.Error()
+}
+
+type csvReader struct {
+	r       *csv.Reader
+	columns map[string]int
+}
+
+func newCSVReader(r io.Reader) *csvReader {
+	cr := csv.NewReader(r)
+	cr.TrimLeadingSpace = true
+	cr.ReuseRecord = true
+	return &csvReader{r: cr}
+}
+
+// readHeader maps column names to positions, rejecting unknown and
+// missing columns.
+func (c *csvReader) readHeader() error {
+	header, err := c.r.Read()
+	if err == io.EOF {
+		return errors.New("csv input has no header row")
+	}
+	if err != nil {
+		return err
+	}
+	c.columns = make(map[string]int, len(header))
+	for i, name := range header {
+		name = strings.ToLower(strings.TrimSpace(strings.TrimPrefix(name, "\ufeff")))
+		known := false
+		for _, h := range csvHeader {
+			known = known || name == h
+		}
+		if !known {
+			return fmt.Errorf("unknown csv column %q", name)
+		}
+		c.columns[name] = i
+	}
+	for _, required := range csvHeader[1:4] {
+		if _, ok := c.columns[required]; !ok {
+			return fmt.Errorf("csv column %q is missing", required)
+		}
+	}
+	return nil
+}
+
+func (c *csvReader) Read() (*models.Player, error) {
+	if c.columns == nil {
+		if err := c.readHeader(); err != nil {
+			return nil, err
+		}
+	}
+	record, err := c.r.Read()
+	if err != nil {
+		var perr *csv.ParseError
+		if errors.As(err, &perr) && errors.Is(perr.Err, csv.ErrFieldCount) {
+			return nil, &validation.Error{Fields: []validation.FieldError{{
+				Rule:    "columns",
+				Message: fmt.Sprintf("must have %d columns", len(c.columns)),
+			}}}
+		}
+		return nil, err
+	}
+	p := &models.Player{
+		Name:    record[c.columns["name"]],
+		Surname: record[c.columns["surname"]],
+	}
+	balance := strings.TrimSpace(record[c.columns["balance"]])
+	if p.Balance.Amount, err = money.Parse(balance); err != nil {
+		return nil, &validation.Error{Fields: []validation.FieldError{{
+			Field:   "balance",
+			Rule:    "type",
+			Message: "must be a number",
+		}}}
+	}
+	p.Balance.Currency = money.DefaultCurrency
+	if i, ok := c.columns["currency"]; ok {
+		p.Balance.Currency = strings.TrimSpace(record[i])
+	}
+	return p, nil
+}
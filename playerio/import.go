@@ -0,0 +1,89 @@
+package playerio
+
+import (
+	"contoso/models"
+	"contoso/repository"
+	"contoso/validation"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// ReadAll reads and validates every record of r. Records that fail are
+// left out and reported in the returned *validation.Error, with fields
+// prefixed by their 1-based record number ("rows[3].balance"). The error
+// is only for input that cannot be read further, or more than limit records.
+func ReadAll(r Reader, limit int) ([]models.Player, *validation.Error, error) {
+	var players []models.Player
+	var failures []validation.FieldError
+	for row := 1; ; row++ {
+		player, err := r.Read()
+		if err == io.EOF {
+			break
+		}
+		if row > limit {
+			return nil, nil, fmt.Errorf("more than %d records", limit)
+		}
+		if err == nil {
+			err = validation.Struct(player)
+		}
+		var verr *validation.Error
+		switch {
+		case errors.As(err, &verr):
+			for _, f := range verr.Fields {
+				f.Field = rowField(row, f.Field)
+				failures = append(failures, f)
+			}
+			continue
+		case err != nil:
+			return nil, nil, fmt.Errorf("record %d: %w", row, err)
+		}
+		players = append(players, *player)
+	}
+	if len(failures) > 0 {
+		return players, &validation.Error{Fields: failures}, nil
+	}
+	return players, nil, nil
+}
+
+func rowField(row int, field string) string {
+	if field == "" {
+		return fmt.Sprintf("rows[%d]", row)
+	}
+	return fmt.Sprintf("rows[%d].%s", row, field)
+}
+
+// importBatch is the number of players created per bulk write.
+const importBatch = 1000
+
+// ImportResult summarises an Import.
+type ImportResult struct {
+	Imported int `json:"imported"`
+	// FailedRows lists the 1-based record numbers that could not be stored.
+	FailedRows []int `json:"failedRows,omitempty"`
+}
+
+// Import creates players in best-effort batches. It is not atomic: when
+// err is returned, the batches before the failing one have been stored.
+func Import(repo repository.PlayerRepository, players []models.Player) (*ImportResult, error) {
+	result := &ImportResult{}
+	for start := 0; start < len(players); start += importBatch {
+		end := min(start+importBatch, len(players))
+		ops := make([]models.BulkOperation, 0, end-start)
+		for i := start; i < end; i++ {
+			ops = append(ops, models.BulkOperation{Op: models.BulkCreate, Player: &players[i]})
+		}
+		items, err := repo.BulkWrite(ops, false)
+		if err != nil {
+			return result, err
+		}
+		for n, item := range items {
+			if item.Err != nil {
+				result.FailedRows = append(result.FailedRows, start+n+1)
+				continue
+			}
+			result.Imported++
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,116 @@
+package playerio
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+)
+
+// JournalEntry maps a source player to the player copied from it, whose
+// contents had Checksum when copied. Checksum is empty while the copy is
+// being written.
+type JournalEntry struct {
+	Source   string `json:"source"`
+	Target   string `json:"target"`
+	Checksum string `json:"checksum"`
+}
+
+// Journal is the append-only NDJSON record of a migration, which makes it
+// resumable: players already in it are not copied again. Entries are synced
+// to disk as they are recorded, so one naming a target ID survives a crash
+// of the copy it was recorded for.
+type Journal struct {
+	file    *os.File
+	entries map[string]JournalEntry
+	targets map[string]string
+}
+
+// OpenJournal opens or creates the journal at path. A last line left
+// incomplete by an interrupted run is discarded.
+func OpenJournal(path string) (*Journal, error) {
+	file, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE, 0o644)
+	if err != nil {
+		return nil, err
+	}
+	j := &Journal{file: file, entries: map[string]JournalEntry{}, targets: map[string]string{}}
+	if err := j.load(); err != nil {
+		file.Close()
+		return nil, fmt.Errorf("journal %s: %w", path, err)
+	}
+	return j, nil
+}
+
+func (j *Journal) load() error {
+	r := bufio.NewReader(j.file)
+	var offset int64
+	for line := 1; ; line++ {
+		data, err := r.ReadBytes('\n')
+		if err == io.EOF {
+			// Whatever follows the last newline was never completely written.
+			if err := j.file.Truncate(offset); err != nil {
+				return err
+			}
+			_, err = j.file.Seek(offset, io.SeekStart)
+			return err
+		}
+		if err != nil {
+			return err
+		}
+		offset += int64(len(data))
+		if len(bytes.TrimSpace(data)) == 0 {
+			continue
+		}
+		var e JournalEntry
+		if err := json.Unmarshal(data, &e); err != nil {
+			return fmt.Errorf("line %d: %w", line, err)
+		}
+		j.add(e)
+	}
+}
+
+func (j *Journal) add(e JournalEntry) {
+	// Later entries for a source player replace earlier ones.
+	j.entries[e.Source] = e
+	j.targets[e.Target] = e.Source
+}
+
+// Lookup returns the entry for a source player.
+func (j *Journal) Lookup(source string) (JournalEntry, bool) {
+	e, ok := j.entries[source]
+	return e, ok
+}
+
+// Source returns the source player a target player was copied from.
+func (j *Journal) Source(target string) (string, bool) {
+	s, ok := j.targets[target]
+	return s, ok
+}
+
+// Len returns the number of source players in the journal.
+func (j *Journal) Len() int {
+	return len(j.entries)
+}
+
+// Record appends e to the journal and syncs it to disk.
+func (j *Journal) Record(e JournalEntry) error {
+	data, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+	if _, err := j.file.Write(append(data, '\n')); err != nil {
+		return err
+	}
+	if err := j.file.Sync(); err != nil {
+		return err
+	}
+	j.add(e)
+	return nil
+}
+
+// Close closes the journal file.
+func (j *Journal) Close() error {
+	return j.file.Close()
+}
@@ -0,0 +1,181 @@
+package playerio
+
+import (
+	"contoso/models"
+	"contoso/money"
+	"contoso/repository"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sort"
+)
+
+// Checksum returns a digest of the player's name, surname, balance and
+// status, which is equal for copies of a player in either database.
+func Checksum(p *models.Player) string {
+	h := sha256.New()
+	// Amounts are compared normalised, as Postgres pads them to four
+	// decimal places and MongoDB does not.
+	for _, field := range []string{p.Name, p.Surname, p.Balance.Amount.String(), p.Balance.Currency, p.Status} {
+		h.Write([]byte(field))
+		h.Write([]byte{0})
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// MigrationResult summarises a Migrate.
+type MigrationResult struct {
+	Created   int `json:"created"`
+	Updated   int `json:"updated"`
+	Unchanged int `json:"unchanged"`
+}
+
+// Migrate copies every player of src into dst, recording each copy in j.
+// Copies get IDs reserved in dst, so j is also the mapping between the two.
+// Each copy is journalled with its target ID before it is written and with
+// its checksum after, so an interrupted copy is written again to the same
+// ID rather than duplicated. Players j already holds are skipped, or written
+// again if they changed since they were copied, so an interrupted migration
+// is resumed by running it again. progress, if not nil, is called after
+// every player.
+func Migrate(src repository.PlayerRepository, dst repository.PlayerCopier, j *Journal, progress func(*MigrationResult)) (*MigrationResult, error) {
+	result := &MigrationResult{}
+	err := src.StreamPlayers(func(player *models.Player) error {
+		sum := Checksum(player)
+		e, copied := j.Lookup(player.ID)
+		if copied && e.Checksum == sum {
+			result.Unchanged++
+		} else {
+			if !copied {
+				id, err := dst.NewPlayerID()
+				if err != nil {
+					return fmt.Errorf("copying player %s: %w", player.ID, err)
+				}
+				e = JournalEntry{Source: player.ID, Target: id}
+				if err := j.Record(e); err != nil {
+					return err
+				}
+			}
+			// An entry without a checksum is a copy an earlier run was
+			// interrupted in.
+			if e.Checksum == "" {
+				result.Created++
+			} else {
+				result.Updated++
+			}
+			target := *player
+			target.ID = e.Target
+			if err := dst.PutPlayer(&target); err != nil {
+				return fmt.Errorf("copying player %s to %s: %w", player.ID, e.Target, err)
+			}
+			e.Checksum = sum
+			if err := j.Record(e); err != nil {
+				return err
+			}
+		}
+		if progress != nil {
+			progress(result)
+		}
+		return nil
+	})
+	return result, err
+}
+
+// VerificationReport compares the source and target of a migration.
+type VerificationReport struct {
+	SourcePlayers int `json:"sourcePlayers"`
+	TargetPlayers int `json:"targetPlayers"`
+	Matched       int `json:"matched"`
+	// Missing lists source players that were not copied or whose copy is
+	// gone, and Mismatched those whose copy differs. Orphaned lists target
+	// players copied from source players deleted since, and Unmapped those
+	// that are no copy at all.
+	Missing    []string `json:"missing,omitempty"`
+	Mismatched []string `json:"mismatched,omitempty"`
+	Orphaned   []string `json:"orphaned,omitempty"`
+	Unmapped   []string `json:"unmapped,omitempty"`
+	// SourceTotals and TargetTotals hold the sum of the balances per
+	// currency of every player in either database.
+	SourceTotals []money.Money `json:"sourceTotals"`
+	TargetTotals []money.Money `json:"targetTotals"`
+}
+
+// OK reports whether every source player has an identical copy, the target
+// holds nothing else and the balance totals agree.
+func (r *VerificationReport) OK() bool {
+	if len(r.Missing) > 0 || len(r.Mismatched) > 0 || len(r.Orphaned) > 0 || len(r.Unmapped) > 0 ||
+		len(r.SourceTotals) != len(r.TargetTotals) {
+		return false
+	}
+	for i, total := range r.SourceTotals {
+		if total.Currency != r.TargetTotals[i].Currency || !total.Amount.Equal(r.TargetTotals[i].Amount) {
+			return false
+		}
+	}
+	return true
+}
+
+// Verify checks every player of src against its copy in dst, found through
+// j, and lists the players of dst that j does not map to.
+func Verify(src, dst repository.PlayerRepository, j *Journal) (*VerificationReport, error) {
+	report := &VerificationReport{}
+	sourceTotals := map[string]money.Amount{}
+	seen := map[string]bool{}
+	err := src.StreamPlayers(func(player *models.Player) error {
+		report.SourcePlayers++
+		seen[player.ID] = true
+		sourceTotals[player.Balance.Currency] = sourceTotals[player.Balance.Currency].Add(player.Balance.Amount)
+		e, ok := j.Lookup(player.ID)
+		if !ok {
+			report.Missing = append(report.Missing, player.ID)
+			return nil
+		}
+		target, err := dst.GetPlayer(e.Target)
+		if errors.Is(err, repository.ErrPlayerNotFound) {
+			report.Missing = append(report.Missing, player.ID)
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if Checksum(target) != Checksum(player) {
+			report.Mismatched = append(report.Mismatched, player.ID)
+			return nil
+		}
+		report.Matched++
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	targetTotals := map[string]money.Amount{}
+	err = dst.StreamPlayers(func(player *models.Player) error {
+		report.TargetPlayers++
+		targetTotals[player.Balance.Currency] = targetTotals[player.Balance.Currency].Add(player.Balance.Amount)
+		source, ok := j.Source(player.ID)
+		if e, _ := j.Lookup(source); !ok || e.Target != player.ID {
+			report.Unmapped = append(report.Unmapped, player.ID)
+			return nil
+		}
+		if !seen[source] {
+			report.Orphaned = append(report.Orphaned, player.ID)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	report.SourceTotals = sortedTotals(sourceTotals)
+	report.TargetTotals = sortedTotals(targetTotals)
+	return report, nil
+}
+
+func sortedTotals(totals map[string]money.Amount) []money.Money {
+	list := make([]money.Money, 0, len(totals))
+	for currency, amount := range totals {
+		list = append(list, money.Money{Amount: amount, Currency: currency})
+	}
+	sort.Slice(list, func(i, k int) bool { return list[i].Currency < list[k].Currency })
+	return list
+}
@@ -0,0 +1,297 @@
+package playerio
+
+import (
+	"contoso/models"
+	"contoso/money"
+	"contoso/repository"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+// memPlayers is an in-memory player database. Only the methods migrations
+// use are implemented; the others panic through the nil embedded interface.
+type memPlayers struct {
+	repository.PlayerRepository
+	players map[string]models.Player
+	nextID  int
+	// failPut makes PutPlayer fail for players with this name.
+	failPut string
+}
+
+func newMemPlayers(players ...models.Player) *memPlayers {
+	m := &memPlayers{players: map[string]models.Player{}}
+	for _, p := range players {
+		m.players[p.ID] = p
+	}
+	return m
+}
+
+func (m *memPlayers) StreamPlayers(fn func(player *models.Player) error) error {
+	ids := make([]string, 0, len(m.players))
+	for id := range m.players {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	for _, id := range ids {
+		p := m.players[id]
+		if err := fn(&p); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *memPlayers) GetPlayer(id string) (*models.Player, error) {
+	p, ok := m.players[id]
+	if !ok {
+		return nil, repository.ErrPlayerNotFound
+	}
+	return &p, nil
+}
+
+func (m *memPlayers) NewPlayerID() (string, error) {
+	m.nextID++
+	return fmt.Sprintf("t%d", m.nextID), nil
+}
+
+func (m *memPlayers) PutPlayer(player *models.Player) error {
+	if player.Name == m.failPut {
+		return errors.New("write failed")
+	}
+	m.players[player.ID] = *player
+	return nil
+}
+
+func player(id, name string, balance int64, status string) models.Player {
+	return models.Player{
+		ID: id, Name: name, Surname: "Smith", Status: status,
+		Balance: money.Money{Amount: money.New(balance, -2), Currency: "EUR"},
+	}
+}
+
+func openTestJournal(t *testing.T, path string) *Journal {
+	t.Helper()
+	j, err := OpenJournal(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { j.Close() })
+	return j
+}
+
+func migrate(t *testing.T, src *memPlayers, dst *memPlayers, j *Journal) MigrationResult {
+	t.Helper()
+	result, err := Migrate(src, dst, j, nil)
+	if err != nil {
+		t.Fatalf("Migrate: %v", err)
+	}
+	return *result
+}
+
+func TestMigrate(t *testing.T) {
+	src := newMemPlayers(
+		player("1", "Anna", 1250, models.StatusActive),
+		player("2", "Ben", 0, models.StatusPending),
+		player("3", "Cleo", 990, models.StatusSuspended),
+	)
+	dst := newMemPlayers()
+	j := openTestJournal(t, filepath.Join(t.TempDir(), "journal.ndjson"))
+
+	if got, want := migrate(t, src, dst, j), (MigrationResult{Created: 3}); got != want {
+		t.Fatalf("first run = %+v, want %+v", got, want)
+	}
+	for _, p := range src.players {
+		e, ok := j.Lookup(p.ID)
+		if !ok || e.Checksum != Checksum(&p) {
+			t.Fatalf("journal entry for %s = %+v, want its checksum", p.ID, e)
+		}
+		copied, err := dst.GetPlayer(e.Target)
+		if err != nil {
+			t.Fatal(err)
+		}
+		// Statuses are copied as they are, whatever the transitions allow.
+		if Checksum(copied) != Checksum(&p) || copied.Status != p.Status {
+			t.Errorf("copy of %s = %+v, want %+v", p.ID, copied, p)
+		}
+	}
+
+	if got, want := migrate(t, src, dst, j), (MigrationResult{Unchanged: 3}); got != want {
+		t.Errorf("second run = %+v, want %+v", got, want)
+	}
+
+	src.players["2"] = player("2", "Ben", 500, models.StatusActive)
+	if got, want := migrate(t, src, dst, j), (MigrationResult{Updated: 1, Unchanged: 2}); got != want {
+		t.Errorf("run after a change = %+v, want %+v", got, want)
+	}
+	if len(dst.players) != 3 {
+		t.Errorf("target holds %d players, want 3", len(dst.players))
+	}
+	report, err := Verify(src, dst, j)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !report.OK() || report.Matched != 3 {
+		t.Errorf("report = %+v, want 3 matched players", report)
+	}
+}
+
+func TestMigrateResumesInterruptedCopy(t *testing.T) {
+	src := newMemPlayers(
+		player("1", "Anna", 1250, models.StatusActive),
+		player("2", "Ben", 300, models.StatusActive),
+		player("3", "Cleo", 990, models.StatusActive),
+	)
+	dst := newMemPlayers()
+	dst.failPut = "Ben"
+	path := filepath.Join(t.TempDir(), "journal.ndjson")
+	j, err := OpenJournal(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Migrate(src, dst, j, nil); err == nil {
+		t.Fatal("Migrate succeeded, want the failed write")
+	}
+	j.Close()
+
+	// The target ID was journalled before the write that failed.
+	j = openTestJournal(t, path)
+	interrupted, ok := j.Lookup("2")
+	if !ok || interrupted.Target == "" || interrupted.Checksum != "" {
+		t.Fatalf("journal entry for the interrupted copy = %+v, %v", interrupted, ok)
+	}
+
+	dst.failPut = ""
+	if got, want := migrate(t, src, dst, j), (MigrationResult{Created: 2, Unchanged: 1}); got != want {
+		t.Errorf("resumed run = %+v, want %+v", got, want)
+	}
+	if e, _ := j.Lookup("2"); e.Target != interrupted.Target {
+		t.Errorf("resumed copy went to %s, want the journalled %s", e.Target, interrupted.Target)
+	}
+	if len(dst.players) != 3 {
+		t.Errorf("target holds %d players, want 3", len(dst.players))
+	}
+}
+
+func TestVerify(t *testing.T) {
+	eur := func(value int64) []money.Money {
+		return []money.Money{{Amount: money.New(value, -2), Currency: "EUR"}}
+	}
+	tests := []struct {
+		name string
+		// change alters a completed migration of players 1 to 3.
+		change func(src, dst *memPlayers)
+		want   VerificationReport
+		wantOK bool
+	}{
+		{
+			name:   "complete",
+			change: func(src, dst *memPlayers) {},
+			want:   VerificationReport{SourcePlayers: 3, TargetPlayers: 3, Matched: 3, SourceTotals: eur(600), TargetTotals: eur(600)},
+			wantOK: true,
+		},
+		{
+			name: "source player added",
+			change: func(src, dst *memPlayers) {
+				src.players["4"] = player("4", "Dora", 0, models.StatusActive)
+			},
+			want: VerificationReport{
+				SourcePlayers: 4, TargetPlayers: 3, Matched: 3, Missing: []string{"4"},
+				SourceTotals: eur(600), TargetTotals: eur(600),
+			},
+		},
+		{
+			name: "copy deleted",
+			change: func(src, dst *memPlayers) {
+				delete(dst.players, "t1")
+			},
+			want: VerificationReport{
+				SourcePlayers: 3, TargetPlayers: 2, Matched: 2, Missing: []string{"1"},
+				SourceTotals: eur(600), TargetTotals: eur(500),
+			},
+		},
+		{
+			name: "copy changed",
+			change: func(src, dst *memPlayers) {
+				dst.players["t2"] = player("t2", "Ben", 250, models.StatusActive)
+			},
+			want: VerificationReport{
+				SourcePlayers: 3, TargetPlayers: 3, Matched: 2, Mismatched: []string{"2"},
+				SourceTotals: eur(600), TargetTotals: eur(650),
+			},
+		},
+		{
+			name: "source player deleted",
+			change: func(src, dst *memPlayers) {
+				delete(src.players, "3")
+			},
+			want: VerificationReport{
+				SourcePlayers: 2, TargetPlayers: 3, Matched: 2, Orphaned: []string{"t3"},
+				SourceTotals: eur(300), TargetTotals: eur(600),
+			},
+		},
+		{
+			name: "target player that is no copy",
+			change: func(src, dst *memPlayers) {
+				dst.players["x"] = player("x", "Eve", 0, models.StatusActive)
+			},
+			want: VerificationReport{
+				SourcePlayers: 3, TargetPlayers: 4, Matched: 3, Unmapped: []string{"x"},
+				SourceTotals: eur(600), TargetTotals: eur(600),
+			},
+		},
+		{
+			// Totals cover the whole target, unmapped players included.
+			name: "unmapped balance",
+			change: func(src, dst *memPlayers) {
+				dst.players["x"] = player("x", "Eve", 100, models.StatusActive)
+			},
+			want: VerificationReport{
+				SourcePlayers: 3, TargetPlayers: 4, Matched: 3, Unmapped: []string{"x"},
+				SourceTotals: eur(600), TargetTotals: eur(700),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			src := newMemPlayers(
+				player("1", "Anna", 100, models.StatusActive),
+				player("2", "Ben", 200, models.StatusActive),
+				player("3", "Cleo", 300, models.StatusClosed),
+			)
+			dst := newMemPlayers()
+			j := openTestJournal(t, filepath.Join(t.TempDir(), "journal.ndjson"))
+			migrate(t, src, dst, j)
+			tt.change(src, dst)
+
+			got, err := Verify(src, dst, j)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(totalStrings(got.SourceTotals), totalStrings(tt.want.SourceTotals)) ||
+				!reflect.DeepEqual(totalStrings(got.TargetTotals), totalStrings(tt.want.TargetTotals)) {
+				t.Errorf("totals = %v and %v, want %v and %v", got.SourceTotals, got.TargetTotals, tt.want.SourceTotals, tt.want.TargetTotals)
+			}
+			got.SourceTotals, got.TargetTotals = nil, nil
+			want := tt.want
+			want.SourceTotals, want.TargetTotals = nil, nil
+			if !reflect.DeepEqual(*got, want) {
+				t.Errorf("report = %+v, want %+v", *got, want)
+			}
+			if got, _ := Verify(src, dst, j); got.OK() != tt.wantOK {
+				t.Errorf("OK() = %v, want %v", got.OK(), tt.wantOK)
+			}
+		})
+	}
+}
+
+func totalStrings(totals []money.Money) []string {
+	s := make([]string, len(totals))
+	for i, t := range totals {
+		s[i] = t.String()
+	}
+	return s
+}
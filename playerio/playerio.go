@@ -0,0 +1,218 @@
+// Package playerio reads and writes players in the file formats used for export and import.
+package playerio
+
+import (
+	"bufio"
+	"bytes"
+	"contoso/models"
+	"contoso/validation"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+)
+
+// Format identifies a player file format.
+type Format string
+
+const (
+	JSON   Format = "json"
+	NDJSON Format = "ndjson"
+	CSV    Format = "csv"
+)
+
+// ParseFormat validates a format name.
+func ParseFormat(s string) (Format, error) {
+	switch f := Format(strings.ToLower(s)); f {
+	case JSON, NDJSON, CSV:
+		return f, nil
+	}
+	return "", fmt.Errorf("unsupported format %q", s)
+}
+
+// ContentType returns the media type of f.
+func (f Format) ContentType() string {
+	switch f {
+	case NDJSON:
+		return "application/x-ndjson"
+	case CSV:
+		return "text/csv; charset=utf-8"
+	}
+	return "application/json"
+}
+
+// FormatFromContentType maps a request media type to a format.
+func FormatFromContentType(contentType string) (Format, bool) {
+	mediaType, _, _ := strings.Cut(contentType, ";")
+	switch strings.ToLower(strings.TrimSpace(mediaType)) {
+	case "application/json":
+		return JSON, true
+	case "application/x-ndjson", "application/ndjson":
+		return NDJSON, true
+	case "text/csv":
+		return CSV, true
+	}
+	return "", false
+}
+
+// FormatFromPath guesses the format from a file extension, defaulting to JSON.
+func FormatFromPath(path string) Format {
+	if f, err := ParseFormat(strings.TrimPrefix(filepath.Ext(path), ".")); err == nil {
+		return f
+	}
+	return JSON
+}
+
+// Writer writes players one at a time.
+type Writer interface {
+	Write(player *models.Player) error
+	// Close terminates the output; it does not close the underlying writer.
+	Close() error
+}
+
+// Reader reads players one at a time, returning io.EOF when exhausted.
+// A record that cannot be decoded is reported as a *validation.Error and
+// reading may continue with the next record; any other error is fatal.
+type Reader interface {
+	Read() (*models.Player, error)
+}
+
+// NewWriter returns a Writer producing the given format.
+func NewWriter(w io.Writer, f Format) (Writer, error) {
+	switch f {
+	case JSON:
+		return &jsonWriter{w: bufio.NewWriter(w)}, nil
+	case NDJSON:
+		bw := bufio.NewWriter(w)
+		return &ndjsonWriter{w: bw, enc: json.NewEncoder(bw)}, nil
+	case CSV:
+		return newCSVWriter(w), nil
+	}
+	return nil, fmt.Errorf("unsupported format %q", f)
+}
+
+// NewReader returns a Reader parsing the given format.
+func NewReader(r io.Reader, f Format) (Reader, error) {
+	switch f {
+	case JSON:
+		return &jsonReader{dec: json.NewDecoder(r)}, nil
+	case NDJSON:
+		sc := bufio.NewScanner(r)
+		sc.Buffer(make([]byte, 0, 64*1024), maxRecordSize)
+		return &ndjsonReader{sc: sc}, nil
+	case CSV:
+		return newCSVReader(r), nil
+	}
+	return nil, fmt.Errorf("unsupported format %q", f)
+}
+
+// jsonWriter streams a JSON array without holding it in memory.
+type jsonWriter struct {
+	w     *bufio.Writer
+	count int
+}
+
+func (j *jsonWriter) Write(player *models.Player) error {
+	b, err := json.Marshal(player)
+	if err != nil {
+		return err
+	}
+	sep := ",\n  "
+	if j.count == 0 {
+		sep = "[\n  "
+	}
+	j.count++
+	if _, err := j.w.WriteString(sep); err != nil {
+		return err
+	}
+	_, err = j.w.Write(b)
+	return err
+}
+
+func (j *jsonWriter) Close() error {
+	end := "\n]\n"
+	if j.count == 0 {
+		end = "[]\n"
+	}
+	if _, err := j.w.WriteString(end); err != nil {
+		return err
+	}
+	return j.w.Flush()
+}
+
+type ndjsonWriter struct {
+	w   *bufio.Writer
+	enc *json.Encoder
+}
+
+func (n *ndjsonWriter) Write(player *models.Player) error {
+	return n.enc.Encode(player)
+}
+
+func (n *ndjsonWriter) Close() error {
+	return n.w.Flush()
+}
+
+// jsonReader walks a JSON array element by element.
+type jsonReader struct {
+	dec     *json.Decoder
+	started bool
+}
+
+func (j *jsonReader) Read() (*models.Player, error) {
+	if !j.started {
+		tok, err := j.dec.Token()
+		if err != nil {
+			return nil, err
+		}
+		if d, ok := tok.(json.Delim); !ok || d != '[' {
+			return nil, errors.New("expected a JSON array of players")
+		}
+		j.started = true
+	}
+	if !j.dec.More() {
+		return nil, io.EOF
+	}
+	var raw json.RawMessage
+	if err := j.dec.Decode(&raw); err != nil {
+		return nil, err
+	}
+	return decodeRecord(raw)
+}
+
+// maxRecordSize bounds a single NDJSON line.
+const maxRecordSize = 1 << 20
+
+type ndjsonReader struct {
+	sc *bufio.Scanner
+}
+
+func (n *ndjsonReader) Read() (*models.Player, error) {
+	for n.sc.Scan() {
+		line := bytes.TrimSpace(n.sc.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+		return decodeRecord(line)
+	}
+	if err := n.sc.Err(); err != nil {
+		return nil, err
+	}
+	return nil, io.EOF
+}
+
+// decodeRecord strictly decodes one JSON player. Malformed JSON is
+// reported as a *validation.Error so the record can be skipped.
+func decodeRecord(data []byte) (*models.Player, error) {
+	var p models.Player
+	if err := validation.DecodeJSON(data, &p); err != nil {
+		var verr *validation.Error
+		if !errors.As(err, &verr) {
+			verr = &validation.Error{Fields: []validation.FieldError{{Field: "", Rule: "syntax", Message: err.Error()}}}
+		}
+		return nil, verr
+	}
+	return &p, nil
+}
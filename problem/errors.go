@@ -0,0 +1,93 @@
+package problem
+
+import (
+	"contoso/repository"
+	"contoso/validation"
+	"errors"
+	"net/http"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// FromError maps an error to a problem. Known domain errors keep their
+// message; anything else becomes a generic 500 so driver and internal
+// messages never reach clients. internal reports whether err was unknown
+// and should be logged by the caller.
+func FromError(err error) (p *Details, internal bool) {
+	var (
+		details *Details
+		verr    *validation.Error
+		ferr    *fiber.Error
+		lerr    *repository.LimitExceededError
+	)
+	switch {
+	case errors.As(err, &details):
+		return details, false
+	case errors.As(err, &verr):
+		p := New(http.StatusUnprocessableEntity, CodeValidationFailed, "one or more fields are invalid")
+		p.Errors = verr.Fields
+		return p, false
+	case errors.Is(err, repository.ErrPlayerNotFound):
+		return New(http.StatusNotFound, CodePlayerNotFound, err.Error()), false
+	case errors.Is(err, repository.ErrAPIKeyNotFound):
+		return New(http.StatusNotFound, CodeAPIKeyNotFound, err.Error()), false
+	case errors.Is(err, repository.ErrWebhookNotFound):
+		return New(http.StatusNotFound, CodeWebhookNotFound, err.Error()), false
+	case errors.Is(err, repository.ErrDeliveryNotFound):
+		return New(http.StatusNotFound, CodeDeliveryNotFound, err.Error()), false
+	case errors.Is(err, repository.ErrWalletNotFound):
+		return New(http.StatusNotFound, CodeWalletNotFound, err.Error()), false
+	case errors.Is(err, repository.ErrWalletExists):
+		return New(http.StatusConflict, CodeWalletExists, err.Error()), false
+	case errors.Is(err, repository.ErrInsufficientFunds):
+		return New(http.StatusUnprocessableEntity, CodeInsufficientFunds, err.Error()), false
+	case errors.Is(err, repository.ErrBalanceLocked):
+		return New(http.StatusConflict, CodeBalanceLocked, err.Error()), false
+	case errors.Is(err, repository.ErrInvalidStatusTransition):
+		return New(http.StatusConflict, CodeInvalidTransition, err.Error()), false
+	case errors.Is(err, repository.ErrLimitNotFound):
+		return New(http.StatusNotFound, CodeLimitNotFound, err.Error()), false
+	case errors.As(err, &lerr):
+		return New(http.StatusUnprocessableEntity, CodeLimitExceeded, err.Error()), false
+	case errors.Is(err, repository.ErrPlayerChanged):
+		return New(http.StatusConflict, CodePlayerChanged, err.Error()), false
+	case errors.Is(err, repository.ErrTransferIDReused):
+		return New(http.StatusConflict, CodeTransferIDReused, err.Error()), false
+	case errors.Is(err, repository.ErrCurrencyMismatch):
+		return New(http.StatusUnprocessableEntity, CodeCurrencyMismatch, err.Error()), false
+	case errors.Is(err, repository.ErrInvalidID):
+		return New(http.StatusBadRequest, CodeInvalidID, err.Error()), false
+	case errors.Is(err, repository.ErrDuplicateBulkID):
+		return New(http.StatusConflict, CodeDuplicateTarget, err.Error()), false
+	case errors.Is(err, repository.ErrNotExecuted):
+		return New(http.StatusFailedDependency, CodeNotExecuted, err.Error()), false
+	case errors.Is(err, repository.ErrTransactionsUnsupported):
+		return New(http.StatusNotImplemented, CodeTxUnsupported, err.Error()), false
+	case errors.As(err, &ferr):
+		return fromFiberError(ferr), ferr.Code >= http.StatusInternalServerError
+	}
+	return New(http.StatusInternalServerError, CodeInternal, "an unexpected error occurred"), true
+}
+
+func fromFiberError(err *fiber.Error) *Details {
+	code := CodeInternal
+	switch err.Code {
+	case http.StatusNotFound:
+		code = CodeNotFound
+	case http.StatusMethodNotAllowed:
+		code = CodeMethodNotAllowed
+	case http.StatusRequestEntityTooLarge:
+		code = CodeRequestEntityTooBig
+	case http.StatusUnsupportedMediaType:
+		code = CodeUnsupportedMedia
+	case http.StatusBadRequest:
+		code = CodeMalformedBody
+	case http.StatusServiceUnavailable:
+		code = CodeServiceUnavailable
+	}
+	detail := err.Message
+	if err.Code >= http.StatusInternalServerError {
+		detail = ""
+	}
+	return New(err.Code, code, detail)
+}
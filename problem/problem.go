@@ -0,0 +1,111 @@
+// Package problem writes RFC 7807 application/problem+json error responses.
+package problem
+
+import (
+	"contoso/validation"
+	"net/http"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// ContentType is the media type of problem responses.
+const ContentType = "application/problem+json"
+
+// Stable problem codes. Clients should branch on these, not on Detail.
+const (
+	CodeMalformedBody         = "malformed_body"
+	CodeInvalidQuery          = "invalid_query"
+	CodeValidationFailed      = "validation_failed"
+	CodeUnauthorized          = "unauthorized"
+	CodeClientCertRequired    = "client_certificate_required"
+	CodeForbidden             = "forbidden"
+	CodeNotFound              = "not_found"
+	CodePlayerNotFound        = "player_not_found"
+	CodeAPIKeyNotFound        = "api_key_not_found"
+	CodeWebhookNotFound       = "webhook_not_found"
+	CodeDeliveryNotFound      = "delivery_not_found"
+	CodeWalletNotFound        = "wallet_not_found"
+	CodeWalletExists          = "wallet_exists"
+	CodeInsufficientFunds     = "insufficient_funds"
+	CodeRateUnavailable       = "rate_unavailable"
+	CodeConversionTooSmall    = "conversion_too_small"
+	CodeBalanceLocked         = "balance_locked"
+	CodeInvalidTransition     = "invalid_status_transition"
+	CodeLimitNotFound         = "limit_not_found"
+	CodeLimitExceeded         = "limit_exceeded"
+	CodeInvalidID             = "invalid_id"
+	CodeImmutableField        = "immutable_field"
+	CodeCurrencyMismatch      = "currency_mismatch"
+	CodeTransferIDReused      = "transfer_id_reused"
+	CodeInvalidPatch          = "invalid_patch"
+	CodePatchTestFailed       = "patch_test_failed"
+	CodePlayerChanged         = "player_changed"
+	CodeRateLimited           = "rate_limited"
+	CodeIdempotencyKeyInvalid = "idempotency_key_invalid"
+	CodeIdempotencyKeyInUse   = "idempotency_key_in_use"
+	CodeIdempotencyKeyReused  = "idempotency_key_reused"
+	CodeDuplicateTarget       = "duplicate_target"
+	CodeNotExecuted           = "not_executed"
+	CodeTxUnsupported         = "transactions_unsupported"
+	CodeInternal              = "internal_error"
+	CodeServiceUnavailable    = "service_unavailable"
+	CodeUnsupportedMedia      = "unsupported_media_type"
+	CodeMethodNotAllowed      = "method_not_allowed"
+	CodeRequestEntityTooBig   = "request_entity_too_large"
+)
+
+// typePrefix namespaces problem types; the code is appended.
+const typePrefix = "urn:contoso:problem:"
+
+// Details is an RFC 7807 problem document. It implements error so handlers
+// can return it and let the app's error handler write it.
+type Details struct {
+	Type      string                  `json:"type"`
+	Title     string                  `json:"title"`
+	Status    int                     `json:"status"`
+	Detail    string                  `json:"detail,omitempty"`
+	Instance  string                  `json:"instance,omitempty"`
+	Code      string                  `json:"code"`
+	RequestID string                  `json:"requestId,omitempty"`
+	Errors    []validation.FieldError `json:"errors,omitempty"`
+}
+
+func (d *Details) Error() string {
+	if d.Detail != "" {
+		return d.Code + ": " + d.Detail
+	}
+	return d.Code
+}
+
+// New creates a problem; the title is the standard text for status.
+func New(status int, code, detail string) *Details {
+	return &Details{
+		Type:   typePrefix + code,
+		Title:  http.StatusText(status),
+		Status: status,
+		Detail: detail,
+		Code:   code,
+	}
+}
+
+// Write sends p as the response, filling in the instance and request ID.
+func Write(c *fiber.Ctx, p *Details) error {
+	if p.Instance == "" {
+		p.Instance = c.OriginalURL()
+	}
+	if p.RequestID == "" {
+		p.RequestID = RequestID(c)
+	}
+	return c.Status(p.Status).JSON(p, ContentType)
+}
+
+// Respond is shorthand for Write(c, New(status, code, detail)).
+func Respond(c *fiber.Ctx, status int, code, detail string) error {
+	return Write(c, New(status, code, detail))
+}
+
+// RequestID returns the ID assigned by the requestid middleware, if any.
+func RequestID(c *fiber.Ctx) string {
+	id, _ := c.Locals("requestid").(string)
+	return id
+}
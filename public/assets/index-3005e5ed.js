@@ -1,39 +0,0 @@
-(function(){const t=document.createElement("link").relList;if(t&&t.supports&&t.supports("modulepreload"))return;for(const r of document.querySelectorAll('link[rel="modulepreload"]'))l(r);new MutationObserver(r=>{for(const o of r)if(o.type==="childList")for(const i of o.addedNodes)i.tagName==="LINK"&&i.rel==="modulepreload"&&l(i)}).observe(document,{childList:!0,subtree:!0});function n(r){const o={};return r.integrity&&(o.integrity=r.integrity),r.referrerPolicy&&(o.referrerPolicy=r.referrerPolicy),r.crossOrigin==="use-credentials"?o.credentials="include":r.crossOrigin==="anonymous"?o.credentials="omit":o.credentials="same-origin",o}function l(r){if(r.ep)return;r.ep=!0;const o=n(r);fetch(r.href,o)}})();/**
-* @vue/shared v3.5.17
-* (c) 2018-present Yuxi (Evan) You and Vue contributors
-* @license MIT
-**//*! #__NO_SIDE_EFFECTS__ */function fa(e){const t=Object.create(null);for(const n of e.split(","))t[n]=1;return n=>n in t}const He={},xl=[],Zt=()=>{},hv=()=>!1,To=e=>e.charCodeAt(0)===111&&e.charCodeAt(1)===110&&(e.charCodeAt(2)>122||e.charCodeAt(2)<97),da=e=>e.startsWith("onUpdate:"),ft=Object.assign,va=(e,t)=>{const n=e.indexOf(t);n>-1&&e.splice(n,1)},gv=Object.prototype.hasOwnProperty,De=(e,t)=>gv.call(e,t),we=Array.isArray,Ll=e=>Er(e)==="[object Map]",yc=e=>Er(e)==="[object Set]",bv=e=>Er(e)==="[object RegExp]",ke=e=>typeof e=="function",rt=e=>typeof e=="string",Dn=e=>typeof e=="symbol",et=e=>e!==null&&typeof e=="object",Sc=e=>(et(e)||ke(e))&&ke(e.then)&&ke(e.catch),wc=Object.prototype.toString,Er=e=>wc.call(e),_v=e=>Er(e).slice(8,-1),Cc=e=>Er(e)==="[object Object]",ma=e=>rt(e)&&e!=="NaN"&&e[0]!=="-"&&""+parseInt(e,10)===e,Jl=fa(",key,ref,ref_for,ref_key,onVnodeBeforeMount,onVnodeMounted,onVnodeBeforeUpdate,onVnodeUpdated,onVnodeBeforeUnmount,onVnodeUnmounted"),ko=e=>{const t=Object.create(null);return n=>t[n]||(t[n]=e(n))},pv=/-(\w)/g,qn=ko(e=>e.replace(pv,(t,n)=>n?n.toUpperCase():"")),yv=/\B([A-Z])/g,fl=ko(e=>e.replace(yv,"-$1").toLowerCase()),Ec=ko(e=>e.charAt(0).toUpperCase()+e.slice(1)),Go=ko(e=>e?`on${Ec(e)}`:""),Fn=(e,t)=>!Object.is(e,t),Zl=(e,...t)=>{for(let n=0;n<e.length;n++)e[n](...t)},Pi=(e,t,n,l=!1)=>{Object.defineProperty(e,t,{configurable:!0,enumerable:!1,writable:l,value:n})},Sv=e=>{const t=parseFloat(e);return isNaN(t)?e:t},wv=e=>{const t=rt(e)?Number(e):NaN;return isNaN(t)?e:t};let ls;const xo=()=>ls||(ls=typeof globalThis<"u"?globalThis:typeof self<"u"?self:typeof window<"u"?window:typeof global<"u"?global:{});function ha(e){if(we(e)){const t={};for(let n=0;n<e.length;n++){const l=e[n],r=rt(l)?kv(l):ha(l);if(r)for(const o in r)t[o]=r[o]}return t}else if(rt(e)||et(e))return e}const Cv=/;(?![^(]*\))/g,Ev=/:([^]+)/,Tv=/\/\*[^]*?\*\//g;function kv(e){const t={};return e.replace(Tv,"").split(Cv).forEach(n=>{if(n){const l=n.split(Ev);l.length>1&&(t[l[0].trim()]=l[1].trim())}}),t}function ga(e){let t="";if(rt(e))t=e;else if(we(e))for(let n=0;n<e.length;n++){const l=ga(e[n]);l&&(t+=l+" ")}else if(et(e))for(const n in e)e[n]&&(t+=n+" ");return t.trim()}const xv="itemscope,allowfullscreen,formnovalidate,ismap,nomodule,novalidate,readonly",Lv=fa(xv);function Tc(e){return!!e||e===""}const kc=e=>!!(e&&e.__v_isRef===!0),Pl=e=>rt(e)?e:e==null?"":we(e)||et(e)&&(e.toString===wc||!ke(e.toString))?kc(e)?Pl(e.value):JSON.stringify(e,xc,2):String(e),xc=(e,t)=>kc(t)?xc(e,t.value):Ll(t)?{[`Map(${t.size})`]:[...t.entries()].reduce((n,[l,r],o)=>(n[Xo(l,o)+" =>"]=r,n),{})}:yc(t)?{[`Set(${t.size})`]:[...t.values()].map(n=>Xo(n))}:Dn(t)?Xo(t):et(t)&&!we(t)&&!Cc(t)?String(t):t,Xo=(e,t="")=>{var n;return Dn(e)?`Symbol(${(n=e.description)!=null?n:t})`:e};/**
-* @vue/reactivity v3.5.17
-* (c) 2018-present Yuxi (Evan) You and Vue contributors
-* @license MIT
-**/let Et;class Lc{constructor(t=!1){this.detached=t,this._active=!0,this._on=0,this.effects=[],this.cleanups=[],this._isPaused=!1,this.parent=Et,!t&&Et&&(this.index=(Et.scopes||(Et.scopes=[])).push(this)-1)}get active(){return this._active}pause(){if(this._active){this._isPaused=!0;let t,n;if(this.scopes)for(t=0,n=this.scopes.length;t<n;t++)this.scopes[t].pause();for(t=0,n=this.effects.length;t<n;t++)this.effects[t].pause()}}resume(){if(this._active&&this._isPaused){this._isPaused=!1;let t,n;if(this.scopes)for(t=0,n=this.scopes.length;t<n;t++)this.scopes[t].resume();for(t=0,n=this.effects.length;t<n;t++)this.effects[t].resume()}}run(t){if(this._active){const n=Et;try{return Et=this,t()}finally{Et=n}}}on(){++this._on===1&&(this.prevScope=Et,Et=this)}off(){this._on>0&&--this._on===0&&(Et=this.prevScope,this.prevScope=void 0)}stop(t){if(this._active){this._active=!1;let n,l;for(n=0,l=this.effects.length;n<l;n++)this.effects[n].stop();for(this.effects.length=0,n=0,l=this.cleanups.length;n<l;n++)this.cleanups[n]();if(this.cleanups.length=0,this.scopes){for(n=0,l=this.scopes.length;n<l;n++)this.scopes[n].stop(!0);this.scopes.length=0}if(!this.detached&&this.parent&&!t){const r=this.parent.scopes.pop();r&&r!==this&&(this.parent.scopes[this.index]=r,r.index=this.index)}this.parent=void 0}}}function ba(e){return new Lc(e)}function Pc(){return Et}function Pv(e,t=!1){Et&&Et.cleanups.push(e)}let We;const Jo=new WeakSet;class Ac{constructor(t){this.fn=t,this.deps=void 0,this.depsTail=void 0,this.flags=5,this.next=void 0,this.cleanup=void 0,this.scheduler=void 0,Et&&Et.active&&Et.effects.push(this)}pause(){this.flags|=64}resume(){this.flags&64&&(this.flags&=-65,Jo.has(this)&&(Jo.delete(this),this.trigger()))}notify(){this.flags&2&&!(this.flags&32)||this.flags&8||Ic(this)}run(){if(!(this.flags&1))return this.fn();this.flags|=2,rs(this),Rc(this);const t=We,n=en;We=this,en=!0;try{return this.fn()}finally{Nc(this),We=t,en=n,this.flags&=-3}}stop(){if(this.flags&1){for(let t=this.deps;t;t=t.nextDep)ya(t);this.deps=this.depsTail=void 0,rs(this),this.onStop&&this.onStop(),this.flags&=-2}}trigger(){this.flags&64?Jo.add(this):this.scheduler?this.scheduler():this.runIfDirty()}runIfDirty(){Ai(this)&&this.run()}get dirty(){return Ai(this)}}let Oc=0,er,tr;function Ic(e,t=!1){if(e.flags|=8,t){e.next=tr,tr=e;return}e.next=er,er=e}function _a(){Oc++}function pa(){if(--Oc>0)return;if(tr){let t=tr;for(tr=void 0;t;){const n=t.next;t.next=void 0,t.flags&=-9,t=n}}let e;for(;er;){let t=er;for(er=void 0;t;){const n=t.next;if(t.next=void 0,t.flags&=-9,t.flags&1)try{t.trigger()}catch(l){e||(e=l)}t=n}}if(e)throw e}function Rc(e){for(let t=e.deps;t;t=t.nextDep)t.version=-1,t.prevActiveLink=t.dep.activeLink,t.dep.activeLink=t}function Nc(e){let t,n=e.depsTail,l=n;for(;l;){const r=l.prevDep;l.version===-1?(l===n&&(n=r),ya(l),Av(l)):t=l,l.dep.activeLink=l.prevActiveLink,l.prevActiveLink=void 0,l=r}e.deps=t,e.depsTail=n}function Ai(e){for(let t=e.deps;t;t=t.nextDep)if(t.dep.version!==t.version||t.dep.computed&&(Mc(t.dep.computed)||t.dep.version!==t.version))return!0;return!!e._dirty}function Mc(e){if(e.flags&4&&!(e.flags&16)||(e.flags&=-17,e.globalVersion===ur)||(e.globalVersion=ur,!e.isSSR&&e.flags&128&&(!e.deps&&!e._dirty||!Ai(e))))return;e.flags|=2;const t=e.dep,n=We,l=en;We=e,en=!0;try{Rc(e);const r=e.fn(e._value);(t.version===0||Fn(r,e._value))&&(e.flags|=128,e._value=r,t.version++)}catch(r){throw t.version++,r}finally{We=n,en=l,Nc(e),e.flags&=-3}}function ya(e,t=!1){const{dep:n,prevSub:l,nextSub:r}=e;if(l&&(l.nextSub=r,e.prevSub=void 0),r&&(r.prevSub=l,e.nextSub=void 0),n.subs===e&&(n.subs=l,!l&&n.computed)){n.computed.flags&=-5;for(let o=n.computed.deps;o;o=o.nextDep)ya(o,!0)}!t&&!--n.sc&&n.map&&n.map.delete(n.key)}function Av(e){const{prevDep:t,nextDep:n}=e;t&&(t.nextDep=n,e.prevDep=void 0),n&&(n.prevDep=t,e.nextDep=void 0)}let en=!0;const Fc=[];function Sn(){Fc.push(en),en=!1}function wn(){const e=Fc.pop();en=e===void 0?!0:e}function rs(e){const{cleanup:t}=e;if(e.cleanup=void 0,t){const n=We;We=void 0;try{t()}finally{We=n}}}let ur=0;class Ov{constructor(t,n){this.sub=t,this.dep=n,this.version=n.version,this.nextDep=this.prevDep=this.nextSub=this.prevSub=this.prevActiveLink=void 0}}class Sa{constructor(t){this.computed=t,this.version=0,this.activeLink=void 0,this.subs=void 0,this.map=void 0,this.key=void 0,this.sc=0,this.__v_skip=!0}track(t){if(!We||!en||We===this.computed)return;let n=this.activeLink;if(n===void 0||n.sub!==We)n=this.activeLink=new Ov(We,this),We.deps?(n.prevDep=We.depsTail,We.depsTail.nextDep=n,We.depsTail=n):We.deps=We.depsTail=n,qc(n);else if(n.version===-1&&(n.version=this.version,n.nextDep)){const l=n.nextDep;l.prevDep=n.prevDep,n.prevDep&&(n.prevDep.nextDep=l),n.prevDep=We.depsTail,n.nextDep=void 0,We.depsTail.nextDep=n,We.depsTail=n,We.deps===n&&(We.deps=l)}return n}trigger(t){this.version++,ur++,this.notify(t)}notify(t){_a();try{for(let n=this.subs;n;n=n.prevSub)n.sub.notify()&&n.sub.dep.notify()}finally{pa()}}}function qc(e){if(e.dep.sc++,e.sub.flags&4){const t=e.dep.computed;if(t&&!e.dep.subs){t.flags|=20;for(let l=t.deps;l;l=l.nextDep)qc(l)}const n=e.dep.subs;n!==e&&(e.prevSub=n,n&&(n.nextSub=e)),e.dep.subs=e}}const ro=new WeakMap,rl=Symbol(""),Oi=Symbol(""),cr=Symbol("");function Tt(e,t,n){if(en&&We){let l=ro.get(e);l||ro.set(e,l=new Map);let r=l.get(n);r||(l.set(n,r=new Sa),r.map=l,r.key=n),r.track()}}function bn(e,t,n,l,r,o){const i=ro.get(e);if(!i){ur++;return}const a=s=>{s&&s.trigger()};if(_a(),t==="clear")i.forEach(a);else{const s=we(e),c=s&&ma(n);if(s&&n==="length"){const u=Number(l);i.forEach((f,v)=>{(v==="length"||v===cr||!Dn(v)&&v>=u)&&a(f)})}else switch((n!==void 0||i.has(void 0))&&a(i.get(n)),c&&a(i.get(cr)),t){case"add":s?c&&a(i.get("length")):(a(i.get(rl)),Ll(e)&&a(i.get(Oi)));break;case"delete":s||(a(i.get(rl)),Ll(e)&&a(i.get(Oi)));break;case"set":Ll(e)&&a(i.get(rl));break}}pa()}function Iv(e,t){const n=ro.get(e);return n&&n.get(t)}function _l(e){const t=Oe(e);return t===e?t:(Tt(t,"iterate",cr),tn(e)?t:t.map(At))}function wa(e){return Tt(e=Oe(e),"iterate",cr),e}const Rv={__proto__:null,[Symbol.iterator](){return Zo(this,Symbol.iterator,At)},concat(...e){return _l(this).concat(...e.map(t=>we(t)?_l(t):t))},entries(){return Zo(this,"entries",e=>(e[1]=At(e[1]),e))},every(e,t){return dn(this,"every",e,t,void 0,arguments)},filter(e,t){return dn(this,"filter",e,t,n=>n.map(At),arguments)},find(e,t){return dn(this,"find",e,t,At,arguments)},findIndex(e,t){return dn(this,"findIndex",e,t,void 0,arguments)},findLast(e,t){return dn(this,"findLast",e,t,At,arguments)},findLastIndex(e,t){return dn(this,"findLastIndex",e,t,void 0,arguments)},forEach(e,t){return dn(this,"forEach",e,t,void 0,arguments)},includes(...e){return ei(this,"includes",e)},indexOf(...e){return ei(this,"indexOf",e)},join(e){return _l(this).join(e)},lastIndexOf(...e){return ei(this,"lastIndexOf",e)},map(e,t){return dn(this,"map",e,t,void 0,arguments)},pop(){return Hl(this,"pop")},push(...e){return Hl(this,"push",e)},reduce(e,...t){return os(this,"reduce",e,t)},reduceRight(e,...t){return os(this,"reduceRight",e,t)},shift(){return Hl(this,"shift")},some(e,t){return dn(this,"some",e,t,void 0,arguments)},splice(...e){return Hl(this,"splice",e)},toReversed(){return _l(this).toReversed()},toSorted(e){return _l(this).toSorted(e)},toSpliced(...e){return _l(this).toSpliced(...e)},unshift(...e){return Hl(this,"unshift",e)},values(){return Zo(this,"values",At)}};function Zo(e,t,n){const l=wa(e),r=l[t]();return l!==e&&!tn(e)&&(r._next=r.next,r.next=()=>{const o=r._next();return o.value&&(o.value=n(o.value)),o}),r}const Nv=Array.prototype;function dn(e,t,n,l,r,o){const i=wa(e),a=i!==e&&!tn(e),s=i[t];if(s!==Nv[t]){const f=s.apply(e,o);return a?At(f):f}let c=n;i!==e&&(a?c=function(f,v){return n.call(this,At(f),v,e)}:n.length>2&&(c=function(f,v){return n.call(this,f,v,e)}));const u=s.call(i,c,l);return a&&r?r(u):u}function os(e,t,n,l){const r=wa(e);let o=n;return r!==e&&(tn(e)?n.length>3&&(o=function(i,a,s){return n.call(this,i,a,s,e)}):o=function(i,a,s){return n.call(this,i,At(a),s,e)}),r[t](o,...l)}function ei(e,t,n){const l=Oe(e);Tt(l,"iterate",cr);const r=l[t](...n);return(r===-1||r===!1)&&Ta(n[0])?(n[0]=Oe(n[0]),l[t](...n)):r}function Hl(e,t,n=[]){Sn(),_a();const l=Oe(e)[t].apply(e,n);return pa(),wn(),l}const Mv=fa("__proto__,__v_isRef,__isVue"),$c=new Set(Object.getOwnPropertyNames(Symbol).filter(e=>e!=="arguments"&&e!=="caller").map(e=>Symbol[e]).filter(Dn));function Fv(e){Dn(e)||(e=String(e));const t=Oe(this);return Tt(t,"has",e),t.hasOwnProperty(e)}class Dc{constructor(t=!1,n=!1){this._isReadonly=t,this._isShallow=n}get(t,n,l){if(n==="__v_skip")return t.__v_skip;const r=this._isReadonly,o=this._isShallow;if(n==="__v_isReactive")return!r;if(n==="__v_isReadonly")return r;if(n==="__v_isShallow")return o;if(n==="__v_raw")return l===(r?o?zv:Uc:o?Hc:Bc).get(t)||Object.getPrototypeOf(t)===Object.getPrototypeOf(l)?t:void 0;const i=we(t);if(!r){let s;if(i&&(s=Rv[n]))return s;if(n==="hasOwnProperty")return Fv}const a=Reflect.get(t,n,lt(t)?t:l);return(Dn(n)?$c.has(n):Mv(n))||(r||Tt(t,"get",n),o)?a:lt(a)?i&&ma(n)?a:a.value:et(a)?r?jc(a):an(a):a}}class Vc extends Dc{constructor(t=!1){super(!1,t)}set(t,n,l,r){let o=t[n];if(!this._isShallow){const s=cl(o);if(!tn(l)&&!cl(l)&&(o=Oe(o),l=Oe(l)),!we(t)&&lt(o)&&!lt(l))return s?!1:(o.value=l,!0)}const i=we(t)&&ma(n)?Number(n)<t.length:De(t,n),a=Reflect.set(t,n,l,lt(t)?t:r);return t===Oe(r)&&(i?Fn(l,o)&&bn(t,"set",n,l):bn(t,"add",n,l)),a}deleteProperty(t,n){const l=De(t,n);t[n];const r=Reflect.deleteProperty(t,n);return r&&l&&bn(t,"delete",n,void 0),r}has(t,n){const l=Reflect.has(t,n);return(!Dn(n)||!$c.has(n))&&Tt(t,"has",n),l}ownKeys(t){return Tt(t,"iterate",we(t)?"length":rl),Reflect.ownKeys(t)}}class qv extends Dc{constructor(t=!1){super(!0,t)}set(t,n){return!0}deleteProperty(t,n){return!0}}const $v=new Vc,Dv=new qv,Vv=new Vc(!0);const Ii=e=>e,qr=e=>Reflect.getPrototypeOf(e);function Bv(e,t,n){return function(...l){const r=this.__v_raw,o=Oe(r),i=Ll(o),a=e==="entries"||e===Symbol.iterator&&i,s=e==="keys"&&i,c=r[e](...l),u=n?Ii:t?Ri:At;return!t&&Tt(o,"iterate",s?Oi:rl),{next(){const{value:f,done:v}=c.next();return v?{value:f,done:v}:{value:a?[u(f[0]),u(f[1])]:u(f),done:v}},[Symbol.iterator](){return this}}}}function $r(e){return function(...t){return e==="delete"?!1:e==="clear"?void 0:this}}function Hv(e,t){const n={get(r){const o=this.__v_raw,i=Oe(o),a=Oe(r);e||(Fn(r,a)&&Tt(i,"get",r),Tt(i,"get",a));const{has:s}=qr(i),c=t?Ii:e?Ri:At;if(s.call(i,r))return c(o.get(r));if(s.call(i,a))return c(o.get(a));o!==i&&o.get(r)},get size(){const r=this.__v_raw;return!e&&Tt(Oe(r),"iterate",rl),Reflect.get(r,"size",r)},has(r){const o=this.__v_raw,i=Oe(o),a=Oe(r);return e||(Fn(r,a)&&Tt(i,"has",r),Tt(i,"has",a)),r===a?o.has(r):o.has(r)||o.has(a)},forEach(r,o){const i=this,a=i.__v_raw,s=Oe(a),c=t?Ii:e?Ri:At;return!e&&Tt(s,"iterate",rl),a.forEach((u,f)=>r.call(o,c(u),c(f),i))}};return ft(n,e?{add:$r("add"),set:$r("set"),delete:$r("delete"),clear:$r("clear")}:{add(r){!t&&!tn(r)&&!cl(r)&&(r=Oe(r));const o=Oe(this);return qr(o).has.call(o,r)||(o.add(r),bn(o,"add",r,r)),this},set(r,o){!t&&!tn(o)&&!cl(o)&&(o=Oe(o));const i=Oe(this),{has:a,get:s}=qr(i);let c=a.call(i,r);c||(r=Oe(r),c=a.call(i,r));const u=s.call(i,r);return i.set(r,o),c?Fn(o,u)&&bn(i,"set",r,o):bn(i,"add",r,o),this},delete(r){const o=Oe(this),{has:i,get:a}=qr(o);let s=i.call(o,r);s||(r=Oe(r),s=i.call(o,r)),a&&a.call(o,r);const c=o.delete(r);return s&&bn(o,"delete",r,void 0),c},clear(){const r=Oe(this),o=r.size!==0,i=r.clear();return o&&bn(r,"clear",void 0,void 0),i}}),["keys","values","entries",Symbol.iterator].forEach(r=>{n[r]=Bv(r,e,t)}),n}function Ca(e,t){const n=Hv(e,t);return(l,r,o)=>r==="__v_isReactive"?!e:r==="__v_isReadonly"?e:r==="__v_raw"?l:Reflect.get(De(n,r)&&r in l?n:l,r,o)}const Uv={get:Ca(!1,!1)},jv={get:Ca(!1,!0)},Wv={get:Ca(!0,!1)};const Bc=new WeakMap,Hc=new WeakMap,Uc=new WeakMap,zv=new WeakMap;function Kv(e){switch(e){case"Object":case"Array":return 1;case"Map":case"Set":case"WeakMap":case"WeakSet":return 2;default:return 0}}function Yv(e){return e.__v_skip||!Object.isExtensible(e)?0:Kv(_v(e))}function an(e){return cl(e)?e:Ea(e,!1,$v,Uv,Bc)}function Qv(e){return Ea(e,!1,Vv,jv,Hc)}function jc(e){return Ea(e,!0,Dv,Wv,Uc)}function Ea(e,t,n,l,r){if(!et(e)||e.__v_raw&&!(t&&e.__v_isReactive))return e;const o=Yv(e);if(o===0)return e;const i=r.get(e);if(i)return i;const a=new Proxy(e,o===2?l:n);return r.set(e,a),a}function ol(e){return cl(e)?ol(e.__v_raw):!!(e&&e.__v_isReactive)}function cl(e){return!!(e&&e.__v_isReadonly)}function tn(e){return!!(e&&e.__v_isShallow)}function Ta(e){return e?!!e.__v_raw:!1}function Oe(e){const t=e&&e.__v_raw;return t?Oe(t):e}function Tr(e){return!De(e,"__v_skip")&&Object.isExtensible(e)&&Pi(e,"__v_skip",!0),e}const At=e=>et(e)?an(e):e,Ri=e=>et(e)?jc(e):e;function lt(e){return e?e.__v_isRef===!0:!1}function ne(e){return zc(e,!1)}function Wc(e){return zc(e,!0)}function zc(e,t){return lt(e)?e:new Gv(e,t)}class Gv{constructor(t,n){this.dep=new Sa,this.__v_isRef=!0,this.__v_isShallow=!1,this._rawValue=n?t:Oe(t),this._value=n?t:At(t),this.__v_isShallow=n}get value(){return this.dep.track(),this._value}set value(t){const n=this._rawValue,l=this.__v_isShallow||tn(t)||cl(t);t=l?t:Oe(t),Fn(t,n)&&(this._rawValue=t,this._value=l?t:At(t),this.dep.trigger())}}function Zn(e){return lt(e)?e.value:e}const Xv={get:(e,t,n)=>t==="__v_raw"?e:Zn(Reflect.get(e,t,n)),set:(e,t,n,l)=>{const r=e[t];return lt(r)&&!lt(n)?(r.value=n,!0):Reflect.set(e,t,n,l)}};function Kc(e){return ol(e)?e:new Proxy(e,Xv)}function Jv(e){const t=we(e)?new Array(e.length):{};for(const n in e)t[n]=em(e,n);return t}class Zv{constructor(t,n,l){this._object=t,this._key=n,this._defaultValue=l,this.__v_isRef=!0,this._value=void 0}get value(){const t=this._object[this._key];return this._value=t===void 0?this._defaultValue:t}set value(t){this._object[this._key]=t}get dep(){return Iv(Oe(this._object),this._key)}}function em(e,t,n){const l=e[t];return lt(l)?l:new Zv(e,t,n)}class tm{constructor(t,n,l){this.fn=t,this.setter=n,this._value=void 0,this.dep=new Sa(this),this.__v_isRef=!0,this.deps=void 0,this.depsTail=void 0,this.flags=16,this.globalVersion=ur-1,this.next=void 0,this.effect=this,this.__v_isReadonly=!n,this.isSSR=l}notify(){if(this.flags|=16,!(this.flags&8)&&We!==this)return Ic(this,!0),!0}get value(){const t=this.dep.track();return Mc(this),t&&(t.version=this.dep.version),this._value}set value(t){this.setter&&this.setter(t)}}function nm(e,t,n=!1){let l,r;return ke(e)?l=e:(l=e.get,r=e.set),new tm(l,r,n)}const Dr={},oo=new WeakMap;let Jn;function lm(e,t=!1,n=Jn){if(n){let l=oo.get(n);l||oo.set(n,l=[]),l.push(e)}}function rm(e,t,n=He){const{immediate:l,deep:r,once:o,scheduler:i,augmentJob:a,call:s}=n,c=g=>r?g:tn(g)||r===!1||r===0?_n(g,1):_n(g);let u,f,v,d,b=!1,C=!1;if(lt(e)?(f=()=>e.value,b=tn(e)):ol(e)?(f=()=>c(e),b=!0):we(e)?(C=!0,b=e.some(g=>ol(g)||tn(g)),f=()=>e.map(g=>{if(lt(g))return g.value;if(ol(g))return c(g);if(ke(g))return s?s(g,2):g()})):ke(e)?t?f=s?()=>s(e,2):e:f=()=>{if(v){Sn();try{v()}finally{wn()}}const g=Jn;Jn=u;try{return s?s(e,3,[d]):e(d)}finally{Jn=g}}:f=Zt,t&&r){const g=f,L=r===!0?1/0:r;f=()=>_n(g(),L)}const E=Pc(),F=()=>{u.stop(),E&&E.active&&va(E.effects,u)};if(o&&t){const g=t;t=(...L)=>{g(...L),F()}}let k=C?new Array(e.length).fill(Dr):Dr;const m=g=>{if(!(!(u.flags&1)||!u.dirty&&!g))if(t){const L=u.run();if(r||b||(C?L.some((P,A)=>Fn(P,k[A])):Fn(L,k))){v&&v();const P=Jn;Jn=u;try{const A=[L,k===Dr?void 0:C&&k[0]===Dr?[]:k,d];k=L,s?s(t,3,A):t(...A)}finally{Jn=P}}}else u.run()};return a&&a(m),u=new Ac(f),u.scheduler=i?()=>i(m,!1):m,d=g=>lm(g,!1,u),v=u.onStop=()=>{const g=oo.get(u);if(g){if(s)s(g,4);else for(const L of g)L();oo.delete(u)}},t?l?m(!0):k=u.run():i?i(m.bind(null,!0),!0):u.run(),F.pause=u.pause.bind(u),F.resume=u.resume.bind(u),F.stop=F,F}function _n(e,t=1/0,n){if(t<=0||!et(e)||e.__v_skip||(n=n||new Set,n.has(e)))return e;if(n.add(e),t--,lt(e))_n(e.value,t,n);else if(we(e))for(let l=0;l<e.length;l++)_n(e[l],t,n);else if(yc(e)||Ll(e))e.forEach(l=>{_n(l,t,n)});else if(Cc(e)){for(const l in e)_n(e[l],t,n);for(const l of Object.getOwnPropertySymbols(e))Object.prototype.propertyIsEnumerable.call(e,l)&&_n(e[l],t,n)}return e}/**
-* @vue/runtime-core v3.5.17
-* (c) 2018-present Yuxi (Evan) You and Vue contributors
-* @license MIT
-**/function kr(e,t,n,l){try{return l?e(...l):e()}catch(r){Lo(r,t,n)}}function nn(e,t,n,l){if(ke(e)){const r=kr(e,t,n,l);return r&&Sc(r)&&r.catch(o=>{Lo(o,t,n)}),r}if(we(e)){const r=[];for(let o=0;o<e.length;o++)r.push(nn(e[o],t,n,l));return r}}function Lo(e,t,n,l=!0){const r=t?t.vnode:null,{errorHandler:o,throwUnhandledErrorInProduction:i}=t&&t.appContext.config||He;if(t){let a=t.parent;const s=t.proxy,c=`https://vuejs.org/error-reference/#runtime-${n}`;for(;a;){const u=a.ec;if(u){for(let f=0;f<u.length;f++)if(u[f](e,s,c)===!1)return}a=a.parent}if(o){Sn(),kr(o,null,10,[e,s,c]),wn();return}}om(e,n,r,l,i)}function om(e,t,n,l=!0,r=!1){if(r)throw e;console.error(e)}const Ot=[];let ln=-1;const Al=[];let On=null,Cl=0;const Yc=Promise.resolve();let io=null;function Ke(e){const t=io||Yc;return e?t.then(this?e.bind(this):e):t}function im(e){let t=ln+1,n=Ot.length;for(;t<n;){const l=t+n>>>1,r=Ot[l],o=fr(r);o<e||o===e&&r.flags&2?t=l+1:n=l}return t}function ka(e){if(!(e.flags&1)){const t=fr(e),n=Ot[Ot.length-1];!n||!(e.flags&2)&&t>=fr(n)?Ot.push(e):Ot.splice(im(t),0,e),e.flags|=1,Qc()}}function Qc(){io||(io=Yc.then(Xc))}function am(e){we(e)?Al.push(...e):On&&e.id===-1?On.splice(Cl+1,0,e):e.flags&1||(Al.push(e),e.flags|=1),Qc()}function is(e,t,n=ln+1){for(;n<Ot.length;n++){const l=Ot[n];if(l&&l.flags&2){if(e&&l.id!==e.uid)continue;Ot.splice(n,1),n--,l.flags&4&&(l.flags&=-2),l(),l.flags&4||(l.flags&=-2)}}}function Gc(e){if(Al.length){const t=[...new Set(Al)].sort((n,l)=>fr(n)-fr(l));if(Al.length=0,On){On.push(...t);return}for(On=t,Cl=0;Cl<On.length;Cl++){const n=On[Cl];n.flags&4&&(n.flags&=-2),n.flags&8||n(),n.flags&=-2}On=null,Cl=0}}const fr=e=>e.id==null?e.flags&2?-1:1/0:e.id;function Xc(e){const t=Zt;try{for(ln=0;ln<Ot.length;ln++){const n=Ot[ln];n&&!(n.flags&8)&&(n.flags&4&&(n.flags&=-2),kr(n,n.i,n.i?15:14),n.flags&4||(n.flags&=-2))}}finally{for(;ln<Ot.length;ln++){const n=Ot[ln];n&&(n.flags&=-2)}ln=-1,Ot.length=0,Gc(),io=null,(Ot.length||Al.length)&&Xc()}}let Nt=null,Jc=null;function ao(e){const t=Nt;return Nt=e,Jc=e&&e.type.__scopeId||null,t}function Me(e,t=Nt,n){if(!t||e._n)return e;const l=(...r)=>{l._d&&bs(-1);const o=ao(t);let i;try{i=e(...r)}finally{ao(o),l._d&&bs(1)}return i};return l._n=!0,l._c=!0,l._d=!0,l}function Ml(e,t){if(Nt===null)return e;const n=No(Nt),l=e.dirs||(e.dirs=[]);for(let r=0;r<t.length;r++){let[o,i,a,s=He]=t[r];o&&(ke(o)&&(o={mounted:o,updated:o}),o.deep&&_n(i),l.push({dir:o,instance:n,value:i,oldValue:void 0,arg:a,modifiers:s}))}return e}function Wn(e,t,n,l){const r=e.dirs,o=t&&t.dirs;for(let i=0;i<r.length;i++){const a=r[i];o&&(a.oldValue=o[i].value);let s=a.dir[l];s&&(Sn(),nn(s,n,8,[e.el,a,e,t]),wn())}}const Zc=Symbol("_vte"),ef=e=>e.__isTeleport,nr=e=>e&&(e.disabled||e.disabled===""),as=e=>e&&(e.defer||e.defer===""),ss=e=>typeof SVGElement<"u"&&e instanceof SVGElement,us=e=>typeof MathMLElement=="function"&&e instanceof MathMLElement,Ni=(e,t)=>{const n=e&&e.to;return rt(n)?t?t(n):null:n},tf={name:"Teleport",__isTeleport:!0,process(e,t,n,l,r,o,i,a,s,c){const{mc:u,pc:f,pbc:v,o:{insert:d,querySelector:b,createText:C,createComment:E}}=c,F=nr(t.props);let{shapeFlag:k,children:m,dynamicChildren:g}=t;if(e==null){const L=t.el=C(""),P=t.anchor=C("");d(L,n,l),d(P,n,l);const A=(S,N)=>{k&16&&(r&&r.isCE&&(r.ce._teleportTarget=S),u(m,S,N,r,o,i,a,s))},I=()=>{const S=t.target=Ni(t.props,b),N=nf(S,t,C,d);S&&(i!=="svg"&&ss(S)?i="svg":i!=="mathml"&&us(S)&&(i="mathml"),F||(A(S,N),Jr(t,!1)))};F&&(A(n,P),Jr(t,!0)),as(t.props)?(t.el.__isMounted=!1,dt(()=>{I(),delete t.el.__isMounted},o)):I()}else{if(as(t.props)&&e.el.__isMounted===!1){dt(()=>{tf.process(e,t,n,l,r,o,i,a,s,c)},o);return}t.el=e.el,t.targetStart=e.targetStart;const L=t.anchor=e.anchor,P=t.target=e.target,A=t.targetAnchor=e.targetAnchor,I=nr(e.props),S=I?n:P,N=I?L:A;if(i==="svg"||ss(P)?i="svg":(i==="mathml"||us(P))&&(i="mathml"),g?(v(e.dynamicChildren,g,S,r,o,i,a),Ia(e,t,!0)):s||f(e,t,S,N,r,o,i,a,!1),F)I?t.props&&e.props&&t.props.to!==e.props.to&&(t.props.to=e.props.to):Vr(t,n,L,c,1);else if((t.props&&t.props.to)!==(e.props&&e.props.to)){const U=t.target=Ni(t.props,b);U&&Vr(t,U,null,c,0)}else I&&Vr(t,P,A,c,1);Jr(t,F)}},remove(e,t,n,{um:l,o:{remove:r}},o){const{shapeFlag:i,children:a,anchor:s,targetStart:c,targetAnchor:u,target:f,props:v}=e;if(f&&(r(c),r(u)),o&&r(s),i&16){const d=o||!nr(v);for(let b=0;b<a.length;b++){const C=a[b];l(C,t,n,d,!!C.dynamicChildren)}}},move:Vr,hydrate:sm};function Vr(e,t,n,{o:{insert:l},m:r},o=2){o===0&&l(e.targetAnchor,t,n);const{el:i,anchor:a,shapeFlag:s,children:c,props:u}=e,f=o===2;if(f&&l(i,t,n),(!f||nr(u))&&s&16)for(let v=0;v<c.length;v++)r(c[v],t,n,2);f&&l(a,t,n)}function sm(e,t,n,l,r,o,{o:{nextSibling:i,parentNode:a,querySelector:s,insert:c,createText:u}},f){const v=t.target=Ni(t.props,s);if(v){const d=nr(t.props),b=v._lpa||v.firstChild;if(t.shapeFlag&16)if(d)t.anchor=f(i(e),t,a(e),n,l,r,o),t.targetStart=b,t.targetAnchor=b&&i(b);else{t.anchor=i(e);let C=b;for(;C;){if(C&&C.nodeType===8){if(C.data==="teleport start anchor")t.targetStart=C;else if(C.data==="teleport anchor"){t.targetAnchor=C,v._lpa=t.targetAnchor&&i(t.targetAnchor);break}}C=i(C)}t.targetAnchor||nf(v,t,u,c),f(b&&i(b),t,v,n,l,r,o)}Jr(t,d)}return t.anchor&&i(t.anchor)}const um=tf;function Jr(e,t){const n=e.ctx;if(n&&n.ut){let l,r;for(t?(l=e.el,r=e.anchor):(l=e.targetStart,r=e.targetAnchor);l&&l!==r;)l.nodeType===1&&l.setAttribute("data-v-owner",n.uid),l=l.nextSibling;n.ut()}}function nf(e,t,n,l){const r=t.targetStart=n(""),o=t.targetAnchor=n("");return r[Zc]=o,e&&(l(r,e),l(o,e)),o}const In=Symbol("_leaveCb"),Br=Symbol("_enterCb");function cm(){const e={isMounted:!1,isLeaving:!1,isUnmounting:!1,leavingVNodes:new Map};return gt(()=>{e.isMounted=!0}),Ge(()=>{e.isUnmounting=!0}),e}const Vt=[Function,Array],lf={mode:String,appear:Boolean,persisted:Boolean,onBeforeEnter:Vt,onEnter:Vt,onAfterEnter:Vt,onEnterCancelled:Vt,onBeforeLeave:Vt,onLeave:Vt,onAfterLeave:Vt,onLeaveCancelled:Vt,onBeforeAppear:Vt,onAppear:Vt,onAfterAppear:Vt,onAppearCancelled:Vt},rf=e=>{const t=e.subTree;return t.component?rf(t.component):t},fm={name:"BaseTransition",props:lf,setup(e,{slots:t}){const n=Ce(),l=cm();return()=>{const r=t.default&&sf(t.default(),!0);if(!r||!r.length)return;const o=of(r),i=Oe(e),{mode:a}=i;if(l.isLeaving)return ti(o);const s=cs(o);if(!s)return ti(o);let c=Mi(s,i,l,n,f=>c=f);s.type!==kt&&Fl(s,c);let u=n.subTree&&cs(n.subTree);if(u&&u.type!==kt&&!Mn(s,u)&&rf(n).type!==kt){let f=Mi(u,i,l,n);if(Fl(u,f),a==="out-in"&&s.type!==kt)return l.isLeaving=!0,f.afterLeave=()=>{l.isLeaving=!1,n.job.flags&8||n.update(),delete f.afterLeave,u=void 0},ti(o);a==="in-out"&&s.type!==kt?f.delayLeave=(v,d,b)=>{const C=af(l,u);C[String(u.key)]=u,v[In]=()=>{d(),v[In]=void 0,delete c.delayedLeave,u=void 0},c.delayedLeave=()=>{b(),delete c.delayedLeave,u=void 0}}:u=void 0}else u&&(u=void 0);return o}}};function of(e){let t=e[0];if(e.length>1){for(const n of e)if(n.type!==kt){t=n;break}}return t}const dm=fm;function af(e,t){const{leavingVNodes:n}=e;let l=n.get(t.type);return l||(l=Object.create(null),n.set(t.type,l)),l}function Mi(e,t,n,l,r){const{appear:o,mode:i,persisted:a=!1,onBeforeEnter:s,onEnter:c,onAfterEnter:u,onEnterCancelled:f,onBeforeLeave:v,onLeave:d,onAfterLeave:b,onLeaveCancelled:C,onBeforeAppear:E,onAppear:F,onAfterAppear:k,onAppearCancelled:m}=t,g=String(e.key),L=af(n,e),P=(S,N)=>{S&&nn(S,l,9,N)},A=(S,N)=>{const U=N[1];P(S,N),we(S)?S.every(x=>x.length<=1)&&U():S.length<=1&&U()},I={mode:i,persisted:a,beforeEnter(S){let N=s;if(!n.isMounted)if(o)N=E||s;else return;S[In]&&S[In](!0);const U=L[g];U&&Mn(e,U)&&U.el[In]&&U.el[In](),P(N,[S])},enter(S){let N=c,U=u,x=f;if(!n.isMounted)if(o)N=F||c,U=k||u,x=m||f;else return;let Q=!1;const B=S[Br]=te=>{Q||(Q=!0,te?P(x,[S]):P(U,[S]),I.delayedLeave&&I.delayedLeave(),S[Br]=void 0)};N?A(N,[S,B]):B()},leave(S,N){const U=String(e.key);if(S[Br]&&S[Br](!0),n.isUnmounting)return N();P(v,[S]);let x=!1;const Q=S[In]=B=>{x||(x=!0,N(),B?P(C,[S]):P(b,[S]),S[In]=void 0,L[U]===e&&delete L[U])};L[U]=e,d?A(d,[S,Q]):Q()},clone(S){const N=Mi(S,t,n,l,r);return r&&r(N),N}};return I}function ti(e){if(Ao(e))return e=Cn(e),e.children=null,e}function cs(e){if(!Ao(e))return ef(e.type)&&e.children?of(e.children):e;if(e.component)return e.component.subTree;const{shapeFlag:t,children:n}=e;if(n){if(t&16)return n[0];if(t&32&&ke(n.default))return n.default()}}function Fl(e,t){e.shapeFlag&6&&e.component?(e.transition=t,Fl(e.component.subTree,t)):e.shapeFlag&128?(e.ssContent.transition=t.clone(e.ssContent),e.ssFallback.transition=t.clone(e.ssFallback)):e.transition=t}function sf(e,t=!1,n){let l=[],r=0;for(let o=0;o<e.length;o++){let i=e[o];const a=n==null?i.key:String(n)+String(i.key!=null?i.key:o);i.type===Ut?(i.patchFlag&128&&r++,l=l.concat(sf(i.children,t,a))):(t||i.type!==kt)&&l.push(a!=null?Cn(i,{key:a}):i)}if(r>1)for(let o=0;o<l.length;o++)l[o].patchFlag=-2;return l}/*! #__NO_SIDE_EFFECTS__ */function Po(e,t){return ke(e)?(()=>ft({name:e.name},t,{setup:e}))():e}function uf(e){e.ids=[e.ids[0]+e.ids[2]+++"-",0,0]}function lr(e,t,n,l,r=!1){if(we(e)){e.forEach((b,C)=>lr(b,t&&(we(t)?t[C]:t),n,l,r));return}if(Ol(l)&&!r){l.shapeFlag&512&&l.type.__asyncResolved&&l.component.subTree.component&&lr(e,t,n,l.component.subTree);return}const o=l.shapeFlag&4?No(l.component):l.el,i=r?null:o,{i:a,r:s}=e,c=t&&t.r,u=a.refs===He?a.refs={}:a.refs,f=a.setupState,v=Oe(f),d=f===He?()=>!1:b=>De(v,b);if(c!=null&&c!==s&&(rt(c)?(u[c]=null,d(c)&&(f[c]=null)):lt(c)&&(c.value=null)),ke(s))kr(s,a,12,[i,u]);else{const b=rt(s),C=lt(s);if(b||C){const E=()=>{if(e.f){const F=b?d(s)?f[s]:u[s]:s.value;r?we(F)&&va(F,o):we(F)?F.includes(o)||F.push(o):b?(u[s]=[o],d(s)&&(f[s]=u[s])):(s.value=[o],e.k&&(u[e.k]=s.value))}else b?(u[s]=i,d(s)&&(f[s]=i)):C&&(s.value=i,e.k&&(u[e.k]=i))};i?(E.id=-1,dt(E,n)):E()}}}xo().requestIdleCallback;xo().cancelIdleCallback;const Ol=e=>!!e.type.__asyncLoader,Ao=e=>e.type.__isKeepAlive,vm={name:"KeepAlive",__isKeepAlive:!0,props:{include:[String,RegExp,Array],exclude:[String,RegExp,Array],max:[String,Number]},setup(e,{slots:t}){const n=Ce(),l=n.ctx;if(!l.renderer)return()=>{const k=t.default&&t.default();return k&&k.length===1?k[0]:k};const r=new Map,o=new Set;let i=null;const a=n.suspense,{renderer:{p:s,m:c,um:u,o:{createElement:f}}}=l,v=f("div");l.activate=(k,m,g,L,P)=>{const A=k.component;c(k,m,g,0,a),s(A.vnode,k,m,g,A,a,L,k.slotScopeIds,P),dt(()=>{A.isDeactivated=!1,A.a&&Zl(A.a);const I=k.props&&k.props.onVnodeMounted;I&&Ht(I,A.parent,k)},a)},l.deactivate=k=>{const m=k.component;uo(m.m),uo(m.a),c(k,v,null,1,a),dt(()=>{m.da&&Zl(m.da);const g=k.props&&k.props.onVnodeUnmounted;g&&Ht(g,m.parent,k),m.isDeactivated=!0},a)};function d(k){ni(k),u(k,n,a,!0)}function b(k){r.forEach((m,g)=>{const L=Ss(m.type);L&&!k(L)&&C(g)})}function C(k){const m=r.get(k);m&&(!i||!Mn(m,i))?d(m):i&&ni(i),r.delete(k),o.delete(k)}me(()=>[e.include,e.exclude],([k,m])=>{k&&b(g=>Yl(k,g)),m&&b(g=>!Yl(m,g))},{flush:"post",deep:!0});let E=null;const F=()=>{E!=null&&(co(n.subTree.type)?dt(()=>{r.set(E,Hr(n.subTree))},n.subTree.suspense):r.set(E,Hr(n.subTree)))};return gt(F),La(F),Ge(()=>{r.forEach(k=>{const{subTree:m,suspense:g}=n,L=Hr(m);if(k.type===L.type&&k.key===L.key){ni(L);const P=L.component.da;P&&dt(P,g);return}d(k)})}),()=>{if(E=null,!t.default)return i=null;const k=t.default(),m=k[0];if(k.length>1)return i=null,k;if(!vr(m)||!(m.shapeFlag&4)&&!(m.shapeFlag&128))return i=null,m;let g=Hr(m);if(g.type===kt)return i=null,g;const L=g.type,P=Ss(Ol(g)?g.type.__asyncResolved||{}:L),{include:A,exclude:I,max:S}=e;if(A&&(!P||!Yl(A,P))||I&&P&&Yl(I,P))return g.shapeFlag&=-257,i=g,m;const N=g.key==null?L:g.key,U=r.get(N);return g.el&&(g=Cn(g),m.shapeFlag&128&&(m.ssContent=g)),E=N,U?(g.el=U.el,g.component=U.component,g.transition&&Fl(g,g.transition),g.shapeFlag|=512,o.delete(N),o.add(N)):(o.add(N),S&&o.size>parseInt(S,10)&&C(o.values().next().value)),g.shapeFlag|=256,i=g,co(m.type)?m:g}}},mm=vm;function Yl(e,t){return we(e)?e.some(n=>Yl(n,t)):rt(e)?e.split(",").includes(t):bv(e)?(e.lastIndex=0,e.test(t)):!1}function xr(e,t){cf(e,"a",t)}function dl(e,t){cf(e,"da",t)}function cf(e,t,n=St){const l=e.__wdc||(e.__wdc=()=>{let r=n;for(;r;){if(r.isDeactivated)return;r=r.parent}return e()});if(Oo(t,l,n),n){let r=n.parent;for(;r&&r.parent;)Ao(r.parent.vnode)&&hm(l,t,n,r),r=r.parent}}function hm(e,t,n,l){const r=Oo(t,e,l,!0);Pr(()=>{va(l[t],r)},n)}function ni(e){e.shapeFlag&=-257,e.shapeFlag&=-513}function Hr(e){return e.shapeFlag&128?e.ssContent:e}function Oo(e,t,n=St,l=!1){if(n){const r=n[e]||(n[e]=[]),o=t.__weh||(t.__weh=(...i)=>{Sn();const a=Or(n),s=nn(t,n,e,i);return a(),wn(),s});return l?r.unshift(o):r.push(o),o}}const kn=e=>(t,n=St)=>{(!mr||e==="sp")&&Oo(e,(...l)=>t(...l),n)},Lr=kn("bm"),gt=kn("m"),xa=kn("bu"),La=kn("u"),Ge=kn("bum"),Pr=kn("um"),gm=kn("sp"),bm=kn("rtg"),_m=kn("rtc");function pm(e,t=St){Oo("ec",e,t)}const ym=Symbol.for("v-ndc"),Fi=e=>e?kf(e)?No(e):Fi(e.parent):null,rr=ft(Object.create(null),{$:e=>e,$el:e=>e.vnode.el,$data:e=>e.data,$props:e=>e.props,$attrs:e=>e.attrs,$slots:e=>e.slots,$refs:e=>e.refs,$parent:e=>Fi(e.parent),$root:e=>Fi(e.root),$host:e=>e.ce,$emit:e=>e.emit,$options:e=>Pa(e),$forceUpdate:e=>e.f||(e.f=()=>{ka(e.update)}),$nextTick:e=>e.n||(e.n=Ke.bind(e.proxy)),$watch:e=>Hm.bind(e)}),li=(e,t)=>e!==He&&!e.__isScriptSetup&&De(e,t),Sm={get({_:e},t){if(t==="__v_skip")return!0;const{ctx:n,setupState:l,data:r,props:o,accessCache:i,type:a,appContext:s}=e;let c;if(t[0]!=="$"){const d=i[t];if(d!==void 0)switch(d){case 1:return l[t];case 2:return r[t];case 4:return n[t];case 3:return o[t]}else{if(li(l,t))return i[t]=1,l[t];if(r!==He&&De(r,t))return i[t]=2,r[t];if((c=e.propsOptions[0])&&De(c,t))return i[t]=3,o[t];if(n!==He&&De(n,t))return i[t]=4,n[t];qi&&(i[t]=0)}}const u=rr[t];let f,v;if(u)return t==="$attrs"&&Tt(e.attrs,"get",""),u(e);if((f=a.__cssModules)&&(f=f[t]))return f;if(n!==He&&De(n,t))return i[t]=4,n[t];if(v=s.config.globalProperties,De(v,t))return v[t]},set({_:e},t,n){const{data:l,setupState:r,ctx:o}=e;return li(r,t)?(r[t]=n,!0):l!==He&&De(l,t)?(l[t]=n,!0):De(e.props,t)||t[0]==="$"&&t.slice(1)in e?!1:(o[t]=n,!0)},has({_:{data:e,setupState:t,accessCache:n,ctx:l,appContext:r,propsOptions:o}},i){let a;return!!n[i]||e!==He&&De(e,i)||li(t,i)||(a=o[0])&&De(a,i)||De(l,i)||De(rr,i)||De(r.config.globalProperties,i)},defineProperty(e,t,n){return n.get!=null?e._.accessCache[t]=0:De(n,"value")&&this.set(e,t,n.value,null),Reflect.defineProperty(e,t,n)}};function fs(e){return we(e)?e.reduce((t,n)=>(t[n]=null,t),{}):e}let qi=!0;function wm(e){const t=Pa(e),n=e.proxy,l=e.ctx;qi=!1,t.beforeCreate&&ds(t.beforeCreate,e,"bc");const{data:r,computed:o,methods:i,watch:a,provide:s,inject:c,created:u,beforeMount:f,mounted:v,beforeUpdate:d,updated:b,activated:C,deactivated:E,beforeDestroy:F,beforeUnmount:k,destroyed:m,unmounted:g,render:L,renderTracked:P,renderTriggered:A,errorCaptured:I,serverPrefetch:S,expose:N,inheritAttrs:U,components:x,directives:Q,filters:B}=t;if(c&&Cm(c,l,null),i)for(const ee in i){const Y=i[ee];ke(Y)&&(l[ee]=Y.bind(n))}if(r){const ee=r.call(n,n);et(ee)&&(e.data=an(ee))}if(qi=!0,o)for(const ee in o){const Y=o[ee],se=ke(Y)?Y.bind(n,n):ke(Y.get)?Y.get.bind(n,n):Zt,he=!ke(Y)&&ke(Y.set)?Y.set.bind(n):Zt,$=w({get:se,set:he});Object.defineProperty(l,ee,{enumerable:!0,configurable:!0,get:()=>$.value,set:q=>$.value=q})}if(a)for(const ee in a)ff(a[ee],l,n,ee);if(s){const ee=ke(s)?s.call(n):s;Reflect.ownKeys(ee).forEach(Y=>{Io(Y,ee[Y])})}u&&ds(u,e,"c");function J(ee,Y){we(Y)?Y.forEach(se=>ee(se.bind(n))):Y&&ee(Y.bind(n))}if(J(Lr,f),J(gt,v),J(xa,d),J(La,b),J(xr,C),J(dl,E),J(pm,I),J(_m,P),J(bm,A),J(Ge,k),J(Pr,g),J(gm,S),we(N))if(N.length){const ee=e.exposed||(e.exposed={});N.forEach(Y=>{Object.defineProperty(ee,Y,{get:()=>n[Y],set:se=>n[Y]=se})})}else e.exposed||(e.exposed={});L&&e.render===Zt&&(e.render=L),U!=null&&(e.inheritAttrs=U),x&&(e.components=x),Q&&(e.directives=Q),S&&uf(e)}function Cm(e,t,n=Zt){we(e)&&(e=$i(e));for(const l in e){const r=e[l];let o;et(r)?"default"in r?o=Kt(r.from||l,r.default,!0):o=Kt(r.from||l):o=Kt(r),lt(o)?Object.defineProperty(t,l,{enumerable:!0,configurable:!0,get:()=>o.value,set:i=>o.value=i}):t[l]=o}}function ds(e,t,n){nn(we(e)?e.map(l=>l.bind(t.proxy)):e.bind(t.proxy),t,n)}function ff(e,t,n,l){let r=l.includes(".")?Cf(n,l):()=>n[l];if(rt(e)){const o=t[e];ke(o)&&me(r,o)}else if(ke(e))me(r,e.bind(n));else if(et(e))if(we(e))e.forEach(o=>ff(o,t,n,l));else{const o=ke(e.handler)?e.handler.bind(n):t[e.handler];ke(o)&&me(r,o,e)}}function Pa(e){const t=e.type,{mixins:n,extends:l}=t,{mixins:r,optionsCache:o,config:{optionMergeStrategies:i}}=e.appContext,a=o.get(t);let s;return a?s=a:!r.length&&!n&&!l?s=t:(s={},r.length&&r.forEach(c=>so(s,c,i,!0)),so(s,t,i)),et(t)&&o.set(t,s),s}function so(e,t,n,l=!1){const{mixins:r,extends:o}=t;o&&so(e,o,n,!0),r&&r.forEach(i=>so(e,i,n,!0));for(const i in t)if(!(l&&i==="expose")){const a=Em[i]||n&&n[i];e[i]=a?a(e[i],t[i]):t[i]}return e}const Em={data:vs,props:ms,emits:ms,methods:Ql,computed:Ql,beforeCreate:Lt,created:Lt,beforeMount:Lt,mounted:Lt,beforeUpdate:Lt,updated:Lt,beforeDestroy:Lt,beforeUnmount:Lt,destroyed:Lt,unmounted:Lt,activated:Lt,deactivated:Lt,errorCaptured:Lt,serverPrefetch:Lt,components:Ql,directives:Ql,watch:km,provide:vs,inject:Tm};function vs(e,t){return t?e?function(){return ft(ke(e)?e.call(this,this):e,ke(t)?t.call(this,this):t)}:t:e}function Tm(e,t){return Ql($i(e),$i(t))}function $i(e){if(we(e)){const t={};for(let n=0;n<e.length;n++)t[e[n]]=e[n];return t}return e}function Lt(e,t){return e?[...new Set([].concat(e,t))]:t}function Ql(e,t){return e?ft(Object.create(null),e,t):t}function ms(e,t){return e?we(e)&&we(t)?[...new Set([...e,...t])]:ft(Object.create(null),fs(e),fs(t??{})):t}function km(e,t){if(!e)return t;if(!t)return e;const n=ft(Object.create(null),e);for(const l in t)n[l]=Lt(e[l],t[l]);return n}function df(){return{app:null,config:{isNativeTag:hv,performance:!1,globalProperties:{},optionMergeStrategies:{},errorHandler:void 0,warnHandler:void 0,compilerOptions:{}},mixins:[],components:{},directives:{},provides:Object.create(null),optionsCache:new WeakMap,propsCache:new WeakMap,emitsCache:new WeakMap}}let xm=0;function Lm(e,t){return function(l,r=null){ke(l)||(l=ft({},l)),r!=null&&!et(r)&&(r=null);const o=df(),i=new WeakSet,a=[];let s=!1;const c=o.app={_uid:xm++,_component:l,_props:r,_container:null,_context:o,_instance:null,version:ch,get config(){return o.config},set config(u){},use(u,...f){return i.has(u)||(u&&ke(u.install)?(i.add(u),u.install(c,...f)):ke(u)&&(i.add(u),u(c,...f))),c},mixin(u){return o.mixins.includes(u)||o.mixins.push(u),c},component(u,f){return f?(o.components[u]=f,c):o.components[u]},directive(u,f){return f?(o.directives[u]=f,c):o.directives[u]},mount(u,f,v){if(!s){const d=c._ceVNode||be(l,r);return d.appContext=o,v===!0?v="svg":v===!1&&(v=void 0),f&&t?t(d,u):e(d,u,v),s=!0,c._container=u,u.__vue_app__=c,No(d.component)}},onUnmount(u){a.push(u)},unmount(){s&&(nn(a,c._instance,16),e(null,c._container),delete c._container.__vue_app__)},provide(u,f){return o.provides[u]=f,c},runWithContext(u){const f=il;il=c;try{return u()}finally{il=f}}};return c}}let il=null;function Io(e,t){if(St){let n=St.provides;const l=St.parent&&St.parent.provides;l===n&&(n=St.provides=Object.create(l)),n[e]=t}}function Kt(e,t,n=!1){const l=St||Nt;if(l||il){let r=il?il._context.provides:l?l.parent==null||l.ce?l.vnode.appContext&&l.vnode.appContext.provides:l.parent.provides:void 0;if(r&&e in r)return r[e];if(arguments.length>1)return n&&ke(t)?t.call(l&&l.proxy):t}}function Pm(){return!!(St||Nt||il)}const vf={},mf=()=>Object.create(vf),hf=e=>Object.getPrototypeOf(e)===vf;function Am(e,t,n,l=!1){const r={},o=mf();e.propsDefaults=Object.create(null),gf(e,t,r,o);for(const i in e.propsOptions[0])i in r||(r[i]=void 0);n?e.props=l?r:Qv(r):e.type.props?e.props=r:e.props=o,e.attrs=o}function Om(e,t,n,l){const{props:r,attrs:o,vnode:{patchFlag:i}}=e,a=Oe(r),[s]=e.propsOptions;let c=!1;if((l||i>0)&&!(i&16)){if(i&8){const u=e.vnode.dynamicProps;for(let f=0;f<u.length;f++){let v=u[f];if(Ro(e.emitsOptions,v))continue;const d=t[v];if(s)if(De(o,v))d!==o[v]&&(o[v]=d,c=!0);else{const b=qn(v);r[b]=Di(s,a,b,d,e,!1)}else d!==o[v]&&(o[v]=d,c=!0)}}}else{gf(e,t,r,o)&&(c=!0);let u;for(const f in a)(!t||!De(t,f)&&((u=fl(f))===f||!De(t,u)))&&(s?n&&(n[f]!==void 0||n[u]!==void 0)&&(r[f]=Di(s,a,f,void 0,e,!0)):delete r[f]);if(o!==a)for(const f in o)(!t||!De(t,f))&&(delete o[f],c=!0)}c&&bn(e.attrs,"set","")}function gf(e,t,n,l){const[r,o]=e.propsOptions;let i=!1,a;if(t)for(let s in t){if(Jl(s))continue;const c=t[s];let u;r&&De(r,u=qn(s))?!o||!o.includes(u)?n[u]=c:(a||(a={}))[u]=c:Ro(e.emitsOptions,s)||(!(s in l)||c!==l[s])&&(l[s]=c,i=!0)}if(o){const s=Oe(n),c=a||He;for(let u=0;u<o.length;u++){const f=o[u];n[f]=Di(r,s,f,c[f],e,!De(c,f))}}return i}function Di(e,t,n,l,r,o){const i=e[n];if(i!=null){const a=De(i,"default");if(a&&l===void 0){const s=i.default;if(i.type!==Function&&!i.skipFactory&&ke(s)){const{propsDefaults:c}=r;if(n in c)l=c[n];else{const u=Or(r);l=c[n]=s.call(null,t),u()}}else l=s;r.ce&&r.ce._setProp(n,l)}i[0]&&(o&&!a?l=!1:i[1]&&(l===""||l===fl(n))&&(l=!0))}return l}const Im=new WeakMap;function bf(e,t,n=!1){const l=n?Im:t.propsCache,r=l.get(e);if(r)return r;const o=e.props,i={},a=[];let s=!1;if(!ke(e)){const u=f=>{s=!0;const[v,d]=bf(f,t,!0);ft(i,v),d&&a.push(...d)};!n&&t.mixins.length&&t.mixins.forEach(u),e.extends&&u(e.extends),e.mixins&&e.mixins.forEach(u)}if(!o&&!s)return et(e)&&l.set(e,xl),xl;if(we(o))for(let u=0;u<o.length;u++){const f=qn(o[u]);hs(f)&&(i[f]=He)}else if(o)for(const u in o){const f=qn(u);if(hs(f)){const v=o[u],d=i[f]=we(v)||ke(v)?{type:v}:ft({},v),b=d.type;let C=!1,E=!0;if(we(b))for(let F=0;F<b.length;++F){const k=b[F],m=ke(k)&&k.name;if(m==="Boolean"){C=!0;break}else m==="String"&&(E=!1)}else C=ke(b)&&b.name==="Boolean";d[0]=C,d[1]=E,(C||De(d,"default"))&&a.push(f)}}const c=[i,a];return et(e)&&l.set(e,c),c}function hs(e){return e[0]!=="$"&&!Jl(e)}const Aa=e=>e[0]==="_"||e==="$stable",Oa=e=>we(e)?e.map(rn):[rn(e)],Rm=(e,t,n)=>{if(t._n)return t;const l=Me((...r)=>Oa(t(...r)),n);return l._c=!1,l},_f=(e,t,n)=>{const l=e._ctx;for(const r in e){if(Aa(r))continue;const o=e[r];if(ke(o))t[r]=Rm(r,o,l);else if(o!=null){const i=Oa(o);t[r]=()=>i}}},pf=(e,t)=>{const n=Oa(t);e.slots.default=()=>n},yf=(e,t,n)=>{for(const l in t)(n||!Aa(l))&&(e[l]=t[l])},Nm=(e,t,n)=>{const l=e.slots=mf();if(e.vnode.shapeFlag&32){const r=t.__;r&&Pi(l,"__",r,!0);const o=t._;o?(yf(l,t,n),n&&Pi(l,"_",o,!0)):_f(t,l)}else t&&pf(e,t)},Mm=(e,t,n)=>{const{vnode:l,slots:r}=e;let o=!0,i=He;if(l.shapeFlag&32){const a=t._;a?n&&a===1?o=!1:yf(r,t,n):(o=!t.$stable,_f(t,r)),i=t}else t&&(pf(e,t),i={default:1});if(o)for(const a in r)!Aa(a)&&i[a]==null&&delete r[a]},dt=Qm;function Fm(e){return qm(e)}function qm(e,t){const n=xo();n.__VUE__=!0;const{insert:l,remove:r,patchProp:o,createElement:i,createText:a,createComment:s,setText:c,setElementText:u,parentNode:f,nextSibling:v,setScopeId:d=Zt,insertStaticContent:b}=e,C=(T,p,D,G=null,K=null,X=null,oe=void 0,h=null,_=!!p.dynamicChildren)=>{if(T===p)return;T&&!Mn(T,p)&&(G=ie(T),q(T,K,X,!0),T=null),p.patchFlag===-2&&(_=!1,p.dynamicChildren=null);const{type:y,ref:V,shapeFlag:j}=p;switch(y){case Ar:E(T,p,D,G);break;case kt:F(T,p,D,G);break;case ii:T==null&&k(p,D,G,oe);break;case Ut:x(T,p,D,G,K,X,oe,h,_);break;default:j&1?L(T,p,D,G,K,X,oe,h,_):j&6?Q(T,p,D,G,K,X,oe,h,_):(j&64||j&128)&&y.process(T,p,D,G,K,X,oe,h,_,Pe)}V!=null&&K?lr(V,T&&T.ref,X,p||T,!p):V==null&&T&&T.ref!=null&&lr(T.ref,null,X,T,!0)},E=(T,p,D,G)=>{if(T==null)l(p.el=a(p.children),D,G);else{const K=p.el=T.el;p.children!==T.children&&c(K,p.children)}},F=(T,p,D,G)=>{T==null?l(p.el=s(p.children||""),D,G):p.el=T.el},k=(T,p,D,G)=>{[T.el,T.anchor]=b(T.children,p,D,G,T.el,T.anchor)},m=({el:T,anchor:p},D,G)=>{let K;for(;T&&T!==p;)K=v(T),l(T,D,G),T=K;l(p,D,G)},g=({el:T,anchor:p})=>{let D;for(;T&&T!==p;)D=v(T),r(T),T=D;r(p)},L=(T,p,D,G,K,X,oe,h,_)=>{p.type==="svg"?oe="svg":p.type==="math"&&(oe="mathml"),T==null?P(p,D,G,K,X,oe,h,_):S(T,p,K,X,oe,h,_)},P=(T,p,D,G,K,X,oe,h)=>{let _,y;const{props:V,shapeFlag:j,transition:z,dirs:M}=T;if(_=T.el=i(T.type,X,V&&V.is,V),j&8?u(_,T.children):j&16&&I(T.children,_,null,G,K,ri(T,X),oe,h),M&&Wn(T,null,G,"created"),A(_,T,T.scopeId,oe,G),V){for(const re in V)re!=="value"&&!Jl(re)&&o(_,re,null,V[re],X,G);"value"in V&&o(_,"value",null,V.value,X),(y=V.onVnodeBeforeMount)&&Ht(y,G,T)}M&&Wn(T,null,G,"beforeMount");const H=$m(K,z);H&&z.beforeEnter(_),l(_,p,D),((y=V&&V.onVnodeMounted)||H||M)&&dt(()=>{y&&Ht(y,G,T),H&&z.enter(_),M&&Wn(T,null,G,"mounted")},K)},A=(T,p,D,G,K)=>{if(D&&d(T,D),G)for(let X=0;X<G.length;X++)d(T,G[X]);if(K){let X=K.subTree;if(p===X||co(X.type)&&(X.ssContent===p||X.ssFallback===p)){const oe=K.vnode;A(T,oe,oe.scopeId,oe.slotScopeIds,K.parent)}}},I=(T,p,D,G,K,X,oe,h,_=0)=>{for(let y=_;y<T.length;y++){const V=T[y]=h?Rn(T[y]):rn(T[y]);C(null,V,p,D,G,K,X,oe,h)}},S=(T,p,D,G,K,X,oe)=>{const h=p.el=T.el;let{patchFlag:_,dynamicChildren:y,dirs:V}=p;_|=T.patchFlag&16;const j=T.props||He,z=p.props||He;let M;if(D&&zn(D,!1),(M=z.onVnodeBeforeUpdate)&&Ht(M,D,p,T),V&&Wn(p,T,D,"beforeUpdate"),D&&zn(D,!0),(j.innerHTML&&z.innerHTML==null||j.textContent&&z.textContent==null)&&u(h,""),y?N(T.dynamicChildren,y,h,D,G,ri(p,K),X):oe||Y(T,p,h,null,D,G,ri(p,K),X,!1),_>0){if(_&16)U(h,j,z,D,K);else if(_&2&&j.class!==z.class&&o(h,"class",null,z.class,K),_&4&&o(h,"style",j.style,z.style,K),_&8){const H=p.dynamicProps;for(let re=0;re<H.length;re++){const ue=H[re],Ee=j[ue],Ae=z[ue];(Ae!==Ee||ue==="value")&&o(h,ue,Ee,Ae,K,D)}}_&1&&T.children!==p.children&&u(h,p.children)}else!oe&&y==null&&U(h,j,z,D,K);((M=z.onVnodeUpdated)||V)&&dt(()=>{M&&Ht(M,D,p,T),V&&Wn(p,T,D,"updated")},G)},N=(T,p,D,G,K,X,oe)=>{for(let h=0;h<p.length;h++){const _=T[h],y=p[h],V=_.el&&(_.type===Ut||!Mn(_,y)||_.shapeFlag&198)?f(_.el):D;C(_,y,V,null,G,K,X,oe,!0)}},U=(T,p,D,G,K)=>{if(p!==D){if(p!==He)for(const X in p)!Jl(X)&&!(X in D)&&o(T,X,p[X],null,K,G);for(const X in D){if(Jl(X))continue;const oe=D[X],h=p[X];oe!==h&&X!=="value"&&o(T,X,h,oe,K,G)}"value"in D&&o(T,"value",p.value,D.value,K)}},x=(T,p,D,G,K,X,oe,h,_)=>{const y=p.el=T?T.el:a(""),V=p.anchor=T?T.anchor:a("");let{patchFlag:j,dynamicChildren:z,slotScopeIds:M}=p;M&&(h=h?h.concat(M):M),T==null?(l(y,D,G),l(V,D,G),I(p.children||[],D,V,K,X,oe,h,_)):j>0&&j&64&&z&&T.dynamicChildren?(N(T.dynamicChildren,z,D,K,X,oe,h),(p.key!=null||K&&p===K.subTree)&&Ia(T,p,!0)):Y(T,p,D,V,K,X,oe,h,_)},Q=(T,p,D,G,K,X,oe,h,_)=>{p.slotScopeIds=h,T==null?p.shapeFlag&512?K.ctx.activate(p,D,G,oe,_):B(p,D,G,K,X,oe,_):te(T,p,_)},B=(T,p,D,G,K,X,oe)=>{const h=T.component=rh(T,G,K);if(Ao(T)&&(h.ctx.renderer=Pe),oh(h,!1,oe),h.asyncDep){if(K&&K.registerDep(h,J,oe),!T.el){const _=h.subTree=be(kt);F(null,_,p,D)}}else J(h,T,p,D,K,X,oe)},te=(T,p,D)=>{const G=p.component=T.component;if(Km(T,p,D))if(G.asyncDep&&!G.asyncResolved){ee(G,p,D);return}else G.next=p,G.update();else p.el=T.el,G.vnode=p},J=(T,p,D,G,K,X,oe)=>{const h=()=>{if(T.isMounted){let{next:j,bu:z,u:M,parent:H,vnode:re}=T;{const ot=Sf(T);if(ot){j&&(j.el=re.el,ee(T,j,oe)),ot.asyncDep.then(()=>{T.isUnmounted||h()});return}}let ue=j,Ee;zn(T,!1),j?(j.el=re.el,ee(T,j,oe)):j=re,z&&Zl(z),(Ee=j.props&&j.props.onVnodeBeforeUpdate)&&Ht(Ee,H,j,re),zn(T,!0);const Ae=oi(T),Fe=T.subTree;T.subTree=Ae,C(Fe,Ae,f(Fe.el),ie(Fe),T,K,X),j.el=Ae.el,ue===null&&Ym(T,Ae.el),M&&dt(M,K),(Ee=j.props&&j.props.onVnodeUpdated)&&dt(()=>Ht(Ee,H,j,re),K)}else{let j;const{el:z,props:M}=p,{bm:H,m:re,parent:ue,root:Ee,type:Ae}=T,Fe=Ol(p);if(zn(T,!1),H&&Zl(H),!Fe&&(j=M&&M.onVnodeBeforeMount)&&Ht(j,ue,p),zn(T,!0),z&&ut){const ot=()=>{T.subTree=oi(T),ut(z,T.subTree,T,K,null)};Fe&&Ae.__asyncHydrate?Ae.__asyncHydrate(z,T,ot):ot()}else{Ee.ce&&Ee.ce._def.shadowRoot!==!1&&Ee.ce._injectChildStyle(Ae);const ot=T.subTree=oi(T);C(null,ot,D,G,T,K,X),p.el=ot.el}if(re&&dt(re,K),!Fe&&(j=M&&M.onVnodeMounted)){const ot=p;dt(()=>Ht(j,ue,ot),K)}(p.shapeFlag&256||ue&&Ol(ue.vnode)&&ue.vnode.shapeFlag&256)&&T.a&&dt(T.a,K),T.isMounted=!0,p=D=G=null}};T.scope.on();const _=T.effect=new Ac(h);T.scope.off();const y=T.update=_.run.bind(_),V=T.job=_.runIfDirty.bind(_);V.i=T,V.id=T.uid,_.scheduler=()=>ka(V),zn(T,!0),y()},ee=(T,p,D)=>{p.component=T;const G=T.vnode.props;T.vnode=p,T.next=null,Om(T,p.props,G,D),Mm(T,p.children,D),Sn(),is(T),wn()},Y=(T,p,D,G,K,X,oe,h,_=!1)=>{const y=T&&T.children,V=T?T.shapeFlag:0,j=p.children,{patchFlag:z,shapeFlag:M}=p;if(z>0){if(z&128){he(y,j,D,G,K,X,oe,h,_);return}else if(z&256){se(y,j,D,G,K,X,oe,h,_);return}}M&8?(V&16&&Z(y,K,X),j!==y&&u(D,j)):V&16?M&16?he(y,j,D,G,K,X,oe,h,_):Z(y,K,X,!0):(V&8&&u(D,""),M&16&&I(j,D,G,K,X,oe,h,_))},se=(T,p,D,G,K,X,oe,h,_)=>{T=T||xl,p=p||xl;const y=T.length,V=p.length,j=Math.min(y,V);let z;for(z=0;z<j;z++){const M=p[z]=_?Rn(p[z]):rn(p[z]);C(T[z],M,D,null,K,X,oe,h,_)}y>V?Z(T,K,X,!0,!1,j):I(p,D,G,K,X,oe,h,_,j)},he=(T,p,D,G,K,X,oe,h,_)=>{let y=0;const V=p.length;let j=T.length-1,z=V-1;for(;y<=j&&y<=z;){const M=T[y],H=p[y]=_?Rn(p[y]):rn(p[y]);if(Mn(M,H))C(M,H,D,null,K,X,oe,h,_);else break;y++}for(;y<=j&&y<=z;){const M=T[j],H=p[z]=_?Rn(p[z]):rn(p[z]);if(Mn(M,H))C(M,H,D,null,K,X,oe,h,_);else break;j--,z--}if(y>j){if(y<=z){const M=z+1,H=M<V?p[M].el:G;for(;y<=z;)C(null,p[y]=_?Rn(p[y]):rn(p[y]),D,H,K,X,oe,h,_),y++}}else if(y>z)for(;y<=j;)q(T[y],K,X,!0),y++;else{const M=y,H=y,re=new Map;for(y=H;y<=z;y++){const mt=p[y]=_?Rn(p[y]):rn(p[y]);mt.key!=null&&re.set(mt.key,y)}let ue,Ee=0;const Ae=z-H+1;let Fe=!1,ot=0;const bt=new Array(Ae);for(y=0;y<Ae;y++)bt[y]=0;for(y=M;y<=j;y++){const mt=T[y];if(Ee>=Ae){q(mt,K,X,!0);continue}let pt;if(mt.key!=null)pt=re.get(mt.key);else for(ue=H;ue<=z;ue++)if(bt[ue-H]===0&&Mn(mt,p[ue])){pt=ue;break}pt===void 0?q(mt,K,X,!0):(bt[pt-H]=y+1,pt>=ot?ot=pt:Fe=!0,C(mt,p[pt],D,null,K,X,oe,h,_),Ee++)}const _t=Fe?Dm(bt):xl;for(ue=_t.length-1,y=Ae-1;y>=0;y--){const mt=H+y,pt=p[mt],xn=mt+1<V?p[mt+1].el:G;bt[y]===0?C(null,pt,D,xn,K,X,oe,h,_):Fe&&(ue<0||y!==_t[ue]?$(pt,D,xn,2):ue--)}}},$=(T,p,D,G,K=null)=>{const{el:X,type:oe,transition:h,children:_,shapeFlag:y}=T;if(y&6){$(T.component.subTree,p,D,G);return}if(y&128){T.suspense.move(p,D,G);return}if(y&64){oe.move(T,p,D,Pe);return}if(oe===Ut){l(X,p,D);for(let j=0;j<_.length;j++)$(_[j],p,D,G);l(T.anchor,p,D);return}if(oe===ii){m(T,p,D);return}if(G!==2&&y&1&&h)if(G===0)h.beforeEnter(X),l(X,p,D),dt(()=>h.enter(X),K);else{const{leave:j,delayLeave:z,afterLeave:M}=h,H=()=>{T.ctx.isUnmounted?r(X):l(X,p,D)},re=()=>{j(X,()=>{H(),M&&M()})};z?z(X,H,re):re()}else l(X,p,D)},q=(T,p,D,G=!1,K=!1)=>{const{type:X,props:oe,ref:h,children:_,dynamicChildren:y,shapeFlag:V,patchFlag:j,dirs:z,cacheIndex:M}=T;if(j===-2&&(K=!1),h!=null&&(Sn(),lr(h,null,D,T,!0),wn()),M!=null&&(p.renderCache[M]=void 0),V&256){p.ctx.deactivate(T);return}const H=V&1&&z,re=!Ol(T);let ue;if(re&&(ue=oe&&oe.onVnodeBeforeUnmount)&&Ht(ue,p,T),V&6)ye(T.component,D,G);else{if(V&128){T.suspense.unmount(D,G);return}H&&Wn(T,null,p,"beforeUnmount"),V&64?T.type.remove(T,p,D,Pe,G):y&&!y.hasOnce&&(X!==Ut||j>0&&j&64)?Z(y,p,D,!1,!0):(X===Ut&&j&384||!K&&V&16)&&Z(_,p,D),G&&ae(T)}(re&&(ue=oe&&oe.onVnodeUnmounted)||H)&&dt(()=>{ue&&Ht(ue,p,T),H&&Wn(T,null,p,"unmounted")},D)},ae=T=>{const{type:p,el:D,anchor:G,transition:K}=T;if(p===Ut){ge(D,G);return}if(p===ii){g(T);return}const X=()=>{r(D),K&&!K.persisted&&K.afterLeave&&K.afterLeave()};if(T.shapeFlag&1&&K&&!K.persisted){const{leave:oe,delayLeave:h}=K,_=()=>oe(D,X);h?h(T.el,X,_):_()}else X()},ge=(T,p)=>{let D;for(;T!==p;)D=v(T),r(T),T=D;r(p)},ye=(T,p,D)=>{const{bum:G,scope:K,job:X,subTree:oe,um:h,m:_,a:y,parent:V,slots:{__:j}}=T;uo(_),uo(y),G&&Zl(G),V&&we(j)&&j.forEach(z=>{V.renderCache[z]=void 0}),K.stop(),X&&(X.flags|=8,q(oe,T,p,D)),h&&dt(h,p),dt(()=>{T.isUnmounted=!0},p),p&&p.pendingBranch&&!p.isUnmounted&&T.asyncDep&&!T.asyncResolved&&T.suspenseId===p.pendingId&&(p.deps--,p.deps===0&&p.resolve())},Z=(T,p,D,G=!1,K=!1,X=0)=>{for(let oe=X;oe<T.length;oe++)q(T[oe],p,D,G,K)},ie=T=>{if(T.shapeFlag&6)return ie(T.component.subTree);if(T.shapeFlag&128)return T.suspense.next();const p=v(T.anchor||T.el),D=p&&p[Zc];return D?v(D):p};let xe=!1;const le=(T,p,D)=>{T==null?p._vnode&&q(p._vnode,null,null,!0):C(p._vnode||null,T,p,null,null,null,D),p._vnode=T,xe||(xe=!0,is(),Gc(),xe=!1)},Pe={p:C,um:q,m:$,r:ae,mt:B,mc:I,pc:Y,pbc:N,n:ie,o:e};let $e,ut;return t&&([$e,ut]=t(Pe)),{render:le,hydrate:$e,createApp:Lm(le,$e)}}function ri({type:e,props:t},n){return n==="svg"&&e==="foreignObject"||n==="mathml"&&e==="annotation-xml"&&t&&t.encoding&&t.encoding.includes("html")?void 0:n}function zn({effect:e,job:t},n){n?(e.flags|=32,t.flags|=4):(e.flags&=-33,t.flags&=-5)}function $m(e,t){return(!e||e&&!e.pendingBranch)&&t&&!t.persisted}function Ia(e,t,n=!1){const l=e.children,r=t.children;if(we(l)&&we(r))for(let o=0;o<l.length;o++){const i=l[o];let a=r[o];a.shapeFlag&1&&!a.dynamicChildren&&((a.patchFlag<=0||a.patchFlag===32)&&(a=r[o]=Rn(r[o]),a.el=i.el),!n&&a.patchFlag!==-2&&Ia(i,a)),a.type===Ar&&(a.el=i.el),a.type===kt&&!a.el&&(a.el=i.el)}}function Dm(e){const t=e.slice(),n=[0];let l,r,o,i,a;const s=e.length;for(l=0;l<s;l++){const c=e[l];if(c!==0){if(r=n[n.length-1],e[r]<c){t[l]=r,n.push(l);continue}for(o=0,i=n.length-1;o<i;)a=o+i>>1,e[n[a]]<c?o=a+1:i=a;c<e[n[o]]&&(o>0&&(t[l]=n[o-1]),n[o]=l)}}for(o=n.length,i=n[o-1];o-- >0;)n[o]=i,i=t[i];return n}function Sf(e){const t=e.subTree.component;if(t)return t.asyncDep&&!t.asyncResolved?t:Sf(t)}function uo(e){if(e)for(let t=0;t<e.length;t++)e[t].flags|=8}const Vm=Symbol.for("v-scx"),Bm=()=>Kt(Vm);function me(e,t,n){return wf(e,t,n)}function wf(e,t,n=He){const{immediate:l,deep:r,flush:o,once:i}=n,a=ft({},n),s=t&&l||!t&&o!=="post";let c;if(mr){if(o==="sync"){const d=Bm();c=d.__watcherHandles||(d.__watcherHandles=[])}else if(!s){const d=()=>{};return d.stop=Zt,d.resume=Zt,d.pause=Zt,d}}const u=St;a.call=(d,b,C)=>nn(d,u,b,C);let f=!1;o==="post"?a.scheduler=d=>{dt(d,u&&u.suspense)}:o!=="sync"&&(f=!0,a.scheduler=(d,b)=>{b?d():ka(d)}),a.augmentJob=d=>{t&&(d.flags|=4),f&&(d.flags|=2,u&&(d.id=u.uid,d.i=u))};const v=rm(e,t,a);return mr&&(c?c.push(v):s&&v()),v}function Hm(e,t,n){const l=this.proxy,r=rt(e)?e.includes(".")?Cf(l,e):()=>l[e]:e.bind(l,l);let o;ke(t)?o=t:(o=t.handler,n=t);const i=Or(this),a=wf(r,o.bind(l),n);return i(),a}function Cf(e,t){const n=t.split(".");return()=>{let l=e;for(let r=0;r<n.length&&l;r++)l=l[n[r]];return l}}const Um=(e,t)=>t==="modelValue"||t==="model-value"?e.modelModifiers:e[`${t}Modifiers`]||e[`${qn(t)}Modifiers`]||e[`${fl(t)}Modifiers`];function jm(e,t,...n){if(e.isUnmounted)return;const l=e.vnode.props||He;let r=n;const o=t.startsWith("update:"),i=o&&Um(l,t.slice(7));i&&(i.trim&&(r=n.map(u=>rt(u)?u.trim():u)),i.number&&(r=n.map(Sv)));let a,s=l[a=Go(t)]||l[a=Go(qn(t))];!s&&o&&(s=l[a=Go(fl(t))]),s&&nn(s,e,6,r);const c=l[a+"Once"];if(c){if(!e.emitted)e.emitted={};else if(e.emitted[a])return;e.emitted[a]=!0,nn(c,e,6,r)}}function Ef(e,t,n=!1){const l=t.emitsCache,r=l.get(e);if(r!==void 0)return r;const o=e.emits;let i={},a=!1;if(!ke(e)){const s=c=>{const u=Ef(c,t,!0);u&&(a=!0,ft(i,u))};!n&&t.mixins.length&&t.mixins.forEach(s),e.extends&&s(e.extends),e.mixins&&e.mixins.forEach(s)}return!o&&!a?(et(e)&&l.set(e,null),null):(we(o)?o.forEach(s=>i[s]=null):ft(i,o),et(e)&&l.set(e,i),i)}function Ro(e,t){return!e||!To(t)?!1:(t=t.slice(2).replace(/Once$/,""),De(e,t[0].toLowerCase()+t.slice(1))||De(e,fl(t))||De(e,t))}function oi(e){const{type:t,vnode:n,proxy:l,withProxy:r,propsOptions:[o],slots:i,attrs:a,emit:s,render:c,renderCache:u,props:f,data:v,setupState:d,ctx:b,inheritAttrs:C}=e,E=ao(e);let F,k;try{if(n.shapeFlag&4){const g=r||l,L=g;F=rn(c.call(L,g,u,f,d,v,b)),k=a}else{const g=t;F=rn(g.length>1?g(f,{attrs:a,slots:i,emit:s}):g(f,null)),k=t.props?a:Wm(a)}}catch(g){or.length=0,Lo(g,e,1),F=be(kt)}let m=F;if(k&&C!==!1){const g=Object.keys(k),{shapeFlag:L}=m;g.length&&L&7&&(o&&g.some(da)&&(k=zm(k,o)),m=Cn(m,k,!1,!0))}return n.dirs&&(m=Cn(m,null,!1,!0),m.dirs=m.dirs?m.dirs.concat(n.dirs):n.dirs),n.transition&&Fl(m,n.transition),F=m,ao(E),F}const Wm=e=>{let t;for(const n in e)(n==="class"||n==="style"||To(n))&&((t||(t={}))[n]=e[n]);return t},zm=(e,t)=>{const n={};for(const l in e)(!da(l)||!(l.slice(9)in t))&&(n[l]=e[l]);return n};function Km(e,t,n){const{props:l,children:r,component:o}=e,{props:i,children:a,patchFlag:s}=t,c=o.emitsOptions;if(t.dirs||t.transition)return!0;if(n&&s>=0){if(s&1024)return!0;if(s&16)return l?gs(l,i,c):!!i;if(s&8){const u=t.dynamicProps;for(let f=0;f<u.length;f++){const v=u[f];if(i[v]!==l[v]&&!Ro(c,v))return!0}}}else return(r||a)&&(!a||!a.$stable)?!0:l===i?!1:l?i?gs(l,i,c):!0:!!i;return!1}function gs(e,t,n){const l=Object.keys(t);if(l.length!==Object.keys(e).length)return!0;for(let r=0;r<l.length;r++){const o=l[r];if(t[o]!==e[o]&&!Ro(n,o))return!0}return!1}function Ym({vnode:e,parent:t},n){for(;t;){const l=t.subTree;if(l.suspense&&l.suspense.activeBranch===e&&(l.el=e.el),l===e)(e=t.vnode).el=n,t=t.parent;else break}}const co=e=>e.__isSuspense;function Qm(e,t){t&&t.pendingBranch?we(e)?t.effects.push(...e):t.effects.push(e):am(e)}const Ut=Symbol.for("v-fgt"),Ar=Symbol.for("v-txt"),kt=Symbol.for("v-cmt"),ii=Symbol.for("v-stc"),or=[];let qt=null;function el(e=!1){or.push(qt=e?null:[])}function Gm(){or.pop(),qt=or[or.length-1]||null}let dr=1;function bs(e,t=!1){dr+=e,e<0&&qt&&t&&(qt.hasOnce=!0)}function Xm(e){return e.dynamicChildren=dr>0?qt||xl:null,Gm(),dr>0&&qt&&qt.push(e),e}function tl(e,t,n,l,r){return Xm(be(e,t,n,l,r,!0))}function vr(e){return e?e.__v_isVNode===!0:!1}function Mn(e,t){return e.type===t.type&&e.key===t.key}const Tf=({key:e})=>e??null,Zr=({ref:e,ref_key:t,ref_for:n})=>(typeof e=="number"&&(e=""+e),e!=null?rt(e)||lt(e)||ke(e)?{i:Nt,r:e,k:t,f:!!n}:e:null);function Il(e,t=null,n=null,l=0,r=null,o=e===Ut?0:1,i=!1,a=!1){const s={__v_isVNode:!0,__v_skip:!0,type:e,props:t,key:t&&Tf(t),ref:t&&Zr(t),scopeId:Jc,slotScopeIds:null,children:n,component:null,suspense:null,ssContent:null,ssFallback:null,dirs:null,transition:null,el:null,anchor:null,target:null,targetStart:null,targetAnchor:null,staticCount:0,shapeFlag:o,patchFlag:l,dynamicProps:r,dynamicChildren:null,appContext:null,ctx:Nt};return a?(Ra(s,n),o&128&&e.normalize(s)):n&&(s.shapeFlag|=rt(n)?8:16),dr>0&&!i&&qt&&(s.patchFlag>0||o&6)&&s.patchFlag!==32&&qt.push(s),s}const be=Jm;function Jm(e,t=null,n=null,l=0,r=null,o=!1){if((!e||e===ym)&&(e=kt),vr(e)){const a=Cn(e,t,!0);return n&&Ra(a,n),dr>0&&!o&&qt&&(a.shapeFlag&6?qt[qt.indexOf(e)]=a:qt.push(a)),a.patchFlag=-2,a}if(uh(e)&&(e=e.__vccOpts),t){t=Zm(t);let{class:a,style:s}=t;a&&!rt(a)&&(t.class=ga(a)),et(s)&&(Ta(s)&&!we(s)&&(s=ft({},s)),t.style=ha(s))}const i=rt(e)?1:co(e)?128:ef(e)?64:et(e)?4:ke(e)?2:0;return Il(e,t,n,l,r,i,o,!0)}function Zm(e){return e?Ta(e)||hf(e)?ft({},e):e:null}function Cn(e,t,n=!1,l=!1){const{props:r,ref:o,patchFlag:i,children:a,transition:s}=e,c=t?th(r||{},t):r,u={__v_isVNode:!0,__v_skip:!0,type:e.type,props:c,key:c&&Tf(c),ref:t&&t.ref?n&&o?we(o)?o.concat(Zr(t)):[o,Zr(t)]:Zr(t):o,scopeId:e.scopeId,slotScopeIds:e.slotScopeIds,children:a,target:e.target,targetStart:e.targetStart,targetAnchor:e.targetAnchor,staticCount:e.staticCount,shapeFlag:e.shapeFlag,patchFlag:t&&e.type!==Ut?i===-1?16:i|16:i,dynamicProps:e.dynamicProps,dynamicChildren:e.dynamicChildren,appContext:e.appContext,dirs:e.dirs,transition:s,component:e.component,suspense:e.suspense,ssContent:e.ssContent&&Cn(e.ssContent),ssFallback:e.ssFallback&&Cn(e.ssFallback),el:e.el,anchor:e.anchor,ctx:e.ctx,ce:e.ce};return s&&l&&Fl(u,s.clone(u)),u}function Gl(e=" ",t=0){return be(Ar,null,e,t)}function eh(e="",t=!1){return t?(el(),tl(kt,null,e)):be(kt,null,e)}function rn(e){return e==null||typeof e=="boolean"?be(kt):we(e)?be(Ut,null,e.slice()):vr(e)?Rn(e):be(Ar,null,String(e))}function Rn(e){return e.el===null&&e.patchFlag!==-1||e.memo?e:Cn(e)}function Ra(e,t){let n=0;const{shapeFlag:l}=e;if(t==null)t=null;else if(we(t))n=16;else if(typeof t=="object")if(l&65){const r=t.default;r&&(r._c&&(r._d=!1),Ra(e,r()),r._c&&(r._d=!0));return}else{n=32;const r=t._;!r&&!hf(t)?t._ctx=Nt:r===3&&Nt&&(Nt.slots._===1?t._=1:(t._=2,e.patchFlag|=1024))}else ke(t)?(t={default:t,_ctx:Nt},n=32):(t=String(t),l&64?(n=16,t=[Gl(t)]):n=8);e.children=t,e.shapeFlag|=n}function th(...e){const t={};for(let n=0;n<e.length;n++){const l=e[n];for(const r in l)if(r==="class")t.class!==l.class&&(t.class=ga([t.class,l.class]));else if(r==="style")t.style=ha([t.style,l.style]);else if(To(r)){const o=t[r],i=l[r];i&&o!==i&&!(we(o)&&o.includes(i))&&(t[r]=o?[].concat(o,i):i)}else r!==""&&(t[r]=l[r])}return t}function Ht(e,t,n,l=null){nn(e,t,7,[n,l])}const nh=df();let lh=0;function rh(e,t,n){const l=e.type,r=(t?t.appContext:e.appContext)||nh,o={uid:lh++,vnode:e,type:l,parent:t,appContext:r,root:null,next:null,subTree:null,effect:null,update:null,job:null,scope:new Lc(!0),render:null,proxy:null,exposed:null,exposeProxy:null,withProxy:null,provides:t?t.provides:Object.create(r.provides),ids:t?t.ids:["",0,0],accessCache:null,renderCache:[],components:null,directives:null,propsOptions:bf(l,r),emitsOptions:Ef(l,r),emit:null,emitted:null,propsDefaults:He,inheritAttrs:l.inheritAttrs,ctx:He,data:He,props:He,attrs:He,slots:He,refs:He,setupState:He,setupContext:null,suspense:n,suspenseId:n?n.pendingId:0,asyncDep:null,asyncResolved:!1,isMounted:!1,isUnmounted:!1,isDeactivated:!1,bc:null,c:null,bm:null,m:null,bu:null,u:null,um:null,bum:null,da:null,a:null,rtg:null,rtc:null,ec:null,sp:null};return o.ctx={_:o},o.root=t?t.root:o,o.emit=jm.bind(null,o),e.ce&&e.ce(o),o}let St=null;const Ce=()=>St||Nt;let fo,Vi;{const e=xo(),t=(n,l)=>{let r;return(r=e[n])||(r=e[n]=[]),r.push(l),o=>{r.length>1?r.forEach(i=>i(o)):r[0](o)}};fo=t("__VUE_INSTANCE_SETTERS__",n=>St=n),Vi=t("__VUE_SSR_SETTERS__",n=>mr=n)}const Or=e=>{const t=St;return fo(e),e.scope.on(),()=>{e.scope.off(),fo(t)}},_s=()=>{St&&St.scope.off(),fo(null)};function kf(e){return e.vnode.shapeFlag&4}let mr=!1;function oh(e,t=!1,n=!1){t&&Vi(t);const{props:l,children:r}=e.vnode,o=kf(e);Am(e,l,o,t),Nm(e,r,n||t);const i=o?ih(e,t):void 0;return t&&Vi(!1),i}function ih(e,t){const n=e.type;e.accessCache=Object.create(null),e.proxy=new Proxy(e.ctx,Sm);const{setup:l}=n;if(l){Sn();const r=e.setupContext=l.length>1?sh(e):null,o=Or(e),i=kr(l,e,0,[e.props,r]),a=Sc(i);if(wn(),o(),(a||e.sp)&&!Ol(e)&&uf(e),a){if(i.then(_s,_s),t)return i.then(s=>{ps(e,s,t)}).catch(s=>{Lo(s,e,0)});e.asyncDep=i}else ps(e,i,t)}else xf(e,t)}function ps(e,t,n){ke(t)?e.type.__ssrInlineRender?e.ssrRender=t:e.render=t:et(t)&&(e.setupState=Kc(t)),xf(e,n)}let ys;function xf(e,t,n){const l=e.type;if(!e.render){if(!t&&ys&&!l.render){const r=l.template||Pa(e).template;if(r){const{isCustomElement:o,compilerOptions:i}=e.appContext.config,{delimiters:a,compilerOptions:s}=l,c=ft(ft({isCustomElement:o,delimiters:a},i),s);l.render=ys(r,c)}}e.render=l.render||Zt}{const r=Or(e);Sn();try{wm(e)}finally{wn(),r()}}}const ah={get(e,t){return Tt(e,"get",""),e[t]}};function sh(e){const t=n=>{e.exposed=n||{}};return{attrs:new Proxy(e.attrs,ah),slots:e.slots,emit:e.emit,expose:t}}function No(e){return e.exposed?e.exposeProxy||(e.exposeProxy=new Proxy(Kc(Tr(e.exposed)),{get(t,n){if(n in t)return t[n];if(n in rr)return rr[n](e)},has(t,n){return n in t||n in rr}})):e.proxy}function Ss(e,t=!0){return ke(e)?e.displayName||e.name:e.name||t&&e.__name}function uh(e){return ke(e)&&"__vccOpts"in e}const w=(e,t)=>nm(e,t,mr);function O(e,t,n){const l=arguments.length;return l===2?et(t)&&!we(t)?vr(t)?be(e,null,[t]):be(e,t):be(e,null,t):(l>3?n=Array.prototype.slice.call(arguments,2):l===3&&vr(n)&&(n=[n]),be(e,t,n))}const ch="3.5.17";/**
-* @vue/runtime-dom v3.5.17
-* (c) 2018-present Yuxi (Evan) You and Vue contributors
-* @license MIT
-**/let Bi;const ws=typeof window<"u"&&window.trustedTypes;if(ws)try{Bi=ws.createPolicy("vue",{createHTML:e=>e})}catch{}const Lf=Bi?e=>Bi.createHTML(e):e=>e,fh="http://www.w3.org/2000/svg",dh="http://www.w3.org/1998/Math/MathML",gn=typeof document<"u"?document:null,Cs=gn&&gn.createElement("template"),vh={insert:(e,t,n)=>{t.insertBefore(e,n||null)},remove:e=>{const t=e.parentNode;t&&t.removeChild(e)},createElement:(e,t,n,l)=>{const r=t==="svg"?gn.createElementNS(fh,e):t==="mathml"?gn.createElementNS(dh,e):n?gn.createElement(e,{is:n}):gn.createElement(e);return e==="select"&&l&&l.multiple!=null&&r.setAttribute("multiple",l.multiple),r},createText:e=>gn.createTextNode(e),createComment:e=>gn.createComment(e),setText:(e,t)=>{e.nodeValue=t},setElementText:(e,t)=>{e.textContent=t},parentNode:e=>e.parentNode,nextSibling:e=>e.nextSibling,querySelector:e=>gn.querySelector(e),setScopeId(e,t){e.setAttribute(t,"")},insertStaticContent(e,t,n,l,r,o){const i=n?n.previousSibling:t.lastChild;if(r&&(r===o||r.nextSibling))for(;t.insertBefore(r.cloneNode(!0),n),!(r===o||!(r=r.nextSibling)););else{Cs.innerHTML=Lf(l==="svg"?`<svg>${e}</svg>`:l==="mathml"?`<math>${e}</math>`:e);const a=Cs.content;if(l==="svg"||l==="mathml"){const s=a.firstChild;for(;s.firstChild;)a.appendChild(s.firstChild);a.removeChild(s)}t.insertBefore(a,n)}return[i?i.nextSibling:t.firstChild,n?n.previousSibling:t.lastChild]}},Ln="transition",Ul="animation",hr=Symbol("_vtc"),Pf={name:String,type:String,css:{type:Boolean,default:!0},duration:[String,Number,Object],enterFromClass:String,enterActiveClass:String,enterToClass:String,appearFromClass:String,appearActiveClass:String,appearToClass:String,leaveFromClass:String,leaveActiveClass:String,leaveToClass:String},mh=ft({},lf,Pf),hh=e=>(e.displayName="Transition",e.props=mh,e),ql=hh((e,{slots:t})=>O(dm,gh(e),t)),Kn=(e,t=[])=>{we(e)?e.forEach(n=>n(...t)):e&&e(...t)},Es=e=>e?we(e)?e.some(t=>t.length>1):e.length>1:!1;function gh(e){const t={};for(const x in e)x in Pf||(t[x]=e[x]);if(e.css===!1)return t;const{name:n="v",type:l,duration:r,enterFromClass:o=`${n}-enter-from`,enterActiveClass:i=`${n}-enter-active`,enterToClass:a=`${n}-enter-to`,appearFromClass:s=o,appearActiveClass:c=i,appearToClass:u=a,leaveFromClass:f=`${n}-leave-from`,leaveActiveClass:v=`${n}-leave-active`,leaveToClass:d=`${n}-leave-to`}=e,b=bh(r),C=b&&b[0],E=b&&b[1],{onBeforeEnter:F,onEnter:k,onEnterCancelled:m,onLeave:g,onLeaveCancelled:L,onBeforeAppear:P=F,onAppear:A=k,onAppearCancelled:I=m}=t,S=(x,Q,B,te)=>{x._enterCancelled=te,Yn(x,Q?u:a),Yn(x,Q?c:i),B&&B()},N=(x,Q)=>{x._isLeaving=!1,Yn(x,f),Yn(x,d),Yn(x,v),Q&&Q()},U=x=>(Q,B)=>{const te=x?A:k,J=()=>S(Q,x,B);Kn(te,[Q,J]),Ts(()=>{Yn(Q,x?s:o),vn(Q,x?u:a),Es(te)||ks(Q,l,C,J)})};return ft(t,{onBeforeEnter(x){Kn(F,[x]),vn(x,o),vn(x,i)},onBeforeAppear(x){Kn(P,[x]),vn(x,s),vn(x,c)},onEnter:U(!1),onAppear:U(!0),onLeave(x,Q){x._isLeaving=!0;const B=()=>N(x,Q);vn(x,f),x._enterCancelled?(vn(x,v),Ps()):(Ps(),vn(x,v)),Ts(()=>{x._isLeaving&&(Yn(x,f),vn(x,d),Es(g)||ks(x,l,E,B))}),Kn(g,[x,B])},onEnterCancelled(x){S(x,!1,void 0,!0),Kn(m,[x])},onAppearCancelled(x){S(x,!0,void 0,!0),Kn(I,[x])},onLeaveCancelled(x){N(x),Kn(L,[x])}})}function bh(e){if(e==null)return null;if(et(e))return[ai(e.enter),ai(e.leave)];{const t=ai(e);return[t,t]}}function ai(e){return wv(e)}function vn(e,t){t.split(/\s+/).forEach(n=>n&&e.classList.add(n)),(e[hr]||(e[hr]=new Set)).add(t)}function Yn(e,t){t.split(/\s+/).forEach(l=>l&&e.classList.remove(l));const n=e[hr];n&&(n.delete(t),n.size||(e[hr]=void 0))}function Ts(e){requestAnimationFrame(()=>{requestAnimationFrame(e)})}let _h=0;function ks(e,t,n,l){const r=e._endId=++_h,o=()=>{r===e._endId&&l()};if(n!=null)return setTimeout(o,n);const{type:i,timeout:a,propCount:s}=ph(e,t);if(!i)return l();const c=i+"end";let u=0;const f=()=>{e.removeEventListener(c,v),o()},v=d=>{d.target===e&&++u>=s&&f()};setTimeout(()=>{u<s&&f()},a+1),e.addEventListener(c,v)}function ph(e,t){const n=window.getComputedStyle(e),l=b=>(n[b]||"").split(", "),r=l(`${Ln}Delay`),o=l(`${Ln}Duration`),i=xs(r,o),a=l(`${Ul}Delay`),s=l(`${Ul}Duration`),c=xs(a,s);let u=null,f=0,v=0;t===Ln?i>0&&(u=Ln,f=i,v=o.length):t===Ul?c>0&&(u=Ul,f=c,v=s.length):(f=Math.max(i,c),u=f>0?i>c?Ln:Ul:null,v=u?u===Ln?o.length:s.length:0);const d=u===Ln&&/\b(transform|all)(,|$)/.test(l(`${Ln}Property`).toString());return{type:u,timeout:f,propCount:v,hasTransform:d}}function xs(e,t){for(;e.length<t.length;)e=e.concat(e);return Math.max(...t.map((n,l)=>Ls(n)+Ls(e[l])))}function Ls(e){return e==="auto"?0:Number(e.slice(0,-1).replace(",","."))*1e3}function Ps(){return document.body.offsetHeight}function yh(e,t,n){const l=e[hr];l&&(t=(t?[t,...l]:[...l]).join(" ")),t==null?e.removeAttribute("class"):n?e.setAttribute("class",t):e.className=t}const As=Symbol("_vod"),Sh=Symbol("_vsh"),wh=Symbol(""),Ch=/(^|;)\s*display\s*:/;function Eh(e,t,n){const l=e.style,r=rt(n);let o=!1;if(n&&!r){if(t)if(rt(t))for(const i of t.split(";")){const a=i.slice(0,i.indexOf(":")).trim();n[a]==null&&eo(l,a,"")}else for(const i in t)n[i]==null&&eo(l,i,"");for(const i in n)i==="display"&&(o=!0),eo(l,i,n[i])}else if(r){if(t!==n){const i=l[wh];i&&(n+=";"+i),l.cssText=n,o=Ch.test(n)}}else t&&e.removeAttribute("style");As in e&&(e[As]=o?l.display:"",e[Sh]&&(l.display="none"))}const Os=/\s*!important$/;function eo(e,t,n){if(we(n))n.forEach(l=>eo(e,t,l));else if(n==null&&(n=""),t.startsWith("--"))e.setProperty(t,n);else{const l=Th(e,t);Os.test(n)?e.setProperty(fl(l),n.replace(Os,""),"important"):e[l]=n}}const Is=["Webkit","Moz","ms"],si={};function Th(e,t){const n=si[t];if(n)return n;let l=qn(t);if(l!=="filter"&&l in e)return si[t]=l;l=Ec(l);for(let r=0;r<Is.length;r++){const o=Is[r]+l;if(o in e)return si[t]=o}return t}const Rs="http://www.w3.org/1999/xlink";function Ns(e,t,n,l,r,o=Lv(t)){l&&t.startsWith("xlink:")?n==null?e.removeAttributeNS(Rs,t.slice(6,t.length)):e.setAttributeNS(Rs,t,n):n==null||o&&!Tc(n)?e.removeAttribute(t):e.setAttribute(t,o?"":Dn(n)?String(n):n)}function Ms(e,t,n,l,r){if(t==="innerHTML"||t==="textContent"){n!=null&&(e[t]=t==="innerHTML"?Lf(n):n);return}const o=e.tagName;if(t==="value"&&o!=="PROGRESS"&&!o.includes("-")){const a=o==="OPTION"?e.getAttribute("value")||"":e.value,s=n==null?e.type==="checkbox"?"on":"":String(n);(a!==s||!("_value"in e))&&(e.value=s),n==null&&e.removeAttribute(t),e._value=n;return}let i=!1;if(n===""||n==null){const a=typeof e[t];a==="boolean"?n=Tc(n):n==null&&a==="string"?(n="",i=!0):a==="number"&&(n=0,i=!0)}try{e[t]=n}catch{}i&&e.removeAttribute(r||t)}function kh(e,t,n,l){e.addEventListener(t,n,l)}function xh(e,t,n,l){e.removeEventListener(t,n,l)}const Fs=Symbol("_vei");function Lh(e,t,n,l,r=null){const o=e[Fs]||(e[Fs]={}),i=o[t];if(l&&i)i.value=l;else{const[a,s]=Ph(t);if(l){const c=o[t]=Ih(l,r);kh(e,a,c,s)}else i&&(xh(e,a,i,s),o[t]=void 0)}}const qs=/(?:Once|Passive|Capture)$/;function Ph(e){let t;if(qs.test(e)){t={};let l;for(;l=e.match(qs);)e=e.slice(0,e.length-l[0].length),t[l[0].toLowerCase()]=!0}return[e[2]===":"?e.slice(3):fl(e.slice(2)),t]}let ui=0;const Ah=Promise.resolve(),Oh=()=>ui||(Ah.then(()=>ui=0),ui=Date.now());function Ih(e,t){const n=l=>{if(!l._vts)l._vts=Date.now();else if(l._vts<=n.attached)return;nn(Rh(l,n.value),t,5,[l])};return n.value=e,n.attached=Oh(),n}function Rh(e,t){if(we(t)){const n=e.stopImmediatePropagation;return e.stopImmediatePropagation=()=>{n.call(e),e._stopped=!0},t.map(l=>r=>!r._stopped&&l&&l(r))}else return t}const $s=e=>e.charCodeAt(0)===111&&e.charCodeAt(1)===110&&e.charCodeAt(2)>96&&e.charCodeAt(2)<123,Nh=(e,t,n,l,r,o)=>{const i=r==="svg";t==="class"?yh(e,l,i):t==="style"?Eh(e,n,l):To(t)?da(t)||Lh(e,t,n,l,o):(t[0]==="."?(t=t.slice(1),!0):t[0]==="^"?(t=t.slice(1),!1):Mh(e,t,l,i))?(Ms(e,t,l),!e.tagName.includes("-")&&(t==="value"||t==="checked"||t==="selected")&&Ns(e,t,l,i,o,t!=="value")):e._isVueCE&&(/[A-Z]/.test(t)||!rt(l))?Ms(e,qn(t),l,o,t):(t==="true-value"?e._trueValue=l:t==="false-value"&&(e._falseValue=l),Ns(e,t,l,i))};function Mh(e,t,n,l){if(l)return!!(t==="innerHTML"||t==="textContent"||t in e&&$s(t)&&ke(n));if(t==="spellcheck"||t==="draggable"||t==="translate"||t==="autocorrect"||t==="form"||t==="list"&&e.tagName==="INPUT"||t==="type"&&e.tagName==="TEXTAREA")return!1;if(t==="width"||t==="height"){const r=e.tagName;if(r==="IMG"||r==="VIDEO"||r==="CANVAS"||r==="SOURCE")return!1}return $s(t)&&rt(n)?!1:t in e}const Fh=ft({patchProp:Nh},vh);let Ds;function qh(){return Ds||(Ds=Fm(Fh))}const $h=(...e)=>{const t=qh().createApp(...e),{mount:n}=t;return t.mount=l=>{const r=Vh(l);if(!r)return;const o=t._component;!ke(o)&&!o.render&&!o.template&&(o.template=r.innerHTML),r.nodeType===1&&(r.textContent="");const i=n(r,!1,Dh(r));return r instanceof Element&&(r.removeAttribute("v-cloak"),r.setAttribute("data-v-app","")),i},t};function Dh(e){if(e instanceof SVGElement)return"svg";if(typeof MathMLElement=="function"&&e instanceof MathMLElement)return"mathml"}function Vh(e){return rt(e)?document.querySelector(e):e}const Hi={xs:18,sm:24,md:32,lg:38,xl:46},Ir={size:String};function Rr(e,t=Hi){return w(()=>e.size!==void 0?{fontSize:e.size in t?`${t[e.size]}px`:e.size}:null)}function Wt(e,t,n,l){return Object.defineProperty(e,t,{get:n,set:l,enumerable:!0}),e}function Bh(e,t){for(const n in t)Wt(e,n,t[n]);return e}function Re(e){return Tr(Po(e))}function Na(e){return Tr(e)}const Mo=(e,t)=>{const n=an(e);for(const l in e)Wt(t,l,()=>n[l],r=>{n[l]=r});return t};function Ve(e,t){return e!==void 0&&e()||t}function Ma(e,t){if(e!==void 0){const n=e();if(n!=null)return n.slice()}return t}function Gt(e,t){return e!==void 0?t.concat(e()):t}function Hh(e,t){return e===void 0?t:t!==void 0?t.concat(e()):e()}function Af(e,t,n,l,r,o){t.key=l+r;const i=O(e,t,n);return r===!0?Ml(i,o()):i}const Vs="0 0 24 24",ci=e=>e,fi=e=>`ionicons ${e}`,Of={"mdi-":e=>`mdi ${e}`,"icon-":ci,"bt-":e=>`bt ${e}`,"eva-":e=>`eva ${e}`,"ion-md":fi,"ion-ios":fi,"ion-logo":fi,"iconfont ":ci,"ti-":e=>`themify-icon ${e}`,"bi-":e=>`bootstrap-icons ${e}`,"i-":ci},If={o_:"-outlined",r_:"-round",s_:"-sharp"},Rf={sym_o_:"-outlined",sym_r_:"-rounded",sym_s_:"-sharp"},Uh=new RegExp("^("+Object.keys(Of).join("|")+")"),jh=new RegExp("^("+Object.keys(If).join("|")+")"),Bs=new RegExp("^("+Object.keys(Rf).join("|")+")"),Wh=/^[Mm]\s?[-+]?\.?\d/,zh=/^img:/,Kh=/^svguse:/,Yh=/^ion-/,Qh=/^(fa-(classic|sharp|solid|regular|light|brands|duotone|thin)|[lf]a[srlbdk]?) /,xt=Re({name:"QIcon",props:{...Ir,tag:{type:String,default:"i"},name:String,color:String,left:Boolean,right:Boolean},setup(e,{slots:t}){const{proxy:{$q:n}}=Ce(),l=Rr(e),r=w(()=>"q-icon"+(e.left===!0?" on-left":"")+(e.right===!0?" on-right":"")+(e.color!==void 0?` text-${e.color}`:"")),o=w(()=>{let i,a=e.name;if(a==="none"||!a)return{none:!0};if(n.iconMapFn!==null){const u=n.iconMapFn(a);if(u!==void 0)if(u.icon!==void 0){if(a=u.icon,a==="none"||!a)return{none:!0}}else return{cls:u.cls,content:u.content!==void 0?u.content:" "}}if(Wh.test(a)===!0){const[u,f=Vs]=a.split("|");return{svg:!0,viewBox:f,nodes:u.split("&&").map(v=>{const[d,b,C]=v.split("@@");return O("path",{style:b,d,transform:C})})}}if(zh.test(a)===!0)return{img:!0,src:a.substring(4)};if(Kh.test(a)===!0){const[u,f=Vs]=a.split("|");return{svguse:!0,src:u.substring(7),viewBox:f}}let s=" ";const c=a.match(Uh);if(c!==null)i=Of[c[1]](a);else if(Qh.test(a)===!0)i=a;else if(Yh.test(a)===!0)i=`ionicons ion-${n.platform.is.ios===!0?"ios":"md"}${a.substring(3)}`;else if(Bs.test(a)===!0){i="notranslate material-symbols";const u=a.match(Bs);u!==null&&(a=a.substring(6),i+=Rf[u[1]]),s=a}else{i="notranslate material-icons";const u=a.match(jh);u!==null&&(a=a.substring(2),i+=If[u[1]]),s=a}return{cls:i,content:s}});return()=>{const i={class:r.value,style:l.value,"aria-hidden":"true"};return o.value.none===!0?O(e.tag,i,Ve(t.default)):o.value.img===!0?O(e.tag,i,Gt(t.default,[O("img",{src:o.value.src})])):o.value.svg===!0?O(e.tag,i,Gt(t.default,[O("svg",{viewBox:o.value.viewBox||"0 0 24 24"},o.value.nodes)])):o.value.svguse===!0?O(e.tag,i,Gt(t.default,[O("svg",{viewBox:o.value.viewBox},[O("use",{"xlink:href":o.value.src})])])):(o.value.cls!==void 0&&(i.class+=" "+o.value.cls),O(e.tag,i,Gt(t.default,[o.value.content])))}}}),Gh=Re({name:"QToolbarTitle",props:{shrink:Boolean},setup(e,{slots:t}){const n=w(()=>"q-toolbar__title ellipsis"+(e.shrink===!0?" col-shrink":""));return()=>O("div",{class:n.value},Ve(t.default))}}),Ui=Re({name:"QItemSection",props:{avatar:Boolean,thumbnail:Boolean,side:Boolean,top:Boolean,noWrap:Boolean},setup(e,{slots:t}){const n=w(()=>`q-item__section column q-item__section--${e.avatar===!0||e.side===!0||e.thumbnail===!0?"side":"main"}`+(e.top===!0?" q-item__section--top justify-start":" justify-center")+(e.avatar===!0?" q-item__section--avatar":"")+(e.thumbnail===!0?" q-item__section--thumbnail":"")+(e.noWrap===!0?" q-item__section--nowrap":""));return()=>O("div",{class:n.value},Ve(t.default))}}),$t={dark:{type:Boolean,default:null}};function Dt(e,t){return w(()=>e.dark===null?t.dark.isActive:e.dark)}function to(e){if(Object(e.$parent)===e.$parent)return e.$parent;let{parent:t}=e.$;for(;Object(t)===t;){if(Object(t.proxy)===t.proxy)return t.proxy;t=t.parent}}function Nf(e,t){typeof t.type=="symbol"?Array.isArray(t.children)===!0&&t.children.forEach(n=>{Nf(e,n)}):e.add(t)}function Xh(e){const t=new Set;return e.forEach(n=>{Nf(t,n)}),Array.from(t)}function Fa(e){return e.appContext.config.globalProperties.$router!==void 0}function Mf(e){return e.isUnmounted===!0||e.isDeactivated===!0}function Hs(e){return e?e.aliasOf?e.aliasOf.path:e.path:""}function Us(e,t){return(e.aliasOf||e)===(t.aliasOf||t)}function Jh(e,t){for(const n in t){const l=t[n],r=e[n];if(typeof l=="string"){if(l!==r)return!1}else if(Array.isArray(r)===!1||r.length!==l.length||l.some((o,i)=>o!==r[i]))return!1}return!0}function js(e,t){return Array.isArray(t)===!0?e.length===t.length&&e.every((n,l)=>n===t[l]):e.length===1&&e[0]===t}function Zh(e,t){return Array.isArray(e)===!0?js(e,t):Array.isArray(t)===!0?js(t,e):e===t}function eg(e,t){if(Object.keys(e).length!==Object.keys(t).length)return!1;for(const n in e)if(Zh(e[n],t[n])===!1)return!1;return!0}const Ff={to:[String,Object],replace:Boolean,href:String,target:String,disable:Boolean},tg={...Ff,exact:Boolean,activeClass:{type:String,default:"q-router-link--active"},exactActiveClass:{type:String,default:"q-router-link--exact-active"}};function qf({fallbackTag:e,useDisableForRouterLinkProps:t=!0}={}){const n=Ce(),{props:l,proxy:r,emit:o}=n,i=Fa(n),a=w(()=>l.disable!==!0&&l.href!==void 0),s=w(t===!0?()=>i===!0&&l.disable!==!0&&a.value!==!0&&l.to!==void 0&&l.to!==null&&l.to!=="":()=>i===!0&&a.value!==!0&&l.to!==void 0&&l.to!==null&&l.to!==""),c=w(()=>s.value===!0?k(l.to):null),u=w(()=>c.value!==null),f=w(()=>a.value===!0||u.value===!0),v=w(()=>l.type==="a"||f.value===!0?"a":l.tag||e||"div"),d=w(()=>a.value===!0?{href:l.href,target:l.target}:u.value===!0?{href:c.value.href,target:l.target}:{}),b=w(()=>{if(u.value===!1)return-1;const{matched:L}=c.value,{length:P}=L,A=L[P-1];if(A===void 0)return-1;const I=r.$route.matched;if(I.length===0)return-1;const S=I.findIndex(Us.bind(null,A));if(S!==-1)return S;const N=Hs(L[P-2]);return P>1&&Hs(A)===N&&I[I.length-1].path!==N?I.findIndex(Us.bind(null,L[P-2])):S}),C=w(()=>u.value===!0&&b.value!==-1&&Jh(r.$route.params,c.value.params)),E=w(()=>C.value===!0&&b.value===r.$route.matched.length-1&&eg(r.$route.params,c.value.params)),F=w(()=>u.value===!0?E.value===!0?` ${l.exactActiveClass} ${l.activeClass}`:l.exact===!0?"":C.value===!0?` ${l.activeClass}`:"":"");function k(L){try{return r.$router.resolve(L)}catch{}return null}function m(L,{returnRouterError:P,to:A=l.to,replace:I=l.replace}={}){if(l.disable===!0)return L.preventDefault(),Promise.resolve(!1);if(L.metaKey||L.altKey||L.ctrlKey||L.shiftKey||L.button!==void 0&&L.button!==0||l.target==="_blank")return Promise.resolve(!1);L.preventDefault();const S=r.$router[I===!0?"replace":"push"](A);return P===!0?S:S.then(()=>{}).catch(()=>{})}function g(L){if(u.value===!0){const P=A=>m(L,A);o("click",L,P),L.defaultPrevented!==!0&&P()}else o("click",L)}return{hasRouterLink:u,hasHrefLink:a,hasLink:f,linkTag:v,resolvedLink:c,linkIsActive:C,linkIsExactActive:E,linkClass:F,linkAttrs:d,getLink:k,navigateToRouterLink:m,navigateOnClick:g}}const nt={hasPassive:!1,passiveCapture:!0,notPassiveCapture:!0};try{const e=Object.defineProperty({},"passive",{get(){Object.assign(nt,{hasPassive:!0,passive:{passive:!0},notPassive:{passive:!1},passiveCapture:{passive:!0,capture:!0},notPassiveCapture:{passive:!1,capture:!0}})}});window.addEventListener("qtest",null,e),window.removeEventListener("qtest",null,e)}catch{}function En(){}function ng(e){return e.button===0}function vo(e){return e.touches&&e.touches[0]?e=e.touches[0]:e.changedTouches&&e.changedTouches[0]?e=e.changedTouches[0]:e.targetTouches&&e.targetTouches[0]&&(e=e.targetTouches[0]),{top:e.clientY,left:e.clientX}}function lg(e){if(e.path)return e.path;if(e.composedPath)return e.composedPath();const t=[];let n=e.target;for(;n;){if(t.push(n),n.tagName==="HTML")return t.push(document),t.push(window),t;n=n.parentElement}}function on(e){e.stopPropagation()}function Ft(e){e.cancelable!==!1&&e.preventDefault()}function Ze(e){e.cancelable!==!1&&e.preventDefault(),e.stopPropagation()}function di(e,t){if(e===void 0||t===!0&&e.__dragPrevented===!0)return;const n=t===!0?l=>{l.__dragPrevented=!0,l.addEventListener("dragstart",Ft,nt.notPassiveCapture)}:l=>{delete l.__dragPrevented,l.removeEventListener("dragstart",Ft,nt.notPassiveCapture)};e.querySelectorAll("a, img").forEach(n)}function nl(e,t,n){const l=`__q_${t}_evt`;e[l]=e[l]!==void 0?e[l].concat(n):n,n.forEach(r=>{r[0].addEventListener(r[1],e[r[2]],nt[r[3]])})}function ir(e,t){const n=`__q_${t}_evt`;e[n]!==void 0&&(e[n].forEach(l=>{l[0].removeEventListener(l[1],e[l[2]],nt[l[3]])}),e[n]=void 0)}let $f=!1;function rg(e){$f=e.isComposing===!0}function Fo(e){return $f===!0||e!==Object(e)||e.isComposing===!0||e.qKeyEvent===!0}function Tn(e,t){return Fo(e)===!0?!1:[].concat(t).includes(e.keyCode)}const ji=Re({name:"QItem",props:{...$t,...tg,tag:{type:String,default:"div"},active:{type:Boolean,default:null},clickable:Boolean,dense:Boolean,insetLevel:Number,tabindex:[String,Number],focused:Boolean,manualFocus:Boolean},emits:["click","keyup"],setup(e,{slots:t,emit:n}){const{proxy:{$q:l}}=Ce(),r=Dt(e,l),{hasLink:o,linkAttrs:i,linkClass:a,linkTag:s,navigateOnClick:c}=qf(),u=ne(null),f=ne(null),v=w(()=>e.clickable===!0||o.value===!0||e.tag==="label"),d=w(()=>e.disable!==!0&&v.value===!0),b=w(()=>"q-item q-item-type row no-wrap"+(e.dense===!0?" q-item--dense":"")+(r.value===!0?" q-item--dark":"")+(o.value===!0&&e.active===null?a.value:e.active===!0?` q-item--active${e.activeClass!==void 0?` ${e.activeClass}`:""}`:"")+(e.disable===!0?" disabled":"")+(d.value===!0?" q-item--clickable q-link cursor-pointer "+(e.manualFocus===!0?"q-manual-focusable":"q-focusable q-hoverable")+(e.focused===!0?" q-manual-focusable--focused":""):"")),C=w(()=>e.insetLevel===void 0?null:{["padding"+(l.lang.rtl===!0?"Right":"Left")]:16+e.insetLevel*56+"px"});function E(m){d.value===!0&&(f.value!==null&&m.qAvoidFocus!==!0&&(m.qKeyEvent!==!0&&document.activeElement===u.value?f.value.focus():document.activeElement===f.value&&u.value.focus()),c(m))}function F(m){if(d.value===!0&&Tn(m,[13,32])===!0){Ze(m),m.qKeyEvent=!0;const g=new MouseEvent("click",m);g.qKeyEvent=!0,u.value.dispatchEvent(g)}n("keyup",m)}function k(){const m=Ma(t.default,[]);return d.value===!0&&m.unshift(O("div",{class:"q-focus-helper",tabindex:-1,ref:f})),m}return()=>{const m={ref:u,class:b.value,style:C.value,role:"listitem",onClick:E,onKeyup:F};return d.value===!0?(m.tabindex=e.tabindex||"0",Object.assign(m,i.value)):v.value===!0&&(m["aria-disabled"]="true"),O(s.value,m,k())}}}),og=["ul","ol"],Df=Re({name:"QList",props:{...$t,bordered:Boolean,dense:Boolean,separator:Boolean,padding:Boolean,tag:{type:String,default:"div"}},setup(e,{slots:t}){const n=Ce(),l=Dt(e,n.proxy.$q),r=w(()=>og.includes(e.tag)?null:"list"),o=w(()=>"q-list"+(e.bordered===!0?" q-list--bordered":"")+(e.dense===!0?" q-list--dense":"")+(e.separator===!0?" q-list--separator":"")+(l.value===!0?" q-list--dark":"")+(e.padding===!0?" q-list--padding":""));return()=>O(e.tag,{class:o.value,role:r.value},Ve(t.default))}}),cn=ne(!1);let Wi;function ig(e,t){const n=/(edg|edge|edga|edgios)\/([\w.]+)/.exec(e)||/(opr)[\/]([\w.]+)/.exec(e)||/(vivaldi)[\/]([\w.]+)/.exec(e)||/(chrome|crios)[\/]([\w.]+)/.exec(e)||/(version)(applewebkit)[\/]([\w.]+).*(safari)[\/]([\w.]+)/.exec(e)||/(webkit)[\/]([\w.]+).*(version)[\/]([\w.]+).*(safari)[\/]([\w.]+)/.exec(e)||/(firefox|fxios)[\/]([\w.]+)/.exec(e)||/(webkit)[\/]([\w.]+)/.exec(e)||/(opera)(?:.*version|)[\/]([\w.]+)/.exec(e)||[];return{browser:n[5]||n[3]||n[1]||"",version:n[4]||n[2]||"0",platform:t[0]||""}}function ag(e){return/(ipad)/.exec(e)||/(ipod)/.exec(e)||/(windows phone)/.exec(e)||/(iphone)/.exec(e)||/(kindle)/.exec(e)||/(silk)/.exec(e)||/(android)/.exec(e)||/(win)/.exec(e)||/(mac)/.exec(e)||/(linux)/.exec(e)||/(cros)/.exec(e)||/(playbook)/.exec(e)||/(bb)/.exec(e)||/(blackberry)/.exec(e)||[]}const Vf="ontouchstart"in window||window.navigator.maxTouchPoints>0;function sg(e){const t=e.toLowerCase(),n=ag(t),l=ig(t,n),r={mobile:!1,desktop:!1,cordova:!1,capacitor:!1,nativeMobile:!1,electron:!1,bex:!1,linux:!1,mac:!1,win:!1,cros:!1,chrome:!1,firefox:!1,opera:!1,safari:!1,vivaldi:!1,edge:!1,edgeChromium:!1,ie:!1,webkit:!1,android:!1,ios:!1,ipad:!1,iphone:!1,ipod:!1,kindle:!1,winphone:!1,blackberry:!1,playbook:!1,silk:!1};l.browser&&(r[l.browser]=!0,r.version=l.version,r.versionNumber=parseInt(l.version,10)),l.platform&&(r[l.platform]=!0);const o=r.android||r.ios||r.bb||r.blackberry||r.ipad||r.iphone||r.ipod||r.kindle||r.playbook||r.silk||r["windows phone"];if(o===!0||t.indexOf("mobile")!==-1?r.mobile=!0:r.desktop=!0,r["windows phone"]&&(r.winphone=!0,delete r["windows phone"]),r.edga||r.edgios||r.edg?(r.edge=!0,l.browser="edge"):r.crios?(r.chrome=!0,l.browser="chrome"):r.fxios&&(r.firefox=!0,l.browser="firefox"),(r.ipod||r.ipad||r.iphone)&&(r.ios=!0),r.vivaldi&&(l.browser="vivaldi",r.vivaldi=!0),(r.chrome||r.opr||r.safari||r.vivaldi||r.mobile===!0&&r.ios!==!0&&o!==!0)&&(r.webkit=!0),r.opr&&(l.browser="opera",r.opera=!0),r.safari&&(r.blackberry||r.bb?(l.browser="blackberry",r.blackberry=!0):r.playbook?(l.browser="playbook",r.playbook=!0):r.android?(l.browser="android",r.android=!0):r.kindle?(l.browser="kindle",r.kindle=!0):r.silk&&(l.browser="silk",r.silk=!0)),r.name=l.browser,r.platform=l.platform,t.indexOf("electron")!==-1)r.electron=!0;else if(document.location.href.indexOf("-extension://")!==-1)r.bex=!0;else{if(window.Capacitor!==void 0?(r.capacitor=!0,r.nativeMobile=!0,r.nativeMobileWrapper="capacitor"):(window._cordovaNative!==void 0||window.cordova!==void 0)&&(r.cordova=!0,r.nativeMobile=!0,r.nativeMobileWrapper="cordova"),cn.value===!0&&(Wi={is:{...r}}),Vf===!0&&r.mac===!0&&(r.desktop===!0&&r.safari===!0||r.nativeMobile===!0&&r.android!==!0&&r.ios!==!0&&r.ipad!==!0)){delete r.mac,delete r.desktop;const i=Math.min(window.innerHeight,window.innerWidth)>414?"ipad":"iphone";Object.assign(r,{mobile:!0,ios:!0,platform:i,[i]:!0})}r.mobile!==!0&&window.navigator.userAgentData&&window.navigator.userAgentData.mobile&&(delete r.desktop,r.mobile=!0)}return r}const Ws=navigator.userAgent||navigator.vendor||window.opera,ug={has:{touch:!1,webStorage:!1},within:{iframe:!1}},Ye={userAgent:Ws,is:sg(Ws),has:{touch:Vf},within:{iframe:window.self!==window.top}},mo={install(e){const{$q:t}=e;cn.value===!0?(e.onSSRHydrated.push(()=>{Object.assign(t.platform,Ye),cn.value=!1}),t.platform=an(this)):t.platform=this}};{let e;Wt(Ye.has,"webStorage",()=>{if(e!==void 0)return e;try{if(window.localStorage)return e=!0,!0}catch{}return e=!1,!1}),Object.assign(mo,Ye),cn.value===!0&&(Object.assign(mo,Wi,ug),Wi=null)}function Bf(){if(window.getSelection!==void 0){const e=window.getSelection();e.empty!==void 0?e.empty():e.removeAllRanges!==void 0&&(e.removeAllRanges(),mo.is.mobile!==!0&&e.addRange(document.createRange()))}else document.selection!==void 0&&document.selection.empty()}const cg={target:{type:[Boolean,String,Element],default:!0},noParentEvent:Boolean},fg={...cg,contextMenu:Boolean};function dg({showing:e,avoidEmit:t,configureAnchorEl:n}){const{props:l,proxy:r,emit:o}=Ce(),i=ne(null);let a=null;function s(d){return i.value===null?!1:d===void 0||d.touches===void 0||d.touches.length<=1}const c={};n===void 0&&(Object.assign(c,{hide(d){r.hide(d)},toggle(d){r.toggle(d),d.qAnchorHandled=!0},toggleKey(d){Tn(d,13)===!0&&c.toggle(d)},contextClick(d){r.hide(d),Ft(d),Ke(()=>{r.show(d),d.qAnchorHandled=!0})},prevent:Ft,mobileTouch(d){if(c.mobileCleanup(d),s(d)!==!0)return;r.hide(d),i.value.classList.add("non-selectable");const b=d.target;nl(c,"anchor",[[b,"touchmove","mobileCleanup","passive"],[b,"touchend","mobileCleanup","passive"],[b,"touchcancel","mobileCleanup","passive"],[i.value,"contextmenu","prevent","notPassive"]]),a=setTimeout(()=>{a=null,r.show(d),d.qAnchorHandled=!0},300)},mobileCleanup(d){i.value.classList.remove("non-selectable"),a!==null&&(clearTimeout(a),a=null),e.value===!0&&d!==void 0&&Bf()}}),n=function(d=l.contextMenu){if(l.noParentEvent===!0||i.value===null)return;let b;d===!0?r.$q.platform.is.mobile===!0?b=[[i.value,"touchstart","mobileTouch","passive"]]:b=[[i.value,"mousedown","hide","passive"],[i.value,"contextmenu","contextClick","notPassive"]]:b=[[i.value,"click","toggle","passive"],[i.value,"keyup","toggleKey","passive"]],nl(c,"anchor",b)});function u(){ir(c,"anchor")}function f(d){for(i.value=d;i.value.classList.contains("q-anchor--skip");)i.value=i.value.parentNode;n()}function v(){if(l.target===!1||l.target===""||r.$el.parentNode===null)i.value=null;else if(l.target===!0)f(r.$el.parentNode);else{let d=l.target;if(typeof l.target=="string")try{d=document.querySelector(l.target)}catch{d=void 0}d!=null?(i.value=d.$el||d,n()):(i.value=null,console.error(`Anchor: target "${l.target}" not found`))}}return me(()=>l.contextMenu,d=>{i.value!==null&&(u(),n(d))}),me(()=>l.target,()=>{i.value!==null&&u(),v()}),me(()=>l.noParentEvent,d=>{i.value!==null&&(d===!0?u():n())}),gt(()=>{v(),t!==!0&&l.modelValue===!0&&i.value===null&&o("update:modelValue",!1)}),Ge(()=>{a!==null&&clearTimeout(a),u()}),{anchorEl:i,canShow:s,anchorEvents:c}}function vg(e,t){const n=ne(null);let l;function r(a,s){const c=`${s!==void 0?"add":"remove"}EventListener`,u=s!==void 0?s:l;a!==window&&a[c]("scroll",u,nt.passive),window[c]("scroll",u,nt.passive),l=s}function o(){n.value!==null&&(r(n.value),n.value=null)}const i=me(()=>e.noParentEvent,()=>{n.value!==null&&(o(),t())});return Ge(i),{localScrollTarget:n,unconfigureScrollTarget:o,changeScrollEvent:r}}const Hf={modelValue:{type:Boolean,default:null},"onUpdate:modelValue":[Function,Array]},Uf=["beforeShow","show","beforeHide","hide"];function jf({showing:e,canShow:t,hideOnRouteChange:n,handleShow:l,handleHide:r,processOnMount:o}){const i=Ce(),{props:a,emit:s,proxy:c}=i;let u;function f(k){e.value===!0?b(k):v(k)}function v(k){if(a.disable===!0||(k==null?void 0:k.qAnchorHandled)===!0||t!==void 0&&t(k)!==!0)return;const m=a["onUpdate:modelValue"]!==void 0;m===!0&&(s("update:modelValue",!0),u=k,Ke(()=>{u===k&&(u=void 0)})),(a.modelValue===null||m===!1)&&d(k)}function d(k){e.value!==!0&&(e.value=!0,s("beforeShow",k),l!==void 0?l(k):s("show",k))}function b(k){if(a.disable===!0)return;const m=a["onUpdate:modelValue"]!==void 0;m===!0&&(s("update:modelValue",!1),u=k,Ke(()=>{u===k&&(u=void 0)})),(a.modelValue===null||m===!1)&&C(k)}function C(k){e.value!==!1&&(e.value=!1,s("beforeHide",k),r!==void 0?r(k):s("hide",k))}function E(k){a.disable===!0&&k===!0?a["onUpdate:modelValue"]!==void 0&&s("update:modelValue",!1):k===!0!==e.value&&(k===!0?d:C)(u)}me(()=>a.modelValue,E),n!==void 0&&Fa(i)===!0&&me(()=>c.$route.fullPath,()=>{n.value===!0&&e.value===!0&&b()}),o===!0&&gt(()=>{E(a.modelValue)});const F={show:v,hide:b,toggle:f};return Object.assign(c,F),F}let ll=[],gr=[];function Wf(e){gr=gr.filter(t=>t!==e)}function mg(e){Wf(e),gr.push(e)}function zs(e){Wf(e),gr.length===0&&ll.length!==0&&(ll[ll.length-1](),ll=[])}function qo(e){gr.length===0?e():ll.push(e)}function hg(e){ll=ll.filter(t=>t!==e)}const ho={};let zf=!1;function gg(){zf=!0}let bg=1,_g=document.body;function pg(e,t){const n=document.createElement("div");if(n.id=t!==void 0?`q-portal--${t}--${bg++}`:e,ho.globalNodes!==void 0){const l=ho.globalNodes.class;l!==void 0&&(n.className=l)}return _g.appendChild(n),n}function yg(e){e.remove()}const Rl=[];function Sg(e){return Rl.find(t=>t.contentEl!==null&&t.contentEl.contains(e))}function Kf(e,t){do{if(e.$options.name==="QMenu"){if(e.hide(t),e.$props.separateClosePopup===!0)return to(e)}else if(e.__qPortal===!0){const n=to(e);return(n==null?void 0:n.$options.name)==="QPopupProxy"?(e.hide(t),n):e}e=to(e)}while(e!=null)}function wg(e,t,n){for(;n!==0&&e!==void 0&&e!==null;){if(e.__qPortal===!0){if(n--,e.$options.name==="QMenu"){e=Kf(e,t);continue}e.hide(t)}e=to(e)}}const Cg=Re({name:"QPortal",setup(e,{slots:t}){return()=>t.default()}});function Eg(e){for(e=e.parent;e!=null;){if(e.type.name==="QGlobalDialog")return!0;if(e.type.name==="QDialog"||e.type.name==="QMenu")return!1;e=e.parent}return!1}function Yf(e,t,n,l){const r=ne(!1),o=ne(!1);let i=null;const a={},s=l==="dialog"&&Eg(e);function c(f){if(f===!0){zs(a),o.value=!0;return}o.value=!1,r.value===!1&&(s===!1&&i===null&&(i=pg(!1,l)),r.value=!0,Rl.push(e.proxy),mg(a))}function u(f){if(o.value=!1,f!==!0)return;zs(a),r.value=!1;const v=Rl.indexOf(e.proxy);v!==-1&&Rl.splice(v,1),i!==null&&(yg(i),i=null)}return Pr(()=>{u(!0)}),e.proxy.__qPortal=!0,Wt(e.proxy,"contentEl",()=>t.value),{showPortal:c,hidePortal:u,portalIsActive:r,portalIsAccessible:o,renderPortal:()=>s===!0?n():r.value===!0?[O(um,{to:i},O(Cg,n))]:void 0}}const Qf={transitionShow:{type:String,default:"fade"},transitionHide:{type:String,default:"fade"},transitionDuration:{type:[String,Number],default:300}};function Gf(e,t=()=>{},n=()=>{}){return{transitionProps:w(()=>{const l=`q-transition--${e.transitionShow||t()}`,r=`q-transition--${e.transitionHide||n()}`;return{appear:!0,enterFromClass:`${l}-enter-from`,enterActiveClass:`${l}-enter-active`,enterToClass:`${l}-enter-to`,leaveFromClass:`${r}-leave-from`,leaveActiveClass:`${r}-leave-active`,leaveToClass:`${r}-leave-to`}}),transitionStyle:w(()=>`--q-transition-duration: ${e.transitionDuration}ms`)}}function ar(){let e;const t=Ce();function n(){e=void 0}return dl(n),Ge(n),{removeTick:n,registerTick(l){e=l,Ke(()=>{e===l&&(Mf(t)===!1&&e(),e=void 0)})}}}function br(){let e=null;const t=Ce();function n(){e!==null&&(clearTimeout(e),e=null)}return dl(n),Ge(n),{removeTimeout:n,registerTimeout(l,r){n(),Mf(t)===!1&&(e=setTimeout(()=>{e=null,l()},r))}}}function zi(e,t){const n=e.style;for(const l in t)n[l]=t[l]}function Tg(e){if(e==null)return;if(typeof e=="string")try{return document.querySelector(e)||void 0}catch{return}const t=Zn(e);if(t)return t.$el||t}function Xf(e,t){if(e==null||e.contains(t)===!0)return!0;for(let n=e.nextElementSibling;n!==null;n=n.nextElementSibling)if(n.contains(t))return!0;return!1}const qa=[Element,String],kg=[null,document,document.body,document.scrollingElement,document.documentElement];function $a(e,t){let n=Tg(t);if(n===void 0){if(e==null)return window;n=e.closest(".scroll,.scroll-y,.overflow-auto")}return kg.includes(n)?window:n}function Jf(e){return e===window?window.pageYOffset||window.scrollY||document.body.scrollTop||0:e.scrollTop}function Zf(e){return e===window?window.pageXOffset||window.scrollX||document.body.scrollLeft||0:e.scrollLeft}let Ur;function no(){if(Ur!==void 0)return Ur;const e=document.createElement("p"),t=document.createElement("div");zi(e,{width:"100%",height:"200px"}),zi(t,{position:"absolute",top:"0px",left:"0px",visibility:"hidden",width:"200px",height:"150px",overflow:"hidden"}),t.appendChild(e),document.body.appendChild(t);const n=e.offsetWidth;t.style.overflow="scroll";let l=e.offsetWidth;return n===l&&(l=t.clientWidth),t.remove(),Ur=n-l,Ur}function xg(e,t=!0){return!e||e.nodeType!==Node.ELEMENT_NODE?!1:t?e.scrollHeight>e.clientHeight&&(e.classList.contains("scroll")||e.classList.contains("overflow-auto")||["auto","scroll"].includes(window.getComputedStyle(e)["overflow-y"])):e.scrollWidth>e.clientWidth&&(e.classList.contains("scroll")||e.classList.contains("overflow-auto")||["auto","scroll"].includes(window.getComputedStyle(e)["overflow-x"]))}const al=[];let $l;function Lg(e){$l=e.keyCode===27}function Pg(){$l===!0&&($l=!1)}function Ag(e){$l===!0&&($l=!1,Tn(e,27)===!0&&al[al.length-1](e))}function ed(e){window[e]("keydown",Lg),window[e]("blur",Pg),window[e]("keyup",Ag),$l=!1}function td(e){Ye.is.desktop===!0&&(al.push(e),al.length===1&&ed("addEventListener"))}function go(e){const t=al.indexOf(e);t!==-1&&(al.splice(t,1),al.length===0&&ed("removeEventListener"))}const sl=[];function nd(e){sl[sl.length-1](e)}function ld(e){Ye.is.desktop===!0&&(sl.push(e),sl.length===1&&document.body.addEventListener("focusin",nd))}function Ki(e){const t=sl.indexOf(e);t!==-1&&(sl.splice(t,1),sl.length===0&&document.body.removeEventListener("focusin",nd))}const{notPassiveCapture:bo}=nt,ul=[];function _o(e){const t=e.target;if(t===void 0||t.nodeType===8||t.classList.contains("no-pointer-events")===!0)return;let n=Rl.length-1;for(;n>=0;){const l=Rl[n].$;if(l.type.name==="QTooltip"){n--;continue}if(l.type.name!=="QDialog")break;if(l.props.seamless!==!0)return;n--}for(let l=ul.length-1;l>=0;l--){const r=ul[l];if((r.anchorEl.value===null||r.anchorEl.value.contains(t)===!1)&&(t===document.body||r.innerRef.value!==null&&r.innerRef.value.contains(t)===!1))e.qClickOutside=!0,r.onClickOutside(e);else return}}function Og(e){ul.push(e),ul.length===1&&(document.addEventListener("mousedown",_o,bo),document.addEventListener("touchstart",_o,bo))}function Ks(e){const t=ul.findIndex(n=>n===e);t!==-1&&(ul.splice(t,1),ul.length===0&&(document.removeEventListener("mousedown",_o,bo),document.removeEventListener("touchstart",_o,bo)))}let Ys,Qs;function Gs(e){const t=e.split(" ");return t.length!==2?!1:["top","center","bottom"].includes(t[0])!==!0?(console.error("Anchor/Self position must start with one of top/center/bottom"),!1):["left","middle","right","start","end"].includes(t[1])!==!0?(console.error("Anchor/Self position must end with one of left/middle/right/start/end"),!1):!0}function Ig(e){return e?!(e.length!==2||typeof e[0]!="number"||typeof e[1]!="number"):!0}const Yi={"start#ltr":"left","start#rtl":"right","end#ltr":"right","end#rtl":"left"};["left","middle","right"].forEach(e=>{Yi[`${e}#ltr`]=e,Yi[`${e}#rtl`]=e});function Xs(e,t){const n=e.split(" ");return{vertical:n[0],horizontal:Yi[`${n[1]}#${t===!0?"rtl":"ltr"}`]}}function Rg(e,t){let{top:n,left:l,right:r,bottom:o,width:i,height:a}=e.getBoundingClientRect();return t!==void 0&&(n-=t[1],l-=t[0],o+=t[1],r+=t[0],i+=t[0],a+=t[1]),{top:n,bottom:o,height:a,left:l,right:r,width:i,middle:l+(r-l)/2,center:n+(o-n)/2}}function Ng(e,t,n){let{top:l,left:r}=e.getBoundingClientRect();return l+=t.top,r+=t.left,n!==void 0&&(l+=n[1],r+=n[0]),{top:l,bottom:l+1,height:1,left:r,right:r+1,width:1,middle:r,center:l}}function Mg(e,t){return{top:0,center:t/2,bottom:t,left:0,middle:e/2,right:e}}function Js(e,t,n,l){return{top:e[n.vertical]-t[l.vertical],left:e[n.horizontal]-t[l.horizontal]}}function rd(e,t=0){if(e.targetEl===null||e.anchorEl===null||t>5)return;if(e.targetEl.offsetHeight===0||e.targetEl.offsetWidth===0){setTimeout(()=>{rd(e,t+1)},10);return}const{targetEl:n,offset:l,anchorEl:r,anchorOrigin:o,selfOrigin:i,absoluteOffset:a,fit:s,cover:c,maxHeight:u,maxWidth:f}=e;if(Ye.is.ios===!0&&window.visualViewport!==void 0){const P=document.body.style,{offsetLeft:A,offsetTop:I}=window.visualViewport;A!==Ys&&(P.setProperty("--q-pe-left",A+"px"),Ys=A),I!==Qs&&(P.setProperty("--q-pe-top",I+"px"),Qs=I)}const{scrollLeft:v,scrollTop:d}=n,b=a===void 0?Rg(r,c===!0?[0,0]:l):Ng(r,a,l);Object.assign(n.style,{top:0,left:0,minWidth:null,minHeight:null,maxWidth:f,maxHeight:u,visibility:"visible"});const{offsetWidth:C,offsetHeight:E}=n,{elWidth:F,elHeight:k}=s===!0||c===!0?{elWidth:Math.max(b.width,C),elHeight:c===!0?Math.max(b.height,E):E}:{elWidth:C,elHeight:E};let m={maxWidth:f,maxHeight:u};(s===!0||c===!0)&&(m.minWidth=b.width+"px",c===!0&&(m.minHeight=b.height+"px")),Object.assign(n.style,m);const g=Mg(F,k);let L=Js(b,g,o,i);if(a===void 0||l===void 0)vi(L,b,g,o,i);else{const{top:P,left:A}=L;vi(L,b,g,o,i);let I=!1;if(L.top!==P){I=!0;const S=2*l[1];b.center=b.top-=S,b.bottom-=S+2}if(L.left!==A){I=!0;const S=2*l[0];b.middle=b.left-=S,b.right-=S+2}I===!0&&(L=Js(b,g,o,i),vi(L,b,g,o,i))}m={top:L.top+"px",left:L.left+"px"},L.maxHeight!==void 0&&(m.maxHeight=L.maxHeight+"px",b.height>L.maxHeight&&(m.minHeight=m.maxHeight)),L.maxWidth!==void 0&&(m.maxWidth=L.maxWidth+"px",b.width>L.maxWidth&&(m.minWidth=m.maxWidth)),Object.assign(n.style,m),n.scrollTop!==d&&(n.scrollTop=d),n.scrollLeft!==v&&(n.scrollLeft=v)}function vi(e,t,n,l,r){const o=n.bottom,i=n.right,a=no(),s=window.innerHeight-a,c=document.body.clientWidth;if(e.top<0||e.top+o>s)if(r.vertical==="center")e.top=t[l.vertical]>s/2?Math.max(0,s-o):0,e.maxHeight=Math.min(o,s);else if(t[l.vertical]>s/2){const u=Math.min(s,l.vertical==="center"?t.center:l.vertical===r.vertical?t.bottom:t.top);e.maxHeight=Math.min(o,u),e.top=Math.max(0,u-o)}else e.top=Math.max(0,l.vertical==="center"?t.center:l.vertical===r.vertical?t.top:t.bottom),e.maxHeight=Math.min(o,s-e.top);if(e.left<0||e.left+i>c)if(e.maxWidth=Math.min(i,c),r.horizontal==="middle")e.left=t[l.horizontal]>c/2?Math.max(0,c-i):0;else if(t[l.horizontal]>c/2){const u=Math.min(c,l.horizontal==="middle"?t.middle:l.horizontal===r.horizontal?t.right:t.left);e.maxWidth=Math.min(i,u),e.left=Math.max(0,u-e.maxWidth)}else e.left=Math.max(0,l.horizontal==="middle"?t.middle:l.horizontal===r.horizontal?t.left:t.right),e.maxWidth=Math.min(i,c-e.left)}const od=Re({name:"QMenu",inheritAttrs:!1,props:{...fg,...Hf,...$t,...Qf,persistent:Boolean,autoClose:Boolean,separateClosePopup:Boolean,noEscDismiss:Boolean,noRouteDismiss:Boolean,noRefocus:Boolean,noFocus:Boolean,fit:Boolean,cover:Boolean,square:Boolean,anchor:{type:String,validator:Gs},self:{type:String,validator:Gs},offset:{type:Array,validator:Ig},scrollTarget:qa,touchPosition:Boolean,maxHeight:{type:String,default:null},maxWidth:{type:String,default:null}},emits:[...Uf,"click","escapeKey"],setup(e,{slots:t,emit:n,attrs:l}){let r=null,o,i,a;const s=Ce(),{proxy:c}=s,{$q:u}=c,f=ne(null),v=ne(!1),d=w(()=>e.persistent!==!0&&e.noRouteDismiss!==!0),b=Dt(e,u),{registerTick:C,removeTick:E}=ar(),{registerTimeout:F}=br(),{transitionProps:k,transitionStyle:m}=Gf(e),{localScrollTarget:g,changeScrollEvent:L,unconfigureScrollTarget:P}=vg(e,ae),{anchorEl:A,canShow:I}=dg({showing:v}),{hide:S}=jf({showing:v,canShow:I,handleShow:he,handleHide:$,hideOnRouteChange:d,processOnMount:!0}),{showPortal:N,hidePortal:U,renderPortal:x}=Yf(s,f,xe,"menu"),Q={anchorEl:A,innerRef:f,onClickOutside(le){if(e.persistent!==!0&&v.value===!0)return S(le),(le.type==="touchstart"||le.target.classList.contains("q-dialog__backdrop"))&&Ze(le),!0}},B=w(()=>Xs(e.anchor||(e.cover===!0?"center middle":"bottom start"),u.lang.rtl)),te=w(()=>e.cover===!0?B.value:Xs(e.self||"top start",u.lang.rtl)),J=w(()=>(e.square===!0?" q-menu--square":"")+(b.value===!0?" q-menu--dark q-dark":"")),ee=w(()=>e.autoClose===!0?{onClick:ge}:{}),Y=w(()=>v.value===!0&&e.persistent!==!0);me(Y,le=>{le===!0?(td(Z),Og(Q)):(go(Z),Ks(Q))});function se(){qo(()=>{let le=f.value;le&&le.contains(document.activeElement)!==!0&&(le=le.querySelector("[autofocus][tabindex], [data-autofocus][tabindex]")||le.querySelector("[autofocus] [tabindex], [data-autofocus] [tabindex]")||le.querySelector("[autofocus], [data-autofocus]")||le,le.focus({preventScroll:!0}))})}function he(le){if(r=e.noRefocus===!1?document.activeElement:null,ld(ye),N(),ae(),o=void 0,le!==void 0&&(e.touchPosition||e.contextMenu)){const Pe=vo(le);if(Pe.left!==void 0){const{top:$e,left:ut}=A.value.getBoundingClientRect();o={left:Pe.left-ut,top:Pe.top-$e}}}i===void 0&&(i=me(()=>u.screen.width+"|"+u.screen.height+"|"+e.self+"|"+e.anchor+"|"+u.lang.rtl,ie)),e.noFocus!==!0&&document.activeElement.blur(),C(()=>{ie(),e.noFocus!==!0&&se()}),F(()=>{u.platform.is.ios===!0&&(a=e.autoClose,f.value.click()),ie(),N(!0),n("show",le)},e.transitionDuration)}function $(le){E(),U(),q(!0),r!==null&&(le===void 0||le.qClickOutside!==!0)&&((((le==null?void 0:le.type.indexOf("key"))===0?r.closest('[tabindex]:not([tabindex^="-"])'):void 0)||r).focus(),r=null),F(()=>{U(!0),n("hide",le)},e.transitionDuration)}function q(le){o=void 0,i!==void 0&&(i(),i=void 0),(le===!0||v.value===!0)&&(Ki(ye),P(),Ks(Q),go(Z)),le!==!0&&(r=null)}function ae(){(A.value!==null||e.scrollTarget!==void 0)&&(g.value=$a(A.value,e.scrollTarget),L(g.value,ie))}function ge(le){a!==!0?(Kf(c,le),n("click",le)):a=!1}function ye(le){Y.value===!0&&e.noFocus!==!0&&Xf(f.value,le.target)!==!0&&se()}function Z(le){e.noEscDismiss!==!0&&(n("escapeKey"),S(le))}function ie(){rd({targetEl:f.value,offset:e.offset,anchorEl:A.value,anchorOrigin:B.value,selfOrigin:te.value,absoluteOffset:o,fit:e.fit,cover:e.cover,maxHeight:e.maxHeight,maxWidth:e.maxWidth})}function xe(){return O(ql,k.value,()=>v.value===!0?O("div",{role:"menu",...l,ref:f,tabindex:-1,class:["q-menu q-position-engine scroll"+J.value,l.class],style:[l.style,m.value],...ee.value},Ve(t.default)):null)}return Ge(q),Object.assign(c,{focus:se,updatePosition:ie}),x}}),Fg={size:{type:[String,Number],default:"1em"},color:String};function qg(e){return{cSize:w(()=>e.size in Hi?`${Hi[e.size]}px`:e.size),classes:w(()=>"q-spinner"+(e.color?` text-${e.color}`:""))}}const id=Re({name:"QSpinner",props:{...Fg,thickness:{type:Number,default:5}},setup(e){const{cSize:t,classes:n}=qg(e);return()=>O("svg",{class:n.value+" q-spinner-mat",width:t.value,height:t.value,viewBox:"25 25 50 50"},[O("circle",{class:"path",cx:"50",cy:"50",r:"20",fill:"none",stroke:"currentColor","stroke-width":e.thickness,"stroke-miterlimit":"10"})])}});function $g(e,t=250){let n=!1,l;return function(){return n===!1&&(n=!0,setTimeout(()=>{n=!1},t),l=e.apply(this,arguments)),l}}function Zs(e,t,n,l){n.modifiers.stop===!0&&on(e);const r=n.modifiers.color;let o=n.modifiers.center;o=o===!0||l===!0;const i=document.createElement("span"),a=document.createElement("span"),s=vo(e),{left:c,top:u,width:f,height:v}=t.getBoundingClientRect(),d=Math.sqrt(f*f+v*v),b=d/2,C=`${(f-d)/2}px`,E=o?C:`${s.left-c-b}px`,F=`${(v-d)/2}px`,k=o?F:`${s.top-u-b}px`;a.className="q-ripple__inner",zi(a,{height:`${d}px`,width:`${d}px`,transform:`translate3d(${E},${k},0) scale3d(.2,.2,1)`,opacity:0}),i.className=`q-ripple${r?" text-"+r:""}`,i.setAttribute("dir","ltr"),i.appendChild(a),t.appendChild(i);const m=()=>{i.remove(),clearTimeout(g)};n.abort.push(m);let g=setTimeout(()=>{a.classList.add("q-ripple__inner--enter"),a.style.transform=`translate3d(${C},${F},0) scale3d(1,1,1)`,a.style.opacity=.2,g=setTimeout(()=>{a.classList.remove("q-ripple__inner--enter"),a.classList.add("q-ripple__inner--leave"),a.style.opacity=0,g=setTimeout(()=>{i.remove(),n.abort.splice(n.abort.indexOf(m),1)},275)},250)},50)}function eu(e,{modifiers:t,value:n,arg:l}){const r=Object.assign({},e.cfg.ripple,t,n);e.modifiers={early:r.early===!0,stop:r.stop===!0,center:r.center===!0,color:r.color||l,keyCodes:[].concat(r.keyCodes||13)}}const Da=Na({name:"ripple",beforeMount(e,t){const n=t.instance.$.appContext.config.globalProperties.$q.config||{};if(n.ripple===!1)return;const l={cfg:n,enabled:t.value!==!1,modifiers:{},abort:[],start(r){l.enabled===!0&&r.qSkipRipple!==!0&&r.type===(l.modifiers.early===!0?"pointerdown":"click")&&Zs(r,e,l,r.qKeyEvent===!0)},keystart:$g(r=>{l.enabled===!0&&r.qSkipRipple!==!0&&Tn(r,l.modifiers.keyCodes)===!0&&r.type===`key${l.modifiers.early===!0?"down":"up"}`&&Zs(r,e,l,!0)},300)};eu(l,t),e.__qripple=l,nl(l,"main",[[e,"pointerdown","start","passive"],[e,"click","start","passive"],[e,"keydown","keystart","passive"],[e,"keyup","keystart","passive"]])},updated(e,t){if(t.oldValue!==t.value){const n=e.__qripple;n!==void 0&&(n.enabled=t.value!==!1,n.enabled===!0&&Object(t.value)===t.value&&eu(n,t))}},beforeUnmount(e){const t=e.__qripple;t!==void 0&&(t.abort.forEach(n=>{n()}),ir(t,"main"),delete e._qripple)}}),ad={left:"start",center:"center",right:"end",between:"between",around:"around",evenly:"evenly",stretch:"stretch"},Dg=Object.keys(ad),sd={align:{type:String,validator:e=>Dg.includes(e)}};function ud(e){return w(()=>{const t=e.align===void 0?e.vertical===!0?"stretch":"left":e.align;return`${e.vertical===!0?"items":"justify"}-${ad[t]}`})}const tu={none:0,xs:4,sm:8,md:16,lg:24,xl:32},Vg={xs:8,sm:10,md:14,lg:20,xl:24},Bg=["button","submit","reset"],Hg=/[^\s]\/[^\s]/,Ug=["flat","outline","push","unelevated"];function jg(e,t){return e.flat===!0?"flat":e.outline===!0?"outline":e.push===!0?"push":e.unelevated===!0?"unelevated":t}const Wg={...Ir,...Ff,type:{type:String,default:"button"},label:[Number,String],icon:String,iconRight:String,...Ug.reduce((e,t)=>(e[t]=Boolean)&&e,{}),square:Boolean,rounded:Boolean,glossy:Boolean,size:String,fab:Boolean,fabMini:Boolean,padding:String,color:String,textColor:String,noCaps:Boolean,noWrap:Boolean,dense:Boolean,tabindex:[Number,String],ripple:{type:[Boolean,Object],default:!0},align:{...sd.align,default:"center"},stack:Boolean,stretch:Boolean,loading:{type:Boolean,default:null},disable:Boolean},zg={...Wg,round:Boolean};function Kg(e){const t=Rr(e,Vg),n=ud(e),{hasRouterLink:l,hasLink:r,linkTag:o,linkAttrs:i,navigateOnClick:a}=qf({fallbackTag:"button"}),s=w(()=>{const E=e.fab===!1&&e.fabMini===!1?t.value:{};return e.padding!==void 0?Object.assign({},E,{padding:e.padding.split(/\s+/).map(F=>F in tu?tu[F]+"px":F).join(" "),minWidth:"0",minHeight:"0"}):E}),c=w(()=>e.rounded===!0||e.fab===!0||e.fabMini===!0),u=w(()=>e.disable!==!0&&e.loading!==!0),f=w(()=>u.value===!0?e.tabindex||0:-1),v=w(()=>jg(e,"standard")),d=w(()=>{const E={tabindex:f.value};return r.value===!0?Object.assign(E,i.value):Bg.includes(e.type)===!0&&(E.type=e.type),o.value==="a"?(e.disable===!0?E["aria-disabled"]="true":E.href===void 0&&(E.role="button"),l.value!==!0&&Hg.test(e.type)===!0&&(E.type=e.type)):e.disable===!0&&(E.disabled="",E["aria-disabled"]="true"),e.loading===!0&&e.percentage!==void 0&&Object.assign(E,{role:"progressbar","aria-valuemin":0,"aria-valuemax":100,"aria-valuenow":e.percentage}),E}),b=w(()=>{let E;e.color!==void 0?e.flat===!0||e.outline===!0?E=`text-${e.textColor||e.color}`:E=`bg-${e.color} text-${e.textColor||"white"}`:e.textColor&&(E=`text-${e.textColor}`);const F=e.round===!0?"round":`rectangle${c.value===!0?" q-btn--rounded":e.square===!0?" q-btn--square":""}`;return`q-btn--${v.value} q-btn--${F}`+(E!==void 0?" "+E:"")+(u.value===!0?" q-btn--actionable q-focusable q-hoverable":e.disable===!0?" disabled":"")+(e.fab===!0?" q-btn--fab":e.fabMini===!0?" q-btn--fab-mini":"")+(e.noCaps===!0?" q-btn--no-uppercase":"")+(e.dense===!0?" q-btn--dense":"")+(e.stretch===!0?" no-border-radius self-stretch":"")+(e.glossy===!0?" glossy":"")+(e.square?" q-btn--square":"")}),C=w(()=>n.value+(e.stack===!0?" column":" row")+(e.noWrap===!0?" no-wrap text-no-wrap":"")+(e.loading===!0?" q-btn__content--hidden":""));return{classes:b,style:s,innerClasses:C,attributes:d,hasLink:r,linkTag:o,navigateOnClick:a,isActionable:u}}const{passiveCapture:Bt}=nt;let pl=null,yl=null,Sl=null;const zt=Re({name:"QBtn",props:{...zg,percentage:Number,darkPercentage:Boolean,onTouchstart:[Function,Array]},emits:["click","keydown","mousedown","keyup"],setup(e,{slots:t,emit:n}){const{proxy:l}=Ce(),{classes:r,style:o,innerClasses:i,attributes:a,hasLink:s,linkTag:c,navigateOnClick:u,isActionable:f}=Kg(e),v=ne(null),d=ne(null);let b=null,C,E=null;const F=w(()=>e.label!==void 0&&e.label!==null&&e.label!==""),k=w(()=>e.disable===!0||e.ripple===!1?!1:{keyCodes:s.value===!0?[13,32]:[13],...e.ripple===!0?{}:e.ripple}),m=w(()=>({center:e.round})),g=w(()=>{const B=Math.max(0,Math.min(100,e.percentage));return B>0?{transition:"transform 0.6s",transform:`translateX(${B-100}%)`}:{}}),L=w(()=>{if(e.loading===!0)return{onMousedown:Q,onTouchstart:Q,onClick:Q,onKeydown:Q,onKeyup:Q};if(f.value===!0){const B={onClick:A,onKeydown:I,onMousedown:N};if(l.$q.platform.has.touch===!0){const te=e.onTouchstart!==void 0?"":"Passive";B[`onTouchstart${te}`]=S}return B}return{onClick:Ze}}),P=w(()=>({ref:v,class:"q-btn q-btn-item non-selectable no-outline "+r.value,style:o.value,...a.value,...L.value}));function A(B){if(v.value!==null){if(B!==void 0){if(B.defaultPrevented===!0)return;const te=document.activeElement;if(e.type==="submit"&&te!==document.body&&v.value.contains(te)===!1&&te.contains(v.value)===!1){B.qAvoidFocus!==!0&&v.value.focus();const J=()=>{var ee;document.removeEventListener("keydown",Ze,!0),document.removeEventListener("keyup",J,Bt),(ee=v.value)==null||ee.removeEventListener("blur",J,Bt)};document.addEventListener("keydown",Ze,!0),document.addEventListener("keyup",J,Bt),v.value.addEventListener("blur",J,Bt)}}u(B)}}function I(B){v.value!==null&&(n("keydown",B),Tn(B,[13,32])===!0&&yl!==v.value&&(yl!==null&&x(),B.defaultPrevented!==!0&&(B.qAvoidFocus!==!0&&v.value.focus(),yl=v.value,v.value.classList.add("q-btn--active"),document.addEventListener("keyup",U,!0),v.value.addEventListener("blur",U,Bt)),Ze(B)))}function S(B){v.value!==null&&(n("touchstart",B),B.defaultPrevented!==!0&&(pl!==v.value&&(pl!==null&&x(),pl=v.value,b=B.target,b.addEventListener("touchcancel",U,Bt),b.addEventListener("touchend",U,Bt)),C=!0,E!==null&&clearTimeout(E),E=setTimeout(()=>{E=null,C=!1},200)))}function N(B){v.value!==null&&(B.qSkipRipple=C===!0,n("mousedown",B),B.defaultPrevented!==!0&&Sl!==v.value&&(Sl!==null&&x(),Sl=v.value,v.value.classList.add("q-btn--active"),document.addEventListener("mouseup",U,Bt)))}function U(B){if(v.value!==null&&!((B==null?void 0:B.type)==="blur"&&document.activeElement===v.value)){if((B==null?void 0:B.type)==="keyup"){if(yl===v.value&&Tn(B,[13,32])===!0){const te=new MouseEvent("click",B);te.qKeyEvent=!0,B.defaultPrevented===!0&&Ft(te),B.cancelBubble===!0&&on(te),v.value.dispatchEvent(te),Ze(B),B.qKeyEvent=!0}n("keyup",B)}x()}}function x(B){var J,ee;const te=d.value;B!==!0&&(pl===v.value||Sl===v.value)&&te!==null&&te!==document.activeElement&&(te.setAttribute("tabindex",-1),te.focus()),pl===v.value&&(b!==null&&(b.removeEventListener("touchcancel",U,Bt),b.removeEventListener("touchend",U,Bt)),pl=b=null),Sl===v.value&&(document.removeEventListener("mouseup",U,Bt),Sl=null),yl===v.value&&(document.removeEventListener("keyup",U,!0),(J=v.value)==null||J.removeEventListener("blur",U,Bt),yl=null),(ee=v.value)==null||ee.classList.remove("q-btn--active")}function Q(B){Ze(B),B.qSkipRipple=!0}return Ge(()=>{x(!0)}),Object.assign(l,{click:B=>{f.value===!0&&A(B)}}),()=>{let B=[];e.icon!==void 0&&B.push(O(xt,{name:e.icon,left:e.stack!==!0&&F.value===!0,role:"img"})),F.value===!0&&B.push(O("span",{class:"block"},[e.label])),B=Gt(t.default,B),e.iconRight!==void 0&&e.round===!1&&B.push(O(xt,{name:e.iconRight,right:e.stack!==!0&&F.value===!0,role:"img"}));const te=[O("span",{class:"q-focus-helper",ref:d})];return e.loading===!0&&e.percentage!==void 0&&te.push(O("span",{class:"q-btn__progress absolute-full overflow-hidden"+(e.darkPercentage===!0?" q-btn__progress--dark":"")},[O("span",{class:"q-btn__progress-indicator fit block",style:g.value})])),te.push(O("span",{class:"q-btn__content text-center col items-center q-anchor--skip "+i.value},B)),e.loading!==null&&te.push(O(ql,{name:"q-transition--fade"},()=>e.loading===!0?[O("span",{key:"loading",class:"absolute-full flex flex-center"},t.loading!==void 0?t.loading():[O(id)])]:null)),Ml(O(c.value,P.value,te),[[Da,k.value,void 0,m.value]])}}}),Yg=Re({name:"QToolbar",props:{inset:Boolean},setup(e,{slots:t}){const n=w(()=>"q-toolbar row no-wrap items-center"+(e.inset===!0?" q-toolbar--inset":""));return()=>O("div",{class:n.value,role:"toolbar"},Ve(t.default))}}),Qg="_q_",$o="_q_l_",cd="_q_pc_",Gg="_q_fo_",fd="_q_tabs_";function It(){}let mi,jr=0;const yt=new Array(256);for(let e=0;e<256;e++)yt[e]=(e+256).toString(16).substring(1);const Xg=(()=>{const e=typeof crypto<"u"?crypto:typeof window<"u"?window.crypto||window.msCrypto:void 0;if(e!==void 0){if(e.randomBytes!==void 0)return e.randomBytes;if(e.getRandomValues!==void 0)return t=>{const n=new Uint8Array(t);return e.getRandomValues(n),n}}return t=>{const n=[];for(let l=t;l>0;l--)n.push(Math.floor(Math.random()*256));return n}})(),nu=4096;function po(){(mi===void 0||jr+16>nu)&&(jr=0,mi=Xg(nu));const e=Array.prototype.slice.call(mi,jr,jr+=16);return e[6]=e[6]&15|64,e[8]=e[8]&63|128,yt[e[0]]+yt[e[1]]+yt[e[2]]+yt[e[3]]+"-"+yt[e[4]]+yt[e[5]]+"-"+yt[e[6]]+yt[e[7]]+"-"+yt[e[8]]+yt[e[9]]+"-"+yt[e[10]]+yt[e[11]]+yt[e[12]]+yt[e[13]]+yt[e[14]]+yt[e[15]]}function pn(e,t){if(e===t)return!0;if(e!==null&&t!==null&&typeof e=="object"&&typeof t=="object"){if(e.constructor!==t.constructor)return!1;let n,l;if(e.constructor===Array){if(n=e.length,n!==t.length)return!1;for(l=n;l--!==0;)if(pn(e[l],t[l])!==!0)return!1;return!0}if(e.constructor===Map){if(e.size!==t.size)return!1;let o=e.entries();for(l=o.next();l.done!==!0;){if(t.has(l.value[0])!==!0)return!1;l=o.next()}for(o=e.entries(),l=o.next();l.done!==!0;){if(pn(l.value[1],t.get(l.value[0]))!==!0)return!1;l=o.next()}return!0}if(e.constructor===Set){if(e.size!==t.size)return!1;const o=e.entries();for(l=o.next();l.done!==!0;){if(t.has(l.value[0])!==!0)return!1;l=o.next()}return!0}if(e.buffer!=null&&e.buffer.constructor===ArrayBuffer){if(n=e.length,n!==t.length)return!1;for(l=n;l--!==0;)if(e[l]!==t[l])return!1;return!0}if(e.constructor===RegExp)return e.source===t.source&&e.flags===t.flags;if(e.valueOf!==Object.prototype.valueOf)return e.valueOf()===t.valueOf();if(e.toString!==Object.prototype.toString)return e.toString()===t.toString();const r=Object.keys(e).filter(o=>e[o]!==void 0);if(n=r.length,n!==Object.keys(t).filter(o=>t[o]!==void 0).length)return!1;for(l=n;l--!==0;){const o=r[l];if(pn(e[o],t[o])!==!0)return!1}return!0}return e!==e&&t!==t}function Qi(e){return e!==null&&typeof e=="object"&&Array.isArray(e)!==!0}function lu(e){return Object.prototype.toString.call(e)==="[object Date]"}function Gi(e){return typeof e=="number"&&isFinite(e)}let Jg=0;const Zg=["click","keydown"],eb={icon:String,label:[Number,String],alert:[Boolean,String],alertIcon:String,name:{type:[Number,String],default:()=>`t_${Jg++}`},noCaps:Boolean,tabindex:[String,Number],disable:Boolean,contentClass:String,ripple:{type:[Boolean,Object],default:!0}};function tb(e,t,n,l){const r=Kt(fd,It);if(r===It)return console.error("QTab/QRouteTab component needs to be child of QTabs"),It;const{proxy:o}=Ce(),i=ne(null),a=ne(null),s=ne(null),c=w(()=>e.disable===!0||e.ripple===!1?!1:Object.assign({keyCodes:[13,32],early:!0},e.ripple===!0?{}:e.ripple)),u=w(()=>r.currentModel.value===e.name),f=w(()=>"q-tab relative-position self-stretch flex flex-center text-center"+(u.value===!0?" q-tab--active"+(r.tabProps.value.activeClass?" "+r.tabProps.value.activeClass:"")+(r.tabProps.value.activeColor?` text-${r.tabProps.value.activeColor}`:"")+(r.tabProps.value.activeBgColor?` bg-${r.tabProps.value.activeBgColor}`:""):" q-tab--inactive")+(e.icon&&e.label&&r.tabProps.value.inlineLabel===!1?" q-tab--full":"")+(e.noCaps===!0||r.tabProps.value.noCaps===!0?" q-tab--no-caps":"")+(e.disable===!0?" disabled":" q-focusable q-hoverable cursor-pointer")+(l!==void 0?l.linkClass.value:"")),v=w(()=>"q-tab__content self-stretch flex-center relative-position q-anchor--skip non-selectable "+(r.tabProps.value.inlineLabel===!0?"row no-wrap q-tab__content--inline":"column")+(e.contentClass!==void 0?` ${e.contentClass}`:"")),d=w(()=>e.disable===!0||r.hasFocus.value===!0||u.value===!1&&r.hasActiveTab.value===!0?-1:e.tabindex||0);function b(m,g){var L;if(g!==!0&&(m==null?void 0:m.qAvoidFocus)!==!0&&((L=i.value)==null||L.focus()),e.disable===!0){(l==null?void 0:l.hasRouterLink.value)===!0&&Ze(m);return}if(l===void 0){r.updateModel({name:e.name}),n("click",m);return}if(l.hasRouterLink.value===!0){const P=(A={})=>{let I;const S=A.to===void 0||pn(A.to,e.to)===!0?r.avoidRouteWatcher=po():null;return l.navigateToRouterLink(m,{...A,returnRouterError:!0}).catch(N=>{I=N}).then(N=>{var U;if(S===r.avoidRouteWatcher&&(r.avoidRouteWatcher=!1,I===void 0&&(N===void 0||((U=N.message)==null?void 0:U.startsWith("Avoided redundant navigation"))===!0)&&r.updateModel({name:e.name})),A.returnRouterError===!0)return I!==void 0?Promise.reject(I):N})};n("click",m,P),m.defaultPrevented!==!0&&P();return}n("click",m)}function C(m){Tn(m,[13,32])?b(m,!0):Fo(m)!==!0&&m.keyCode>=35&&m.keyCode<=40&&m.altKey!==!0&&m.metaKey!==!0&&r.onKbdNavigate(m.keyCode,o.$el)===!0&&Ze(m),n("keydown",m)}function E(){const m=r.tabProps.value.narrowIndicator,g=[],L=O("div",{ref:s,class:["q-tab__indicator",r.tabProps.value.indicatorClass]});e.icon!==void 0&&g.push(O(xt,{class:"q-tab__icon",name:e.icon})),e.label!==void 0&&g.push(O("div",{class:"q-tab__label"},e.label)),e.alert!==!1&&g.push(e.alertIcon!==void 0?O(xt,{class:"q-tab__alert-icon",color:e.alert!==!0?e.alert:void 0,name:e.alertIcon}):O("div",{class:"q-tab__alert"+(e.alert!==!0?` text-${e.alert}`:"")})),m===!0&&g.push(L);const P=[O("div",{class:"q-focus-helper",tabindex:-1,ref:i}),O("div",{class:v.value},Gt(t.default,g))];return m===!1&&P.push(L),P}const F={name:w(()=>e.name),rootRef:a,tabIndicatorRef:s,routeData:l};Ge(()=>{r.unregisterTab(F)}),gt(()=>{r.registerTab(F)});function k(m,g){const L={ref:a,class:f.value,tabindex:d.value,role:"tab","aria-selected":u.value===!0?"true":"false","aria-disabled":e.disable===!0?"true":void 0,onClick:b,onKeydown:C,...g};return Ml(O(m,L,E()),[[Da,c.value]])}return{renderTab:k,$tabs:r}}const ru=Re({name:"QTab",props:eb,emits:Zg,setup(e,{slots:t,emit:n}){const{renderTab:l}=tb(e,t,n);return()=>l("div")}});function nb(){const e=ne(!cn.value);return e.value===!1&&gt(()=>{e.value=!0}),{isHydrated:e}}const dd=typeof ResizeObserver<"u",ou=dd===!0?{}:{style:"display:block;position:absolute;top:0;left:0;right:0;bottom:0;height:100%;width:100%;overflow:hidden;pointer-events:none;z-index:-1;",url:"about:blank"},yo=Re({name:"QResizeObserver",props:{debounce:{type:[String,Number],default:100}},emits:["resize"],setup(e,{emit:t}){let n=null,l,r={width:-1,height:-1};function o(u){u===!0||e.debounce===0||e.debounce==="0"?i():n===null&&(n=setTimeout(i,e.debounce))}function i(){if(n!==null&&(clearTimeout(n),n=null),l){const{offsetWidth:u,offsetHeight:f}=l;(u!==r.width||f!==r.height)&&(r={width:u,height:f},t("resize",r))}}const{proxy:a}=Ce();if(a.trigger=o,dd===!0){let u;const f=v=>{l=a.$el.parentNode,l?(u=new ResizeObserver(o),u.observe(l),i()):v!==!0&&Ke(()=>{f(!0)})};return gt(()=>{f()}),Ge(()=>{n!==null&&clearTimeout(n),u!==void 0&&(u.disconnect!==void 0?u.disconnect():l&&u.unobserve(l))}),En}else{let v=function(){n!==null&&(clearTimeout(n),n=null),f!==void 0&&(f.removeEventListener!==void 0&&f.removeEventListener("resize",o,nt.passive),f=void 0)},d=function(){v(),l!=null&&l.contentDocument&&(f=l.contentDocument.defaultView,f.addEventListener("resize",o,nt.passive),i())};var s=v,c=d;const{isHydrated:u}=nb();let f;return gt(()=>{Ke(()=>{l=a.$el,l&&d()})}),Ge(v),()=>{if(u.value===!0)return O("object",{class:"q--avoid-card-border",style:ou.style,tabindex:-1,type:"text/html",data:ou.url,"aria-hidden":"true",onLoad:d})}}}});let _r=!1;{const e=document.createElement("div");e.setAttribute("dir","rtl"),Object.assign(e.style,{width:"1px",height:"1px",overflow:"auto"});const t=document.createElement("div");Object.assign(t.style,{width:"1000px",height:"1px"}),document.body.appendChild(e),e.appendChild(t),e.scrollLeft=-1e3,_r=e.scrollLeft>=0,e.remove()}function lb(e,t,n){const l=n===!0?["left","right"]:["top","bottom"];return`absolute-${t===!0?l[0]:l[1]}${e?` text-${e}`:""}`}const rb=["left","center","right","justify"],ob=Re({name:"QTabs",props:{modelValue:[Number,String],align:{type:String,default:"center",validator:e=>rb.includes(e)},breakpoint:{type:[String,Number],default:600},vertical:Boolean,shrink:Boolean,stretch:Boolean,activeClass:String,activeColor:String,activeBgColor:String,indicatorColor:String,leftIcon:String,rightIcon:String,outsideArrows:Boolean,mobileArrows:Boolean,switchIndicator:Boolean,narrowIndicator:Boolean,inlineLabel:Boolean,noCaps:Boolean,dense:Boolean,contentClass:String,"onUpdate:modelValue":[Function,Array]},setup(e,{slots:t,emit:n}){const{proxy:l}=Ce(),{$q:r}=l,{registerTick:o}=ar(),{registerTick:i}=ar(),{registerTick:a}=ar(),{registerTimeout:s,removeTimeout:c}=br(),{registerTimeout:u,removeTimeout:f}=br(),v=ne(null),d=ne(null),b=ne(e.modelValue),C=ne(!1),E=ne(!0),F=ne(!1),k=ne(!1),m=[],g=ne(0),L=ne(!1);let P=null,A=null,I;const S=w(()=>({activeClass:e.activeClass,activeColor:e.activeColor,activeBgColor:e.activeBgColor,indicatorClass:lb(e.indicatorColor,e.switchIndicator,e.vertical),narrowIndicator:e.narrowIndicator,inlineLabel:e.inlineLabel,noCaps:e.noCaps})),N=w(()=>{const y=g.value,V=b.value;for(let j=0;j<y;j++)if(m[j].name.value===V)return!0;return!1}),U=w(()=>`q-tabs__content--align-${C.value===!0?"left":k.value===!0?"justify":e.align}`),x=w(()=>`q-tabs row no-wrap items-center q-tabs--${C.value===!0?"":"not-"}scrollable q-tabs--${e.vertical===!0?"vertical":"horizontal"} q-tabs__arrows--${e.outsideArrows===!0?"outside":"inside"} q-tabs--mobile-with${e.mobileArrows===!0?"":"out"}-arrows`+(e.dense===!0?" q-tabs--dense":"")+(e.shrink===!0?" col-shrink":"")+(e.stretch===!0?" self-stretch":"")),Q=w(()=>"q-tabs__content scroll--mobile row no-wrap items-center self-stretch hide-scrollbar relative-position "+U.value+(e.contentClass!==void 0?` ${e.contentClass}`:"")),B=w(()=>e.vertical===!0?{container:"height",content:"offsetHeight",scroll:"scrollHeight"}:{container:"width",content:"offsetWidth",scroll:"scrollWidth"}),te=w(()=>e.vertical!==!0&&r.lang.rtl===!0),J=w(()=>_r===!1&&te.value===!0);me(te,q),me(()=>e.modelValue,y=>{ee({name:y,setCurrent:!0,skipEmit:!0})}),me(()=>e.outsideArrows,Y);function ee({name:y,setCurrent:V,skipEmit:j}){b.value!==y&&(j!==!0&&e["onUpdate:modelValue"]!==void 0&&n("update:modelValue",y),(V===!0||e["onUpdate:modelValue"]===void 0)&&(he(b.value,y),b.value=y))}function Y(){o(()=>{v.value&&se({width:v.value.offsetWidth,height:v.value.offsetHeight})})}function se(y){if(B.value===void 0||d.value===null)return;const V=y[B.value.container],j=Math.min(d.value[B.value.scroll],Array.prototype.reduce.call(d.value.children,(M,H)=>M+(H[B.value.content]||0),0)),z=V>0&&j>V;C.value=z,z===!0&&i(q),k.value=V<parseInt(e.breakpoint,10)}function he(y,V){const j=y!=null&&y!==""?m.find(M=>M.name.value===y):null,z=V!=null&&V!==""?m.find(M=>M.name.value===V):null;if(_===!0)_=!1;else if(j&&z){const M=j.tabIndicatorRef.value,H=z.tabIndicatorRef.value;P!==null&&(clearTimeout(P),P=null),M.style.transition="none",M.style.transform="none",H.style.transition="none",H.style.transform="none";const re=M.getBoundingClientRect(),ue=H.getBoundingClientRect();H.style.transform=e.vertical===!0?`translate3d(0,${re.top-ue.top}px,0) scale3d(1,${ue.height?re.height/ue.height:1},1)`:`translate3d(${re.left-ue.left}px,0,0) scale3d(${ue.width?re.width/ue.width:1},1,1)`,a(()=>{P=setTimeout(()=>{P=null,H.style.transition="transform .25s cubic-bezier(.4, 0, .2, 1)",H.style.transform="none"},70)})}z&&C.value===!0&&$(z.rootRef.value)}function $(y){const{left:V,width:j,top:z,height:M}=d.value.getBoundingClientRect(),H=y.getBoundingClientRect();let re=e.vertical===!0?H.top-z:H.left-V;if(re<0){d.value[e.vertical===!0?"scrollTop":"scrollLeft"]+=Math.floor(re),q();return}re+=e.vertical===!0?H.height-M:H.width-j,re>0&&(d.value[e.vertical===!0?"scrollTop":"scrollLeft"]+=Math.ceil(re),q())}function q(){const y=d.value;if(y===null)return;const V=y.getBoundingClientRect(),j=e.vertical===!0?y.scrollTop:Math.abs(y.scrollLeft);te.value===!0?(E.value=Math.ceil(j+V.width)<y.scrollWidth-1,F.value=j>0):(E.value=j>0,F.value=e.vertical===!0?Math.ceil(j+V.height)<y.scrollHeight:Math.ceil(j+V.width)<y.scrollWidth)}function ae(y){A!==null&&clearInterval(A),A=setInterval(()=>{le(y)===!0&&Z()},5)}function ge(){ae(J.value===!0?Number.MAX_SAFE_INTEGER:0)}function ye(){ae(J.value===!0?0:Number.MAX_SAFE_INTEGER)}function Z(){A!==null&&(clearInterval(A),A=null)}function ie(y,V){const j=Array.prototype.filter.call(d.value.children,ue=>ue===V||ue.matches&&ue.matches(".q-tab.q-focusable")===!0),z=j.length;if(z===0)return;if(y===36)return $(j[0]),j[0].focus(),!0;if(y===35)return $(j[z-1]),j[z-1].focus(),!0;const M=y===(e.vertical===!0?38:37),H=y===(e.vertical===!0?40:39),re=M===!0?-1:H===!0?1:void 0;if(re!==void 0){const ue=te.value===!0?-1:1,Ee=j.indexOf(V)+re*ue;return Ee>=0&&Ee<z&&($(j[Ee]),j[Ee].focus({preventScroll:!0})),!0}}const xe=w(()=>J.value===!0?{get:y=>Math.abs(y.scrollLeft),set:(y,V)=>{y.scrollLeft=-V}}:e.vertical===!0?{get:y=>y.scrollTop,set:(y,V)=>{y.scrollTop=V}}:{get:y=>y.scrollLeft,set:(y,V)=>{y.scrollLeft=V}});function le(y){const V=d.value,{get:j,set:z}=xe.value;let M=!1,H=j(V);const re=y<H?-1:1;return H+=re*5,H<0?(M=!0,H=0):(re===-1&&H<=y||re===1&&H>=y)&&(M=!0,H=y),z(V,H),q(),M}function Pe(y,V){for(const j in y)if(y[j]!==V[j])return!1;return!0}function $e(){let y=null,V={matchedLen:0,queryDiff:9999,hrefLen:0};const j=m.filter(re=>{var ue;return((ue=re.routeData)==null?void 0:ue.hasRouterLink.value)===!0}),{hash:z,query:M}=l.$route,H=Object.keys(M).length;for(const re of j){const ue=re.routeData.exact.value===!0;if(re.routeData[ue===!0?"linkIsExactActive":"linkIsActive"].value!==!0)continue;const{hash:Ee,query:Ae,matched:Fe,href:ot}=re.routeData.resolvedLink.value,bt=Object.keys(Ae).length;if(ue===!0){if(Ee!==z||bt!==H||Pe(M,Ae)===!1)continue;y=re.name.value;break}if(Ee!==""&&Ee!==z||bt!==0&&Pe(Ae,M)===!1)continue;const _t={matchedLen:Fe.length,queryDiff:H-bt,hrefLen:ot.length-Ee.length};if(_t.matchedLen>V.matchedLen){y=re.name.value,V=_t;continue}else if(_t.matchedLen!==V.matchedLen)continue;if(_t.queryDiff<V.queryDiff)y=re.name.value,V=_t;else if(_t.queryDiff!==V.queryDiff)continue;_t.hrefLen>V.hrefLen&&(y=re.name.value,V=_t)}if(y===null&&m.some(re=>re.routeData===void 0&&re.name.value===b.value)===!0){_=!1;return}ee({name:y,setCurrent:!0})}function ut(y){if(c(),L.value!==!0&&v.value!==null&&y.target&&typeof y.target.closest=="function"){const V=y.target.closest(".q-tab");V&&v.value.contains(V)===!0&&(L.value=!0,C.value===!0&&$(V))}}function T(){s(()=>{L.value=!1},30)}function p(){X.avoidRouteWatcher===!1?u($e):f()}function D(){if(I===void 0){const y=me(()=>l.$route.fullPath,p);I=()=>{y(),I=void 0}}}function G(y){m.push(y),g.value++,Y(),y.routeData===void 0||l.$route===void 0?u(()=>{if(C.value===!0){const V=b.value,j=V!=null&&V!==""?m.find(z=>z.name.value===V):null;j&&$(j.rootRef.value)}}):(D(),y.routeData.hasRouterLink.value===!0&&p())}function K(y){m.splice(m.indexOf(y),1),g.value--,Y(),I!==void 0&&y.routeData!==void 0&&(m.every(V=>V.routeData===void 0)===!0&&I(),p())}const X={currentModel:b,tabProps:S,hasFocus:L,hasActiveTab:N,registerTab:G,unregisterTab:K,verifyRouteModel:p,updateModel:ee,onKbdNavigate:ie,avoidRouteWatcher:!1};Io(fd,X);function oe(){P!==null&&clearTimeout(P),Z(),I==null||I()}let h,_;return Ge(oe),dl(()=>{h=I!==void 0,oe()}),xr(()=>{h===!0&&(D(),_=!0,p()),Y()}),()=>O("div",{ref:v,class:x.value,role:"tablist",onFocusin:ut,onFocusout:T},[O(yo,{onResize:se}),O("div",{ref:d,class:Q.value,onScroll:q},Ve(t.default)),O(xt,{class:"q-tabs__arrow q-tabs__arrow--left absolute q-tab__icon"+(E.value===!0?"":" q-tabs__arrow--faded"),name:e.leftIcon||r.iconSet.tabs[e.vertical===!0?"up":"left"],onMousedownPassive:ge,onTouchstartPassive:ge,onMouseupPassive:Z,onMouseleavePassive:Z,onTouchendPassive:Z}),O(xt,{class:"q-tabs__arrow q-tabs__arrow--right absolute q-tab__icon"+(F.value===!0?"":" q-tabs__arrow--faded"),name:e.rightIcon||r.iconSet.tabs[e.vertical===!0?"down":"right"],onMousedownPassive:ye,onTouchstartPassive:ye,onMouseupPassive:Z,onMouseleavePassive:Z,onTouchendPassive:Z})])}}),ib=Re({name:"QHeader",props:{modelValue:{type:Boolean,default:!0},reveal:Boolean,revealOffset:{type:Number,default:250},bordered:Boolean,elevated:Boolean,heightHint:{type:[String,Number],default:50}},emits:["reveal","focusin"],setup(e,{slots:t,emit:n}){const{proxy:{$q:l}}=Ce(),r=Kt($o,It);if(r===It)return console.error("QHeader needs to be child of QLayout"),It;const o=ne(parseInt(e.heightHint,10)),i=ne(!0),a=w(()=>e.reveal===!0||r.view.value.indexOf("H")!==-1||l.platform.is.ios&&r.isContainer.value===!0),s=w(()=>{if(e.modelValue!==!0)return 0;if(a.value===!0)return i.value===!0?o.value:0;const k=o.value-r.scroll.value.position;return k>0?k:0}),c=w(()=>e.modelValue!==!0||a.value===!0&&i.value!==!0),u=w(()=>e.modelValue===!0&&c.value===!0&&e.reveal===!0),f=w(()=>"q-header q-layout__section--marginal "+(a.value===!0?"fixed":"absolute")+"-top"+(e.bordered===!0?" q-header--bordered":"")+(c.value===!0?" q-header--hidden":"")+(e.modelValue!==!0?" q-layout--prevent-focus":"")),v=w(()=>{const k=r.rows.value.top,m={};return k[0]==="l"&&r.left.space===!0&&(m[l.lang.rtl===!0?"right":"left"]=`${r.left.size}px`),k[2]==="r"&&r.right.space===!0&&(m[l.lang.rtl===!0?"left":"right"]=`${r.right.size}px`),m});function d(k,m){r.update("header",k,m)}function b(k,m){k.value!==m&&(k.value=m)}function C({height:k}){b(o,k),d("size",k)}function E(k){u.value===!0&&b(i,!0),n("focusin",k)}me(()=>e.modelValue,k=>{d("space",k),b(i,!0),r.animate()}),me(s,k=>{d("offset",k)}),me(()=>e.reveal,k=>{k===!1&&b(i,e.modelValue)}),me(i,k=>{r.animate(),n("reveal",k)}),me(r.scroll,k=>{e.reveal===!0&&b(i,k.direction==="up"||k.position<=e.revealOffset||k.position-k.inflectionPoint<100)});const F={};return r.instances.header=F,e.modelValue===!0&&d("size",o.value),d("space",e.modelValue),d("offset",s.value),Ge(()=>{r.instances.header===F&&(r.instances.header=void 0,d("size",0),d("offset",0),d("space",!1))}),()=>{const k=Ma(t.default,[]);return e.elevated===!0&&k.push(O("div",{class:"q-layout__shadow absolute-full overflow-hidden no-pointer-events"})),k.push(O(yo,{debounce:0,onResize:C})),O("header",{class:f.value,style:v.value,onFocusin:E},k)}}}),iu=Re({name:"QPage",props:{padding:Boolean,styleFn:Function},setup(e,{slots:t}){const{proxy:{$q:n}}=Ce(),l=Kt($o,It);if(l===It)return console.error("QPage needs to be a deep child of QLayout"),It;if(Kt(cd,It)===It)return console.error("QPage needs to be child of QPageContainer"),It;const o=w(()=>{const a=(l.header.space===!0?l.header.size:0)+(l.footer.space===!0?l.footer.size:0);if(typeof e.styleFn=="function"){const s=l.isContainer.value===!0?l.containerHeight.value:n.screen.height;return e.styleFn(a,s)}return{minHeight:l.isContainer.value===!0?l.containerHeight.value-a+"px":n.screen.height===0?a!==0?`calc(100vh - ${a}px)`:"100vh":n.screen.height-a+"px"}}),i=w(()=>`q-page${e.padding===!0?" q-layout-padding":""}`);return()=>O("main",{class:i.value,style:o.value},Ve(t.default))}}),Va={left:!0,right:!0,up:!0,down:!0,horizontal:!0,vertical:!0},ab=Object.keys(Va);Va.all=!0;function au(e){const t={};for(const n of ab)e[n]===!0&&(t[n]=!0);return Object.keys(t).length===0?Va:(t.horizontal===!0?t.left=t.right=!0:t.left===!0&&t.right===!0&&(t.horizontal=!0),t.vertical===!0?t.up=t.down=!0:t.up===!0&&t.down===!0&&(t.vertical=!0),t.horizontal===!0&&t.vertical===!0&&(t.all=!0),t)}const sb=["INPUT","TEXTAREA"];function su(e,t){return t.event===void 0&&e.target!==void 0&&e.target.draggable!==!0&&typeof t.handler=="function"&&sb.includes(e.target.nodeName.toUpperCase())===!1&&(e.qClonedBy===void 0||e.qClonedBy.indexOf(t.uid)===-1)}function ub(e){const t=[.06,6,50];return typeof e=="string"&&e.length&&e.split(":").forEach((n,l)=>{const r=parseFloat(n);r&&(t[l]=r)}),t}const cb=Na({name:"touch-swipe",beforeMount(e,{value:t,arg:n,modifiers:l}){if(l.mouse!==!0&&Ye.has.touch!==!0)return;const r=l.mouseCapture===!0?"Capture":"",o={handler:t,sensitivity:ub(n),direction:au(l),noop:En,mouseStart(i){su(i,o)&&ng(i)&&(nl(o,"temp",[[document,"mousemove","move",`notPassive${r}`],[document,"mouseup","end","notPassiveCapture"]]),o.start(i,!0))},touchStart(i){if(su(i,o)){const a=i.target;nl(o,"temp",[[a,"touchmove","move","notPassiveCapture"],[a,"touchcancel","end","notPassiveCapture"],[a,"touchend","end","notPassiveCapture"]]),o.start(i)}},start(i,a){Ye.is.firefox===!0&&di(e,!0);const s=vo(i);o.event={x:s.left,y:s.top,time:Date.now(),mouse:a===!0,dir:!1}},move(i){if(o.event===void 0)return;if(o.event.dir!==!1){Ze(i);return}const a=Date.now()-o.event.time;if(a===0)return;const s=vo(i),c=s.left-o.event.x,u=Math.abs(c),f=s.top-o.event.y,v=Math.abs(f);if(o.event.mouse!==!0){if(u<o.sensitivity[1]&&v<o.sensitivity[1]){o.end(i);return}}else if(window.getSelection().toString()!==""){o.end(i);return}else if(u<o.sensitivity[2]&&v<o.sensitivity[2])return;const d=u/a,b=v/a;o.direction.vertical===!0&&u<v&&u<100&&b>o.sensitivity[0]&&(o.event.dir=f<0?"up":"down"),o.direction.horizontal===!0&&u>v&&v<100&&d>o.sensitivity[0]&&(o.event.dir=c<0?"left":"right"),o.direction.up===!0&&u<v&&f<0&&u<100&&b>o.sensitivity[0]&&(o.event.dir="up"),o.direction.down===!0&&u<v&&f>0&&u<100&&b>o.sensitivity[0]&&(o.event.dir="down"),o.direction.left===!0&&u>v&&c<0&&v<100&&d>o.sensitivity[0]&&(o.event.dir="left"),o.direction.right===!0&&u>v&&c>0&&v<100&&d>o.sensitivity[0]&&(o.event.dir="right"),o.event.dir!==!1?(Ze(i),o.event.mouse===!0&&(document.body.classList.add("no-pointer-events--children"),document.body.classList.add("non-selectable"),Bf(),o.styleCleanup=C=>{o.styleCleanup=void 0,document.body.classList.remove("non-selectable");const E=()=>{document.body.classList.remove("no-pointer-events--children")};C===!0?setTimeout(E,50):E()}),o.handler({evt:i,touch:o.event.mouse!==!0,mouse:o.event.mouse,direction:o.event.dir,duration:a,distance:{x:u,y:v}})):o.end(i)},end(i){var a;o.event!==void 0&&(ir(o,"temp"),Ye.is.firefox===!0&&di(e,!1),(a=o.styleCleanup)==null||a.call(o,!0),i!==void 0&&o.event.dir!==!1&&Ze(i),o.event=void 0)}};if(e.__qtouchswipe=o,l.mouse===!0){const i=l.mouseCapture===!0||l.mousecapture===!0?"Capture":"";nl(o,"main",[[e,"mousedown","mouseStart",`passive${i}`]])}Ye.has.touch===!0&&nl(o,"main",[[e,"touchstart","touchStart",`passive${l.capture===!0?"Capture":""}`],[e,"touchmove","noop","notPassiveCapture"]])},updated(e,t){const n=e.__qtouchswipe;n!==void 0&&(t.oldValue!==t.value&&(typeof t.value!="function"&&n.end(),n.handler=t.value),n.direction=au(t.modifiers))},beforeUnmount(e){var n;const t=e.__qtouchswipe;t!==void 0&&(ir(t,"main"),ir(t,"temp"),Ye.is.firefox===!0&&di(e,!1),(n=t.styleCleanup)==null||n.call(t),delete e.__qtouchswipe)}});function fb(){let e=Object.create(null);return{getCache:(t,n)=>e[t]===void 0?e[t]=typeof n=="function"?n():n:e[t],setCache(t,n){e[t]=n},hasCache(t){return Object.hasOwnProperty.call(e,t)},clearCache(t){t!==void 0?delete e[t]:e=Object.create(null)}}}const db={name:{required:!0},disable:Boolean},uu={setup(e,{slots:t}){return()=>O("div",{class:"q-panel scroll",role:"tabpanel"},Ve(t.default))}},vb={modelValue:{required:!0},animated:Boolean,infinite:Boolean,swipeable:Boolean,vertical:Boolean,transitionPrev:String,transitionNext:String,transitionDuration:{type:[String,Number],default:300},keepAlive:Boolean,keepAliveInclude:[String,Array,RegExp],keepAliveExclude:[String,Array,RegExp],keepAliveMax:Number},mb=["update:modelValue","beforeTransition","transition"];function hb(){const{props:e,emit:t,proxy:n}=Ce(),{getCache:l}=fb(),{registerTimeout:r}=br();let o,i;const a=ne(null),s={value:null};function c(B){const te=e.vertical===!0?"up":"left";I((n.$q.lang.rtl===!0?-1:1)*(B.direction===te?1:-1))}const u=w(()=>[[cb,c,void 0,{horizontal:e.vertical!==!0,vertical:e.vertical,mouse:!0}]]),f=w(()=>e.transitionPrev||`slide-${e.vertical===!0?"down":"right"}`),v=w(()=>e.transitionNext||`slide-${e.vertical===!0?"up":"left"}`),d=w(()=>`--q-transition-duration: ${e.transitionDuration}ms`),b=w(()=>typeof e.modelValue=="string"||typeof e.modelValue=="number"?e.modelValue:String(e.modelValue)),C=w(()=>({include:e.keepAliveInclude,exclude:e.keepAliveExclude,max:e.keepAliveMax})),E=w(()=>e.keepAliveInclude!==void 0||e.keepAliveExclude!==void 0);me(()=>e.modelValue,(B,te)=>{const J=g(B)===!0?L(B):-1;i!==!0&&A(J===-1?0:J<L(te)?-1:1),s.value!==J&&(s.value=J,t("beforeTransition",B,te),r(()=>{t("transition",B,te)},e.transitionDuration))});function F(){I(1)}function k(){I(-1)}function m(B){t("update:modelValue",B)}function g(B){return B!=null&&B!==""}function L(B){return o.findIndex(te=>te.props.name===B&&te.props.disable!==""&&te.props.disable!==!0)}function P(){return o.filter(B=>B.props.disable!==""&&B.props.disable!==!0)}function A(B){const te=B!==0&&e.animated===!0&&s.value!==-1?"q-transition--"+(B===-1?f.value:v.value):null;a.value!==te&&(a.value=te)}function I(B,te=s.value){let J=te+B;for(;J!==-1&&J<o.length;){const ee=o[J];if(ee!==void 0&&ee.props.disable!==""&&ee.props.disable!==!0){A(B),i=!0,t("update:modelValue",ee.props.name),setTimeout(()=>{i=!1});return}J+=B}e.infinite===!0&&o.length!==0&&te!==-1&&te!==o.length&&I(B,B===-1?o.length:-1)}function S(){const B=L(e.modelValue);return s.value!==B&&(s.value=B),!0}function N(){const B=g(e.modelValue)===!0&&S()&&o[s.value];return e.keepAlive===!0?[O(mm,C.value,[O(E.value===!0?l(b.value,()=>({...uu,name:b.value})):uu,{key:b.value,style:d.value},()=>B)])]:[O("div",{class:"q-panel scroll",style:d.value,key:b.value,role:"tabpanel"},[B])]}function U(){if(o.length!==0)return e.animated===!0?[O(ql,{name:a.value},N)]:N()}function x(B){return o=Xh(Ve(B.default,[])).filter(te=>te.props!==null&&te.props.slot===void 0&&g(te.props.name)===!0),o.length}function Q(){return o}return Object.assign(n,{next:F,previous:k,goTo:m}),{panelIndex:s,panelDirectives:u,updatePanelsList:x,updatePanelIndex:S,getPanelContent:U,getEnabledPanels:P,getPanels:Q,isValidPanelName:g,keepAliveProps:C,needsUniqueKeepAliveWrapper:E,goToPanelByOffset:I,goToPanel:m,nextPanel:F,previousPanel:k}}const cu=Re({name:"QTabPanel",props:db,setup(e,{slots:t}){return()=>O("div",{class:"q-tab-panel",role:"tabpanel"},Ve(t.default))}}),Tl=Re({name:"QCardSection",props:{tag:{type:String,default:"div"},horizontal:Boolean},setup(e,{slots:t}){const n=w(()=>`q-card__section q-card__section--${e.horizontal===!0?"horiz row no-wrap":"vert"}`);return()=>O(e.tag,{class:n.value},Ve(t.default))}}),gb=Re({name:"QBanner",props:{...$t,inlineActions:Boolean,dense:Boolean,rounded:Boolean},setup(e,{slots:t}){const{proxy:{$q:n}}=Ce(),l=Dt(e,n),r=w(()=>"q-banner row items-center"+(e.dense===!0?" q-banner--dense":"")+(l.value===!0?" q-banner--dark q-dark":"")+(e.rounded===!0?" rounded-borders":"")),o=w(()=>`q-banner__actions row items-center justify-end col-${e.inlineActions===!0?"auto":"all"}`);return()=>{const i=[O("div",{class:"q-banner__avatar col-auto row items-center self-start"},Ve(t.avatar)),O("div",{class:"q-banner__content col text-body2"},Ve(t.default))],a=Ve(t.action);return a!==void 0&&i.push(O("div",{class:o.value},a)),O("div",{class:r.value+(e.inlineActions===!1&&a!==void 0?" q-banner--top-padding":""),role:"alert"},i)}}}),Xi=Re({name:"QCard",props:{...$t,tag:{type:String,default:"div"},square:Boolean,flat:Boolean,bordered:Boolean},setup(e,{slots:t}){const{proxy:{$q:n}}=Ce(),l=Dt(e,n),r=w(()=>"q-card"+(l.value===!0?" q-card--dark q-dark":"")+(e.bordered===!0?" q-card--bordered":"")+(e.square===!0?" q-card--square no-border-radius":"")+(e.flat===!0?" q-card--flat no-shadow":""));return()=>O(e.tag,{class:r.value},Ve(t.default))}}),bb=Re({name:"QTabPanels",props:{...vb,...$t},emits:mb,setup(e,{slots:t}){const n=Ce(),l=Dt(e,n.proxy.$q),{updatePanelsList:r,getPanelContent:o,panelDirectives:i}=hb(),a=w(()=>"q-tab-panels q-panel-parent"+(l.value===!0?" q-tab-panels--dark q-dark":""));return()=>(r(t),Af("div",{class:a.value},o(),"pan",e.swipeable,()=>i.value))}}),_b=Re({name:"QPageContainer",setup(e,{slots:t}){const{proxy:{$q:n}}=Ce(),l=Kt($o,It);if(l===It)return console.error("QPageContainer needs to be child of QLayout"),It;Io(cd,!0);const r=w(()=>{const o={};return l.header.space===!0&&(o.paddingTop=`${l.header.size}px`),l.right.space===!0&&(o[`padding${n.lang.rtl===!0?"Left":"Right"}`]=`${l.right.size}px`),l.footer.space===!0&&(o.paddingBottom=`${l.footer.size}px`),l.left.space===!0&&(o[`padding${n.lang.rtl===!0?"Right":"Left"}`]=`${l.left.size}px`),o});return()=>O("div",{class:"q-page-container",style:r.value},Ve(t.default))}}),{passive:fu}=nt,pb=["both","horizontal","vertical"],yb=Re({name:"QScrollObserver",props:{axis:{type:String,validator:e=>pb.includes(e),default:"vertical"},debounce:[String,Number],scrollTarget:qa},emits:["scroll"],setup(e,{emit:t}){const n={position:{top:0,left:0},direction:"down",directionChanged:!1,delta:{top:0,left:0},inflectionPoint:{top:0,left:0}};let l=null,r,o;me(()=>e.scrollTarget,()=>{s(),a()});function i(){l==null||l();const f=Math.max(0,Jf(r)),v=Zf(r),d={top:f-n.position.top,left:v-n.position.left};if(e.axis==="vertical"&&d.top===0||e.axis==="horizontal"&&d.left===0)return;const b=Math.abs(d.top)>=Math.abs(d.left)?d.top<0?"up":"down":d.left<0?"left":"right";n.position={top:f,left:v},n.directionChanged=n.direction!==b,n.delta=d,n.directionChanged===!0&&(n.direction=b,n.inflectionPoint=n.position),t("scroll",{...n})}function a(){r=$a(o,e.scrollTarget),r.addEventListener("scroll",c,fu),c(!0)}function s(){r!==void 0&&(r.removeEventListener("scroll",c,fu),r=void 0)}function c(f){if(f===!0||e.debounce===0||e.debounce==="0")i();else if(l===null){const[v,d]=e.debounce?[setTimeout(i,e.debounce),clearTimeout]:[requestAnimationFrame(i),cancelAnimationFrame];l=()=>{d(v),l=null}}}const{proxy:u}=Ce();return me(()=>u.$q.lang.rtl,i),gt(()=>{o=u.$el.parentNode,a()}),Ge(()=>{l==null||l(),s()}),Object.assign(u,{trigger:c,getPosition:()=>n}),En}}),Sb=Re({name:"QLayout",props:{container:Boolean,view:{type:String,default:"hhh lpr fff",validator:e=>/^(h|l)h(h|r) lpr (f|l)f(f|r)$/.test(e.toLowerCase())},onScroll:Function,onScrollHeight:Function,onResize:Function},setup(e,{slots:t,emit:n}){const{proxy:{$q:l}}=Ce(),r=ne(null),o=ne(l.screen.height),i=ne(e.container===!0?0:l.screen.width),a=ne({position:0,direction:"down",inflectionPoint:0}),s=ne(0),c=ne(cn.value===!0?0:no()),u=w(()=>"q-layout q-layout--"+(e.container===!0?"containerized":"standard")),f=w(()=>e.container===!1?{minHeight:l.screen.height+"px"}:null),v=w(()=>c.value!==0?{[l.lang.rtl===!0?"left":"right"]:`${c.value}px`}:null),d=w(()=>c.value!==0?{[l.lang.rtl===!0?"right":"left"]:0,[l.lang.rtl===!0?"left":"right"]:`-${c.value}px`,width:`calc(100% + ${c.value}px)`}:null);function b(A){if(e.container===!0||document.qScrollPrevented!==!0){const I={position:A.position.top,direction:A.direction,directionChanged:A.directionChanged,inflectionPoint:A.inflectionPoint.top,delta:A.delta.top};a.value=I,e.onScroll!==void 0&&n("scroll",I)}}function C(A){const{height:I,width:S}=A;let N=!1;o.value!==I&&(N=!0,o.value=I,e.onScrollHeight!==void 0&&n("scrollHeight",I),F()),i.value!==S&&(N=!0,i.value=S),N===!0&&e.onResize!==void 0&&n("resize",A)}function E({height:A}){s.value!==A&&(s.value=A,F())}function F(){if(e.container===!0){const A=o.value>s.value?no():0;c.value!==A&&(c.value=A)}}let k=null;const m={instances:{},view:w(()=>e.view),isContainer:w(()=>e.container),rootRef:r,height:o,containerHeight:s,scrollbarWidth:c,totalWidth:w(()=>i.value+c.value),rows:w(()=>{const A=e.view.toLowerCase().split(" ");return{top:A[0].split(""),middle:A[1].split(""),bottom:A[2].split("")}}),header:an({size:0,offset:0,space:!1}),right:an({size:300,offset:0,space:!1}),footer:an({size:0,offset:0,space:!1}),left:an({size:300,offset:0,space:!1}),scroll:a,animate(){k!==null?clearTimeout(k):document.body.classList.add("q-body--layout-animate"),k=setTimeout(()=>{k=null,document.body.classList.remove("q-body--layout-animate")},155)},update(A,I,S){m[A][I]=S}};if(Io($o,m),no()>0){let S=function(){A=null,I.classList.remove("hide-scrollbar")},N=function(){if(A===null){if(I.scrollHeight>l.screen.height)return;I.classList.add("hide-scrollbar")}else clearTimeout(A);A=setTimeout(S,300)},U=function(x){A!==null&&x==="remove"&&(clearTimeout(A),S()),window[`${x}EventListener`]("resize",N)};var g=S,L=N,P=U;let A=null;const I=document.body;me(()=>e.container!==!0?"add":"remove",U),e.container!==!0&&U("add"),Pr(()=>{U("remove")})}return()=>{const A=Gt(t.default,[O(yb,{onScroll:b}),O(yo,{onResize:C})]),I=O("div",{class:u.value,style:f.value,ref:e.container===!0?void 0:r,tabindex:-1},A);return e.container===!0?O("div",{class:"q-layout-container overflow-hidden",ref:r},[O(yo,{onResize:E}),O("div",{class:"absolute-full",style:v.value},[O("div",{class:"scroll",style:d.value},[I])])]):I}}});function du(e){if(e===!1)return 0;if(e===!0||e===void 0)return 1;const t=parseInt(e,10);return isNaN(t)?0:t}const Ji=Na({name:"close-popup",beforeMount(e,{value:t}){const n={depth:du(t),handler(l){n.depth!==0&&setTimeout(()=>{const r=Sg(e);r!==void 0&&wg(r,l,n.depth)})},handlerKey(l){Tn(l,13)===!0&&n.handler(l)}};e.__qclosepopup=n,e.addEventListener("click",n.handler),e.addEventListener("keyup",n.handlerKey)},updated(e,{value:t,oldValue:n}){t!==n&&(e.__qclosepopup.depth=du(t))},beforeUnmount(e){const t=e.__qclosepopup;e.removeEventListener("click",t.handler),e.removeEventListener("keyup",t.handlerKey),delete e.__qclosepopup}});/*!
-  * shared v9.14.5
-  * (c) 2025 kazuya kawaguchi
-  * Released under the MIT License.
-  */function wb(e,t){typeof console<"u"&&(console.warn("[intlify] "+e),t&&console.warn(t.stack))}const So=typeof window<"u",Vn=(e,t=!1)=>t?Symbol.for(e):Symbol(e),Cb=(e,t,n)=>Eb({l:e,k:t,s:n}),Eb=e=>JSON.stringify(e).replace(/\u2028/g,"\\u2028").replace(/\u2029/g,"\\u2029").replace(/\u0027/g,"\\u0027"),st=e=>typeof e=="number"&&isFinite(e),Tb=e=>md(e)==="[object Date]",$n=e=>md(e)==="[object RegExp]",Do=e=>Te(e)&&Object.keys(e).length===0,wt=Object.assign,kb=Object.create,Be=(e=null)=>kb(e);let vu;const yn=()=>vu||(vu=typeof globalThis<"u"?globalThis:typeof self<"u"?self:typeof window<"u"?window:typeof global<"u"?global:Be());function mu(e){return e.replace(/&/g,"&amp;").replace(/</g,"&lt;").replace(/>/g,"&gt;").replace(/"/g,"&quot;").replace(/'/g,"&apos;").replace(/\//g,"&#x2F;").replace(/=/g,"&#x3D;")}function hu(e){return e.replace(/&(?![a-zA-Z0-9#]{2,6};)/g,"&amp;").replace(/"/g,"&quot;").replace(/'/g,"&apos;").replace(/</g,"&lt;").replace(/>/g,"&gt;")}function xb(e){return e=e.replace(/(\w+)\s*=\s*"([^"]*)"/g,(l,r,o)=>`${r}="${hu(o)}"`),e=e.replace(/(\w+)\s*=\s*'([^']*)'/g,(l,r,o)=>`${r}='${hu(o)}'`),/\s*on\w+\s*=\s*["']?[^"'>]+["']?/gi.test(e)&&(e=e.replace(/(\s+)(on)(\w+\s*=)/gi,"$1&#111;n$3")),[/(\s+(?:href|src|action|formaction)\s*=\s*["']?)\s*javascript:/gi,/(style\s*=\s*["'][^"']*url\s*\(\s*)javascript:/gi].forEach(l=>{e=e.replace(l,"$1javascript&#58;")}),e}const Lb=Object.prototype.hasOwnProperty;function Xt(e,t){return Lb.call(e,t)}const Qe=Array.isArray,ze=e=>typeof e=="function",ce=e=>typeof e=="string",Ie=e=>typeof e=="boolean",qe=e=>e!==null&&typeof e=="object",Pb=e=>qe(e)&&ze(e.then)&&ze(e.catch),vd=Object.prototype.toString,md=e=>vd.call(e),Te=e=>{if(!qe(e))return!1;const t=Object.getPrototypeOf(e);return t===null||t.constructor===Object},Ab=e=>e==null?"":Qe(e)||Te(e)&&e.toString===vd?JSON.stringify(e,null,2):String(e);function Ob(e,t=""){return e.reduce((n,l,r)=>r===0?n+l:n+t+l,"")}function Vo(e){let t=e;return()=>++t}const Wr=e=>!qe(e)||Qe(e);function lo(e,t){if(Wr(e)||Wr(t))throw new Error("Invalid value");const n=[{src:e,des:t}];for(;n.length;){const{src:l,des:r}=n.pop();Object.keys(l).forEach(o=>{o!=="__proto__"&&(qe(l[o])&&!qe(r[o])&&(r[o]=Array.isArray(l[o])?[]:Be()),Wr(r[o])||Wr(l[o])?r[o]=l[o]:n.push({src:l[o],des:r[o]}))})}}/*!
-  * message-compiler v9.14.5
-  * (c) 2025 kazuya kawaguchi
-  * Released under the MIT License.
-  */function Ib(e,t,n){return{line:e,column:t,offset:n}}function wo(e,t,n){const l={start:e,end:t};return n!=null&&(l.source=n),l}const Rb=/\{([0-9a-zA-Z]+)\}/g;function hd(e,...t){return t.length===1&&Nb(t[0])&&(t=t[0]),(!t||!t.hasOwnProperty)&&(t={}),e.replace(Rb,(n,l)=>t.hasOwnProperty(l)?t[l]:"")}const gd=Object.assign,gu=e=>typeof e=="string",Nb=e=>e!==null&&typeof e=="object";function bd(e,t=""){return e.reduce((n,l,r)=>r===0?n+l:n+t+l,"")}const Ba={USE_MODULO_SYNTAX:1,__EXTEND_POINT__:2},Mb={[Ba.USE_MODULO_SYNTAX]:"Use modulo before '{{0}}'."};function Fb(e,t,...n){const l=hd(Mb[e]||"",...n||[]),r={message:String(l),code:e};return t&&(r.location=t),r}const Se={EXPECTED_TOKEN:1,INVALID_TOKEN_IN_PLACEHOLDER:2,UNTERMINATED_SINGLE_QUOTE_IN_PLACEHOLDER:3,UNKNOWN_ESCAPE_SEQUENCE:4,INVALID_UNICODE_ESCAPE_SEQUENCE:5,UNBALANCED_CLOSING_BRACE:6,UNTERMINATED_CLOSING_BRACE:7,EMPTY_PLACEHOLDER:8,NOT_ALLOW_NEST_PLACEHOLDER:9,INVALID_LINKED_FORMAT:10,MUST_HAVE_MESSAGES_IN_PLURAL:11,UNEXPECTED_EMPTY_LINKED_MODIFIER:12,UNEXPECTED_EMPTY_LINKED_KEY:13,UNEXPECTED_LEXICAL_ANALYSIS:14,UNHANDLED_CODEGEN_NODE_TYPE:15,UNHANDLED_MINIFIER_NODE_TYPE:16,__EXTEND_POINT__:17},qb={[Se.EXPECTED_TOKEN]:"Expected token: '{0}'",[Se.INVALID_TOKEN_IN_PLACEHOLDER]:"Invalid token in placeholder: '{0}'",[Se.UNTERMINATED_SINGLE_QUOTE_IN_PLACEHOLDER]:"Unterminated single quote in placeholder",[Se.UNKNOWN_ESCAPE_SEQUENCE]:"Unknown escape sequence: \\{0}",[Se.INVALID_UNICODE_ESCAPE_SEQUENCE]:"Invalid unicode escape sequence: {0}",[Se.UNBALANCED_CLOSING_BRACE]:"Unbalanced closing brace",[Se.UNTERMINATED_CLOSING_BRACE]:"Unterminated closing brace",[Se.EMPTY_PLACEHOLDER]:"Empty placeholder",[Se.NOT_ALLOW_NEST_PLACEHOLDER]:"Not allowed nest placeholder",[Se.INVALID_LINKED_FORMAT]:"Invalid linked format",[Se.MUST_HAVE_MESSAGES_IN_PLURAL]:"Plural must have messages",[Se.UNEXPECTED_EMPTY_LINKED_MODIFIER]:"Unexpected empty linked modifier",[Se.UNEXPECTED_EMPTY_LINKED_KEY]:"Unexpected empty linked key",[Se.UNEXPECTED_LEXICAL_ANALYSIS]:"Unexpected lexical analysis in token: '{0}'",[Se.UNHANDLED_CODEGEN_NODE_TYPE]:"unhandled codegen node type: '{0}'",[Se.UNHANDLED_MINIFIER_NODE_TYPE]:"unhandled mimifier node type: '{0}'"};function Bl(e,t,n={}){const{domain:l,messages:r,args:o}=n,i=hd((r||qb)[e]||"",...o||[]),a=new SyntaxError(String(i));return a.code=e,t&&(a.location=t),a.domain=l,a}function $b(e){throw e}const mn=" ",Db="\r",Pt=`
-`,Vb=String.fromCharCode(8232),Bb=String.fromCharCode(8233);function Hb(e){const t=e;let n=0,l=1,r=1,o=0;const i=A=>t[A]===Db&&t[A+1]===Pt,a=A=>t[A]===Pt,s=A=>t[A]===Bb,c=A=>t[A]===Vb,u=A=>i(A)||a(A)||s(A)||c(A),f=()=>n,v=()=>l,d=()=>r,b=()=>o,C=A=>i(A)||s(A)||c(A)?Pt:t[A],E=()=>C(n),F=()=>C(n+o);function k(){return o=0,u(n)&&(l++,r=0),i(n)&&n++,n++,r++,t[n]}function m(){return i(n+o)&&o++,o++,t[n+o]}function g(){n=0,l=1,r=1,o=0}function L(A=0){o=A}function P(){const A=n+o;for(;A!==n;)k();o=0}return{index:f,line:v,column:d,peekOffset:b,charAt:C,currentChar:E,currentPeek:F,next:k,peek:m,reset:g,resetPeek:L,skipToPeek:P}}const Pn=void 0,Ub=".",bu="'",jb="tokenizer";function Wb(e,t={}){const n=t.location!==!1,l=Hb(e),r=()=>l.index(),o=()=>Ib(l.line(),l.column(),l.index()),i=o(),a=r(),s={currentType:14,offset:a,startLoc:i,endLoc:i,lastType:14,lastOffset:a,lastStartLoc:i,lastEndLoc:i,braceNest:0,inLinked:!1,text:""},c=()=>s,{onError:u}=t;function f(h,_,y,...V){const j=c();if(_.column+=y,_.offset+=y,u){const z=n?wo(j.startLoc,_):null,M=Bl(h,z,{domain:jb,args:V});u(M)}}function v(h,_,y){h.endLoc=o(),h.currentType=_;const V={type:_};return n&&(V.loc=wo(h.startLoc,h.endLoc)),y!=null&&(V.value=y),V}const d=h=>v(h,14);function b(h,_){return h.currentChar()===_?(h.next(),_):(f(Se.EXPECTED_TOKEN,o(),0,_),"")}function C(h){let _="";for(;h.currentPeek()===mn||h.currentPeek()===Pt;)_+=h.currentPeek(),h.peek();return _}function E(h){const _=C(h);return h.skipToPeek(),_}function F(h){if(h===Pn)return!1;const _=h.charCodeAt(0);return _>=97&&_<=122||_>=65&&_<=90||_===95}function k(h){if(h===Pn)return!1;const _=h.charCodeAt(0);return _>=48&&_<=57}function m(h,_){const{currentType:y}=_;if(y!==2)return!1;C(h);const V=F(h.currentPeek());return h.resetPeek(),V}function g(h,_){const{currentType:y}=_;if(y!==2)return!1;C(h);const V=h.currentPeek()==="-"?h.peek():h.currentPeek(),j=k(V);return h.resetPeek(),j}function L(h,_){const{currentType:y}=_;if(y!==2)return!1;C(h);const V=h.currentPeek()===bu;return h.resetPeek(),V}function P(h,_){const{currentType:y}=_;if(y!==8)return!1;C(h);const V=h.currentPeek()===".";return h.resetPeek(),V}function A(h,_){const{currentType:y}=_;if(y!==9)return!1;C(h);const V=F(h.currentPeek());return h.resetPeek(),V}function I(h,_){const{currentType:y}=_;if(!(y===8||y===12))return!1;C(h);const V=h.currentPeek()===":";return h.resetPeek(),V}function S(h,_){const{currentType:y}=_;if(y!==10)return!1;const V=()=>{const z=h.currentPeek();return z==="{"?F(h.peek()):z==="@"||z==="%"||z==="|"||z===":"||z==="."||z===mn||!z?!1:z===Pt?(h.peek(),V()):x(h,!1)},j=V();return h.resetPeek(),j}function N(h){C(h);const _=h.currentPeek()==="|";return h.resetPeek(),_}function U(h){const _=C(h),y=h.currentPeek()==="%"&&h.peek()==="{";return h.resetPeek(),{isModulo:y,hasSpace:_.length>0}}function x(h,_=!0){const y=(j=!1,z="",M=!1)=>{const H=h.currentPeek();return H==="{"?z==="%"?!1:j:H==="@"||!H?z==="%"?!0:j:H==="%"?(h.peek(),y(j,"%",!0)):H==="|"?z==="%"||M?!0:!(z===mn||z===Pt):H===mn?(h.peek(),y(!0,mn,M)):H===Pt?(h.peek(),y(!0,Pt,M)):!0},V=y();return _&&h.resetPeek(),V}function Q(h,_){const y=h.currentChar();return y===Pn?Pn:_(y)?(h.next(),y):null}function B(h){const _=h.charCodeAt(0);return _>=97&&_<=122||_>=65&&_<=90||_>=48&&_<=57||_===95||_===36}function te(h){return Q(h,B)}function J(h){const _=h.charCodeAt(0);return _>=97&&_<=122||_>=65&&_<=90||_>=48&&_<=57||_===95||_===36||_===45}function ee(h){return Q(h,J)}function Y(h){const _=h.charCodeAt(0);return _>=48&&_<=57}function se(h){return Q(h,Y)}function he(h){const _=h.charCodeAt(0);return _>=48&&_<=57||_>=65&&_<=70||_>=97&&_<=102}function $(h){return Q(h,he)}function q(h){let _="",y="";for(;_=se(h);)y+=_;return y}function ae(h){E(h);const _=h.currentChar();return _!=="%"&&f(Se.EXPECTED_TOKEN,o(),0,_),h.next(),"%"}function ge(h){let _="";for(;;){const y=h.currentChar();if(y==="{"||y==="}"||y==="@"||y==="|"||!y)break;if(y==="%")if(x(h))_+=y,h.next();else break;else if(y===mn||y===Pt)if(x(h))_+=y,h.next();else{if(N(h))break;_+=y,h.next()}else _+=y,h.next()}return _}function ye(h){E(h);let _="",y="";for(;_=ee(h);)y+=_;return h.currentChar()===Pn&&f(Se.UNTERMINATED_CLOSING_BRACE,o(),0),y}function Z(h){E(h);let _="";return h.currentChar()==="-"?(h.next(),_+=`-${q(h)}`):_+=q(h),h.currentChar()===Pn&&f(Se.UNTERMINATED_CLOSING_BRACE,o(),0),_}function ie(h){return h!==bu&&h!==Pt}function xe(h){E(h),b(h,"'");let _="",y="";for(;_=Q(h,ie);)_==="\\"?y+=le(h):y+=_;const V=h.currentChar();return V===Pt||V===Pn?(f(Se.UNTERMINATED_SINGLE_QUOTE_IN_PLACEHOLDER,o(),0),V===Pt&&(h.next(),b(h,"'")),y):(b(h,"'"),y)}function le(h){const _=h.currentChar();switch(_){case"\\":case"'":return h.next(),`\\${_}`;case"u":return Pe(h,_,4);case"U":return Pe(h,_,6);default:return f(Se.UNKNOWN_ESCAPE_SEQUENCE,o(),0,_),""}}function Pe(h,_,y){b(h,_);let V="";for(let j=0;j<y;j++){const z=$(h);if(!z){f(Se.INVALID_UNICODE_ESCAPE_SEQUENCE,o(),0,`\\${_}${V}${h.currentChar()}`);break}V+=z}return`\\${_}${V}`}function $e(h){return h!=="{"&&h!=="}"&&h!==mn&&h!==Pt}function ut(h){E(h);let _="",y="";for(;_=Q(h,$e);)y+=_;return y}function T(h){let _="",y="";for(;_=te(h);)y+=_;return y}function p(h){const _=y=>{const V=h.currentChar();return V==="{"||V==="%"||V==="@"||V==="|"||V==="("||V===")"||!V||V===mn?y:(y+=V,h.next(),_(y))};return _("")}function D(h){E(h);const _=b(h,"|");return E(h),_}function G(h,_){let y=null;switch(h.currentChar()){case"{":return _.braceNest>=1&&f(Se.NOT_ALLOW_NEST_PLACEHOLDER,o(),0),h.next(),y=v(_,2,"{"),E(h),_.braceNest++,y;case"}":return _.braceNest>0&&_.currentType===2&&f(Se.EMPTY_PLACEHOLDER,o(),0),h.next(),y=v(_,3,"}"),_.braceNest--,_.braceNest>0&&E(h),_.inLinked&&_.braceNest===0&&(_.inLinked=!1),y;case"@":return _.braceNest>0&&f(Se.UNTERMINATED_CLOSING_BRACE,o(),0),y=K(h,_)||d(_),_.braceNest=0,y;default:{let j=!0,z=!0,M=!0;if(N(h))return _.braceNest>0&&f(Se.UNTERMINATED_CLOSING_BRACE,o(),0),y=v(_,1,D(h)),_.braceNest=0,_.inLinked=!1,y;if(_.braceNest>0&&(_.currentType===5||_.currentType===6||_.currentType===7))return f(Se.UNTERMINATED_CLOSING_BRACE,o(),0),_.braceNest=0,X(h,_);if(j=m(h,_))return y=v(_,5,ye(h)),E(h),y;if(z=g(h,_))return y=v(_,6,Z(h)),E(h),y;if(M=L(h,_))return y=v(_,7,xe(h)),E(h),y;if(!j&&!z&&!M)return y=v(_,13,ut(h)),f(Se.INVALID_TOKEN_IN_PLACEHOLDER,o(),0,y.value),E(h),y;break}}return y}function K(h,_){const{currentType:y}=_;let V=null;const j=h.currentChar();switch((y===8||y===9||y===12||y===10)&&(j===Pt||j===mn)&&f(Se.INVALID_LINKED_FORMAT,o(),0),j){case"@":return h.next(),V=v(_,8,"@"),_.inLinked=!0,V;case".":return E(h),h.next(),v(_,9,".");case":":return E(h),h.next(),v(_,10,":");default:return N(h)?(V=v(_,1,D(h)),_.braceNest=0,_.inLinked=!1,V):P(h,_)||I(h,_)?(E(h),K(h,_)):A(h,_)?(E(h),v(_,12,T(h))):S(h,_)?(E(h),j==="{"?G(h,_)||V:v(_,11,p(h))):(y===8&&f(Se.INVALID_LINKED_FORMAT,o(),0),_.braceNest=0,_.inLinked=!1,X(h,_))}}function X(h,_){let y={type:14};if(_.braceNest>0)return G(h,_)||d(_);if(_.inLinked)return K(h,_)||d(_);switch(h.currentChar()){case"{":return G(h,_)||d(_);case"}":return f(Se.UNBALANCED_CLOSING_BRACE,o(),0),h.next(),v(_,3,"}");case"@":return K(h,_)||d(_);default:{if(N(h))return y=v(_,1,D(h)),_.braceNest=0,_.inLinked=!1,y;const{isModulo:j,hasSpace:z}=U(h);if(j)return z?v(_,0,ge(h)):v(_,4,ae(h));if(x(h))return v(_,0,ge(h));break}}return y}function oe(){const{currentType:h,offset:_,startLoc:y,endLoc:V}=s;return s.lastType=h,s.lastOffset=_,s.lastStartLoc=y,s.lastEndLoc=V,s.offset=r(),s.startLoc=o(),l.currentChar()===Pn?v(s,14):X(l,s)}return{nextToken:oe,currentOffset:r,currentPosition:o,context:c}}const zb="parser",Kb=/(?:\\\\|\\'|\\u([0-9a-fA-F]{4})|\\U([0-9a-fA-F]{6}))/g;function Yb(e,t,n){switch(e){case"\\\\":return"\\";case"\\'":return"'";default:{const l=parseInt(t||n,16);return l<=55295||l>=57344?String.fromCodePoint(l):"�"}}}function Qb(e={}){const t=e.location!==!1,{onError:n,onWarn:l}=e;function r(m,g,L,P,...A){const I=m.currentPosition();if(I.offset+=P,I.column+=P,n){const S=t?wo(L,I):null,N=Bl(g,S,{domain:zb,args:A});n(N)}}function o(m,g,L,P,...A){const I=m.currentPosition();if(I.offset+=P,I.column+=P,l){const S=t?wo(L,I):null;l(Fb(g,S,A))}}function i(m,g,L){const P={type:m};return t&&(P.start=g,P.end=g,P.loc={start:L,end:L}),P}function a(m,g,L,P){P&&(m.type=P),t&&(m.end=g,m.loc&&(m.loc.end=L))}function s(m,g){const L=m.context(),P=i(3,L.offset,L.startLoc);return P.value=g,a(P,m.currentOffset(),m.currentPosition()),P}function c(m,g){const L=m.context(),{lastOffset:P,lastStartLoc:A}=L,I=i(5,P,A);return I.index=parseInt(g,10),m.nextToken(),a(I,m.currentOffset(),m.currentPosition()),I}function u(m,g,L){const P=m.context(),{lastOffset:A,lastStartLoc:I}=P,S=i(4,A,I);return S.key=g,L===!0&&(S.modulo=!0),m.nextToken(),a(S,m.currentOffset(),m.currentPosition()),S}function f(m,g){const L=m.context(),{lastOffset:P,lastStartLoc:A}=L,I=i(9,P,A);return I.value=g.replace(Kb,Yb),m.nextToken(),a(I,m.currentOffset(),m.currentPosition()),I}function v(m){const g=m.nextToken(),L=m.context(),{lastOffset:P,lastStartLoc:A}=L,I=i(8,P,A);return g.type!==12?(r(m,Se.UNEXPECTED_EMPTY_LINKED_MODIFIER,L.lastStartLoc,0),I.value="",a(I,P,A),{nextConsumeToken:g,node:I}):(g.value==null&&r(m,Se.UNEXPECTED_LEXICAL_ANALYSIS,L.lastStartLoc,0,Yt(g)),I.value=g.value||"",a(I,m.currentOffset(),m.currentPosition()),{node:I})}function d(m,g){const L=m.context(),P=i(7,L.offset,L.startLoc);return P.value=g,a(P,m.currentOffset(),m.currentPosition()),P}function b(m){const g=m.context(),L=i(6,g.offset,g.startLoc);let P=m.nextToken();if(P.type===9){const A=v(m);L.modifier=A.node,P=A.nextConsumeToken||m.nextToken()}switch(P.type!==10&&r(m,Se.UNEXPECTED_LEXICAL_ANALYSIS,g.lastStartLoc,0,Yt(P)),P=m.nextToken(),P.type===2&&(P=m.nextToken()),P.type){case 11:P.value==null&&r(m,Se.UNEXPECTED_LEXICAL_ANALYSIS,g.lastStartLoc,0,Yt(P)),L.key=d(m,P.value||"");break;case 5:P.value==null&&r(m,Se.UNEXPECTED_LEXICAL_ANALYSIS,g.lastStartLoc,0,Yt(P)),L.key=u(m,P.value||"");break;case 6:P.value==null&&r(m,Se.UNEXPECTED_LEXICAL_ANALYSIS,g.lastStartLoc,0,Yt(P)),L.key=c(m,P.value||"");break;case 7:P.value==null&&r(m,Se.UNEXPECTED_LEXICAL_ANALYSIS,g.lastStartLoc,0,Yt(P)),L.key=f(m,P.value||"");break;default:{r(m,Se.UNEXPECTED_EMPTY_LINKED_KEY,g.lastStartLoc,0);const A=m.context(),I=i(7,A.offset,A.startLoc);return I.value="",a(I,A.offset,A.startLoc),L.key=I,a(L,A.offset,A.startLoc),{nextConsumeToken:P,node:L}}}return a(L,m.currentOffset(),m.currentPosition()),{node:L}}function C(m){const g=m.context(),L=g.currentType===1?m.currentOffset():g.offset,P=g.currentType===1?g.endLoc:g.startLoc,A=i(2,L,P);A.items=[];let I=null,S=null;do{const x=I||m.nextToken();switch(I=null,x.type){case 0:x.value==null&&r(m,Se.UNEXPECTED_LEXICAL_ANALYSIS,g.lastStartLoc,0,Yt(x)),A.items.push(s(m,x.value||""));break;case 6:x.value==null&&r(m,Se.UNEXPECTED_LEXICAL_ANALYSIS,g.lastStartLoc,0,Yt(x)),A.items.push(c(m,x.value||""));break;case 4:S=!0;break;case 5:x.value==null&&r(m,Se.UNEXPECTED_LEXICAL_ANALYSIS,g.lastStartLoc,0,Yt(x)),A.items.push(u(m,x.value||"",!!S)),S&&(o(m,Ba.USE_MODULO_SYNTAX,g.lastStartLoc,0,Yt(x)),S=null);break;case 7:x.value==null&&r(m,Se.UNEXPECTED_LEXICAL_ANALYSIS,g.lastStartLoc,0,Yt(x)),A.items.push(f(m,x.value||""));break;case 8:{const Q=b(m);A.items.push(Q.node),I=Q.nextConsumeToken||null;break}}}while(g.currentType!==14&&g.currentType!==1);const N=g.currentType===1?g.lastOffset:m.currentOffset(),U=g.currentType===1?g.lastEndLoc:m.currentPosition();return a(A,N,U),A}function E(m,g,L,P){const A=m.context();let I=P.items.length===0;const S=i(1,g,L);S.cases=[],S.cases.push(P);do{const N=C(m);I||(I=N.items.length===0),S.cases.push(N)}while(A.currentType!==14);return I&&r(m,Se.MUST_HAVE_MESSAGES_IN_PLURAL,L,0),a(S,m.currentOffset(),m.currentPosition()),S}function F(m){const g=m.context(),{offset:L,startLoc:P}=g,A=C(m);return g.currentType===14?A:E(m,L,P,A)}function k(m){const g=Wb(m,gd({},e)),L=g.context(),P=i(0,L.offset,L.startLoc);return t&&P.loc&&(P.loc.source=m),P.body=F(g),e.onCacheKey&&(P.cacheKey=e.onCacheKey(m)),L.currentType!==14&&r(g,Se.UNEXPECTED_LEXICAL_ANALYSIS,L.lastStartLoc,0,m[L.offset]||""),a(P,g.currentOffset(),g.currentPosition()),P}return{parse:k}}function Yt(e){if(e.type===14)return"EOF";const t=(e.value||"").replace(/\r?\n/gu,"\\n");return t.length>10?t.slice(0,9)+"…":t}function Gb(e,t={}){const n={ast:e,helpers:new Set};return{context:()=>n,helper:o=>(n.helpers.add(o),o)}}function _u(e,t){for(let n=0;n<e.length;n++)Ha(e[n],t)}function Ha(e,t){switch(e.type){case 1:_u(e.cases,t),t.helper("plural");break;case 2:_u(e.items,t);break;case 6:{Ha(e.key,t),t.helper("linked"),t.helper("type");break}case 5:t.helper("interpolate"),t.helper("list");break;case 4:t.helper("interpolate"),t.helper("named");break}}function Xb(e,t={}){const n=Gb(e);n.helper("normalize"),e.body&&Ha(e.body,n);const l=n.context();e.helpers=Array.from(l.helpers)}function Jb(e){const t=e.body;return t.type===2?pu(t):t.cases.forEach(n=>pu(n)),e}function pu(e){if(e.items.length===1){const t=e.items[0];(t.type===3||t.type===9)&&(e.static=t.value,delete t.value)}else{const t=[];for(let n=0;n<e.items.length;n++){const l=e.items[n];if(!(l.type===3||l.type===9)||l.value==null)break;t.push(l.value)}if(t.length===e.items.length){e.static=bd(t);for(let n=0;n<e.items.length;n++){const l=e.items[n];(l.type===3||l.type===9)&&delete l.value}}}}const Zb="minifier";function El(e){switch(e.t=e.type,e.type){case 0:{const t=e;El(t.body),t.b=t.body,delete t.body;break}case 1:{const t=e,n=t.cases;for(let l=0;l<n.length;l++)El(n[l]);t.c=n,delete t.cases;break}case 2:{const t=e,n=t.items;for(let l=0;l<n.length;l++)El(n[l]);t.i=n,delete t.items,t.static&&(t.s=t.static,delete t.static);break}case 3:case 9:case 8:case 7:{const t=e;t.value&&(t.v=t.value,delete t.value);break}case 6:{const t=e;El(t.key),t.k=t.key,delete t.key,t.modifier&&(El(t.modifier),t.m=t.modifier,delete t.modifier);break}case 5:{const t=e;t.i=t.index,delete t.index;break}case 4:{const t=e;t.k=t.key,delete t.key;break}default:throw Bl(Se.UNHANDLED_MINIFIER_NODE_TYPE,null,{domain:Zb,args:[e.type]})}delete e.type}const e_="parser";function t_(e,t){const{sourceMap:n,filename:l,breakLineCode:r,needIndent:o}=t,i=t.location!==!1,a={filename:l,code:"",column:1,line:1,offset:0,map:void 0,breakLineCode:r,needIndent:o,indentLevel:0};i&&e.loc&&(a.source=e.loc.source);const s=()=>a;function c(E,F){a.code+=E}function u(E,F=!0){const k=F?r:"";c(o?k+"  ".repeat(E):k)}function f(E=!0){const F=++a.indentLevel;E&&u(F)}function v(E=!0){const F=--a.indentLevel;E&&u(F)}function d(){u(a.indentLevel)}return{context:s,push:c,indent:f,deindent:v,newline:d,helper:E=>`_${E}`,needIndent:()=>a.needIndent}}function n_(e,t){const{helper:n}=e;e.push(`${n("linked")}(`),Dl(e,t.key),t.modifier?(e.push(", "),Dl(e,t.modifier),e.push(", _type")):e.push(", undefined, _type"),e.push(")")}function l_(e,t){const{helper:n,needIndent:l}=e;e.push(`${n("normalize")}([`),e.indent(l());const r=t.items.length;for(let o=0;o<r&&(Dl(e,t.items[o]),o!==r-1);o++)e.push(", ");e.deindent(l()),e.push("])")}function r_(e,t){const{helper:n,needIndent:l}=e;if(t.cases.length>1){e.push(`${n("plural")}([`),e.indent(l());const r=t.cases.length;for(let o=0;o<r&&(Dl(e,t.cases[o]),o!==r-1);o++)e.push(", ");e.deindent(l()),e.push("])")}}function o_(e,t){t.body?Dl(e,t.body):e.push("null")}function Dl(e,t){const{helper:n}=e;switch(t.type){case 0:o_(e,t);break;case 1:r_(e,t);break;case 2:l_(e,t);break;case 6:n_(e,t);break;case 8:e.push(JSON.stringify(t.value),t);break;case 7:e.push(JSON.stringify(t.value),t);break;case 5:e.push(`${n("interpolate")}(${n("list")}(${t.index}))`,t);break;case 4:e.push(`${n("interpolate")}(${n("named")}(${JSON.stringify(t.key)}))`,t);break;case 9:e.push(JSON.stringify(t.value),t);break;case 3:e.push(JSON.stringify(t.value),t);break;default:throw Bl(Se.UNHANDLED_CODEGEN_NODE_TYPE,null,{domain:e_,args:[t.type]})}}const i_=(e,t={})=>{const n=gu(t.mode)?t.mode:"normal",l=gu(t.filename)?t.filename:"message.intl",r=!!t.sourceMap,o=t.breakLineCode!=null?t.breakLineCode:n==="arrow"?";":`
-`,i=t.needIndent?t.needIndent:n!=="arrow",a=e.helpers||[],s=t_(e,{mode:n,filename:l,sourceMap:r,breakLineCode:o,needIndent:i});s.push(n==="normal"?"function __msg__ (ctx) {":"(ctx) => {"),s.indent(i),a.length>0&&(s.push(`const { ${bd(a.map(f=>`${f}: _${f}`),", ")} } = ctx`),s.newline()),s.push("return "),Dl(s,e),s.deindent(i),s.push("}"),delete e.helpers;const{code:c,map:u}=s.context();return{ast:e,code:c,map:u?u.toJSON():void 0}};function a_(e,t={}){const n=gd({},t),l=!!n.jit,r=!!n.minify,o=n.optimize==null?!0:n.optimize,a=Qb(n).parse(e);return l?(o&&Jb(a),r&&El(a),{ast:a,code:""}):(Xb(a,n),i_(a,n))}/*!
-  * core-base v9.14.5
-  * (c) 2025 kazuya kawaguchi
-  * Released under the MIT License.
-  */function s_(){typeof __INTLIFY_PROD_DEVTOOLS__!="boolean"&&(yn().__INTLIFY_PROD_DEVTOOLS__=!1),typeof __INTLIFY_JIT_COMPILATION__!="boolean"&&(yn().__INTLIFY_JIT_COMPILATION__=!1),typeof __INTLIFY_DROP_MESSAGE_COMPILER__!="boolean"&&(yn().__INTLIFY_DROP_MESSAGE_COMPILER__=!1)}function un(e){return qe(e)&&Ua(e)===0&&(Xt(e,"b")||Xt(e,"body"))}const _d=["b","body"];function u_(e){return Bn(e,_d)}const pd=["c","cases"];function c_(e){return Bn(e,pd,[])}const yd=["s","static"];function f_(e){return Bn(e,yd)}const Sd=["i","items"];function d_(e){return Bn(e,Sd,[])}const wd=["t","type"];function Ua(e){return Bn(e,wd)}const Cd=["v","value"];function zr(e,t){const n=Bn(e,Cd);if(n!=null)return n;throw pr(t)}const Ed=["m","modifier"];function v_(e){return Bn(e,Ed)}const Td=["k","key"];function m_(e){const t=Bn(e,Td);if(t)return t;throw pr(6)}function Bn(e,t,n){for(let l=0;l<t.length;l++){const r=t[l];if(Xt(e,r)&&e[r]!=null)return e[r]}return n}const kd=[..._d,...pd,...yd,...Sd,...Td,...Ed,...Cd,...wd];function pr(e){return new Error(`unhandled node type: ${e}`)}const Hn=[];Hn[0]={w:[0],i:[3,0],"[":[4],o:[7]};Hn[1]={w:[1],".":[2],"[":[4],o:[7]};Hn[2]={w:[2],i:[3,0],0:[3,0]};Hn[3]={i:[3,0],0:[3,0],w:[1,1],".":[2,1],"[":[4,1],o:[7,1]};Hn[4]={"'":[5,0],'"':[6,0],"[":[4,2],"]":[1,3],o:8,l:[4,0]};Hn[5]={"'":[4,0],o:8,l:[5,0]};Hn[6]={'"':[4,0],o:8,l:[6,0]};const h_=/^\s?(?:true|false|-?[\d.]+|'[^']*'|"[^"]*")\s?$/;function g_(e){return h_.test(e)}function b_(e){const t=e.charCodeAt(0),n=e.charCodeAt(e.length-1);return t===n&&(t===34||t===39)?e.slice(1,-1):e}function __(e){if(e==null)return"o";switch(e.charCodeAt(0)){case 91:case 93:case 46:case 34:case 39:return e;case 95:case 36:case 45:return"i";case 9:case 10:case 13:case 160:case 65279:case 8232:case 8233:return"w"}return"i"}function p_(e){const t=e.trim();return e.charAt(0)==="0"&&isNaN(parseInt(e))?!1:g_(t)?b_(t):"*"+t}function y_(e){const t=[];let n=-1,l=0,r=0,o,i,a,s,c,u,f;const v=[];v[0]=()=>{i===void 0?i=a:i+=a},v[1]=()=>{i!==void 0&&(t.push(i),i=void 0)},v[2]=()=>{v[0](),r++},v[3]=()=>{if(r>0)r--,l=4,v[0]();else{if(r=0,i===void 0||(i=p_(i),i===!1))return!1;v[1]()}};function d(){const b=e[n+1];if(l===5&&b==="'"||l===6&&b==='"')return n++,a="\\"+b,v[0](),!0}for(;l!==null;)if(n++,o=e[n],!(o==="\\"&&d())){if(s=__(o),f=Hn[l],c=f[s]||f.l||8,c===8||(l=c[0],c[1]!==void 0&&(u=v[c[1]],u&&(a=o,u()===!1))))return;if(l===7)return t}}const yu=new Map;function S_(e,t){return qe(e)?e[t]:null}function w_(e,t){if(!qe(e))return null;let n=yu.get(t);if(n||(n=y_(t),n&&yu.set(t,n)),!n)return null;const l=n.length;let r=e,o=0;for(;o<l;){const i=n[o];if(kd.includes(i)&&un(r))return null;const a=r[i];if(a===void 0||ze(r))return null;r=a,o++}return r}const C_=e=>e,E_=e=>"",T_="text",k_=e=>e.length===0?"":Ob(e),x_=Ab;function Su(e,t){return e=Math.abs(e),t===2?e?e>1?1:0:1:e?Math.min(e,2):0}function L_(e){const t=st(e.pluralIndex)?e.pluralIndex:-1;return e.named&&(st(e.named.count)||st(e.named.n))?st(e.named.count)?e.named.count:st(e.named.n)?e.named.n:t:t}function P_(e,t){t.count||(t.count=e),t.n||(t.n=e)}function A_(e={}){const t=e.locale,n=L_(e),l=qe(e.pluralRules)&&ce(t)&&ze(e.pluralRules[t])?e.pluralRules[t]:Su,r=qe(e.pluralRules)&&ce(t)&&ze(e.pluralRules[t])?Su:void 0,o=F=>F[l(n,F.length,r)],i=e.list||[],a=F=>i[F],s=e.named||Be();st(e.pluralIndex)&&P_(n,s);const c=F=>s[F];function u(F){const k=ze(e.messages)?e.messages(F):qe(e.messages)?e.messages[F]:!1;return k||(e.parent?e.parent.message(F):E_)}const f=F=>e.modifiers?e.modifiers[F]:C_,v=Te(e.processor)&&ze(e.processor.normalize)?e.processor.normalize:k_,d=Te(e.processor)&&ze(e.processor.interpolate)?e.processor.interpolate:x_,b=Te(e.processor)&&ce(e.processor.type)?e.processor.type:T_,E={list:a,named:c,plural:o,linked:(F,...k)=>{const[m,g]=k;let L="text",P="";k.length===1?qe(m)?(P=m.modifier||P,L=m.type||L):ce(m)&&(P=m||P):k.length===2&&(ce(m)&&(P=m||P),ce(g)&&(L=g||L));const A=u(F)(E),I=L==="vnode"&&Qe(A)&&P?A[0]:A;return P?f(P)(I,L):I},message:u,type:b,interpolate:d,normalize:v,values:wt(Be(),i,s)};return E}let yr=null;function O_(e){yr=e}function I_(e,t,n){yr&&yr.emit("i18n:init",{timestamp:Date.now(),i18n:e,version:t,meta:n})}const R_=N_("function:translate");function N_(e){return t=>yr&&yr.emit(e,t)}const xd=Ba.__EXTEND_POINT__,Qn=Vo(xd),M_={NOT_FOUND_KEY:xd,FALLBACK_TO_TRANSLATE:Qn(),CANNOT_FORMAT_NUMBER:Qn(),FALLBACK_TO_NUMBER_FORMAT:Qn(),CANNOT_FORMAT_DATE:Qn(),FALLBACK_TO_DATE_FORMAT:Qn(),EXPERIMENTAL_CUSTOM_MESSAGE_COMPILER:Qn(),__EXTEND_POINT__:Qn()},Ld=Se.__EXTEND_POINT__,Gn=Vo(Ld),Jt={INVALID_ARGUMENT:Ld,INVALID_DATE_ARGUMENT:Gn(),INVALID_ISO_DATE_ARGUMENT:Gn(),NOT_SUPPORT_NON_STRING_MESSAGE:Gn(),NOT_SUPPORT_LOCALE_PROMISE_VALUE:Gn(),NOT_SUPPORT_LOCALE_ASYNC_FUNCTION:Gn(),NOT_SUPPORT_LOCALE_TYPE:Gn(),__EXTEND_POINT__:Gn()};function sn(e){return Bl(e,null,void 0)}function ja(e,t){return t.locale!=null?wu(t.locale):wu(e.locale)}let hi;function wu(e){if(ce(e))return e;if(ze(e)){if(e.resolvedOnce&&hi!=null)return hi;if(e.constructor.name==="Function"){const t=e();if(Pb(t))throw sn(Jt.NOT_SUPPORT_LOCALE_PROMISE_VALUE);return hi=t}else throw sn(Jt.NOT_SUPPORT_LOCALE_ASYNC_FUNCTION)}else throw sn(Jt.NOT_SUPPORT_LOCALE_TYPE)}function F_(e,t,n){return[...new Set([n,...Qe(t)?t:qe(t)?Object.keys(t):ce(t)?[t]:[n]])]}function Pd(e,t,n){const l=ce(n)?n:Vl,r=e;r.__localeChainCache||(r.__localeChainCache=new Map);let o=r.__localeChainCache.get(l);if(!o){o=[];let i=[n];for(;Qe(i);)i=Cu(o,i,t);const a=Qe(t)||!Te(t)?t:t.default?t.default:null;i=ce(a)?[a]:a,Qe(i)&&Cu(o,i,!1),r.__localeChainCache.set(l,o)}return o}function Cu(e,t,n){let l=!0;for(let r=0;r<t.length&&Ie(l);r++){const o=t[r];ce(o)&&(l=q_(e,t[r],n))}return l}function q_(e,t,n){let l;const r=t.split("-");do{const o=r.join("-");l=$_(e,o,n),r.splice(-1,1)}while(r.length&&l===!0);return l}function $_(e,t,n){let l=!1;if(!e.includes(t)&&(l=!0,t)){l=t[t.length-1]!=="!";const r=t.replace(/!/g,"");e.push(r),(Qe(n)||Te(n))&&n[r]&&(l=n[r])}return l}const D_="9.14.5",Bo=-1,Vl="en-US",Eu="",Tu=e=>`${e.charAt(0).toLocaleUpperCase()}${e.substr(1)}`;function V_(){return{upper:(e,t)=>t==="text"&&ce(e)?e.toUpperCase():t==="vnode"&&qe(e)&&"__v_isVNode"in e?e.children.toUpperCase():e,lower:(e,t)=>t==="text"&&ce(e)?e.toLowerCase():t==="vnode"&&qe(e)&&"__v_isVNode"in e?e.children.toLowerCase():e,capitalize:(e,t)=>t==="text"&&ce(e)?Tu(e):t==="vnode"&&qe(e)&&"__v_isVNode"in e?Tu(e.children):e}}let Ad;function ku(e){Ad=e}let Od;function B_(e){Od=e}let Id;function H_(e){Id=e}let Rd=null;const U_=e=>{Rd=e},j_=()=>Rd;let Nd=null;const xu=e=>{Nd=e},W_=()=>Nd;let Lu=0;function z_(e={}){const t=ze(e.onWarn)?e.onWarn:wb,n=ce(e.version)?e.version:D_,l=ce(e.locale)||ze(e.locale)?e.locale:Vl,r=ze(l)?Vl:l,o=Qe(e.fallbackLocale)||Te(e.fallbackLocale)||ce(e.fallbackLocale)||e.fallbackLocale===!1?e.fallbackLocale:r,i=Te(e.messages)?e.messages:gi(r),a=Te(e.datetimeFormats)?e.datetimeFormats:gi(r),s=Te(e.numberFormats)?e.numberFormats:gi(r),c=wt(Be(),e.modifiers,V_()),u=e.pluralRules||Be(),f=ze(e.missing)?e.missing:null,v=Ie(e.missingWarn)||$n(e.missingWarn)?e.missingWarn:!0,d=Ie(e.fallbackWarn)||$n(e.fallbackWarn)?e.fallbackWarn:!0,b=!!e.fallbackFormat,C=!!e.unresolving,E=ze(e.postTranslation)?e.postTranslation:null,F=Te(e.processor)?e.processor:null,k=Ie(e.warnHtmlMessage)?e.warnHtmlMessage:!0,m=!!e.escapeParameter,g=ze(e.messageCompiler)?e.messageCompiler:Ad,L=ze(e.messageResolver)?e.messageResolver:Od||S_,P=ze(e.localeFallbacker)?e.localeFallbacker:Id||F_,A=qe(e.fallbackContext)?e.fallbackContext:void 0,I=e,S=qe(I.__datetimeFormatters)?I.__datetimeFormatters:new Map,N=qe(I.__numberFormatters)?I.__numberFormatters:new Map,U=qe(I.__meta)?I.__meta:{};Lu++;const x={version:n,cid:Lu,locale:l,fallbackLocale:o,messages:i,modifiers:c,pluralRules:u,missing:f,missingWarn:v,fallbackWarn:d,fallbackFormat:b,unresolving:C,postTranslation:E,processor:F,warnHtmlMessage:k,escapeParameter:m,messageCompiler:g,messageResolver:L,localeFallbacker:P,fallbackContext:A,onWarn:t,__meta:U};return x.datetimeFormats=a,x.numberFormats=s,x.__datetimeFormatters=S,x.__numberFormatters=N,__INTLIFY_PROD_DEVTOOLS__&&I_(x,n,U),x}const gi=e=>({[e]:Be()});function Wa(e,t,n,l,r){const{missing:o,onWarn:i}=e;if(o!==null){const a=o(e,n,t,r);return ce(a)?a:t}else return t}function jl(e,t,n){const l=e;l.__localeChainCache=new Map,e.localeFallbacker(e,n,t)}function K_(e,t){return e===t?!1:e.split("-")[0]===t.split("-")[0]}function Y_(e,t){const n=t.indexOf(e);if(n===-1)return!1;for(let l=n+1;l<t.length;l++)if(K_(e,t[l]))return!0;return!1}function bi(e){return n=>Q_(n,e)}function Q_(e,t){const n=u_(t);if(n==null)throw pr(0);if(Ua(n)===1){const o=c_(n);return e.plural(o.reduce((i,a)=>[...i,Pu(e,a)],[]))}else return Pu(e,n)}function Pu(e,t){const n=f_(t);if(n!=null)return e.type==="text"?n:e.normalize([n]);{const l=d_(t).reduce((r,o)=>[...r,Zi(e,o)],[]);return e.normalize(l)}}function Zi(e,t){const n=Ua(t);switch(n){case 3:return zr(t,n);case 9:return zr(t,n);case 4:{const l=t;if(Xt(l,"k")&&l.k)return e.interpolate(e.named(l.k));if(Xt(l,"key")&&l.key)return e.interpolate(e.named(l.key));throw pr(n)}case 5:{const l=t;if(Xt(l,"i")&&st(l.i))return e.interpolate(e.list(l.i));if(Xt(l,"index")&&st(l.index))return e.interpolate(e.list(l.index));throw pr(n)}case 6:{const l=t,r=v_(l),o=m_(l);return e.linked(Zi(e,o),r?Zi(e,r):void 0,e.type)}case 7:return zr(t,n);case 8:return zr(t,n);default:throw new Error(`unhandled node on format message part: ${n}`)}}const Md=e=>e;let kl=Be();function Fd(e,t={}){let n=!1;const l=t.onError||$b;return t.onError=r=>{n=!0,l(r)},{...a_(e,t),detectError:n}}const G_=(e,t)=>{if(!ce(e))throw sn(Jt.NOT_SUPPORT_NON_STRING_MESSAGE);{Ie(t.warnHtmlMessage)&&t.warnHtmlMessage;const l=(t.onCacheKey||Md)(e),r=kl[l];if(r)return r;const{code:o,detectError:i}=Fd(e,t),a=new Function(`return ${o}`)();return i?a:kl[l]=a}};function X_(e,t){if(__INTLIFY_JIT_COMPILATION__&&!__INTLIFY_DROP_MESSAGE_COMPILER__&&ce(e)){Ie(t.warnHtmlMessage)&&t.warnHtmlMessage;const l=(t.onCacheKey||Md)(e),r=kl[l];if(r)return r;const{ast:o,detectError:i}=Fd(e,{...t,location:!1,jit:!0}),a=bi(o);return i?a:kl[l]=a}else{const n=e.cacheKey;if(n){const l=kl[n];return l||(kl[n]=bi(e))}else return bi(e)}}const Au=()=>"",jt=e=>ze(e);function Ou(e,...t){const{fallbackFormat:n,postTranslation:l,unresolving:r,messageCompiler:o,fallbackLocale:i,messages:a}=e,[s,c]=ea(...t),u=Ie(c.missingWarn)?c.missingWarn:e.missingWarn,f=Ie(c.fallbackWarn)?c.fallbackWarn:e.fallbackWarn,v=Ie(c.escapeParameter)?c.escapeParameter:e.escapeParameter,d=!!c.resolvedMessage,b=ce(c.default)||Ie(c.default)?Ie(c.default)?o?s:()=>s:c.default:n?o?s:()=>s:"",C=n||b!=="",E=ja(e,c);v&&J_(c);let[F,k,m]=d?[s,E,a[E]||Be()]:qd(e,s,E,i,f,u),g=F,L=s;if(!d&&!(ce(g)||un(g)||jt(g))&&C&&(g=b,L=g),!d&&(!(ce(g)||un(g)||jt(g))||!ce(k)))return r?Bo:s;let P=!1;const A=()=>{P=!0},I=jt(g)?g:$d(e,s,k,g,L,A);if(P)return g;const S=tp(e,k,m,c),N=A_(S),U=Z_(e,I,N);let x=l?l(U,s):U;if(v&&ce(x)&&(x=xb(x)),__INTLIFY_PROD_DEVTOOLS__){const Q={timestamp:Date.now(),key:ce(s)?s:jt(g)?g.key:"",locale:k||(jt(g)?g.locale:""),format:ce(g)?g:jt(g)?g.source:"",message:x};Q.meta=wt({},e.__meta,j_()||{}),R_(Q)}return x}function J_(e){Qe(e.list)?e.list=e.list.map(t=>ce(t)?mu(t):t):qe(e.named)&&Object.keys(e.named).forEach(t=>{ce(e.named[t])&&(e.named[t]=mu(e.named[t]))})}function qd(e,t,n,l,r,o){const{messages:i,onWarn:a,messageResolver:s,localeFallbacker:c}=e,u=c(e,l,n);let f=Be(),v,d=null;const b="translate";for(let C=0;C<u.length&&(v=u[C],f=i[v]||Be(),(d=s(f,t))===null&&(d=f[t]),!(ce(d)||un(d)||jt(d)));C++)if(!Y_(v,u)){const E=Wa(e,t,v,o,b);E!==t&&(d=E)}return[d,v,f]}function $d(e,t,n,l,r,o){const{messageCompiler:i,warnHtmlMessage:a}=e;if(jt(l)){const c=l;return c.locale=c.locale||n,c.key=c.key||t,c}if(i==null){const c=()=>l;return c.locale=n,c.key=t,c}const s=i(l,ep(e,n,r,l,a,o));return s.locale=n,s.key=t,s.source=l,s}function Z_(e,t,n){return t(n)}function ea(...e){const[t,n,l]=e,r=Be();if(!ce(t)&&!st(t)&&!jt(t)&&!un(t))throw sn(Jt.INVALID_ARGUMENT);const o=st(t)?String(t):(jt(t),t);return st(n)?r.plural=n:ce(n)?r.default=n:Te(n)&&!Do(n)?r.named=n:Qe(n)&&(r.list=n),st(l)?r.plural=l:ce(l)?r.default=l:Te(l)&&wt(r,l),[o,r]}function ep(e,t,n,l,r,o){return{locale:t,key:n,warnHtmlMessage:r,onError:i=>{throw o&&o(i),i},onCacheKey:i=>Cb(t,n,i)}}function tp(e,t,n,l){const{modifiers:r,pluralRules:o,messageResolver:i,fallbackLocale:a,fallbackWarn:s,missingWarn:c,fallbackContext:u}=e,v={locale:t,modifiers:r,pluralRules:o,messages:d=>{let b=i(n,d);if(b==null&&u){const[,,C]=qd(u,d,t,a,s,c);b=i(C,d)}if(ce(b)||un(b)){let C=!1;const F=$d(e,d,t,b,d,()=>{C=!0});return C?Au:F}else return jt(b)?b:Au}};return e.processor&&(v.processor=e.processor),l.list&&(v.list=l.list),l.named&&(v.named=l.named),st(l.plural)&&(v.pluralIndex=l.plural),v}function Iu(e,...t){const{datetimeFormats:n,unresolving:l,fallbackLocale:r,onWarn:o,localeFallbacker:i}=e,{__datetimeFormatters:a}=e,[s,c,u,f]=ta(...t),v=Ie(u.missingWarn)?u.missingWarn:e.missingWarn;Ie(u.fallbackWarn)?u.fallbackWarn:e.fallbackWarn;const d=!!u.part,b=ja(e,u),C=i(e,r,b);if(!ce(s)||s==="")return new Intl.DateTimeFormat(b,f).format(c);let E={},F,k=null;const m="datetime format";for(let P=0;P<C.length&&(F=C[P],E=n[F]||{},k=E[s],!Te(k));P++)Wa(e,s,F,v,m);if(!Te(k)||!ce(F))return l?Bo:s;let g=`${F}__${s}`;Do(f)||(g=`${g}__${JSON.stringify(f)}`);let L=a.get(g);return L||(L=new Intl.DateTimeFormat(F,wt({},k,f)),a.set(g,L)),d?L.formatToParts(c):L.format(c)}const Dd=["localeMatcher","weekday","era","year","month","day","hour","minute","second","timeZoneName","formatMatcher","hour12","timeZone","dateStyle","timeStyle","calendar","dayPeriod","numberingSystem","hourCycle","fractionalSecondDigits"];function ta(...e){const[t,n,l,r]=e,o=Be();let i=Be(),a;if(ce(t)){const s=t.match(/(\d{4}-\d{2}-\d{2})(T|\s)?(.*)/);if(!s)throw sn(Jt.INVALID_ISO_DATE_ARGUMENT);const c=s[3]?s[3].trim().startsWith("T")?`${s[1].trim()}${s[3].trim()}`:`${s[1].trim()}T${s[3].trim()}`:s[1].trim();a=new Date(c);try{a.toISOString()}catch{throw sn(Jt.INVALID_ISO_DATE_ARGUMENT)}}else if(Tb(t)){if(isNaN(t.getTime()))throw sn(Jt.INVALID_DATE_ARGUMENT);a=t}else if(st(t))a=t;else throw sn(Jt.INVALID_ARGUMENT);return ce(n)?o.key=n:Te(n)&&Object.keys(n).forEach(s=>{Dd.includes(s)?i[s]=n[s]:o[s]=n[s]}),ce(l)?o.locale=l:Te(l)&&(i=l),Te(r)&&(i=r),[o.key||"",a,o,i]}function Ru(e,t,n){const l=e;for(const r in n){const o=`${t}__${r}`;l.__datetimeFormatters.has(o)&&l.__datetimeFormatters.delete(o)}}function Nu(e,...t){const{numberFormats:n,unresolving:l,fallbackLocale:r,onWarn:o,localeFallbacker:i}=e,{__numberFormatters:a}=e,[s,c,u,f]=na(...t),v=Ie(u.missingWarn)?u.missingWarn:e.missingWarn;Ie(u.fallbackWarn)?u.fallbackWarn:e.fallbackWarn;const d=!!u.part,b=ja(e,u),C=i(e,r,b);if(!ce(s)||s==="")return new Intl.NumberFormat(b,f).format(c);let E={},F,k=null;const m="number format";for(let P=0;P<C.length&&(F=C[P],E=n[F]||{},k=E[s],!Te(k));P++)Wa(e,s,F,v,m);if(!Te(k)||!ce(F))return l?Bo:s;let g=`${F}__${s}`;Do(f)||(g=`${g}__${JSON.stringify(f)}`);let L=a.get(g);return L||(L=new Intl.NumberFormat(F,wt({},k,f)),a.set(g,L)),d?L.formatToParts(c):L.format(c)}const Vd=["localeMatcher","style","currency","currencyDisplay","currencySign","useGrouping","minimumIntegerDigits","minimumFractionDigits","maximumFractionDigits","minimumSignificantDigits","maximumSignificantDigits","compactDisplay","notation","signDisplay","unit","unitDisplay","roundingMode","roundingPriority","roundingIncrement","trailingZeroDisplay"];function na(...e){const[t,n,l,r]=e,o=Be();let i=Be();if(!st(t))throw sn(Jt.INVALID_ARGUMENT);const a=t;return ce(n)?o.key=n:Te(n)&&Object.keys(n).forEach(s=>{Vd.includes(s)?i[s]=n[s]:o[s]=n[s]}),ce(l)?o.locale=l:Te(l)&&(i=l),Te(r)&&(i=r),[o.key||"",a,o,i]}function Mu(e,t,n){const l=e;for(const r in n){const o=`${t}__${r}`;l.__numberFormatters.has(o)&&l.__numberFormatters.delete(o)}}s_();/*!
-  * vue-i18n v9.14.5
-  * (c) 2025 kazuya kawaguchi
-  * Released under the MIT License.
-  */const np="9.14.5";function lp(){typeof __VUE_I18N_FULL_INSTALL__!="boolean"&&(yn().__VUE_I18N_FULL_INSTALL__=!0),typeof __VUE_I18N_LEGACY_API__!="boolean"&&(yn().__VUE_I18N_LEGACY_API__=!0),typeof __INTLIFY_JIT_COMPILATION__!="boolean"&&(yn().__INTLIFY_JIT_COMPILATION__=!1),typeof __INTLIFY_DROP_MESSAGE_COMPILER__!="boolean"&&(yn().__INTLIFY_DROP_MESSAGE_COMPILER__=!1),typeof __INTLIFY_PROD_DEVTOOLS__!="boolean"&&(yn().__INTLIFY_PROD_DEVTOOLS__=!1)}const Bd=M_.__EXTEND_POINT__,hn=Vo(Bd);hn(),hn(),hn(),hn(),hn(),hn(),hn(),hn(),hn();const Hd=Jt.__EXTEND_POINT__,Rt=Vo(Hd),vt={UNEXPECTED_RETURN_TYPE:Hd,INVALID_ARGUMENT:Rt(),MUST_BE_CALL_SETUP_TOP:Rt(),NOT_INSTALLED:Rt(),NOT_AVAILABLE_IN_LEGACY_MODE:Rt(),REQUIRED_VALUE:Rt(),INVALID_VALUE:Rt(),CANNOT_SETUP_VUE_DEVTOOLS_PLUGIN:Rt(),NOT_INSTALLED_WITH_PROVIDE:Rt(),UNEXPECTED_ERROR:Rt(),NOT_COMPATIBLE_LEGACY_VUE_I18N:Rt(),BRIDGE_SUPPORT_VUE_2_ONLY:Rt(),MUST_DEFINE_I18N_OPTION_IN_ALLOW_COMPOSITION:Rt(),NOT_AVAILABLE_COMPOSITION_IN_LEGACY:Rt(),__EXTEND_POINT__:Rt()};function ht(e,...t){return Bl(e,null,void 0)}const la=Vn("__translateVNode"),ra=Vn("__datetimeParts"),oa=Vn("__numberParts"),Ud=Vn("__setPluralRules"),jd=Vn("__injectWithOption"),ia=Vn("__dispose");function Sr(e){if(!qe(e)||un(e))return e;for(const t in e)if(Xt(e,t))if(!t.includes("."))qe(e[t])&&Sr(e[t]);else{const n=t.split("."),l=n.length-1;let r=e,o=!1;for(let i=0;i<l;i++){if(n[i]==="__proto__")throw new Error(`unsafe key: ${n[i]}`);if(n[i]in r||(r[n[i]]=Be()),!qe(r[n[i]])){o=!0;break}r=r[n[i]]}if(o||(un(r)?kd.includes(n[l])||delete e[t]:(r[n[l]]=e[t],delete e[t])),!un(r)){const i=r[n[l]];qe(i)&&Sr(i)}}return e}function Ho(e,t){const{messages:n,__i18n:l,messageResolver:r,flatJson:o}=t,i=Te(n)?n:Qe(l)?Be():{[e]:Be()};if(Qe(l)&&l.forEach(a=>{if("locale"in a&&"resource"in a){const{locale:s,resource:c}=a;s?(i[s]=i[s]||Be(),lo(c,i[s])):lo(c,i)}else ce(a)&&lo(JSON.parse(a),i)}),r==null&&o)for(const a in i)Xt(i,a)&&Sr(i[a]);return i}function Wd(e){return e.type}function zd(e,t,n){let l=qe(t.messages)?t.messages:Be();"__i18nGlobal"in n&&(l=Ho(e.locale.value,{messages:l,__i18n:n.__i18nGlobal}));const r=Object.keys(l);r.length&&r.forEach(o=>{e.mergeLocaleMessage(o,l[o])});{if(qe(t.datetimeFormats)){const o=Object.keys(t.datetimeFormats);o.length&&o.forEach(i=>{e.mergeDateTimeFormat(i,t.datetimeFormats[i])})}if(qe(t.numberFormats)){const o=Object.keys(t.numberFormats);o.length&&o.forEach(i=>{e.mergeNumberFormat(i,t.numberFormats[i])})}}}function Fu(e){return be(Ar,null,e,0)}const qu="__INTLIFY_META__",$u=()=>[],rp=()=>!1;let Du=0;function Vu(e){return(t,n,l,r)=>e(n,l,Ce()||void 0,r)}const op=()=>{const e=Ce();let t=null;return e&&(t=Wd(e)[qu])?{[qu]:t}:null};function za(e={},t){const{__root:n,__injectWithOption:l}=e,r=n===void 0,o=e.flatJson,i=So?ne:Wc,a=!!e.translateExistCompatible;let s=Ie(e.inheritLocale)?e.inheritLocale:!0;const c=i(n&&s?n.locale.value:ce(e.locale)?e.locale:Vl),u=i(n&&s?n.fallbackLocale.value:ce(e.fallbackLocale)||Qe(e.fallbackLocale)||Te(e.fallbackLocale)||e.fallbackLocale===!1?e.fallbackLocale:c.value),f=i(Ho(c.value,e)),v=i(Te(e.datetimeFormats)?e.datetimeFormats:{[c.value]:{}}),d=i(Te(e.numberFormats)?e.numberFormats:{[c.value]:{}});let b=n?n.missingWarn:Ie(e.missingWarn)||$n(e.missingWarn)?e.missingWarn:!0,C=n?n.fallbackWarn:Ie(e.fallbackWarn)||$n(e.fallbackWarn)?e.fallbackWarn:!0,E=n?n.fallbackRoot:Ie(e.fallbackRoot)?e.fallbackRoot:!0,F=!!e.fallbackFormat,k=ze(e.missing)?e.missing:null,m=ze(e.missing)?Vu(e.missing):null,g=ze(e.postTranslation)?e.postTranslation:null,L=n?n.warnHtmlMessage:Ie(e.warnHtmlMessage)?e.warnHtmlMessage:!0,P=!!e.escapeParameter;const A=n?n.modifiers:Te(e.modifiers)?e.modifiers:{};let I=e.pluralRules||n&&n.pluralRules,S;S=(()=>{r&&xu(null);const M={version:np,locale:c.value,fallbackLocale:u.value,messages:f.value,modifiers:A,pluralRules:I,missing:m===null?void 0:m,missingWarn:b,fallbackWarn:C,fallbackFormat:F,unresolving:!0,postTranslation:g===null?void 0:g,warnHtmlMessage:L,escapeParameter:P,messageResolver:e.messageResolver,messageCompiler:e.messageCompiler,__meta:{framework:"vue"}};M.datetimeFormats=v.value,M.numberFormats=d.value,M.__datetimeFormatters=Te(S)?S.__datetimeFormatters:void 0,M.__numberFormatters=Te(S)?S.__numberFormatters:void 0;const H=z_(M);return r&&xu(H),H})(),jl(S,c.value,u.value);function U(){return[c.value,u.value,f.value,v.value,d.value]}const x=w({get:()=>c.value,set:M=>{c.value=M,S.locale=c.value}}),Q=w({get:()=>u.value,set:M=>{u.value=M,S.fallbackLocale=u.value,jl(S,c.value,M)}}),B=w(()=>f.value),te=w(()=>v.value),J=w(()=>d.value);function ee(){return ze(g)?g:null}function Y(M){g=M,S.postTranslation=M}function se(){return k}function he(M){M!==null&&(m=Vu(M)),k=M,S.missing=m}const $=(M,H,re,ue,Ee,Ae)=>{U();let Fe;try{__INTLIFY_PROD_DEVTOOLS__,r||(S.fallbackContext=n?W_():void 0),Fe=M(S)}finally{__INTLIFY_PROD_DEVTOOLS__,r||(S.fallbackContext=void 0)}if(re!=="translate exists"&&st(Fe)&&Fe===Bo||re==="translate exists"&&!Fe){const[ot,bt]=H();return n&&E?ue(n):Ee(ot)}else{if(Ae(Fe))return Fe;throw ht(vt.UNEXPECTED_RETURN_TYPE)}};function q(...M){return $(H=>Reflect.apply(Ou,null,[H,...M]),()=>ea(...M),"translate",H=>Reflect.apply(H.t,H,[...M]),H=>H,H=>ce(H))}function ae(...M){const[H,re,ue]=M;if(ue&&!qe(ue))throw ht(vt.INVALID_ARGUMENT);return q(H,re,wt({resolvedMessage:!0},ue||{}))}function ge(...M){return $(H=>Reflect.apply(Iu,null,[H,...M]),()=>ta(...M),"datetime format",H=>Reflect.apply(H.d,H,[...M]),()=>Eu,H=>ce(H))}function ye(...M){return $(H=>Reflect.apply(Nu,null,[H,...M]),()=>na(...M),"number format",H=>Reflect.apply(H.n,H,[...M]),()=>Eu,H=>ce(H))}function Z(M){return M.map(H=>ce(H)||st(H)||Ie(H)?Fu(String(H)):H)}const xe={normalize:Z,interpolate:M=>M,type:"vnode"};function le(...M){return $(H=>{let re;const ue=H;try{ue.processor=xe,re=Reflect.apply(Ou,null,[ue,...M])}finally{ue.processor=null}return re},()=>ea(...M),"translate",H=>H[la](...M),H=>[Fu(H)],H=>Qe(H))}function Pe(...M){return $(H=>Reflect.apply(Nu,null,[H,...M]),()=>na(...M),"number format",H=>H[oa](...M),$u,H=>ce(H)||Qe(H))}function $e(...M){return $(H=>Reflect.apply(Iu,null,[H,...M]),()=>ta(...M),"datetime format",H=>H[ra](...M),$u,H=>ce(H)||Qe(H))}function ut(M){I=M,S.pluralRules=I}function T(M,H){return $(()=>{if(!M)return!1;const re=ce(H)?H:c.value,ue=G(re),Ee=S.messageResolver(ue,M);return a?Ee!=null:un(Ee)||jt(Ee)||ce(Ee)},()=>[M],"translate exists",re=>Reflect.apply(re.te,re,[M,H]),rp,re=>Ie(re))}function p(M){let H=null;const re=Pd(S,u.value,c.value);for(let ue=0;ue<re.length;ue++){const Ee=f.value[re[ue]]||{},Ae=S.messageResolver(Ee,M);if(Ae!=null){H=Ae;break}}return H}function D(M){const H=p(M);return H??(n?n.tm(M)||{}:{})}function G(M){return f.value[M]||{}}function K(M,H){if(o){const re={[M]:H};for(const ue in re)Xt(re,ue)&&Sr(re[ue]);H=re[M]}f.value[M]=H,S.messages=f.value}function X(M,H){f.value[M]=f.value[M]||{};const re={[M]:H};if(o)for(const ue in re)Xt(re,ue)&&Sr(re[ue]);H=re[M],lo(H,f.value[M]),S.messages=f.value}function oe(M){return v.value[M]||{}}function h(M,H){v.value[M]=H,S.datetimeFormats=v.value,Ru(S,M,H)}function _(M,H){v.value[M]=wt(v.value[M]||{},H),S.datetimeFormats=v.value,Ru(S,M,H)}function y(M){return d.value[M]||{}}function V(M,H){d.value[M]=H,S.numberFormats=d.value,Mu(S,M,H)}function j(M,H){d.value[M]=wt(d.value[M]||{},H),S.numberFormats=d.value,Mu(S,M,H)}Du++,n&&So&&(me(n.locale,M=>{s&&(c.value=M,S.locale=M,jl(S,c.value,u.value))}),me(n.fallbackLocale,M=>{s&&(u.value=M,S.fallbackLocale=M,jl(S,c.value,u.value))}));const z={id:Du,locale:x,fallbackLocale:Q,get inheritLocale(){return s},set inheritLocale(M){s=M,M&&n&&(c.value=n.locale.value,u.value=n.fallbackLocale.value,jl(S,c.value,u.value))},get availableLocales(){return Object.keys(f.value).sort()},messages:B,get modifiers(){return A},get pluralRules(){return I||{}},get isGlobal(){return r},get missingWarn(){return b},set missingWarn(M){b=M,S.missingWarn=b},get fallbackWarn(){return C},set fallbackWarn(M){C=M,S.fallbackWarn=C},get fallbackRoot(){return E},set fallbackRoot(M){E=M},get fallbackFormat(){return F},set fallbackFormat(M){F=M,S.fallbackFormat=F},get warnHtmlMessage(){return L},set warnHtmlMessage(M){L=M,S.warnHtmlMessage=M},get escapeParameter(){return P},set escapeParameter(M){P=M,S.escapeParameter=M},t:q,getLocaleMessage:G,setLocaleMessage:K,mergeLocaleMessage:X,getPostTranslationHandler:ee,setPostTranslationHandler:Y,getMissingHandler:se,setMissingHandler:he,[Ud]:ut};return z.datetimeFormats=te,z.numberFormats=J,z.rt=ae,z.te=T,z.tm=D,z.d=ge,z.n=ye,z.getDateTimeFormat=oe,z.setDateTimeFormat=h,z.mergeDateTimeFormat=_,z.getNumberFormat=y,z.setNumberFormat=V,z.mergeNumberFormat=j,z[jd]=l,z[la]=le,z[ra]=$e,z[oa]=Pe,z}function ip(e){const t=ce(e.locale)?e.locale:Vl,n=ce(e.fallbackLocale)||Qe(e.fallbackLocale)||Te(e.fallbackLocale)||e.fallbackLocale===!1?e.fallbackLocale:t,l=ze(e.missing)?e.missing:void 0,r=Ie(e.silentTranslationWarn)||$n(e.silentTranslationWarn)?!e.silentTranslationWarn:!0,o=Ie(e.silentFallbackWarn)||$n(e.silentFallbackWarn)?!e.silentFallbackWarn:!0,i=Ie(e.fallbackRoot)?e.fallbackRoot:!0,a=!!e.formatFallbackMessages,s=Te(e.modifiers)?e.modifiers:{},c=e.pluralizationRules,u=ze(e.postTranslation)?e.postTranslation:void 0,f=ce(e.warnHtmlInMessage)?e.warnHtmlInMessage!=="off":!0,v=!!e.escapeParameterHtml,d=Ie(e.sync)?e.sync:!0;let b=e.messages;if(Te(e.sharedMessages)){const P=e.sharedMessages;b=Object.keys(P).reduce((I,S)=>{const N=I[S]||(I[S]={});return wt(N,P[S]),I},b||{})}const{__i18n:C,__root:E,__injectWithOption:F}=e,k=e.datetimeFormats,m=e.numberFormats,g=e.flatJson,L=e.translateExistCompatible;return{locale:t,fallbackLocale:n,messages:b,flatJson:g,datetimeFormats:k,numberFormats:m,missing:l,missingWarn:r,fallbackWarn:o,fallbackRoot:i,fallbackFormat:a,modifiers:s,pluralRules:c,postTranslation:u,warnHtmlMessage:f,escapeParameter:v,messageResolver:e.messageResolver,inheritLocale:d,translateExistCompatible:L,__i18n:C,__root:E,__injectWithOption:F}}function aa(e={},t){{const n=za(ip(e)),{__extender:l}=e,r={id:n.id,get locale(){return n.locale.value},set locale(o){n.locale.value=o},get fallbackLocale(){return n.fallbackLocale.value},set fallbackLocale(o){n.fallbackLocale.value=o},get messages(){return n.messages.value},get datetimeFormats(){return n.datetimeFormats.value},get numberFormats(){return n.numberFormats.value},get availableLocales(){return n.availableLocales},get formatter(){return{interpolate(){return[]}}},set formatter(o){},get missing(){return n.getMissingHandler()},set missing(o){n.setMissingHandler(o)},get silentTranslationWarn(){return Ie(n.missingWarn)?!n.missingWarn:n.missingWarn},set silentTranslationWarn(o){n.missingWarn=Ie(o)?!o:o},get silentFallbackWarn(){return Ie(n.fallbackWarn)?!n.fallbackWarn:n.fallbackWarn},set silentFallbackWarn(o){n.fallbackWarn=Ie(o)?!o:o},get modifiers(){return n.modifiers},get formatFallbackMessages(){return n.fallbackFormat},set formatFallbackMessages(o){n.fallbackFormat=o},get postTranslation(){return n.getPostTranslationHandler()},set postTranslation(o){n.setPostTranslationHandler(o)},get sync(){return n.inheritLocale},set sync(o){n.inheritLocale=o},get warnHtmlInMessage(){return n.warnHtmlMessage?"warn":"off"},set warnHtmlInMessage(o){n.warnHtmlMessage=o!=="off"},get escapeParameterHtml(){return n.escapeParameter},set escapeParameterHtml(o){n.escapeParameter=o},get preserveDirectiveContent(){return!0},set preserveDirectiveContent(o){},get pluralizationRules(){return n.pluralRules||{}},__composer:n,t(...o){const[i,a,s]=o,c={};let u=null,f=null;if(!ce(i))throw ht(vt.INVALID_ARGUMENT);const v=i;return ce(a)?c.locale=a:Qe(a)?u=a:Te(a)&&(f=a),Qe(s)?u=s:Te(s)&&(f=s),Reflect.apply(n.t,n,[v,u||f||{},c])},rt(...o){return Reflect.apply(n.rt,n,[...o])},tc(...o){const[i,a,s]=o,c={plural:1};let u=null,f=null;if(!ce(i))throw ht(vt.INVALID_ARGUMENT);const v=i;return ce(a)?c.locale=a:st(a)?c.plural=a:Qe(a)?u=a:Te(a)&&(f=a),ce(s)?c.locale=s:Qe(s)?u=s:Te(s)&&(f=s),Reflect.apply(n.t,n,[v,u||f||{},c])},te(o,i){return n.te(o,i)},tm(o){return n.tm(o)},getLocaleMessage(o){return n.getLocaleMessage(o)},setLocaleMessage(o,i){n.setLocaleMessage(o,i)},mergeLocaleMessage(o,i){n.mergeLocaleMessage(o,i)},d(...o){return Reflect.apply(n.d,n,[...o])},getDateTimeFormat(o){return n.getDateTimeFormat(o)},setDateTimeFormat(o,i){n.setDateTimeFormat(o,i)},mergeDateTimeFormat(o,i){n.mergeDateTimeFormat(o,i)},n(...o){return Reflect.apply(n.n,n,[...o])},getNumberFormat(o){return n.getNumberFormat(o)},setNumberFormat(o,i){n.setNumberFormat(o,i)},mergeNumberFormat(o,i){n.mergeNumberFormat(o,i)},getChoiceIndex(o,i){return-1}};return r.__extender=l,r}}const Ka={tag:{type:[String,Object]},locale:{type:String},scope:{type:String,validator:e=>e==="parent"||e==="global",default:"parent"},i18n:{type:Object}};function ap({slots:e},t){return t.length===1&&t[0]==="default"?(e.default?e.default():[]).reduce((l,r)=>[...l,...r.type===Ut?r.children:[r]],[]):t.reduce((n,l)=>{const r=e[l];return r&&(n[l]=r()),n},Be())}function Kd(e){return Ut}const sp=Po({name:"i18n-t",props:wt({keypath:{type:String,required:!0},plural:{type:[Number,String],validator:e=>st(e)||!isNaN(e)}},Ka),setup(e,t){const{slots:n,attrs:l}=t,r=e.i18n||Nr({useScope:e.scope,__useComponent:!0});return()=>{const o=Object.keys(n).filter(f=>f!=="_"),i=Be();e.locale&&(i.locale=e.locale),e.plural!==void 0&&(i.plural=ce(e.plural)?+e.plural:e.plural);const a=ap(t,o),s=r[la](e.keypath,a,i),c=wt(Be(),l),u=ce(e.tag)||qe(e.tag)?e.tag:Kd();return O(u,c,s)}}}),Bu=sp;function up(e){return Qe(e)&&!ce(e[0])}function Yd(e,t,n,l){const{slots:r,attrs:o}=t;return()=>{const i={part:!0};let a=Be();e.locale&&(i.locale=e.locale),ce(e.format)?i.key=e.format:qe(e.format)&&(ce(e.format.key)&&(i.key=e.format.key),a=Object.keys(e.format).reduce((v,d)=>n.includes(d)?wt(Be(),v,{[d]:e.format[d]}):v,Be()));const s=l(e.value,i,a);let c=[i.key];Qe(s)?c=s.map((v,d)=>{const b=r[v.type],C=b?b({[v.type]:v.value,index:d,parts:s}):[v.value];return up(C)&&(C[0].key=`${v.type}-${d}`),C}):ce(s)&&(c=[s]);const u=wt(Be(),o),f=ce(e.tag)||qe(e.tag)?e.tag:Kd();return O(f,u,c)}}const cp=Po({name:"i18n-n",props:wt({value:{type:Number,required:!0},format:{type:[String,Object]}},Ka),setup(e,t){const n=e.i18n||Nr({useScope:e.scope,__useComponent:!0});return Yd(e,t,Vd,(...l)=>n[oa](...l))}}),Hu=cp,fp=Po({name:"i18n-d",props:wt({value:{type:[Number,Date],required:!0},format:{type:[String,Object]}},Ka),setup(e,t){const n=e.i18n||Nr({useScope:e.scope,__useComponent:!0});return Yd(e,t,Dd,(...l)=>n[ra](...l))}}),Uu=fp;function dp(e,t){const n=e;if(e.mode==="composition")return n.__getInstance(t)||e.global;{const l=n.__getInstance(t);return l!=null?l.__composer:e.global.__composer}}function vp(e){const t=i=>{const{instance:a,modifiers:s,value:c}=i;if(!a||!a.$)throw ht(vt.UNEXPECTED_ERROR);const u=dp(e,a.$),f=ju(c);return[Reflect.apply(u.t,u,[...Wu(f)]),u]};return{created:(i,a)=>{const[s,c]=t(a);So&&e.global===c&&(i.__i18nWatcher=me(c.locale,()=>{a.instance&&a.instance.$forceUpdate()})),i.__composer=c,i.textContent=s},unmounted:i=>{So&&i.__i18nWatcher&&(i.__i18nWatcher(),i.__i18nWatcher=void 0,delete i.__i18nWatcher),i.__composer&&(i.__composer=void 0,delete i.__composer)},beforeUpdate:(i,{value:a})=>{if(i.__composer){const s=i.__composer,c=ju(a);i.textContent=Reflect.apply(s.t,s,[...Wu(c)])}},getSSRProps:i=>{const[a]=t(i);return{textContent:a}}}}function ju(e){if(ce(e))return{path:e};if(Te(e)){if(!("path"in e))throw ht(vt.REQUIRED_VALUE,"path");return e}else throw ht(vt.INVALID_VALUE)}function Wu(e){const{path:t,locale:n,args:l,choice:r,plural:o}=e,i={},a=l||{};return ce(n)&&(i.locale=n),st(r)&&(i.plural=r),st(o)&&(i.plural=o),[t,a,i]}function mp(e,t,...n){const l=Te(n[0])?n[0]:{},r=!!l.useI18nComponentName;(Ie(l.globalInstall)?l.globalInstall:!0)&&([r?"i18n":Bu.name,"I18nT"].forEach(i=>e.component(i,Bu)),[Hu.name,"I18nN"].forEach(i=>e.component(i,Hu)),[Uu.name,"I18nD"].forEach(i=>e.component(i,Uu))),e.directive("t",vp(t))}function hp(e,t,n){return{beforeCreate(){const l=Ce();if(!l)throw ht(vt.UNEXPECTED_ERROR);const r=this.$options;if(r.i18n){const o=r.i18n;if(r.__i18n&&(o.__i18n=r.__i18n),o.__root=t,this===this.$root)this.$i18n=zu(e,o);else{o.__injectWithOption=!0,o.__extender=n.__vueI18nExtend,this.$i18n=aa(o);const i=this.$i18n;i.__extender&&(i.__disposer=i.__extender(this.$i18n))}}else if(r.__i18n)if(this===this.$root)this.$i18n=zu(e,r);else{this.$i18n=aa({__i18n:r.__i18n,__injectWithOption:!0,__extender:n.__vueI18nExtend,__root:t});const o=this.$i18n;o.__extender&&(o.__disposer=o.__extender(this.$i18n))}else this.$i18n=e;r.__i18nGlobal&&zd(t,r,r),this.$t=(...o)=>this.$i18n.t(...o),this.$rt=(...o)=>this.$i18n.rt(...o),this.$tc=(...o)=>this.$i18n.tc(...o),this.$te=(o,i)=>this.$i18n.te(o,i),this.$d=(...o)=>this.$i18n.d(...o),this.$n=(...o)=>this.$i18n.n(...o),this.$tm=o=>this.$i18n.tm(o),n.__setInstance(l,this.$i18n)},mounted(){},unmounted(){const l=Ce();if(!l)throw ht(vt.UNEXPECTED_ERROR);const r=this.$i18n;delete this.$t,delete this.$rt,delete this.$tc,delete this.$te,delete this.$d,delete this.$n,delete this.$tm,r.__disposer&&(r.__disposer(),delete r.__disposer,delete r.__extender),n.__deleteInstance(l),delete this.$i18n}}}function zu(e,t){e.locale=t.locale||e.locale,e.fallbackLocale=t.fallbackLocale||e.fallbackLocale,e.missing=t.missing||e.missing,e.silentTranslationWarn=t.silentTranslationWarn||e.silentFallbackWarn,e.silentFallbackWarn=t.silentFallbackWarn||e.silentFallbackWarn,e.formatFallbackMessages=t.formatFallbackMessages||e.formatFallbackMessages,e.postTranslation=t.postTranslation||e.postTranslation,e.warnHtmlInMessage=t.warnHtmlInMessage||e.warnHtmlInMessage,e.escapeParameterHtml=t.escapeParameterHtml||e.escapeParameterHtml,e.sync=t.sync||e.sync,e.__composer[Ud](t.pluralizationRules||e.pluralizationRules);const n=Ho(e.locale,{messages:t.messages,__i18n:t.__i18n});return Object.keys(n).forEach(l=>e.mergeLocaleMessage(l,n[l])),t.datetimeFormats&&Object.keys(t.datetimeFormats).forEach(l=>e.mergeDateTimeFormat(l,t.datetimeFormats[l])),t.numberFormats&&Object.keys(t.numberFormats).forEach(l=>e.mergeNumberFormat(l,t.numberFormats[l])),e}const gp=Vn("global-vue-i18n");function bp(e={},t){const n=__VUE_I18N_LEGACY_API__&&Ie(e.legacy)?e.legacy:__VUE_I18N_LEGACY_API__,l=Ie(e.globalInjection)?e.globalInjection:!0,r=__VUE_I18N_LEGACY_API__&&n?!!e.allowComposition:!0,o=new Map,[i,a]=_p(e,n),s=Vn("");function c(v){return o.get(v)||null}function u(v,d){o.set(v,d)}function f(v){o.delete(v)}{const v={get mode(){return __VUE_I18N_LEGACY_API__&&n?"legacy":"composition"},get allowComposition(){return r},async install(d,...b){if(d.__VUE_I18N_SYMBOL__=s,d.provide(d.__VUE_I18N_SYMBOL__,v),Te(b[0])){const F=b[0];v.__composerExtend=F.__composerExtend,v.__vueI18nExtend=F.__vueI18nExtend}let C=null;!n&&l&&(C=xp(d,v.global)),__VUE_I18N_FULL_INSTALL__&&mp(d,v,...b),__VUE_I18N_LEGACY_API__&&n&&d.mixin(hp(a,a.__composer,v));const E=d.unmount;d.unmount=()=>{C&&C(),v.dispose(),E()}},get global(){return a},dispose(){i.stop()},__instances:o,__getInstance:c,__setInstance:u,__deleteInstance:f};return v}}function Nr(e={}){const t=Ce();if(t==null)throw ht(vt.MUST_BE_CALL_SETUP_TOP);if(!t.isCE&&t.appContext.app!=null&&!t.appContext.app.__VUE_I18N_SYMBOL__)throw ht(vt.NOT_INSTALLED);const n=pp(t),l=Sp(n),r=Wd(t),o=yp(e,r);if(__VUE_I18N_LEGACY_API__&&n.mode==="legacy"&&!e.__useComponent){if(!n.allowComposition)throw ht(vt.NOT_AVAILABLE_IN_LEGACY_MODE);return Tp(t,o,l,e)}if(o==="global")return zd(l,e,r),l;if(o==="parent"){let s=wp(n,t,e.__useComponent);return s==null&&(s=l),s}const i=n;let a=i.__getInstance(t);if(a==null){const s=wt({},e);"__i18n"in r&&(s.__i18n=r.__i18n),l&&(s.__root=l),a=za(s),i.__composerExtend&&(a[ia]=i.__composerExtend(a)),Ep(i,t,a),i.__setInstance(t,a)}return a}function _p(e,t,n){const l=ba();{const r=__VUE_I18N_LEGACY_API__&&t?l.run(()=>aa(e)):l.run(()=>za(e));if(r==null)throw ht(vt.UNEXPECTED_ERROR);return[l,r]}}function pp(e){{const t=Kt(e.isCE?gp:e.appContext.app.__VUE_I18N_SYMBOL__);if(!t)throw ht(e.isCE?vt.NOT_INSTALLED_WITH_PROVIDE:vt.UNEXPECTED_ERROR);return t}}function yp(e,t){return Do(e)?"__i18n"in t?"local":"global":e.useScope?e.useScope:"local"}function Sp(e){return e.mode==="composition"?e.global:e.global.__composer}function wp(e,t,n=!1){let l=null;const r=t.root;let o=Cp(t,n);for(;o!=null;){const i=e;if(e.mode==="composition")l=i.__getInstance(o);else if(__VUE_I18N_LEGACY_API__){const a=i.__getInstance(o);a!=null&&(l=a.__composer,n&&l&&!l[jd]&&(l=null))}if(l!=null||r===o)break;o=o.parent}return l}function Cp(e,t=!1){return e==null?null:t&&e.vnode.ctx||e.parent}function Ep(e,t,n){gt(()=>{},t),Pr(()=>{const l=n;e.__deleteInstance(t);const r=l[ia];r&&(r(),delete l[ia])},t)}function Tp(e,t,n,l={}){const r=t==="local",o=Wc(null);if(r&&e.proxy&&!(e.proxy.$options.i18n||e.proxy.$options.__i18n))throw ht(vt.MUST_DEFINE_I18N_OPTION_IN_ALLOW_COMPOSITION);const i=Ie(l.inheritLocale)?l.inheritLocale:!ce(l.locale),a=ne(!r||i?n.locale.value:ce(l.locale)?l.locale:Vl),s=ne(!r||i?n.fallbackLocale.value:ce(l.fallbackLocale)||Qe(l.fallbackLocale)||Te(l.fallbackLocale)||l.fallbackLocale===!1?l.fallbackLocale:a.value),c=ne(Ho(a.value,l)),u=ne(Te(l.datetimeFormats)?l.datetimeFormats:{[a.value]:{}}),f=ne(Te(l.numberFormats)?l.numberFormats:{[a.value]:{}}),v=r?n.missingWarn:Ie(l.missingWarn)||$n(l.missingWarn)?l.missingWarn:!0,d=r?n.fallbackWarn:Ie(l.fallbackWarn)||$n(l.fallbackWarn)?l.fallbackWarn:!0,b=r?n.fallbackRoot:Ie(l.fallbackRoot)?l.fallbackRoot:!0,C=!!l.fallbackFormat,E=ze(l.missing)?l.missing:null,F=ze(l.postTranslation)?l.postTranslation:null,k=r?n.warnHtmlMessage:Ie(l.warnHtmlMessage)?l.warnHtmlMessage:!0,m=!!l.escapeParameter,g=r?n.modifiers:Te(l.modifiers)?l.modifiers:{},L=l.pluralRules||r&&n.pluralRules;function P(){return[a.value,s.value,c.value,u.value,f.value]}const A=w({get:()=>o.value?o.value.locale.value:a.value,set:p=>{o.value&&(o.value.locale.value=p),a.value=p}}),I=w({get:()=>o.value?o.value.fallbackLocale.value:s.value,set:p=>{o.value&&(o.value.fallbackLocale.value=p),s.value=p}}),S=w(()=>o.value?o.value.messages.value:c.value),N=w(()=>u.value),U=w(()=>f.value);function x(){return o.value?o.value.getPostTranslationHandler():F}function Q(p){o.value&&o.value.setPostTranslationHandler(p)}function B(){return o.value?o.value.getMissingHandler():E}function te(p){o.value&&o.value.setMissingHandler(p)}function J(p){return P(),p()}function ee(...p){return o.value?J(()=>Reflect.apply(o.value.t,null,[...p])):J(()=>"")}function Y(...p){return o.value?Reflect.apply(o.value.rt,null,[...p]):""}function se(...p){return o.value?J(()=>Reflect.apply(o.value.d,null,[...p])):J(()=>"")}function he(...p){return o.value?J(()=>Reflect.apply(o.value.n,null,[...p])):J(()=>"")}function $(p){return o.value?o.value.tm(p):{}}function q(p,D){return o.value?o.value.te(p,D):!1}function ae(p){return o.value?o.value.getLocaleMessage(p):{}}function ge(p,D){o.value&&(o.value.setLocaleMessage(p,D),c.value[p]=D)}function ye(p,D){o.value&&o.value.mergeLocaleMessage(p,D)}function Z(p){return o.value?o.value.getDateTimeFormat(p):{}}function ie(p,D){o.value&&(o.value.setDateTimeFormat(p,D),u.value[p]=D)}function xe(p,D){o.value&&o.value.mergeDateTimeFormat(p,D)}function le(p){return o.value?o.value.getNumberFormat(p):{}}function Pe(p,D){o.value&&(o.value.setNumberFormat(p,D),f.value[p]=D)}function $e(p,D){o.value&&o.value.mergeNumberFormat(p,D)}const ut={get id(){return o.value?o.value.id:-1},locale:A,fallbackLocale:I,messages:S,datetimeFormats:N,numberFormats:U,get inheritLocale(){return o.value?o.value.inheritLocale:i},set inheritLocale(p){o.value&&(o.value.inheritLocale=p)},get availableLocales(){return o.value?o.value.availableLocales:Object.keys(c.value)},get modifiers(){return o.value?o.value.modifiers:g},get pluralRules(){return o.value?o.value.pluralRules:L},get isGlobal(){return o.value?o.value.isGlobal:!1},get missingWarn(){return o.value?o.value.missingWarn:v},set missingWarn(p){o.value&&(o.value.missingWarn=p)},get fallbackWarn(){return o.value?o.value.fallbackWarn:d},set fallbackWarn(p){o.value&&(o.value.missingWarn=p)},get fallbackRoot(){return o.value?o.value.fallbackRoot:b},set fallbackRoot(p){o.value&&(o.value.fallbackRoot=p)},get fallbackFormat(){return o.value?o.value.fallbackFormat:C},set fallbackFormat(p){o.value&&(o.value.fallbackFormat=p)},get warnHtmlMessage(){return o.value?o.value.warnHtmlMessage:k},set warnHtmlMessage(p){o.value&&(o.value.warnHtmlMessage=p)},get escapeParameter(){return o.value?o.value.escapeParameter:m},set escapeParameter(p){o.value&&(o.value.escapeParameter=p)},t:ee,getPostTranslationHandler:x,setPostTranslationHandler:Q,getMissingHandler:B,setMissingHandler:te,rt:Y,d:se,n:he,tm:$,te:q,getLocaleMessage:ae,setLocaleMessage:ge,mergeLocaleMessage:ye,getDateTimeFormat:Z,setDateTimeFormat:ie,mergeDateTimeFormat:xe,getNumberFormat:le,setNumberFormat:Pe,mergeNumberFormat:$e};function T(p){p.locale.value=a.value,p.fallbackLocale.value=s.value,Object.keys(c.value).forEach(D=>{p.mergeLocaleMessage(D,c.value[D])}),Object.keys(u.value).forEach(D=>{p.mergeDateTimeFormat(D,u.value[D])}),Object.keys(f.value).forEach(D=>{p.mergeNumberFormat(D,f.value[D])}),p.escapeParameter=m,p.fallbackFormat=C,p.fallbackRoot=b,p.fallbackWarn=d,p.missingWarn=v,p.warnHtmlMessage=k}return Lr(()=>{if(e.proxy==null||e.proxy.$i18n==null)throw ht(vt.NOT_AVAILABLE_COMPOSITION_IN_LEGACY);const p=o.value=e.proxy.$i18n.__composer;t==="global"?(a.value=p.locale.value,s.value=p.fallbackLocale.value,c.value=p.messages.value,u.value=p.datetimeFormats.value,f.value=p.numberFormats.value):r&&T(p)}),ut}const kp=["locale","fallbackLocale","availableLocales"],Ku=["t","rt","d","n","tm","te"];function xp(e,t){const n=Object.create(null);return kp.forEach(r=>{const o=Object.getOwnPropertyDescriptor(t,r);if(!o)throw ht(vt.UNEXPECTED_ERROR);const i=lt(o.value)?{get(){return o.value.value},set(a){o.value.value=a}}:{get(){return o.get&&o.get()}};Object.defineProperty(n,r,i)}),e.config.globalProperties.$i18n=n,Ku.forEach(r=>{const o=Object.getOwnPropertyDescriptor(t,r);if(!o||!o.value)throw ht(vt.UNEXPECTED_ERROR);Object.defineProperty(e.config.globalProperties,`$${r}`,o)}),()=>{delete e.config.globalProperties.$i18n,Ku.forEach(r=>{delete e.config.globalProperties[`$${r}`]})}}lp();__INTLIFY_JIT_COMPILATION__?ku(X_):ku(G_);B_(w_);H_(Pd);if(__INTLIFY_PROD_DEVTOOLS__){const e=yn();e.__INTLIFY__=!0,O_(e.__INTLIFY_DEVTOOLS_GLOBAL_HOOK__)}var Lp=!1;/*!
- * pinia v2.3.1
- * (c) 2025 Eduardo San Martin Morote
- * @license MIT
- */let Qd;const Uo=e=>Qd=e,Gd=Symbol();function sa(e){return e&&typeof e=="object"&&Object.prototype.toString.call(e)==="[object Object]"&&typeof e.toJSON!="function"}var sr;(function(e){e.direct="direct",e.patchObject="patch object",e.patchFunction="patch function"})(sr||(sr={}));function Pp(){const e=ba(!0),t=e.run(()=>ne({}));let n=[],l=[];const r=Tr({install(o){Uo(r),r._a=o,o.provide(Gd,r),o.config.globalProperties.$pinia=r,l.forEach(i=>n.push(i)),l=[]},use(o){return!this._a&&!Lp?l.push(o):n.push(o),this},_p:n,_a:null,_e:e,_s:new Map,state:t});return r}const Xd=()=>{};function Yu(e,t,n,l=Xd){e.push(t);const r=()=>{const o=e.indexOf(t);o>-1&&(e.splice(o,1),l())};return!n&&Pc()&&Pv(r),r}function wl(e,...t){e.slice().forEach(n=>{n(...t)})}const Ap=e=>e(),Qu=Symbol(),_i=Symbol();function ua(e,t){e instanceof Map&&t instanceof Map?t.forEach((n,l)=>e.set(l,n)):e instanceof Set&&t instanceof Set&&t.forEach(e.add,e);for(const n in t){if(!t.hasOwnProperty(n))continue;const l=t[n],r=e[n];sa(r)&&sa(l)&&e.hasOwnProperty(n)&&!lt(l)&&!ol(l)?e[n]=ua(r,l):e[n]=l}return e}const Op=Symbol();function Ip(e){return!sa(e)||!e.hasOwnProperty(Op)}const{assign:An}=Object;function Rp(e){return!!(lt(e)&&e.effect)}function Np(e,t,n,l){const{state:r,actions:o,getters:i}=t,a=n.state.value[e];let s;function c(){a||(n.state.value[e]=r?r():{});const u=Jv(n.state.value[e]);return An(u,o,Object.keys(i||{}).reduce((f,v)=>(f[v]=Tr(w(()=>{Uo(n);const d=n._s.get(e);return i[v].call(d,d)})),f),{}))}return s=Jd(e,c,t,n,l,!0),s}function Jd(e,t,n={},l,r,o){let i;const a=An({actions:{}},n),s={deep:!0};let c,u,f=[],v=[],d;const b=l.state.value[e];!o&&!b&&(l.state.value[e]={}),ne({});let C;function E(I){let S;c=u=!1,typeof I=="function"?(I(l.state.value[e]),S={type:sr.patchFunction,storeId:e,events:d}):(ua(l.state.value[e],I),S={type:sr.patchObject,payload:I,storeId:e,events:d});const N=C=Symbol();Ke().then(()=>{C===N&&(c=!0)}),u=!0,wl(f,S,l.state.value[e])}const F=o?function(){const{state:S}=n,N=S?S():{};this.$patch(U=>{An(U,N)})}:Xd;function k(){i.stop(),f=[],v=[],l._s.delete(e)}const m=(I,S="")=>{if(Qu in I)return I[_i]=S,I;const N=function(){Uo(l);const U=Array.from(arguments),x=[],Q=[];function B(ee){x.push(ee)}function te(ee){Q.push(ee)}wl(v,{args:U,name:N[_i],store:L,after:B,onError:te});let J;try{J=I.apply(this&&this.$id===e?this:L,U)}catch(ee){throw wl(Q,ee),ee}return J instanceof Promise?J.then(ee=>(wl(x,ee),ee)).catch(ee=>(wl(Q,ee),Promise.reject(ee))):(wl(x,J),J)};return N[Qu]=!0,N[_i]=S,N},g={_p:l,$id:e,$onAction:Yu.bind(null,v),$patch:E,$reset:F,$subscribe(I,S={}){const N=Yu(f,I,S.detached,()=>U()),U=i.run(()=>me(()=>l.state.value[e],x=>{(S.flush==="sync"?u:c)&&I({storeId:e,type:sr.direct,events:d},x)},An({},s,S)));return N},$dispose:k},L=an(g);l._s.set(e,L);const A=(l._a&&l._a.runWithContext||Ap)(()=>l._e.run(()=>(i=ba()).run(()=>t({action:m}))));for(const I in A){const S=A[I];if(lt(S)&&!Rp(S)||ol(S))o||(b&&Ip(S)&&(lt(S)?S.value=b[I]:ua(S,b[I])),l.state.value[e][I]=S);else if(typeof S=="function"){const N=m(S,I);A[I]=N,a.actions[I]=S}}return An(L,A),An(Oe(L),A),Object.defineProperty(L,"$state",{get:()=>l.state.value[e],set:I=>{E(S=>{An(S,I)})}}),l._p.forEach(I=>{An(L,i.run(()=>I({store:L,app:l._a,pinia:l,options:a})))}),b&&o&&n.hydrate&&n.hydrate(L.$state,b),c=!0,u=!0,L}/*! #__NO_SIDE_EFFECTS__ */function Mp(e,t,n){let l,r;const o=typeof t=="function";typeof e=="string"?(l=e,r=o?n:t):(r=e,l=e.id);function i(a,s){const c=Pm();return a=a||(c?Kt(Gd,null):null),a&&Uo(a),a=Qd,a._s.has(l)||(o?Jd(l,t,r,a):Np(l,r,a)),a._s.get(l)}return i.$id=l,i}const Zd=Mp("portal",()=>{const e=ne(""),t=ne([]),n=ne(null);async function l(){try{const u=await(await fetch("/api/ping")).json();e.value=u.message||JSON.stringify(u)}catch{e.value="Ping failed"}}function r(){e.value=""}async function o(){const c=await fetch("/api/players");t.value=await c.json()}async function i(c){return await(await fetch("/api/players",{method:"POST",headers:{"Content-Type":"application/json"},body:JSON.stringify(c)})).json()}async function a(c,u){return await(await fetch(`/api/players/${c}`,{method:"PUT",headers:{"Content-Type":"application/json"},body:JSON.stringify(u)})).json()}async function s(c){const u=await fetch(`/api/players/${c}`,{method:"DELETE"});if(u.status===204)return{};try{return await u.json()}catch{return{}}}return{pingResult:e,pingBackend:l,clearResult:r,players:t,player:n,fetchPlayers:o,createPlayer:i,updatePlayer:a,deletePlayer:s}}),Fp={true:"inset",item:"item-inset","item-thumbnail":"item-thumbnail-inset"},pi={xs:2,sm:4,md:8,lg:16,xl:24},ev=Re({name:"QSeparator",props:{...$t,spaced:[Boolean,String],inset:[Boolean,String],vertical:Boolean,color:String,size:String},setup(e){const t=Ce(),n=Dt(e,t.proxy.$q),l=w(()=>e.vertical===!0?"vertical":"horizontal"),r=w(()=>` q-separator--${l.value}`),o=w(()=>e.inset!==!1?`${r.value}-${Fp[e.inset]}`:""),i=w(()=>`q-separator${r.value}${o.value}`+(e.color!==void 0?` bg-${e.color}`:"")+(n.value===!0?" q-separator--dark":"")),a=w(()=>{const s={};if(e.size!==void 0&&(s[e.vertical===!0?"width":"height"]=e.size),e.spaced!==!1){const c=e.spaced===!0?`${pi.md}px`:e.spaced in pi?`${pi[e.spaced]}px`:e.spaced,u=e.vertical===!0?["Left","Right"]:["Top","Bottom"];s[`margin${u[0]}`]=s[`margin${u[1]}`]=c}return s});return()=>O("hr",{class:i.value,style:a.value,"aria-orientation":l.value})}}),qp=Re({name:"QTd",props:{props:Object,autoWidth:Boolean,noHover:Boolean},setup(e,{slots:t}){const n=Ce(),l=w(()=>"q-td"+(e.autoWidth===!0?" q-table--col-auto-width":"")+(e.noHover===!0?" q-td--no-hover":"")+" ");return()=>{if(e.props===void 0)return O("td",{class:l.value},Ve(t.default));const r=n.vnode.key,o=(e.props.colsMap!==void 0?e.props.colsMap[r]:null)||e.props.col;if(o===void 0)return;const{row:i}=e.props;return O("td",{class:l.value+o.__tdClass(i),style:o.__tdStyle(i)},Ve(t.default))}}}),$p=Re({name:"QTh",props:{props:Object,autoWidth:Boolean},emits:["click"],setup(e,{slots:t,emit:n}){const l=Ce(),{proxy:{$q:r}}=l,o=i=>{n("click",i)};return()=>{if(e.props===void 0)return O("th",{class:e.autoWidth===!0?"q-table--col-auto-width":"",onClick:o},Ve(t.default));let i,a;const s=l.vnode.key;if(s){if(i=e.props.colsMap[s],i===void 0)return}else i=e.props.col;if(i.sortable===!0){const u=i.align==="right"?"unshift":"push";a=Ma(t.default,[]),a[u](O(xt,{class:i.__iconClass,name:r.iconSet.table.arrowUp}))}else a=Ve(t.default);const c={class:i.__thClass+(e.autoWidth===!0?" q-table--col-auto-width":""),style:i.headerStyle,onClick:u=>{i.sortable===!0&&e.props.sort(i),o(u)}};return O("th",c,a)}}}),Dp=["horizontal","vertical","cell","none"],Vp=Re({name:"QMarkupTable",props:{...$t,dense:Boolean,flat:Boolean,bordered:Boolean,square:Boolean,wrapCells:Boolean,separator:{type:String,default:"horizontal",validator:e=>Dp.includes(e)}},setup(e,{slots:t}){const n=Ce(),l=Dt(e,n.proxy.$q),r=w(()=>`q-markup-table q-table__container q-table__card q-table--${e.separator}-separator`+(l.value===!0?" q-table--dark q-table__card--dark q-dark":"")+(e.dense===!0?" q-table--dense":"")+(e.flat===!0?" q-table--flat":"")+(e.bordered===!0?" q-table--bordered":"")+(e.square===!0?" q-table--square":"")+(e.wrapCells===!1?" q-table--no-wrap":""));return()=>O("div",{class:r.value},[O("table",{class:"q-table"},Ve(t.default))])}});function tv(e,t){return O("div",e,[O("table",{class:"q-table"},t)])}function Ya(e,t=250,n){let l=null;function r(){const o=arguments,i=()=>{l=null,n!==!0&&e.apply(this,o)};l!==null?clearTimeout(l):n===!0&&e.apply(this,o),l=setTimeout(i,t)}return r.cancel=()=>{l!==null&&clearTimeout(l)},r}const Qt=1e3,Bp=["start","center","end","start-force","center-force","end-force"],nv=Array.prototype.filter,Hp=window.getComputedStyle(document.body).overflowAnchor===void 0?En:function(e,t){e!==null&&(e._qOverflowAnimationFrame!==void 0&&cancelAnimationFrame(e._qOverflowAnimationFrame),e._qOverflowAnimationFrame=requestAnimationFrame(()=>{if(e===null)return;e._qOverflowAnimationFrame=void 0;const n=e.children||[];nv.call(n,r=>r.dataset&&r.dataset.qVsAnchor!==void 0).forEach(r=>{delete r.dataset.qVsAnchor});const l=n[t];l!=null&&l.dataset&&(l.dataset.qVsAnchor="")}))};function Nl(e,t){return e+t}function yi(e,t,n,l,r,o,i,a){const s=e===window?document.scrollingElement||document.documentElement:e,c=r===!0?"offsetWidth":"offsetHeight",u={scrollStart:0,scrollViewSize:-i-a,scrollMaxSize:0,offsetStart:-i,offsetEnd:-a};if(r===!0?(e===window?(u.scrollStart=window.pageXOffset||window.scrollX||document.body.scrollLeft||0,u.scrollViewSize+=document.documentElement.clientWidth):(u.scrollStart=s.scrollLeft,u.scrollViewSize+=s.clientWidth),u.scrollMaxSize=s.scrollWidth,o===!0&&(u.scrollStart=(_r===!0?u.scrollMaxSize-u.scrollViewSize:0)-u.scrollStart)):(e===window?(u.scrollStart=window.pageYOffset||window.scrollY||document.body.scrollTop||0,u.scrollViewSize+=document.documentElement.clientHeight):(u.scrollStart=s.scrollTop,u.scrollViewSize+=s.clientHeight),u.scrollMaxSize=s.scrollHeight),n!==null)for(let f=n.previousElementSibling;f!==null;f=f.previousElementSibling)f.classList.contains("q-virtual-scroll--skip")===!1&&(u.offsetStart+=f[c]);if(l!==null)for(let f=l.nextElementSibling;f!==null;f=f.nextElementSibling)f.classList.contains("q-virtual-scroll--skip")===!1&&(u.offsetEnd+=f[c]);if(t!==e){const f=s.getBoundingClientRect(),v=t.getBoundingClientRect();r===!0?(u.offsetStart+=v.left-f.left,u.offsetEnd-=v.width):(u.offsetStart+=v.top-f.top,u.offsetEnd-=v.height),e!==window&&(u.offsetStart+=u.scrollStart),u.offsetEnd+=u.scrollMaxSize-u.offsetStart}return u}function Gu(e,t,n,l){t==="end"&&(t=(e===window?document.body:e)[n===!0?"scrollWidth":"scrollHeight"]),e===window?n===!0?(l===!0&&(t=(_r===!0?document.body.scrollWidth-document.documentElement.clientWidth:0)-t),window.scrollTo(t,window.pageYOffset||window.scrollY||document.body.scrollTop||0)):window.scrollTo(window.pageXOffset||window.scrollX||document.body.scrollLeft||0,t):n===!0?(l===!0&&(t=(_r===!0?e.scrollWidth-e.offsetWidth:0)-t),e.scrollLeft=t):e.scrollTop=t}function Wl(e,t,n,l){if(n>=l)return 0;const r=t.length,o=Math.floor(n/Qt),i=Math.floor((l-1)/Qt)+1;let a=e.slice(o,i).reduce(Nl,0);return n%Qt!==0&&(a-=t.slice(o*Qt,n).reduce(Nl,0)),l%Qt!==0&&l!==r&&(a-=t.slice(l,i*Qt).reduce(Nl,0)),a}const lv={virtualScrollSliceSize:{type:[Number,String],default:10},virtualScrollSliceRatioBefore:{type:[Number,String],default:1},virtualScrollSliceRatioAfter:{type:[Number,String],default:1},virtualScrollItemSize:{type:[Number,String],default:24},virtualScrollStickySizeStart:{type:[Number,String],default:0},virtualScrollStickySizeEnd:{type:[Number,String],default:0},tableColspan:[Number,String]},rv=Object.keys(lv),ca={virtualScrollHorizontal:Boolean,onVirtualScroll:Function,...lv};function ov({virtualScrollLength:e,getVirtualScrollTarget:t,getVirtualScrollEl:n,virtualScrollItemSizeComputed:l}){const r=Ce(),{props:o,emit:i,proxy:a}=r,{$q:s}=a;let c,u,f,v=[],d;const b=ne(0),C=ne(0),E=ne({}),F=ne(null),k=ne(null),m=ne(null),g=ne({from:0,to:0}),L=w(()=>o.tableColspan!==void 0?o.tableColspan:100);l===void 0&&(l=w(()=>o.virtualScrollItemSize));const P=w(()=>l.value+";"+o.virtualScrollHorizontal),A=w(()=>P.value+";"+o.virtualScrollSliceRatioBefore+";"+o.virtualScrollSliceRatioAfter);me(A,()=>{J()}),me(P,I);function I(){te(u,!0)}function S($){te($===void 0?u:$)}function N($,q){const ae=t();if(ae==null||ae.nodeType===8)return;const ge=yi(ae,n(),F.value,k.value,o.virtualScrollHorizontal,s.lang.rtl,o.virtualScrollStickySizeStart,o.virtualScrollStickySizeEnd);f!==ge.scrollViewSize&&J(ge.scrollViewSize),x(ae,ge,Math.min(e.value-1,Math.max(0,parseInt($,10)||0)),0,Bp.indexOf(q)!==-1?q:u!==-1&&$>u?"end":"start")}function U(){const $=t();if($==null||$.nodeType===8)return;const q=yi($,n(),F.value,k.value,o.virtualScrollHorizontal,s.lang.rtl,o.virtualScrollStickySizeStart,o.virtualScrollStickySizeEnd),ae=e.value-1,ge=q.scrollMaxSize-q.offsetStart-q.offsetEnd-C.value;if(c===q.scrollStart)return;if(q.scrollMaxSize<=0){x($,q,0,0);return}f!==q.scrollViewSize&&J(q.scrollViewSize),Q(g.value.from);const ye=Math.floor(q.scrollMaxSize-Math.max(q.scrollViewSize,q.offsetEnd)-Math.min(d[ae],q.scrollViewSize/2));if(ye>0&&Math.ceil(q.scrollStart)>=ye){x($,q,ae,q.scrollMaxSize-q.offsetEnd-v.reduce(Nl,0));return}let Z=0,ie=q.scrollStart-q.offsetStart,xe=ie;if(ie<=ge&&ie+q.scrollViewSize>=b.value)ie-=b.value,Z=g.value.from,xe=ie;else for(let le=0;ie>=v[le]&&Z<ae;le++)ie-=v[le],Z+=Qt;for(;ie>0&&Z<ae;)ie-=d[Z],ie>-q.scrollViewSize?(Z++,xe=ie):xe=d[Z]+ie;x($,q,Z,xe)}function x($,q,ae,ge,ye){const Z=typeof ye=="string"&&ye.indexOf("-force")!==-1,ie=Z===!0?ye.replace("-force",""):ye,xe=ie!==void 0?ie:"start";let le=Math.max(0,ae-E.value[xe]),Pe=le+E.value.total;Pe>e.value&&(Pe=e.value,le=Math.max(0,Pe-E.value.total)),c=q.scrollStart;const $e=le!==g.value.from||Pe!==g.value.to;if($e===!1&&ie===void 0){Y(ae);return}const{activeElement:ut}=document,T=m.value;$e===!0&&T!==null&&T!==ut&&T.contains(ut)===!0&&(T.addEventListener("focusout",B),setTimeout(()=>{T==null||T.removeEventListener("focusout",B)})),Hp(T,ae-le);const p=ie!==void 0?d.slice(le,ae).reduce(Nl,0):0;if($e===!0){const D=Pe>=g.value.from&&le<=g.value.to?g.value.to:Pe;g.value={from:le,to:D},b.value=Wl(v,d,0,le),C.value=Wl(v,d,Pe,e.value),requestAnimationFrame(()=>{g.value.to!==Pe&&c===q.scrollStart&&(g.value={from:g.value.from,to:Pe},C.value=Wl(v,d,Pe,e.value))})}requestAnimationFrame(()=>{if(c!==q.scrollStart)return;$e===!0&&Q(le);const D=d.slice(le,ae).reduce(Nl,0),G=D+q.offsetStart+b.value,K=G+d[ae];let X=G+ge;if(ie!==void 0){const oe=D-p,h=q.scrollStart+oe;X=Z!==!0&&h<G&&K<h+q.scrollViewSize?h:ie==="end"?K-q.scrollViewSize:G-(ie==="start"?0:Math.round((q.scrollViewSize-d[ae])/2))}c=X,Gu($,X,o.virtualScrollHorizontal,s.lang.rtl),Y(ae)})}function Q($){const q=m.value;if(q){const ae=nv.call(q.children,le=>le.classList&&le.classList.contains("q-virtual-scroll--skip")===!1),ge=ae.length,ye=o.virtualScrollHorizontal===!0?le=>le.getBoundingClientRect().width:le=>le.offsetHeight;let Z=$,ie,xe;for(let le=0;le<ge;){for(ie=ye(ae[le]),le++;le<ge&&ae[le].classList.contains("q-virtual-scroll--with-prev")===!0;)ie+=ye(ae[le]),le++;xe=ie-d[Z],xe!==0&&(d[Z]+=xe,v[Math.floor(Z/Qt)]+=xe),Z++}}}function B(){var $;($=m.value)==null||$.focus()}function te($,q){const ae=1*l.value;(q===!0||Array.isArray(d)===!1)&&(d=[]);const ge=d.length;d.length=e.value;for(let Z=e.value-1;Z>=ge;Z--)d[Z]=ae;const ye=Math.floor((e.value-1)/Qt);v=[];for(let Z=0;Z<=ye;Z++){let ie=0;const xe=Math.min((Z+1)*Qt,e.value);for(let le=Z*Qt;le<xe;le++)ie+=d[le];v.push(ie)}u=-1,c=void 0,b.value=Wl(v,d,0,g.value.from),C.value=Wl(v,d,g.value.to,e.value),$>=0?(Q(g.value.from),Ke(()=>{N($)})):se()}function J($){if($===void 0&&typeof window<"u"){const ie=t();ie!=null&&ie.nodeType!==8&&($=yi(ie,n(),F.value,k.value,o.virtualScrollHorizontal,s.lang.rtl,o.virtualScrollStickySizeStart,o.virtualScrollStickySizeEnd).scrollViewSize)}f=$;const q=parseFloat(o.virtualScrollSliceRatioBefore)||0,ae=parseFloat(o.virtualScrollSliceRatioAfter)||0,ge=1+q+ae,ye=$===void 0||$<=0?1:Math.ceil($/l.value),Z=Math.max(1,ye,Math.ceil((o.virtualScrollSliceSize>0?o.virtualScrollSliceSize:10)/ge));E.value={total:Math.ceil(Z*ge),start:Math.ceil(Z*q),center:Math.ceil(Z*(.5+q)),end:Math.ceil(Z*(1+q)),view:ye}}function ee($,q){const ae=o.virtualScrollHorizontal===!0?"width":"height",ge={["--q-virtual-scroll-item-"+ae]:l.value+"px"};return[$==="tbody"?O($,{class:"q-virtual-scroll__padding",key:"before",ref:F},[O("tr",[O("td",{style:{[ae]:`${b.value}px`,...ge},colspan:L.value})])]):O($,{class:"q-virtual-scroll__padding",key:"before",ref:F,style:{[ae]:`${b.value}px`,...ge}}),O($,{class:"q-virtual-scroll__content",key:"content",ref:m,tabindex:-1},q.flat()),$==="tbody"?O($,{class:"q-virtual-scroll__padding",key:"after",ref:k},[O("tr",[O("td",{style:{[ae]:`${C.value}px`,...ge},colspan:L.value})])]):O($,{class:"q-virtual-scroll__padding",key:"after",ref:k,style:{[ae]:`${C.value}px`,...ge}})]}function Y($){u!==$&&(o.onVirtualScroll!==void 0&&i("virtualScroll",{index:$,from:g.value.from,to:g.value.to-1,direction:$<u?"decrease":"increase",ref:a}),u=$)}J();const se=Ya(U,s.platform.is.ios===!0?120:35);Lr(()=>{J()});let he=!1;return dl(()=>{he=!0}),xr(()=>{if(he!==!0)return;const $=t();c!==void 0&&$!==void 0&&$!==null&&$.nodeType!==8?Gu($,c,o.virtualScrollHorizontal,s.lang.rtl):N(u)}),Ge(()=>{se.cancel()}),Object.assign(a,{scrollTo:N,reset:I,refresh:S}),{virtualScrollSliceRange:g,virtualScrollSliceSizeComputed:E,setVirtualScrollSize:J,onVirtualScrollEvt:se,localResetVirtualScroll:te,padVirtualScroll:ee,scrollTo:N,reset:I,refresh:S}}const Up={list:Df,table:Vp},jp=["list","table","__qtable"],Wp=Re({name:"QVirtualScroll",props:{...ca,type:{type:String,default:"list",validator:e=>jp.includes(e)},items:{type:Array,default:()=>[]},itemsFn:Function,itemsSize:Number,scrollTarget:qa},setup(e,{slots:t,attrs:n}){let l;const r=ne(null),o=w(()=>e.itemsSize>=0&&e.itemsFn!==void 0?parseInt(e.itemsSize,10):Array.isArray(e.items)?e.items.length:0),{virtualScrollSliceRange:i,localResetVirtualScroll:a,padVirtualScroll:s,onVirtualScrollEvt:c}=ov({virtualScrollLength:o,getVirtualScrollTarget:b,getVirtualScrollEl:d}),u=w(()=>{if(o.value===0)return[];const k=(m,g)=>({index:i.value.from+g,item:m});return e.itemsFn===void 0?e.items.slice(i.value.from,i.value.to).map(k):e.itemsFn(i.value.from,i.value.to-i.value.from).map(k)}),f=w(()=>"q-virtual-scroll q-virtual-scroll"+(e.virtualScrollHorizontal===!0?"--horizontal":"--vertical")+(e.scrollTarget!==void 0?"":" scroll")),v=w(()=>e.scrollTarget!==void 0?{}:{tabindex:0});me(o,()=>{a()}),me(()=>e.scrollTarget,()=>{E(),C()});function d(){return r.value.$el||r.value}function b(){return l}function C(){l=$a(d(),e.scrollTarget),l.addEventListener("scroll",c,nt.passive)}function E(){l!==void 0&&(l.removeEventListener("scroll",c,nt.passive),l=void 0)}function F(){let k=s(e.type==="list"?"div":"tbody",u.value.map(t.default));return t.before!==void 0&&(k=t.before().concat(k)),Gt(t.after,k)}return Lr(()=>{a()}),gt(()=>{C()}),xr(()=>{C()}),dl(()=>{E()}),Ge(()=>{E()}),()=>{if(t.default===void 0){console.error("QVirtualScroll: default scoped slot is required for rendering");return}return e.type==="__qtable"?tv({ref:r,class:"q-table__middle "+f.value},F()):O(Up[e.type],{...n,ref:r,class:[n.class,f.value],...v.value},F)}}});function zp(e){return e??null}function Xu(e,t){return e??(t===!0?`f_${po()}`:null)}function Kp({getValue:e,required:t=!0}={}){if(cn.value===!0){const n=ne(e!==void 0?zp(e()):null);return t===!0&&n.value===null&&gt(()=>{n.value=`f_${po()}`}),e!==void 0&&me(e,l=>{n.value=Xu(l,t)}),n}return e!==void 0?w(()=>Xu(e(),t)):ne(`f_${po()}`)}const Ju=/^on[A-Z]/;function Yp(){const{attrs:e,vnode:t}=Ce(),n={listeners:ne({}),attributes:ne({})};function l(){const r={},o={};for(const i in e)i!=="class"&&i!=="style"&&Ju.test(i)===!1&&(r[i]=e[i]);for(const i in t.props)Ju.test(i)===!0&&(o[i]=t.props[i]);n.attributes.value=r,n.listeners.value=o}return xa(l),l(),n}function Qp({validate:e,resetValidation:t,requiresQForm:n}){const l=Kt(Gg,!1);if(l!==!1){const{props:r,proxy:o}=Ce();Object.assign(o,{validate:e,resetValidation:t}),me(()=>r.disable,i=>{i===!0?(typeof t=="function"&&t(),l.unbindComponent(o)):l.bindComponent(o)}),gt(()=>{r.disable!==!0&&l.bindComponent(o)}),Ge(()=>{r.disable!==!0&&l.unbindComponent(o)})}else n===!0&&console.error("Parent QForm not found on useFormChild()!")}const Zu=/^#[0-9a-fA-F]{3}([0-9a-fA-F]{3})?$/,ec=/^#[0-9a-fA-F]{4}([0-9a-fA-F]{4})?$/,tc=/^#([0-9a-fA-F]{3}|[0-9a-fA-F]{4}|[0-9a-fA-F]{6}|[0-9a-fA-F]{8})$/,Kr=/^rgb\(((0|[1-9][\d]?|1[\d]{0,2}|2[\d]?|2[0-4][\d]|25[0-5]),){2}(0|[1-9][\d]?|1[\d]{0,2}|2[\d]?|2[0-4][\d]|25[0-5])\)$/,Yr=/^rgba\(((0|[1-9][\d]?|1[\d]{0,2}|2[\d]?|2[0-4][\d]|25[0-5]),){2}(0|[1-9][\d]?|1[\d]{0,2}|2[\d]?|2[0-4][\d]|25[0-5]),(0|0\.[0-9]+[1-9]|0\.[1-9]+|1)\)$/,Si={date:e=>/^-?[\d]+\/[0-1]\d\/[0-3]\d$/.test(e),time:e=>/^([0-1]?\d|2[0-3]):[0-5]\d$/.test(e),fulltime:e=>/^([0-1]?\d|2[0-3]):[0-5]\d:[0-5]\d$/.test(e),timeOrFulltime:e=>/^([0-1]?\d|2[0-3]):[0-5]\d(:[0-5]\d)?$/.test(e),email:e=>/^(([^<>()\[\]\\.,;:\s@"]+(\.[^<>()\[\]\\.,;:\s@"]+)*)|(".+"))@((\[[0-9]{1,3}\.[0-9]{1,3}\.[0-9]{1,3}\.[0-9]{1,3}])|(([a-zA-Z\-0-9]+\.)+[a-zA-Z]{2,}))$/.test(e),hexColor:e=>Zu.test(e),hexaColor:e=>ec.test(e),hexOrHexaColor:e=>tc.test(e),rgbColor:e=>Kr.test(e),rgbaColor:e=>Yr.test(e),rgbOrRgbaColor:e=>Kr.test(e)||Yr.test(e),hexOrRgbColor:e=>Zu.test(e)||Kr.test(e),hexaOrRgbaColor:e=>ec.test(e)||Yr.test(e),anyColor:e=>tc.test(e)||Kr.test(e)||Yr.test(e)},Gp=[!0,!1,"ondemand"],Xp={modelValue:{},error:{type:Boolean,default:null},errorMessage:String,noErrorIcon:Boolean,rules:Array,reactiveRules:Boolean,lazyRules:{type:[Boolean,String],default:!1,validator:e=>Gp.includes(e)}};function Jp(e,t){const{props:n,proxy:l}=Ce(),r=ne(!1),o=ne(null),i=ne(!1);Qp({validate:C,resetValidation:b});let a=0,s;const c=w(()=>n.rules!==void 0&&n.rules!==null&&n.rules.length!==0),u=w(()=>n.disable!==!0&&c.value===!0&&t.value===!1),f=w(()=>n.error===!0||r.value===!0),v=w(()=>typeof n.errorMessage=="string"&&n.errorMessage.length!==0?n.errorMessage:o.value);me(()=>n.modelValue,()=>{i.value=!0,u.value===!0&&n.lazyRules===!1&&E()});function d(){n.lazyRules!=="ondemand"&&u.value===!0&&i.value===!0&&E()}me(()=>n.reactiveRules,F=>{F===!0?s===void 0&&(s=me(()=>n.rules,d,{immediate:!0,deep:!0})):s!==void 0&&(s(),s=void 0)},{immediate:!0}),me(()=>n.lazyRules,d),me(e,F=>{F===!0?i.value=!0:u.value===!0&&n.lazyRules!=="ondemand"&&E()});function b(){a++,t.value=!1,i.value=!1,r.value=!1,o.value=null,E.cancel()}function C(F=n.modelValue){if(n.disable===!0||c.value===!1)return!0;const k=++a,m=t.value!==!0?()=>{i.value=!0}:()=>{},g=(P,A)=>{P===!0&&m(),r.value=P,o.value=A||null,t.value=!1},L=[];for(let P=0;P<n.rules.length;P++){const A=n.rules[P];let I;if(typeof A=="function"?I=A(F,Si):typeof A=="string"&&Si[A]!==void 0&&(I=Si[A](F)),I===!1||typeof I=="string")return g(!0,I),!1;I!==!0&&I!==void 0&&L.push(I)}return L.length===0?(g(!1),!0):(t.value=!0,Promise.all(L).then(P=>{if(P===void 0||Array.isArray(P)===!1||P.length===0)return k===a&&g(!1),!0;const A=P.find(I=>I===!1||typeof I=="string");return k===a&&g(A!==void 0,A),A===void 0},P=>(k===a&&(console.error(P),g(!0)),!1)))}const E=Ya(C,0);return Ge(()=>{s==null||s(),E.cancel()}),Object.assign(l,{resetValidation:b,validate:C}),Wt(l,"hasError",()=>f.value),{isDirtyModel:i,hasRules:c,hasError:f,errorMessage:v,validate:C,resetValidation:b}}function wr(e){return e!=null&&(""+e).length!==0}const Zp={...$t,...Xp,label:String,stackLabel:Boolean,hint:String,hideHint:Boolean,prefix:String,suffix:String,labelColor:String,color:String,bgColor:String,filled:Boolean,outlined:Boolean,borderless:Boolean,standout:[Boolean,String],square:Boolean,loading:Boolean,labelSlot:Boolean,bottomSlots:Boolean,hideBottomSpace:Boolean,rounded:Boolean,dense:Boolean,itemAligned:Boolean,counter:Boolean,clearable:Boolean,clearIcon:String,disable:Boolean,readonly:Boolean,autofocus:Boolean,for:String},jo={...Zp,maxlength:[Number,String]},Qa=["update:modelValue","clear","focus","blur"];function Ga({requiredForAttr:e=!0,tagProp:t,changeEvent:n=!1}={}){const{props:l,proxy:r}=Ce(),o=Dt(l,r.$q),i=Kp({required:e,getValue:()=>l.for});return{requiredForAttr:e,changeEvent:n,tag:t===!0?w(()=>l.tag):{value:"label"},isDark:o,editable:w(()=>l.disable!==!0&&l.readonly!==!0),innerLoading:ne(!1),focused:ne(!1),hasPopupOpen:!1,splitAttrs:Yp(),targetUid:i,rootRef:ne(null),targetRef:ne(null),controlRef:ne(null)}}function Xa(e){const{props:t,emit:n,slots:l,attrs:r,proxy:o}=Ce(),{$q:i}=o;let a=null;e.hasValue===void 0&&(e.hasValue=w(()=>wr(t.modelValue))),e.emitValue===void 0&&(e.emitValue=Y=>{n("update:modelValue",Y)}),e.controlEvents===void 0&&(e.controlEvents={onFocusin:S,onFocusout:N}),Object.assign(e,{clearValue:U,onControlFocusin:S,onControlFocusout:N,focus:A}),e.computedCounter===void 0&&(e.computedCounter=w(()=>{if(t.counter!==!1){const Y=typeof t.modelValue=="string"||typeof t.modelValue=="number"?(""+t.modelValue).length:Array.isArray(t.modelValue)===!0?t.modelValue.length:0,se=t.maxlength!==void 0?t.maxlength:t.maxValues;return Y+(se!==void 0?" / "+se:"")}}));const{isDirtyModel:s,hasRules:c,hasError:u,errorMessage:f,resetValidation:v}=Jp(e.focused,e.innerLoading),d=e.floatingLabel!==void 0?w(()=>t.stackLabel===!0||e.focused.value===!0||e.floatingLabel.value===!0):w(()=>t.stackLabel===!0||e.focused.value===!0||e.hasValue.value===!0),b=w(()=>t.bottomSlots===!0||t.hint!==void 0||c.value===!0||t.counter===!0||t.error!==null),C=w(()=>t.filled===!0?"filled":t.outlined===!0?"outlined":t.borderless===!0?"borderless":t.standout?"standout":"standard"),E=w(()=>`q-field row no-wrap items-start q-field--${C.value}`+(e.fieldClass!==void 0?` ${e.fieldClass.value}`:"")+(t.rounded===!0?" q-field--rounded":"")+(t.square===!0?" q-field--square":"")+(d.value===!0?" q-field--float":"")+(k.value===!0?" q-field--labeled":"")+(t.dense===!0?" q-field--dense":"")+(t.itemAligned===!0?" q-field--item-aligned q-item-type":"")+(e.isDark.value===!0?" q-field--dark":"")+(e.getControl===void 0?" q-field--auto-height":"")+(e.focused.value===!0?" q-field--focused":"")+(u.value===!0?" q-field--error":"")+(u.value===!0||e.focused.value===!0?" q-field--highlighted":"")+(t.hideBottomSpace!==!0&&b.value===!0?" q-field--with-bottom":"")+(t.disable===!0?" q-field--disabled":t.readonly===!0?" q-field--readonly":"")),F=w(()=>"q-field__control relative-position row no-wrap"+(t.bgColor!==void 0?` bg-${t.bgColor}`:"")+(u.value===!0?" text-negative":typeof t.standout=="string"&&t.standout.length!==0&&e.focused.value===!0?` ${t.standout}`:t.color!==void 0?` text-${t.color}`:"")),k=w(()=>t.labelSlot===!0||t.label!==void 0),m=w(()=>"q-field__label no-pointer-events absolute ellipsis"+(t.labelColor!==void 0&&u.value!==!0?` text-${t.labelColor}`:"")),g=w(()=>({id:e.targetUid.value,editable:e.editable.value,focused:e.focused.value,floatingLabel:d.value,modelValue:t.modelValue,emitValue:e.emitValue})),L=w(()=>{const Y={};return e.targetUid.value&&(Y.for=e.targetUid.value),t.disable===!0&&(Y["aria-disabled"]="true"),Y});function P(){var he;const Y=document.activeElement;let se=(he=e.targetRef)==null?void 0:he.value;se&&(Y===null||Y.id!==e.targetUid.value)&&(se.hasAttribute("tabindex")===!0||(se=se.querySelector("[tabindex]")),se!==Y&&(se==null||se.focus({preventScroll:!0})))}function A(){qo(P)}function I(){hg(P);const Y=document.activeElement;Y!==null&&e.rootRef.value.contains(Y)&&Y.blur()}function S(Y){a!==null&&(clearTimeout(a),a=null),e.editable.value===!0&&e.focused.value===!1&&(e.focused.value=!0,n("focus",Y))}function N(Y,se){a!==null&&clearTimeout(a),a=setTimeout(()=>{a=null,!(document.hasFocus()===!0&&(e.hasPopupOpen===!0||e.controlRef===void 0||e.controlRef.value===null||e.controlRef.value.contains(document.activeElement)!==!1))&&(e.focused.value===!0&&(e.focused.value=!1,n("blur",Y)),se==null||se())})}function U(Y){var se;Ze(Y),i.platform.is.mobile!==!0?(((se=e.targetRef)==null?void 0:se.value)||e.rootRef.value).focus():e.rootRef.value.contains(document.activeElement)===!0&&document.activeElement.blur(),t.type==="file"&&(e.inputRef.value.value=null),n("update:modelValue",null),e.changeEvent===!0&&n("change",null),n("clear",t.modelValue),Ke(()=>{const he=s.value;v(),s.value=he})}function x(Y){[13,32].includes(Y.keyCode)&&U(Y)}function Q(){const Y=[];return l.prepend!==void 0&&Y.push(O("div",{class:"q-field__prepend q-field__marginal row no-wrap items-center",key:"prepend",onClick:Ft},l.prepend())),Y.push(O("div",{class:"q-field__control-container col relative-position row no-wrap q-anchor--skip"},B())),u.value===!0&&t.noErrorIcon===!1&&Y.push(J("error",[O(xt,{name:i.iconSet.field.error,color:"negative"})])),t.loading===!0||e.innerLoading.value===!0?Y.push(J("inner-loading-append",l.loading!==void 0?l.loading():[O(id,{color:t.color})])):t.clearable===!0&&e.hasValue.value===!0&&e.editable.value===!0&&Y.push(J("inner-clearable-append",[O(xt,{class:"q-field__focusable-action",name:t.clearIcon||i.iconSet.field.clear,tabindex:0,role:"button","aria-hidden":"false","aria-label":i.lang.label.clear,onKeyup:x,onClick:U})])),l.append!==void 0&&Y.push(O("div",{class:"q-field__append q-field__marginal row no-wrap items-center",key:"append",onClick:Ft},l.append())),e.getInnerAppend!==void 0&&Y.push(J("inner-append",e.getInnerAppend())),e.getControlChild!==void 0&&Y.push(e.getControlChild()),Y}function B(){const Y=[];return t.prefix!==void 0&&t.prefix!==null&&Y.push(O("div",{class:"q-field__prefix no-pointer-events row items-center"},t.prefix)),e.getShadowControl!==void 0&&e.hasShadow.value===!0&&Y.push(e.getShadowControl()),e.getControl!==void 0?Y.push(e.getControl()):l.rawControl!==void 0?Y.push(l.rawControl()):l.control!==void 0&&Y.push(O("div",{ref:e.targetRef,class:"q-field__native row",tabindex:-1,...e.splitAttrs.attributes.value,"data-autofocus":t.autofocus===!0||void 0},l.control(g.value))),k.value===!0&&Y.push(O("div",{class:m.value},Ve(l.label,t.label))),t.suffix!==void 0&&t.suffix!==null&&Y.push(O("div",{class:"q-field__suffix no-pointer-events row items-center"},t.suffix)),Y.concat(Ve(l.default))}function te(){let Y,se;u.value===!0?f.value!==null?(Y=[O("div",{role:"alert"},f.value)],se=`q--slot-error-${f.value}`):(Y=Ve(l.error),se="q--slot-error"):(t.hideHint!==!0||e.focused.value===!0)&&(t.hint!==void 0?(Y=[O("div",t.hint)],se=`q--slot-hint-${t.hint}`):(Y=Ve(l.hint),se="q--slot-hint"));const he=t.counter===!0||l.counter!==void 0;if(t.hideBottomSpace===!0&&he===!1&&Y===void 0)return;const $=O("div",{key:se,class:"q-field__messages col"},Y);return O("div",{class:"q-field__bottom row items-start q-field__bottom--"+(t.hideBottomSpace!==!0?"animated":"stale"),onClick:Ft},[t.hideBottomSpace===!0?$:O(ql,{name:"q-transition--field-message"},()=>$),he===!0?O("div",{class:"q-field__counter"},l.counter!==void 0?l.counter():e.computedCounter.value):null])}function J(Y,se){return se===null?null:O("div",{key:Y,class:"q-field__append q-field__marginal row no-wrap items-center q-anchor--skip"},se)}let ee=!1;return dl(()=>{ee=!0}),xr(()=>{ee===!0&&t.autofocus===!0&&o.focus()}),t.autofocus===!0&&gt(()=>{o.focus()}),Ge(()=>{a!==null&&clearTimeout(a)}),Object.assign(o,{focus:A,blur:I}),function(){const se=e.getControl===void 0&&l.control===void 0?{...e.splitAttrs.attributes.value,"data-autofocus":t.autofocus===!0||void 0,...L.value}:L.value;return O(e.tag.value,{ref:e.rootRef,class:[E.value,r.class],style:r.style,...se},[l.before!==void 0?O("div",{class:"q-field__before q-field__marginal row no-wrap items-center",onClick:Ft},l.before()):null,O("div",{class:"q-field__inner relative-position col self-stretch"},[O("div",{ref:e.controlRef,class:F.value,tabindex:-1,...e.controlEvents},Q()),b.value===!0?te():null]),l.after!==void 0?O("div",{class:"q-field__after q-field__marginal row no-wrap items-center",onClick:Ft},l.after()):null])}}const ey=Re({name:"QField",inheritAttrs:!1,props:{...jo,tag:{type:String,default:"label"}},emits:Qa,setup(){return Xa(Ga({tagProp:!0}))}}),ty={xs:8,sm:10,md:14,lg:20,xl:24},ny=Re({name:"QChip",props:{...$t,...Ir,dense:Boolean,icon:String,iconRight:String,iconRemove:String,iconSelected:String,label:[String,Number],color:String,textColor:String,modelValue:{type:Boolean,default:!0},selected:{type:Boolean,default:null},square:Boolean,outline:Boolean,clickable:Boolean,removable:Boolean,removeAriaLabel:String,tabindex:[String,Number],disable:Boolean,ripple:{type:[Boolean,Object],default:!0}},emits:["update:modelValue","update:selected","remove","click"],setup(e,{slots:t,emit:n}){const{proxy:{$q:l}}=Ce(),r=Dt(e,l),o=Rr(e,ty),i=w(()=>e.selected===!0||e.icon!==void 0),a=w(()=>e.selected===!0?e.iconSelected||l.iconSet.chip.selected:e.icon),s=w(()=>e.iconRemove||l.iconSet.chip.remove),c=w(()=>e.disable===!1&&(e.clickable===!0||e.selected!==null)),u=w(()=>{const E=e.outline===!0&&e.color||e.textColor;return"q-chip row inline no-wrap items-center"+(e.outline===!1&&e.color!==void 0?` bg-${e.color}`:"")+(E?` text-${E} q-chip--colored`:"")+(e.disable===!0?" disabled":"")+(e.dense===!0?" q-chip--dense":"")+(e.outline===!0?" q-chip--outline":"")+(e.selected===!0?" q-chip--selected":"")+(c.value===!0?" q-chip--clickable cursor-pointer non-selectable q-hoverable":"")+(e.square===!0?" q-chip--square":"")+(r.value===!0?" q-chip--dark q-dark":"")}),f=w(()=>{const E=e.disable===!0?{tabindex:-1,"aria-disabled":"true"}:{tabindex:e.tabindex||0},F={...E,role:"button","aria-hidden":"false","aria-label":e.removeAriaLabel||l.lang.label.remove};return{chip:E,remove:F}});function v(E){E.keyCode===13&&d(E)}function d(E){e.disable||(n("update:selected",!e.selected),n("click",E))}function b(E){(E.keyCode===void 0||E.keyCode===13)&&(Ze(E),e.disable===!1&&(n("update:modelValue",!1),n("remove")))}function C(){const E=[];c.value===!0&&E.push(O("div",{class:"q-focus-helper"})),i.value===!0&&E.push(O(xt,{class:"q-chip__icon q-chip__icon--left",name:a.value}));const F=e.label!==void 0?[O("div",{class:"ellipsis"},[e.label])]:void 0;return E.push(O("div",{class:"q-chip__content col row no-wrap items-center q-anchor--skip"},Hh(t.default,F))),e.iconRight&&E.push(O(xt,{class:"q-chip__icon q-chip__icon--right",name:e.iconRight})),e.removable===!0&&E.push(O(xt,{class:"q-chip__icon q-chip__icon--remove cursor-pointer",name:s.value,...f.value.remove,onClick:b,onKeyup:b})),E}return()=>{if(e.modelValue===!1)return;const E={class:u.value,style:o.value};return c.value===!0&&Object.assign(E,f.value.chip,{onClick:d,onKeyup:v}),Af("div",E,C(),"ripple",e.ripple!==!1&&e.disable!==!0,()=>[[Da,e.ripple]])}}}),ly=Re({name:"QItemLabel",props:{overline:Boolean,caption:Boolean,header:Boolean,lines:[Number,String]},setup(e,{slots:t}){const n=w(()=>parseInt(e.lines,10)),l=w(()=>"q-item__label"+(e.overline===!0?" q-item__label--overline text-overline":"")+(e.caption===!0?" q-item__label--caption text-caption":"")+(e.header===!0?" q-item__label--header":"")+(n.value===1?" ellipsis":"")),r=w(()=>e.lines!==void 0&&n.value>1?{overflow:"hidden",display:"-webkit-box","-webkit-box-orient":"vertical","-webkit-line-clamp":n.value}:null);return()=>O("div",{style:r.value,class:l.value},Ve(t.default))}}),iv=()=>!0;function ry(e){return typeof e=="string"&&e!==""&&e!=="/"&&e!=="#/"}function oy(e){return e.startsWith("#")===!0&&(e=e.substring(1)),e.startsWith("/")===!1&&(e="/"+e),e.endsWith("/")===!0&&(e=e.substring(0,e.length-1)),"#"+e}function iy(e){if(e.backButtonExit===!1)return()=>!1;if(e.backButtonExit==="*")return iv;const t=["#/"];return Array.isArray(e.backButtonExit)===!0&&t.push(...e.backButtonExit.filter(ry).map(oy)),()=>t.includes(window.location.hash)}const Cr={__history:[],add:En,remove:En,install({$q:e}){if(this.__installed===!0)return;const{cordova:t,capacitor:n}=Ye.is;if(t!==!0&&n!==!0)return;const l=e.config[t===!0?"cordova":"capacitor"];if((l==null?void 0:l.backButton)===!1||n===!0&&(window.Capacitor===void 0||window.Capacitor.Plugins.App===void 0))return;this.add=i=>{i.condition===void 0&&(i.condition=iv),this.__history.push(i)},this.remove=i=>{const a=this.__history.indexOf(i);a>=0&&this.__history.splice(a,1)};const r=iy(Object.assign({backButtonExit:!0},l)),o=()=>{if(this.__history.length){const i=this.__history[this.__history.length-1];i.condition()===!0&&(this.__history.pop(),i.handler())}else r()===!0?navigator.app.exitApp():window.history.back()};t===!0?document.addEventListener("deviceready",()=>{document.addEventListener("backbutton",o,!1)}):window.Capacitor.Plugins.App.addListener("backButton",o)}};function ay(e,t,n){let l;function r(){l!==void 0&&(Cr.remove(l),l=void 0)}return Ge(()=>{e.value===!0&&r()}),{removeFromHistory:r,addToHistory(){l={condition:()=>n.value===!0,handler:t},Cr.add(l)}}}let zl=0,wi,Ci,Xl,Ei=!1,nc,lc,rc,Xn=null;function sy(e){uy(e)&&Ze(e)}function uy(e){if(e.target===document.body||e.target.classList.contains("q-layout__backdrop"))return!0;const t=lg(e),n=e.shiftKey&&!e.deltaX,l=!n&&Math.abs(e.deltaX)<=Math.abs(e.deltaY),r=n||l?e.deltaY:e.deltaX;for(let o=0;o<t.length;o++){const i=t[o];if(xg(i,l))return l?r<0&&i.scrollTop===0?!0:r>0&&i.scrollTop+i.clientHeight===i.scrollHeight:r<0&&i.scrollLeft===0?!0:r>0&&i.scrollLeft+i.clientWidth===i.scrollWidth}return!0}function oc(e){e.target===document&&(document.scrollingElement.scrollTop=document.scrollingElement.scrollTop)}function Qr(e){Ei!==!0&&(Ei=!0,requestAnimationFrame(()=>{Ei=!1;const{height:t}=e.target,{clientHeight:n,scrollTop:l}=document.scrollingElement;(Xl===void 0||t!==window.innerHeight)&&(Xl=n-t,document.scrollingElement.scrollTop=l),l>Xl&&(document.scrollingElement.scrollTop-=Math.ceil((l-Xl)/8))}))}function ic(e){const t=document.body,n=window.visualViewport!==void 0;if(e==="add"){const{overflowY:l,overflowX:r}=window.getComputedStyle(t);wi=Zf(window),Ci=Jf(window),nc=t.style.left,lc=t.style.top,rc=window.location.href,t.style.left=`-${wi}px`,t.style.top=`-${Ci}px`,r!=="hidden"&&(r==="scroll"||t.scrollWidth>window.innerWidth)&&t.classList.add("q-body--force-scrollbar-x"),l!=="hidden"&&(l==="scroll"||t.scrollHeight>window.innerHeight)&&t.classList.add("q-body--force-scrollbar-y"),t.classList.add("q-body--prevent-scroll"),document.qScrollPrevented=!0,Ye.is.ios===!0&&(n===!0?(window.scrollTo(0,0),window.visualViewport.addEventListener("resize",Qr,nt.passiveCapture),window.visualViewport.addEventListener("scroll",Qr,nt.passiveCapture),window.scrollTo(0,0)):window.addEventListener("scroll",oc,nt.passiveCapture))}Ye.is.desktop===!0&&Ye.is.mac===!0&&window[`${e}EventListener`]("wheel",sy,nt.notPassive),e==="remove"&&(Ye.is.ios===!0&&(n===!0?(window.visualViewport.removeEventListener("resize",Qr,nt.passiveCapture),window.visualViewport.removeEventListener("scroll",Qr,nt.passiveCapture)):window.removeEventListener("scroll",oc,nt.passiveCapture)),t.classList.remove("q-body--prevent-scroll"),t.classList.remove("q-body--force-scrollbar-x"),t.classList.remove("q-body--force-scrollbar-y"),document.qScrollPrevented=!1,t.style.left=nc,t.style.top=lc,window.location.href===rc&&window.scrollTo(wi,Ci),Xl=void 0)}function cy(e){let t="add";if(e===!0){if(zl++,Xn!==null){clearTimeout(Xn),Xn=null;return}if(zl>1)return}else{if(zl===0||(zl--,zl>0))return;if(t="remove",Ye.is.ios===!0&&Ye.is.nativeMobile===!0){Xn!==null&&clearTimeout(Xn),Xn=setTimeout(()=>{ic(t),Xn=null},100);return}}ic(t)}function fy(){let e;return{preventBodyScroll(t){t!==e&&(e!==void 0||t===!0)&&(e=t,cy(t))}}}let Gr=0;const dy={standard:"fixed-full flex-center",top:"fixed-top justify-center",bottom:"fixed-bottom justify-center",right:"fixed-right items-center",left:"fixed-left items-center"},ac={standard:["scale","scale"],top:["slide-down","slide-up"],bottom:["slide-up","slide-down"],right:["slide-left","slide-right"],left:["slide-right","slide-left"]},av=Re({name:"QDialog",inheritAttrs:!1,props:{...Hf,...Qf,transitionShow:String,transitionHide:String,persistent:Boolean,autoClose:Boolean,allowFocusOutside:Boolean,noEscDismiss:Boolean,noBackdropDismiss:Boolean,noRouteDismiss:Boolean,noRefocus:Boolean,noFocus:Boolean,noShake:Boolean,seamless:Boolean,maximized:Boolean,fullWidth:Boolean,fullHeight:Boolean,square:Boolean,backdropFilter:String,position:{type:String,default:"standard",validator:e=>["standard","top","bottom","left","right"].includes(e)}},emits:[...Uf,"shake","click","escapeKey"],setup(e,{slots:t,emit:n,attrs:l}){const r=Ce(),o=ne(null),i=ne(!1),a=ne(!1);let s=null,c=null,u,f;const v=w(()=>e.persistent!==!0&&e.noRouteDismiss!==!0&&e.seamless!==!0),{preventBodyScroll:d}=fy(),{registerTimeout:b}=br(),{registerTick:C,removeTick:E}=ar(),{transitionProps:F,transitionStyle:k}=Gf(e,()=>ac[e.position][0],()=>ac[e.position][1]),m=w(()=>k.value+(e.backdropFilter!==void 0?`;backdrop-filter:${e.backdropFilter};-webkit-backdrop-filter:${e.backdropFilter}`:"")),{showPortal:g,hidePortal:L,portalIsAccessible:P,renderPortal:A}=Yf(r,o,ye,"dialog"),{hide:I}=jf({showing:i,hideOnRouteChange:v,handleShow:te,handleHide:J,processOnMount:!0}),{addToHistory:S,removeFromHistory:N}=ay(i,I,v),U=w(()=>`q-dialog__inner flex no-pointer-events q-dialog__inner--${e.maximized===!0?"maximized":"minimized"} q-dialog__inner--${e.position} ${dy[e.position]}`+(a.value===!0?" q-dialog__inner--animating":"")+(e.fullWidth===!0?" q-dialog__inner--fullwidth":"")+(e.fullHeight===!0?" q-dialog__inner--fullheight":"")+(e.square===!0?" q-dialog__inner--square":"")),x=w(()=>i.value===!0&&e.seamless!==!0),Q=w(()=>e.autoClose===!0?{onClick:q}:{}),B=w(()=>[`q-dialog fullscreen no-pointer-events q-dialog--${x.value===!0?"modal":"seamless"}`,l.class]);me(()=>e.maximized,Z=>{i.value===!0&&$(Z)}),me(x,Z=>{d(Z),Z===!0?(ld(ge),td(se)):(Ki(ge),go(se))});function te(Z){var ie;S(),c=e.noRefocus===!1&&document.activeElement!==null?document.activeElement:null,$(e.maximized),g(),a.value=!0,e.noFocus!==!0?((ie=document.activeElement)==null||ie.blur(),C(ee)):E(),b(()=>{if(r.proxy.$q.platform.is.ios===!0){if(e.seamless!==!0&&document.activeElement){const{top:xe,bottom:le}=document.activeElement.getBoundingClientRect(),{innerHeight:Pe}=window,$e=window.visualViewport!==void 0?window.visualViewport.height:Pe;xe>0&&le>$e/2&&(document.scrollingElement.scrollTop=Math.min(document.scrollingElement.scrollHeight-$e,le>=Pe?1/0:Math.ceil(document.scrollingElement.scrollTop+le-$e/2))),document.activeElement.scrollIntoView()}f=!0,o.value.click(),f=!1}g(!0),a.value=!1,n("show",Z)},e.transitionDuration)}function J(Z){E(),N(),he(!0),a.value=!0,L(),c!==null&&((((Z==null?void 0:Z.type.indexOf("key"))===0?c.closest('[tabindex]:not([tabindex^="-"])'):void 0)||c).focus(),c=null),b(()=>{L(!0),a.value=!1,n("hide",Z)},e.transitionDuration)}function ee(Z){qo(()=>{let ie=o.value;if(ie!==null){if(Z!==void 0){const xe=ie.querySelector(Z);if(xe!==null){xe.focus({preventScroll:!0});return}}ie.contains(document.activeElement)!==!0&&(ie=ie.querySelector("[autofocus][tabindex], [data-autofocus][tabindex]")||ie.querySelector("[autofocus] [tabindex], [data-autofocus] [tabindex]")||ie.querySelector("[autofocus], [data-autofocus]")||ie,ie.focus({preventScroll:!0}))}})}function Y(Z){Z&&typeof Z.focus=="function"?Z.focus({preventScroll:!0}):ee(),n("shake");const ie=o.value;ie!==null&&(ie.classList.remove("q-animate--scale"),ie.classList.add("q-animate--scale"),s!==null&&clearTimeout(s),s=setTimeout(()=>{s=null,o.value!==null&&(ie.classList.remove("q-animate--scale"),ee())},170))}function se(){e.seamless!==!0&&(e.persistent===!0||e.noEscDismiss===!0?e.maximized!==!0&&e.noShake!==!0&&Y():(n("escapeKey"),I()))}function he(Z){s!==null&&(clearTimeout(s),s=null),(Z===!0||i.value===!0)&&($(!1),e.seamless!==!0&&(d(!1),Ki(ge),go(se))),Z!==!0&&(c=null)}function $(Z){Z===!0?u!==!0&&(Gr<1&&document.body.classList.add("q-body--dialog"),Gr++,u=!0):u===!0&&(Gr<2&&document.body.classList.remove("q-body--dialog"),Gr--,u=!1)}function q(Z){f!==!0&&(I(Z),n("click",Z))}function ae(Z){e.persistent!==!0&&e.noBackdropDismiss!==!0?I(Z):e.noShake!==!0&&Y()}function ge(Z){e.allowFocusOutside!==!0&&P.value===!0&&Xf(o.value,Z.target)!==!0&&ee('[tabindex]:not([tabindex="-1"])')}Object.assign(r.proxy,{focus:ee,shake:Y,__updateRefocusTarget(Z){c=Z||null}}),Ge(he);function ye(){return O("div",{role:"dialog","aria-modal":x.value===!0?"true":"false",...l,class:B.value},[O(ql,{name:"q-transition--fade",appear:!0},()=>x.value===!0?O("div",{class:"q-dialog__backdrop fixed-full",style:m.value,"aria-hidden":"true",tabindex:-1,onClick:ae}):null),O(ql,F.value,()=>i.value===!0?O("div",{ref:o,class:U.value,style:k.value,tabindex:-1,...Q.value},Ve(t.default)):null)])}return A}}),Ja={name:String};function vy(e={}){return(t,n,l)=>{t[n](O("input",{class:"hidden"+(l||""),...e.value}))}}function sv(e){return w(()=>e.name||e.for)}const my=/[\u3000-\u303f\u3040-\u309f\u30a0-\u30ff\uff00-\uff9f\u4e00-\u9faf\u3400-\u4dbf]/,hy=/[\u4e00-\u9fff\u3400-\u4dbf\u{20000}-\u{2a6df}\u{2a700}-\u{2b73f}\u{2b740}-\u{2b81f}\u{2b820}-\u{2ceaf}\uf900-\ufaff\u3300-\u33ff\ufe30-\ufe4f\uf900-\ufaff\u{2f800}-\u{2fa1f}]/u,gy=/[\u3131-\u314e\u314f-\u3163\uac00-\ud7a3]/,by=/[a-z0-9_ -]$/i;function uv(e){return function(n){if(n.type==="compositionend"||n.type==="change"){if(n.target.qComposing!==!0)return;n.target.qComposing=!1,e(n)}else n.type==="compositionupdate"&&n.target.qComposing!==!0&&typeof n.data=="string"&&(Ye.is.firefox===!0?by.test(n.data)===!1:my.test(n.data)===!0||hy.test(n.data)===!0||gy.test(n.data)===!0)===!0&&(n.target.qComposing=!0)}}function sc(e,t,n){if(n<=t)return t;const l=n-t+1;let r=t+(e-t)%l;return r<t&&(r=l+r),r===0?0:r}const uc=e=>["add","add-unique","toggle"].includes(e),_y=".*+?^${}()|[]\\",py=Object.keys(jo);function Ti(e,t){if(typeof e=="function")return e;const n=e!==void 0?e:t;return l=>l!==null&&typeof l=="object"&&n in l?l[n]:l}const yy=Re({name:"QSelect",inheritAttrs:!1,props:{...ca,...Ja,...jo,modelValue:{required:!0},multiple:Boolean,displayValue:[String,Number],displayValueHtml:Boolean,dropdownIcon:String,options:{type:Array,default:()=>[]},optionValue:[Function,String],optionLabel:[Function,String],optionDisable:[Function,String],hideSelected:Boolean,hideDropdownIcon:Boolean,fillInput:Boolean,maxValues:[Number,String],optionsDense:Boolean,optionsDark:{type:Boolean,default:null},optionsSelectedClass:String,optionsHtml:Boolean,optionsCover:Boolean,menuShrink:Boolean,menuAnchor:String,menuSelf:String,menuOffset:Array,popupContentClass:String,popupContentStyle:[String,Array,Object],popupNoRouteDismiss:Boolean,useInput:Boolean,useChips:Boolean,newValueMode:{type:String,validator:uc},mapOptions:Boolean,emitValue:Boolean,disableTabSelection:Boolean,inputDebounce:{type:[Number,String],default:500},inputClass:[Array,String,Object],inputStyle:[Array,String,Object],tabindex:{type:[String,Number],default:0},autocomplete:String,transitionShow:{},transitionHide:{},transitionDuration:{},behavior:{type:String,validator:e=>["default","menu","dialog"].includes(e),default:"default"},virtualScrollItemSize:ca.virtualScrollItemSize.type,onNewValue:Function,onFilter:Function},emits:[...Qa,"add","remove","inputValue","keyup","keypress","keydown","popupShow","popupHide","filterAbort"],setup(e,{slots:t,emit:n}){const{proxy:l}=Ce(),{$q:r}=l,o=ne(!1),i=ne(!1),a=ne(-1),s=ne(""),c=ne(!1),u=ne(!1);let f=null,v=null,d,b,C,E=null,F,k,m,g;const L=ne(null),P=ne(null),A=ne(null),I=ne(null),S=ne(null),N=sv(e),U=uv(W),x=w(()=>Array.isArray(e.options)?e.options.length:0),Q=w(()=>e.virtualScrollItemSize===void 0?e.optionsDense===!0?24:48:e.virtualScrollItemSize),{virtualScrollSliceRange:B,virtualScrollSliceSizeComputed:te,localResetVirtualScroll:J,padVirtualScroll:ee,onVirtualScrollEvt:Y,scrollTo:se,setVirtualScrollSize:he}=ov({virtualScrollLength:x,getVirtualScrollTarget:Wo,getVirtualScrollEl:Mr,virtualScrollItemSizeComputed:Q}),$=Ga(),q=w(()=>{const R=e.mapOptions===!0&&e.multiple!==!0,fe=e.modelValue!==void 0&&(e.modelValue!==null||R===!0)?e.multiple===!0&&Array.isArray(e.modelValue)?e.modelValue:[e.modelValue]:[];if(e.mapOptions===!0&&Array.isArray(e.options)===!0){const ve=e.mapOptions===!0&&d!==void 0?d:[],Ne=fe.map(Ue=>ot(Ue,ve));return e.modelValue===null&&R===!0?Ne.filter(Ue=>Ue!==null):Ne}return fe}),ae=w(()=>{const R={};return py.forEach(fe=>{const ve=e[fe];ve!==void 0&&(R[fe]=ve)}),R}),ge=w(()=>e.optionsDark===null?$.isDark.value:e.optionsDark),ye=w(()=>wr(q.value)),Z=w(()=>{let R="q-field__input q-placeholder col";return e.hideSelected===!0||q.value.length===0?[R,e.inputClass]:(R+=" q-field__input--padding",e.inputClass===void 0?R:[R,e.inputClass])}),ie=w(()=>(e.virtualScrollHorizontal===!0?"q-virtual-scroll--horizontal":"")+(e.popupContentClass?" "+e.popupContentClass:"")),xe=w(()=>x.value===0),le=w(()=>q.value.map(R=>y.value(R)).join(", ")),Pe=w(()=>e.displayValue!==void 0?e.displayValue:le.value),$e=w(()=>e.optionsHtml===!0?()=>!0:R=>(R==null?void 0:R.html)===!0),ut=w(()=>e.displayValueHtml===!0||e.displayValue===void 0&&(e.optionsHtml===!0||q.value.some($e.value))),T=w(()=>$.focused.value===!0?e.tabindex:-1),p=w(()=>{const R={tabindex:e.tabindex,role:"combobox","aria-label":e.label,"aria-readonly":e.readonly===!0?"true":"false","aria-autocomplete":e.useInput===!0?"list":"none","aria-expanded":o.value===!0?"true":"false","aria-controls":`${$.targetUid.value}_lb`};return a.value>=0&&(R["aria-activedescendant"]=`${$.targetUid.value}_${a.value}`),R}),D=w(()=>({id:`${$.targetUid.value}_lb`,role:"listbox","aria-multiselectable":e.multiple===!0?"true":"false"})),G=w(()=>q.value.map((R,fe)=>({index:fe,opt:R,html:$e.value(R),selected:!0,removeAtIndex:re,toggleOption:Ee,tabindex:T.value}))),K=w(()=>{if(x.value===0)return[];const{from:R,to:fe}=B.value;return e.options.slice(R,fe).map((ve,Ne)=>{const Ue=V.value(ve)===!0,it=bt(ve)===!0,je=R+Ne,at={clickable:!0,active:it,activeClass:h.value,manualFocus:!0,focused:!1,disable:Ue,tabindex:-1,dense:e.optionsDense,dark:ge.value,role:"option","aria-selected":it===!0?"true":"false",id:`${$.targetUid.value}_${je}`,onClick:()=>{Ee(ve)}};return Ue!==!0&&(a.value===je&&(at.focused=!0),r.platform.is.desktop===!0&&(at.onMousemove=()=>{o.value===!0&&Ae(je)})),{index:je,opt:ve,html:$e.value(ve),label:y.value(ve),selected:at.active,focused:at.focused,toggleOption:Ee,setOptionIndex:Ae,itemProps:at}})}),X=w(()=>e.dropdownIcon!==void 0?e.dropdownIcon:r.iconSet.arrow.dropdown),oe=w(()=>e.optionsCover===!1&&e.outlined!==!0&&e.standout!==!0&&e.borderless!==!0&&e.rounded!==!0),h=w(()=>e.optionsSelectedClass!==void 0?e.optionsSelectedClass:e.color!==void 0?`text-${e.color}`:""),_=w(()=>Ti(e.optionValue,"value")),y=w(()=>Ti(e.optionLabel,"label")),V=w(()=>Ti(e.optionDisable,"disable")),j=w(()=>q.value.map(_.value)),z=w(()=>{const R={onInput:W,onChange:U,onKeydown:Un,onKeyup:pt,onKeypress:xn,onFocus:_t,onClick(fe){b===!0&&on(fe)}};return R.onCompositionstart=R.onCompositionupdate=R.onCompositionend=U,R});me(q,R=>{d=R,e.useInput===!0&&e.fillInput===!0&&e.multiple!==!0&&$.innerLoading.value!==!0&&(i.value!==!0&&o.value!==!0||ye.value!==!0)&&(C!==!0&&gl(),(i.value===!0||o.value===!0)&&_e(""))},{immediate:!0}),me(()=>e.fillInput,gl),me(o,Qo),me(x,mv);function M(R){return e.emitValue===!0?_.value(R):R}function H(R){if(R!==-1&&R<q.value.length)if(e.multiple===!0){const fe=e.modelValue.slice();n("remove",{index:R,value:fe.splice(R,1)[0]}),n("update:modelValue",fe)}else n("update:modelValue",null)}function re(R){H(R),$.focus()}function ue(R,fe){const ve=M(R);if(e.multiple!==!0){e.fillInput===!0&&pe(y.value(R),!0,!0),n("update:modelValue",ve);return}if(q.value.length===0){n("add",{index:0,value:ve}),n("update:modelValue",e.multiple===!0?[ve]:ve);return}if(fe===!0&&bt(R)===!0||e.maxValues!==void 0&&e.modelValue.length>=e.maxValues)return;const Ne=e.modelValue.slice();n("add",{index:Ne.length,value:ve}),Ne.push(ve),n("update:modelValue",Ne)}function Ee(R,fe){var it;if($.editable.value!==!0||R===void 0||V.value(R)===!0)return;const ve=_.value(R);if(e.multiple!==!0){fe!==!0&&(pe(e.fillInput===!0?y.value(R):"",!0,!0),jn()),(it=P.value)==null||it.focus(),(q.value.length===0||pn(_.value(q.value[0]),ve)!==!0)&&n("update:modelValue",e.emitValue===!0?ve:R);return}if((b!==!0||c.value===!0)&&$.focus(),_t(),q.value.length===0){const je=e.emitValue===!0?ve:R;n("add",{index:0,value:je}),n("update:modelValue",e.multiple===!0?[je]:je);return}const Ne=e.modelValue.slice(),Ue=j.value.findIndex(je=>pn(je,ve));if(Ue!==-1)n("remove",{index:Ue,value:Ne.splice(Ue,1)[0]});else{if(e.maxValues!==void 0&&Ne.length>=e.maxValues)return;const je=e.emitValue===!0?ve:R;n("add",{index:Ne.length,value:je}),Ne.push(je)}n("update:modelValue",Ne)}function Ae(R){if(r.platform.is.desktop!==!0)return;const fe=R!==-1&&R<x.value?R:-1;a.value!==fe&&(a.value=fe)}function Fe(R=1,fe){if(o.value===!0){let ve=a.value;do ve=sc(ve+R,-1,x.value-1);while(ve!==-1&&ve!==a.value&&V.value(e.options[ve])===!0);a.value!==ve&&(Ae(ve),se(ve),fe!==!0&&e.useInput===!0&&e.fillInput===!0&&de(ve>=0?y.value(e.options[ve]):F,!0))}}function ot(R,fe){const ve=Ne=>pn(_.value(Ne),R);return e.options.find(ve)||fe.find(ve)||R}function bt(R){const fe=_.value(R);return j.value.find(ve=>pn(ve,fe))!==void 0}function _t(R){e.useInput===!0&&P.value!==null&&(R===void 0||P.value===R.target&&R.target.value===le.value)&&P.value.select()}function mt(R){Tn(R,27)===!0&&o.value===!0&&(on(R),jn(),gl()),n("keyup",R)}function pt(R){const{value:fe}=R.target;if(R.keyCode!==void 0){mt(R);return}if(R.target.value="",f!==null&&(clearTimeout(f),f=null),v!==null&&(clearTimeout(v),v=null),gl(),typeof fe=="string"&&fe.length!==0){const ve=fe.toLocaleLowerCase(),Ne=it=>{const je=e.options.find(at=>String(it.value(at)).toLocaleLowerCase()===ve);return je===void 0?!1:(q.value.indexOf(je)===-1?Ee(je):jn(),!0)},Ue=it=>{Ne(_)!==!0&&it!==!0&&Ne(y)!==!0&&_e(fe,!0,()=>Ue(!0))};Ue()}else $.clearValue(R)}function xn(R){n("keypress",R)}function Un(R){if(n("keydown",R),Fo(R)===!0)return;const fe=s.value.length!==0&&(e.newValueMode!==void 0||e.onNewValue!==void 0),ve=R.shiftKey!==!0&&e.disableTabSelection!==!0&&e.multiple!==!0&&(a.value!==-1||fe===!0);if(R.keyCode===27){Ft(R);return}if(R.keyCode===9&&ve===!1){ml();return}if(R.target===void 0||R.target.id!==$.targetUid.value||$.editable.value!==!0)return;if(R.keyCode===40&&$.innerLoading.value!==!0&&o.value===!1){Ze(R),hl();return}if(R.keyCode===8&&(e.useChips===!0||e.clearable===!0)&&e.hideSelected!==!0&&s.value.length===0){e.multiple===!0&&Array.isArray(e.modelValue)===!0?H(e.modelValue.length-1):e.multiple!==!0&&e.modelValue!==null&&n("update:modelValue",null);return}(R.keyCode===35||R.keyCode===36)&&(typeof s.value!="string"||s.value.length===0)&&(Ze(R),a.value=-1,Fe(R.keyCode===36?1:-1,e.multiple)),(R.keyCode===33||R.keyCode===34)&&te.value!==void 0&&(Ze(R),a.value=Math.max(-1,Math.min(x.value,a.value+(R.keyCode===33?-1:1)*te.value.view)),Fe(R.keyCode===33?1:-1,e.multiple)),(R.keyCode===38||R.keyCode===40)&&(Ze(R),Fe(R.keyCode===38?-1:1,e.multiple));const Ne=x.value;if((m===void 0||g<Date.now())&&(m=""),Ne>0&&e.useInput!==!0&&R.key!==void 0&&R.key.length===1&&R.altKey===!1&&R.ctrlKey===!1&&R.metaKey===!1&&(R.keyCode!==32||m.length!==0)){o.value!==!0&&hl(R);const Ue=R.key.toLocaleLowerCase(),it=m.length===1&&m[0]===Ue;g=Date.now()+1500,it===!1&&(Ze(R),m+=Ue);const je=new RegExp("^"+m.split("").map(bl=>_y.indexOf(bl)!==-1?"\\"+bl:bl).join(".*"),"i");let at=a.value;if(it===!0||at<0||je.test(y.value(e.options[at]))!==!0)do at=sc(at+1,-1,Ne-1);while(at!==a.value&&(V.value(e.options[at])===!0||je.test(y.value(e.options[at]))!==!0));a.value!==at&&Ke(()=>{Ae(at),se(at),at>=0&&e.useInput===!0&&e.fillInput===!0&&de(y.value(e.options[at]),!0)});return}if(!(R.keyCode!==13&&(R.keyCode!==32||e.useInput===!0||m!=="")&&(R.keyCode!==9||ve===!1))){if(R.keyCode!==9&&Ze(R),a.value!==-1&&a.value<Ne){Ee(e.options[a.value]);return}if(fe===!0){const Ue=(it,je)=>{var bl;if(je){if(uc(je)!==!0)return}else je=e.newValueMode;if(pe("",e.multiple!==!0,!0),it==null)return;(je==="toggle"?Ee:ue)(it,je==="add-unique"),e.multiple!==!0&&((bl=P.value)==null||bl.focus(),jn())};if(e.onNewValue!==void 0?n("newValue",s.value,Ue):Ue(s.value),e.multiple!==!0)return}o.value===!0?ml():$.innerLoading.value!==!0&&hl()}}function Mr(){return b===!0?S.value:A.value!==null&&A.value.contentEl!==null?A.value.contentEl:void 0}function Wo(){return Mr()}function zo(){return e.hideSelected===!0?[]:t["selected-item"]!==void 0?G.value.map(R=>t["selected-item"](R)).slice():t.selected!==void 0?[].concat(t.selected()):e.useChips===!0?G.value.map((R,fe)=>O(ny,{key:"option-"+fe,removable:$.editable.value===!0&&V.value(R.opt)!==!0,dense:!0,textColor:e.color,tabindex:T.value,onRemove(){R.removeAtIndex(fe)}},()=>O("span",{class:"ellipsis",[R.html===!0?"innerHTML":"textContent"]:y.value(R.opt)}))):[O("span",{class:"ellipsis",[ut.value===!0?"innerHTML":"textContent"]:Pe.value})]}function Fr(){if(xe.value===!0)return t["no-option"]!==void 0?t["no-option"]({inputValue:s.value}):void 0;const R=t.option!==void 0?t.option:ve=>O(ji,{key:ve.index,...ve.itemProps},()=>O(Ui,()=>O(ly,()=>O("span",{[ve.html===!0?"innerHTML":"textContent"]:ve.label}))));let fe=ee("div",K.value.map(R));return t["before-options"]!==void 0&&(fe=t["before-options"]().concat(fe)),Gt(t["after-options"],fe)}function Ko(R,fe){const ve=fe===!0?{...p.value,...$.splitAttrs.attributes.value}:void 0,Ne={ref:fe===!0?P:void 0,key:"i_t",class:Z.value,style:e.inputStyle,value:s.value!==void 0?s.value:"",type:"search",...ve,id:fe===!0?$.targetUid.value:void 0,maxlength:e.maxlength,autocomplete:e.autocomplete,"data-autofocus":R===!0||e.autofocus===!0||void 0,disabled:e.disable===!0,readonly:e.readonly===!0,...z.value};return R!==!0&&b===!0&&(Array.isArray(Ne.class)===!0?Ne.class=[...Ne.class,"no-pointer-events"]:Ne.class+=" no-pointer-events"),O("input",Ne)}function W(R){f!==null&&(clearTimeout(f),f=null),v!==null&&(clearTimeout(v),v=null),!(R&&R.target&&R.target.qComposing===!0)&&(de(R.target.value||""),C=!0,F=s.value,$.focused.value!==!0&&(b!==!0||c.value===!0)&&$.focus(),e.onFilter!==void 0&&(f=setTimeout(()=>{f=null,_e(s.value)},e.inputDebounce)))}function de(R,fe){s.value!==R&&(s.value=R,fe===!0||e.inputDebounce===0||e.inputDebounce==="0"?n("inputValue",R):v=setTimeout(()=>{v=null,n("inputValue",R)},e.inputDebounce))}function pe(R,fe,ve){C=ve!==!0,e.useInput===!0&&(de(R,!0),(fe===!0||ve!==!0)&&(F=R),fe!==!0&&_e(R))}function _e(R,fe,ve){if(e.onFilter===void 0||fe!==!0&&$.focused.value!==!0)return;$.innerLoading.value===!0?n("filterAbort"):($.innerLoading.value=!0,u.value=!0),R!==""&&e.multiple!==!0&&q.value.length!==0&&C!==!0&&R===y.value(q.value[0])&&(R="");const Ne=setTimeout(()=>{o.value===!0&&(o.value=!1)},10);E!==null&&clearTimeout(E),E=Ne,n("filter",R,(Ue,it)=>{(fe===!0||$.focused.value===!0)&&E===Ne&&(clearTimeout(E),typeof Ue=="function"&&Ue(),u.value=!1,Ke(()=>{$.innerLoading.value=!1,$.editable.value===!0&&(fe===!0?o.value===!0&&jn():o.value===!0?Qo(!0):o.value=!0),typeof it=="function"&&Ke(()=>{it(l)}),typeof ve=="function"&&Ke(()=>{ve(l)})}))},()=>{$.focused.value===!0&&E===Ne&&(clearTimeout(E),$.innerLoading.value=!1,u.value=!1),o.value===!0&&(o.value=!1)})}function Le(){return O(od,{ref:A,class:ie.value,style:e.popupContentStyle,modelValue:o.value,fit:e.menuShrink!==!0,cover:e.optionsCover===!0&&xe.value!==!0&&e.useInput!==!0,anchor:e.menuAnchor,self:e.menuSelf,offset:e.menuOffset,dark:ge.value,noParentEvent:!0,noRefocus:!0,noFocus:!0,noRouteDismiss:e.popupNoRouteDismiss,square:oe.value,transitionShow:e.transitionShow,transitionHide:e.transitionHide,transitionDuration:e.transitionDuration,separateClosePopup:!0,...D.value,onScrollPassive:Y,onBeforeShow:es,onBeforeHide:tt,onShow:Xe},Fr)}function tt(R){ts(R),ml()}function Xe(){he()}function Mt(R){var fe;on(R),(fe=P.value)==null||fe.focus(),c.value=!0,window.scrollTo(window.pageXOffset||window.scrollX||document.body.scrollLeft||0,0)}function Je(R){on(R),Ke(()=>{c.value=!1})}function fn(){const R=[O(ey,{class:`col-auto ${$.fieldClass.value}`,...ae.value,for:$.targetUid.value,dark:ge.value,square:!0,loading:u.value,itemAligned:!1,filled:!0,stackLabel:s.value.length!==0,...$.splitAttrs.listeners.value,onFocus:Mt,onBlur:Je},{...t,rawControl:()=>$.getControl(!0),before:void 0,after:void 0})];return o.value===!0&&R.push(O("div",{ref:S,class:ie.value+" scroll",style:e.popupContentStyle,...D.value,onClick:Ft,onScrollPassive:Y},Fr())),O(av,{ref:I,modelValue:i.value,position:e.useInput===!0?"top":void 0,transitionShow:k,transitionHide:e.transitionHide,transitionDuration:e.transitionDuration,noRouteDismiss:e.popupNoRouteDismiss,onBeforeShow:es,onBeforeHide:vl,onHide:Yo,onShow:vv},()=>O("div",{class:"q-select__dialog"+(ge.value===!0?" q-select__dialog--dark q-dark":"")+(c.value===!0?" q-select__dialog--focused":"")},R))}function vl(R){ts(R),I.value!==null&&I.value.__updateRefocusTarget($.rootRef.value.querySelector(".q-field__native > [tabindex]:last-child")),$.focused.value=!1}function Yo(R){jn(),$.focused.value===!1&&n("blur",R),gl()}function vv(){const R=document.activeElement;(R===null||R.id!==$.targetUid.value)&&P.value!==null&&P.value!==R&&P.value.focus(),he()}function ml(){i.value!==!0&&(a.value=-1,o.value===!0&&(o.value=!1),$.focused.value===!1&&(E!==null&&(clearTimeout(E),E=null),$.innerLoading.value===!0&&(n("filterAbort"),$.innerLoading.value=!1,u.value=!1)))}function hl(R){$.editable.value===!0&&(b===!0?($.onControlFocusin(R),i.value=!0,Ke(()=>{$.focus()})):$.focus(),e.onFilter!==void 0?_e(s.value):(xe.value!==!0||t["no-option"]!==void 0)&&(o.value=!0))}function jn(){i.value=!1,ml()}function gl(){e.useInput===!0&&pe(e.multiple!==!0&&e.fillInput===!0&&q.value.length!==0&&y.value(q.value[0])||"",!0,!0)}function Qo(R){let fe=-1;if(R===!0){if(q.value.length!==0){const ve=_.value(q.value[0]);fe=e.options.findIndex(Ne=>pn(_.value(Ne),ve))}J(fe)}Ae(fe)}function mv(R,fe){o.value===!0&&$.innerLoading.value===!1&&(J(-1,!0),Ke(()=>{o.value===!0&&$.innerLoading.value===!1&&(R>fe?J():Qo(!0))}))}function Za(){i.value===!1&&A.value!==null&&A.value.updatePosition()}function es(R){R!==void 0&&on(R),n("popupShow",R),$.hasPopupOpen=!0,$.onControlFocusin(R)}function ts(R){R!==void 0&&on(R),n("popupHide",R),$.hasPopupOpen=!1,$.onControlFocusout(R)}function ns(){b=r.platform.is.mobile!==!0&&e.behavior!=="dialog"?!1:e.behavior!=="menu"&&(e.useInput===!0?t["no-option"]!==void 0||e.onFilter!==void 0||xe.value===!1:!0),k=r.platform.is.ios===!0&&b===!0&&e.useInput===!0?"fade":e.transitionShow}return xa(ns),La(Za),ns(),Ge(()=>{f!==null&&clearTimeout(f),v!==null&&clearTimeout(v)}),Object.assign(l,{showPopup:hl,hidePopup:jn,removeAtIndex:H,add:ue,toggleOption:Ee,getOptionIndex:()=>a.value,setOptionIndex:Ae,moveOptionSelection:Fe,filter:_e,updateMenuPosition:Za,updateInputValue:pe,isOptionSelected:bt,getEmittingOptionValue:M,isOptionDisabled:(...R)=>V.value.apply(null,R)===!0,getOptionValue:(...R)=>_.value.apply(null,R),getOptionLabel:(...R)=>y.value.apply(null,R)}),Object.assign($,{innerValue:q,fieldClass:w(()=>`q-select q-field--auto-height q-select--with${e.useInput!==!0?"out":""}-input q-select--with${e.useChips!==!0?"out":""}-chips q-select--${e.multiple===!0?"multiple":"single"}`),inputRef:L,targetRef:P,hasValue:ye,showPopup:hl,floatingLabel:w(()=>e.hideSelected!==!0&&ye.value===!0||typeof s.value=="number"||s.value.length!==0||wr(e.displayValue)),getControlChild:()=>{if($.editable.value!==!1&&(i.value===!0||xe.value!==!0||t["no-option"]!==void 0))return b===!0?fn():Le();$.hasPopupOpen===!0&&($.hasPopupOpen=!1)},controlEvents:{onFocusin(R){$.onControlFocusin(R)},onFocusout(R){$.onControlFocusout(R,()=>{gl(),ml()})},onClick(R){var fe;if(Ft(R),b!==!0&&o.value===!0){ml(),(fe=P.value)==null||fe.focus();return}hl(R)}},getControl:R=>{const fe=zo(),ve=R===!0||i.value!==!0||b!==!0;if(e.useInput===!0)fe.push(Ko(R,ve));else if($.editable.value===!0){const Ue=ve===!0?p.value:void 0;fe.push(O("input",{ref:ve===!0?P:void 0,key:"d_t",class:"q-select__focus-target",id:ve===!0?$.targetUid.value:void 0,value:Pe.value,readonly:!0,"data-autofocus":R===!0||e.autofocus===!0||void 0,...Ue,onKeydown:Un,onKeyup:mt,onKeypress:xn})),ve===!0&&typeof e.autocomplete=="string"&&e.autocomplete.length!==0&&fe.push(O("input",{class:"q-select__autocomplete-input",autocomplete:e.autocomplete,tabindex:-1,onKeyup:pt}))}if(N.value!==void 0&&e.disable!==!0&&j.value.length!==0){const Ue=j.value.map(it=>O("option",{value:it,selected:!0}));fe.push(O("select",{class:"hidden",name:N.value,multiple:e.multiple},Ue))}const Ne=e.useInput===!0||ve!==!0?void 0:$.splitAttrs.attributes.value;return O("div",{class:"q-field__native row items-center",...Ne,...$.splitAttrs.listeners.value},fe)},getInnerAppend:()=>e.loading!==!0&&u.value!==!0&&e.hideDropdownIcon!==!0?[O(xt,{class:"q-select__dropdown-icon"+(o.value===!0?" rotate-180":""),name:X.value})]:null}),Xa($)}}),Sy={xs:2,sm:4,md:6,lg:10,xl:14};function cc(e,t,n){return{transform:t===!0?`translateX(${n.lang.rtl===!0?"-":""}100%) scale3d(${-e},1,1)`:`scale3d(${e},1,1)`}}const wy=Re({name:"QLinearProgress",props:{...$t,...Ir,value:{type:Number,default:0},buffer:Number,color:String,trackColor:String,reverse:Boolean,stripe:Boolean,indeterminate:Boolean,query:Boolean,rounded:Boolean,animationSpeed:{type:[String,Number],default:2100},instantFeedback:Boolean},setup(e,{slots:t}){const{proxy:n}=Ce(),l=Dt(e,n.$q),r=Rr(e,Sy),o=w(()=>e.indeterminate===!0||e.query===!0),i=w(()=>e.reverse!==e.query),a=w(()=>({...r.value!==null?r.value:{},"--q-linear-progress-speed":`${e.animationSpeed}ms`})),s=w(()=>"q-linear-progress"+(e.color!==void 0?` text-${e.color}`:"")+(e.reverse===!0||e.query===!0?" q-linear-progress--reverse":"")+(e.rounded===!0?" rounded-borders":"")),c=w(()=>cc(e.buffer!==void 0?e.buffer:1,i.value,n.$q)),u=w(()=>`with${e.instantFeedback===!0?"out":""}-transition`),f=w(()=>`q-linear-progress__track absolute-full q-linear-progress__track--${u.value} q-linear-progress__track--${l.value===!0?"dark":"light"}`+(e.trackColor!==void 0?` bg-${e.trackColor}`:"")),v=w(()=>cc(o.value===!0?1:e.value,i.value,n.$q)),d=w(()=>`q-linear-progress__model absolute-full q-linear-progress__model--${u.value} q-linear-progress__model--${o.value===!0?"in":""}determinate`),b=w(()=>({width:`${e.value*100}%`})),C=w(()=>`q-linear-progress__stripe absolute-${e.reverse===!0?"right":"left"} q-linear-progress__stripe--${u.value}`);return()=>{const E=[O("div",{class:f.value,style:c.value}),O("div",{class:d.value,style:v.value})];return e.stripe===!0&&o.value===!1&&E.push(O("div",{class:C.value,style:b.value})),O("div",{class:s.value,style:a.value,role:"progressbar","aria-valuemin":0,"aria-valuemax":1,"aria-valuenow":e.indeterminate===!0?void 0:e.value},Gt(t.default,E))}}});function Cy(e,t){const n=ne(null),l=w(()=>e.disable===!0?null:O("span",{ref:n,class:"no-outline",tabindex:-1}));function r(o){const i=t.value;(o==null?void 0:o.qAvoidFocus)!==!0&&((o==null?void 0:o.type.indexOf("key"))===0?document.activeElement!==i&&(i==null?void 0:i.contains(document.activeElement))===!0&&i.focus():n.value!==null&&(o===void 0||(i==null?void 0:i.contains(o.target))===!0)&&n.value.focus())}return{refocusTargetEl:l,refocusTarget:r}}const Ey={xs:30,sm:35,md:40,lg:50,xl:60},Ty={...$t,...Ir,...Ja,modelValue:{required:!0,default:null},val:{},trueValue:{default:!0},falseValue:{default:!1},indeterminateValue:{default:null},checkedIcon:String,uncheckedIcon:String,indeterminateIcon:String,toggleOrder:{type:String,validator:e=>e==="tf"||e==="ft"},toggleIndeterminate:Boolean,label:String,leftLabel:Boolean,color:String,keepColor:Boolean,dense:Boolean,disable:Boolean,tabindex:[String,Number]},ky=["update:modelValue"];function xy(e,t){const{props:n,slots:l,emit:r,proxy:o}=Ce(),{$q:i}=o,a=Dt(n,i),s=ne(null),{refocusTargetEl:c,refocusTarget:u}=Cy(n,s),f=Rr(n,Ey),v=w(()=>n.val!==void 0&&Array.isArray(n.modelValue)),d=w(()=>{const x=Oe(n.val);return v.value===!0?n.modelValue.findIndex(Q=>Oe(Q)===x):-1}),b=w(()=>v.value===!0?d.value!==-1:Oe(n.modelValue)===Oe(n.trueValue)),C=w(()=>v.value===!0?d.value===-1:Oe(n.modelValue)===Oe(n.falseValue)),E=w(()=>b.value===!1&&C.value===!1),F=w(()=>n.disable===!0?-1:n.tabindex||0),k=w(()=>`q-${e} cursor-pointer no-outline row inline no-wrap items-center`+(n.disable===!0?" disabled":"")+(a.value===!0?` q-${e}--dark`:"")+(n.dense===!0?` q-${e}--dense`:"")+(n.leftLabel===!0?" reverse":"")),m=w(()=>{const x=b.value===!0?"truthy":C.value===!0?"falsy":"indet",Q=n.color!==void 0&&(n.keepColor===!0||(e==="toggle"?b.value===!0:C.value!==!0))?` text-${n.color}`:"";return`q-${e}__inner relative-position non-selectable q-${e}__inner--${x}${Q}`}),g=w(()=>{const x={type:"checkbox"};return n.name!==void 0&&Object.assign(x,{".checked":b.value,"^checked":b.value===!0?"checked":void 0,name:n.name,value:v.value===!0?n.val:n.trueValue}),x}),L=vy(g),P=w(()=>{const x={tabindex:F.value,role:e==="toggle"?"switch":"checkbox","aria-label":n.label,"aria-checked":E.value===!0?"mixed":b.value===!0?"true":"false"};return n.disable===!0&&(x["aria-disabled"]="true"),x});function A(x){x!==void 0&&(Ze(x),u(x)),n.disable!==!0&&r("update:modelValue",I(),x)}function I(){if(v.value===!0){if(b.value===!0){const x=n.modelValue.slice();return x.splice(d.value,1),x}return n.modelValue.concat([n.val])}if(b.value===!0){if(n.toggleOrder!=="ft"||n.toggleIndeterminate===!1)return n.falseValue}else if(C.value===!0){if(n.toggleOrder==="ft"||n.toggleIndeterminate===!1)return n.trueValue}else return n.toggleOrder!=="ft"?n.trueValue:n.falseValue;return n.indeterminateValue}function S(x){(x.keyCode===13||x.keyCode===32)&&Ze(x)}function N(x){(x.keyCode===13||x.keyCode===32)&&A(x)}const U=t(b,E);return Object.assign(o,{toggle:A}),()=>{const x=U();n.disable!==!0&&L(x,"unshift",` q-${e}__native absolute q-ma-none q-pa-none`);const Q=[O("div",{class:m.value,style:f.value,"aria-hidden":"true"},x)];c.value!==null&&Q.push(c.value);const B=n.label!==void 0?Gt(l.default,[n.label]):Ve(l.default);return B!==void 0&&Q.push(O("div",{class:`q-${e}__label q-anchor--skip`},B)),O("div",{ref:s,class:k.value,...P.value,onClick:A,onKeydown:S,onKeyup:N},Q)}}const Ly=()=>O("div",{key:"svg",class:"q-checkbox__bg absolute"},[O("svg",{class:"q-checkbox__svg fit absolute-full",viewBox:"0 0 24 24"},[O("path",{class:"q-checkbox__truthy",fill:"none",d:"M1.73,12.91 8.1,19.28 22.79,4.59"}),O("path",{class:"q-checkbox__indet",d:"M4,14H20V10H4"})])]),ki=Re({name:"QCheckbox",props:Ty,emits:ky,setup(e){const t=Ly();function n(l,r){const o=w(()=>(l.value===!0?e.checkedIcon:r.value===!0?e.indeterminateIcon:e.uncheckedIcon)||null);return()=>o.value!==null?[O("div",{key:"icon",class:"q-checkbox__icon-container absolute-full flex flex-center no-wrap"},[O(xt,{class:"q-checkbox__icon",name:o.value})])]:[t]}return xy("checkbox",n)}});let Kl=0;const Py={fullscreen:Boolean,noRouteFullscreenExit:Boolean},Ay=["update:fullscreen","fullscreen"];function Oy(){const e=Ce(),{props:t,emit:n,proxy:l}=e;let r,o,i;const a=ne(!1);Fa(e)===!0&&me(()=>l.$route.fullPath,()=>{t.noRouteFullscreenExit!==!0&&u()}),me(()=>t.fullscreen,f=>{a.value!==f&&s()}),me(a,f=>{n("update:fullscreen",f),n("fullscreen",f)});function s(){a.value===!0?u():c()}function c(){a.value!==!0&&(a.value=!0,i=l.$el.parentNode,i.replaceChild(o,l.$el),document.body.appendChild(l.$el),Kl++,Kl===1&&document.body.classList.add("q-body--fullscreen-mixin"),r={handler:u},Cr.add(r))}function u(){a.value===!0&&(r!==void 0&&(Cr.remove(r),r=void 0),i.replaceChild(l.$el,o),a.value=!1,Kl=Math.max(0,Kl-1),Kl===0&&(document.body.classList.remove("q-body--fullscreen-mixin"),l.$el.scrollIntoView!==void 0&&setTimeout(()=>{l.$el.scrollIntoView()})))}return Lr(()=>{o=document.createElement("span")}),gt(()=>{t.fullscreen===!0&&c()}),Ge(u),Object.assign(l,{toggleFullscreen:s,setFullscreen:c,exitFullscreen:u}),{inFullscreen:a,toggleFullscreen:s}}function Iy(e,t){return new Date(e)-new Date(t)}const Ry={sortMethod:Function,binaryStateSort:Boolean,columnSortOrder:{type:String,validator:e=>e==="ad"||e==="da",default:"ad"}};function Ny(e,t,n,l){const r=w(()=>{const{sortBy:a}=t.value;return a&&n.value.find(s=>s.name===a)||null}),o=w(()=>e.sortMethod!==void 0?e.sortMethod:(a,s,c)=>{const u=n.value.find(d=>d.name===s);if(u===void 0||u.field===void 0)return a;const f=c===!0?-1:1,v=typeof u.field=="function"?d=>u.field(d):d=>d[u.field];return a.sort((d,b)=>{let C=v(d),E=v(b);return u.rawSort!==void 0?u.rawSort(C,E,d,b)*f:C==null?-1*f:E==null?1*f:u.sort!==void 0?u.sort(C,E,d,b)*f:Gi(C)===!0&&Gi(E)===!0?(C-E)*f:lu(C)===!0&&lu(E)===!0?Iy(C,E)*f:typeof C=="boolean"&&typeof E=="boolean"?(C-E)*f:([C,E]=[C,E].map(F=>(F+"").toLocaleString().toLowerCase()),C<E?-1*f:C===E?0:f)})});function i(a){let s=e.columnSortOrder;if(Qi(a)===!0)a.sortOrder&&(s=a.sortOrder),a=a.name;else{const f=n.value.find(v=>v.name===a);f!=null&&f.sortOrder&&(s=f.sortOrder)}let{sortBy:c,descending:u}=t.value;c!==a?(c=a,u=s==="da"):e.binaryStateSort===!0?u=!u:u===!0?s==="ad"?c=null:u=!1:s==="ad"?u=!0:c=null,l({sortBy:c,descending:u,page:1})}return{columnToSort:r,computedSortMethod:o,sort:i}}const My={filter:[String,Object],filterMethod:Function};function Fy(e,t){const n=w(()=>e.filterMethod!==void 0?e.filterMethod:(l,r,o,i)=>{const a=r?r.toLowerCase():"";return l.filter(s=>o.some(c=>{const u=i(c,s)+"";return(u==="undefined"||u==="null"?"":u.toLowerCase()).indexOf(a)!==-1}))});return me(()=>e.filter,()=>{Ke(()=>{t({page:1},!0)})},{deep:!0}),{computedFilterMethod:n}}function qy(e,t){for(const n in t)if(t[n]!==e[n])return!1;return!0}function fc(e){return e.page<1&&(e.page=1),e.rowsPerPage!==void 0&&e.rowsPerPage<1&&(e.rowsPerPage=0),e}const $y={pagination:Object,rowsPerPageOptions:{type:Array,default:()=>[5,7,10,15,20,25,50,0]},"onUpdate:pagination":[Function,Array]};function Dy(e,t){const{props:n,emit:l}=e,r=ne(Object.assign({sortBy:null,descending:!1,page:1,rowsPerPage:n.rowsPerPageOptions.length!==0?n.rowsPerPageOptions[0]:5},n.pagination)),o=w(()=>{const u=n["onUpdate:pagination"]!==void 0?{...r.value,...n.pagination}:r.value;return fc(u)}),i=w(()=>o.value.rowsNumber!==void 0);function a(u){s({pagination:u,filter:n.filter})}function s(u={}){Ke(()=>{l("request",{pagination:u.pagination||o.value,filter:u.filter||n.filter,getCellValue:t})})}function c(u,f){const v=fc({...o.value,...u});if(qy(o.value,v)===!0){i.value===!0&&f===!0&&a(v);return}if(i.value===!0){a(v);return}n.pagination!==void 0&&n["onUpdate:pagination"]!==void 0?l("update:pagination",v):r.value=v}return{innerPagination:r,computedPagination:o,isServerSide:i,requestServerInteraction:s,setPagination:c}}function Vy(e,t,n,l,r,o){const{props:i,emit:a,proxy:{$q:s}}=e,c=w(()=>l.value===!0?n.value.rowsNumber||0:o.value),u=w(()=>{const{page:g,rowsPerPage:L}=n.value;return(g-1)*L}),f=w(()=>{const{page:g,rowsPerPage:L}=n.value;return g*L}),v=w(()=>n.value.page===1),d=w(()=>n.value.rowsPerPage===0?1:Math.max(1,Math.ceil(c.value/n.value.rowsPerPage))),b=w(()=>f.value===0?!0:n.value.page>=d.value),C=w(()=>(i.rowsPerPageOptions.includes(t.value.rowsPerPage)?i.rowsPerPageOptions:[t.value.rowsPerPage].concat(i.rowsPerPageOptions)).map(L=>({label:L===0?s.lang.table.allRows:""+L,value:L})));me(d,(g,L)=>{if(g===L)return;const P=n.value.page;g&&!P?r({page:1}):g<P&&r({page:g})});function E(){r({page:1})}function F(){const{page:g}=n.value;g>1&&r({page:g-1})}function k(){const{page:g,rowsPerPage:L}=n.value;f.value>0&&g*L<c.value&&r({page:g+1})}function m(){r({page:d.value})}return i["onUpdate:pagination"]!==void 0&&a("update:pagination",{...n.value}),{firstRowIndex:u,lastRowIndex:f,isFirstPage:v,isLastPage:b,pagesNumber:d,computedRowsPerPageOptions:C,computedRowsNumber:c,firstPage:E,prevPage:F,nextPage:k,lastPage:m}}const By={selection:{type:String,default:"none",validator:e=>["single","multiple","none"].includes(e)},selected:{type:Array,default:()=>[]}},Hy=["update:selected","selection"];function Uy(e,t,n,l){const r=w(()=>{const b={};return e.selected.map(l.value).forEach(C=>{b[C]=!0}),b}),o=w(()=>e.selection!=="none"),i=w(()=>e.selection==="single"),a=w(()=>e.selection==="multiple"),s=w(()=>n.value.length!==0&&n.value.every(b=>r.value[l.value(b)]===!0)),c=w(()=>s.value!==!0&&n.value.some(b=>r.value[l.value(b)]===!0)),u=w(()=>e.selected.length);function f(b){return r.value[b]===!0}function v(){t("update:selected",[])}function d(b,C,E,F){t("selection",{rows:C,added:E,keys:b,evt:F});const k=i.value===!0?E===!0?C:[]:E===!0?e.selected.concat(C):e.selected.filter(m=>b.includes(l.value(m))===!1);t("update:selected",k)}return{hasSelectionMode:o,singleSelection:i,multipleSelection:a,allRowsSelected:s,someRowsSelected:c,rowsSelectedNumber:u,isRowSelected:f,clearSelection:v,updateSelection:d}}function dc(e){return Array.isArray(e)?e.slice():[]}const jy={expanded:Array},Wy=["update:expanded"];function zy(e,t){const n=ne(dc(e.expanded));me(()=>e.expanded,i=>{n.value=dc(i)});function l(i){return n.value.includes(i)}function r(i){e.expanded!==void 0?t("update:expanded",i):n.value=i}function o(i,a){const s=n.value.slice(),c=s.indexOf(i);a===!0?c===-1&&(s.push(i),r(s)):c!==-1&&(s.splice(c,1),r(s))}return{isRowExpanded:l,setExpanded:r,updateExpanded:o}}const Ky={visibleColumns:Array};function Yy(e,t,n){const l=w(()=>{if(e.columns!==void 0)return e.columns;const a=e.rows[0];return a!==void 0?Object.keys(a).map(s=>({name:s,label:s.toUpperCase(),field:s,align:Gi(a[s])?"right":"left",sortable:!0})):[]}),r=w(()=>{const{sortBy:a,descending:s}=t.value;return(e.visibleColumns!==void 0?l.value.filter(u=>u.required===!0||e.visibleColumns.includes(u.name)===!0):l.value).map(u=>{const f=u.align||"right",v=`text-${f}`;return{...u,align:f,__iconClass:`q-table__sort-icon q-table__sort-icon--${f}`,__thClass:v+(u.headerClasses!==void 0?" "+u.headerClasses:"")+(u.sortable===!0?" sortable":"")+(u.name===a?` sorted ${s===!0?"sort-desc":""}`:""),__tdStyle:u.style!==void 0?typeof u.style!="function"?()=>u.style:u.style:()=>null,__tdClass:u.classes!==void 0?typeof u.classes!="function"?()=>v+" "+u.classes:d=>v+" "+u.classes(d):()=>v}})}),o=w(()=>{const a={};return r.value.forEach(s=>{a[s.name]=s}),a}),i=w(()=>e.tableColspan!==void 0?e.tableColspan:r.value.length+(n.value===!0?1:0));return{colList:l,computedCols:r,computedColsMap:o,computedColspan:i}}const Xr="q-table__bottom row items-center",cv={};rv.forEach(e=>{cv[e]={}});const Qy=Re({name:"QTable",props:{rows:{type:Array,required:!0},rowKey:{type:[String,Function],default:"id"},columns:Array,loading:Boolean,iconFirstPage:String,iconPrevPage:String,iconNextPage:String,iconLastPage:String,title:String,hideHeader:Boolean,grid:Boolean,gridHeader:Boolean,dense:Boolean,flat:Boolean,bordered:Boolean,square:Boolean,separator:{type:String,default:"horizontal",validator:e=>["horizontal","vertical","cell","none"].includes(e)},wrapCells:Boolean,virtualScroll:Boolean,virtualScrollTarget:{},...cv,noDataLabel:String,noResultsLabel:String,loadingLabel:String,selectedRowsLabel:Function,rowsPerPageLabel:String,paginationLabel:Function,color:{type:String,default:"grey-8"},titleClass:[String,Array,Object],tableStyle:[String,Array,Object],tableClass:[String,Array,Object],tableHeaderStyle:[String,Array,Object],tableHeaderClass:[String,Array,Object],tableRowStyleFn:Function,tableRowClassFn:Function,cardContainerClass:[String,Array,Object],cardContainerStyle:[String,Array,Object],cardStyle:[String,Array,Object],cardClass:[String,Array,Object],cardStyleFn:Function,cardClassFn:Function,hideBottom:Boolean,hideSelectedBanner:Boolean,hideNoData:Boolean,hidePagination:Boolean,onRowClick:Function,onRowDblclick:Function,onRowContextmenu:Function,...$t,...Py,...Ky,...My,...$y,...jy,...By,...Ry},emits:["request","virtualScroll",...Ay,...Wy,...Hy],setup(e,{slots:t,emit:n}){const l=Ce(),{proxy:{$q:r}}=l,o=Dt(e,r),{inFullscreen:i,toggleFullscreen:a}=Oy(),s=w(()=>typeof e.rowKey=="function"?e.rowKey:W=>W[e.rowKey]),c=ne(null),u=ne(null),f=w(()=>e.grid!==!0&&e.virtualScroll===!0),v=w(()=>" q-table__card"+(o.value===!0?" q-table__card--dark q-dark":"")+(e.square===!0?" q-table--square":"")+(e.flat===!0?" q-table--flat":"")+(e.bordered===!0?" q-table--bordered":"")),d=w(()=>`q-table__container q-table--${e.separator}-separator column no-wrap`+(e.grid===!0?" q-table--grid":v.value)+(o.value===!0?" q-table--dark":"")+(e.dense===!0?" q-table--dense":"")+(e.wrapCells===!1?" q-table--no-wrap":"")+(i.value===!0?" fullscreen scroll":"")),b=w(()=>d.value+(e.loading===!0?" q-table--loading":""));me(()=>e.tableStyle+e.tableClass+e.tableHeaderStyle+e.tableHeaderClass+d.value,()=>{var W;f.value===!0&&((W=u.value)==null||W.reset())});const{innerPagination:C,computedPagination:E,isServerSide:F,requestServerInteraction:k,setPagination:m}=Dy(l,Ae),{computedFilterMethod:g}=Fy(e,m),{isRowExpanded:L,setExpanded:P,updateExpanded:A}=zy(e,n),I=w(()=>{let W=e.rows;if(F.value===!0||W.length===0)return W;const{sortBy:de,descending:pe}=E.value;return e.filter&&(W=g.value(W,e.filter,$.value,Ae)),ge.value!==null&&(W=ye.value(e.rows===W?W.slice():W,de,pe)),W}),S=w(()=>I.value.length),N=w(()=>{let W=I.value;if(F.value===!0)return W;const{rowsPerPage:de}=E.value;return de!==0&&(ie.value===0&&e.rows!==W?W.length>xe.value&&(W=W.slice(0,xe.value)):W=W.slice(ie.value,xe.value)),W}),{hasSelectionMode:U,singleSelection:x,multipleSelection:Q,allRowsSelected:B,someRowsSelected:te,rowsSelectedNumber:J,isRowSelected:ee,clearSelection:Y,updateSelection:se}=Uy(e,n,N,s),{colList:he,computedCols:$,computedColsMap:q,computedColspan:ae}=Yy(e,E,U),{columnToSort:ge,computedSortMethod:ye,sort:Z}=Ny(e,E,he,m),{firstRowIndex:ie,lastRowIndex:xe,isFirstPage:le,isLastPage:Pe,pagesNumber:$e,computedRowsPerPageOptions:ut,computedRowsNumber:T,firstPage:p,prevPage:D,nextPage:G,lastPage:K}=Vy(l,C,E,F,m,S),X=w(()=>N.value.length===0),oe=w(()=>{const W={};return rv.forEach(de=>{W[de]=e[de]}),W.virtualScrollItemSize===void 0&&(W.virtualScrollItemSize=e.dense===!0?28:48),W});function h(){f.value===!0&&u.value.reset()}function _(){if(e.grid===!0)return Ko();const W=e.hideHeader!==!0?_t:null;if(f.value===!0){const pe=t["top-row"],_e=t["bottom-row"],Le={default:tt=>z(tt.item,t.body,tt.index)};if(pe!==void 0){const tt=O("tbody",pe({cols:$.value}));Le.before=W===null?()=>tt:()=>[W()].concat(tt)}else W!==null&&(Le.before=W);return _e!==void 0&&(Le.after=()=>O("tbody",_e({cols:$.value}))),O(Wp,{ref:u,class:e.tableClass,style:e.tableStyle,...oe.value,scrollTarget:e.virtualScrollTarget,items:N.value,type:"__qtable",tableColspan:ae.value,onVirtualScroll:V},Le)}const de=[M()];return W!==null&&de.unshift(W()),tv({class:["q-table__middle scroll",e.tableClass],style:e.tableStyle},de)}function y(W,de){if(u.value!==null){u.value.scrollTo(W,de);return}W=parseInt(W,10);const pe=c.value.querySelector(`tbody tr:nth-of-type(${W+1})`);if(pe!==null){const _e=c.value.querySelector(".q-table__middle.scroll"),Le=pe.offsetTop-e.virtualScrollStickySizeStart,tt=Le<_e.scrollTop?"decrease":"increase";_e.scrollTop=Le,n("virtualScroll",{index:W,from:0,to:C.value.rowsPerPage-1,direction:tt})}}function V(W){n("virtualScroll",W)}function j(){return[O(wy,{class:"q-table__linear-progress",color:e.color,dark:o.value,indeterminate:!0,trackColor:"transparent"})]}function z(W,de,pe){const _e=s.value(W),Le=ee(_e);if(de!==void 0){const Je={key:_e,row:W,pageIndex:pe,__trClass:Le?"selected":""};if(e.tableRowStyleFn!==void 0&&(Je.__trStyle=e.tableRowStyleFn(W)),e.tableRowClassFn!==void 0){const fn=e.tableRowClassFn(W);fn&&(Je.__trClass=`${fn} ${Je.__trClass}`)}return de(H(Je))}const tt=t["body-cell"],Xe=$.value.map(Je=>{const fn=t[`body-cell-${Je.name}`],vl=fn!==void 0?fn:tt;return vl!==void 0?vl(re({key:_e,row:W,pageIndex:pe,col:Je})):O("td",{class:Je.__tdClass(W),style:Je.__tdStyle(W)},Ae(Je,W))});if(U.value===!0){const Je=t["body-selection"],fn=Je!==void 0?Je(ue({key:_e,row:W,pageIndex:pe})):[O(ki,{modelValue:Le,color:e.color,dark:o.value,dense:e.dense,"onUpdate:modelValue":(vl,Yo)=>{se([_e],[W],vl,Yo)}})];Xe.unshift(O("td",{class:"q-table--col-auto-width"},fn))}const Mt={key:_e,class:{selected:Le}};if(e.onRowClick!==void 0&&(Mt.class["cursor-pointer"]=!0,Mt.onClick=Je=>{n("rowClick",Je,W,pe)}),e.onRowDblclick!==void 0&&(Mt.class["cursor-pointer"]=!0,Mt.onDblclick=Je=>{n("rowDblclick",Je,W,pe)}),e.onRowContextmenu!==void 0&&(Mt.class["cursor-pointer"]=!0,Mt.onContextmenu=Je=>{n("rowContextmenu",Je,W,pe)}),e.tableRowStyleFn!==void 0&&(Mt.style=e.tableRowStyleFn(W)),e.tableRowClassFn!==void 0){const Je=e.tableRowClassFn(W);Je&&(Mt.class[Je]=!0)}return O("tr",Mt,Xe)}function M(){const W=t.body,de=t["top-row"],pe=t["bottom-row"];let _e=N.value.map((Le,tt)=>z(Le,W,tt));return de!==void 0&&(_e=de({cols:$.value}).concat(_e)),pe!==void 0&&(_e=_e.concat(pe({cols:$.value}))),O("tbody",_e)}function H(W){return Ee(W),W.cols=W.cols.map(de=>Wt({...de},"value",()=>Ae(de,W.row))),W}function re(W){return Ee(W),Wt(W,"value",()=>Ae(W.col,W.row)),W}function ue(W){return Ee(W),W}function Ee(W){Object.assign(W,{cols:$.value,colsMap:q.value,sort:Z,rowIndex:ie.value+W.pageIndex,color:e.color,dark:o.value,dense:e.dense}),U.value===!0&&Wt(W,"selected",()=>ee(W.key),(de,pe)=>{se([W.key],[W.row],de,pe)}),Wt(W,"expand",()=>L(W.key),de=>{A(W.key,de)})}function Ae(W,de){const pe=typeof W.field=="function"?W.field(de):de[W.field];return W.format!==void 0?W.format(pe,de):pe}const Fe=w(()=>({pagination:E.value,pagesNumber:$e.value,isFirstPage:le.value,isLastPage:Pe.value,firstPage:p,prevPage:D,nextPage:G,lastPage:K,inFullscreen:i.value,toggleFullscreen:a}));function ot(){const W=t.top,de=t["top-left"],pe=t["top-right"],_e=t["top-selection"],Le=U.value===!0&&_e!==void 0&&J.value>0,tt="q-table__top relative-position row items-center";if(W!==void 0)return O("div",{class:tt},[W(Fe.value)]);let Xe;if(Le===!0?Xe=_e(Fe.value).slice():(Xe=[],de!==void 0?Xe.push(O("div",{class:"q-table__control"},[de(Fe.value)])):e.title&&Xe.push(O("div",{class:"q-table__control"},[O("div",{class:["q-table__title",e.titleClass]},e.title)]))),pe!==void 0&&(Xe.push(O("div",{class:"q-table__separator col"})),Xe.push(O("div",{class:"q-table__control"},[pe(Fe.value)]))),Xe.length!==0)return O("div",{class:tt},Xe)}const bt=w(()=>te.value===!0?null:B.value);function _t(){const W=mt();return e.loading===!0&&t.loading===void 0&&W.push(O("tr",{class:"q-table__progress"},[O("th",{class:"relative-position",colspan:ae.value},j())])),O("thead",W)}function mt(){const W=t.header,de=t["header-cell"];if(W!==void 0)return W(pt({header:!0})).slice();const pe=$.value.map(_e=>{const Le=t[`header-cell-${_e.name}`],tt=Le!==void 0?Le:de,Xe=pt({col:_e});return tt!==void 0?tt(Xe):O($p,{key:_e.name,props:Xe},()=>_e.label)});if(x.value===!0&&e.grid!==!0)pe.unshift(O("th",{class:"q-table--col-auto-width"}," "));else if(Q.value===!0){const _e=t["header-selection"],Le=_e!==void 0?_e(pt({})):[O(ki,{color:e.color,modelValue:bt.value,dark:o.value,dense:e.dense,"onUpdate:modelValue":xn})];pe.unshift(O("th",{class:"q-table--col-auto-width"},Le))}return[O("tr",{class:e.tableHeaderClass,style:e.tableHeaderStyle},pe)]}function pt(W){return Object.assign(W,{cols:$.value,sort:Z,colsMap:q.value,color:e.color,dark:o.value,dense:e.dense}),Q.value===!0&&Wt(W,"selected",()=>bt.value,xn),W}function xn(W){te.value===!0&&(W=!1),se(N.value.map(s.value),N.value,W)}const Un=w(()=>{const W=[e.iconFirstPage||r.iconSet.table.firstPage,e.iconPrevPage||r.iconSet.table.prevPage,e.iconNextPage||r.iconSet.table.nextPage,e.iconLastPage||r.iconSet.table.lastPage];return r.lang.rtl===!0?W.reverse():W});function Mr(){if(e.hideBottom===!0)return;if(X.value===!0){if(e.hideNoData===!0)return;const pe=e.loading===!0?e.loadingLabel||r.lang.table.loading:e.filter?e.noResultsLabel||r.lang.table.noResults:e.noDataLabel||r.lang.table.noData,_e=t["no-data"],Le=_e!==void 0?[_e({message:pe,icon:r.iconSet.table.warning,filter:e.filter})]:[O(xt,{class:"q-table__bottom-nodata-icon",name:r.iconSet.table.warning}),pe];return O("div",{class:Xr+" q-table__bottom--nodata"},Le)}const W=t.bottom;if(W!==void 0)return O("div",{class:Xr},[W(Fe.value)]);const de=e.hideSelectedBanner!==!0&&U.value===!0&&J.value>0?[O("div",{class:"q-table__control"},[O("div",[(e.selectedRowsLabel||r.lang.table.selectedRecords)(J.value)])])]:[];if(e.hidePagination!==!0)return O("div",{class:Xr+" justify-end"},zo(de));if(de.length!==0)return O("div",{class:Xr},de)}function Wo(W){m({page:1,rowsPerPage:W.value})}function zo(W){let de;const{rowsPerPage:pe}=E.value,_e=e.paginationLabel||r.lang.table.pagination,Le=t.pagination,tt=e.rowsPerPageOptions.length>1;if(W.push(O("div",{class:"q-table__separator col"})),tt===!0&&W.push(O("div",{class:"q-table__control"},[O("span",{class:"q-table__bottom-item"},[e.rowsPerPageLabel||r.lang.table.recordsPerPage]),O(yy,{class:"q-table__select inline q-table__bottom-item",color:e.color,modelValue:pe,options:ut.value,displayValue:pe===0?r.lang.table.allRows:pe,dark:o.value,borderless:!0,dense:!0,optionsDense:!0,optionsCover:!0,"onUpdate:modelValue":Wo})])),Le!==void 0)de=Le(Fe.value);else if(de=[O("span",pe!==0?{class:"q-table__bottom-item"}:{},[pe?_e(ie.value+1,Math.min(xe.value,T.value),T.value):_e(1,S.value,T.value)])],pe!==0&&$e.value>1){const Xe={color:e.color,round:!0,dense:!0,flat:!0};e.dense===!0&&(Xe.size="sm"),$e.value>2&&de.push(O(zt,{key:"pgFirst",...Xe,icon:Un.value[0],disable:le.value,ariaLabel:r.lang.pagination.first,onClick:p})),de.push(O(zt,{key:"pgPrev",...Xe,icon:Un.value[1],disable:le.value,ariaLabel:r.lang.pagination.prev,onClick:D}),O(zt,{key:"pgNext",...Xe,icon:Un.value[2],disable:Pe.value,ariaLabel:r.lang.pagination.next,onClick:G})),$e.value>2&&de.push(O(zt,{key:"pgLast",...Xe,icon:Un.value[3],disable:Pe.value,ariaLabel:r.lang.pagination.last,onClick:K}))}return W.push(O("div",{class:"q-table__control"},de)),W}function Fr(){const W=e.gridHeader===!0?[O("table",{class:"q-table"},[_t()])]:e.loading===!0&&t.loading===void 0?j():void 0;return O("div",{class:"q-table__middle"},W)}function Ko(){const W=t.item!==void 0?t.item:de=>{const pe=de.cols.map(Le=>O("div",{class:"q-table__grid-item-row"},[O("div",{class:"q-table__grid-item-title"},[Le.label]),O("div",{class:"q-table__grid-item-value"},[Le.value])]));if(U.value===!0){const Le=t["body-selection"],tt=Le!==void 0?Le(de):[O(ki,{modelValue:de.selected,color:e.color,dark:o.value,dense:e.dense,"onUpdate:modelValue":(Xe,Mt)=>{se([de.key],[de.row],Xe,Mt)}})];pe.unshift(O("div",{class:"q-table__grid-item-row"},tt),O(ev,{dark:o.value}))}const _e={class:["q-table__grid-item-card"+v.value,e.cardClass],style:e.cardStyle};if(e.cardStyleFn!==void 0&&(_e.style=[_e.style,e.cardStyleFn(de.row)]),e.cardClassFn!==void 0){const Le=e.cardClassFn(de.row);Le&&(_e.class[0]+=` ${Le}`)}return(e.onRowClick!==void 0||e.onRowDblclick!==void 0||e.onRowContextmenu!==void 0)&&(_e.class[0]+=" cursor-pointer",e.onRowClick!==void 0&&(_e.onClick=Le=>{n("RowClick",Le,de.row,de.pageIndex)}),e.onRowDblclick!==void 0&&(_e.onDblclick=Le=>{n("RowDblclick",Le,de.row,de.pageIndex)}),e.onRowContextmenu!==void 0&&(_e.onContextmenu=Le=>{n("rowContextmenu",Le,de.row,de.pageIndex)})),O("div",{class:"q-table__grid-item col-xs-12 col-sm-6 col-md-4 col-lg-3"+(de.selected===!0?" q-table__grid-item--selected":"")},[O("div",_e,pe)])};return O("div",{class:["q-table__grid-content row",e.cardContainerClass],style:e.cardContainerStyle},N.value.map((de,pe)=>W(H({key:s.value(de),row:de,pageIndex:pe}))))}return Object.assign(l.proxy,{requestServerInteraction:k,setPagination:m,firstPage:p,prevPage:D,nextPage:G,lastPage:K,isRowSelected:ee,clearSelection:Y,isRowExpanded:L,setExpanded:P,sort:Z,resetVirtualScroll:h,scrollTo:y,getCellValue:Ae}),Bh(l.proxy,{filteredSortedRows:()=>I.value,computedRows:()=>N.value,computedRowsNumber:()=>T.value}),()=>{const W=[ot()],de={ref:c,class:b.value};return e.grid===!0?W.push(Fr()):Object.assign(de,{class:[de.class,e.cardClass],style:e.cardStyle}),W.push(_(),Mr()),e.loading===!0&&t.loading!==void 0&&W.push(t.loading()),O("div",de,W)}}}),vc={date:"####/##/##",datetime:"####/##/## ##:##",time:"##:##",fulltime:"##:##:##",phone:"(###) ### - ####",card:"#### #### #### ####"},Co={"#":{pattern:"[\\d]",negate:"[^\\d]"},S:{pattern:"[a-zA-Z]",negate:"[^a-zA-Z]"},N:{pattern:"[0-9a-zA-Z]",negate:"[^0-9a-zA-Z]"},A:{pattern:"[a-zA-Z]",negate:"[^a-zA-Z]",transform:e=>e.toLocaleUpperCase()},a:{pattern:"[a-zA-Z]",negate:"[^a-zA-Z]",transform:e=>e.toLocaleLowerCase()},X:{pattern:"[0-9a-zA-Z]",negate:"[^0-9a-zA-Z]",transform:e=>e.toLocaleUpperCase()},x:{pattern:"[0-9a-zA-Z]",negate:"[^0-9a-zA-Z]",transform:e=>e.toLocaleLowerCase()}},fv=Object.keys(Co);fv.forEach(e=>{Co[e].regex=new RegExp(Co[e].pattern)});const Gy=new RegExp("\\\\([^.*+?^${}()|([\\]])|([.*+?^${}()|[\\]])|(["+fv.join("")+"])|(.)","g"),mc=/[.*+?^${}()|[\]\\]/g,ct=String.fromCharCode(1),Xy={mask:String,reverseFillMask:Boolean,fillMask:[Boolean,String],unmaskedValue:Boolean};function Jy(e,t,n,l){let r,o,i,a,s,c;const u=ne(null),f=ne(d());function v(){return e.autogrow===!0||["textarea","text","search","url","tel","password"].includes(e.type)}me(()=>e.type+e.autogrow,C),me(()=>e.mask,S=>{if(S!==void 0)E(f.value,!0);else{const N=A(f.value);C(),e.modelValue!==N&&t("update:modelValue",N)}}),me(()=>e.fillMask+e.reverseFillMask,()=>{u.value===!0&&E(f.value,!0)}),me(()=>e.unmaskedValue,()=>{u.value===!0&&E(f.value)});function d(){if(C(),u.value===!0){const S=L(A(e.modelValue));return e.fillMask!==!1?I(S):S}return e.modelValue}function b(S){if(S<r.length)return r.slice(-S);let N="",U=r;const x=U.indexOf(ct);if(x!==-1){for(let Q=S-U.length;Q>0;Q--)N+=ct;U=U.slice(0,x)+N+U.slice(x)}return U}function C(){if(u.value=e.mask!==void 0&&e.mask.length!==0&&v(),u.value===!1){a=void 0,r="",o="";return}const S=vc[e.mask]===void 0?e.mask:vc[e.mask],N=typeof e.fillMask=="string"&&e.fillMask.length!==0?e.fillMask.slice(0,1):"_",U=N.replace(mc,"\\$&"),x=[],Q=[],B=[];let te=e.reverseFillMask===!0,J="",ee="";S.replace(Gy,($,q,ae,ge,ye)=>{if(ge!==void 0){const Z=Co[ge];B.push(Z),ee=Z.negate,te===!0&&(Q.push("(?:"+ee+"+)?("+Z.pattern+"+)?(?:"+ee+"+)?("+Z.pattern+"+)?"),te=!1),Q.push("(?:"+ee+"+)?("+Z.pattern+")?")}else if(ae!==void 0)J="\\"+(ae==="\\"?"":ae),B.push(ae),x.push("([^"+J+"]+)?"+J+"?");else{const Z=q!==void 0?q:ye;J=Z==="\\"?"\\\\\\\\":Z.replace(mc,"\\\\$&"),B.push(Z),x.push("([^"+J+"]+)?"+J+"?")}});const Y=new RegExp("^"+x.join("")+"("+(J===""?".":"[^"+J+"]")+"+)?"+(J===""?"":"["+J+"]*")+"$"),se=Q.length-1,he=Q.map(($,q)=>q===0&&e.reverseFillMask===!0?new RegExp("^"+U+"*"+$):q===se?new RegExp("^"+$+"("+(ee===""?".":ee)+"+)?"+(e.reverseFillMask===!0?"$":U+"*")):new RegExp("^"+$));i=B,a=$=>{const q=Y.exec(e.reverseFillMask===!0?$:$.slice(0,B.length+1));q!==null&&($=q.slice(1).join(""));const ae=[],ge=he.length;for(let ye=0,Z=$;ye<ge;ye++){const ie=he[ye].exec(Z);if(ie===null)break;Z=Z.slice(ie.shift().length),ae.push(...ie)}return ae.length!==0?ae.join(""):$},r=B.map($=>typeof $=="string"?$:ct).join(""),o=r.split(ct).join(N)}function E(S,N,U){const x=l.value,Q=x.selectionEnd,B=x.value.length-Q,te=A(S);N===!0&&C();const J=L(te),ee=e.fillMask!==!1?I(J):J,Y=f.value!==ee;x.value!==ee&&(x.value=ee),Y===!0&&(f.value=ee),document.activeElement===x&&Ke(()=>{if(ee===o){const he=e.reverseFillMask===!0?o.length:0;x.setSelectionRange(he,he,"forward");return}if(U==="insertFromPaste"&&e.reverseFillMask!==!0){const he=x.selectionEnd;let $=Q-1;for(let q=s;q<=$&&q<he;q++)r[q]!==ct&&$++;k.right(x,$);return}if(["deleteContentBackward","deleteContentForward"].indexOf(U)!==-1){const he=e.reverseFillMask===!0?Q===0?ee.length>J.length?1:0:Math.max(0,ee.length-(ee===o?0:Math.min(J.length,B)+1))+1:Q;x.setSelectionRange(he,he,"forward");return}if(e.reverseFillMask===!0)if(Y===!0){const he=Math.max(0,ee.length-(ee===o?0:Math.min(J.length,B+1)));he===1&&Q===1?x.setSelectionRange(he,he,"forward"):k.rightReverse(x,he)}else{const he=ee.length-B;x.setSelectionRange(he,he,"backward")}else if(Y===!0){const he=Math.max(0,r.indexOf(ct),Math.min(J.length,Q)-1);k.right(x,he)}else{const he=Q-1;k.right(x,he)}});const se=e.unmaskedValue===!0?A(ee):ee;String(e.modelValue)!==se&&(e.modelValue!==null||se!=="")&&n(se,!0)}function F(S,N,U){const x=L(A(S.value));N=Math.max(0,r.indexOf(ct),Math.min(x.length,N)),s=N,S.setSelectionRange(N,U,"forward")}const k={left(S,N){const U=r.slice(N-1).indexOf(ct)===-1;let x=Math.max(0,N-1);for(;x>=0;x--)if(r[x]===ct){N=x,U===!0&&N++;break}if(x<0&&r[N]!==void 0&&r[N]!==ct)return k.right(S,0);N>=0&&S.setSelectionRange(N,N,"backward")},right(S,N){const U=S.value.length;let x=Math.min(U,N+1);for(;x<=U;x++)if(r[x]===ct){N=x;break}else r[x-1]===ct&&(N=x);if(x>U&&r[N-1]!==void 0&&r[N-1]!==ct)return k.left(S,U);S.setSelectionRange(N,N,"forward")},leftReverse(S,N){const U=b(S.value.length);let x=Math.max(0,N-1);for(;x>=0;x--)if(U[x-1]===ct){N=x;break}else if(U[x]===ct&&(N=x,x===0))break;if(x<0&&U[N]!==void 0&&U[N]!==ct)return k.rightReverse(S,0);N>=0&&S.setSelectionRange(N,N,"backward")},rightReverse(S,N){const U=S.value.length,x=b(U),Q=x.slice(0,N+1).indexOf(ct)===-1;let B=Math.min(U,N+1);for(;B<=U;B++)if(x[B-1]===ct){N=B,N>0&&Q===!0&&N--;break}if(B>U&&x[N-1]!==void 0&&x[N-1]!==ct)return k.leftReverse(S,U);S.setSelectionRange(N,N,"forward")}};function m(S){t("click",S),c=void 0}function g(S){if(t("keydown",S),Fo(S)===!0||S.altKey===!0)return;const N=l.value,U=N.selectionStart,x=N.selectionEnd;if(S.shiftKey||(c=void 0),S.keyCode===37||S.keyCode===39){S.shiftKey&&c===void 0&&(c=N.selectionDirection==="forward"?U:x);const Q=k[(S.keyCode===39?"right":"left")+(e.reverseFillMask===!0?"Reverse":"")];if(S.preventDefault(),Q(N,c===U?x:U),S.shiftKey){const B=N.selectionStart;N.setSelectionRange(Math.min(c,B),Math.max(c,B),"forward")}}else S.keyCode===8&&e.reverseFillMask!==!0&&U===x?(k.left(N,U),N.setSelectionRange(N.selectionStart,x,"backward")):S.keyCode===46&&e.reverseFillMask===!0&&U===x&&(k.rightReverse(N,x),N.setSelectionRange(U,N.selectionEnd,"forward"))}function L(S){if(S==null||S==="")return"";if(e.reverseFillMask===!0)return P(S);const N=i;let U=0,x="";for(let Q=0;Q<N.length;Q++){const B=S[U],te=N[Q];if(typeof te=="string")x+=te,B===te&&U++;else if(B!==void 0&&te.regex.test(B))x+=te.transform!==void 0?te.transform(B):B,U++;else return x}return x}function P(S){const N=i,U=r.indexOf(ct);let x=S.length-1,Q="";for(let B=N.length-1;B>=0&&x!==-1;B--){const te=N[B];let J=S[x];if(typeof te=="string")Q=te+Q,J===te&&x--;else if(J!==void 0&&te.regex.test(J))do Q=(te.transform!==void 0?te.transform(J):J)+Q,x--,J=S[x];while(U===B&&J!==void 0&&te.regex.test(J));else return Q}return Q}function A(S){return typeof S!="string"||a===void 0?typeof S=="number"?a(""+S):S:a(S)}function I(S){return o.length-S.length<=0?S:e.reverseFillMask===!0&&S.length!==0?o.slice(0,-S.length)+S:S+o.slice(S.length)}return{innerValue:f,hasMask:u,moveCursorForPaste:F,updateMaskValue:E,onMaskedKeydown:g,onMaskedClick:m}}function Zy(e,t){function n(){const l=e.modelValue;try{const r="DataTransfer"in window?new DataTransfer:"ClipboardEvent"in window?new ClipboardEvent("").clipboardData:void 0;return Object(l)===l&&("length"in l?Array.from(l):[l]).forEach(o=>{r.items.add(o)}),{files:r.files}}catch{return{files:void 0}}}return w(t===!0?()=>{if(e.type==="file")return n()}:n)}const xi=Re({name:"QInput",inheritAttrs:!1,props:{...jo,...Xy,...Ja,modelValue:[String,Number,FileList],shadowText:String,type:{type:String,default:"text"},debounce:[String,Number],autogrow:Boolean,inputClass:[Array,String,Object],inputStyle:[Array,String,Object]},emits:[...Qa,"paste","change","keydown","click","animationend"],setup(e,{emit:t,attrs:n}){const{proxy:l}=Ce(),{$q:r}=l,o={};let i=NaN,a,s,c=null,u;const f=ne(null),v=sv(e),{innerValue:d,hasMask:b,moveCursorForPaste:C,updateMaskValue:E,onMaskedKeydown:F,onMaskedClick:k}=Jy(e,t,J,f),m=Zy(e,!0),g=w(()=>wr(d.value)),L=uv(B),P=Ga({changeEvent:!0}),A=w(()=>e.type==="textarea"||e.autogrow===!0),I=w(()=>A.value===!0||["text","search","url","tel","password"].includes(e.type)),S=w(()=>{const q={...P.splitAttrs.listeners.value,onInput:B,onPaste:Q,onChange:Y,onBlur:se,onFocus:on};return q.onCompositionstart=q.onCompositionupdate=q.onCompositionend=L,b.value===!0&&(q.onKeydown=F,q.onClick=k),e.autogrow===!0&&(q.onAnimationend=te),q}),N=w(()=>{const q={tabindex:0,"data-autofocus":e.autofocus===!0||void 0,rows:e.type==="textarea"?6:void 0,"aria-label":e.label,name:v.value,...P.splitAttrs.attributes.value,id:P.targetUid.value,maxlength:e.maxlength,disabled:e.disable===!0,readonly:e.readonly===!0};return A.value===!1&&(q.type=e.type),e.autogrow===!0&&(q.rows=1),q});me(()=>e.type,()=>{f.value&&(f.value.value=e.modelValue)}),me(()=>e.modelValue,q=>{if(b.value===!0){if(s===!0&&(s=!1,String(q)===i))return;E(q)}else d.value!==q&&(d.value=q,e.type==="number"&&o.hasOwnProperty("value")===!0&&(a===!0?a=!1:delete o.value));e.autogrow===!0&&Ke(ee)}),me(()=>e.autogrow,q=>{q===!0?Ke(ee):f.value!==null&&n.rows>0&&(f.value.style.height="auto")}),me(()=>e.dense,()=>{e.autogrow===!0&&Ke(ee)});function U(){qo(()=>{const q=document.activeElement;f.value!==null&&f.value!==q&&(q===null||q.id!==P.targetUid.value)&&f.value.focus({preventScroll:!0})})}function x(){var q;(q=f.value)==null||q.select()}function Q(q){if(b.value===!0&&e.reverseFillMask!==!0){const ae=q.target;C(ae,ae.selectionStart,ae.selectionEnd)}t("paste",q)}function B(q){if(!q||!q.target)return;if(e.type==="file"){t("update:modelValue",q.target.files);return}const ae=q.target.value;if(q.target.qComposing===!0){o.value=ae;return}if(b.value===!0)E(ae,!1,q.inputType);else if(J(ae),I.value===!0&&q.target===document.activeElement){const{selectionStart:ge,selectionEnd:ye}=q.target;ge!==void 0&&ye!==void 0&&Ke(()=>{q.target===document.activeElement&&ae.indexOf(q.target.value)===0&&q.target.setSelectionRange(ge,ye)})}e.autogrow===!0&&ee()}function te(q){t("animationend",q),ee()}function J(q,ae){u=()=>{c=null,e.type!=="number"&&o.hasOwnProperty("value")===!0&&delete o.value,e.modelValue!==q&&i!==q&&(i=q,ae===!0&&(s=!0),t("update:modelValue",q),Ke(()=>{i===q&&(i=NaN)})),u=void 0},e.type==="number"&&(a=!0,o.value=q),e.debounce!==void 0?(c!==null&&clearTimeout(c),o.value=q,c=setTimeout(u,e.debounce)):u()}function ee(){requestAnimationFrame(()=>{const q=f.value;if(q!==null){const ae=q.parentNode.style,{scrollTop:ge}=q,{overflowY:ye,maxHeight:Z}=r.platform.is.firefox===!0?{}:window.getComputedStyle(q),ie=ye!==void 0&&ye!=="scroll";ie===!0&&(q.style.overflowY="hidden"),ae.marginBottom=q.scrollHeight-1+"px",q.style.height="1px",q.style.height=q.scrollHeight+"px",ie===!0&&(q.style.overflowY=parseInt(Z,10)<q.scrollHeight?"auto":"hidden"),ae.marginBottom="",q.scrollTop=ge}})}function Y(q){L(q),c!==null&&(clearTimeout(c),c=null),u==null||u(),t("change",q.target.value)}function se(q){q!==void 0&&on(q),c!==null&&(clearTimeout(c),c=null),u==null||u(),a=!1,s=!1,delete o.value,e.type!=="file"&&setTimeout(()=>{f.value!==null&&(f.value.value=d.value!==void 0?d.value:"")})}function he(){return o.hasOwnProperty("value")===!0?o.value:d.value!==void 0?d.value:""}Ge(()=>{se()}),gt(()=>{e.autogrow===!0&&ee()}),Object.assign(P,{innerValue:d,fieldClass:w(()=>`q-${A.value===!0?"textarea":"input"}`+(e.autogrow===!0?" q-textarea--autogrow":"")),hasShadow:w(()=>e.type!=="file"&&typeof e.shadowText=="string"&&e.shadowText.length!==0),inputRef:f,emitValue:J,hasValue:g,floatingLabel:w(()=>g.value===!0&&(e.type!=="number"||isNaN(d.value)===!1)||wr(e.displayValue)),getControl:()=>O(A.value===!0?"textarea":"input",{ref:f,class:["q-field__native q-placeholder",e.inputClass],style:e.inputStyle,...N.value,...S.value,...e.type!=="file"?{value:he()}:m.value}),getShadowControl:()=>O("div",{class:"q-field__native q-field__shadow absolute-bottom no-pointer-events"+(A.value===!0?"":" text-no-wrap")},[O("span",{class:"invisible"},he()),O("span",e.shadowText)])});const $=Xa(P);return Object.assign(l,{focus:U,select:x,getNativeElement:()=>f.value}),Wt(l,"nativeEl",()=>f.value),$}}),e0=Re({name:"QCardActions",props:{...sd,vertical:Boolean},setup(e,{slots:t}){const n=ud(e),l=w(()=>`q-card__actions ${n.value} q-card__actions--${e.vertical===!0?"vert column":"horiz row"}`);return()=>O("div",{class:l.value},Ve(t.default))}}),t0={class:"text-h6"},n0={class:"text-h6"},l0={__name:"Players",setup(e){const t=Zd(),{t:n}=Nr(),l=ne(!1),r=ne(!1),o=ne({id:null,name:"",surname:"",balance:0}),i=ne(0),a=w(()=>[{name:"id",label:n("id"),field:"id",align:"left"},{name:"name",label:n("name"),field:"name",align:"left"},{name:"surname",label:n("surname"),field:"surname",align:"left"},{name:"balance",label:n("balance"),field:"balance",align:"right"},{name:"actions",label:n("actions"),field:"actions",align:"center"}]);function s(){o.value={id:null,name:"",surname:"",balance:0},r.value=!1}function c(d){o.value={...d},r.value=!0,l.value=!0}async function u(){let d;r.value?d=await t.updatePlayer(o.value.id,{name:o.value.name,surname:o.value.surname,balance:o.value.balance}):d=await t.createPlayer({name:o.value.name,surname:o.value.surname,balance:o.value.balance}),d!=null&&d.error||(l.value=!1,s(),await v())}async function f(d){await t.deletePlayer(d.id),await v()}async function v(){await t.fetchPlayers(),i.value++}return gt(()=>{v()}),(d,b)=>(el(),tl(Xi,null,{default:Me(()=>[be(Tl,null,{default:Me(()=>[Il("div",t0,Pl(d.$t("players")),1),be(zt,{color:"primary",icon:"add",label:d.$t("addPlayer"),onClick:b[0]||(b[0]=C=>l.value=!0),class:"q-ml-sm"},null,8,["label"])]),_:1}),be(ev),be(Tl,null,{default:Me(()=>[(el(),tl(Qy,{rows:Zn(t).players,columns:a.value,"row-key":"id",flat:"",dense:"",pagination:{rowsPerPage:5},"rows-per-page-label":d.$t("recordsPerPage"),key:i.value},{"body-cell-actions":Me(C=>[be(qp,null,{default:Me(()=>[be(zt,{size:"sm",color:"primary",icon:"edit",flat:"",onClick:E=>c(C.row)},null,8,["onClick"]),be(zt,{size:"sm",color:"negative",icon:"delete",flat:"",onClick:E=>f(C.row)},null,8,["onClick"])]),_:2},1024)]),_:1},8,["rows","columns","rows-per-page-label"]))]),_:1}),be(av,{modelValue:l.value,"onUpdate:modelValue":b[4]||(b[4]=C=>l.value=C)},{default:Me(()=>[be(Xi,{style:{"min-width":"350px"}},{default:Me(()=>[be(Tl,null,{default:Me(()=>[Il("div",n0,Pl(r.value?d.$t("editPlayer"):d.$t("addPlayer")),1)]),_:1}),be(Tl,null,{default:Me(()=>[be(xi,{modelValue:o.value.name,"onUpdate:modelValue":b[1]||(b[1]=C=>o.value.name=C),label:d.$t("name")},null,8,["modelValue","label"]),be(xi,{modelValue:o.value.surname,"onUpdate:modelValue":b[2]||(b[2]=C=>o.value.surname=C),label:d.$t("surname")},null,8,["modelValue","label"]),be(xi,{modelValue:o.value.balance,"onUpdate:modelValue":b[3]||(b[3]=C=>o.value.balance=C),modelModifiers:{number:!0},label:d.$t("balance"),type:"number"},null,8,["modelValue","label"])]),_:1}),be(e0,{align:"right"},{default:Me(()=>[Ml(be(zt,{flat:"",label:d.$t("cancel"),onClick:s},null,8,["label"]),[[Ji]]),be(zt,{flat:"",label:d.$t("save"),color:"primary",onClick:u},null,8,["label"])]),_:1})]),_:1})]),_:1},8,["modelValue"])]),_:1}))}};const r0={class:"text-h5 text-center"},o0={class:"q-mb-md text-center"},i0={class:"q-mt-md"},a0={__name:"App",setup(e){const t=Zd(),{locale:n}=Nr(),l=ne("players");function r(o){n.value=o}return(o,i)=>(el(),tl(Sb,{view:"lHh Lpr lFf"},{default:Me(()=>[be(ib,{elevated:"",class:"bg-primary text-white"},{default:Me(()=>[be(Yg,null,{default:Me(()=>[be(Gh,null,{default:Me(()=>[be(xt,{name:"apps",class:"q-mr-sm"}),Gl(" "+Pl(o.$t("title")),1)]),_:1}),be(zt,{flat:"",dense:"",round:"",icon:"language"},{default:Me(()=>[be(od,null,{default:Me(()=>[be(Df,null,{default:Me(()=>[Ml((el(),tl(ji,{clickable:"",onClick:i[0]||(i[0]=a=>r("en"))},{default:Me(()=>[be(Ui,null,{default:Me(()=>i[4]||(i[4]=[Gl("English")])),_:1,__:[4]})]),_:1})),[[Ji]]),Ml((el(),tl(ji,{clickable:"",onClick:i[1]||(i[1]=a=>r("fr"))},{default:Me(()=>[be(Ui,null,{default:Me(()=>i[5]||(i[5]=[Gl("Français")])),_:1,__:[5]})]),_:1})),[[Ji]])]),_:1})]),_:1})]),_:1})]),_:1}),be(ob,{modelValue:l.value,"onUpdate:modelValue":i[2]||(i[2]=a=>l.value=a),class:"bg-primary text-white",align:"left",dense:"","indicator-color":"yellow"},{default:Me(()=>[be(ru,{name:"players",icon:"people",label:o.$t("players")},null,8,["label"]),be(ru,{name:"ping",icon:"wifi_tethering",label:o.$t("pingTab")},null,8,["label"])]),_:1},8,["modelValue"])]),_:1}),be(_b,null,{default:Me(()=>[be(bb,{modelValue:l.value,"onUpdate:modelValue":i[3]||(i[3]=a=>l.value=a),animated:""},{default:Me(()=>[be(cu,{name:"players"},{default:Me(()=>[be(iu,{class:"q-pa-md",style:{"min-height":"300px"}},{default:Me(()=>[be(l0)]),_:1})]),_:1}),be(cu,{name:"ping"},{default:Me(()=>[be(iu,{class:"q-pa-md flex flex-center bg-grey-2",style:{"min-height":"300px"}},{default:Me(()=>[be(Xi,{class:"my-card"},{default:Me(()=>[be(Tl,{class:"bg-primary text-white"},{default:Me(()=>[Il("div",r0,Pl(o.$t("welcome")),1)]),_:1}),be(Tl,null,{default:Me(()=>[Il("div",o0,[be(zt,{color:"primary",onClick:Zn(t).pingBackend,label:o.$t("ping"),unelevated:"",rounded:""},null,8,["onClick","label"]),be(zt,{color:"secondary",onClick:Zn(t).clearResult,label:o.$t("clear"),unelevated:"",rounded:"",class:"q-ml-sm"},null,8,["onClick","label"])]),Il("div",i0,[Zn(t).pingResult?(el(),tl(gb,{key:0,dense:"",class:"bg-green-2 text-primary text-center"},{default:Me(()=>[Gl(Pl(Zn(t).pingResult),1)]),_:1})):eh("",!0)])]),_:1})]),_:1})]),_:1})]),_:1})]),_:1},8,["modelValue"])]),_:1})]),_:1}))}},Li=["sm","md","lg","xl"],{passive:hc}=nt,s0=Mo({width:0,height:0,name:"xs",sizes:{sm:600,md:1024,lg:1440,xl:1920},lt:{sm:!0,md:!0,lg:!0,xl:!0},gt:{xs:!1,sm:!1,md:!1,lg:!1},xs:!0,sm:!1,md:!1,lg:!1,xl:!1},{setSizes:En,setDebounce:En,install({$q:e,onSSRHydrated:t}){var f;if(e.screen=this,this.__installed===!0){e.config.screen!==void 0&&(e.config.screen.bodyClasses===!1?document.body.classList.remove(`screen--${this.name}`):this.__update(!0));return}const{visualViewport:n}=window,l=n||window,r=document.scrollingElement||document.documentElement,o=n===void 0||Ye.is.mobile===!0?()=>[Math.max(window.innerWidth,r.clientWidth),Math.max(window.innerHeight,r.clientHeight)]:()=>[n.width*n.scale+window.innerWidth-r.clientWidth,n.height*n.scale+window.innerHeight-r.clientHeight],i=((f=e.config.screen)==null?void 0:f.bodyClasses)===!0;this.__update=v=>{const[d,b]=o();if(b!==this.height&&(this.height=b),d!==this.width)this.width=d;else if(v!==!0)return;let C=this.sizes;this.gt.xs=d>=C.sm,this.gt.sm=d>=C.md,this.gt.md=d>=C.lg,this.gt.lg=d>=C.xl,this.lt.sm=d<C.sm,this.lt.md=d<C.md,this.lt.lg=d<C.lg,this.lt.xl=d<C.xl,this.xs=this.lt.sm,this.sm=this.gt.xs===!0&&this.lt.md===!0,this.md=this.gt.sm===!0&&this.lt.lg===!0,this.lg=this.gt.md===!0&&this.lt.xl===!0,this.xl=this.gt.lg,C=this.xs===!0&&"xs"||this.sm===!0&&"sm"||this.md===!0&&"md"||this.lg===!0&&"lg"||"xl",C!==this.name&&(i===!0&&(document.body.classList.remove(`screen--${this.name}`),document.body.classList.add(`screen--${C}`)),this.name=C)};let a,s={},c=16;this.setSizes=v=>{Li.forEach(d=>{v[d]!==void 0&&(s[d]=v[d])})},this.setDebounce=v=>{c=v};const u=()=>{const v=getComputedStyle(document.body);v.getPropertyValue("--q-size-sm")&&Li.forEach(d=>{this.sizes[d]=parseInt(v.getPropertyValue(`--q-size-${d}`),10)}),this.setSizes=d=>{Li.forEach(b=>{d[b]&&(this.sizes[b]=d[b])}),this.__update(!0)},this.setDebounce=d=>{a!==void 0&&l.removeEventListener("resize",a,hc),a=d>0?Ya(this.__update,d):this.__update,l.addEventListener("resize",a,hc)},this.setDebounce(c),Object.keys(s).length!==0?(this.setSizes(s),s=void 0):this.__update(),i===!0&&this.name==="xs"&&document.body.classList.add("screen--xs")};cn.value===!0?t.push(u):u()}}),Ct=Mo({isActive:!1,mode:!1},{__media:void 0,set(e){Ct.mode=e,e==="auto"?(Ct.__media===void 0&&(Ct.__media=window.matchMedia("(prefers-color-scheme: dark)"),Ct.__updateMedia=()=>{Ct.set("auto")},Ct.__media.addListener(Ct.__updateMedia)),e=Ct.__media.matches):Ct.__media!==void 0&&(Ct.__media.removeListener(Ct.__updateMedia),Ct.__media=void 0),Ct.isActive=e===!0,document.body.classList.remove(`body--${e===!0?"light":"dark"}`),document.body.classList.add(`body--${e===!0?"dark":"light"}`)},toggle(){Ct.set(Ct.isActive===!1)},install({$q:e,ssrContext:t}){const{dark:n}=e.config;e.dark=this,this.__installed!==!0&&this.set(n!==void 0?n:!1)}});function u0(e,t,n=document.body){if(typeof e!="string")throw new TypeError("Expected a string as propName");if(typeof t!="string")throw new TypeError("Expected a string as value");if(!(n instanceof Element))throw new TypeError("Expected a DOM element");n.style.setProperty(`--q-${e}`,t)}function dv(e){if(e.ios===!0)return"ios";if(e.android===!0)return"android"}function c0({is:e,has:t,within:n},l){const r=[e.desktop===!0?"desktop":"mobile",`${t.touch===!1?"no-":""}touch`];if(e.mobile===!0){const o=dv(e);o!==void 0&&r.push("platform-"+o)}if(e.nativeMobile===!0){const o=e.nativeMobileWrapper;r.push(o),r.push("native-mobile"),e.ios===!0&&(l[o]===void 0||l[o].iosStatusBarPadding!==!1)&&r.push("q-ios-padding")}else e.electron===!0?r.push("electron"):e.bex===!0&&r.push("bex");return n.iframe===!0&&r.push("within-iframe"),r}function f0(){const{is:e}=Ye,t=document.body.className,n=new Set(t.replace(/ {2}/g," ").split(" "));if(e.nativeMobile!==!0&&e.electron!==!0&&e.bex!==!0){if(e.desktop===!0)n.delete("mobile"),n.delete("platform-ios"),n.delete("platform-android"),n.add("desktop");else if(e.mobile===!0){n.delete("desktop"),n.add("mobile"),n.delete("platform-ios"),n.delete("platform-android");const r=dv(e);r!==void 0&&n.add(`platform-${r}`)}}Ye.has.touch===!0&&(n.delete("no-touch"),n.add("touch")),Ye.within.iframe===!0&&n.add("within-iframe");const l=Array.from(n).join(" ");t!==l&&(document.body.className=l)}function d0(e){for(const t in e)u0(t,e[t])}const v0={install(e){if(this.__installed!==!0){if(cn.value===!0)f0();else{const{$q:t}=e;t.config.brand!==void 0&&d0(t.config.brand);const n=c0(Ye,t.config);document.body.classList.add.apply(document.body.classList,n)}Ye.is.ios===!0&&document.body.addEventListener("touchstart",En),window.addEventListener("keydown",rg,!0)}}},gc={isoName:"en-US",nativeName:"English (US)",label:{clear:"Clear",ok:"OK",cancel:"Cancel",close:"Close",set:"Set",select:"Select",reset:"Reset",remove:"Remove",update:"Update",create:"Create",search:"Search",filter:"Filter",refresh:"Refresh",expand:e=>e?`Expand "${e}"`:"Expand",collapse:e=>e?`Collapse "${e}"`:"Collapse"},date:{days:"Sunday_Monday_Tuesday_Wednesday_Thursday_Friday_Saturday".split("_"),daysShort:"Sun_Mon_Tue_Wed_Thu_Fri_Sat".split("_"),months:"January_February_March_April_May_June_July_August_September_October_November_December".split("_"),monthsShort:"Jan_Feb_Mar_Apr_May_Jun_Jul_Aug_Sep_Oct_Nov_Dec".split("_"),firstDayOfWeek:0,format24h:!1,pluralDay:"days",prevMonth:"Previous month",nextMonth:"Next month",prevYear:"Previous year",nextYear:"Next year",today:"Today",prevRangeYears:e=>`Previous ${e} years`,nextRangeYears:e=>`Next ${e} years`},table:{noData:"No data available",noResults:"No matching records found",loading:"Loading...",selectedRecords:e=>e===1?"1 record selected.":(e===0?"No":e)+" records selected.",recordsPerPage:"Records per page:",allRows:"All",pagination:(e,t,n)=>e+"-"+t+" of "+n,columns:"Columns"},pagination:{first:"First page",prev:"Previous page",next:"Next page",last:"Last page"},editor:{url:"URL",bold:"Bold",italic:"Italic",strikethrough:"Strikethrough",underline:"Underline",unorderedList:"Unordered List",orderedList:"Ordered List",subscript:"Subscript",superscript:"Superscript",hyperlink:"Hyperlink",toggleFullscreen:"Toggle Fullscreen",quote:"Quote",left:"Left align",center:"Center align",right:"Right align",justify:"Justify align",print:"Print",outdent:"Decrease indentation",indent:"Increase indentation",removeFormat:"Remove formatting",formatting:"Formatting",fontSize:"Font Size",align:"Align",hr:"Insert Horizontal Rule",undo:"Undo",redo:"Redo",heading1:"Heading 1",heading2:"Heading 2",heading3:"Heading 3",heading4:"Heading 4",heading5:"Heading 5",heading6:"Heading 6",paragraph:"Paragraph",code:"Code",size1:"Very small",size2:"A bit small",size3:"Normal",size4:"Medium-large",size5:"Big",size6:"Very big",size7:"Maximum",defaultFont:"Default Font",viewSource:"View Source"},tree:{noNodes:"No nodes available",noResults:"No matching nodes found"}};function bc(){const e=Array.isArray(navigator.languages)===!0&&navigator.languages.length!==0?navigator.languages[0]:navigator.language;if(typeof e=="string")return e.split(/[-_]/).map((t,n)=>n===0?t.toLowerCase():n>1||t.length<4?t.toUpperCase():t[0].toUpperCase()+t.slice(1).toLowerCase()).join("-")}const Nn=Mo({__qLang:{}},{getLocale:bc,set(e=gc,t){const n={...e,rtl:e.rtl===!0,getLocale:bc};{if(n.set=Nn.set,Nn.__langConfig===void 0||Nn.__langConfig.noHtmlAttrs!==!0){const l=document.documentElement;l.setAttribute("dir",n.rtl===!0?"rtl":"ltr"),l.setAttribute("lang",n.isoName)}Object.assign(Nn.__qLang,n)}},install({$q:e,lang:t,ssrContext:n}){e.lang=Nn.__qLang,Nn.__langConfig=e.config.lang,this.__installed===!0?t!==void 0&&this.set(t):(this.props=new Proxy(this.__qLang,{get(){return Reflect.get(...arguments)},ownKeys(l){return Reflect.ownKeys(l).filter(r=>r!=="set"&&r!=="getLocale")}}),this.set(t||gc))}}),m0={name:"material-icons",type:{positive:"check_circle",negative:"warning",info:"info",warning:"priority_high"},arrow:{up:"arrow_upward",right:"arrow_forward",down:"arrow_downward",left:"arrow_back",dropdown:"arrow_drop_down"},chevron:{left:"chevron_left",right:"chevron_right"},colorPicker:{spectrum:"gradient",tune:"tune",palette:"style"},pullToRefresh:{icon:"refresh"},carousel:{left:"chevron_left",right:"chevron_right",up:"keyboard_arrow_up",down:"keyboard_arrow_down",navigationIcon:"lens"},chip:{remove:"cancel",selected:"check"},datetime:{arrowLeft:"chevron_left",arrowRight:"chevron_right",now:"access_time",today:"today"},editor:{bold:"format_bold",italic:"format_italic",strikethrough:"strikethrough_s",underline:"format_underlined",unorderedList:"format_list_bulleted",orderedList:"format_list_numbered",subscript:"vertical_align_bottom",superscript:"vertical_align_top",hyperlink:"link",toggleFullscreen:"fullscreen",quote:"format_quote",left:"format_align_left",center:"format_align_center",right:"format_align_right",justify:"format_align_justify",print:"print",outdent:"format_indent_decrease",indent:"format_indent_increase",removeFormat:"format_clear",formatting:"text_format",fontSize:"format_size",align:"format_align_left",hr:"remove",undo:"undo",redo:"redo",heading:"format_size",code:"code",size:"format_size",font:"font_download",viewSource:"code"},expansionItem:{icon:"keyboard_arrow_down",denseIcon:"arrow_drop_down"},fab:{icon:"add",activeIcon:"close"},field:{clear:"cancel",error:"error"},pagination:{first:"first_page",prev:"keyboard_arrow_left",next:"keyboard_arrow_right",last:"last_page"},rating:{icon:"grade"},stepper:{done:"check",active:"edit",error:"warning"},tabs:{left:"chevron_left",right:"chevron_right",up:"keyboard_arrow_up",down:"keyboard_arrow_down"},table:{arrowUp:"arrow_upward",warning:"warning",firstPage:"first_page",prevPage:"chevron_left",nextPage:"chevron_right",lastPage:"last_page"},tree:{icon:"play_arrow"},uploader:{done:"done",clear:"clear",add:"add_box",upload:"cloud_upload",removeQueue:"clear_all",removeUploaded:"done_all"}},Eo=Mo({iconMapFn:null,__qIconSet:{}},{set(e,t){const n={...e};n.set=Eo.set,Object.assign(Eo.__qIconSet,n)},install({$q:e,iconSet:t,ssrContext:n}){e.config.iconMapFn!==void 0&&(this.iconMapFn=e.config.iconMapFn),e.iconSet=this.__qIconSet,Wt(e,"iconMapFn",()=>this.iconMapFn,l=>{this.iconMapFn=l}),this.__installed===!0?t!==void 0&&this.set(t):(this.props=new Proxy(this.__qIconSet,{get(){return Reflect.get(...arguments)},ownKeys(l){return Reflect.ownKeys(l).filter(r=>r!=="set")}}),this.set(t||m0))}}),_c=[mo,v0,Ct,s0,Cr,Nn,Eo];function pc(e,t){t.forEach(n=>{n.install(e),n.__installed=!0})}function h0(e,t,n){e.config.globalProperties.$q=n.$q,e.provide(Qg,n.$q),pc(n,_c),t.components!==void 0&&Object.values(t.components).forEach(l=>{Qi(l)===!0&&l.name!==void 0&&e.component(l.name,l)}),t.directives!==void 0&&Object.values(t.directives).forEach(l=>{Qi(l)===!0&&l.name!==void 0&&e.directive(l.name,l)}),t.plugins!==void 0&&pc(n,Object.values(t.plugins).filter(l=>typeof l.install=="function"&&_c.includes(l)===!1)),cn.value===!0&&(n.$q.onSSRHydrated=()=>{n.onSSRHydrated.forEach(l=>{l()}),n.$q.onSSRHydrated=()=>{}})}const g0=function(e,t={}){const n={version:"2.18.1"};zf===!1?(t.config!==void 0&&Object.assign(ho,t.config),n.config={...ho},gg()):n.config=t.config||{},h0(e,t,{parentApp:e,$q:n,lang:t.lang,iconSet:t.iconSet,onSSRHydrated:[]})},b0={name:"Quasar",version:"2.18.1",install:g0,lang:Nn,iconSet:Eo};const _0={config:{},plugins:{}};const p0={en:{title:"Contoso Quasar App",welcome:"Welcome to Contoso!",ping:"Ping Backend",clear:"Clear",players:"Players",addPlayer:"Add Player",editPlayer:"Edit Player",name:"Name",surname:"Surname",balance:"Balance",cancel:"Cancel",save:"Save",pingTab:"Ping",id:"ID",actions:"Actions",recordsPerPage:"Records per page"},fr:{title:"Application Quasar Contoso",welcome:"Bienvenue chez Contoso !",ping:"Tester le backend",clear:"Effacer",players:"Joueurs",addPlayer:"Ajouter un joueur",editPlayer:"Modifier le joueur",name:"Prénom",surname:"Nom de famille",balance:"Solde",cancel:"Annuler",save:"Enregistrer",pingTab:"Ping",id:"ID",actions:"Actions",recordsPerPage:"Enregistrements par page"}},y0=bp({legacy:!1,locale:"en",fallbackLocale:"en",messages:p0}),S0=Pp();$h(a0).use(b0,_0).use(y0).use(S0).mount("#app");
@@ -0,0 +1,3 @@
+true&&(function a(){const a=document.createElement('link').relList;if(a&&a.supports&&a.supports('modulepreload')){return;}for(const a of document.querySelectorAll('link[rel="modulepreload"]')){b(a);}new MutationObserver((a)=>{for(const c of a){if(c.type!=='childList'){continue;}for(const a of c.addedNodes){if(a.tagName==='LINK'&&a.rel==='modulepreload')b(a);}}}).observe(document,{childList:true,subtree:true});function c(a){const b={};if(a.integrity)b.integrity=a.integrity;if(a.referrerPolicy)b.referrerPolicy=a.referrerPolicy;if(a.crossOrigin==='use-credentials')b.credentials='include';else if(a.crossOrigin==='anonymous')b.credentials='omit';else b.credentials='same-origin';return b;}function b(a){if(a.ep)return;a.ep=true;const b=c(a);fetch(a.href,b);}}());function dC(b){const a=Object.create(null);for(const c of b.split(","))a[c]=1;return(b)=>b in a;}const t={};const bu=[];const al=()=>{};const jN=()=>false;const cz=(a)=>a.charCodeAt(0)===111&&a.charCodeAt(1)===110&&(a.charCodeAt(2)>122||a.charCodeAt(2)<97);const dD=(a)=>a.startsWith("onUpdate:");const G=Object.assign;const dE=(a,c)=>{const b=a.indexOf(c);if(b>-1){a.splice(b,1);}};const jO=Object.prototype.hasOwnProperty;const q=(a,b)=>jO.call(a,b);const g=Array.isArray;const bv=(a)=>bR(a)==="[object Map]";const fk=(a)=>bR(a)==="[object Set]";const jP=(a)=>bR(a)==="[object RegExp]";const j=(a)=>typeof a==="function";const C=(a)=>typeof a==="string";const aR=(a)=>typeof a==="symbol";const A=(a)=>a!==null&&typeof a==="object";const fl=(a)=>{return(A(a)||j(a))&&j(a.then)&&j(a.catch);};const fm=Object.prototype.toString;const bR=(a)=>fm.call(a);const jQ=(a)=>{return bR(a).slice(8,-1);};const fn=(a)=>bR(a)==="[object Object]";const dF=(a)=>C(a)&&a!=="NaN"&&a[0]!=="-"&&""+parseInt(a,10)===a;const bS=dC(",key,ref,ref_for,ref_key,onVnodeBeforeMount,onVnodeMounted,onVnodeBeforeUpdate,onVnodeUpdated,onVnodeBeforeUnmount,onVnodeUnmounted");const cA=(b)=>{const a=Object.create(null);return(c)=>{const d=a[c];return d||(a[c]=b(c));};};const jR=/-(\w)/g;const aS=cA((a)=>{return a.replace(jR,(b,a)=>a?a.toUpperCase():"");});const jS=/\B([A-Z])/g;const aT=cA((a)=>a.replace(jS,"-$1").toLowerCase());const fo=cA((a)=>{return a.charAt(0).toUpperCase()+a.slice(1);});const dG=cA((a)=>{const b=a?`on${fo(a)}`:``;return b;});const aU=(a,b)=>!Object.is(a,b);const bT=(a,...b)=>{for(let c=0;c<a.length;c++){a[c](...b);}};const dH=(a,b,c,d=false)=>{Object.defineProperty(a,b,{configurable:true,enumerable:false,writable:d,value:c});};const jT=(a)=>{const b=parseFloat(a);return isNaN(b)?a:b;};const jU=(a)=>{const b=C(a)?Number(a):NaN;return isNaN(b)?a:b;};let fp;const cB=()=>{return fp||(fp=typeof globalThis!=="undefined"?globalThis:typeof self!=="undefined"?self:typeof window!=="undefined"?window:typeof global!=="undefined"?global:{});};function dI(a){if(g(a)){const b={};for(let c=0;c<a.length;c++){const d=a[c];const e=C(d)?jY(d):dI(d);if(e){for(const a in e){b[a]=e[a];}}}return b;}else if(C(a)||A(a)){return a;}}const jV=/;(?![^(]*\))/g;const jW=/:([^]+)/;const jX=/\/\*[^]*?\*\//g;function jY(b){const a={};b.replace(jX,"").split(jV).forEach((b)=>{if(b){const c=b.split(jW);c.length>1&&(a[c[0].trim()]=c[1].trim());}});return a;}function dJ(a){let b="";if(C(a)){b=a;}else if(g(a)){for(let c=0;c<a.length;c++){const d=dJ(a[c]);if(d){b+=d+" ";}}}else if(A(a)){for(const c in a){if(a[c]){b+=c+" ";}}}return b.trim();}const jZ=`itemscope,allowfullscreen,formnovalidate,ismap,nomodule,novalidate,readonly`;const j$=dC(jZ);function fq(a){return!!a||a==="";}const fr=(a)=>{return!!(a&&a["__v_isRef"]===true);};const aV=(a)=>{return C(a)?a:a==null?"":g(a)||A(a)&&(a.toString===fm||!j(a.toString))?fr(a)?aV(a.value):JSON.stringify(a,fs,2):String(a);};const fs=(b,a)=>{if(fr(a)){return fs(b,a.value);}else if(bv(a)){return{[`Map(${a.size})`]:[...a.entries()].reduce((a,[b,c],d)=>{a[dK(b,d)+" =>"]=c;return a;},{})};}else if(fk(a)){return{[`Set(${a.size})`]:[...a.values()].map((a)=>dK(a))};}else if(aR(a)){return dK(a);}else if(A(a)&&!g(a)&&!fn(a)){return String(a);}return a;};const dK=(a,c="")=>{var b;return(aR(a)?`Symbol(${(b=a.description)!=null?b:c})`:a);};let P;class ft{constructor(a=false){this.detached=a;this._active=true;this._on=0;this.effects=[];this.cleanups=[];this._isPaused=false;this.parent=P;if(!a&&P){this.index=(P.scopes||(P.scopes=[])).push(this)-1;}}get active(){return this._active;}pause(){if(this._active){this._isPaused=true;let a,b;if(this.scopes){for(a=0,b=this.scopes.length;a<b;a++){this.scopes[a].pause();}}for(a=0,b=this.effects.length;a<b;a++){this.effects[a].pause();}}}resume(){if(this._active){if(this._isPaused){this._isPaused=false;let a,b;if(this.scopes){for(a=0,b=this.scopes.length;a<b;a++){this.scopes[a].resume();}}for(a=0,b=this.effects.length;a<b;a++){this.effects[a].resume();}}}}run(a){if(this._active){const b=P;try{P=this;return a();}finally{P=b;}}}on(){if(++this._on===1){this.prevScope=P;P=this;}}off(){if(this._on>0&&--this._on===0){P=this.prevScope;this.prevScope=void 0;}}stop(a){if(this._active){this._active=false;let b,c;for(b=0,c=this.effects.length;b<c;b++){this.effects[b].stop();}this.effects.length=0;for(b=0,c=this.cleanups.length;b<c;b++){this.cleanups[b]();}this.cleanups.length=0;if(this.scopes){for(b=0,c=this.scopes.length;b<c;b++){this.scopes[b].stop(true);}this.scopes.length=0;}if(!this.detached&&this.parent&&!a){const a=this.parent.scopes.pop();if(a&&a!==this){this.parent.scopes[this.index]=a;a.index=this.index;}}this.parent=void 0;}}}function dL(a){return new ft(a);}function fu(){return P;}function j_(a,b=false){if(P){P.cleanups.push(a);}}let u;const dM=new WeakSet();class fv{constructor(a){this.fn=a;this.deps=void 0;this.depsTail=void 0;this.flags=1|4;this.next=void 0;this.cleanup=void 0;this.scheduler=void 0;if(P&&P.active){P.effects.push(this);}}pause(){this.flags|=64;}resume(){if(this.flags&64){this.flags&=-65;if(dM.has(this)){dM.delete(this);this.trigger();}}}notify(){if(this.flags&2&&!(this.flags&32)){return;}if(!(this.flags&8)){fx(this);}}run(){if(!(this.flags&1)){return this.fn();}this.flags|=2;fC(this);fy(this);const a=u;const b=am;u=this;am=true;try{return this.fn();}finally{fz(this);u=a;am=b;this.flags&=-3;}}stop(){if(this.flags&1){for(let a=this.deps;a;a=a.nextDep){dQ(a);}this.deps=this.depsTail=void 0;fC(this);this.onStop&&this.onStop();this.flags&=-2;}}trigger(){if(this.flags&64){dM.add(this);}else if(this.scheduler){this.scheduler();}else{this.runIfDirty();}}runIfDirty(){if(dP(this)){this.run();}}get dirty(){return dP(this);}}let fw=0;let bU;let bV;function fx(a,b=false){a.flags|=8;if(b){a.next=bV;bV=a;return;}a.next=bU;bU=a;}function dN(){fw++;}function dO(){if(--fw>0){return;}if(bV){let a=bV;bV=void 0;while(a){const b=a.next;a.next=void 0;a.flags&=-9;a=b;}}let a;while(bU){let b=bU;bU=void 0;while(b){const c=b.next;b.next=void 0;b.flags&=-9;if(b.flags&1){try{;b.trigger();}catch(b){if(!a)a=b;}}b=c;}}if(a)throw a;}function fy(a){for(let b=a.deps;b;b=b.nextDep){b.version=-1;b.prevActiveLink=b.dep.activeLink;b.dep.activeLink=b;}}function fz(c){let d;let b=c.depsTail;let a=b;while(a){const c=a.prevDep;if(a.version===-1){if(a===b)b=c;dQ(a);j0(a);}else{d=a;}a.dep.activeLink=a.prevActiveLink;a.prevActiveLink=void 0;a=c;}c.deps=d;c.depsTail=b;}function dP(a){for(let b=a.deps;b;b=b.nextDep){if(b.dep.version!==b.version||b.dep.computed&&(fA(b.dep.computed)||b.dep.version!==b.version)){return true;}}if(a._dirty){return true;}return false;}function fA(a){if(a.flags&4&&!(a.flags&16)){return;}a.flags&=-17;if(a.globalVersion===bW){return;}a.globalVersion=bW;if(!a.isSSR&&a.flags&128&&(!a.deps&&!a._dirty||!dP(a))){return;}a.flags|=2;const b=a.dep;const c=u;const d=am;u=a;am=true;try{fy(a);const c=a.fn(a._value);if(b.version===0||aU(c,a._value)){a.flags|=128;a._value=c;b.version++;}}catch(a){b.version++;throw a;}finally{u=c;am=d;fz(a);a.flags&=-3;}}function dQ(c,e=false){const{dep:a,prevSub:b,nextSub:d}=c;if(b){b.nextSub=d;c.prevSub=void 0;}if(d){d.prevSub=b;c.nextSub=void 0;}if(a.subs===c){a.subs=b;if(!b&&a.computed){a.computed.flags&=-5;for(let b=a.computed.deps;b;b=b.nextDep){dQ(b,true);}}}if(!e&&!--a.sc&&a.map){a.map.delete(a.key);}}function j0(a){const{prevDep:b,nextDep:c}=a;if(b){b.nextDep=c;a.prevDep=void 0;}if(c){c.prevDep=b;a.nextDep=void 0;}}let am=true;const fB=[];function av(){fB.push(am);am=false;}function aw(){const a=fB.pop();am=a===void 0?true:a;}function fC(a){const{cleanup:b}=a;a.cleanup=void 0;if(b){const a=u;u=void 0;try{b();}finally{u=a;}}}let bW=0;class j1{constructor(b,a){this.sub=b;this.dep=a;this.version=a.version;this.nextDep=this.prevDep=this.nextSub=this.prevSub=this.prevActiveLink=void 0;}}class dR{constructor(a){this.computed=a;this.version=0;this.activeLink=void 0;this.subs=void 0;this.map=void 0;this.key=void 0;this.sc=0;this.__v_skip=true;}track(b){if(!u||!am||u===this.computed){return;}let a=this.activeLink;if(a===void 0||a.sub!==u){a=this.activeLink=new j1(u,this);if(!u.deps){u.deps=u.depsTail=a;}else{a.prevDep=u.depsTail;u.depsTail.nextDep=a;u.depsTail=a;}fD(a);}else if(a.version===-1){a.version=this.version;if(a.nextDep){const b=a.nextDep;b.prevDep=a.prevDep;if(a.prevDep){a.prevDep.nextDep=b;}a.prevDep=u.depsTail;a.nextDep=void 0;u.depsTail.nextDep=a;u.depsTail=a;if(u.deps===a){u.deps=b;}}}return a;}trigger(a){this.version++;bW++;this.notify(a);}notify(a){dN();try{if(!!("production"!=="production"));for(let a=this.subs;a;a=a.prevSub){if(a.sub.notify()){;a.sub.dep.notify();}}}finally{dO();}}}function fD(a){a.dep.sc++;if(a.sub.flags&4){const c=a.dep.computed;if(c&&!a.dep.subs){c.flags|=4|16;for(let a=c.deps;a;a=a.nextDep){fD(a);}}const b=a.dep.subs;if(b!==a){a.prevSub=b;if(b)b.nextSub=a;}a.dep.subs=a;}}const cC=new WeakMap();const a8=Symbol("");const dS=Symbol("");const bX=Symbol("");function Q(b,c,a){if(am&&u){let c=cC.get(b);if(!c){cC.set(b,c=new Map());}let d=c.get(a);if(!d){c.set(a,d=new dR());d.map=c;d.key=a;}{d.track();}}}function aE(c,e,d,f,h,i){const a=cC.get(c);if(!a){bW++;return;}const b=(a)=>{if(a){{a.trigger();}}};dN();if(e==="clear"){a.forEach(b);}else{const h=g(c);const i=h&&dF(d);if(h&&d==="length"){const c=Number(f);a.forEach((d,a)=>{if(a==="length"||a===bX||!aR(a)&&a>=c){b(d);}});}else{if(d!==void 0||a.has(void 0)){b(a.get(d));}if(i){b(a.get(bX));}switch(e){case"add":if(!h){b(a.get(a8));if(bv(c)){b(a.get(dS));}}else if(i){b(a.get("length"));}break;case"delete":if(!h){b(a.get(a8));if(bv(c)){b(a.get(dS));}}break;case"set":if(bv(c)){b(a.get(a8));}break;}}}dO();}function j2(b,c){const a=cC.get(b);return a&&a.get(c);}function bw(b){const a=l(b);if(a===b)return a;Q(a,"iterate",bX);return an(b)?a:a.map(U);}function dT(a){Q(a=l(a),"iterate",bX);return a;}const j3={__proto__:null,[Symbol.iterator](){return dU(this,Symbol.iterator,U);},concat(...a){return bw(this).concat(...a.map((a)=>g(a)?bw(a):a));},entries(){return dU(this,"entries",(a)=>{a[1]=U(a[1]);return a;});},every(a,b){return aF(this,"every",a,b,void 0,arguments);},filter(a,b){return aF(this,"filter",a,b,(a)=>a.map(U),arguments);},find(a,b){return aF(this,"find",a,b,U,arguments);},findIndex(a,b){return aF(this,"findIndex",a,b,void 0,arguments);},findLast(a,b){return aF(this,"findLast",a,b,U,arguments);},findLastIndex(a,b){return aF(this,"findLastIndex",a,b,void 0,arguments);},forEach(a,b){return aF(this,"forEach",a,b,void 0,arguments);},includes(...a){return dV(this,"includes",a);},indexOf(...a){return dV(this,"indexOf",a);},join(a){return bw(this).join(a);},lastIndexOf(...a){return dV(this,"lastIndexOf",a);},map(a,b){return aF(this,"map",a,b,void 0,arguments);},pop(){return bY(this,"pop");},push(...a){return bY(this,"push",a);},reduce(a,...b){return fE(this,"reduce",a,b);},reduceRight(a,...b){return fE(this,"reduceRight",a,b);},shift(){return bY(this,"shift");},some(a,b){return aF(this,"some",a,b,void 0,arguments);},splice(...a){return bY(this,"splice",a);},toReversed(){return bw(this).toReversed();},toSorted(a){return bw(this).toSorted(a);},toSpliced(...a){return bw(this).toSpliced(...a);},unshift(...a){return bY(this,"unshift",a);},values(){return dU(this,"values",U);}};function dU(b,d,e){const c=dT(b);const a=c[d]();if(c!==b&&!an(b)){a._next=a.next;a.next=()=>{const b=a._next();if(b.value){b.value=e(b.value);}return b;};}return a;}const j4=Array.prototype;function aF(a,g,b,j,h,k){const c=dT(a);const d=c!==a&&!an(a);const e=c[g];if(e!==j4[g]){const b=e.apply(a,k);return d?U(b):b;}let f=b;if(c!==a){if(d){f=function(c,d){return b.call(this,U(c),d,a);};}else if(b.length>2){f=function(c,d){return b.call(this,c,d,a);};}}const i=e.call(c,f,j);return d&&h?h(i):i;}function fE(a,e,b,f){const d=dT(a);let c=b;if(d!==a){if(!an(a)){c=function(c,d,e){return b.call(this,c,U(d),e,a);};}else if(b.length>3){c=function(c,d,e){return b.call(this,c,d,e,a);};}}return d[e](c,...f);}function dV(e,d,a){const b=l(e);Q(b,"iterate",bX);const c=b[d](...a);if((c===-1||c===false)&&dX(a[0])){a[0]=l(a[0]);return b[d](...a);}return c;}function bY(a,b,c=[]){av();dN();const d=l(a)[b].apply(a,c);dO();aw();return d;}const j5=dC(`__proto__,__v_isRef,__isVue`);const fF=new Set(Object.getOwnPropertyNames(Symbol).filter((a)=>a!=="arguments"&&a!=="caller").map((a)=>Symbol[a]).filter(aR));function j6(a){if(!aR(a))a=String(a);const b=l(this);Q(b,"has",a);return b.hasOwnProperty(a);}class fG{constructor(a=false,b=false){this._isReadonly=a;this._isShallow=b;}get(b,a,f){if(a==="__v_skip")return b["__v_skip"];const d=this._isReadonly,e=this._isShallow;if(a==="__v_isReactive"){return!d;}else if(a==="__v_isReadonly"){return d;}else if(a==="__v_isShallow"){return e;}else if(a==="__v_raw"){if(f===(d?e?fM:fL:e?fK:fJ).get(b)||Object.getPrototypeOf(b)===Object.getPrototypeOf(f)){return b;}return;}const h=g(b);if(!d){let b;if(h&&(b=j3[a])){return b;}if(a==="hasOwnProperty"){return j6;}}const c=Reflect.get(b,a,D(b)?b:f);if(aR(a)?fF.has(a):j5(a)){return c;}if(!d){Q(b,"get",a);}if(e){return c;}if(D(c)){return h&&dF(a)?c:c.value;}if(A(c)){return d?fN(c):ax(c);}return c;}}class fH extends fG{constructor(a=false){super(false,a);}set(a,c,b,e){let d=a[c];if(!this._isShallow){const c=ba(d);if(!an(b)&&!ba(b)){d=l(d);b=l(b);}if(!g(a)&&D(d)&&!D(b)){if(c){return false;}else{d.value=b;return true;}}}const f=g(a)&&dF(c)?Number(c)<a.length:q(a,c);const h=Reflect.set(a,c,b,D(a)?a:e);if(a===l(e)){if(!f){aE(a,"add",c,b);}else if(aU(b,d)){aE(a,"set",c,b);}}return h;}deleteProperty(a,b){const d=q(a,b);a[b];const c=Reflect.deleteProperty(a,b);if(c&&d){aE(a,"delete",b,void 0);}return c;}has(b,a){const c=Reflect.has(b,a);if(!aR(a)||!fF.has(a)){Q(b,"has",a);}return c;}ownKeys(a){Q(a,"iterate",g(a)?"length":a8);return Reflect.ownKeys(a);}}class fI extends fG{constructor(a=false){super(true,a);}set(a,b){return true;}deleteProperty(a,b){return true;}}const j7=new fH();const j8=new fI();const j9=new fH(true);const ka=new fI(true);const dW=(a)=>a;const cD=(a)=>Reflect.getPrototypeOf(a);function kb(a,b,c){return function(...h){const e=this["__v_raw"];const f=l(e);const g=bv(f);const i=a==="entries"||a===Symbol.iterator&&g;const j=a==="keys"&&g;const k=e[a](...h);const d=c?dW:b?dY:U;!b&&Q(f,"iterate",j?dS:a8);return{next(){const{value:a,done:b}=k.next();return b?{value:a,done:b}:{value:i?[d(a[0]),d(a[1])]:d(a),done:b};},[Symbol.iterator](){return this;}};};}function cE(a){return function(...b){return a==="delete"?false:a==="clear"?void 0:this;};}function kc(a,b){const c={get(c){const e=this["__v_raw"];const d=l(e);const f=l(c);if(!a){if(aU(c,f)){Q(d,"get",c);}Q(d,"get",f);}const{has:g}=cD(d);const h=b?dW:a?dY:U;if(g.call(d,c)){return h(e.get(c));}else if(g.call(d,f)){return h(e.get(f));}else if(e!==d){e.get(c);}},get size(){const b=this["__v_raw"];!a&&Q(l(b),"iterate",a8);return Reflect.get(b,"size",b);},has(b){const c=this["__v_raw"];const e=l(c);const d=l(b);if(!a){if(aU(b,d)){Q(e,"has",b);}Q(e,"has",d);}return b===d?c.has(b):c.has(b)||c.has(d);},forEach(f,g){const c=this;const d=c["__v_raw"];const h=l(d);const e=b?dW:a?dY:U;!a&&Q(h,"iterate",a8);return d.forEach((a,b)=>{return f.call(g,e(a),e(b),c);});}};G(c,a?{add:cE("add"),set:cE("set"),delete:cE("delete"),clear:cE("clear")}:{add(a){if(!b&&!an(a)&&!ba(a)){a=l(a);}const c=l(this);const d=cD(c);const e=d.has.call(c,a);if(!e){c.add(a);aE(c,"add",a,a);}return this;},set(a,c){if(!b&&!an(c)&&!ba(c)){c=l(c);}const d=l(this);const{has:f,get:g}=cD(d);let e=f.call(d,a);if(!e){a=l(a);e=f.call(d,a);}const h=g.call(d,a);d.set(a,c);if(!e){aE(d,"add",a,c);}else if(aU(c,h)){aE(d,"set",a,c);}return this;},delete(a){const b=l(this);const{has:d,get:e}=cD(b);let c=d.call(b,a);if(!c){a=l(a);c=d.call(b,a);}e?e.call(b,a):void 0;const f=b.delete(a);if(c){aE(b,"delete",a,void 0);}return f;},clear(){const a=l(this);const b=a.size!==0;const c=a.clear();if(b){aE(a,"clear",void 0,void 0);}return c;}});const d=["keys","values","entries",Symbol.iterator];d.forEach((d)=>{c[d]=kb(d,a,b);});return c;}function cF(a,c){const b=kc(a,c);return(d,c,e)=>{if(c==="__v_isReactive"){return!a;}else if(c==="__v_isReadonly"){return a;}else if(c==="__v_raw"){return d;}return Reflect.get(q(b,c)&&c in d?b:d,c,e);};}const kd={get:cF(false,false)};const ke={get:cF(false,true)};const kf={get:cF(true,false)};const kg={get:cF(true,true)};const fJ=new WeakMap();const fK=new WeakMap();const fL=new WeakMap();const fM=new WeakMap();function kh(a){switch(a){case"Object":case"Array":return 1;case"Map":case"Set":case"WeakMap":case"WeakSet":return 2;default:return 0;}}function ki(a){return a["__v_skip"]||!Object.isExtensible(a)?0:kh(jQ(a));}function ax(a){if(ba(a)){return a;}return cH(a,false,j7,kd,fJ);}function kj(a){return cH(a,false,j9,ke,fK);}function fN(a){return cH(a,true,j8,kf,fL);}function cG(a){return cH(a,true,ka,kg,fM);}function cH(a,f,g,h,b){if(!A(a)){return a;}if(a["__v_raw"]&&!(f&&a["__v_isReactive"])){return a;}const c=ki(a);if(c===0){return a;}const d=b.get(a);if(d){return d;}const e=new Proxy(a,c===2?h:g);b.set(a,e);return e;}function a9(a){if(ba(a)){return a9(a["__v_raw"]);}return!!(a&&a["__v_isReactive"]);}function ba(a){return!!(a&&a["__v_isReadonly"]);}function an(a){return!!(a&&a["__v_isShallow"]);}function dX(a){return a?!!a["__v_raw"]:false;}function l(a){const b=a&&a["__v_raw"];return b?l(b):a;}function bZ(a){if(!q(a,"__v_skip")&&Object.isExtensible(a)){dH(a,"__v_skip",true);}return a;}const U=(a)=>A(a)?ax(a):a;const dY=(a)=>A(a)?fN(a):a;function D(a){return a?a["__v_isRef"]===true:false;}function c(a){return fP(a,false);}function fO(a){return fP(a,true);}function fP(a,b){if(D(a)){return a;}return new kk(a,b);}class kk{constructor(a,b){this.dep=new dR();this["__v_isRef"]=true;this["__v_isShallow"]=false;this._rawValue=b?a:l(a);this._value=b?a:U(a);this["__v_isShallow"]=b;}get value(){{this.dep.track();}return this._value;}set value(a){const c=this._rawValue;const b=this["__v_isShallow"]||an(a)||ba(a);a=b?a:l(a);if(aU(a,c)){this._rawValue=a;this._value=b?a:U(a);{this.dep.trigger();}}}}function bb(a){return D(a)?a.value:a;}const kl={get:(a,b,c)=>b==="__v_raw"?a:bb(Reflect.get(a,b,c)),set:(b,c,a,e)=>{const d=b[c];if(D(d)&&!D(a)){d.value=a;return true;}else{return Reflect.set(b,c,a,e);}}};function fQ(a){return a9(a)?a:new Proxy(a,kl);}function km(a){const b=g(a)?new Array(a.length):{};for(const c in a){b[c]=ko(a,c);}return b;}class kn{constructor(a,b,c){this._object=a;this._key=b;this._defaultValue=c;this["__v_isRef"]=true;this._value=void 0;}get value(){const a=this._object[this._key];return this._value=a===void 0?this._defaultValue:a;}set value(a){this._object[this._key]=a;}get dep(){return j2(l(this._object),this._key);}}function ko(a,b,d){const c=a[b];return D(c)?c:new kn(a,b,d);}class kp{constructor(b,a,c){this.fn=b;this.setter=a;this._value=void 0;this.dep=new dR(this);this.__v_isRef=true;this.deps=void 0;this.depsTail=void 0;this.flags=16;this.globalVersion=bW-1;this.next=void 0;this.effect=this;this["__v_isReadonly"]=!a;this.isSSR=c;}notify(){this.flags|=16;if(!(this.flags&8)&&u!==this){fx(this,true);return true;}}get value(){const a=this.dep.track();fA(this);if(a){a.version=this.dep.version;}return this._value;}set value(a){if(this.setter){this.setter(a);}}}function kq(a,f,d=false){let b;let c;if(j(a)){b=a;}else{b=a.get;c=a.set;}const e=new kp(b,c,d);return e;}const cI={};const cJ=new WeakMap();let bc=void 0;function kr(b,c=false,a=bc){if(a){let c=cJ.get(a);if(!c)cJ.set(a,c=[]);c.push(b);}}function ks(b,d,u=t){const{immediate:v,deep:f,once:w,scheduler:m,augmentJob:r,call:c}=u;const s=(a)=>{if(f)return a;if(an(a)||f===false||f===0)return aG(a,1);return aG(a);};let a;let e;let k;let n;let o=false;let p=false;if(D(b)){e=()=>b.value;o=an(b);}else if(a9(b)){e=()=>s(b);o=true;}else if(g(b)){p=true;o=b.some((a)=>a9(a)||an(a));e=()=>b.map((a)=>{if(D(a)){return a.value;}else if(a9(a)){return s(a);}else if(j(a)){return c?c(a,2):a();}else;});}else if(j(b)){if(d){e=c?()=>c(b,2):b;}else{e=()=>{if(k){av();try{k();}finally{aw();}}const d=bc;bc=a;try{return c?c(b,3,[n]):b(n);}finally{bc=d;}};}}else{e=al;}if(d&&f){const a=e;const b=f===true?Infinity:f;e=()=>aG(a(),b);}const q=fu();const i=()=>{a.stop();if(q&&q.active){dE(q.effects,a);}};if(w&&d){const a=d;d=(...b)=>{a(...b);i();};}let h=p?new Array(b.length).fill(cI):cI;const l=(b)=>{if(!(a.flags&1)||!a.dirty&&!b){return;}if(d){const b=a.run();if(f||o||(p?b.some((a,b)=>aU(a,h[b])):aU(b,h))){if(k){k();}const e=bc;bc=a;try{const a=[b,h===cI?void 0:p&&h[0]===cI?[]:h,n];h=b;c?c(d,3,a):(d(...a));}finally{bc=e;}}}else{a.run();}};if(r){r(l);}a=new fv(e);a.scheduler=m?()=>m(l,false):l;n=(b)=>kr(b,false,a);k=a.onStop=()=>{const b=cJ.get(a);if(b){if(c){c(b,4);}else{for(const a of b)a();}cJ.delete(a);}};if(d){if(v){l(true);}else{h=a.run();}}else if(m){m(l.bind(null,true),true);}else{a.run();}i.pause=a.pause.bind(a);i.resume=a.resume.bind(a);i.stop=i;return i;}function aG(a,c=Infinity,b){if(c<=0||!A(a)||a["__v_skip"]){return a;}b=b||new Set();if(b.has(a)){return a;}b.add(a);c--;if(D(a)){aG(a.value,c,b);}else if(g(a)){for(let d=0;d<a.length;d++){aG(a[d],c,b);}}else if(fk(a)||bv(a)){a.forEach((a)=>{aG(a,c,b);});}else if(fn(a)){for(const d in a){aG(a[d],c,b);}for(const d of Object.getOwnPropertySymbols(a)){if(Object.prototype.propertyIsEnumerable.call(a,d)){aG(a[d],c,b);}}}return a;}const b$=[];let dZ=false;function kt(c,...d){if(dZ)return;dZ=true;av();const a=b$.length?b$[b$.length-1].component:null;const e=a&&a.appContext.config.warnHandler;const b=ku();if(e){bx(e,a,11,[c+d.map((a)=>{var b,c;return(c=(b=a.toString)==null?void 0:b.call(a))!=null?c:JSON.stringify(a);}).join(""),a&&a.proxy,b.map(({vnode:b})=>`at <${gC(a,b.type)}>`).join("\n"),b]);}else{const a=[`[Vue warn]: ${c}`,...d];if(b.length&&true){a.push(`
+`,...kv(b));}console.warn(...a);}aw();dZ=false;}function ku(){let a=b$[b$.length-1];if(!a){return[];}const b=[];while(a){const c=b[0];if(c&&c.vnode===a){c.recurseCount++;}else{b.push({vnode:a,recurseCount:0});}const d=a.component&&a.component.parent;a=d&&d.vnode;}return b;}function kv(b){const a=[];b.forEach((b,c)=>{a.push(...c===0?[]:[`
+`],...kw(b));});return a;}function kw({vnode:a,recurseCount:b}){const e=b>0?`... (${b} recursive calls)`:``;const f=a.component?a.component.parent==null:false;const c=` at <${gC(a.component,a.type,f)}`;const d=`>`+e;return a.props?[c,...kx(a.props),d]:[c+d];}function kx(b){const a=[];const c=Object.keys(b);c.slice(0,3).forEach((c)=>{a.push(...fR(c,b[c]));});if(c.length>3){a.push(` ...`);}return a;}function fR(b,a,c){if(C(a)){a=JSON.stringify(a);return c?a:[`${b}=${a}`];}else if(typeof a==="number"||typeof a==="boolean"||a==null){return c?a:[`${b}=${a}`];}else if(D(a)){a=fR(b,l(a.value),true);return c?a:[`${b}=Ref<`,a,`>`];}else if(j(a)){return[`${b}=fn${a.name?`<${a.name}>`:``}`];}else{a=l(a);return c?a:[`${b}=`,a];}}function bx(a,c,d,b){try{return b?a(...b):a();}catch(a){cK(a,c,d);}}function ao(a,b,c,d){if(j(a)){const e=bx(a,b,c,d);if(e&&fl(e)){e.catch((a)=>{cK(a,b,c);});}return e;}if(g(a)){const e=[];for(let f=0;f<a.length;f++){e.push(ao(a[f],b,c,d));}return e;}}function cK(b,a,c,e=true){const f=a?a.vnode:null;const{errorHandler:d,throwUnhandledErrorInProduction:g}=a&&a.appContext.config||t;if(a){let e=a.parent;const f=a.proxy;const g=`https://vuejs.org/error-reference/#runtime-${c}`;while(e){const a=e.ec;if(a){for(let c=0;c<a.length;c++){if(a[c](b,f,g)===false){return;}}}e=e.parent;}if(d){av();bx(d,null,10,[b,f,g]);aw();return;}}ky(b,c,f,e,g);}function ky(a,c,d,e=true,b=false){if(b){throw a;}else{console.error(a);}}const V=[];let ay=-1;const by=[];let aW=null;let bz=0;const fS=Promise.resolve();let cL=null;function w(a){const b=cL||fS;return a?b.then(this?a.bind(this):a):b;}function kz(c){let a=ay+1;let b=V.length;while(a<b){const d=a+b>>>1;const e=V[d];const f=b_(e);if(f<c||f===c&&e.flags&2){a=d+1;}else{b=d;}}return a;}function d$(a){if(!(a.flags&1)){const b=b_(a);const c=V[V.length-1];if(!c||!(a.flags&2)&&b>=b_(c)){V.push(a);}else{V.splice(kz(b),0,a);}a.flags|=1;fT();}}function fT(){if(!cL){cL=fS.then(fW);}}function kA(a){if(!g(a)){if(aW&&a.id===-1){aW.splice(bz+1,0,a);}else if(!(a.flags&1)){by.push(a);a.flags|=1;}}else{by.push(...a);}fT();}function fU(b,c,a=ay+1){for(;a<V.length;a++){const c=V[a];if(c&&c.flags&2){if(b&&c.id!==b.uid){continue;}V.splice(a,1);a--;if(c.flags&4){c.flags&=-2;}c();if(!(c.flags&4)){c.flags&=-2;}}}}function fV(a){if(by.length){const a=[...new Set(by)].sort((a,b)=>b_(a)-b_(b));by.length=0;if(aW){aW.push(...a);return;}aW=a;for(bz=0;bz<aW.length;bz++){const a=aW[bz];if(a.flags&4){a.flags&=-2;}if(!(a.flags&8))a();a.flags&=-2;}aW=null;bz=0;}}const b_=(a)=>a.id==null?a.flags&2?-1:Infinity:a.id;function fW(b){const a=al;try{for(ay=0;ay<V.length;ay++){const b=V[ay];if(b&&!(b.flags&8)){if(!!("production"!=="production")&&a(b));if(b.flags&4){b.flags&=~1;}bx(b,b.i,b.i?15:14);if(!(b.flags&4)){b.flags&=~1;}}}}finally{for(;ay<V.length;ay++){const a=V[ay];if(a){a.flags&=-2;}}ay=-1;V.length=0;fV();cL=null;if(V.length||by.length){fW();}}}let W=null;let fX=null;function cM(a){const b=W;W=a;fX=a&&a.type.__scopeId||null;return b;}function m(b,c=W,d){if(!c)return b;if(b._n){return b;}const a=(...e)=>{if(a._d){gv(-1);}const f=cM(c);let d;try{d=b(...e);}finally{cM(f);if(a._d){gv(1);}}return d;};a._n=true;a._c=true;a._d=true;return a;}function bd(a,b){if(W===null){return a;}const c=c_(W);const d=a.dirs||(a.dirs=[]);for(let a=0;a<b.length;a++){let[e,f,g,h=t]=b[a];if(e){if(j(e)){e={mounted:e,updated:e};}if(e.deep){aG(f);}d.push({dir:e,instance:c,value:f,oldValue:void 0,arg:g,modifiers:h});}}return a;}function be(a,b,e,f){const c=a.dirs;const d=b&&b.dirs;for(let g=0;g<c.length;g++){const h=c[g];if(d){h.oldValue=d[g].value;}let i=h.dir[f];if(i){av();ao(i,e,8,[a.el,h,a,b]);aw();}}}const fY=Symbol("_vte");const fZ=(a)=>a.__isTeleport;const b0=(a)=>a&&(a.disabled||a.disabled==="");const f$=(a)=>a&&(a.defer||a.defer==="");const f_=(a)=>typeof SVGElement!=="undefined"&&a instanceof SVGElement;const f0=(a)=>typeof MathMLElement==="function"&&a instanceof MathMLElement;const d_=(b,c)=>{const a=b&&b.to;if(C(a)){if(!c){return null;}else{const b=c(a);return b;}}else{return a;}};const f1={name:"Teleport",__isTeleport:true,process(b,a,e,j,d,f,c,h,k,g){const{mc:p,pc:q,pbc:r,o:{insert:l,querySelector:n,createText:m,createComment:u}}=g;const i=b0(a.props);let{shapeFlag:s,children:t,dynamicChildren:o}=a;if(b==null){const q=a.el=m("");const b=a.anchor=m("");l(q,e,j);l(b,e,j);const g=(a,b)=>{if(s&16){if(d&&d.isCE){d.ce._teleportTarget=a;}p(t,a,b,d,f,c,h,k);}};const o=()=>{const b=a.target=d_(a.props,n);const d=f2(b,a,m,l);if(b){if(c!=="svg"&&f_(b)){c="svg";}else if(c!=="mathml"&&f0(b)){c="mathml";}if(!i){g(b,d);cO(a,false);}}};if(i){g(e,b);cO(a,true);}if(f$(a.props)){a.el.__isMounted=false;I(()=>{o();delete a.el.__isMounted;},f);}else{o();}}else{if(f$(a.props)&&b.el.__isMounted===false){I(()=>{f1.process(b,a,e,j,d,f,c,h,k,g);},f);return;}a.el=b.el;a.targetStart=b.targetStart;const p=a.anchor=b.anchor;const l=a.target=b.target;const s=a.targetAnchor=b.targetAnchor;const m=b0(b.props);const t=m?e:l;const u=m?p:s;if(c==="svg"||f_(l)){c="svg";}else if(c==="mathml"||f0(l)){c="mathml";}if(o){r(b.dynamicChildren,o,t,d,f,c,h);ee(b,a,!!!("production"!=="production"));}else if(!k){q(b,a,t,u,d,f,c,h,false);}if(i){if(!m){cN(a,e,p,g,1);}else{if(a.props&&b.props&&a.props.to!==b.props.to){a.props.to=b.props.to;}}}else{if((a.props&&a.props.to)!==(b.props&&b.props.to)){const b=a.target=d_(a.props,n);if(b){cN(a,b,null,g,0);}}else if(m){cN(a,l,s,g,1);}}cO(a,i);}},remove(d,e,f,{um:g,o:{remove:a}},b){const{shapeFlag:h,children:c,anchor:i,targetStart:j,targetAnchor:k,target:l,props:m}=d;if(l){a(j);a(k);}b&&a(i);if(h&16){const a=b||!b0(m);for(let b=0;b<c.length;b++){const d=c[b];g(d,e,f,a,!!d.dynamicChildren);}}},move:cN,hydrate:kB};function cN(e,a,b,{o:{insert:c},m:h},f=2){if(f===0){c(e.targetAnchor,a,b);}const{el:i,anchor:j,shapeFlag:k,children:g,props:l}=e;const d=f===2;if(d){c(i,a,b);}if(!d||b0(l)){if(k&16){for(let c=0;c<g.length;c++){h(g[c],a,b,2);}}}if(d){c(j,a,b);}}function kB(d,a,e,f,g,h,{o:{nextSibling:b,parentNode:j,querySelector:k,insert:l,createText:m}},i){const c=a.target=d_(a.props,k);if(c){const n=b0(a.props);const k=c._lpa||c.firstChild;if(a.shapeFlag&16){if(n){a.anchor=i(b(d),a,j(d),e,f,g,h);a.targetStart=k;a.targetAnchor=k&&b(k);}else{a.anchor=b(d);let j=k;while(j){if(j&&j.nodeType===8){if(j.data==="teleport start anchor"){a.targetStart=j;}else if(j.data==="teleport anchor"){a.targetAnchor=j;c._lpa=a.targetAnchor&&b(a.targetAnchor);break;}}j=b(j);}if(!a.targetAnchor){f2(c,a,m,l);}i(k&&b(k),a,c,e,f,g,h);}}cO(a,n);}return a.anchor&&b(a.anchor);}const kC=f1;function cO(a,c){const b=a.ctx;if(b&&b.ut){let d,e;if(c){d=a.el;e=a.anchor;}else{d=a.targetStart;e=a.targetAnchor;}while(d&&d!==e){if(d.nodeType===1)d.setAttribute("data-v-owner",b.uid);d=d.nextSibling;}b.ut();}}function f2(a,c,d,e){const f=c.targetStart=d("");const b=c.targetAnchor=d("");f[fY]=b;if(a){e(f,a);e(b,a);}return b;}const aX=Symbol("_leaveCb");const cP=Symbol("_enterCb");function kD(){const a={isMounted:false,isLeaving:false,isUnmounting:false,leavingVNodes:new Map()};L(()=>{a.isMounted=true;});x(()=>{a.isUnmounting=true;});return a;}const ae=[Function,Array];const f3={mode:String,appear:Boolean,persisted:Boolean,onBeforeEnter:ae,onEnter:ae,onAfterEnter:ae,onEnterCancelled:ae,onBeforeLeave:ae,onLeave:ae,onAfterLeave:ae,onLeaveCancelled:ae,onBeforeAppear:ae,onAppear:ae,onAfterAppear:ae,onAppearCancelled:ae};const f4=(b)=>{const a=b.subTree;return a.component?f4(a.component):a;};const kE={name:`BaseTransition`,props:f3,setup(d,{slots:c}){const a=i();const b=kD();return()=>{const i=c.default&&f8(c.default(),true);if(!i||!i.length){return;}const g=f5(i);const j=l(d);const{mode:k}=j;if(b.isLeaving){return d1(g);}const f=f7(g);if(!f){return d1(g);}let h=d0(f,j,b,a,(a)=>h=a);if(f.type!==R){bA(f,h);}let e=a.subTree&&f7(a.subTree);if(e&&e.type!==R&&!aY(f,e)&&f4(a).type!==R){let c=d0(e,j,b,a);bA(e,c);if(k==="out-in"&&f.type!==R){b.isLeaving=true;c.afterLeave=()=>{b.isLeaving=false;if(!(a.job.flags&8)){a.update();}delete c.afterLeave;e=void 0;};return d1(g);}else if(k==="in-out"&&f.type!==R){c.delayLeave=(a,c,d)=>{const f=f6(b,e);f[String(e.key)]=e;a[aX]=()=>{c();a[aX]=void 0;delete h.delayedLeave;e=void 0;};h.delayedLeave=()=>{d();delete h.delayedLeave;e=void 0;};};}else{e=void 0;}}else if(e){e=void 0;}return g;};}};function f5(a){let b=a[0];if(a.length>1){for(const c of a){if(c.type!==R){b=c;break;}}}return b;}const kF=kE;function f6(d,b){const{leavingVNodes:c}=d;let a=c.get(b.type);if(!a){a=Object.create(null);c.set(b.type,a);}return a;}function d0(b,h,c,i,e){const{appear:j,mode:q,persisted:r=false,onBeforeEnter:k,onEnter:l,onAfterEnter:m,onEnterCancelled:n,onBeforeLeave:s,onLeave:o,onAfterLeave:t,onLeaveCancelled:u,onBeforeAppear:v,onAppear:w,onAfterAppear:x,onAppearCancelled:y}=h;const z=String(b.key);const d=f6(c,b);const a=(a,b)=>{a&&ao(a,i,9,b);};const p=(b,c)=>{const d=c[1];a(b,c);if(g(b)){if(b.every((a)=>a.length<=1))d();}else if(b.length<=1){d();}};const f={mode:q,persisted:r,beforeEnter(f){let g=k;if(!c.isMounted){if(j){g=v||k;}else{return;}}if(f[aX]){f[aX](true);}const e=d[z];if(e&&aY(b,e)&&e.el[aX]){e.el[aX]();}a(g,[f]);},enter(b){let d=l;let e=m;let g=n;if(!c.isMounted){if(j){d=w||l;e=x||m;g=y||n;}else{return;}}let h=false;const i=b[cP]=(c)=>{if(h)return;h=true;if(c){a(g,[b]);}else{a(e,[b]);}if(f.delayedLeave){f.delayedLeave();}b[cP]=void 0;};if(d){p(d,[b,i]);}else{i();}},leave(e,g){const f=String(b.key);if(e[cP]){e[cP](true);}if(c.isUnmounting){return g();}a(s,[e]);let h=false;const i=e[aX]=(c)=>{if(h)return;h=true;g();if(c){a(u,[e]);}else{a(t,[e]);}e[aX]=void 0;if(d[f]===b){delete d[f];}};d[f]=b;if(o){p(o,[e,i]);}else{i();}},clone(b){const a=d0(b,h,c,i,e);if(e)e(a);return a;}};return f;}function d1(a){if(cR(a)){a=aI(a);a.children=null;return a;}}function f7(a){if(!cR(a)){if(fZ(a.type)&&a.children){return f5(a.children);}return a;}if(a.component){return a.component.subTree;}const{shapeFlag:c,children:b}=a;if(b){if(c&16){return b[0];}if(c&32&&j(b.default)){return b.default();}}}function bA(a,b){if(a.shapeFlag&6&&a.component){a.transition=b;bA(a.component.subTree,b);}else if(a.shapeFlag&128){a.ssContent.transition=b.clone(a.ssContent);a.ssFallback.transition=b.clone(a.ssFallback);}else{a.transition=b;}}function f8(b,c=false,d){let a=[];let e=0;for(let f=0;f<b.length;f++){let g=b[f];const h=d==null?g.key:String(d)+String(g.key!=null?g.key:f);if(g.type===ag){if(g.patchFlag&128)e++;a=a.concat(f8(g.children,c,h));}else if(c||g.type!==R){a.push(h!=null?aI(g,{key:h}):g);}}if(e>1){for(let b=0;b<a.length;b++){a[b].patchFlag=-2;}}return a;}function cQ(a,b){return j(a)?((()=>G({name:a.name},b,{setup:a}))()):a;}function f9(a){a.ids=[a.ids[0]+a.ids[2]++ +"-",0,0];}function b1(b,f,p,c,n=false){if(g(b)){b.forEach((a,b)=>b1(a,f&&(g(f)?f[b]:f),p,c,n));return;}if(bB(c)&&!n){if(c.shapeFlag&512&&c.type.__asyncResolved&&c.component.subTree.component){b1(b,f,p,c.component.subTree);}return;}const h=c.shapeFlag&4?c_(c.component):c.el;const i=n?null:h;const{i:m,r:a}=b;const d=f&&f.r;const e=m.refs===t?m.refs={}:m.refs;const k=m.setupState;const r=l(k);const o=k===t?()=>false:(a)=>{return q(r,a);};if(d!=null&&d!==a){if(C(d)){e[d]=null;if(o(d)){k[d]=null;}}else if(D(d)){d.value=null;}}if(j(a)){bx(a,m,12,[i,e]);}else{const c=C(a);const d=D(a);if(c||d){const f=()=>{if(b.f){const d=c?o(a)?k[a]:e[a]:a.value;if(n){g(d)&&dE(d,h);}else{if(!g(d)){if(c){e[a]=[h];if(o(a)){k[a]=e[a];}}else{a.value=[h];if(b.k)e[b.k]=a.value;}}else if(!d.includes(h)){d.push(h);}}}else if(c){e[a]=i;if(o(a)){k[a]=i;}}else if(d){a.value=i;if(b.k)e[b.k]=i;}else;};if(i){f.id=-1;I(f,p);}else{f();}}}}cB().requestIdleCallback||((a)=>setTimeout(a,1));cB().cancelIdleCallback||((a)=>clearTimeout(a));const bB=(a)=>!!a.type.__asyncLoader;const cR=(a)=>a.type.__isKeepAlive;const kG={name:`KeepAlive`,__isKeepAlive:true,props:{include:[String,RegExp,Array],exclude:[String,RegExp,Array],max:[String,Number]},setup(k,{slots:h}){const b=i();const j=b.ctx;if(!j.renderer){return()=>{const a=h.default&&h.default();return a&&a.length===1?a[0]:a;};}const c=new Map();const d=new Set();let a=null;const e=b.suspense;const{renderer:{p:q,m:l,um:r,o:{createElement:s}}}=j;const t=s("div");j.activate=(a,c,d,f,g)=>{const b=a.component;l(a,c,d,0,e);q(b.vnode,a,c,d,b,e,f,a.slotScopeIds,g);I(()=>{b.isDeactivated=false;if(b.a){bT(b.a);}const c=a.props&&a.props.onVnodeMounted;if(c){ah(c,b.parent,a);}},e);};j.deactivate=(b)=>{const a=b.component;cW(a.m);cW(a.a);l(b,t,null,1,e);I(()=>{if(a.da){bT(a.da);}const c=b.props&&b.props.onVnodeUnmounted;if(c){ah(c,a.parent,b);}a.isDeactivated=true;},e);};function m(a){d2(a);r(a,b,e,true);}function n(a){c.forEach((c,d)=>{const b=ek(c.type);if(b&&!a(b)){o(d);}});}function o(b){const e=c.get(b);if(e&&(!a||!aY(e,a))){m(e);}else if(a){d2(a);}c.delete(b);d.delete(b);}f(()=>[k.include,k.exclude],([a,b])=>{a&&n((b)=>b2(a,b));b&&n((a)=>!b2(b,a));},{flush:"post",deep:true});let g=null;const p=()=>{if(g!=null){if(cY(b.subTree.type)){I(()=>{c.set(g,cS(b.subTree));},b.subTree.suspense);}else{c.set(g,cS(b.subTree));}}};L(p);d4(p);x(()=>{c.forEach((c)=>{const{subTree:d,suspense:e}=b;const a=cS(d);if(c.type===a.type&&c.key===a.key){d2(a);const b=a.component.da;b&&I(b,e);return;}m(c);});});return()=>{g=null;if(!h.default){return a=null;}const j=h.default();const e=j[0];if(j.length>1){a=null;return j;}else if(!ca(e)||!(e.shapeFlag&4)&&!(e.shapeFlag&128)){a=null;return e;}let b=cS(e);if(b.type===R){a=null;return b;}const m=b.type;const i=ek(bB(b)?b.type.__asyncResolved||{}:m);const{include:n,exclude:p,max:q}=k;if(n&&(!i||!b2(n,i))||p&&i&&b2(p,i)){b.shapeFlag&=-257;a=b;return e;}const f=b.key==null?m:b.key;const l=c.get(f);if(b.el){b=aI(b);if(e.shapeFlag&128){e.ssContent=b;}}g=f;if(l){b.el=l.el;b.component=l.component;if(b.transition){bA(b,b.transition);}b.shapeFlag|=512;d.delete(f);d.add(f);}else{d.add(f);if(q&&d.size>parseInt(q,10)){o(d.values().next().value);}}b.shapeFlag|=256;a=b;return cY(e.type)?e:b;};}};const kH=kG;function b2(a,b){if(g(a)){return a.some((a)=>b2(a,b));}else if(C(a)){return a.split(",").includes(b);}else if(jP(a)){a.lastIndex=0;return a.test(b);}return false;}function b3(a,b){ga(a,"a",b);}function bf(a,b){ga(a,"da",b);}function ga(b,c,a=J){const d=b.__wdc||(b.__wdc=()=>{let c=a;while(c){if(c.isDeactivated){return;}c=c.parent;}return b();});cT(c,d,a);if(a){let b=a.parent;while(b&&b.parent){if(cR(b.parent.vnode)){kI(d,c,a,b);}b=b.parent;}}}function kI(c,a,d,b){const e=cT(a,c,b,true);bC(()=>{dE(b[a],e);},d);}function d2(a){a.shapeFlag&=-257;a.shapeFlag&=-513;}function cS(a){return a.shapeFlag&128?a.ssContent:a;}function cT(b,c,a=J,d=false){if(a){const f=a[b]||(a[b]=[]);const e=c.__weh||(c.__weh=(...d)=>{av();const e=cc(a);const f=ao(c,a,b,d);e();aw();return f;});if(d){f.unshift(e);}else{f.push(e);}return e;}}const aH=(a)=>(b,c=J)=>{if(!cd||a==="sp"){cT(a,(...a)=>b(...a),c);}};const b4=aH("bm");const L=aH("m");const d3=aH("bu");const d4=aH("u");const x=aH("bum");const bC=aH("um");const kJ=aH("sp");const kK=aH("rtg");const kL=aH("rtc");function kM(a,b=J){cT("ec",a,b);}const kN=Symbol.for("v-ndc");const d5=(a)=>{if(!a)return null;if(gy(a))return c_(a);return d5(a.parent);};const b5=(G(Object.create(null),{$:(a)=>a,$el:(a)=>a.vnode.el,$data:(a)=>a.data,$props:(a)=>a.props,$attrs:(a)=>a.attrs,$slots:(a)=>a.slots,$refs:(a)=>a.refs,$parent:(a)=>d5(a.parent),$root:(a)=>d5(a.root),$host:(a)=>a.ce,$emit:(a)=>a.emit,$options:(a)=>d8(a),$forceUpdate:(a)=>a.f||(a.f=()=>{d$(a.update);}),$nextTick:(a)=>a.n||(a.n=w.bind(a.proxy)),$watch:(a)=>k7.bind(a)}));const d6=(a,b)=>a!==t&&!a.__isScriptSetup&&q(a,b);const kO={get({_:d},a){if(a==="__v_skip"){return true;}const{ctx:b,setupState:g,data:e,props:i,accessCache:c,type:l,appContext:m}=d;let j;if(a[0]!=="$"){const f=c[a];if(f!==void 0){switch(f){case 1:return g[a];case 2:return e[a];case 4:return b[a];case 3:return i[a];}}else if(d6(g,a)){c[a]=1;return g[a];}else if(e!==t&&q(e,a)){c[a]=2;return e[a];}else if((j=d.propsOptions[0])&&q(j,a)){c[a]=3;return i[a];}else if(b!==t&&q(b,a)){c[a]=4;return b[a];}else if(d7){c[a]=0;}}const k=b5[a];let f,h;if(k){if(a==="$attrs"){Q(d.attrs,"get","");}return k(d);}else if((f=l.__cssModules)&&(f=f[a])){return f;}else if(b!==t&&q(b,a)){c[a]=4;return b[a];}else if(h=m.config.globalProperties,q(h,a)){{return h[a];}}else;},set({_:b},a,c){const{data:d,setupState:e,ctx:f}=b;if(d6(e,a)){e[a]=c;return true;}else if(d!==t&&q(d,a)){d[a]=c;return true;}else if(q(b.props,a)){return false;}if(a[0]==="$"&&a.slice(1)in b){return false;}else{{f[a]=c;}}return true;},has({_:{data:b,setupState:d,accessCache:e,ctx:f,appContext:g,propsOptions:h}},a){let c;return!!e[a]||b!==t&&q(b,a)||d6(d,a)||(c=h[0])&&q(c,a)||q(f,a)||q(b5,a)||q(g.config.globalProperties,a);},defineProperty(b,c,a){if(a.get!=null){b._.accessCache[c]=0;}else if(q(a,"value")){this.set(b,c,a.value,null);}return Reflect.defineProperty(b,c,a);}};function gb(a){return g(a)?a.reduce((a,b)=>(a[b]=null,a),{}):a;}let d7=true;function kP(b){const h=d8(b);const c=b.proxy;const e=b.ctx;d7=false;if(h.beforeCreate){gc(h.beforeCreate,b,"bc");}const{data:n,computed:i,methods:k,watch:l,provide:f,inject:o,created:p,beforeMount:v,mounted:w,beforeUpdate:y,updated:z,activated:B,deactivated:C,beforeDestroy:J,beforeUnmount:D,destroyed:K,unmounted:E,render:q,renderTracked:F,renderTriggered:G,errorCaptured:H,serverPrefetch:r,expose:m,inheritAttrs:s,components:t,directives:u,filters:M}=h;const I=null;if(o){kQ(o,e,I);}if(k){for(const a in k){const b=k[a];if(j(b)){{e[a]=b.bind(c);}}}}if(n){const a=n.call(c,c);if(!A(a));else{b.data=ax(a);}}d7=true;if(i){for(const b in i){const d=i[b];const g=j(d)?d.bind(c,c):j(d.get)?d.get.bind(c,c):al;const h=!j(d)&&j(d.set)?d.set.bind(c):al;const f=a({get:g,set:h});Object.defineProperty(e,b,{enumerable:true,configurable:true,get:()=>f.value,set:(a)=>f.value=a});}}if(l){for(const a in l){gd(l[a],e,c,a);}}if(f){const a=j(f)?f.call(c):f;Reflect.ownKeys(a).forEach((b)=>{cV(b,a[b]);});}if(p){gc(p,b,"c");}function d(b,a){if(g(a)){a.forEach((a)=>b(a.bind(c)));}else if(a){b(a.bind(c));}}d(b4,v);d(L,w);d(d3,y);d(d4,z);d(b3,B);d(bf,C);d(kM,H);d(kL,F);d(kK,G);d(x,D);d(bC,E);d(kJ,r);if(g(m)){if(m.length){const a=b.exposed||(b.exposed={});m.forEach((b)=>{Object.defineProperty(a,b,{get:()=>c[b],set:(a)=>c[b]=a});});}else if(!b.exposed){b.exposed={};}}if(q&&b.render===al){b.render=q;}if(s!=null){b.inheritAttrs=s;}if(t)b.components=t;if(u)b.directives=u;if(r){f9(b);}}function kQ(a,b,c=al){if(g(a)){a=d9(a);}for(const c in a){const e=a[c];let d;if(A(e)){if("default"in e){d=af(e.from||c,e.default,true);}else{d=af(e.from||c);}}else{d=af(e);}if(D(d)){Object.defineProperty(b,c,{enumerable:true,configurable:true,get:()=>d.value,set:(a)=>d.value=a});}else{b[c]=d;}}}function gc(a,b,c){ao(g(a)?a.map((a)=>a.bind(b.proxy)):a.bind(b.proxy),b,c);}function gd(a,d,b,c){let e=c.includes(".")?gs(b,c):()=>b[c];if(C(a)){const b=d[a];if(j(b)){{f(e,b);}}}else if(j(a)){{f(e,a.bind(b));}}else if(A(a)){if(g(a)){a.forEach((a)=>gd(a,d,b,c));}else{const c=j(a.handler)?a.handler.bind(b):d[a.handler];if(j(c)){f(e,c,a);}}}else;}function d8(d){const b=d.type;const{mixins:h,extends:i}=b;const{mixins:c,optionsCache:e,config:{optionMergeStrategies:f}}=d.appContext;const g=e.get(b);let a;if(g){a=g;}else if(!c.length&&!h&&!i){{a=b;}}else{a={};if(c.length){c.forEach((b)=>cU(a,b,f,true));}cU(a,b,f);}if(A(b)){e.set(b,a);}return a;}function cU(a,b,c,f=false){const{mixins:d,extends:e}=b;if(e){cU(a,e,c,true);}if(d){d.forEach((b)=>cU(a,b,c,true));}for(const d in b){if(f&&d==="expose");else{const e=kR[d]||c&&c[d];a[d]=e?e(a[d],b[d]):b[d];}}return a;}const kR={data:ge,props:gf,emits:gf,methods:b6,computed:b6,beforeCreate:X,created:X,beforeMount:X,mounted:X,beforeUpdate:X,updated:X,beforeDestroy:X,beforeUnmount:X,destroyed:X,unmounted:X,activated:X,deactivated:X,errorCaptured:X,serverPrefetch:X,components:b6,directives:b6,watch:kT,provide:ge,inject:kS};function ge(a,b){if(!b){return a;}if(!a){return b;}return function c(){return(G)(j(a)?a.call(this,this):a,j(b)?b.call(this,this):b);};}function kS(a,b){return b6(d9(a),d9(b));}function d9(a){if(g(a)){const b={};for(let c=0;c<a.length;c++){b[a[c]]=a[c];}return b;}return a;}function X(a,b){return a?[...new Set([].concat(a,b))]:b;}function b6(a,b){return a?G(Object.create(null),a,b):b;}function gf(b,a){if(b){if(g(b)&&g(a)){return[...new Set([...b,...a])];}return G(Object.create(null),gb(b),gb(a!=null?a:{}));}else{return a;}}function kT(a,b){if(!a)return b;if(!b)return a;const c=G(Object.create(null),a);for(const d in b){c[d]=X(a[d],b[d]);}return c;}function gg(){return{app:null,config:{isNativeTag:jN,performance:false,globalProperties:{},optionMergeStrategies:{},errorHandler:void 0,warnHandler:void 0,compilerOptions:{}},mixins:[],components:{},directives:{},provides:Object.create(null),optionsCache:new WeakMap(),propsCache:new WeakMap(),emitsCache:new WeakMap()};}let kU=0;function kV(a,b){return function c(f,g=null){if(!j(f)){f=G({},f);}if(g!=null&&!A(g)){g=null;}const d=gg();const h=new WeakSet();const k=[];let i=false;const c=d.app={_uid:kU++,_component:f,_props:g,_container:null,_context:d,_instance:null,version:lv,get config(){return d.config;},set config(a){},use(a,...b){if(h.has(a));else if(a&&j(a.install)){h.add(a);a.install(c,...b);}else if(j(a)){h.add(a);a(c,...b);}else;return c;},mixin(a){{if(!d.mixins.includes(a)){d.mixins.push(a);}}return c;},component(a,b){if(!b){return d.components[a];}d.components[a]=b;return c;},directive(a,b){if(!b){return d.directives[a];}d.directives[a]=b;return c;},mount(j,k,h){if(!i){const l=c._ceVNode||e(f,g);l.appContext=d;if(h===true){h="svg";}else if(h===false){h=void 0;}if(k&&b){b(l,j);}else{a(l,j,h);}i=true;c._container=j;j.__vue_app__=c;return c_(l.component);}},onUnmount(a){k.push(a);},unmount(){if(i){ao(k,c._instance,16);a(null,c._container);delete c._container.__vue_app__;}},provide(a,b){d.provides[a]=b;return c;},runWithContext(a){const b=bg;bg=c;try{return a();}finally{bg=b;}}};return c;};}let bg=null;function cV(a,b){if(!J);else{let c=J.provides;const d=J.parent&&J.parent.provides;if(d===c){c=J.provides=Object.create(d);}c[a]=b;}}function af(c,b,d=false){const a=J||W;if(a||bg){let e=bg?bg._context.provides:a?a.parent==null||a.ce?a.vnode.appContext&&a.vnode.appContext.provides:a.parent.provides:void 0;if(e&&c in e){return e[c];}else if(arguments.length>1){return d&&j(b)?b.call(a&&a.proxy):b;}else;}}function kW(){return!!(J||W||bg);}const gh={};const gi=()=>Object.create(gh);const gj=(a)=>Object.getPrototypeOf(a)===gh;function kX(a,d,e,f=false){const b={};const c=gi();a.propsDefaults=Object.create(null);gk(a,d,b,c);for(const c in a.propsOptions[0]){if(!(c in b)){b[c]=void 0;}}if(e){a.props=f?b:kj(b);}else{if(!a.type.props){a.props=c;}else{a.props=b;}}a.attrs=c;}function kY(b,c,h,j){const{props:d,attrs:a,vnode:{patchFlag:i}}=b;const f=l(d);const[g]=b.propsOptions;let e=false;if((j||i>0)&&!(i&16)){if(i&8){const h=b.vnode.dynamicProps;for(let i=0;i<h.length;i++){let j=h[i];if(cX(b.emitsOptions,j)){continue;}const k=c[j];if(g){if(q(a,j)){if(k!==a[j]){a[j]=k;e=true;}}else{const a=aS(j);d[a]=ea(g,f,a,k,b,false);}}else{if(k!==a[j]){a[j]=k;e=true;}}}}}else{if(gk(b,c,d,a)){e=true;}let i;for(const a in f){if(!c||!q(c,a)&&((i=aT(a))===a||!q(c,i))){if(g){if(h&&(h[a]!==void 0||h[i]!==void 0)){d[a]=ea(g,f,a,void 0,b,true);}}else{delete d[a];}}}if(a!==f){for(const b in a){if(!c||!q(c,b)&&true){delete a[b];e=true;}}}}if(e){aE(b.attrs,"set","");}}function gk(b,c,d,e){const[f,a]=b.propsOptions;let h=false;let g;if(c){for(let i in c){if(bS(i)){continue;}const j=c[i];let k;if(f&&q(f,k=aS(i))){if(!a||!a.includes(k)){d[k]=j;}else{(g||(g={}))[k]=j;}}else if(!cX(b.emitsOptions,i)){if(!(i in e)||j!==e[i]){e[i]=j;h=true;}}}}if(a){const e=l(d);const c=g||t;for(let g=0;g<a.length;g++){const h=a[g];d[h]=ea(f,e,h,c[h],b,!q(c,h));}}return h;}function ea(e,f,c,a,d,g){const b=e[c];if(b!=null){const e=q(b,"default");if(e&&a===void 0){const e=b.default;if(b.type!==Function&&!b.skipFactory&&j(e)){const{propsDefaults:b}=d;if(c in b){a=b[c];}else{const g=cc(d);a=b[c]=e.call(null,f);g();}}else{a=e;}if(d.ce){d.ce._setProp(c,a);}}if(b[0]){if(g&&!e){a=false;}else if(b[1]&&(a===""||a===aT(c))){a=true;}}}return a;}const kZ=new WeakMap();function gl(a,c,h=false){const e=h?kZ:c.propsCache;const i=e.get(a);if(i){return i;}const b=a.props;const d={};const f=[];let k=false;if(!j(a)){const b=(b)=>{k=true;const[e,a]=gl(b,c,true);G(d,e);if(a)f.push(...a);};if(!h&&c.mixins.length){c.mixins.forEach(b);}if(a.extends){b(a.extends);}if(a.mixins){a.mixins.forEach(b);}}if(!b&&!k){if(A(a)){e.set(a,bu);}return bu;}if(g(b)){for(let a=0;a<b.length;a++){const c=aS(b[a]);if(gm(c)){d[c]=t;}}}else if(b){for(const a in b){const c=aS(a);if(gm(c)){const h=b[a];const i=d[c]=g(h)||j(h)?{type:h}:G({},h);const e=i.type;let k=false;let l=true;if(g(e)){for(let a=0;a<e.length;++a){const b=e[a];const c=j(b)&&b.name;if(c==="Boolean"){k=true;break;}else if(c==="String"){l=false;}}}else{k=j(e)&&e.name==="Boolean";}i[0]=k;i[1]=l;if(k||q(i,"default")){f.push(c);}}}}const l=[d,f];if(A(a)){e.set(a,l);}return l;}function gm(a){if(a[0]!=="$"&&!bS(a)){return true;}return false;}const eb=(a)=>a[0]==="_"||a==="$stable";const ec=(a)=>g(a)?a.map(az):[az(a)];const k$=(d,b,a)=>{if(b._n){return b;}const c=m((...c)=>{if(!!("production"!=="production")&&J&&!(a===null&&W)&&!(a&&a.root!==J.root));return ec(b(...c));},a);c._c=false;return c;};const gn=(a,b,d)=>{const c=a._ctx;for(const d in a){if(eb(d))continue;const e=a[d];if(j(e)){b[d]=k$(d,e,c);}else if(e!=null){const a=ec(e);b[d]=()=>a;}}};const go=(a,b)=>{const c=ec(b);a.slots.default=()=>c;};const gp=(b,a,c)=>{for(const d in a){if(c||!eb(d)){b[d]=a[d];}}};const k_=(c,a,d)=>{const b=c.slots=gi();if(c.vnode.shapeFlag&32){const c=a.__;if(c)dH(b,"__",c,true);const e=a._;if(e){gp(b,a,d);if(d){dH(b,"_",e,true);}}else{gn(a,b);}}else if(a){go(c,a);}};const k0=(e,a,f)=>{const{vnode:g,slots:b}=e;let c=true;let d=t;if(g.shapeFlag&32){const e=a._;if(e){if(f&&e===1){c=false;}else{gp(b,a,f);}}else{c=!a.$stable;gn(a,b);}d=a;}else if(a){go(e,a);d={default:1};}if(c){for(const a in b){if(!eb(a)&&d[a]==null){delete b[a];}}}};const I=lf;function k1(a){return k2(a);}function k2(w,x){const J=cB();J.__VUE__=true;const{insert:a,remove:f,patchProp:c,createElement:K,createText:o,createComment:L,setText:M,setElementText:i,parentNode:y,nextSibling:j,setScopeId:z=al,insertStaticContent:N}=w;const b=(a,b,e,c=null,g=null,f=null,i=void 0,k=null,j=!!b.dynamicChildren)=>{if(a===b){return;}if(a&&!aY(a,b)){c=n(a);d(a,g,f,true);a=null;}if(b.patchFlag===-2){j=false;b.dynamicChildren=null;}const{type:m,ref:o,shapeFlag:l}=b;switch(m){case b7:O(a,b,e,c);break;case R:A(a,b,e,c);break;case eg:if(a==null){P(b,e,c,i);}break;case ag:W(a,b,e,c,g,f,i,k,j);break;default:if(l&1){T(a,b,e,c,g,f,i,k,j);}else if(l&6){X(a,b,e,c,g,f,i,k,j);}else if(l&64){m.process(a,b,e,c,g,f,i,k,j,h);}else if(l&128){m.process(a,b,e,c,g,f,i,k,j,h);}else;}if(o!=null&&g){b1(o,a&&a.ref,f,b||a,!b);}else if(o==null&&a&&a.ref!=null){b1(a.ref,null,f,a,true);}};const O=(c,b,d,e)=>{if(c==null){a(b.el=o(b.children),d,e);}else{const a=b.el=c.el;if(b.children!==c.children){M(a,b.children);}}};const A=(c,b,d,e)=>{if(c==null){a(b.el=L(b.children||""),d,e);}else{b.el=c.el;}};const P=(a,b,c,d)=>{[a.el,a.anchor]=N(a.children,b,c,d,a.el,a.anchor);};const Q=({el:b,anchor:c},d,e)=>{let f;while(b&&b!==c){f=j(b);a(b,d,e);b=f;}a(c,d,e);};const S=({el:a,anchor:b})=>{let c;while(a&&a!==b){c=j(a);f(a);a=c;}f(b);};const T=(c,a,h,i,d,e,b,f,g)=>{if(a.type==="svg"){b="svg";}else if(a.type==="math"){b="mathml";}if(c==null){U(a,h,i,d,e,b,f,g);}else{V(c,a,d,e,b,f,g);}};const U=(b,q,r,f,l,h,o,s)=>{let e;let g;const{props:d,shapeFlag:p,transition:m,dirs:j}=b;e=b.el=K(b.type,h,d&&d.is,d);if(p&8){i(e,b.children);}else if(p&16){k(b.children,e,null,f,l,ed(b,h),o,s);}if(j){be(b,null,f,"created");}B(e,b,b.scopeId,o,f);if(d){for(const a in d){if(a!=="value"&&!bS(a)){c(e,a,null,d[a],h,f);}}if("value"in d){c(e,"value",null,d.value,h);}if(g=d.onVnodeBeforeMount){ah(g,f,b);}}if(j){be(b,null,f,"beforeMount");}const n=k3(l,m);if(n){m.beforeEnter(e);}a(e,q,r);if((g=d&&d.onVnodeMounted)||n||j){I(()=>{g&&ah(g,f,b);n&&m.enter(e);j&&be(b,null,f,"mounted");},l);}};const B=(b,c,e,d,a)=>{if(e){z(b,e);}if(d){for(let a=0;a<d.length;a++){z(b,d[a]);}}if(a){let d=a.subTree;if(c===d||cY(d.type)&&(d.ssContent===c||d.ssFallback===c)){const c=a.vnode;B(b,c,c.scopeId,c.slotScopeIds,a.parent);}}};const k=(a,d,e,f,g,h,i,c,j=0)=>{for(let k=j;k<a.length;k++){const j=a[k]=c?a$(a[k]):az(a[k]);b(null,j,d,e,f,g,h,i,c);}};const V=(d,a,b,l,g,o,q)=>{const f=a.el=d.el;let{patchFlag:h,dynamicChildren:m,dirs:n}=a;h|=d.patchFlag&16;const j=d.props||t;const e=a.props||t;let k;b&&bh(b,false);if(k=e.onVnodeBeforeUpdate){ah(k,b,a,d);}if(n){be(a,d,b,"beforeUpdate");}b&&bh(b,true);if(j.innerHTML&&e.innerHTML==null||j.textContent&&e.textContent==null){i(f,"");}if(m){p(d.dynamicChildren,m,f,b,l,ed(a,g),o);}else if(!q){r(d,a,f,null,b,l,ed(a,g),o,false);}if(h>0){if(h&16){C(f,j,e,b,g);}else{if(h&2){if(j.class!==e.class){c(f,"class",null,e.class,g);}}if(h&4){c(f,"style",j.style,e.style,g);}if(h&8){const d=a.dynamicProps;for(let a=0;a<d.length;a++){const h=d[a];const i=j[h];const k=e[h];if(k!==i||h==="value"){c(f,h,i,k,g,b);}}}}if(h&1){if(d.children!==a.children){i(f,a.children);}}}else if(!q&&m==null){C(f,j,e,b,g);}if((k=e.onVnodeUpdated)||n){I(()=>{k&&ah(k,b,a,d);n&&be(a,d,b,"updated");},l);}};const p=(c,a,d,e,f,g,h)=>{for(let i=0;i<a.length;i++){const j=c[i];const k=a[i];const l=(j.el&&(j.type===ag||!aY(j,k)||j.shapeFlag&(6|64|128))?y(j.el):(d));b(j,k,l,null,e,f,g,h,true);}};const C=(d,a,b,f,e)=>{if(a!==b){if(a!==t){for(const g in a){if(!bS(g)&&!(g in b)){c(d,g,a[g],null,e,f);}}}for(const g in b){if(bS(g))continue;const h=b[g];const i=a[g];if(h!==i&&g!=="value"){c(d,g,i,h,e,f);}}if("value"in b){c(d,"value",a.value,b.value,e);}}};const W=(b,c,e,l,f,g,h,d,m)=>{const s=c.el=b?b.el:o("");const i=c.anchor=b?b.anchor:o("");let{patchFlag:n,dynamicChildren:q,slotScopeIds:j}=c;if(j){d=d?d.concat(j):j;}if(b==null){a(s,e,l);a(i,e,l);k(c.children||[],e,i,f,g,h,d,m);}else{if(n>0&&n&64&&q&&b.dynamicChildren){p(b.dynamicChildren,q,e,f,g,h,d);if(c.key!=null||f&&c===f.subTree){ee(b,c,true);}}else{r(b,c,e,i,f,g,h,d,m);}}};const X=(c,a,d,e,f,h,g,i,b)=>{a.slotScopeIds=i;if(c==null){if(a.shapeFlag&512){f.ctx.activate(a,d,e,g,b);}else{D(a,d,e,f,h,g,b);}}else{Y(c,a,b);}};const D=(b,f,g,i,c,j,d)=>{const a=(b.component=ln(b,i,c));if(cR(b)){a.ctx.renderer=h;}{lo(a,false,d);}if(a.asyncDep){c&&c.registerDep(a,E,d);if(!b.el){const b=a.subTree=e(R);A(null,b,f,g);}}else{E(a,b,f,g,c,j,d);}};const Y=(c,a,d)=>{const b=a.component=c.component;if(ld(c,a,d)){if(b.asyncDep&&!b.asyncResolved){q(b,a,d);return;}else{b.next=a;b.update();}}else{a.el=c.el;b.vnode=a;}};const E=(a,d,g,h,c,i,j)=>{const k=()=>{if(!a.isMounted){let f;const{el:k,props:j}=d;const{bm:o,m:p,parent:e,root:l,type:m}=a;const n=bB(d);bh(a,false);if(o){bT(o);}if(!n&&(f=j&&j.onVnodeBeforeMount)){ah(f,e,d);}bh(a,true);if(k&&v){const b=()=>{a.subTree=ef(a);v(k,a.subTree,a,c,null);};if(n&&m.__asyncHydrate){m.__asyncHydrate(k,a,b);}else{b();}}else{if(l.ce&&l.ce._def.shadowRoot!==false){l.ce._injectChildStyle(m);}const e=a.subTree=ef(a);b(null,e,g,h,a,c,i);d.el=e.el;}if(p){I(p,c);}if(!n&&(f=j&&j.onVnodeMounted)){const a=d;I(()=>ah(f,e,a),c);}if(d.shapeFlag&256||e&&bB(e.vnode)&&e.vnode.shapeFlag&256){a.a&&I(a.a,c);}a.isMounted=true;d=g=h=null;}else{let{next:d,bu:l,u:m,parent:o,vnode:e}=a;{const b=gq(a);if(b){if(d){d.el=e.el;q(a,d,j);}b.asyncDep.then(()=>{if(!a.isUnmounted){k();}});return;}}let p=d;let f;bh(a,false);if(d){d.el=e.el;q(a,d,j);}else{d=e;}if(l){bT(l);}if(f=d.props&&d.props.onVnodeBeforeUpdate){ah(f,o,d,e);}bh(a,true);const g=ef(a);const h=a.subTree;a.subTree=g;b(h,g,y(h.el),n(h),a,c,i);d.el=g.el;if(p===null){le(a,g.el);}if(m){I(m,c);}if(f=d.props&&d.props.onVnodeUpdated){I(()=>ah(f,o,d,e),c);}}};a.scope.on();const e=a.effect=new fv(k);a.scope.off();const l=a.update=e.run.bind(e);const f=a.job=e.runIfDirty.bind(e);f.i=a;f.id=a.uid;e.scheduler=()=>d$(f);bh(a,true);l();};const q=(a,b,c)=>{b.component=a;const d=a.vnode.props;a.vnode=b;a.next=null;kY(a,b.props,d,c);k0(a,b.children,c);av();fU(a);aw();};const r=(f,q,a,g,b,c,h,j,m=false)=>{const d=f&&f.children;const n=f?f.shapeFlag:0;const e=q.children;const{patchFlag:o,shapeFlag:p}=q;if(o>0){if(o&128){F(d,e,a,g,b,c,h,j,m);return;}else if(o&256){Z(d,e,a,g,b,c,h,j,m);return;}}if(p&8){if(n&16){l(d,b,c);}if(e!==d){i(a,e);}}else{if(n&16){if(p&16){F(d,e,a,g,b,c,h,j,m);}else{l(d,b,c,true);}}else{if(n&8){i(a,"");}if(p&16){k(e,a,g,b,c,h,j,m);}}}};const Z=(d,a,i,p,e,f,j,m,g)=>{d=d||bu;a=a||bu;const n=d.length;const o=a.length;const h=Math.min(n,o);let c;for(c=0;c<h;c++){const h=a[c]=g?a$(a[c]):az(a[c]);b(d[c],h,i,null,e,f,j,m,g);}if(n>o){l(d,e,f,true,false,h);}else{k(a,i,p,e,f,j,m,g,h);}};const F=(k,c,j,p,g,h,l,n,f)=>{let a=0;const o=c.length;let i=k.length-1;let e=o-1;while(a<=i&&a<=e){const d=k[a];const e=c[a]=f?a$(c[a]):az(c[a]);if(aY(d,e)){b(d,e,j,null,g,h,l,n,f);}else{break;}a++;}while(a<=i&&a<=e){const a=k[i];const d=c[e]=f?a$(c[e]):az(c[e]);if(aY(a,d)){b(a,d,j,null,g,h,l,n,f);}else{break;}i--;e--;}if(a>i){if(a<=e){const d=e+1;const i=d<o?c[d].el:p;while(a<=e){b(null,c[a]=f?a$(c[a]):az(c[a]),j,i,g,h,l,n,f);a++;}}}else if(a>e){while(a<=i){d(k[a],g,h,true);a++;}}else{const z=a;const r=a;const v=new Map();for(a=r;a<=e;a++){const b=c[a]=f?a$(c[a]):az(c[a]);if(b.key!=null){v.set(b.key,a);}}let q;let w=0;const t=e-r+1;let u=false;let x=0;const s=new Array(t);for(a=0;a<t;a++)s[a]=0;for(a=z;a<=i;a++){const m=k[a];if(w>=t){d(m,g,h,true);continue;}let i;if(m.key!=null){i=v.get(m.key);}else{for(q=r;q<=e;q++){if(s[q-r]===0&&aY(m,c[q])){i=q;break;}}}if(i===void 0){d(m,g,h,true);}else{s[i-r]=a+1;if(i>=x){x=i;}else{u=true;}b(m,c[i],j,null,g,h,l,n,f);w++;}}const y=u?k4(s):bu;q=y.length-1;for(a=t-1;a>=0;a--){const d=r+a;const e=c[d];const i=d+1<o?c[d+1].el:p;if(s[a]===0){b(null,e,j,i,g,h,l,n,f);}else if(u){if(q<0||a!==y[q]){m(e,j,i,2);}else{q--;}}}}};const m=(e,b,c,g,n=null)=>{const{el:d,type:k,transition:i,children:l,shapeFlag:j}=e;if(j&6){m(e.component.subTree,b,c,g);return;}if(j&128){e.suspense.move(b,c,g);return;}if(j&64){k.move(e,b,c,h);return;}if(k===ag){a(d,b,c);for(let a=0;a<l.length;a++){m(l[a],b,c,g);}a(e.anchor,b,c);return;}if(k===eg){Q(e,b,c);return;}const o=g!==2&&j&1&&i;if(o){if(g===0){i.beforeEnter(d);a(d,b,c);I(()=>i.enter(d),n);}else{const{leave:l,delayLeave:g,afterLeave:h}=i;const j=()=>{if(e.ctx.isUnmounted){f(d);}else{a(d,b,c);}};const k=()=>{l(d,()=>{j();h&&h();});};if(g){g(d,j,k);}else{k();}}}else{a(d,b,c);}};const d=(a,b,c,f=false,m=false)=>{const{type:n,props:g,ref:o,children:r,dynamicChildren:j,shapeFlag:d,patchFlag:i,dirs:s,cacheIndex:p}=a;if(i===-2){m=false;}if(o!=null){av();b1(o,null,c,a,true);aw();}if(p!=null){b.renderCache[p]=void 0;}if(d&256){b.ctx.deactivate(a);return;}const k=d&1&&s;const q=!bB(a);let e;if(q&&(e=g&&g.onVnodeBeforeUnmount)){ah(e,b,a);}if(d&6){_(a.component,c,f);}else{if(d&128){a.suspense.unmount(c,f);return;}if(k){be(a,null,b,"beforeUnmount");}if(d&64){a.type.remove(a,b,c,h,f);}else if(j&&!j.hasOnce&&(n!==ag||i>0&&i&64)){l(j,b,c,false,true);}else if(n===ag&&i&(128|256)||!m&&d&16){l(r,b,c);}if(f){G(a);}}if(q&&(e=g&&g.onVnodeUnmounted)||k){I(()=>{e&&ah(e,b,a);k&&be(a,null,b,"unmounted");},c);}};const G=(b)=>{const{type:e,el:c,anchor:g,transition:a}=b;if(e===ag){{$(c,g);}return;}if(e===eg){S(b);return;}const d=()=>{f(c);if(a&&!a.persisted&&a.afterLeave){a.afterLeave();}};if(b.shapeFlag&1&&a&&!a.persisted){const{leave:g,delayLeave:e}=a;const f=()=>g(c,d);if(e){e(b.el,d,f);}else{f();}}else{d();}};const $=(a,b)=>{let c;while(a!==b){c=j(a);f(a);a=c;}f(b);};const _=(b,a,j)=>{const{bum:c,scope:k,job:e,subTree:l,um:f,m,a:n,parent:h,slots:{__:i}}=b;cW(m);cW(n);if(c){bT(c);}if(h&&g(i)){i.forEach((a)=>{h.renderCache[a]=void 0;});}k.stop();if(e){e.flags|=8;d(l,b,a,j);}if(f){I(f,a);}I(()=>{b.isUnmounted=true;},a);if(a&&a.pendingBranch&&!a.isUnmounted&&b.asyncDep&&!b.asyncResolved&&b.suspenseId===a.pendingId){a.deps--;if(a.deps===0){a.resolve();}}};const l=(a,b,c,e=false,f=false,g=0)=>{for(let h=g;h<a.length;h++){d(a[h],b,c,e,f);}};const n=(a)=>{if(a.shapeFlag&6){return n(a.component.subTree);}if(a.shapeFlag&128){return a.suspense.next();}const b=j(a.anchor||a.el);const c=b&&b[fY];return c?j(c):b;};let s=false;const H=(c,a,e)=>{if(c==null){if(a._vnode){d(a._vnode,null,null,true);}}else{b(a._vnode||null,c,a,null,null,null,e);}a._vnode=c;if(!s){s=true;fU();fV();s=false;}};const h={p:b,um:d,m:m,r:G,mt:D,mc:k,pc:r,pbc:p,n:n,o:w};let u;let v;if(x){[u,v]=x(h);}return{render:H,hydrate:u,createApp:kV(H,u)};}function ed({type:c,props:a},b){return b==="svg"&&c==="foreignObject"||b==="mathml"&&c==="annotation-xml"&&a&&a.encoding&&a.encoding.includes("html")?void 0:b;}function bh({effect:a,job:b},c){if(c){a.flags|=32;b.flags|=4;}else{a.flags&=-33;b.flags&=-5;}}function k3(a,b){return(!a||a&&!a.pendingBranch)&&b&&!b.persisted;}function ee(c,d,e=false){const b=c.children;const a=d.children;if(g(b)&&g(a)){for(let c=0;c<b.length;c++){const f=b[c];let d=a[c];if(d.shapeFlag&1&&!d.dynamicChildren){if(d.patchFlag<=0||d.patchFlag===32){d=a[c]=a$(a[c]);d.el=f.el;}if(!e&&d.patchFlag!==-2)ee(f,d);}if(d.type===b7){d.el=f.el;}if(d.type===R&&!d.el){d.el=f.el;}}}}function k4(e){const g=e.slice();const a=[0];let c,h,b,d,f;const i=e.length;for(c=0;c<i;c++){const i=e[c];if(i!==0){h=a[a.length-1];if(e[h]<i){g[c]=h;a.push(c);continue;}b=0;d=a.length-1;while(b<d){f=b+d>>1;if(e[a[f]]<i){b=f+1;}else{d=f;}}if(i<e[a[b]]){if(b>0){g[c]=a[b-1];}a[b]=c;}}}b=a.length;d=a[b-1];while(b-- >0){a[b]=d;d=g[d];}return a;}function gq(b){const a=b.subTree.component;if(a){if(a.asyncDep&&!a.asyncResolved){return a;}else{return gq(a);}}}function cW(a){if(a){for(let b=0;b<a.length;b++)a[b].flags|=8;}}const k5=Symbol.for("v-scx");const k6=()=>{{const a=af(k5);return a;}};function f(a,b,c){return gr(a,b,c);}function gr(j,c,g=t){const{immediate:k,deep:l,flush:d,once:m}=g;const b=G({},g);const h=c&&k||!c&&d!=="post";let e;if(cd){if(d==="sync"){const a=k6();e=a.__watcherHandles||(a.__watcherHandles=[]);}else if(!h){const a=()=>{};a.stop=al;a.resume=al;a.pause=al;return a;}}const a=J;b.call=(b,c,d)=>ao(b,a,c,d);let i=false;if(d==="post"){b.scheduler=(b)=>{I(b,a&&a.suspense);};}else if(d!=="sync"){i=true;b.scheduler=(a,b)=>{if(b){a();}else{d$(a);}};}b.augmentJob=(b)=>{if(c){b.flags|=4;}if(i){b.flags|=2;if(a){b.id=a.uid;b.i=a;}}};const f=ks(j,c,b);if(cd){if(e){e.push(f);}else if(h){f();}}return f;}function k7(a,c,e){const b=this.proxy;const f=C(a)?a.includes(".")?gs(b,a):()=>b[a]:a.bind(b,b);let d;if(j(c)){d=c;}else{d=c.handler;e=c;}const g=cc(this);const h=gr(f,d.bind(b),e);g();return h;}function gs(b,c){const a=c.split(".");return()=>{let c=b;for(let b=0;b<a.length&&c;b++){c=c[a[b]];}return c;};}const k8=(b,a)=>{return a==="modelValue"||a==="model-value"?b.modelModifiers:b[`${a}Modifiers`]||b[`${aS(a)}Modifiers`]||b[`${aT(a)}Modifiers`];};function k9(a,c,...g){if(a.isUnmounted)return;const d=a.vnode.props||t;let e=g;const i=c.startsWith("update:");const h=i&&k8(d,c.slice(7));if(h){if(h.trim){e=g.map((a)=>C(a)?a.trim():a);}if(h.number){e=g.map(jT);}}let b;let f=d[b=dG(c)]||d[b=dG(aS(c))];if(!f&&i){f=d[b=dG(aT(c))];}if(f){ao(f,a,6,e);}const j=d[b+`Once`];if(j){if(!a.emitted){a.emitted={};}else if(a.emitted[b]){return;}a.emitted[b]=true;ao(j,a,6,e);}}function gt(a,c,i=false){const e=c.emitsCache;const f=e.get(a);if(f!==void 0){return f;}const d=a.emits;let b={};let h=false;if(!j(a)){const d=(d)=>{const a=gt(d,c,true);if(a){h=true;G(b,a);}};if(!i&&c.mixins.length){c.mixins.forEach(d);}if(a.extends){d(a.extends);}if(a.mixins){a.mixins.forEach(d);}}if(!d&&!h){if(A(a)){e.set(a,null);}return null;}if(g(d)){d.forEach((a)=>b[a]=null);}else{G(b,d);}if(A(a)){e.set(a,b);}return b;}function cX(b,a){if(!b||!cz(a)){return false;}a=a.slice(2).replace(/Once$/,"");return q(b,a[0].toLowerCase()+a.slice(1))||q(b,aT(a))||q(b,a);}function la(){}function ef(h){const{type:i,vnode:d,proxy:n,withProxy:o,propsOptions:[j],slots:k,attrs:f,emit:l,render:p,renderCache:q,props:b,data:r,setupState:m,ctx:s,inheritAttrs:t}=h;const u=cM(h);let g;let c;try{if(d.shapeFlag&4){const a=o||n;const d=!!("production"!=="production")&&m.__isScriptSetup?new Proxy(a,{get(b,a,c){kt(`Property '${String(a)}' was accessed via 'this'. Avoid using 'this' in templates.`);return Reflect.get(b,a,c);}}):a;g=az(p.call(d,a,q,!!("production"!=="production")?cG(b):b,m,r,s));c=f;}else{const a=i;if(!!("production"!=="production")&&f===b);g=az(a.length>1?a(!!("production"!=="production")?cG(b):b,!!("production"!=="production")?{get attrs(){la();return cG(f);},slots:k,emit:l}:{attrs:f,slots:k,emit:l}):a(!!("production"!=="production")?cG(b):b,null));c=i.props?f:lb(f);}}catch(a){b8.length=0;cK(a,h,1);g=e(R);}let a=g;if(c&&t!==false){const b=Object.keys(c);const{shapeFlag:d}=a;if(b.length){if(d&(1|6)){if(j&&b.some(dD)){c=lc(c,j);}a=aI(a,c,false,true);}}}if(d.dirs){a=aI(a,null,false,true);a.dirs=a.dirs?a.dirs.concat(d.dirs):d.dirs;}if(d.transition){bA(a,d.transition);}{g=a;}cM(u);return g;}const lb=(b)=>{let a;for(const c in b){if(c==="class"||c==="style"||cz(c)){(a||(a={}))[c]=b[c];}}return a;};const lc=(a,c)=>{const b={};for(const d in a){if(!dD(d)||!(d.slice(9)in c)){b[d]=a[d];}}return b;};function ld(g,c,h){const{props:b,children:i,component:j}=g;const{props:a,children:e,patchFlag:d}=c;const f=j.emitsOptions;if(c.dirs||c.transition){return true;}if(h&&d>=0){if(d&1024){return true;}if(d&16){if(!b){return!!a;}return gu(b,a,f);}else if(d&8){const d=c.dynamicProps;for(let c=0;c<d.length;c++){const e=d[c];if(a[e]!==b[e]&&!cX(f,e)){return true;}}}}else{if(i||e){if(!e||!e.$stable){return true;}}if(b===a){return false;}if(!b){return!!a;}if(!a){return true;}return gu(b,a,f);}return false;}function gu(b,c,d){const a=Object.keys(c);if(a.length!==Object.keys(b).length){return true;}for(let e=0;e<a.length;e++){const f=a[e];if(c[f]!==b[f]&&!cX(d,f)){return true;}}return false;}function le({vnode:b,parent:a},c){while(a){const d=a.subTree;if(d.suspense&&d.suspense.activeBranch===b){d.el=b.el;}if(d===b){(b=a.vnode).el=c;a=a.parent;}else{break;}}}const cY=(a)=>a.__isSuspense;function lf(a,b){if(b&&b.pendingBranch){if(g(a)){b.effects.push(...a);}else{b.effects.push(a);}}else{kA(a);}}const ag=Symbol.for("v-fgt");const b7=Symbol.for("v-txt");const R=Symbol.for("v-cmt");const eg=Symbol.for("v-stc");const b8=[];let aa=null;function bi(a=false){b8.push(aa=a?null:[]);}function lg(){b8.pop();aa=b8[b8.length-1]||null;}let b9=1;function gv(a,b=false){b9+=a;if(a<0&&aa&&b){aa.hasOnce=true;}}function lh(a){a.dynamicChildren=b9>0?aa||bu:null;lg();if(b9>0&&aa){aa.push(a);}return a;}function bj(a,b,c,d,f){return lh(e(a,b,c,d,f,true));}function ca(a){return a?a.__v_isVNode===true:false;}function aY(a,b){return a.type===b.type&&a.key===b.key;}const gw=({key:a})=>a!=null?a:null;const cZ=({ref:a,ref_key:b,ref_for:c})=>{if(typeof a==="number"){a=""+a;}return a!=null?C(a)||D(a)||j(a)?{i:W,r:a,k:b,f:!!c}:a:null;};function aZ(d,b=null,c=null,f=0,g=null,e=d===ag?0:1,h=false,i=false){const a={__v_isVNode:true,__v_skip:true,type:d,props:b,key:b&&gw(b),ref:b&&cZ(b),scopeId:fX,slotScopeIds:null,children:c,component:null,suspense:null,ssContent:null,ssFallback:null,dirs:null,transition:null,el:null,anchor:null,target:null,targetStart:null,targetAnchor:null,staticCount:0,shapeFlag:e,patchFlag:f,dynamicProps:g,dynamicChildren:null,appContext:null,ctx:W};if(i){ei(a,c);if(e&128){d.normalize(a);}}else if(c){a.shapeFlag|=C(c)?8:16;}if(b9>0&&!h&&aa&&(a.patchFlag>0||e&6)&&a.patchFlag!==32){aa.push(a);}return a;}const e=li;function li(a,b=null,c=null,e=0,f=null,d=false){if(!a||a===kN){a=R;}if(ca(a)){const e=aI(a,b,true);if(c){ei(e,c);}if(b9>0&&!d&&aa){if(e.shapeFlag&6){aa[aa.indexOf(a)]=e;}else{aa.push(e);}}e.patchFlag=-2;return e;}if(lu(a)){a=a.__vccOpts;}if(b){b=lj(b);let{class:c,style:a}=b;if(c&&!C(c)){b.class=dJ(c);}if(A(a)){if(dX(a)&&!g(a)){a=G({},a);}b.style=dI(a);}}const h=C(a)?1:cY(a)?128:fZ(a)?64:A(a)?4:j(a)?2:0;return aZ(a,b,c,e,f,h,d,true);}function lj(a){if(!a)return null;return dX(a)||gj(a)?G({},a):a;}function aI(a,b,j=false,k=false){const{props:i,ref:c,patchFlag:d,children:l,transition:e}=a;const f=b?lk(i||{},b):i;const h={__v_isVNode:true,__v_skip:true,type:a.type,props:f,key:f&&gw(f),ref:b&&b.ref?(j&&c?g(c)?c.concat(cZ(b)):[c,cZ(b)]:cZ(b)):c,scopeId:a.scopeId,slotScopeIds:a.slotScopeIds,children:l,target:a.target,targetStart:a.targetStart,targetAnchor:a.targetAnchor,staticCount:a.staticCount,shapeFlag:a.shapeFlag,patchFlag:b&&a.type!==ag?d===-1?16:d|16:d,dynamicProps:a.dynamicProps,dynamicChildren:a.dynamicChildren,appContext:a.appContext,dirs:a.dirs,transition:e,component:a.component,suspense:a.suspense,ssContent:a.ssContent&&aI(a.ssContent),ssFallback:a.ssFallback&&aI(a.ssFallback),el:a.el,anchor:a.anchor,ctx:a.ctx,ce:a.ce};if(e&&k){bA(h,e.clone(h));}return h;}function cb(a=" ",b=0){return e(b7,null,a,b);}function eh(a="",b=false){return b?(bi(),bj(R,null,a)):e(R,null,a);}function az(a){if(a==null||typeof a==="boolean"){return e(R);}else if(g(a)){return e(ag,null,a.slice());}else if(ca(a)){return a$(a);}else{return e(b7,null,String(a));}}function a$(a){return a.el===null&&a.patchFlag!==-1||a.memo?a:aI(a);}function ei(c,a){let b=0;const{shapeFlag:d}=c;if(a==null){a=null;}else if(g(a)){b=16;}else if(typeof a==="object"){if(d&(1|64)){const b=a.default;if(b){b._c&&(b._d=false);ei(c,b());b._c&&(b._d=true);}return;}else{b=32;const d=a._;if(!d&&!gj(a)){a._ctx=W;}else if(d===3&&W){if(W.slots._===1){a._=1;}else{a._=2;c.patchFlag|=1024;}}}}else if(j(a)){a={default:a,_ctx:W};b=32;}else{a=String(a);if(d&64){b=16;a=[cb(a)];}else{b=8;}}c.children=a;c.shapeFlag|=b;}function lk(...b){const a={};for(let c=0;c<b.length;c++){const d=b[c];for(const b in d){if(b==="class"){if(a.class!==d.class){a.class=dJ([a.class,d.class]);}}else if(b==="style"){a.style=dI([a.style,d.style]);}else if(cz(b)){const c=a[b];const e=d[b];if(e&&c!==e&&!(g(c)&&c.includes(e))){a[b]=c?[].concat(c,e):e;}}else if(b!==""){a[b]=d[b];}}}return a;}function ah(a,b,c,d=null){ao(a,b,7,[c,d]);}const ll=gg();let lm=0;function ln(c,a,f){const d=c.type;const e=(a?a.appContext:c.appContext)||ll;const b={uid:lm++,vnode:c,type:d,parent:a,appContext:e,root:null,next:null,subTree:null,effect:null,update:null,job:null,scope:new ft(true),render:null,proxy:null,exposed:null,exposeProxy:null,withProxy:null,provides:a?a.provides:Object.create(e.provides),ids:a?a.ids:["",0,0],accessCache:null,renderCache:[],components:null,directives:null,propsOptions:gl(d,e),emitsOptions:gt(d,e),emit:null,emitted:null,propsDefaults:t,inheritAttrs:d.inheritAttrs,ctx:t,data:t,props:t,attrs:t,slots:t,refs:t,setupState:t,setupContext:null,suspense:f,suspenseId:f?f.pendingId:0,asyncDep:null,asyncResolved:false,isMounted:false,isUnmounted:false,isDeactivated:false,bc:null,c:null,bm:null,m:null,bu:null,u:null,um:null,bum:null,da:null,a:null,rtg:null,rtc:null,ec:null,sp:null};{b.ctx={_:b};}b.root=a?a.root:b;b.emit=k9.bind(null,b);if(c.ce){c.ce(b);}return b;}let J=null;const i=()=>J||W;let c$;let ej;{const a=cB();const b=(c,d)=>{let b;if(!(b=a[c]))b=a[c]=[];b.push(d);return(a)=>{if(b.length>1)b.forEach((b)=>b(a));else b[0](a);};};c$=b(`__VUE_INSTANCE_SETTERS__`,(a)=>J=a);ej=b(`__VUE_SSR_SETTERS__`,(a)=>cd=a);}const cc=(a)=>{const b=J;c$(a);a.scope.on();return()=>{a.scope.off();c$(b);};};const gx=()=>{J&&J.scope.off();c$(null);};function gy(a){return a.vnode.shapeFlag&4;}let cd=false;function lo(b,a=false,d=false){a&&ej(a);const{props:e,children:f}=b.vnode;const c=gy(b);kX(b,e,c,a);k_(b,f,d||a);const g=c?lp(b,a):void 0;a&&ej(false);return g;}function lp(a,b){const d=a.type;a.accessCache=Object.create(null);a.proxy=new Proxy(a.ctx,kO);const{setup:c}=d;if(c){av();const f=a.setupContext=c.length>1?lr(a):null;const g=cc(a);const d=bx(c,a,0,[a.props,f]);const e=fl(d);aw();g();if((e||a.sp)&&!bB(a)){f9(a);}if(e){d.then(gx,gx);if(b){return d.then((c)=>{gz(a,c,b);}).catch((b)=>{cK(b,a,0);});}else{a.asyncDep=d;}}else{gz(a,d,b);}}else{gB(a,b);}}function gz(a,b,c){if(j(b)){if(a.type.__ssrInlineRender){a.ssrRender=b;}else{a.render=b;}}else if(A(b)){a.setupState=fQ(b);}else;gB(a,c);}let gA;function gB(a,c,d){const b=a.type;if(!a.render){if(!c&&gA&&!b.render){const c=b.template||d8(a).template;if(c){const{isCustomElement:d,compilerOptions:e}=a.appContext.config;const{delimiters:f,compilerOptions:g}=b;const h=G(G({isCustomElement:d,delimiters:f},e),g);b.render=gA(c,h);}}a.render=b.render||al;}{const b=cc(a);av();try{kP(a);}finally{aw();b();}}}const lq={get(a,b){Q(a,"get","");return a[b];}};function lr(a){const b=(b)=>{a.exposed=b||{};};{return{attrs:new Proxy(a.attrs,lq),slots:a.slots,emit:a.emit,expose:b};}}function c_(a){if(a.exposed){return a.exposeProxy||(a.exposeProxy=new Proxy(fQ(bZ(a.exposed)),{get(c,b){if(b in c){return c[b];}else if(b in b5){return b5[b](a);}},has(b,a){return a in b||a in b5;}}));}else{return a.proxy;}}const ls=/(?:^|[-_])(\w)/g;const lt=(a)=>a.replace(ls,(a)=>a.toUpperCase()).replace(/[-_]/g,"");function ek(a,b=true){return j(a)?a.displayName||a.name:a.name||b&&a.__name;}function gC(b,c,d=false){let a=ek(c);if(!a&&c.__file){const b=c.__file.match(/([^/\\]+)\.\w+$/);if(b){a=b[1];}}if(!a&&b&&b.parent){const d=(a)=>{for(const b in a){if(a[b]===c){return b;}}};a=d(b.components||b.parent.type.components)||d(b.appContext.components);}return a?lt(a):d?`App`:`Anonymous`;}function lu(a){return j(a)&&"__vccOpts"in a;}const a=(a,b)=>{const c=kq(a,b,cd);return c;};function b(c,a,b){const d=arguments.length;if(d===2){if(A(a)&&!g(a)){if(ca(a)){return e(c,null,[a]);}return e(c,a);}else{return e(c,null,a);}}else{if(d>3){b=Array.prototype.slice.call(arguments,2);}else if(d===3&&ca(b)){b=[b];}return e(c,a,b);}}const lv="3.5.17";let el=void 0;const gD=typeof window!=="undefined"&&window.trustedTypes;if(gD){try{el=gD.createPolicy("vue",{createHTML:(a)=>a});}catch(a){}}const gE=el?(a)=>el.createHTML(a):(a)=>a;const lw="http://www.w3.org/2000/svg";const lx="http://www.w3.org/1998/Math/MathML";const aJ=typeof document!=="undefined"?document:null;const gF=aJ&&aJ.createElement("template");const ly={insert:(a,b,c)=>{b.insertBefore(a,c||null);},remove:(a)=>{const b=a.parentNode;if(b){b.removeChild(a);}},createElement:(a,c,d,b)=>{const e=c==="svg"?aJ.createElementNS(lw,a):c==="mathml"?aJ.createElementNS(lx,a):d?aJ.createElement(a,{is:d}):aJ.createElement(a);if(a==="select"&&b&&b.multiple!=null){e.setAttribute("multiple",b.multiple);}return e;},createText:(a)=>aJ.createTextNode(a),createComment:(a)=>aJ.createComment(a),setText:(a,b)=>{a.nodeValue=b;},setElementText:(a,b)=>{a.textContent=b;},parentNode:(a)=>a.parentNode,nextSibling:(a)=>a.nextSibling,querySelector:(a)=>aJ.querySelector(a),setScopeId(a,b){a.setAttribute(b,"");},insertStaticContent(e,c,b,d,a,f){const g=b?b.previousSibling:c.lastChild;if(a&&(a===f||a.nextSibling)){while(true){c.insertBefore(a.cloneNode(true),b);if(a===f||!(a=a.nextSibling))break;}}else{gF.innerHTML=gE(d==="svg"?`<svg>${e}</svg>`:d==="mathml"?`<math>${e}</math>`:e);const a=gF.content;if(d==="svg"||d==="mathml"){const b=a.firstChild;while(b.firstChild){a.appendChild(b.firstChild);}a.removeChild(b);}c.insertBefore(a,b);}return[g?g.nextSibling:c.firstChild,b?b.previousSibling:c.lastChild];}};const a_="transition";const ce="animation";const cf=Symbol("_vtc");const gG={name:String,type:String,css:{type:Boolean,default:true},duration:[String,Number,Object],enterFromClass:String,enterActiveClass:String,enterToClass:String,appearFromClass:String,appearActiveClass:String,appearToClass:String,leaveFromClass:String,leaveActiveClass:String,leaveToClass:String};const lz=G({},f3,gG);const lA=(a)=>{a.displayName="Transition";a.props=lz;return a;};const bD=lA((a,{slots:c})=>b(kF,lB(a),c));const bk=(a,b=[])=>{if(g(a)){a.forEach((a)=>a(...b));}else if(a){a(...b);}};const gH=(a)=>{return a?g(a)?a.some((a)=>a.length>1):a.length>1:false;};function lB(b){const c={};for(const a in b){if(!(a in gG)){c[a]=b[a];}}if(b.css===false){return c;}const{name:a="v",type:k,duration:v,enterFromClass:e=`${a}-enter-from`,enterActiveClass:f=`${a}-enter-active`,enterToClass:g=`${a}-enter-to`,appearFromClass:l=e,appearActiveClass:m=f,appearToClass:n=g,leaveFromClass:h=`${a}-leave-from`,leaveActiveClass:i=`${a}-leave-active`,leaveToClass:o=`${a}-leave-to`}=b;const d=lC(v);const w=d&&d[0];const x=d&&d[1];const{onBeforeEnter:p,onEnter:q,onEnterCancelled:r,onLeave:s,onLeaveCancelled:y,onBeforeAppear:z=p,onAppear:A=q,onAppearCancelled:B=r}=c;const j=(a,b,c,d)=>{a._enterCancelled=d;bl(a,b?n:g);bl(a,b?m:f);c&&c();};const t=(a,b)=>{a._isLeaving=false;bl(a,h);bl(a,o);bl(a,i);b&&b();};const u=(a)=>{return(b,f)=>{const c=a?A:q;const d=()=>j(b,a,f);bk(c,[b,d]);gI(()=>{bl(b,a?l:e);aK(b,a?n:g);if(!gH(c)){gJ(b,k,w,d);}});};};return G(c,{onBeforeEnter(a){bk(p,[a]);aK(a,e);aK(a,f);},onBeforeAppear(a){bk(z,[a]);aK(a,l);aK(a,m);},onEnter:u(false),onAppear:u(true),onLeave(a,c){a._isLeaving=true;const b=()=>t(a,c);aK(a,h);if(!a._enterCancelled){gM();aK(a,i);}else{aK(a,i);gM();}gI(()=>{if(!a._isLeaving){return;}bl(a,h);aK(a,o);if(!gH(s)){gJ(a,k,x,b);}});bk(s,[a,b]);},onEnterCancelled(a){j(a,false,void 0,true);bk(r,[a]);},onAppearCancelled(a){j(a,true,void 0,true);bk(B,[a]);},onLeaveCancelled(a){t(a);bk(y,[a]);}});}function lC(a){if(a==null){return null;}else if(A(a)){return[em(a.enter),em(a.leave)];}else{const b=em(a);return[b,b];}}function em(a){const b=jU(a);return b;}function aK(a,b){b.split(/\s+/).forEach((b)=>b&&a.classList.add(b));(a[cf]||(a[cf]=new Set())).add(b);}function bl(a,c){c.split(/\s+/).forEach((b)=>b&&a.classList.remove(b));const b=a[cf];if(b){b.delete(c);if(!b.size){a[cf]=void 0;}}}function gI(a){requestAnimationFrame(()=>{requestAnimationFrame(a);});}let lD=0;function gJ(a,k,b,c){const l=a._endId=++lD;const d=()=>{if(l===a._endId){c();}};if(b!=null){return setTimeout(d,b);}const{type:e,timeout:m,propCount:f}=lE(a,k);if(!e){return c();}const g=e+"end";let h=0;const i=()=>{a.removeEventListener(g,j);d();};const j=(b)=>{if(b.target===a&&++h>=f){i();}};setTimeout(()=>{if(h<f){i();}},m+1);a.addEventListener(g,j);}function lE(j,i){const k=window.getComputedStyle(j);const b=(a)=>(k[a]||"").split(", ");const l=b(`${a_}Delay`);const g=b(`${a_}Duration`);const d=gK(l,g);const m=b(`${ce}Delay`);const h=b(`${ce}Duration`);const e=gK(m,h);let a=null;let c=0;let f=0;if(i===a_){if(d>0){a=a_;c=d;f=g.length;}}else if(i===ce){if(e>0){a=ce;c=e;f=h.length;}}else{c=Math.max(d,e);a=c>0?d>e?a_:ce:null;f=a?a===a_?g.length:h.length:0;}const n=a===a_&&/\b(transform|all)(,|$)/.test(b(`${a_}Property`).toString());return{type:a,timeout:c,propCount:f,hasTransform:n};}function gK(a,b){while(a.length<b.length){a=a.concat(a);}return Math.max(...b.map((b,c)=>gL(b)+gL(a[c])));}function gL(a){if(a==="auto")return 0;return Number(a.slice(0,-1).replace(",","."))*1e3;}function gM(){return document.body.offsetHeight;}function lF(b,a,d){const c=b[cf];if(c){a=(a?[a,...c]:[...c]).join(" ");}if(a==null){b.removeAttribute("class");}else if(d){b.setAttribute("class",a);}else{b.className=a;}}const gN=Symbol("_vod");const lG=Symbol("_vsh");const lH=Symbol("");const lI=/(^|;)\s*display\s*:/;function lJ(d,c,a){const b=d.style;const f=C(a);let e=false;if(a&&!f){if(c){if(!C(c)){for(const d in c){if(a[d]==null){c0(b,d,"");}}}else{for(const d of c.split(";")){const c=d.slice(0,d.indexOf(":")).trim();if(a[c]==null){c0(b,c,"");}}}}for(const c in a){if(c==="display"){e=true;}c0(b,c,a[c]);}}else{if(f){if(c!==a){const c=b[lH];if(c){a+=";"+c;}b.cssText=a;e=lI.test(a);}}else if(c){d.removeAttribute("style");}}if(gN in d){d[gN]=e?b.display:"";if(d[lG]){b.display="none";}}}const gO=/\s*!important$/;function c0(b,c,a){if(g(a)){a.forEach((a)=>c0(b,c,a));}else{if(a==null)a="";if(c.startsWith("--")){b.setProperty(c,a);}else{const d=lK(b,c);if(gO.test(a)){b.setProperty(aT(d),a.replace(gO,""),"important");}else{b[d]=a;}}}}const gP=["Webkit","Moz","ms"];const en={};function lK(c,b){const d=en[b];if(d){return d;}let a=aS(b);if(a!=="filter"&&a in c){return en[b]=a;}a=fo(a);for(let d=0;d<gP.length;d++){const e=gP[d]+a;if(e in c){return en[b]=e;}}return b;}const gQ="http://www.w3.org/1999/xlink";function gR(c,a,b,e,f,d=j$(a)){if(e&&a.startsWith("xlink:")){if(b==null){c.removeAttributeNS(gQ,a.slice(6,a.length));}else{c.setAttributeNS(gQ,a,b);}}else{if(b==null||d&&!fq(b)){c.removeAttribute(a);}else{c.setAttribute(a,d?"":aR(b)?String(b):b);}}}function gS(b,c,a,g,f){if(c==="innerHTML"||c==="textContent"){if(a!=null){b[c]=c==="innerHTML"?gE(a):a;}return;}const d=b.tagName;if(c==="value"&&d!=="PROGRESS"&&!d.includes("-")){const f=d==="OPTION"?b.getAttribute("value")||"":b.value;const e=a==null?(b.type==="checkbox"?"on":""):String(a);if(f!==e||!("_value"in b)){b.value=e;}if(a==null){b.removeAttribute(c);}b._value=a;return;}let e=false;if(a===""||a==null){const d=typeof b[c];if(d==="boolean"){a=fq(a);}else if(a==null&&d==="string"){a="";e=true;}else if(d==="number"){a=0;e=true;}}try{b[c]=a;}catch(a){}e&&b.removeAttribute(f||c);}function lL(a,b,c,d){a.addEventListener(b,c,d);}function lM(a,b,c,d){a.removeEventListener(b,c,d);}const gT=Symbol("_vei");function lN(a,b,g,c,f=null){const e=a[gT]||(a[gT]={});const d=e[b];if(c&&d){d.value=c;}else{const[g,h]=lO(b);if(c){const d=e[b]=lR(c,f);lL(a,g,d,h);}else if(d){lM(a,g,d,h);e[b]=void 0;}}}const gU=/(?:Once|Passive|Capture)$/;function lO(a){let b;if(gU.test(a)){b={};let c;while(c=a.match(gU)){a=a.slice(0,a.length-c[0].length);b[c[0].toLowerCase()]=true;}}const c=a[2]===":"?a.slice(3):aT(a.slice(2));return[c,b];}let eo=0;const lP=Promise.resolve();const lQ=()=>eo||(lP.then(()=>eo=0),eo=Date.now());function lR(b,c){const a=(b)=>{if(!b._vts){b._vts=Date.now();}else if(b._vts<=a.attached){return;}ao(lS(b,a.value),c,5,[b]);};a.value=b;a.attached=lQ();return a;}function lS(a,b){if(g(b)){const c=a.stopImmediatePropagation;a.stopImmediatePropagation=()=>{c.call(a);a._stopped=true;};return b.map((a)=>(b)=>!b._stopped&&a&&a(b));}else{return b;}}const gV=(a)=>a.charCodeAt(0)===111&&a.charCodeAt(1)===110&&a.charCodeAt(2)>96&&a.charCodeAt(2)<123;const lT=(b,a,f,c,g,e)=>{const d=g==="svg";if(a==="class"){lF(b,c,d);}else if(a==="style"){lJ(b,f,c);}else if(cz(a)){if(!dD(a)){lN(b,a,f,c,e);}}else if(a[0]==="."?(a=a.slice(1),true):a[0]==="^"?(a=a.slice(1),false):lU(b,a,c,d)){gS(b,a,c);if(!b.tagName.includes("-")&&(a==="value"||a==="checked"||a==="selected")){gR(b,a,c,d,e,a!=="value");}}else if(b._isVueCE&&(/[A-Z]/.test(a)||!C(c))){gS(b,aS(a),c,e,a);}else{if(a==="true-value"){b._trueValue=c;}else if(a==="false-value"){b._falseValue=c;}gR(b,a,c,d);}};function lU(b,a,c,d){if(d){if(a==="innerHTML"||a==="textContent"){return true;}if(a in b&&gV(a)&&j(c)){return true;}return false;}if(a==="spellcheck"||a==="draggable"||a==="translate"||a==="autocorrect"){return false;}if(a==="form"){return false;}if(a==="list"&&b.tagName==="INPUT"){return false;}if(a==="type"&&b.tagName==="TEXTAREA"){return false;}if(a==="width"||a==="height"){const a=b.tagName;if(a==="IMG"||a==="VIDEO"||a==="CANVAS"||a==="SOURCE"){return false;}}if(gV(a)&&C(c)){return false;}return a in b;}const lV={esc:"escape",space:" ",up:"arrow-up",left:"arrow-left",right:"arrow-right",down:"arrow-down",delete:"backspace"};const lW=(a,b)=>{const c=a._withKeys||(a._withKeys={});const d=b.join(".");return c[d]||(c[d]=(c)=>{if(!("key"in c)){return;}const d=aT(c.key);if(b.some((a)=>a===d||lV[a]===d)){return a(c);}});};const lX=G({patchProp:lT},ly);let gW;function lY(){return gW||(gW=k1(lX));}const lZ=(...b)=>{const a=lY().createApp(...b);const{mount:c}=a;a.mount=(e)=>{const b=l_(e);if(!b)return;const d=a._component;if(!j(d)&&!d.render&&!d.template){d.template=b.innerHTML;}if(b.nodeType===1){b.textContent="";}const f=c(b,false,l$(b));if(b instanceof Element){b.removeAttribute("v-cloak");b.setAttribute("data-v-app","");}return f;};return a;};function l$(a){if(a instanceof SVGElement){return"svg";}if(typeof MathMLElement==="function"&&a instanceof MathMLElement){return"mathml";}}function l_(a){if(C(a)){const b=document.querySelector(a);return b;}return a;}const ep={xs:18,sm:24,md:32,lg:38,xl:46};const cg={size:String};function ch(b,c=ep){return a(()=>(b.size!==void 0?{fontSize:b.size in c?`${c[b.size]}px`:b.size}:null));}function ai(a,b,c,d){Object.defineProperty(a,b,{get:c,set:d,enumerable:true});return a;}function l0(a,b){for(const c in b){ai(a,c,b[c]);}return a;}function n(a){return bZ(cQ(a));}function eq(a){return bZ(a);}const c1=(a,b)=>{const c=ax(a);for(const d in a){ai(b,d,()=>c[d],a=>{c[d]=a;});}return b;};function r(a,b){return a!==void 0?a()||b:b;}function er(a,b){if(a!==void 0){const b=a();if(b!==void 0&&b!==null){return b.slice();}}return b;}function ap(a,b){return a!==void 0?b.concat(a()):b;}function l1(a,b){if(a===void 0){return b;}return b!==void 0?b.concat(a()):a();}function gX(e,a,f,g,c,h){a.key=g+c;const d=b(e,a,f);return c===true?bd(d,h()):d;}const gY='0 0 24 24';const es=a=>a;const et=a=>`ionicons ${a}`;const gZ={'mdi-':a=>`mdi ${a}`,'icon-':es,'bt-':a=>`bt ${a}`,'eva-':a=>`eva ${a}`,'ion-md':et,'ion-ios':et,'ion-logo':et,'iconfont ':es,'ti-':a=>`themify-icon ${a}`,'bi-':a=>`bootstrap-icons ${a}`,'i-':es};const g$={o_:'-outlined',r_:'-round',s_:'-sharp'};const g_={sym_o_:'-outlined',sym_r_:'-rounded',sym_s_:'-sharp'};const l2=new RegExp('^('+Object.keys(gZ).join('|')+')');const l3=new RegExp('^('+Object.keys(g$).join('|')+')');const g0=new RegExp('^('+Object.keys(g_).join('|')+')');const l4=/^[Mm]\s?[-+]?\.?\d/;const l5=/^img:/;const l6=/^svguse:/;const l7=/^ion-/;const l8=/^(fa-(classic|sharp|solid|regular|light|brands|duotone|thin)|[lf]a[srlbdk]?) /;const S=n({name:'QIcon',props:{...cg,tag:{type:String,default:'i'},name:String,color:String,left:Boolean,right:Boolean},setup(d,{slots:e}){const{proxy:{$q:f}}=i();const g=ch(d);const h=a(()=>'q-icon'+(d.left===true?' on-left':'')+(d.right===true?' on-right':'')+(d.color!==void 0?` text-${d.color}`:''));const c=a(()=>{let c;let a=d.name;if(a==='none'||!a){return{none:true};}if(f.iconMapFn!==null){const b=f.iconMapFn(a);if(b!==void 0){if(b.icon!==void 0){a=b.icon;if(a==='none'||!a){return{none:true};}}else{return{cls:b.cls,content:b.content!==void 0?b.content:' '};}}}if(l4.test(a)===true){const[c,d=gY]=a.split('|');return{svg:true,viewBox:d,nodes:c.split('&&').map(a=>{const[c,d,e]=a.split('@@');return b('path',{style:d,d:c,transform:e});})};}if(l5.test(a)===true){return{img:true,src:a.substring(4)};}if(l6.test(a)===true){const[b,c=gY]=a.split('|');return{svguse:true,src:b.substring(7),viewBox:c};}let e=' ';const g=a.match(l2);if(g!==null){c=gZ[g[1]](a);}else if(l8.test(a)===true){c=a;}else if(l7.test(a)===true){c=`ionicons ion-${f.platform.is.ios===true?'ios':'md'}${a.substring(3)}`;}else if(g0.test(a)===true){c='notranslate material-symbols';const b=a.match(g0);if(b!==null){a=a.substring(6);c+=g_[b[1]];}e=a;}else{c='notranslate material-icons';const b=a.match(l3);if(b!==null){a=a.substring(2);c+=g$[b[1]];}e=a;}return{cls:c,content:e};});return()=>{const a={class:h.value,style:g.value,'aria-hidden':'true',};if(c.value.none===true){return b(d.tag,a,r(e.default));}if(c.value.img===true){return b(d.tag,a,ap(e.default,[b('img',{src:c.value.src})]));}if(c.value.svg===true){return b(d.tag,a,ap(e.default,[b('svg',{viewBox:c.value.viewBox||'0 0 24 24'},c.value.nodes)]));}if(c.value.svguse===true){return b(d.tag,a,ap(e.default,[b('svg',{viewBox:c.value.viewBox},[b('use',{'xlink:href':c.value.src})])]));}if(c.value.cls!==void 0){a.class+=' '+c.value.cls;}return b(d.tag,a,ap(e.default,[c.value.content]));};}});const l9=n({name:'QToolbarTitle',props:{shrink:Boolean},setup(c,{slots:d}){const e=a(()=>'q-toolbar__title ellipsis'+(c.shrink===true?' col-shrink':''));return()=>b('div',{class:e.value},r(d.default));}});const ma={size:{type:[String,Number],default:'1em'},color:String};function mb(b){return{cSize:a(()=>(b.size in ep?`${ep[b.size]}px`:b.size)),classes:a(()=>'q-spinner'+(b.color?` text-${b.color}`:''))};}const g1=n({name:'QSpinner',props:{...ma,thickness:{type:Number,default:5}},setup(a){const{cSize:c,classes:d}=mb(a);return()=>b('svg',{class:d.value+' q-spinner-mat',width:c.value,height:c.value,viewBox:'25 25 50 50'},[b('circle',{class:'path',cx:'50',cy:'50',r:'20',fill:'none',stroke:'currentColor','stroke-width':a.thickness,'stroke-miterlimit':'10'})]);}});function eu(b,a){const c=b.style;for(const b in a){c[b]=a[b];}}function mc(a){if(a===void 0||a===null){return void 0;}if(typeof a==='string'){try{return document.querySelector(a)||void 0;}catch(a){return void 0;}}const b=bb(a);if(b){return b.$el||b;}}function g2(a,b){if(a===void 0||a===null||a.contains(b)===true){return true;}for(let c=a.nextElementSibling;c!==null;c=c.nextElementSibling){if(c.contains(b)){return true;}}return false;}const E={hasPassive:false,passiveCapture:true,notPassiveCapture:true};try{const a=Object.defineProperty({},'passive',{get(){Object.assign(E,{hasPassive:true,passive:{passive:true},notPassive:{passive:false},passiveCapture:{passive:true,capture:true},notPassiveCapture:{passive:false,capture:true}});}});window.addEventListener('qtest',null,a);window.removeEventListener('qtest',null,a);}catch(a){}function aL(){}function md(a){return a.button===0;}function c2(a){if(a.touches&&a.touches[0]){a=a.touches[0];}else if(a.changedTouches&&a.changedTouches[0]){a=a.changedTouches[0];}else if(a.targetTouches&&a.targetTouches[0]){a=a.targetTouches[0];}return{top:a.clientY,left:a.clientX};}function me(a){if(a.path){return a.path;}if(a.composedPath){return a.composedPath();}const c=[];let b=a.target;while(b){c.push(b);if(b.tagName==='HTML'){c.push(document);c.push(window);return c;}b=b.parentElement;}}function aA(a){a.stopPropagation();}function ab(a){a.cancelable!==false&&a.preventDefault();}function B(a){a.cancelable!==false&&a.preventDefault();a.stopPropagation();}function ev(a,b){if(a===void 0||(b===true&&a.__dragPrevented===true)){return;}const c=b===true?a=>{a.__dragPrevented=true;a.addEventListener('dragstart',ab,E.notPassiveCapture);}:a=>{delete a.__dragPrevented;a.removeEventListener('dragstart',ab,E.notPassiveCapture);};a.querySelectorAll('a, img').forEach(c);}function bm(a,d,b){const c=`__q_${d}_evt`;a[c]=a[c]!==void 0?a[c].concat(b):b;b.forEach(b=>{b[0].addEventListener(b[1],a[b[2]],E[b[3]]);});}function ci(a,c){const b=`__q_${c}_evt`;if(a[b]!==void 0){a[b].forEach(b=>{b[0].removeEventListener(b[1],a[b[2]],E[b[3]]);});a[b]=void 0;}}let g3=false;function mf(a){g3=a.isComposing===true;}function c3(a){return g3===true||a!==Object(a)||a.isComposing===true||a.qKeyEvent===true;}function aM(a,b){return c3(a)===true?false:[].concat(b).includes(a.keyCode);}function mg(c,d=250){let a=false,b;return function(){if(a===false){a=true;setTimeout(()=>{a=false;},d);b=c.apply(this,arguments);}return b;};}function g4(i,j,b,q){b.modifiers.stop===true&&aA(i);const k=b.modifiers.color;let e=b.modifiers.center;e=e===true||q===true;const c=document.createElement('span'),a=document.createElement('span'),l=c2(i),{left:r,top:s,width:f,height:g}=j.getBoundingClientRect(),d=Math.sqrt(f*f+g*g),m=d/2,n=`${(f-d)/2}px`,t=e?n:`${l.left-r-m}px`,o=`${(g-d)/2}px`,u=e?o:`${l.top-s-m}px`;a.className='q-ripple__inner';eu(a,{height:`${d}px`,width:`${d}px`,transform:`translate3d(${t},${u},0) scale3d(.2,.2,1)`,opacity:0});c.className=`q-ripple${k?' text-'+k:''}`;c.setAttribute('dir','ltr');c.appendChild(a);j.appendChild(c);const p=()=>{c.remove();clearTimeout(h);};b.abort.push(p);let h=setTimeout(()=>{a.classList.add('q-ripple__inner--enter');a.style.transform=`translate3d(${n},${o},0) scale3d(1,1,1)`;a.style.opacity=0.2;h=setTimeout(()=>{a.classList.remove('q-ripple__inner--enter');a.classList.add('q-ripple__inner--leave');a.style.opacity=0;h=setTimeout(()=>{c.remove();b.abort.splice(b.abort.indexOf(p),1);},275);},250);},50);}function g5(b,{modifiers:c,value:d,arg:e}){const a=Object.assign({},b.cfg.ripple,c,d);b.modifiers={early:a.early===true,stop:a.stop===true,center:a.center===true,color:a.color||e,keyCodes:[].concat(a.keyCodes||13)};}const ew=eq({name:'ripple',beforeMount(b,c){const d=c.instance.$.appContext.config.globalProperties.$q.config||{};if(d.ripple===false)return;const a={cfg:d,enabled:c.value!==false,modifiers:{},abort:[],start(c){if(a.enabled===true&&c.qSkipRipple!==true&&c.type===(a.modifiers.early===true?'pointerdown':'click')){g4(c,b,a,c.qKeyEvent===true);}},keystart:mg(c=>{if(a.enabled===true&&c.qSkipRipple!==true&&aM(c,a.modifiers.keyCodes)===true&&c.type===`key${a.modifiers.early===true?'down':'up'}`){g4(c,b,a,true);}},300)};g5(a,c);b.__qripple=a;bm(a,'main',[[b,'pointerdown','start','passive'],[b,'click','start','passive'],[b,'keydown','keystart','passive'],[b,'keyup','keystart','passive']]);},updated(b,a){if(a.oldValue!==a.value){const c=b.__qripple;if(c!==void 0){c.enabled=a.value!==false;if(c.enabled===true&&Object(a.value)===a.value){g5(c,a);}}}},beforeUnmount(b){const a=b.__qripple;if(a!==void 0){a.abort.forEach(a=>{a();});ci(a,'main');delete b._qripple;}}});const g6={left:'start',center:'center',right:'end',between:'between',around:'around',evenly:'evenly',stretch:'stretch'};const mh=Object.keys(g6);const g7={align:{type:String,validator:a=>mh.includes(a)}};function g8(b){return a(()=>{const a=b.align===void 0?b.vertical===true?'stretch':'left':b.align;return`${b.vertical===true?'items':'justify'}-${g6[a]}`;});}function c4(b){if(Object(b.$parent)===b.$parent){return b.$parent;}let{parent:a}=b.$;while(Object(a)===a){if(Object(a.proxy)===a.proxy){return a.proxy;}a=a.parent;}}function g9(b,a){if(typeof a.type==='symbol'){if(Array.isArray(a.children)===true){a.children.forEach(a=>{g9(b,a);});}}else{b.add(a);}}function mi(b){const a=new Set();b.forEach(b=>{g9(a,b);});return Array.from(a);}function ex(a){return a.appContext.config.globalProperties.$router!==void 0;}function ha(a){return a.isUnmounted===true||a.isDeactivated===true;}function hb(a){return a?(a.aliasOf?a.aliasOf.path:a.path):'';}function hc(a,b){return(a.aliasOf||a)===(b.aliasOf||b);}function mj(b,a){for(const c in a){const d=a[c],e=b[c];if(typeof d==='string'){if(d!==e){return false;}}else if(Array.isArray(e)===false||e.length!==d.length||d.some((a,b)=>a!==e[b])){return false;}}return true;}function hd(a,b){return Array.isArray(b)===true?a.length===b.length&&a.every((a,c)=>a===b[c]):a.length===1&&a[0]===b;}function mk(a,b){return Array.isArray(a)===true?hd(a,b):(Array.isArray(b)===true?hd(b,a):a===b);}function ml(a,b){if(Object.keys(a).length!==Object.keys(b).length){return false;}for(const c in a){if(mk(a[c],b[c])===false){return false;}}return true;}const he={to:[String,Object],replace:Boolean,href:String,target:String,disable:Boolean};const mm={...he,exact:Boolean,activeClass:{type:String,default:'q-router-link--active'},exactActiveClass:{type:String,default:'q-router-link--exact-active'}};function hf({fallbackTag:q,useDisableForRouterLinkProps:r=true}={}){const h=i();const{props:b,proxy:d,emit:j}=h;const k=ex(h);const f=a(()=>b.disable!==true&&b.href!==void 0);const s=r===true?a(()=>k===true&&b.disable!==true&&f.value!==true&&b.to!==void 0&&b.to!==null&&b.to!==''):a(()=>k===true&&f.value!==true&&b.to!==void 0&&b.to!==null&&b.to!=='');const e=a(()=>(s.value===true?o(b.to):null));const c=a(()=>e.value!==null);const l=a(()=>f.value===true||c.value===true);const t=a(()=>(b.type==='a'||l.value===true?'a':(b.tag||q||'div')));const u=a(()=>(f.value===true?{href:b.href,target:b.target}:(c.value===true?{href:e.value.href,target:b.target}:{})));const m=a(()=>{if(c.value===false){return-1;}const{matched:b}=e.value,{length:f}=b,g=b[f-1];if(g===void 0){return-1;}const a=d.$route.matched;if(a.length===0){return-1;}const h=a.findIndex(hc.bind(null,g));if(h!==-1){return h;}const i=hb(b[f-2]);return(f>1&&hb(g)===i&&a[a.length-1].path!==i?a.findIndex(hc.bind(null,b[f-2])):h);});const g=a(()=>c.value===true&&m.value!==-1&&mj(d.$route.params,e.value.params));const n=a(()=>g.value===true&&m.value===d.$route.matched.length-1&&ml(d.$route.params,e.value.params));const v=a(()=>(c.value===true?(n.value===true?` ${b.exactActiveClass} ${b.activeClass}`:(b.exact===true?'':(g.value===true?` ${b.activeClass}`:''))):''));function o(a){try{return d.$router.resolve(a);}catch(a){}return null;}function p(a,{returnRouterError:e,to:f=b.to,replace:g=b.replace}={}){if(b.disable===true){a.preventDefault();return Promise.resolve(false);}if(a.metaKey||a.altKey||a.ctrlKey||a.shiftKey||(a.button!==void 0&&a.button!==0)||b.target==='_blank'){return Promise.resolve(false);}a.preventDefault();const c=d.$router[g===true?'replace':'push'](f);return e===true?c:c.then(()=>{}).catch(()=>{});}function w(a){if(c.value===true){const b=b=>p(a,b);j('click',a,b);a.defaultPrevented!==true&&b();}else{j('click',a);}}return{hasRouterLink:c,hasHrefLink:f,hasLink:l,linkTag:t,resolvedLink:e,linkIsActive:g,linkIsExactActive:n,linkClass:v,linkAttrs:u,getLink:o,navigateToRouterLink:p,navigateOnClick:w};}const hg={none:0,xs:4,sm:8,md:16,lg:24,xl:32};const mn={xs:8,sm:10,md:14,lg:20,xl:24};const mo=['button','submit','reset'];const mp=/[^\s]\/[^\s]/;const mq=['flat','outline','push','unelevated'];function mr(a,b){if(a.flat===true)return'flat';if(a.outline===true)return'outline';if(a.push===true)return'push';if(a.unelevated===true)return'unelevated';return b;}const ms={...cg,...he,type:{type:String,default:'button'},label:[Number,String],icon:String,iconRight:String,...mq.reduce((a,b)=>(a[b]=Boolean)&&a,{}),square:Boolean,rounded:Boolean,glossy:Boolean,size:String,fab:Boolean,fabMini:Boolean,padding:String,color:String,textColor:String,noCaps:Boolean,noWrap:Boolean,dense:Boolean,tabindex:[Number,String],ripple:{type:[Boolean,Object],default:true},align:{...g7.align,default:'center'},stack:Boolean,stretch:Boolean,loading:{type:Boolean,default:null},disable:Boolean};const mt={...ms,round:Boolean};function mu(b){const f=ch(b,mn);const g=g8(b);const{hasRouterLink:h,hasLink:d,linkTag:e,linkAttrs:i,navigateOnClick:j}=hf({fallbackTag:'button'});const k=a(()=>{const a=b.fab===false&&b.fabMini===false?f.value:{};return b.padding!==void 0?Object.assign({},a,{padding:b.padding.split(/\s+/).map(a=>(a in hg?hg[a]+'px':a)).join(' '),minWidth:'0',minHeight:'0'}):a;});const l=a(()=>b.rounded===true||b.fab===true||b.fabMini===true);const c=a(()=>b.disable!==true&&b.loading!==true);const m=a(()=>(c.value===true?b.tabindex||0:-1));const n=a(()=>mr(b,'standard'));const o=a(()=>{const a={tabindex:m.value};if(d.value===true){Object.assign(a,i.value);}else if(mo.includes(b.type)===true){a.type=b.type;}if(e.value==='a'){if(b.disable===true){a['aria-disabled']='true';}else if(a.href===void 0){a.role='button';}if(h.value!==true&&mp.test(b.type)===true){a.type=b.type;}}else if(b.disable===true){a.disabled='';a['aria-disabled']='true';}if(b.loading===true&&b.percentage!==void 0){Object.assign(a,{role:'progressbar','aria-valuemin':0,'aria-valuemax':100,'aria-valuenow':b.percentage});}return a;});const p=a(()=>{let a;if(b.color!==void 0){if(b.flat===true||b.outline===true){a=`text-${b.textColor||b.color}`;}else{a=`bg-${b.color} text-${b.textColor||'white'}`;}}else if(b.textColor){a=`text-${b.textColor}`;}const d=b.round===true?'round':`rectangle${l.value===true?' q-btn--rounded':(b.square===true?' q-btn--square':'')}`;return`q-btn--${n.value} q-btn--${d}`+(a!==void 0?' '+a:'')+(c.value===true?' q-btn--actionable q-focusable q-hoverable':(b.disable===true?' disabled':''))+(b.fab===true?' q-btn--fab':(b.fabMini===true?' q-btn--fab-mini':''))+(b.noCaps===true?' q-btn--no-uppercase':'')+(b.dense===true?' q-btn--dense':'')+(b.stretch===true?' no-border-radius self-stretch':'')+(b.glossy===true?' glossy':'')+(b.square?' q-btn--square':'');});const q=a(()=>g.value+(b.stack===true?' column':' row')+(b.noWrap===true?' no-wrap text-no-wrap':'')+(b.loading===true?' q-btn__content--hidden':''));return{classes:p,style:k,innerClasses:q,attributes:o,hasLink:d,linkTag:e,navigateOnClick:j,isActionable:c};}const{passiveCapture:aj}=E;let bE=null,bF=null,bG=null;const Y=n({name:'QBtn',props:{...mt,percentage:Number,darkPercentage:Boolean,onTouchstart:[Function,Array]},emits:['click','keydown','mousedown','keyup'],setup(e,{slots:m,emit:k}){const{proxy:p}=i();const{classes:t,style:u,innerClasses:v,attributes:w,hasLink:y,linkTag:z,navigateOnClick:A,isActionable:q}=mu(e);const d=c(null);const r=c(null);let g=null,n,l=null;const o=a(()=>e.label!==void 0&&e.label!==null&&e.label!=='');const C=a(()=>(e.disable===true||e.ripple===false?false:{keyCodes:y.value===true?[13,32]:[13],...(e.ripple===true?{}:e.ripple)}));const D=a(()=>({center:e.round}));const E=a(()=>{const a=Math.max(0,Math.min(100,e.percentage));return a>0?{transition:'transform 0.6s',transform:`translateX(${a-100}%)`}:{};});const F=a(()=>{if(e.loading===true){return{onMousedown:j,onTouchstart:j,onClick:j,onKeydown:j,onKeyup:j};}if(q.value===true){const a={onClick:s,onKeydown:H,onMousedown:J};if(p.$q.platform.has.touch===true){const b=e.onTouchstart!==void 0?'':'Passive';a[`onTouchstart${b}`]=I;}return a;}return{onClick:B};});const G=a(()=>({ref:d,class:'q-btn q-btn-item non-selectable no-outline '+t.value,style:u.value,...w.value,...F.value}));function s(a){if(d.value===null)return;if(a!==void 0){if(a.defaultPrevented===true)return;const b=document.activeElement;if(e.type==='submit'&&b!==document.body&&d.value.contains(b)===false&&b.contains(d.value)===false){a.qAvoidFocus!==true&&d.value.focus();const b=()=>{document.removeEventListener('keydown',B,true);document.removeEventListener('keyup',b,aj);d.value?.removeEventListener('blur',b,aj);};document.addEventListener('keydown',B,true);document.addEventListener('keyup',b,aj);d.value.addEventListener('blur',b,aj);}}A(a);}function H(a){if(d.value===null)return;k('keydown',a);if(aM(a,[13,32])===true&&bF!==d.value){bF!==null&&h();if(a.defaultPrevented!==true){a.qAvoidFocus!==true&&d.value.focus();bF=d.value;d.value.classList.add('q-btn--active');document.addEventListener('keyup',f,true);d.value.addEventListener('blur',f,aj);}B(a);}}function I(a){if(d.value===null)return;k('touchstart',a);if(a.defaultPrevented===true)return;if(bE!==d.value){bE!==null&&h();bE=d.value;g=a.target;g.addEventListener('touchcancel',f,aj);g.addEventListener('touchend',f,aj);}n=true;l!==null&&clearTimeout(l);l=setTimeout(()=>{l=null;n=false;},200);}function J(a){if(d.value===null)return;a.qSkipRipple=n===true;k('mousedown',a);if(a.defaultPrevented!==true&&bG!==d.value){bG!==null&&h();bG=d.value;d.value.classList.add('q-btn--active');document.addEventListener('mouseup',f,aj);}}function f(a){if(d.value===null)return;if(a?.type==='blur'&&document.activeElement===d.value)return;if(a?.type==='keyup'){if(bF===d.value&&aM(a,[13,32])===true){const b=new MouseEvent('click',a);b.qKeyEvent=true;a.defaultPrevented===true&&ab(b);a.cancelBubble===true&&aA(b);d.value.dispatchEvent(b);B(a);a.qKeyEvent=true;}k('keyup',a);}h();}function h(b){const a=r.value;if(b!==true&&(bE===d.value||bG===d.value)&&a!==null&&a!==document.activeElement){a.setAttribute('tabindex',-1);a.focus();}if(bE===d.value){if(g!==null){g.removeEventListener('touchcancel',f,aj);g.removeEventListener('touchend',f,aj);}bE=g=null;}if(bG===d.value){document.removeEventListener('mouseup',f,aj);bG=null;}if(bF===d.value){document.removeEventListener('keyup',f,true);d.value?.removeEventListener('blur',f,aj);bF=null;}d.value?.classList.remove('q-btn--active');}function j(a){B(a);a.qSkipRipple=true;}x(()=>{h(true);});Object.assign(p,{click:a=>{if(q.value===true){s(a);}}});return()=>{let a=[];e.icon!==void 0&&a.push(b(S,{name:e.icon,left:e.stack!==true&&o.value===true,role:'img'}));o.value===true&&a.push(b('span',{class:'block'},[e.label]));a=ap(m.default,a);if(e.iconRight!==void 0&&e.round===false){a.push(b(S,{name:e.iconRight,right:e.stack!==true&&o.value===true,role:'img'}));}const c=[b('span',{class:'q-focus-helper',ref:r})];if(e.loading===true&&e.percentage!==void 0){c.push(b('span',{class:'q-btn__progress absolute-full overflow-hidden'+(e.darkPercentage===true?' q-btn__progress--dark':'')},[b('span',{class:'q-btn__progress-indicator fit block',style:E.value})]));}c.push(b('span',{class:'q-btn__content text-center col items-center q-anchor--skip '+v.value},a));e.loading!==null&&c.push(b(bD,{name:'q-transition--fade'},()=>(e.loading===true?[b('span',{key:'loading',class:'absolute-full flex flex-center'},m.loading!==void 0?m.loading():[b(g1)])]:null)));return bd(b(z.value,G.value,c),[[ew,C.value,void 0,D.value]]);};}});const ey=n({name:'QItemSection',props:{avatar:Boolean,thumbnail:Boolean,side:Boolean,top:Boolean,noWrap:Boolean},setup(c,{slots:d}){const e=a(()=>'q-item__section column'+` q-item__section--${c.avatar===true||c.side===true||c.thumbnail===true?'side':'main'}`+(c.top===true?' q-item__section--top justify-start':' justify-center')+(c.avatar===true?' q-item__section--avatar':'')+(c.thumbnail===true?' q-item__section--thumbnail':'')+(c.noWrap===true?' q-item__section--nowrap':''));return()=>b('div',{class:e.value},r(d.default));}});const ac={dark:{type:Boolean,default:null}};function ad(b,c){return a(()=>(b.dark===null?c.dark.isActive:b.dark));}const ez=n({name:'QItem',props:{...ac,...mm,tag:{type:String,default:'div'},active:{type:Boolean,default:null},clickable:Boolean,dense:Boolean,insetLevel:Number,tabindex:[String,Number],focused:Boolean,manualFocus:Boolean},emits:['click','keyup'],setup(d,{slots:l,emit:m}){const{proxy:{$q:h}}=i();const n=ad(d,h);const{hasLink:j,linkAttrs:o,linkClass:p,linkTag:q,navigateOnClick:r}=hf();const f=c(null);const g=c(null);const k=a(()=>d.clickable===true||j.value===true||d.tag==='label');const e=a(()=>d.disable!==true&&k.value===true);const s=a(()=>'q-item q-item-type row no-wrap'+(d.dense===true?' q-item--dense':'')+(n.value===true?' q-item--dark':'')+(j.value===true&&d.active===null?p.value:(d.active===true?` q-item--active${d.activeClass!==void 0?` ${d.activeClass}`:''}`:''))+(d.disable===true?' disabled':'')+(e.value===true?' q-item--clickable q-link cursor-pointer '+(d.manualFocus===true?'q-manual-focusable':'q-focusable q-hoverable')+(d.focused===true?' q-manual-focusable--focused':''):''));const t=a(()=>{if(d.insetLevel===void 0){return null;}const a=h.lang.rtl===true?'Right':'Left';return{['padding'+a]:(16+d.insetLevel*56)+'px'};});function u(a){if(e.value===true){if(g.value!==null&&a.qAvoidFocus!==true){if(a.qKeyEvent!==true&&document.activeElement===f.value){g.value.focus();}else if(document.activeElement===g.value){f.value.focus();}}r(a);}}function v(a){if(e.value===true&&aM(a,[13,32])===true){B(a);a.qKeyEvent=true;const b=new MouseEvent('click',a);b.qKeyEvent=true;f.value.dispatchEvent(b);}m('keyup',a);}function w(){const a=er(l.default,[]);e.value===true&&a.unshift(b('div',{class:'q-focus-helper',tabindex:-1,ref:g}));return a;}return()=>{const a={ref:f,class:s.value,style:t.value,role:'listitem',onClick:u,onKeyup:v};if(e.value===true){a.tabindex=d.tabindex||'0';Object.assign(a,o.value);}else if(k.value===true){a['aria-disabled']='true';}return b(q.value,a,w());};}});const mv=['ul','ol'];const hh=n({name:'QList',props:{...ac,bordered:Boolean,dense:Boolean,separator:Boolean,padding:Boolean,tag:{type:String,default:'div'}},setup(c,{slots:d}){const e=i();const f=ad(c,e.proxy.$q);const g=a(()=>(mv.includes(c.tag)?null:'list'));const h=a(()=>'q-list'+(c.bordered===true?' q-list--bordered':'')+(c.dense===true?' q-list--dense':'')+(c.separator===true?' q-list--separator':'')+(f.value===true?' q-list--dark':'')+(c.padding===true?' q-list--padding':''));return()=>b(c.tag,{class:h.value,role:g.value},r(d.default));}});const aB=c(false);let eA;function mw(a,c){const b=/(edg|edge|edga|edgios)\/([\w.]+)/.exec(a)||/(opr)[\/]([\w.]+)/.exec(a)||/(vivaldi)[\/]([\w.]+)/.exec(a)||/(chrome|crios)[\/]([\w.]+)/.exec(a)||/(version)(applewebkit)[\/]([\w.]+).*(safari)[\/]([\w.]+)/.exec(a)||/(webkit)[\/]([\w.]+).*(version)[\/]([\w.]+).*(safari)[\/]([\w.]+)/.exec(a)||/(firefox|fxios)[\/]([\w.]+)/.exec(a)||/(webkit)[\/]([\w.]+)/.exec(a)||/(opera)(?:.*version|)[\/]([\w.]+)/.exec(a)||[];return{browser:b[5]||b[3]||b[1]||'',version:b[4]||b[2]||'0',platform:c[0]||''};}function mx(a){return/(ipad)/.exec(a)||/(ipod)/.exec(a)||/(windows phone)/.exec(a)||/(iphone)/.exec(a)||/(kindle)/.exec(a)||/(silk)/.exec(a)||/(android)/.exec(a)||/(win)/.exec(a)||/(mac)/.exec(a)||/(linux)/.exec(a)||/(cros)/.exec(a)||/(playbook)/.exec(a)||/(bb)/.exec(a)||/(blackberry)/.exec(a)||[];}const hi='ontouchstart'in window||window.navigator.maxTouchPoints>0;function my(e){const c=e.toLowerCase();const f=mx(c);const b=mw(c,f);const a={mobile:false,desktop:false,cordova:false,capacitor:false,nativeMobile:false,electron:false,bex:false,linux:false,mac:false,win:false,cros:false,chrome:false,firefox:false,opera:false,safari:false,vivaldi:false,edge:false,edgeChromium:false,ie:false,webkit:false,android:false,ios:false,ipad:false,iphone:false,ipod:false,kindle:false,winphone:false,blackberry:false,playbook:false,silk:false};if(b.browser){a[b.browser]=true;a.version=b.version;a.versionNumber=parseInt(b.version,10);}if(b.platform){a[b.platform]=true;}const d=a.android||a.ios||a.bb||a.blackberry||a.ipad||a.iphone||a.ipod||a.kindle||a.playbook||a.silk||a['windows phone'];if(d===true||c.indexOf('mobile')!==-1){a.mobile=true;}else{a.desktop=true;}if(a['windows phone']){a.winphone=true;delete a['windows phone'];}if(a.edga||a.edgios||a.edg){a.edge=true;b.browser='edge';}else if(a.crios){a.chrome=true;b.browser='chrome';}else if(a.fxios){a.firefox=true;b.browser='firefox';}if(a.ipod||a.ipad||a.iphone){a.ios=true;}if(a.vivaldi){b.browser='vivaldi';a.vivaldi=true;}if(a.chrome||a.opr||a.safari||a.vivaldi||(a.mobile===true&&a.ios!==true&&d!==true)){a.webkit=true;}if(a.opr){b.browser='opera';a.opera=true;}if(a.safari){if(a.blackberry||a.bb){b.browser='blackberry';a.blackberry=true;}else if(a.playbook){b.browser='playbook';a.playbook=true;}else if(a.android){b.browser='android';a.android=true;}else if(a.kindle){b.browser='kindle';a.kindle=true;}else if(a.silk){b.browser='silk';a.silk=true;}}a.name=b.browser;a.platform=b.platform;{if(c.indexOf('electron')!==-1){a.electron=true;}else if(document.location.href.indexOf('-extension://')!==-1){a.bex=true;}else{if(window.Capacitor!==void 0){a.capacitor=true;a.nativeMobile=true;a.nativeMobileWrapper='capacitor';}else if(window._cordovaNative!==void 0||window.cordova!==void 0){a.cordova=true;a.nativeMobile=true;a.nativeMobileWrapper='cordova';}if(aB.value===true){eA={is:{...a}};}if(hi===true&&a.mac===true&&((a.desktop===true&&a.safari===true)||(a.nativeMobile===true&&a.android!==true&&a.ios!==true&&a.ipad!==true))){delete a.mac;delete a.desktop;const b=Math.min(window.innerHeight,window.innerWidth)>414?'ipad':'iphone';Object.assign(a,{mobile:true,ios:true,platform:b,[b]:true});}if(a.mobile!==true&&window.navigator.userAgentData&&window.navigator.userAgentData.mobile){delete a.desktop;a.mobile=true;}}}return a;}const hj=navigator.userAgent||navigator.vendor||window.opera;const mz={has:{touch:false,webStorage:false},within:{iframe:false}};const y={userAgent:hj,is:my(hj),has:{touch:hi},within:{iframe:window.self!==window.top}};const c5={install(b){const{$q:a}=b;if(aB.value===true){b.onSSRHydrated.push(()=>{Object.assign(a.platform,y);aB.value=false;});a.platform=ax(this);}else{a.platform=this;}}};{let a;ai(y.has,'webStorage',()=>{if(a!==void 0){return a;}try{if(window.localStorage){a=true;return true;}}catch(a){}a=false;return false;});Object.assign(c5,y);if(aB.value===true){Object.assign(c5,eA,mz);eA=null;}}function hk(){if(window.getSelection!==void 0){const a=window.getSelection();if(a.empty!==void 0){a.empty();}else if(a.removeAllRanges!==void 0){a.removeAllRanges();c5.is.mobile!==true&&a.addRange(document.createRange());}}else if(document.selection!==void 0){document.selection.empty();}}const mA={target:{type:[Boolean,String,Element],default:true},noParentEvent:Boolean};const mB={...mA,contextMenu:Boolean};function mC({showing:m,avoidEmit:n,configureAnchorEl:h}){const{props:b,proxy:d,emit:o}=i();const a=c(null);let e=null;function k(b){return a.value===null?false:(b===void 0||b.touches===void 0||b.touches.length<=1);}const g={};if(h===void 0){Object.assign(g,{hide(a){d.hide(a);},toggle(a){d.toggle(a);a.qAnchorHandled=true;},toggleKey(a){aM(a,13)===true&&g.toggle(a);},contextClick(a){d.hide(a);ab(a);w(()=>{d.show(a);a.qAnchorHandled=true;});},prevent:ab,mobileTouch(b){g.mobileCleanup(b);if(k(b)!==true)return;d.hide(b);a.value.classList.add('non-selectable');const c=b.target;bm(g,'anchor',[[c,'touchmove','mobileCleanup','passive'],[c,'touchend','mobileCleanup','passive'],[c,'touchcancel','mobileCleanup','passive'],[a.value,'contextmenu','prevent','notPassive']]);e=setTimeout(()=>{e=null;d.show(b);b.qAnchorHandled=true;},300);},mobileCleanup(b){a.value.classList.remove('non-selectable');if(e!==null){clearTimeout(e);e=null;}if(m.value===true&&b!==void 0){hk();}}});h=function(e=b.contextMenu){if(b.noParentEvent===true||a.value===null)return;let c;if(e===true){if(d.$q.platform.is.mobile===true){c=[[a.value,'touchstart','mobileTouch','passive']];}else{c=[[a.value,'mousedown','hide','passive'],[a.value,'contextmenu','contextClick','notPassive']];}}else{c=[[a.value,'click','toggle','passive'],[a.value,'keyup','toggleKey','passive']];}bm(g,'anchor',c);};}function j(){ci(g,'anchor');}function p(b){a.value=b;while(a.value.classList.contains('q-anchor--skip')){a.value=a.value.parentNode;}h();}function l(){if(b.target===false||b.target===''||d.$el.parentNode===null){a.value=null;}else if(b.target===true){p(d.$el.parentNode);}else{let c=b.target;if(typeof b.target==='string'){try{c=document.querySelector(b.target);}catch(a){c=void 0;}}if(c!==void 0&&c!==null){a.value=c.$el||c;h();}else{a.value=null;console.error(`Anchor: target "${b.target}" not found`);}}}f(()=>b.contextMenu,b=>{if(a.value!==null){j();h(b);}});f(()=>b.target,()=>{if(a.value!==null){j();}l();});f(()=>b.noParentEvent,b=>{if(a.value!==null){if(b===true){j();}else{h();}}});L(()=>{l();if(n!==true&&b.modelValue===true&&a.value===null){o('update:modelValue',false);}});x(()=>{e!==null&&clearTimeout(e);j();});return{anchorEl:a,canShow:k,anchorEvents:g};}function mD(g,h){const a=c(null);let b;function d(c,a){const d=`${a!==void 0?'add':'remove'}EventListener`;const e=a!==void 0?a:b;if(c!==window){c[d]('scroll',e,E.passive);}window[d]('scroll',e,E.passive);b=a;}function e(){if(a.value!==null){d(a.value);a.value=null;}}const i=f(()=>g.noParentEvent,()=>{if(a.value!==null){e();h();}});x(i);return{localScrollTarget:a,unconfigureScrollTarget:e,changeScrollEvent:d};}const hl={modelValue:{type:Boolean,default:null},'onUpdate:modelValue':[Function,Array]};const hm=['beforeShow','show','beforeHide','hide'];function hn({showing:b,canShow:g,hideOnRouteChange:h,handleShow:j,handleHide:k,processOnMount:s}){const l=i();const{props:a,emit:c,proxy:m}=l;let d;function t(a){if(b.value===true){e(a);}else{n(a);}}function n(b){if((a.disable===true)||(b?.qAnchorHandled===true)||(g!==void 0&&g(b)!==true))return;const e=a['onUpdate:modelValue']!==void 0;if(e===true&&false!==true){c('update:modelValue',true);d=b;w(()=>{if(d===b){d=void 0;}});}if(a.modelValue===null||e===false||false){o(b);}}function o(a){if(b.value===true)return;b.value=true;c('beforeShow',a);if(j!==void 0){j(a);}else{c('show',a);}}function e(b){if(a.disable===true)return;const e=a['onUpdate:modelValue']!==void 0;if(e===true&&false!==true){c('update:modelValue',false);d=b;w(()=>{if(d===b){d=void 0;}});}if(a.modelValue===null||e===false||false){p(b);}}function p(a){if(b.value===false)return;b.value=false;c('beforeHide',a);if(k!==void 0){k(a);}else{c('hide',a);}}function q(e){if(a.disable===true&&e===true){if(a['onUpdate:modelValue']!==void 0){c('update:modelValue',false);}}else if((e===true)!==b.value){const a=e===true?o:p;a(d);}}f(()=>a.modelValue,q);if(h!==void 0&&ex(l)===true){f(()=>m.$route.fullPath,()=>{if(h.value===true&&b.value===true){e();}});}s===true&&L(()=>{q(a.modelValue);});const r={show:n,hide:e,toggle:t};Object.assign(m,r);return r;}let bn=[];let cj=[];function ho(a){cj=cj.filter(b=>b!==a);}function mE(a){ho(a);cj.push(a);}function hp(a){ho(a);if(cj.length===0&&bn.length!==0){bn[bn.length-1]();bn=[];}}function c6(a){if(cj.length===0){a();}else{bn.push(a);}}function mF(a){bn=bn.filter(b=>b!==a);}const c7={};let hq=false;function mG(){hq=true;}let mH=1;let mI=document.body;function mJ(c,b){const a=document.createElement('div');a.id=b!==void 0?`q-portal--${b}--${mH++}`:c;if(c7.globalNodes!==void 0){const b=c7.globalNodes.class;if(b!==void 0){a.className=b;}}mI.appendChild(a);return a;}function mK(a){a.remove();}const bH=[];function mL(a){return bH.find(b=>b.contentEl!==null&&b.contentEl.contains(a));}function hr(a,b){do{if(a.$options.name==='QMenu'){a.hide(b);if(a.$props.separateClosePopup===true){return c4(a);}}else if(a.__qPortal===true){const c=c4(a);if(c?.$options.name==='QPopupProxy'){a.hide(b);return c;}else{return a;}}a=c4(a);}while(a!==void 0&&a!==null);}function mM(a,b,c){while(c!==0&&a!==void 0&&a!==null){if(a.__qPortal===true){c--;if(a.$options.name==='QMenu'){a=hr(a,b);continue;}a.hide(b);}a=c4(a);}}const mN=n({name:'QPortal',setup(b,{slots:a}){return()=>a.default();}});function mO(a){a=a.parent;while(a!==void 0&&a!==null){if(a.type.name==='QGlobalDialog'){return true;}if(a.type.name==='QDialog'||a.type.name==='QMenu'){return false;}a=a.parent;}return false;}function hs(d,l,h,i){const e=c(false);const f=c(false);let a=null;const g={};const j=i==='dialog'&&mO(d);function m(b){if(b===true){hp(g);f.value=true;return;}f.value=false;if(e.value===false){if(j===false&&a===null){a=mJ(false,i);}e.value=true;bH.push(d.proxy);mE(g);}}function k(c){f.value=false;if(c!==true)return;hp(g);e.value=false;const b=bH.indexOf(d.proxy);if(b!==-1){bH.splice(b,1);}if(a!==null){mK(a);a=null;}}bC(()=>{k(true);});d.proxy.__qPortal=true;ai(d.proxy,'contentEl',()=>l.value);return{showPortal:m,hidePortal:k,portalIsActive:e,portalIsAccessible:f,renderPortal:()=>(j===true?h():(e.value===true?[b(kC,{to:a},b(mN,h))]:void 0))};}const ht={transitionShow:{type:String,default:'fade'},transitionHide:{type:String,default:'fade'},transitionDuration:{type:[String,Number],default:300}};function hu(b,c=()=>{},d=()=>{}){return{transitionProps:a(()=>{const a=`q-transition--${b.transitionShow||c()}`;const e=`q-transition--${b.transitionHide||d()}`;return{appear:true,enterFromClass:`${a}-enter-from`,enterActiveClass:`${a}-enter-active`,enterToClass:`${a}-enter-to`,leaveFromClass:`${e}-leave-from`,leaveActiveClass:`${e}-leave-active`,leaveToClass:`${e}-leave-to`};}),transitionStyle:a(()=>`--q-transition-duration: ${b.transitionDuration}ms`)};}function ck(){let a;const c=i();function b(){a=void 0;}bf(b);x(b);return{removeTick:b,registerTick(b){a=b;w(()=>{if(a===b){ha(c)===false&&a();a=void 0;}});}};}function cl(){let a=null;const c=i();function b(){if(a!==null){clearTimeout(a);a=null;}}bf(b);x(b);return{removeTimeout:b,registerTimeout(d,e){b();if(ha(c)===false){a=setTimeout(()=>{a=null;d();},e);}}};}const eB=[Element,String];const mP=[null,document,document.body,document.scrollingElement,document.documentElement];function eC(b,c){let a=mc(c);if(a===void 0){if(b===void 0||b===null){return window;}a=b.closest('.scroll,.scroll-y,.overflow-auto');}return mP.includes(a)?window:a;}function hv(a){return a===window?window.pageYOffset||window.scrollY||document.body.scrollTop||0:a.scrollTop;}function hw(a){return a===window?window.pageXOffset||window.scrollX||document.body.scrollLeft||0:a.scrollLeft;}let c8;function c9(){if(c8!==undefined){return c8;}const b=document.createElement('p'),a=document.createElement('div');eu(b,{width:'100%',height:'200px'});eu(a,{position:'absolute',top:'0px',left:'0px',visibility:'hidden',width:'200px',height:'150px',overflow:'hidden'});a.appendChild(b);document.body.appendChild(a);const d=b.offsetWidth;a.style.overflow='scroll';let c=b.offsetWidth;if(d===c){c=a.clientWidth;}a.remove();c8=d-c;return c8;}function mQ(a,b=true){if(!a||a.nodeType!==Node.ELEMENT_NODE){return false;}return b?(a.scrollHeight>a.clientHeight&&(a.classList.contains('scroll')||a.classList.contains('overflow-auto')||['auto','scroll'].includes(window.getComputedStyle(a)['overflow-y']))):(a.scrollWidth>a.clientWidth&&(a.classList.contains('scroll')||a.classList.contains('overflow-auto')||['auto','scroll'].includes(window.getComputedStyle(a)['overflow-x'])));}const bo=[];let bI;function mR(a){bI=a.keyCode===27;}function mS(){if(bI===true){bI=false;}}function mT(a){if(bI===true){bI=false;if(aM(a,27)===true){bo[bo.length-1](a);}}}function hx(a){window[a]('keydown',mR);window[a]('blur',mS);window[a]('keyup',mT);bI=false;}function hy(a){if(y.is.desktop===true){bo.push(a);if(bo.length===1){hx('addEventListener');}}}function da(b){const a=bo.indexOf(b);if(a!==-1){bo.splice(a,1);if(bo.length===0){hx('removeEventListener');}}}const bp=[];function hz(a){bp[bp.length-1](a);}function hA(a){if(y.is.desktop===true){bp.push(a);if(bp.length===1){document.body.addEventListener('focusin',hz);}}}function eD(b){const a=bp.indexOf(b);if(a!==-1){bp.splice(a,1);if(bp.length===0){document.body.removeEventListener('focusin',hz);}}}const{notPassiveCapture:db}=E,bq=[];function dc(c){const a=c.target;if(a===void 0||a.nodeType===8||a.classList.contains('no-pointer-events')===true)return;let b=bH.length-1;while(b>=0){const a=bH[b].$;if(a.type.name==='QTooltip'){b--;continue;}if(a.type.name!=='QDialog'){break;}if(a.props.seamless!==true)return;b--;}for(let b=bq.length-1;b>=0;b--){const d=bq[b];if((d.anchorEl.value===null||d.anchorEl.value.contains(a)===false)&&(a===document.body||(d.innerRef.value!==null&&d.innerRef.value.contains(a)===false))){c.qClickOutside=true;d.onClickOutside(c);}else{return;}}}function mU(a){bq.push(a);if(bq.length===1){document.addEventListener('mousedown',dc,db);document.addEventListener('touchstart',dc,db);}}function hB(b){const a=bq.findIndex(a=>a===b);if(a!==-1){bq.splice(a,1);if(bq.length===0){document.removeEventListener('mousedown',dc,db);document.removeEventListener('touchstart',dc,db);}}}let hC,hD;function hE(b){const a=b.split(' ');if(a.length!==2){return false;}if(['top','center','bottom'].includes(a[0])!==true){console.error('Anchor/Self position must start with one of top/center/bottom');return false;}if(['left','middle','right','start','end'].includes(a[1])!==true){console.error('Anchor/Self position must end with one of left/middle/right/start/end');return false;}return true;}function mV(a){if(!a){return true;}if(a.length!==2){return false;}if(typeof a[0]!=='number'||typeof a[1]!=='number'){return false;}return true;}const eE={'start#ltr':'left','start#rtl':'right','end#ltr':'right','end#rtl':'left'};['left','middle','right'].forEach(a=>{eE[`${a}#ltr`]=a;eE[`${a}#rtl`]=a;});function hF(b,c){const a=b.split(' ');return{vertical:a[0],horizontal:eE[`${a[1]}#${c===true?'rtl':'ltr'}`]};}function mW(h,a){let{top:b,left:c,right:d,bottom:e,width:f,height:g}=h.getBoundingClientRect();if(a!==void 0){b-=a[1];c-=a[0];e+=a[1];d+=a[0];f+=a[0];g+=a[1];}return{top:b,bottom:e,height:g,left:c,right:d,width:f,middle:c+(d-c)/2,center:b+(e-b)/2};}function mX(e,d,c){let{top:a,left:b}=e.getBoundingClientRect();a+=d.top;b+=d.left;if(c!==void 0){a+=c[1];b+=c[0];}return{top:a,bottom:a+1,height:1,left:b,right:b+1,width:1,middle:b,center:a};}function mY(a,b){return{top:0,center:b/2,bottom:b,left:0,middle:a/2,right:a};}function hG(a,b,c,d){return{top:a[c.vertical]-b[d.vertical],left:a[c.horizontal]-b[d.horizontal]};}function hH(e,m=0){if(e.targetEl===null||e.anchorEl===null||m>5)return;if(e.targetEl.offsetHeight===0||e.targetEl.offsetWidth===0){setTimeout(()=>{hH(e,m+1);},10);return;}const{targetEl:d,offset:f,anchorEl:n,anchorOrigin:g,selfOrigin:h,absoluteOffset:k,fit:o,cover:i,maxHeight:p,maxWidth:q}=e;if(y.is.ios===true&&window.visualViewport!==void 0){const c=document.body.style;const{offsetLeft:a,offsetTop:b}=window.visualViewport;if(a!==hC){c.setProperty('--q-pe-left',a+'px');hC=a;}if(b!==hD){c.setProperty('--q-pe-top',b+'px');hD=b;}}const{scrollLeft:r,scrollTop:s}=d;const a=k===void 0?mW(n,i===true?[0,0]:f):mX(n,k,f);Object.assign(d.style,{top:0,left:0,minWidth:null,minHeight:null,maxWidth:q,maxHeight:p,visibility:'visible'});const{offsetWidth:t,offsetHeight:l}=d;const{elWidth:u,elHeight:v}=o===true||i===true?{elWidth:Math.max(a.width,t),elHeight:i===true?Math.max(a.height,l):l}:{elWidth:t,elHeight:l};let c={maxWidth:q,maxHeight:p};if(o===true||i===true){c.minWidth=a.width+'px';if(i===true){c.minHeight=a.height+'px';}}Object.assign(d.style,c);const j=mY(u,v);let b=hG(a,j,g,h);if(k===void 0||f===void 0){eF(b,a,j,g,h);}else{const{top:d,left:e}=b;eF(b,a,j,g,h);let c=false;if(b.top!==d){c=true;const b=2*f[1];a.center=a.top-=b;a.bottom-=b+2;}if(b.left!==e){c=true;const b=2*f[0];a.middle=a.left-=b;a.right-=b+2;}if(c===true){b=hG(a,j,g,h);eF(b,a,j,g,h);}}c={top:b.top+'px',left:b.left+'px'};if(b.maxHeight!==void 0){c.maxHeight=b.maxHeight+'px';if(a.height>b.maxHeight){c.minHeight=c.maxHeight;}}if(b.maxWidth!==void 0){c.maxWidth=b.maxWidth+'px';if(a.width>b.maxWidth){c.minWidth=c.maxWidth;}}Object.assign(d.style,c);if(d.scrollTop!==s){d.scrollTop=s;}if(d.scrollLeft!==r){d.scrollLeft=r;}}function eF(a,b,i,c,f){const g=i.bottom,h=i.right,j=c9(),d=window.innerHeight-j,e=document.body.clientWidth;if(a.top<0||a.top+g>d){if(f.vertical==='center'){a.top=b[c.vertical]>d/2?Math.max(0,d-g):0;a.maxHeight=Math.min(g,d);}else if(b[c.vertical]>d/2){const e=Math.min(d,c.vertical==='center'?b.center:(c.vertical===f.vertical?b.bottom:b.top));a.maxHeight=Math.min(g,e);a.top=Math.max(0,e-g);}else{a.top=Math.max(0,c.vertical==='center'?b.center:(c.vertical===f.vertical?b.top:b.bottom));a.maxHeight=Math.min(g,d-a.top);}}if(a.left<0||a.left+h>e){a.maxWidth=Math.min(h,e);if(f.horizontal==='middle'){a.left=b[c.horizontal]>e/2?Math.max(0,e-h):0;}else if(b[c.horizontal]>e/2){const d=Math.min(e,c.horizontal==='middle'?b.middle:(c.horizontal===f.horizontal?b.right:b.left));a.maxWidth=Math.min(h,d);a.left=Math.max(0,d-a.maxWidth);}else{a.left=Math.max(0,c.horizontal==='middle'?b.middle:(c.horizontal===f.horizontal?b.left:b.right));a.maxWidth=Math.min(h,e-a.left);}}}const hI=n({name:'QMenu',inheritAttrs:false,props:{...mB,...hl,...ac,...ht,persistent:Boolean,autoClose:Boolean,separateClosePopup:Boolean,noEscDismiss:Boolean,noRouteDismiss:Boolean,noRefocus:Boolean,noFocus:Boolean,fit:Boolean,cover:Boolean,square:Boolean,anchor:{type:String,validator:hE},self:{type:String,validator:hE},offset:{type:Array,validator:mV},scrollTarget:eB,touchPosition:Boolean,maxHeight:{type:String,default:null},maxWidth:{type:String,default:null}},emits:[...hm,'click','escapeKey'],setup(d,{slots:J,emit:n,attrs:p}){let h=null,o,k,q;const w=i();const{proxy:s}=w;const{$q:e}=s;const g=c(null);const j=c(false);const K=a(()=>d.persistent!==true&&d.noRouteDismiss!==true);const L=ad(d,e);const{registerTick:M,removeTick:N}=ck();const{registerTimeout:y}=cl();const{transitionProps:O,transitionStyle:P}=hu(d);const{localScrollTarget:z,changeScrollEvent:Q,unconfigureScrollTarget:R}=mD(d,H);const{anchorEl:l,canShow:S}=mC({showing:j});const{hide:A}=hn({showing:j,canShow:S,handleShow:X,handleHide:Y,hideOnRouteChange:K,processOnMount:true});const{showPortal:C,hidePortal:D,renderPortal:T}=hs(w,g,$,'menu');const t={anchorEl:l,innerRef:g,onClickOutside(a){if(d.persistent!==true&&j.value===true){A(a);if(a.type==='touchstart'||a.target.classList.contains('q-dialog__backdrop')){B(a);}return true;}}};const E=a(()=>hF(d.anchor||(d.cover===true?'center middle':'bottom start'),e.lang.rtl));const U=a(()=>(d.cover===true?E.value:hF(d.self||'top start',e.lang.rtl)));const V=a(()=>(d.square===true?' q-menu--square':'')+(L.value===true?' q-menu--dark q-dark':''));const W=a(()=>(d.autoClose===true?{onClick:Z}:{}));const F=a(()=>j.value===true&&d.persistent!==true);f(F,a=>{if(a===true){hy(v);mU(t);}else{da(v);hB(t);}});function u(){c6(()=>{let a=g.value;if(a&&(a.contains(document.activeElement)!==true)){a=a.querySelector('[autofocus][tabindex], [data-autofocus][tabindex]')||a.querySelector('[autofocus] [tabindex], [data-autofocus] [tabindex]')||a.querySelector('[autofocus], [data-autofocus]')||a;a.focus({preventScroll:true});}});}function X(a){h=d.noRefocus===false?document.activeElement:null;hA(I);C();H();o=void 0;if(a!==void 0&&(d.touchPosition||d.contextMenu)){const b=c2(a);if(b.left!==void 0){const{top:a,left:c}=l.value.getBoundingClientRect();o={left:b.left-c,top:b.top-a};}}if(k===void 0){k=f(()=>e.screen.width+'|'+e.screen.height+'|'+d.self+'|'+d.anchor+'|'+e.lang.rtl,m);}if(d.noFocus!==true){document.activeElement.blur();}M(()=>{m();d.noFocus!==true&&u();});y(()=>{if(e.platform.is.ios===true){q=d.autoClose;g.value.click();}m();C(true);n('show',a);},d.transitionDuration);}function Y(a){N();D();G(true);if(h!==null&&(a===void 0||a.qClickOutside!==true)){((a?.type.indexOf('key')===0?h.closest('[tabindex]:not([tabindex^="-"])'):void 0)||h).focus();h=null;}y(()=>{D(true);n('hide',a);},d.transitionDuration);}function G(a){o=void 0;if(k!==void 0){k();k=void 0;}if(a===true||j.value===true){eD(I);R();hB(t);da(v);}if(a!==true){h=null;}}function H(){if(l.value!==null||d.scrollTarget!==void 0){z.value=eC(l.value,d.scrollTarget);Q(z.value,m);}}function Z(a){if(q!==true){hr(s,a);n('click',a);}else{q=false;}}function I(a){if(F.value===true&&d.noFocus!==true&&g2(g.value,a.target)!==true){u();}}function v(a){if(d.noEscDismiss!==true){n('escapeKey');A(a);}}function m(){hH({targetEl:g.value,offset:d.offset,anchorEl:l.value,anchorOrigin:E.value,selfOrigin:U.value,absoluteOffset:o,fit:d.fit,cover:d.cover,maxHeight:d.maxHeight,maxWidth:d.maxWidth});}function $(){return b(bD,O.value,()=>(j.value===true?b('div',{role:'menu',...p,ref:g,tabindex:-1,class:['q-menu q-position-engine scroll'+V.value,p.class],style:[p.style,P.value],...W.value},r(J.default)):null));}x(G);Object.assign(s,{focus:u,updatePosition:m});return T;}});const mZ=n({name:'QToolbar',props:{inset:Boolean},setup(c,{slots:d}){const e=a(()=>'q-toolbar row no-wrap items-center'+(c.inset===true?' q-toolbar--inset':''));return()=>b('div',{class:e.value,role:'toolbar'},r(d.default));}});const m$='_q_';const dd='_q_l_';const hJ='_q_pc_';const m_='_q_fo_';const hK='_q_tabs_';function Z(){}let eG,de=0;const N=new Array(256);for(let a=0;a<256;a++){N[a]=(a+0x100).toString(16).substring(1);}const m0=(()=>{const a=typeof crypto!=='undefined'?crypto:(typeof window!=='undefined'?window.crypto||window.msCrypto:void 0);if(a!==void 0){if(a.randomBytes!==void 0){return a.randomBytes;}if(a.getRandomValues!==void 0){return c=>{const b=new Uint8Array(c);a.getRandomValues(b);return b;};}}return b=>{const a=[];for(let c=b;c>0;c--){a.push(Math.floor(Math.random()*256));}return a;};})();const hL=4096;function df(){if(eG===void 0||(de+16>hL)){de=0;eG=m0(hL);}const a=Array.prototype.slice.call(eG,de,(de+=16));a[6]=(a[6]&0x0f)|0x40;a[8]=(a[8]&0x3f)|0x80;return N[a[0]]+N[a[1]]+N[a[2]]+N[a[3]]+'-'+N[a[4]]+N[a[5]]+'-'+N[a[6]]+N[a[7]]+'-'+N[a[8]]+N[a[9]]+'-'+N[a[10]]+N[a[11]]+N[a[12]]+N[a[13]]+N[a[14]]+N[a[15]];}function aN(a,b){if(a===b){return true;}if(a!==null&&b!==null&&typeof a==='object'&&typeof b==='object'){if(a.constructor!==b.constructor){return false;}let d,c;if(a.constructor===Array){d=a.length;if(d!==b.length){return false;}for(c=d;c--!==0;){if(aN(a[c],b[c])!==true){return false;}}return true;}if(a.constructor===Map){if(a.size!==b.size){return false;}let d=a.entries();c=d.next();while(c.done!==true){if(b.has(c.value[0])!==true){return false;}c=d.next();}d=a.entries();c=d.next();while(c.done!==true){if(aN(c.value[1],b.get(c.value[0]))!==true){return false;}c=d.next();}return true;}if(a.constructor===Set){if(a.size!==b.size){return false;}const d=a.entries();c=d.next();while(c.done!==true){if(b.has(c.value[0])!==true){return false;}c=d.next();}return true;}if(a.buffer!=null&&a.buffer.constructor===ArrayBuffer){d=a.length;if(d!==b.length){return false;}for(c=d;c--!==0;){if(a[c]!==b[c]){return false;}}return true;}if(a.constructor===RegExp){return a.source===b.source&&a.flags===b.flags;}if(a.valueOf!==Object.prototype.valueOf){return a.valueOf()===b.valueOf();}if(a.toString!==Object.prototype.toString){return a.toString()===b.toString();}const e=Object.keys(a).filter(b=>a[b]!==void 0);d=e.length;if(d!==Object.keys(b).filter(a=>b[a]!==void 0).length){return false;}for(c=d;c--!==0;){const d=e[c];if(aN(a[d],b[d])!==true){return false;}}return true;}return a!==a&&b!==b;}function eH(a){return a!==null&&typeof a==='object'&&Array.isArray(a)!==true;}function hM(a){return Object.prototype.toString.call(a)==='[object Date]';}function eI(a){return typeof a==='number'&&isFinite(a);}let m1=0;const m2=['click','keydown'];const m3={icon:String,label:[Number,String],alert:[Boolean,String],alertIcon:String,name:{type:[Number,String],default:()=>`t_${m1++}`},noCaps:Boolean,tabindex:[String,Number],disable:Boolean,contentClass:String,ripple:{type:[Boolean,Object],default:true}};function m4(d,o,g,f){const e=af(hK,Z);if(e===Z){console.error('QTab/QRouteTab component needs to be child of QTabs');return Z;}const{proxy:p}=i();const j=c(null);const k=c(null);const l=c(null);const q=a(()=>(d.disable===true||d.ripple===false?false:Object.assign({keyCodes:[13,32],early:true},d.ripple===true?{}:d.ripple)));const h=a(()=>e.currentModel.value===d.name);const r=a(()=>'q-tab relative-position self-stretch flex flex-center text-center'+(h.value===true?(' q-tab--active'+(e.tabProps.value.activeClass?' '+e.tabProps.value.activeClass:'')+(e.tabProps.value.activeColor?` text-${e.tabProps.value.activeColor}`:'')+(e.tabProps.value.activeBgColor?` bg-${e.tabProps.value.activeBgColor}`:'')):' q-tab--inactive')+(d.icon&&d.label&&e.tabProps.value.inlineLabel===false?' q-tab--full':'')+(d.noCaps===true||e.tabProps.value.noCaps===true?' q-tab--no-caps':'')+(d.disable===true?' disabled':' q-focusable q-hoverable cursor-pointer')+(f!==void 0?f.linkClass.value:''));const s=a(()=>'q-tab__content self-stretch flex-center relative-position q-anchor--skip non-selectable '+(e.tabProps.value.inlineLabel===true?'row no-wrap q-tab__content--inline':'column')+(d.contentClass!==void 0?` ${d.contentClass}`:''));const t=a(()=>((d.disable===true||e.hasFocus.value===true||(h.value===false&&e.hasActiveTab.value===true))?-1:d.tabindex||0));function m(a,b){if(b!==true&&a?.qAvoidFocus!==true){j.value?.focus();}if(d.disable===true){if(f?.hasRouterLink.value===true){B(a);}return;}if(f===void 0){e.updateModel({name:d.name});g('click',a);return;}if(f.hasRouterLink.value===true){const b=(b={})=>{let c;const g=b.to===void 0||aN(b.to,d.to)===true?(e.avoidRouteWatcher=df()):null;return f.navigateToRouterLink(a,{...b,returnRouterError:true}).catch(a=>{c=a;}).then(a=>{if(g===e.avoidRouteWatcher){e.avoidRouteWatcher=false;if(c===void 0&&(a===void 0||(a.message?.startsWith('Avoided redundant navigation')===true))){e.updateModel({name:d.name});}}if(b.returnRouterError===true){return c!==void 0?Promise.reject(c):a;}});};g('click',a,b);a.defaultPrevented!==true&&b();return;}g('click',a);}function u(a){if(aM(a,[13,32])){m(a,true);}else if(c3(a)!==true&&a.keyCode>=35&&a.keyCode<=40&&a.altKey!==true&&a.metaKey!==true){e.onKbdNavigate(a.keyCode,p.$el)===true&&B(a);}g('keydown',a);}function v(){const c=e.tabProps.value.narrowIndicator,a=[],f=b('div',{ref:l,class:['q-tab__indicator',e.tabProps.value.indicatorClass]});d.icon!==void 0&&a.push(b(S,{class:'q-tab__icon',name:d.icon}));d.label!==void 0&&a.push(b('div',{class:'q-tab__label'},d.label));d.alert!==false&&a.push(d.alertIcon!==void 0?b(S,{class:'q-tab__alert-icon',color:d.alert!==true?d.alert:void 0,name:d.alertIcon}):b('div',{class:'q-tab__alert'+(d.alert!==true?` text-${d.alert}`:'')}));c===true&&a.push(f);const g=[b('div',{class:'q-focus-helper',tabindex:-1,ref:j}),b('div',{class:s.value},ap(o.default,a))];c===false&&g.push(f);return g;}const n={name:a(()=>d.name),rootRef:k,tabIndicatorRef:l,routeData:f};x(()=>{e.unregisterTab(n);});L(()=>{e.registerTab(n);});function w(a,c){const e={ref:k,class:r.value,tabindex:t.value,role:'tab','aria-selected':h.value===true?'true':'false','aria-disabled':d.disable===true?'true':void 0,onClick:m,onKeydown:u,...c};return bd(b(a,e,v()),[[ew,q.value]]);}return{renderTab:w,$tabs:e};}const hN=n({name:'QTab',props:m3,emits:m2,setup(a,{slots:b,emit:c}){const{renderTab:d}=m4(a,b,c);return()=>d('div');}});function m5(){const a=c(!aB.value);if(a.value===false){L(()=>{a.value=true;});}return{isHydrated:a};}const hO=typeof ResizeObserver!=='undefined';const hP=hO===true?{}:{style:'display:block;position:absolute;top:0;left:0;right:0;bottom:0;height:100%;width:100%;overflow:hidden;pointer-events:none;z-index:-1;',url:'about:blank'};const dg=n({name:'QResizeObserver',props:{debounce:{type:[String,Number],default:100}},emits:['resize'],setup(g,{emit:j}){let c=null,a,d={width:-1,height:-1};function e(a){if(a===true||g.debounce===0||g.debounce==='0'){f();}else if(c===null){c=setTimeout(f,g.debounce);}}function f(){if(c!==null){clearTimeout(c);c=null;}if(a){const{offsetWidth:b,offsetHeight:c}=a;if(b!==d.width||c!==d.height){d={width:b,height:c};j('resize',d);}}}const{proxy:h}=i();h.trigger=e;if(hO===true){let b;const d=c=>{a=h.$el.parentNode;if(a){b=new ResizeObserver(e);b.observe(a);f();}else if(c!==true){w(()=>{d(true);});}};L(()=>{d();});x(()=>{c!==null&&clearTimeout(c);if(b!==void 0){if(b.disconnect!==void 0){b.disconnect();}else if(a){b.unobserve(a);}}});return aL;}else{const{isHydrated:j}=m5();let d;function g(){if(c!==null){clearTimeout(c);c=null;}if(d!==void 0){if(d.removeEventListener!==void 0){d.removeEventListener('resize',e,E.passive);}d=void 0;}}function i(){g();if(a?.contentDocument){d=a.contentDocument.defaultView;d.addEventListener('resize',e,E.passive);f();}}L(()=>{w(()=>{a=h.$el;a&&i();});});x(g);return()=>{if(j.value===true){return b('object',{class:'q--avoid-card-border',style:hP.style,tabindex:-1,type:'text/html',data:hP.url,'aria-hidden':'true',onLoad:i});}};}}});let cm=false;{const a=document.createElement('div');a.setAttribute('dir','rtl');Object.assign(a.style,{width:'1px',height:'1px',overflow:'auto'});const b=document.createElement('div');Object.assign(b.style,{width:'1000px',height:'1px'});document.body.appendChild(a);a.appendChild(b);a.scrollLeft=-1000;cm=a.scrollLeft>=0;a.remove();}function m6(a,c,d){const b=d===true?['left','right']:['top','bottom'];return`absolute-${c===true?b[0]:b[1]}${a?` text-${a}`:''}`;}const m7=['left','center','right','justify'];const m8=n({name:'QTabs',props:{modelValue:[Number,String],align:{type:String,default:'center',validator:a=>m7.includes(a)},breakpoint:{type:[String,Number],default:600},vertical:Boolean,shrink:Boolean,stretch:Boolean,activeClass:String,activeColor:String,activeBgColor:String,indicatorColor:String,leftIcon:String,rightIcon:String,outsideArrows:Boolean,mobileArrows:Boolean,switchIndicator:Boolean,narrowIndicator:Boolean,inlineLabel:Boolean,noCaps:Boolean,dense:Boolean,contentClass:String,'onUpdate:modelValue':[Function,Array]},setup(d,{slots:R,emit:T}){const{proxy:t}=i();const{$q:A}=t;const{registerTick:U}=ck();const{registerTick:V}=ck();const{registerTick:W}=ck();const{registerTimeout:X,removeTimeout:Y}=cl();const{registerTimeout:G,removeTimeout:Z}=cl();const m=c(null);const e=c(null);const j=c(d.modelValue);const n=c(false);const B=c(true);const C=c(false);const H=c(false);const g=[];const D=c(0);const u=c(false);let k=null,o=null,l;const $=a(()=>({activeClass:d.activeClass,activeColor:d.activeColor,activeBgColor:d.activeBgColor,indicatorClass:m6(d.indicatorColor,d.switchIndicator,d.vertical),narrowIndicator:d.narrowIndicator,inlineLabel:d.inlineLabel,noCaps:d.noCaps}));const _=a(()=>{const a=D.value;const b=j.value;for(let c=0;c<a;c++){if(g[c].name.value===b){return true;}}return false;});const aa=a(()=>{const a=n.value===true?'left':(H.value===true?'justify':d.align);return`q-tabs__content--align-${a}`;});const ab=a(()=>'q-tabs row no-wrap items-center'+` q-tabs--${n.value===true?'':'not-'}scrollable`+` q-tabs--${d.vertical===true?'vertical':'horizontal'}`+` q-tabs__arrows--${d.outsideArrows===true?'outside':'inside'}`+` q-tabs--mobile-with${d.mobileArrows===true?'':'out'}-arrows`+(d.dense===true?' q-tabs--dense':'')+(d.shrink===true?' col-shrink':'')+(d.stretch===true?' self-stretch':''));const ac=a(()=>'q-tabs__content scroll--mobile row no-wrap items-center self-stretch hide-scrollbar relative-position '+aa.value+(d.contentClass!==void 0?` ${d.contentClass}`:''));const v=a(()=>(d.vertical===true?{container:'height',content:'offsetHeight',scroll:'scrollHeight'}:{container:'width',content:'offsetWidth',scroll:'scrollWidth'}));const w=a(()=>d.vertical!==true&&A.lang.rtl===true);const E=a(()=>cm===false&&w.value===true);f(w,q);f(()=>d.modelValue,a=>{F({name:a,setCurrent:true,skipEmit:true});});f(()=>d.outsideArrows,y);function F({name:a,setCurrent:b,skipEmit:c}){if(j.value===a)return;if(c!==true&&d['onUpdate:modelValue']!==void 0){T('update:modelValue',a);}if(b===true||d['onUpdate:modelValue']===void 0){ad(j.value,a);j.value=a;}}function y(){U(()=>{m.value&&I({width:m.value.offsetWidth,height:m.value.offsetHeight});});}function I(c){if(v.value===void 0||e.value===null)return;const a=c[v.value.container],f=Math.min(e.value[v.value.scroll],Array.prototype.reduce.call(e.value.children,(a,b)=>a+(b[v.value.content]||0),0)),b=a>0&&f>a;n.value=b;b===true&&V(q);H.value=a<parseInt(d.breakpoint,10);}function ad(a,b){const e=a!==void 0&&a!==null&&a!==''?g.find(b=>b.name.value===a):null,c=b!==void 0&&b!==null&&b!==''?g.find(a=>a.name.value===b):null;if(z===true){z=false;}else if(e&&c){const g=e.tabIndicatorRef.value,a=c.tabIndicatorRef.value;if(k!==null){clearTimeout(k);k=null;}g.style.transition='none';g.style.transform='none';a.style.transition='none';a.style.transform='none';const f=g.getBoundingClientRect(),b=a.getBoundingClientRect();a.style.transform=d.vertical===true?`translate3d(0,${f.top-b.top}px,0) scale3d(1,${b.height?f.height/b.height:1},1)`:`translate3d(${f.left-b.left}px,0,0) scale3d(${b.width?f.width/b.width:1},1,1)`;W(()=>{k=setTimeout(()=>{k=null;a.style.transition='transform .25s cubic-bezier(.4, 0, .2, 1)';a.style.transform='none';},70);});}if(c&&n.value===true){p(c.rootRef.value);}}function p(c){const{left:f,width:g,top:h,height:i}=e.value.getBoundingClientRect(),b=c.getBoundingClientRect();let a=d.vertical===true?b.top-h:b.left-f;if(a<0){e.value[d.vertical===true?'scrollTop':'scrollLeft']+=Math.floor(a);q();return;}a+=d.vertical===true?b.height-i:b.width-g;if(a>0){e.value[d.vertical===true?'scrollTop':'scrollLeft']+=Math.ceil(a);q();}}function q(){const a=e.value;if(a===null)return;const c=a.getBoundingClientRect(),b=d.vertical===true?a.scrollTop:Math.abs(a.scrollLeft);if(w.value===true){B.value=Math.ceil(b+c.width)<a.scrollWidth-1;C.value=b>0;}else{B.value=b>0;C.value=d.vertical===true?Math.ceil(b+c.height)<a.scrollHeight:Math.ceil(b+c.width)<a.scrollWidth;}}function J(a){o!==null&&clearInterval(o);o=setInterval(()=>{if(ag(a)===true){h();}},5);}function K(){J(E.value===true?Number.MAX_SAFE_INTEGER:0);}function L(){J(E.value===true?0:Number.MAX_SAFE_INTEGER);}function h(){if(o!==null){clearInterval(o);o=null;}}function ae(b,f){const a=Array.prototype.filter.call(e.value.children,a=>a===f||(a.matches&&a.matches('.q-tab.q-focusable')===true));const c=a.length;if(c===0)return;if(b===36){p(a[0]);a[0].focus();return true;}if(b===35){p(a[c-1]);a[c-1].focus();return true;}const h=b===(d.vertical===true?38:37);const i=b===(d.vertical===true?40:39);const g=h===true?-1:(i===true?1:void 0);if(g!==void 0){const d=w.value===true?-1:1;const b=a.indexOf(f)+g*d;if(b>=0&&b<c){p(a[b]);a[b].focus({preventScroll:true});}return true;}}const af=a(()=>(E.value===true?{get:a=>Math.abs(a.scrollLeft),set:(a,b)=>{a.scrollLeft=-b;}}:(d.vertical===true?{get:a=>a.scrollTop,set:(a,b)=>{a.scrollTop=b;}}:{get:a=>a.scrollLeft,set:(a,b)=>{a.scrollLeft=b;}})));function ag(b){const f=e.value,{get:g,set:h}=af.value;let c=false,a=g(f);const d=b<a?-1:1;a+=d*5;if(a<0){c=true;a=0;}else if((d===-1&&a<=b)||(d===1&&a>=b)){c=true;a=b;}h(f,a);q();return c;}function M(a,b){for(const c in a){if(a[c]!==b[c]){return false;}}return true;}function ah(){let b=null,a={matchedLen:0,queryDiff:9999,hrefLen:0};const f=g.filter(a=>a.routeData?.hasRouterLink.value===true);const{hash:d,query:c}=t.$route;const e=Object.keys(c).length;for(const g of f){const k=g.routeData.exact.value===true;if(g.routeData[k===true?'linkIsExactActive':'linkIsActive'].value!==true){continue;}const{hash:h,query:i,matched:l,href:m}=g.routeData.resolvedLink.value;const j=Object.keys(i).length;if(k===true){if(h!==d){continue;}if(j!==e||M(c,i)===false){continue;}b=g.name.value;break;}if(h!==''&&h!==d){continue;}if(j!==0&&M(i,c)===false){continue;}const f={matchedLen:l.length,queryDiff:e-j,hrefLen:m.length-h.length};if(f.matchedLen>a.matchedLen){b=g.name.value;a=f;continue;}else if(f.matchedLen!==a.matchedLen){continue;}if(f.queryDiff<a.queryDiff){b=g.name.value;a=f;}else if(f.queryDiff!==a.queryDiff){continue;}if(f.hrefLen>a.hrefLen){b=g.name.value;a=f;}}if(b===null&&g.some(a=>a.routeData===void 0&&a.name.value===j.value)===true){z=false;return;}F({name:b,setCurrent:true});}function ai(a){Y();if(u.value!==true&&m.value!==null&&a.target&&typeof a.target.closest==='function'){const b=a.target.closest('.q-tab');if(b&&m.value.contains(b)===true){u.value=true;n.value===true&&p(b);}}}function aj(){X(()=>{u.value=false;},30);}function s(){if(O.avoidRouteWatcher===false){G(ah);}else{Z();}}function N(){if(l===void 0){const a=f(()=>t.$route.fullPath,s);l=()=>{a();l=void 0;};}}function ak(a){g.push(a);D.value++;y();if(a.routeData===void 0||t.$route===void 0){G(()=>{if(n.value===true){const a=j.value;const b=a!==void 0&&a!==null&&a!==''?g.find(b=>b.name.value===a):null;b&&p(b.rootRef.value);}});}else{N();if(a.routeData.hasRouterLink.value===true){s();}}}function al(a){g.splice(g.indexOf(a),1);D.value--;y();if(l!==void 0&&a.routeData!==void 0){if(g.every(a=>a.routeData===void 0)===true){l();}s();}}const O={currentModel:j,tabProps:$,hasFocus:u,hasActiveTab:_,registerTab:ak,unregisterTab:al,verifyRouteModel:s,updateModel:F,onKbdNavigate:ae,avoidRouteWatcher:false};cV(hK,O);function P(){k!==null&&clearTimeout(k);h();l?.();}let Q,z;x(P);bf(()=>{Q=l!==void 0;P();});b3(()=>{if(Q===true){N();z=true;s();}y();});return()=>{return b('div',{ref:m,class:ab.value,role:'tablist',onFocusin:ai,onFocusout:aj},[b(dg,{onResize:I}),b('div',{ref:e,class:ac.value,onScroll:q},r(R.default)),b(S,{class:'q-tabs__arrow q-tabs__arrow--left absolute q-tab__icon'+(B.value===true?'':' q-tabs__arrow--faded'),name:d.leftIcon||A.iconSet.tabs[d.vertical===true?'up':'left'],onMousedownPassive:K,onTouchstartPassive:K,onMouseupPassive:h,onMouseleavePassive:h,onTouchendPassive:h}),b(S,{class:'q-tabs__arrow q-tabs__arrow--right absolute q-tab__icon'+(C.value===true?'':' q-tabs__arrow--faded'),name:d.rightIcon||A.iconSet.tabs[d.vertical===true?'down':'right'],onMousedownPassive:L,onTouchstartPassive:L,onMouseupPassive:h,onMouseleavePassive:h,onTouchendPassive:h})]);};}});const m9=n({name:'QHeader',props:{modelValue:{type:Boolean,default:true},reveal:Boolean,revealOffset:{type:Number,default:250},bordered:Boolean,elevated:Boolean,heightHint:{type:[String,Number],default:50}},emits:['reveal','focusin'],setup(d,{slots:r,emit:n}){const{proxy:{$q:l}}=i();const e=af(dd,Z);if(e===Z){console.error('QHeader needs to be child of QLayout');return Z;}const k=c(parseInt(d.heightHint,10));const h=c(true);const m=a(()=>d.reveal===true||e.view.value.indexOf('H')!==-1||(l.platform.is.ios&&e.isContainer.value===true));const o=a(()=>{if(d.modelValue!==true){return 0;}if(m.value===true){return h.value===true?k.value:0;}const a=k.value-e.scroll.value.position;return a>0?a:0;});const p=a(()=>d.modelValue!==true||(m.value===true&&h.value!==true));const s=a(()=>d.modelValue===true&&p.value===true&&d.reveal===true);const t=a(()=>'q-header q-layout__section--marginal '+(m.value===true?'fixed':'absolute')+'-top'+(d.bordered===true?' q-header--bordered':'')+(p.value===true?' q-header--hidden':'')+(d.modelValue!==true?' q-layout--prevent-focus':''));const u=a(()=>{const b=e.rows.value.top,a={};if(b[0]==='l'&&e.left.space===true){a[l.lang.rtl===true?'right':'left']=`${e.left.size}px`;}if(b[2]==='r'&&e.right.space===true){a[l.lang.rtl===true?'left':'right']=`${e.right.size}px`;}return a;});function g(a,b){e.update('header',a,b);}function j(a,b){if(a.value!==b){a.value=b;}}function v({height:a}){j(k,a);g('size',a);}function w(a){if(s.value===true){j(h,true);}n('focusin',a);}f(()=>d.modelValue,a=>{g('space',a);j(h,true);e.animate();});f(o,a=>{g('offset',a);});f(()=>d.reveal,a=>{a===false&&j(h,d.modelValue);});f(h,a=>{e.animate();n('reveal',a);});f(e.scroll,a=>{d.reveal===true&&j(h,a.direction==='up'||a.position<=d.revealOffset||a.position-a.inflectionPoint<100);});const q={};e.instances.header=q;d.modelValue===true&&g('size',k.value);g('space',d.modelValue);g('offset',o.value);x(()=>{if(e.instances.header===q){e.instances.header=void 0;g('size',0);g('offset',0);g('space',false);}});return()=>{const a=er(r.default,[]);d.elevated===true&&a.push(b('div',{class:'q-layout__shadow absolute-full overflow-hidden no-pointer-events'}));a.push(b(dg,{debounce:0,onResize:v}));return b('header',{class:t.value,style:u.value,onFocusin:w},a);};}});const hQ=n({name:'QPage',props:{padding:Boolean,styleFn:Function},setup(d,{slots:f}){const{proxy:{$q:e}}=i();const c=af(dd,Z);if(c===Z){console.error('QPage needs to be a deep child of QLayout');return Z;}const g=af(hJ,Z);if(g===Z){console.error('QPage needs to be child of QPageContainer');return Z;}const h=a(()=>{const a=(c.header.space===true?c.header.size:0)+(c.footer.space===true?c.footer.size:0);if(typeof d.styleFn==='function'){const b=c.isContainer.value===true?c.containerHeight.value:e.screen.height;return d.styleFn(a,b);}return{minHeight:c.isContainer.value===true?(c.containerHeight.value-a)+'px':(e.screen.height===0?(a!==0?`calc(100vh - ${a}px)`:'100vh'):(e.screen.height-a)+'px')};});const j=a(()=>`q-page${d.padding===true?' q-layout-padding':''}`);return()=>b('main',{class:j.value,style:h.value},r(f.default));}});const eJ={left:true,right:true,up:true,down:true,horizontal:true,vertical:true};const na=Object.keys(eJ);eJ.all=true;function hR(b){const a={};for(const c of na){if(b[c]===true){a[c]=true;}}if(Object.keys(a).length===0){return eJ;}if(a.horizontal===true){a.left=a.right=true;}else if(a.left===true&&a.right===true){a.horizontal=true;}if(a.vertical===true){a.up=a.down=true;}else if(a.up===true&&a.down===true){a.vertical=true;}if(a.horizontal===true&&a.vertical===true){a.all=true;}return a;}const nb=['INPUT','TEXTAREA'];function hS(a,b){return b.event===void 0&&a.target!==void 0&&a.target.draggable!==true&&typeof b.handler==='function'&&nb.includes(a.target.nodeName.toUpperCase())===false&&(a.qClonedBy===void 0||a.qClonedBy.indexOf(b.uid)===-1);}function nc(a){const b=[0.06,6,50];if(typeof a==='string'&&a.length){a.split(':').forEach((c,d)=>{const a=parseFloat(c);a&&(b[d]=a);});}return b;}const nd=eq({name:'touch-swipe',beforeMount(c,{value:d,arg:e,modifiers:b}){if(b.mouse!==true&&y.has.touch!==true)return;const f=b.mouseCapture===true?'Capture':'';const a={handler:d,sensitivity:nc(e),direction:hR(b),noop:aL,mouseStart(b){if(hS(b,a)&&md(b)){bm(a,'temp',[[document,'mousemove','move',`notPassive${f}`],[document,'mouseup','end','notPassiveCapture']]);a.start(b,true);}},touchStart(b){if(hS(b,a)){const c=b.target;bm(a,'temp',[[c,'touchmove','move','notPassiveCapture'],[c,'touchcancel','end','notPassiveCapture'],[c,'touchend','end','notPassiveCapture']]);a.start(b);}},start(d,e){y.is.firefox===true&&ev(c,true);const b=c2(d);a.event={x:b.left,y:b.top,time:Date.now(),mouse:e===true,dir:false};},move(d){if(a.event===void 0)return;if(a.event.dir!==false){B(d);return;}const e=Date.now()-a.event.time;if(e===0)return;const j=c2(d),f=j.left-a.event.x,b=Math.abs(f),g=j.top-a.event.y,c=Math.abs(g);if(a.event.mouse!==true){if(b<a.sensitivity[1]&&c<a.sensitivity[1]){a.end(d);return;}}else if(window.getSelection().toString()!==''){a.end(d);return;}else if(b<a.sensitivity[2]&&c<a.sensitivity[2]){return;}const h=b/e,i=c/e;if(a.direction.vertical===true&&b<c&&b<100&&i>a.sensitivity[0]){a.event.dir=g<0?'up':'down';}if(a.direction.horizontal===true&&b>c&&c<100&&h>a.sensitivity[0]){a.event.dir=f<0?'left':'right';}if(a.direction.up===true&&b<c&&g<0&&b<100&&i>a.sensitivity[0]){a.event.dir='up';}if(a.direction.down===true&&b<c&&g>0&&b<100&&i>a.sensitivity[0]){a.event.dir='down';}if(a.direction.left===true&&b>c&&f<0&&c<100&&h>a.sensitivity[0]){a.event.dir='left';}if(a.direction.right===true&&b>c&&f>0&&c<100&&h>a.sensitivity[0]){a.event.dir='right';}if(a.event.dir!==false){B(d);if(a.event.mouse===true){document.body.classList.add('no-pointer-events--children');document.body.classList.add('non-selectable');hk();a.styleCleanup=c=>{a.styleCleanup=void 0;document.body.classList.remove('non-selectable');const b=()=>{document.body.classList.remove('no-pointer-events--children');};if(c===true){setTimeout(b,50);}else{b();}};}a.handler({evt:d,touch:a.event.mouse!==true,mouse:a.event.mouse,direction:a.event.dir,duration:e,distance:{x:b,y:c}});}else{a.end(d);}},end(b){if(a.event===void 0)return;ci(a,'temp');y.is.firefox===true&&ev(c,false);a.styleCleanup?.(true);if((b!==void 0)&&(a.event.dir!==false))B(b);a.event=void 0;}};c.__qtouchswipe=a;if(b.mouse===true){const d=b.mouseCapture===true||b.mousecapture===true?'Capture':'';bm(a,'main',[[c,'mousedown','mouseStart',`passive${d}`]]);}y.has.touch===true&&bm(a,'main',[[c,'touchstart','touchStart',`passive${b.capture===true?'Capture':''}`],[c,'touchmove','noop','notPassiveCapture']]);},updated(c,a){const b=c.__qtouchswipe;if(b!==void 0){if(a.oldValue!==a.value){typeof a.value!=='function'&&b.end();b.handler=a.value;}b.direction=hR(a.modifiers);}},beforeUnmount(b){const a=b.__qtouchswipe;if(a!==void 0){ci(a,'main');ci(a,'temp');y.is.firefox===true&&ev(b,false);a.styleCleanup?.();delete b.__qtouchswipe;}}});function ne(){let a=Object.create(null);return{getCache:(b,c)=>(a[b]===void 0?(a[b]=(typeof c==='function'?c():c)):a[b]),setCache(b,c){a[b]=c;},hasCache(b){return Object.hasOwnProperty.call(a,b);},clearCache(b){if(b!==void 0){delete a[b];}else{a=Object.create(null);}}};}const nf={name:{required:true},disable:Boolean};const hT={setup(c,{slots:a}){return()=>b('div',{class:'q-panel scroll',role:'tabpanel'},r(a.default));}};const ng={modelValue:{required:true},animated:Boolean,infinite:Boolean,swipeable:Boolean,vertical:Boolean,transitionPrev:String,transitionNext:String,transitionDuration:{type:[String,Number],default:300},keepAlive:Boolean,keepAliveInclude:[String,Array,RegExp],keepAliveExclude:[String,Array,RegExp],keepAliveMax:Number};const nh=['update:modelValue','beforeTransition','transition'];function ni(){const{props:d,emit:j,proxy:p}=i();const{getCache:A}=ne();const{registerTimeout:B}=cl();let e,m;const n=c(null);const g={value:null};function C(a){const b=d.vertical===true?'up':'left';h((p.$q.lang.rtl===true?-1:1)*(a.direction===b?1:-1));}const D=a(()=>{return[[nd,C,void 0,{horizontal:d.vertical!==true,vertical:d.vertical,mouse:true}]];});const E=a(()=>d.transitionPrev||`slide-${d.vertical===true?'down':'right'}`);const F=a(()=>d.transitionNext||`slide-${d.vertical===true?'up':'left'}`);const q=a(()=>`--q-transition-duration: ${d.transitionDuration}ms`);const k=a(()=>(typeof d.modelValue==='string'||typeof d.modelValue==='number'?d.modelValue:String(d.modelValue)));const s=a(()=>({include:d.keepAliveInclude,exclude:d.keepAliveExclude,max:d.keepAliveMax}));const t=a(()=>d.keepAliveInclude!==void 0||d.keepAliveExclude!==void 0);f(()=>d.modelValue,(a,c)=>{const b=l(a)===true?o(a):-1;if(m!==true){x(b===-1?0:(b<o(c)?-1:1));}if(g.value!==b){g.value=b;j('beforeTransition',a,c);B(()=>{j('transition',a,c);},d.transitionDuration);}});function u(){h(1);}function v(){h(-1);}function w(a){j('update:modelValue',a);}function l(a){return a!==void 0&&a!==null&&a!=='';}function o(a){return e.findIndex(b=>{return b.props.name===a&&b.props.disable!==''&&b.props.disable!==true;});}function G(){return e.filter(a=>{return a.props.disable!==''&&a.props.disable!==true;});}function x(a){const b=a!==0&&d.animated===true&&g.value!==-1?'q-transition--'+(a===-1?E.value:F.value):null;if(n.value!==b){n.value=b;}}function h(a,c=g.value){let b=c+a;while(b!==-1&&b<e.length){const c=e[b];if(c!==void 0&&c.props.disable!==''&&c.props.disable!==true){x(a);m=true;j('update:modelValue',c.props.name);setTimeout(()=>{m=false;});return;}b+=a;}if(d.infinite===true&&e.length!==0&&c!==-1&&c!==e.length){h(a,a===-1?e.length:-1);}}function y(){const a=o(d.modelValue);if(g.value!==a){g.value=a;}return true;}function z(){const a=l(d.modelValue)===true&&y()&&e[g.value];return d.keepAlive===true?[b(kH,s.value,[b(t.value===true?A(k.value,()=>({...hT,name:k.value})):hT,{key:k.value,style:q.value},()=>a)])]:[b('div',{class:'q-panel scroll',style:q.value,key:k.value,role:'tabpanel'},[a])];}function H(){if(e.length===0)return;return d.animated===true?[b(bD,{name:n.value},z)]:z();}function I(a){e=mi(r(a.default,[])).filter(a=>a.props!==null&&a.props.slot===void 0&&l(a.props.name)===true);return e.length;}function J(){return e;}Object.assign(p,{next:u,previous:v,goTo:w});return{panelIndex:g,panelDirectives:D,updatePanelsList:I,updatePanelIndex:y,getPanelContent:H,getEnabledPanels:G,getPanels:J,isValidPanelName:l,keepAliveProps:s,needsUniqueKeepAliveWrapper:t,goToPanelByOffset:h,goToPanel:w,nextPanel:u,previousPanel:v};}const hU=n({name:'QTabPanel',props:nf,setup(c,{slots:a}){return()=>b('div',{class:'q-tab-panel',role:'tabpanel'},r(a.default));}});const a0=n({name:'QCardSection',props:{tag:{type:String,default:'div'},horizontal:Boolean},setup(c,{slots:d}){const e=a(()=>'q-card__section'+` q-card__section--${c.horizontal===true?'horiz row no-wrap':'vert'}`);return()=>b(c.tag,{class:e.value},r(d.default));}});const nj=n({name:'QBanner',props:{...ac,inlineActions:Boolean,dense:Boolean,rounded:Boolean},setup(c,{slots:d}){const{proxy:{$q:e}}=i();const f=ad(c,e);const g=a(()=>'q-banner row items-center'+(c.dense===true?' q-banner--dense':'')+(f.value===true?' q-banner--dark q-dark':'')+(c.rounded===true?' rounded-borders':''));const h=a(()=>'q-banner__actions row items-center justify-end'+` col-${c.inlineActions===true?'auto':'all'}`);return()=>{const e=[b('div',{class:'q-banner__avatar col-auto row items-center self-start'},r(d.avatar)),b('div',{class:'q-banner__content col text-body2'},r(d.default))];const a=r(d.action);a!==void 0&&e.push(b('div',{class:h.value},a));return b('div',{class:g.value+(c.inlineActions===false&&a!==void 0?' q-banner--top-padding':''),role:'alert'},e);};}});const dh=n({name:'QCard',props:{...ac,tag:{type:String,default:'div'},square:Boolean,flat:Boolean,bordered:Boolean},setup(c,{slots:d}){const{proxy:{$q:e}}=i();const f=ad(c,e);const g=a(()=>'q-card'+(f.value===true?' q-card--dark q-dark':'')+(c.bordered===true?' q-card--bordered':'')+(c.square===true?' q-card--square no-border-radius':'')+(c.flat===true?' q-card--flat no-shadow':''));return()=>b(c.tag,{class:g.value},r(d.default));}});const nk=n({name:'QTabPanels',props:{...ng,...ac},emits:nh,setup(b,{slots:c}){const d=i();const e=ad(b,d.proxy.$q);const{updatePanelsList:f,getPanelContent:g,panelDirectives:h}=ni();const j=a(()=>'q-tab-panels q-panel-parent'+(e.value===true?' q-tab-panels--dark q-dark':''));return()=>{f(c);return gX('div',{class:j.value},g(),'pan',b.swipeable,()=>h.value);};}});const nl=n({name:'QPageContainer',setup(g,{slots:e}){const{proxy:{$q:d}}=i();const c=af(dd,Z);if(c===Z){console.error('QPageContainer needs to be child of QLayout');return Z;}cV(hJ,true);const f=a(()=>{const a={};if(c.header.space===true){a.paddingTop=`${c.header.size}px`;}if(c.right.space===true){a[`padding${d.lang.rtl===true?'Left':'Right'}`]=`${c.right.size}px`;}if(c.footer.space===true){a.paddingBottom=`${c.footer.size}px`;}if(c.left.space===true){a[`padding${d.lang.rtl===true?'Right':'Left'}`]=`${c.left.size}px`;}return a;});return()=>b('div',{class:'q-page-container',style:f.value},r(e.default));}});function nm(a){return a===void 0||a===null?null:a;}function hV(a,b){return a===void 0||a===null?(b===true?`f_${df()}`:null):a;}function nn({getValue:b,required:d=true}={}){if(aB.value===true){const a=b!==void 0?c(nm(b())):c(null);if(d===true&&a.value===null){L(()=>{a.value=`f_${df()}`;});}if(b!==void 0){f(b,b=>{a.value=hV(b,d);});}return a;}return b!==void 0?a(()=>hV(b(),d)):c(`f_${df()}`);}const hW=/^on[A-Z]/;function no(){const{attrs:b,vnode:d}=i();const a={listeners:c({}),attributes:c({})};function e(){const c={};const e={};for(const a in b){if(a!=='class'&&a!=='style'&&hW.test(a)===false){c[a]=b[a];}}for(const a in d.props){if(hW.test(a)===true){e[a]=d.props[a];}}a.attributes.value=c;a.listeners.value=e;}d3(e);e();return a;}function np({validate:c,resetValidation:b,requiresQForm:d}){const a=af(m_,false);if(a!==false){const{props:e,proxy:d}=i();Object.assign(d,{validate:c,resetValidation:b});f(()=>e.disable,c=>{if(c===true){typeof b==='function'&&b();a.unbindComponent(d);}else{a.bindComponent(d);}});L(()=>{e.disable!==true&&a.bindComponent(d);});x(()=>{e.disable!==true&&a.unbindComponent(d);});}else if(d===true){console.error('Parent QForm not found on useFormChild()!');}}const hX=/^#[0-9a-fA-F]{3}([0-9a-fA-F]{3})?$/,hY=/^#[0-9a-fA-F]{4}([0-9a-fA-F]{4})?$/,hZ=/^#([0-9a-fA-F]{3}|[0-9a-fA-F]{4}|[0-9a-fA-F]{6}|[0-9a-fA-F]{8})$/,di=/^rgb\(((0|[1-9][\d]?|1[\d]{0,2}|2[\d]?|2[0-4][\d]|25[0-5]),){2}(0|[1-9][\d]?|1[\d]{0,2}|2[\d]?|2[0-4][\d]|25[0-5])\)$/,dj=/^rgba\(((0|[1-9][\d]?|1[\d]{0,2}|2[\d]?|2[0-4][\d]|25[0-5]),){2}(0|[1-9][\d]?|1[\d]{0,2}|2[\d]?|2[0-4][\d]|25[0-5]),(0|0\.[0-9]+[1-9]|0\.[1-9]+|1)\)$/;const eK={date:a=>/^-?[\d]+\/[0-1]\d\/[0-3]\d$/.test(a),time:a=>/^([0-1]?\d|2[0-3]):[0-5]\d$/.test(a),fulltime:a=>/^([0-1]?\d|2[0-3]):[0-5]\d:[0-5]\d$/.test(a),timeOrFulltime:a=>/^([0-1]?\d|2[0-3]):[0-5]\d(:[0-5]\d)?$/.test(a),email:a=>/^(([^<>()\[\]\\.,;:\s@"]+(\.[^<>()\[\]\\.,;:\s@"]+)*)|(".+"))@((\[[0-9]{1,3}\.[0-9]{1,3}\.[0-9]{1,3}\.[0-9]{1,3}])|(([a-zA-Z\-0-9]+\.)+[a-zA-Z]{2,}))$/.test(a),hexColor:a=>hX.test(a),hexaColor:a=>hY.test(a),hexOrHexaColor:a=>hZ.test(a),rgbColor:a=>di.test(a),rgbaColor:a=>dj.test(a),rgbOrRgbaColor:a=>di.test(a)||dj.test(a),hexOrRgbColor:a=>hX.test(a)||di.test(a),hexaOrRgbaColor:a=>hY.test(a)||dj.test(a),anyColor:a=>hZ.test(a)||di.test(a)||dj.test(a)};function eL(b,e=250,c){let a=null;function d(){const d=arguments;const f=()=>{a=null;if(c!==true){b.apply(this,d);}};if(a!==null){clearTimeout(a);}else if(c===true){b.apply(this,d);}a=setTimeout(f,e);}d.cancel=()=>{a!==null&&clearTimeout(a);};return d;}const nq=[true,false,'ondemand'];const nr={modelValue:{},error:{type:Boolean,default:null},errorMessage:String,noErrorIcon:Boolean,rules:Array,reactiveRules:Boolean,lazyRules:{type:[Boolean,String],default:false,validator:a=>nq.includes(a)}};function ns(t,g){const{props:b,proxy:q}=i();const l=c(false);const m=c(null);const d=c(false);np({validate:k,resetValidation:p});let h=0,e;const n=a(()=>b.rules!==void 0&&b.rules!==null&&b.rules.length!==0);const o=a(()=>(b.disable!==true&&n.value===true&&g.value===false));const r=a(()=>b.error===true||l.value===true);const u=a(()=>(typeof b.errorMessage==='string'&&b.errorMessage.length!==0?b.errorMessage:m.value));f(()=>b.modelValue,()=>{d.value=true;if(o.value===true&&b.lazyRules===false){j();}});function s(){if(b.lazyRules!=='ondemand'&&o.value===true&&d.value===true){j();}}f(()=>b.reactiveRules,a=>{if(a===true){if(e===void 0){e=f(()=>b.rules,s,{immediate:true,deep:true});}}else if(e!==void 0){e();e=void 0;}},{immediate:true});f(()=>b.lazyRules,s);f(t,a=>{if(a===true){d.value=true;}else if(o.value===true&&b.lazyRules!=='ondemand'){j();}});function p(){h++;g.value=false;d.value=false;l.value=false;m.value=null;j.cancel();}function k(f=b.modelValue){if(b.disable===true||n.value===false){return true;}const c=++h;const i=g.value!==true?()=>{d.value=true;}:()=>{};const a=(a,b)=>{a===true&&i();l.value=a;m.value=b||null;g.value=false;};const e=[];for(let c=0;c<b.rules.length;c++){const g=b.rules[c];let d;if(typeof g==='function'){d=g(f,eK);}else if(typeof g==='string'&&eK[g]!==void 0){d=eK[g](f);}if(d===false||typeof d==='string'){a(true,d);return false;}else if(d!==true&&d!==void 0){e.push(d);}}if(e.length===0){a(false);return true;}g.value=true;return Promise.all(e).then(b=>{if(b===void 0||Array.isArray(b)===false||b.length===0){c===h&&a(false);return true;}const d=b.find(a=>a===false||typeof a==='string');c===h&&a(d!==void 0,d);return d===void 0;},b=>{if(c===h){console.error(b);a(true);}return false;});}const j=eL(k,0);x(()=>{e?.();j.cancel();});Object.assign(q,{resetValidation:p,validate:k});ai(q,'hasError',()=>r.value);return{isDirtyModel:d,hasRules:n,hasError:r,errorMessage:u,validate:k,resetValidation:p};}function cn(a){return a!==void 0&&a!==null&&(''+a).length!==0;}const nt={...ac,...nr,label:String,stackLabel:Boolean,hint:String,hideHint:Boolean,prefix:String,suffix:String,labelColor:String,color:String,bgColor:String,filled:Boolean,outlined:Boolean,borderless:Boolean,standout:[Boolean,String],square:Boolean,loading:Boolean,labelSlot:Boolean,bottomSlots:Boolean,hideBottomSpace:Boolean,rounded:Boolean,dense:Boolean,itemAligned:Boolean,counter:Boolean,clearable:Boolean,clearIcon:String,disable:Boolean,readonly:Boolean,autofocus:Boolean,for:String};const dk={...nt,maxlength:[Number,String]};const eM=['update:modelValue','clear','focus','blur'];function eN({requiredForAttr:d=true,tagProp:e,changeEvent:f=false}={}){const{props:b,proxy:g}=i();const h=ad(b,g.$q);const j=nn({required:d,getValue:()=>b.for});return{requiredForAttr:d,changeEvent:f,tag:e===true?a(()=>b.tag):{value:'label'},isDark:h,editable:a(()=>b.disable!==true&&b.readonly!==true),innerLoading:c(false),focused:c(false),hasPopupOpen:false,splitAttrs:no(),targetUid:j,rootRef:c(null),targetRef:c(null),controlRef:c(null)};}function eO(c){const{props:d,emit:g,slots:e,attrs:o,proxy:j}=i();const{$q:k}=j;let f=null;if(c.hasValue===void 0){c.hasValue=a(()=>cn(d.modelValue));}if(c.emitValue===void 0){c.emitValue=a=>{g('update:modelValue',a);};}if(c.controlEvents===void 0){c.controlEvents={onFocusin:z,onFocusout:A};}Object.assign(c,{clearValue:n,onControlFocusin:z,onControlFocusout:A,focus:y});if(c.computedCounter===void 0){c.computedCounter=a(()=>{if(d.counter!==false){const b=typeof d.modelValue==='string'||typeof d.modelValue==='number'?(''+d.modelValue).length:(Array.isArray(d.modelValue)===true?d.modelValue.length:0);const a=d.maxlength!==void 0?d.maxlength:d.maxValues;return b+(a!==void 0?' / '+a:'');}});}const{isDirtyModel:p,hasRules:D,hasError:h,errorMessage:m,resetValidation:E}=ns(c.focused,c.innerLoading);const q=c.floatingLabel!==void 0?a(()=>d.stackLabel===true||c.focused.value===true||c.floatingLabel.value===true):a(()=>d.stackLabel===true||c.focused.value===true||c.hasValue.value===true);const s=a(()=>d.bottomSlots===true||d.hint!==void 0||D.value===true||d.counter===true||d.error!==null);const F=a(()=>{if(d.filled===true){return'filled';}if(d.outlined===true){return'outlined';}if(d.borderless===true){return'borderless';}if(d.standout){return'standout';}return'standard';});const G=a(()=>`q-field row no-wrap items-start q-field--${F.value}`+(c.fieldClass!==void 0?` ${c.fieldClass.value}`:'')+(d.rounded===true?' q-field--rounded':'')+(d.square===true?' q-field--square':'')+(q.value===true?' q-field--float':'')+(t.value===true?' q-field--labeled':'')+(d.dense===true?' q-field--dense':'')+(d.itemAligned===true?' q-field--item-aligned q-item-type':'')+(c.isDark.value===true?' q-field--dark':'')+(c.getControl===void 0?' q-field--auto-height':'')+(c.focused.value===true?' q-field--focused':'')+(h.value===true?' q-field--error':'')+(h.value===true||c.focused.value===true?' q-field--highlighted':'')+(d.hideBottomSpace!==true&&s.value===true?' q-field--with-bottom':'')+(d.disable===true?' q-field--disabled':(d.readonly===true?' q-field--readonly':'')));const H=a(()=>'q-field__control relative-position row no-wrap'+(d.bgColor!==void 0?` bg-${d.bgColor}`:'')+(h.value===true?' text-negative':(typeof d.standout==='string'&&d.standout.length!==0&&c.focused.value===true?` ${d.standout}`:(d.color!==void 0?` text-${d.color}`:''))));const t=a(()=>d.labelSlot===true||d.label!==void 0);const I=a(()=>'q-field__label no-pointer-events absolute ellipsis'+(d.labelColor!==void 0&&h.value!==true?` text-${d.labelColor}`:''));const J=a(()=>({id:c.targetUid.value,editable:c.editable.value,focused:c.focused.value,floatingLabel:q.value,modelValue:d.modelValue,emitValue:c.emitValue}));const u=a(()=>{const a={};if(c.targetUid.value){a.for=c.targetUid.value;}if(d.disable===true){a['aria-disabled']='true';}return a;});function v(){const b=document.activeElement;let a=c.targetRef?.value;if(a&&(b===null||b.id!==c.targetUid.value)){a.hasAttribute('tabindex')===true||(a=a.querySelector('[tabindex]'));if(a!==b){a?.focus({preventScroll:true});}}}function y(){c6(v);}function K(){mF(v);const a=document.activeElement;if(a!==null&&c.rootRef.value.contains(a)){a.blur();}}function z(a){if(f!==null){clearTimeout(f);f=null;}if(c.editable.value===true&&c.focused.value===false){c.focused.value=true;g('focus',a);}}function A(a,b){f!==null&&clearTimeout(f);f=setTimeout(()=>{f=null;if(document.hasFocus()===true&&(c.hasPopupOpen===true||c.controlRef===void 0||c.controlRef.value===null||c.controlRef.value.contains(document.activeElement)!==false))return;if(c.focused.value===true){c.focused.value=false;g('blur',a);}b?.();});}function n(a){B(a);if(k.platform.is.mobile!==true){const a=c.targetRef?.value||c.rootRef.value;a.focus();}else if(c.rootRef.value.contains(document.activeElement)===true){document.activeElement.blur();}if(d.type==='file'){c.inputRef.value.value=null;}g('update:modelValue',null);c.changeEvent===true&&g('change',null);g('clear',d.modelValue);w(()=>{const a=p.value;E();p.value=a;});}function M(a){[13,32].includes(a.keyCode)&&n(a);}function N(){const a=[];e.prepend!==void 0&&a.push(b('div',{class:'q-field__prepend q-field__marginal row no-wrap items-center',key:'prepend',onClick:ab},e.prepend()));a.push(b('div',{class:'q-field__control-container col relative-position row no-wrap q-anchor--skip'},O()));h.value===true&&d.noErrorIcon===false&&a.push(l('error',[b(S,{name:k.iconSet.field.error,color:'negative'})]));if(d.loading===true||c.innerLoading.value===true){a.push(l('inner-loading-append',e.loading!==void 0?e.loading():[b(g1,{color:d.color})]));}else if(d.clearable===true&&c.hasValue.value===true&&c.editable.value===true){a.push(l('inner-clearable-append',[b(S,{class:'q-field__focusable-action',name:d.clearIcon||k.iconSet.field.clear,tabindex:0,role:'button','aria-hidden':'false','aria-label':k.lang.label.clear,onKeyup:M,onClick:n})]));}e.append!==void 0&&a.push(b('div',{class:'q-field__append q-field__marginal row no-wrap items-center',key:'append',onClick:ab},e.append()));c.getInnerAppend!==void 0&&a.push(l('inner-append',c.getInnerAppend()));c.getControlChild!==void 0&&a.push(c.getControlChild());return a;}function O(){const a=[];d.prefix!==void 0&&d.prefix!==null&&a.push(b('div',{class:'q-field__prefix no-pointer-events row items-center'},d.prefix));if(c.getShadowControl!==void 0&&c.hasShadow.value===true){a.push(c.getShadowControl());}if(c.getControl!==void 0){a.push(c.getControl());}else if(e.rawControl!==void 0){a.push(e.rawControl());}else if(e.control!==void 0){a.push(b('div',{ref:c.targetRef,class:'q-field__native row',tabindex:-1,...c.splitAttrs.attributes.value,'data-autofocus':d.autofocus===true||void 0},e.control(J.value)));}t.value===true&&a.push(b('div',{class:I.value},r(e.label,d.label)));d.suffix!==void 0&&d.suffix!==null&&a.push(b('div',{class:'q-field__suffix no-pointer-events row items-center'},d.suffix));return a.concat(r(e.default));}function P(){let a,f;if(h.value===true){if(m.value!==null){a=[b('div',{role:'alert'},m.value)];f=`q--slot-error-${m.value}`;}else{a=r(e.error);f='q--slot-error';}}else if(d.hideHint!==true||c.focused.value===true){if(d.hint!==void 0){a=[b('div',d.hint)];f=`q--slot-hint-${d.hint}`;}else{a=r(e.hint);f='q--slot-hint';}}const g=d.counter===true||e.counter!==void 0;if(d.hideBottomSpace===true&&g===false&&a===void 0)return;const i=b('div',{key:f,class:'q-field__messages col'},a);return b('div',{class:'q-field__bottom row items-start q-field__bottom--'+(d.hideBottomSpace!==true?'animated':'stale'),onClick:ab},[d.hideBottomSpace===true?i:b(bD,{name:'q-transition--field-message'},()=>i),g===true?b('div',{class:'q-field__counter'},e.counter!==void 0?e.counter():c.computedCounter.value):null]);}function l(c,a){return a===null?null:b('div',{key:c,class:'q-field__append q-field__marginal row no-wrap items-center q-anchor--skip'},a);}let C=false;bf(()=>{C=true;});b3(()=>{C===true&&d.autofocus===true&&j.focus();});d.autofocus===true&&L(()=>{j.focus();});x(()=>{f!==null&&clearTimeout(f);});Object.assign(j,{focus:y,blur:K});return function a(){const a=c.getControl===void 0&&e.control===void 0?{...c.splitAttrs.attributes.value,'data-autofocus':d.autofocus===true||void 0,...u.value}:u.value;return b(c.tag.value,{ref:c.rootRef,class:[G.value,o.class],style:o.style,...a},[e.before!==void 0?b('div',{class:'q-field__before q-field__marginal row no-wrap items-center',onClick:ab},e.before()):null,b('div',{class:'q-field__inner relative-position col self-stretch'},[b('div',{ref:c.controlRef,class:H.value,tabindex:-1,...c.controlEvents},N()),s.value===true?P():null]),e.after!==void 0?b('div',{class:'q-field__after q-field__marginal row no-wrap items-center',onClick:ab},e.after()):null]);};}const h$={date:'####/##/##',datetime:'####/##/## ##:##',time:'##:##',fulltime:'##:##:##',phone:'(###) ### - ####',card:'#### #### #### ####'};const dl={'#':{pattern:'[\\d]',negate:'[^\\d]'},S:{pattern:'[a-zA-Z]',negate:'[^a-zA-Z]'},N:{pattern:'[0-9a-zA-Z]',negate:'[^0-9a-zA-Z]'},A:{pattern:'[a-zA-Z]',negate:'[^a-zA-Z]',transform:a=>a.toLocaleUpperCase()},a:{pattern:'[a-zA-Z]',negate:'[^a-zA-Z]',transform:a=>a.toLocaleLowerCase()},X:{pattern:'[0-9a-zA-Z]',negate:'[^0-9a-zA-Z]',transform:a=>a.toLocaleUpperCase()},x:{pattern:'[0-9a-zA-Z]',negate:'[^0-9a-zA-Z]',transform:a=>a.toLocaleLowerCase()}};const h_=Object.keys(dl);h_.forEach(a=>{dl[a].regex=new RegExp(dl[a].pattern);});const nu=new RegExp('\\\\([^.*+?^${}()|([\\]])|([.*+?^${}()|[\\]])|(['+h_.join('')+'])|(.)','g'),h0=/[.*+?^${}()|[\]\\]/g;const H=String.fromCharCode(1);const nv={mask:String,reverseFillMask:Boolean,fillMask:[Boolean,String],unmaskedValue:Boolean};function nw(a,n,u,q){let b,e,o,j,r,g;const i=c(null);const h=c(x());function v(){return a.autogrow===true||['textarea','text','search','url','tel','password'].includes(a.type);}f(()=>a.type+a.autogrow,l);f(()=>a.mask,b=>{if(b!==void 0){m(h.value,true);}else{const b=k(h.value);l();a.modelValue!==b&&n('update:modelValue',b);}});f(()=>a.fillMask+a.reverseFillMask,()=>{i.value===true&&m(h.value,true);});f(()=>a.unmaskedValue,()=>{i.value===true&&m(h.value);});function x(){l();if(i.value===true){const b=p(k(a.modelValue));return a.fillMask!==false?t(b):b;}return a.modelValue;}function s(c){if(c<b.length){return b.slice(-c);}let e='',a=b;const d=a.indexOf(H);if(d!==-1){for(let b=c-a.length;b>0;b--){e+=H;}a=a.slice(0,d)+e+a.slice(d);}return a;}function l(){i.value=a.mask!==void 0&&a.mask.length!==0&&v();if(i.value===false){j=void 0;b='';e='';return;}const p=h$[a.mask]===void 0?a.mask:h$[a.mask],k=typeof a.fillMask==='string'&&a.fillMask.length!==0?a.fillMask.slice(0,1):'_',l=k.replace(h0,'\\$&'),h=[],g=[],d=[];let m=a.reverseFillMask===true,c='',f='';p.replace(nu,(j,b,a,e,i)=>{if(e!==void 0){const a=dl[e];d.push(a);f=a.negate;if(m===true){g.push('(?:'+f+'+)?('+a.pattern+'+)?(?:'+f+'+)?('+a.pattern+'+)?');m=false;}g.push('(?:'+f+'+)?('+a.pattern+')?');}else if(a!==void 0){c='\\'+(a==='\\'?'':a);d.push(a);h.push('([^'+c+']+)?'+c+'?');}else{const a=b!==void 0?b:i;c=a==='\\'?'\\\\\\\\':a.replace(h0,'\\\\$&');d.push(a);h.push('([^'+c+']+)?'+c+'?');}});const q=new RegExp('^'+h.join('')+'('+(c===''?'.':'[^'+c+']')+'+)?'+(c===''?'':'['+c+']*')+'$'),r=g.length-1,n=g.map((b,c)=>{if(c===0&&a.reverseFillMask===true){return new RegExp('^'+l+'*'+b);}else if(c===r){return new RegExp('^'+b+'('+(f===''?'.':f)+'+)?'+(a.reverseFillMask===true?'$':l+'*'));}return new RegExp('^'+b);});o=d;j=b=>{const e=q.exec(a.reverseFillMask===true?b:b.slice(0,d.length+1));if(e!==null){b=e.slice(1).join('');}const c=[],f=n.length;for(let a=0,d=b;a<f;a++){const b=n[a].exec(d);if(b===null){break;}d=d.slice(b.shift().length);c.push(...b);}if(c.length!==0){return c.join('');}return b;};b=d.map(a=>(typeof a==='string'?a:H)).join('');e=b.split(H).join(k);}function m(s,v,o){const c=q.value,g=c.selectionEnd,j=c.value.length-g,x=k(s);v===true&&l();const i=p(x),f=a.fillMask!==false?t(i):i,m=h.value!==f;c.value!==f&&(c.value=f);m===true&&(h.value=f);document.activeElement===c&&w(()=>{if(f===e){const b=a.reverseFillMask===true?e.length:0;c.setSelectionRange(b,b,'forward');return;}if(o==='insertFromPaste'&&a.reverseFillMask!==true){const e=c.selectionEnd;let a=g-1;for(let c=r;c<=a&&c<e;c++){if(b[c]!==H){a++;}}d.right(c,a);return;}if(['deleteContentBackward','deleteContentForward'].indexOf(o)!==-1){const b=a.reverseFillMask===true?(g===0?(f.length>i.length?1:0):Math.max(0,f.length-(f===e?0:Math.min(i.length,j)+1))+1):g;c.setSelectionRange(b,b,'forward');return;}if(a.reverseFillMask===true){if(m===true){const a=Math.max(0,f.length-(f===e?0:Math.min(i.length,j+1)));if(a===1&&g===1){c.setSelectionRange(a,a,'forward');}else{d.rightReverse(c,a);}}else{const a=f.length-j;c.setSelectionRange(a,a,'backward');}}else{if(m===true){const a=Math.max(0,b.indexOf(H),Math.min(i.length,g)-1);d.right(c,a);}else{const a=g-1;d.right(c,a);}}});const n=a.unmaskedValue===true?k(f):f;if(String(a.modelValue)!==n&&(a.modelValue!==null||n!=='')){u(n,true);}}function y(c,a,d){const e=p(k(c.value));a=Math.max(0,b.indexOf(H),Math.min(e.length,a));r=a;c.setSelectionRange(a,d,'forward');}const d={left(e,a){const f=b.slice(a-1).indexOf(H)===-1;let c=Math.max(0,a-1);for(;c>=0;c--){if(b[c]===H){a=c;f===true&&a++;break;}}if(c<0&&b[a]!==void 0&&b[a]!==H){return d.right(e,0);}a>=0&&e.setSelectionRange(a,a,'backward');},right(f,a){const e=f.value.length;let c=Math.min(e,a+1);for(;c<=e;c++){if(b[c]===H){a=c;break;}else if(b[c-1]===H){a=c;}}if(c>e&&b[a-1]!==void 0&&b[a-1]!==H){return d.left(f,e);}f.setSelectionRange(a,a,'forward');},leftReverse(e,a){const c=s(e.value.length);let b=Math.max(0,a-1);for(;b>=0;b--){if(c[b-1]===H){a=b;break;}else if(c[b]===H){a=b;if(b===0){break;}}}if(b<0&&c[a]!==void 0&&c[a]!==H){return d.rightReverse(e,0);}a>=0&&e.setSelectionRange(a,a,'backward');},rightReverse(f,a){const b=f.value.length,e=s(b),g=e.slice(0,a+1).indexOf(H)===-1;let c=Math.min(b,a+1);for(;c<=b;c++){if(e[c-1]===H){a=c;a>0&&g===true&&a--;break;}}if(c>b&&e[a-1]!==void 0&&e[a-1]!==H){return d.leftReverse(f,b);}f.setSelectionRange(a,a,'forward');}};function z(a){n('click',a);g=void 0;}function A(b){n('keydown',b);if(c3(b)===true||b.altKey===true)return;const c=q.value,e=c.selectionStart,f=c.selectionEnd;if(!b.shiftKey){g=void 0;}if(b.keyCode===37||b.keyCode===39){if(b.shiftKey&&g===void 0){g=c.selectionDirection==='forward'?e:f;}const h=d[(b.keyCode===39?'right':'left')+(a.reverseFillMask===true?'Reverse':'')];b.preventDefault();h(c,g===e?f:e);if(b.shiftKey){const a=c.selectionStart;c.setSelectionRange(Math.min(g,a),Math.max(g,a),'forward');}}else if(b.keyCode===8&&a.reverseFillMask!==true&&e===f){d.left(c,e);c.setSelectionRange(c.selectionStart,f,'backward');}else if(b.keyCode===46&&a.reverseFillMask===true&&e===f){d.rightReverse(c,f);c.setSelectionRange(e,c.selectionEnd,'forward');}}function p(b){if(b===void 0||b===null||b===''){return'';}if(a.reverseFillMask===true){return B(b);}const e=o;let d=0,c='';for(let a=0;a<e.length;a++){const g=b[d],f=e[a];if(typeof f==='string'){c+=f;g===f&&d++;}else if(g!==void 0&&f.regex.test(g)){c+=f.transform!==void 0?f.transform(g):g;d++;}else{return c;}}return c;}function B(d){const e=o,f=b.indexOf(H);let c=d.length-1,a='';for(let b=e.length-1;b>=0&&c!==-1;b--){const h=e[b];let g=d[c];if(typeof h==='string'){a=h+a;g===h&&c--;}else if(g!==void 0&&h.regex.test(g)){do{a=(h.transform!==void 0?h.transform(g):g)+a;c--;g=d[c];}while(f===b&&g!==void 0&&h.regex.test(g));}else{return a;}}return a;}function k(a){return typeof a!=='string'||j===void 0?(typeof a==='number'?j(''+a):a):j(a);}function t(b){if(e.length-b.length<=0){return b;}return a.reverseFillMask===true&&b.length!==0?e.slice(0,-b.length)+b:b+e.slice(b.length);}return{innerValue:h,hasMask:i,moveCursorForPaste:y,updateMaskValue:m,onMaskedKeydown:A,onMaskedClick:z};}const eP={name:String};function nx(a={}){return(c,d,e)=>{c[d](b('input',{class:'hidden'+(e||''),...a.value}));};}function h1(b){return a(()=>b.name||b.for);}function ny(b,d){function c(){const a=b.modelValue;try{const b='DataTransfer'in window?new DataTransfer():('ClipboardEvent'in window?new ClipboardEvent('').clipboardData:void 0);if(Object(a)===a){('length'in a?Array.from(a):[a]).forEach(a=>{b.items.add(a);});}return{files:b.files};}catch(a){return{files:void 0};}}return d===true?a(()=>{if(b.type!=='file')return;return c();}):a(c);}const nz=/[\u3000-\u303f\u3040-\u309f\u30a0-\u30ff\uff00-\uff9f\u4e00-\u9faf\u3400-\u4dbf]/;const nA=/[\u4e00-\u9fff\u3400-\u4dbf\u{20000}-\u{2a6df}\u{2a700}-\u{2b73f}\u{2b740}-\u{2b81f}\u{2b820}-\u{2ceaf}\uf900-\ufaff\u3300-\u33ff\ufe30-\ufe4f\uf900-\ufaff\u{2f800}-\u{2fa1f}]/u;const nB=/[\u3131-\u314e\u314f-\u3163\uac00-\ud7a3]/;const nC=/[a-z0-9_ -]$/i;function h2(a){return function b(b){if(b.type==='compositionend'||b.type==='change'){if(b.target.qComposing!==true)return;b.target.qComposing=false;a(b);}else if(b.type==='compositionupdate'&&b.target.qComposing!==true&&typeof b.data==='string'){const a=y.is.firefox===true?nC.test(b.data)===false:nz.test(b.data)===true||nA.test(b.data)===true||nB.test(b.data)===true;if(a===true){b.target.qComposing=true;}}};}const co=n({name:'QInput',inheritAttrs:false,props:{...dk,...nv,...eP,modelValue:[String,Number,FileList],shadowText:String,type:{type:String,default:'text'},debounce:[String,Number],autogrow:Boolean,inputClass:[Array,String,Object],inputStyle:[Array,String,Object]},emits:[...eM,'paste','change','keydown','click','animationend'],setup(d,{emit:k,attrs:D}){const{proxy:t}=i();const{$q:E}=t;const g={};let o=NaN,q,r,h=null,l;const e=c(null);const F=h1(d);const{innerValue:j,hasMask:s,moveCursorForPaste:G,updateMaskValue:v,onMaskedKeydown:H,onMaskedClick:I}=nw(d,k,u,e);const J=ny(d,true);const y=a(()=>cn(j.value));const z=h2(A);const m=eN({changeEvent:true});const p=a(()=>d.type==='textarea'||d.autogrow===true);const K=a(()=>p.value===true||['text','search','url','tel','password'].includes(d.type));const M=a(()=>{const a={...m.splitAttrs.listeners.value,onInput:A,onPaste:Q,onChange:S,onBlur:B,onFocus:aA};a.onCompositionstart=a.onCompositionupdate=a.onCompositionend=z;if(s.value===true){a.onKeydown=H;a.onClick=I;}if(d.autogrow===true){a.onAnimationend=R;}return a;});const N=a(()=>{const a={tabindex:0,'data-autofocus':d.autofocus===true||void 0,rows:d.type==='textarea'?6:void 0,'aria-label':d.label,name:F.value,...m.splitAttrs.attributes.value,id:m.targetUid.value,maxlength:d.maxlength,disabled:d.disable===true,readonly:d.readonly===true};if(p.value===false){a.type=d.type;}if(d.autogrow===true){a.rows=1;}return a;});f(()=>d.type,()=>{if(e.value){e.value.value=d.modelValue;}});f(()=>d.modelValue,a=>{if(s.value===true){if(r===true){r=false;if(String(a)===o)return;}v(a);}else if(j.value!==a){j.value=a;if(d.type==='number'&&g.hasOwnProperty('value')===true){if(q===true){q=false;}else{delete g.value;}}}d.autogrow===true&&w(n);});f(()=>d.autogrow,a=>{if(a===true){w(n);}else if(e.value!==null&&D.rows>0){e.value.style.height='auto';}});f(()=>d.dense,()=>{d.autogrow===true&&w(n);});function O(){c6(()=>{const a=document.activeElement;if(e.value!==null&&e.value!==a&&(a===null||a.id!==m.targetUid.value)){e.value.focus({preventScroll:true});}});}function P(){e.value?.select();}function Q(a){if(s.value===true&&d.reverseFillMask!==true){const b=a.target;G(b,b.selectionStart,b.selectionEnd);}k('paste',a);}function A(a){if(!a||!a.target)return;if(d.type==='file'){k('update:modelValue',a.target.files);return;}const b=a.target.value;if(a.target.qComposing===true){g.value=b;return;}if(s.value===true){v(b,false,a.inputType);}else{u(b);if(K.value===true&&a.target===document.activeElement){const{selectionStart:c,selectionEnd:d}=a.target;if(c!==void 0&&d!==void 0){w(()=>{if(a.target===document.activeElement&&b.indexOf(a.target.value)===0){a.target.setSelectionRange(c,d);}});}}}d.autogrow===true&&n();}function R(a){k('animationend',a);n();}function u(a,b){l=()=>{h=null;if(d.type!=='number'&&g.hasOwnProperty('value')===true){delete g.value;}if(d.modelValue!==a&&o!==a){o=a;b===true&&(r=true);k('update:modelValue',a);w(()=>{o===a&&(o=NaN);});}l=void 0;};if(d.type==='number'){q=true;g.value=a;}if(d.debounce!==void 0){h!==null&&clearTimeout(h);g.value=a;h=setTimeout(l,d.debounce);}else{l();}}function n(){requestAnimationFrame(()=>{const a=e.value;if(a!==null){const b=a.parentNode.style;const{scrollTop:e}=a;const{overflowY:c,maxHeight:f}=E.platform.is.firefox===true?{}:window.getComputedStyle(a);const d=c!==void 0&&c!=='scroll';d===true&&(a.style.overflowY='hidden');b.marginBottom=(a.scrollHeight-1)+'px';a.style.height='1px';a.style.height=a.scrollHeight+'px';d===true&&(a.style.overflowY=parseInt(f,10)<a.scrollHeight?'auto':'hidden');b.marginBottom='';a.scrollTop=e;}});}function S(a){z(a);if(h!==null){clearTimeout(h);h=null;}l?.();k('change',a.target.value);}function B(a){a!==void 0&&aA(a);if(h!==null){clearTimeout(h);h=null;}l?.();q=false;r=false;delete g.value;d.type!=='file'&&setTimeout(()=>{if(e.value!==null){e.value.value=j.value!==void 0?j.value:'';}});}function C(){return g.hasOwnProperty('value')===true?g.value:(j.value!==void 0?j.value:'');}x(()=>{B();});L(()=>{d.autogrow===true&&n();});Object.assign(m,{innerValue:j,fieldClass:a(()=>`q-${p.value===true?'textarea':'input'}`+(d.autogrow===true?' q-textarea--autogrow':'')),hasShadow:a(()=>d.type!=='file'&&typeof d.shadowText==='string'&&d.shadowText.length!==0),inputRef:e,emitValue:u,hasValue:y,floatingLabel:a(()=>(y.value===true&&(d.type!=='number'||isNaN(j.value)===false))||cn(d.displayValue)),getControl:()=>{return b(p.value===true?'textarea':'input',{ref:e,class:['q-field__native q-placeholder',d.inputClass],style:d.inputStyle,...N.value,...M.value,...(d.type!=='file'?{value:C()}:J.value)});},getShadowControl:()=>{return b('div',{class:'q-field__native q-field__shadow absolute-bottom no-pointer-events'+(p.value===true?'':' text-no-wrap')},[b('span',{class:'invisible'},C()),b('span',d.shadowText)]);}});const T=eO(m);Object.assign(t,{focus:O,select:P,getNativeElement:()=>e.value});ai(t,'nativeEl',()=>e.value);return T;}});const h3=n({name:'QCardActions',props:{...g7,vertical:Boolean},setup(c,{slots:d}){const e=g8(c);const f=a(()=>`q-card__actions ${e.value}`+` q-card__actions--${c.vertical===true?'vert column':'horiz row'}`);return()=>b('div',{class:f.value},r(d.default));}});const h4=()=>true;function nD(a){return typeof a==='string'&&a!==''&&a!=='/'&&a!=='#/';}function nE(a){a.startsWith('#')===true&&(a=a.substring(1));a.startsWith('/')===false&&(a='/'+a);a.endsWith('/')===true&&(a=a.substring(0,a.length-1));return'#'+a;}function nF(a){if(a.backButtonExit===false){return()=>false;}if(a.backButtonExit==='*'){return h4;}const b=['#/'];Array.isArray(a.backButtonExit)===true&&b.push(...a.backButtonExit.filter(nD).map(nE));return()=>b.includes(window.location.hash);}const cp={__history:[],add:aL,remove:aL,install({$q:e}){if(this.__installed===true)return;const{cordova:a,capacitor:b}=y.is;if(a!==true&&b!==true)return;const c=e.config[a===true?'cordova':'capacitor'];if(c?.backButton===false)return;if(b===true&&(window.Capacitor===void 0||window.Capacitor.Plugins.App===void 0))return;this.add=a=>{if(a.condition===void 0){a.condition=h4;}this.__history.push(a);};this.remove=b=>{const a=this.__history.indexOf(b);if(a>=0){this.__history.splice(a,1);}};const f=nF(Object.assign({backButtonExit:true},c));const d=()=>{if(this.__history.length){const a=this.__history[this.__history.length-1];if(a.condition()===true){this.__history.pop();a.handler();}}else if(f()===true){navigator.app.exitApp();}else{window.history.back();}};if(a===true){document.addEventListener('deviceready',()=>{document.addEventListener('backbutton',d,false);});}else{window.Capacitor.Plugins.App.addListener('backButton',d);}}};function nG(c,d,e){let a;function b(){if(a!==void 0){cp.remove(a);a=void 0;}}x(()=>{c.value===true&&b();});return{removeFromHistory:b,addToHistory(){a={condition:()=>e.value===true,handler:d};cp.add(a);}};}let cq=0,eQ,eR,cr,eS=false,h5,h6,h7,br=null;function nH(a){if(nI(a)){B(a);}}function nI(a){if(a.target===document.body||a.target.classList.contains('q-layout__backdrop')){return true;}const d=me(a),e=a.shiftKey&&!a.deltaX,c=!e&&Math.abs(a.deltaX)<=Math.abs(a.deltaY),b=e||c?a.deltaY:a.deltaX;for(let a=0;a<d.length;a++){const e=d[a];if(mQ(e,c)){return c?(b<0&&e.scrollTop===0?true:b>0&&e.scrollTop+e.clientHeight===e.scrollHeight):(b<0&&e.scrollLeft===0?true:b>0&&e.scrollLeft+e.clientWidth===e.scrollWidth);}}return true;}function h8(a){if(a.target===document){document.scrollingElement.scrollTop=document.scrollingElement.scrollTop;}}function dm(a){if(eS===true)return;eS=true;requestAnimationFrame(()=>{eS=false;const{height:c}=a.target,{clientHeight:d,scrollTop:b}=document.scrollingElement;if(cr===void 0||c!==window.innerHeight){cr=d-c;document.scrollingElement.scrollTop=b;}if(b>cr){document.scrollingElement.scrollTop-=Math.ceil((b-cr)/8);}});}function h9(b){const a=document.body,c=window.visualViewport!==void 0;if(b==='add'){const{overflowY:b,overflowX:d}=window.getComputedStyle(a);eQ=hw(window);eR=hv(window);h5=a.style.left;h6=a.style.top;h7=window.location.href;a.style.left=`-${eQ}px`;a.style.top=`-${eR}px`;if(d!=='hidden'&&(d==='scroll'||a.scrollWidth>window.innerWidth)){a.classList.add('q-body--force-scrollbar-x');}if(b!=='hidden'&&(b==='scroll'||a.scrollHeight>window.innerHeight)){a.classList.add('q-body--force-scrollbar-y');}a.classList.add('q-body--prevent-scroll');document.qScrollPrevented=true;if(y.is.ios===true){if(c===true){window.scrollTo(0,0);window.visualViewport.addEventListener('resize',dm,E.passiveCapture);window.visualViewport.addEventListener('scroll',dm,E.passiveCapture);window.scrollTo(0,0);}else{window.addEventListener('scroll',h8,E.passiveCapture);}}}if(y.is.desktop===true&&y.is.mac===true){window[`${b}EventListener`]('wheel',nH,E.notPassive);}if(b==='remove'){if(y.is.ios===true){if(c===true){window.visualViewport.removeEventListener('resize',dm,E.passiveCapture);window.visualViewport.removeEventListener('scroll',dm,E.passiveCapture);}else{window.removeEventListener('scroll',h8,E.passiveCapture);}}a.classList.remove('q-body--prevent-scroll');a.classList.remove('q-body--force-scrollbar-x');a.classList.remove('q-body--force-scrollbar-y');document.qScrollPrevented=false;a.style.left=h5;a.style.top=h6;if(window.location.href===h7){window.scrollTo(eQ,eR);}cr=void 0;}}function nJ(b){let a='add';if(b===true){cq++;if(br!==null){clearTimeout(br);br=null;return;}if(cq>1)return;}else{if(cq===0)return;cq--;if(cq>0)return;a='remove';if(y.is.ios===true&&y.is.nativeMobile===true){br!==null&&clearTimeout(br);br=setTimeout(()=>{h9(a);br=null;},100);return;}}h9(a);}function nK(){let a;return{preventBodyScroll(b){if(b!==a&&(a!==void 0||b===true)){a=b;nJ(b);}}};}let dn=0;const nL={standard:'fixed-full flex-center',top:'fixed-top justify-center',bottom:'fixed-bottom justify-center',right:'fixed-right items-center',left:'fixed-left items-center'};const ia={standard:['scale','scale'],top:['slide-down','slide-up'],bottom:['slide-up','slide-down'],right:['slide-left','slide-right'],left:['slide-right','slide-left']};const eT=n({name:'QDialog',inheritAttrs:false,props:{...hl,...ht,transitionShow:String,transitionHide:String,persistent:Boolean,autoClose:Boolean,allowFocusOutside:Boolean,noEscDismiss:Boolean,noBackdropDismiss:Boolean,noRouteDismiss:Boolean,noRefocus:Boolean,noFocus:Boolean,noShake:Boolean,seamless:Boolean,maximized:Boolean,fullWidth:Boolean,fullHeight:Boolean,square:Boolean,backdropFilter:String,position:{type:String,default:'standard',validator:a=>['standard','top','bottom','left','right'].includes(a)}},emits:[...hm,'shake','click','escapeKey'],setup(d,{slots:H,emit:k,attrs:y}){const q=i();const e=c(null);const j=c(false);const l=c(false);let g=null,h=null,n,s;const z=a(()=>d.persistent!==true&&d.noRouteDismiss!==true&&d.seamless!==true);const{preventBodyScroll:A}=nK();const{registerTimeout:B}=cl();const{registerTick:I,removeTick:C}=ck();const{transitionProps:J,transitionStyle:D}=hu(d,()=>ia[d.position][0],()=>ia[d.position][1]);const K=a(()=>(D.value+(d.backdropFilter!==void 0?`;backdrop-filter:${d.backdropFilter};-webkit-backdrop-filter:${d.backdropFilter}`:'')));const{showPortal:E,hidePortal:F,portalIsAccessible:L,renderPortal:M}=hs(q,e,W,'dialog');const{hide:o}=hn({showing:j,hideOnRouteChange:z,handleShow:S,handleHide:T,processOnMount:true});const{addToHistory:N,removeFromHistory:O}=nG(j,o,z);const P=a(()=>'q-dialog__inner flex no-pointer-events'+` q-dialog__inner--${d.maximized===true?'maximized':'minimized'}`+` q-dialog__inner--${d.position} ${nL[d.position]}`+(l.value===true?' q-dialog__inner--animating':'')+(d.fullWidth===true?' q-dialog__inner--fullwidth':'')+(d.fullHeight===true?' q-dialog__inner--fullheight':'')+(d.square===true?' q-dialog__inner--square':''));const p=a(()=>j.value===true&&d.seamless!==true);const Q=a(()=>(d.autoClose===true?{onClick:U}:{}));const R=a(()=>['q-dialog fullscreen no-pointer-events '+`q-dialog--${p.value===true?'modal':'seamless'}`,y.class]);f(()=>d.maximized,a=>{j.value===true&&v(a);});f(p,a=>{A(a);if(a===true){hA(w);hy(u);}else{eD(w);da(u);}});function S(a){N();h=d.noRefocus===false&&document.activeElement!==null?document.activeElement:null;v(d.maximized);E();l.value=true;if(d.noFocus!==true){document.activeElement?.blur();I(m);}else{C();}B(()=>{if(q.proxy.$q.platform.is.ios===true){if(d.seamless!==true&&document.activeElement){const{top:d,bottom:a}=document.activeElement.getBoundingClientRect(),{innerHeight:c}=window,b=window.visualViewport!==void 0?window.visualViewport.height:c;if(d>0&&a>b/2){document.scrollingElement.scrollTop=Math.min(document.scrollingElement.scrollHeight-b,a>=c?Infinity:Math.ceil(document.scrollingElement.scrollTop+a-b/2));}document.activeElement.scrollIntoView();}s=true;e.value.click();s=false;}E(true);l.value=false;k('show',a);},d.transitionDuration);}function T(a){C();O();G(true);l.value=true;F();if(h!==null){((a?.type.indexOf('key')===0?h.closest('[tabindex]:not([tabindex^="-"])'):void 0)||h).focus();h=null;}B(()=>{F(true);l.value=false;k('hide',a);},d.transitionDuration);}function m(a){c6(()=>{let b=e.value;if(b===null)return;if(a!==void 0){const c=b.querySelector(a);if(c!==null){c.focus({preventScroll:true});return;}}if(b.contains(document.activeElement)!==true){b=(b.querySelector('[autofocus][tabindex], [data-autofocus][tabindex]')||b.querySelector('[autofocus] [tabindex], [data-autofocus] [tabindex]')||b.querySelector('[autofocus], [data-autofocus]')||b);b.focus({preventScroll:true});}});}function t(b){if(b&&typeof b.focus==='function'){b.focus({preventScroll:true});}else{m();}k('shake');const a=e.value;if(a!==null){a.classList.remove('q-animate--scale');a.classList.add('q-animate--scale');g!==null&&clearTimeout(g);g=setTimeout(()=>{g=null;if(e.value!==null){a.classList.remove('q-animate--scale');m();}},170);}}function u(){if(d.seamless!==true){if(d.persistent===true||d.noEscDismiss===true){d.maximized!==true&&d.noShake!==true&&t();}else{k('escapeKey');o();}}}function G(a){if(g!==null){clearTimeout(g);g=null;}if(a===true||j.value===true){v(false);if(d.seamless!==true){A(false);eD(w);da(u);}}if(a!==true){h=null;}}function v(a){if(a===true){if(n!==true){dn<1&&document.body.classList.add('q-body--dialog');dn++;n=true;}}else if(n===true){if(dn<2){document.body.classList.remove('q-body--dialog');}dn--;n=false;}}function U(a){if(s!==true){o(a);k('click',a);}}function V(a){if(d.persistent!==true&&d.noBackdropDismiss!==true){o(a);}else if(d.noShake!==true){t();}}function w(a){if(d.allowFocusOutside!==true&&L.value===true&&g2(e.value,a.target)!==true){m('[tabindex]:not([tabindex="-1"])');}}Object.assign(q.proxy,{focus:m,shake:t,__updateRefocusTarget(a){h=a||null;}});x(G);function W(){return b('div',{role:'dialog','aria-modal':p.value===true?'true':'false',...y,class:R.value},[b(bD,{name:'q-transition--fade',appear:true},()=>(p.value===true?b('div',{class:'q-dialog__backdrop fixed-full',style:K.value,'aria-hidden':'true',tabindex:-1,onClick:V}):null)),b(bD,J.value,()=>(j.value===true?b('div',{ref:e,class:P.value,style:D.value,tabindex:-1,...Q.value},r(H.default)):null))]);}return M;}});const{passive:ib}=E;const nM=['both','horizontal','vertical'];const nN=n({name:'QScrollObserver',props:{axis:{type:String,validator:a=>nM.includes(a),default:'vertical'},debounce:[String,Number],scrollTarget:eB},emits:['scroll'],setup(b,{emit:m}){const a={position:{top:0,left:0},direction:'down',directionChanged:false,delta:{top:0,left:0},inflectionPoint:{top:0,left:0}};let d=null,c,j;f(()=>b.scrollTarget,()=>{l();k();});function e(){d?.();const f=Math.max(0,hv(c));const g=hw(c);const e={top:f-a.position.top,left:g-a.position.left};if((b.axis==='vertical'&&e.top===0)||(b.axis==='horizontal'&&e.left===0))return;const h=Math.abs(e.top)>=Math.abs(e.left)?(e.top<0?'up':'down'):(e.left<0?'left':'right');a.position={top:f,left:g};a.directionChanged=a.direction!==h;a.delta=e;if(a.directionChanged===true){a.direction=h;a.inflectionPoint=a.position;}m('scroll',{...a});}function k(){c=eC(j,b.scrollTarget);c.addEventListener('scroll',g,ib);g(true);}function l(){if(c!==void 0){c.removeEventListener('scroll',g,ib);c=void 0;}}function g(a){if(a===true||b.debounce===0||b.debounce==='0'){e();}else if(d===null){const[a,c]=b.debounce?[setTimeout(e,b.debounce),clearTimeout]:[requestAnimationFrame(e),cancelAnimationFrame];d=()=>{c(a);d=null;};}}const{proxy:h}=i();f(()=>h.$q.lang.rtl,e);L(()=>{j=h.$el.parentNode;k();});x(()=>{d?.();l();});Object.assign(h,{trigger:g,getPosition:()=>a});return aL;}});const nO=n({name:'QLayout',props:{container:Boolean,view:{type:String,default:'hhh lpr fff',validator:a=>/^(h|l)h(h|r) lpr (f|l)f(f|r)$/.test(a.toLowerCase())},onScroll:Function,onScrollHeight:Function,onResize:Function},setup(d,{slots:r,emit:l}){const{proxy:{$q:g}}=i();const m=c(null);const h=c(g.screen.height);const n=c(d.container===true?0:g.screen.width);const o=c({position:0,direction:'down',inflectionPoint:0});const j=c(0);const e=c(aB.value===true?0:c9());const s=a(()=>'q-layout q-layout--'+(d.container===true?'containerized':'standard'));const t=a(()=>(d.container===false?{minHeight:g.screen.height+'px'}:null));const u=a(()=>(e.value!==0?{[g.lang.rtl===true?'left':'right']:`${e.value}px`}:null));const v=a(()=>(e.value!==0?{[g.lang.rtl===true?'right':'left']:0,[g.lang.rtl===true?'left':'right']:`-${e.value}px`,width:`calc(100% + ${e.value}px)`}:null));function w(a){if(d.container===true||document.qScrollPrevented!==true){const b={position:a.position.top,direction:a.direction,directionChanged:a.directionChanged,inflectionPoint:a.inflectionPoint.top,delta:a.delta.top};o.value=b;d.onScroll!==void 0&&l('scroll',b);}}function x(c){const{height:a,width:e}=c;let b=false;if(h.value!==a){b=true;h.value=a;d.onScrollHeight!==void 0&&l('scrollHeight',a);p();}if(n.value!==e){b=true;n.value=e;}if(b===true&&d.onResize!==void 0){l('resize',c);}}function y({height:a}){if(j.value!==a){j.value=a;p();}}function p(){if(d.container===true){const a=h.value>j.value?c9():0;if(e.value!==a){e.value=a;}}}let k=null;const q={instances:{},view:a(()=>d.view),isContainer:a(()=>d.container),rootRef:m,height:h,containerHeight:j,scrollbarWidth:e,totalWidth:a(()=>n.value+e.value),rows:a(()=>{const a=d.view.toLowerCase().split(' ');return{top:a[0].split(''),middle:a[1].split(''),bottom:a[2].split('')};}),header:ax({size:0,offset:0,space:false}),right:ax({size:300,offset:0,space:false}),footer:ax({size:0,offset:0,space:false}),left:ax({size:300,offset:0,space:false}),scroll:o,animate(){if(k!==null){clearTimeout(k);}else{document.body.classList.add('q-body--layout-animate');}k=setTimeout(()=>{k=null;document.body.classList.remove('q-body--layout-animate');},155);},update(a,b,c){q[a][b]=c;}};cV(dd,q);if(c9()>0){let a=null;const b=document.body;function e(){a=null;b.classList.remove('hide-scrollbar');}function h(){if(a===null){if(b.scrollHeight>g.screen.height)return;b.classList.add('hide-scrollbar');}else{clearTimeout(a);}a=setTimeout(e,300);}function c(b){if(a!==null&&b==='remove'){clearTimeout(a);e();}window[`${b}EventListener`]('resize',h);}f(()=>(d.container!==true?'add':'remove'),c);d.container!==true&&c('add');bC(()=>{c('remove');});}return()=>{const c=ap(r.default,[b(nN,{onScroll:w}),b(dg,{onResize:x})]);const a=b('div',{class:s.value,style:t.value,ref:d.container===true?void 0:m,tabindex:-1},c);if(d.container===true){return b('div',{class:'q-layout-container overflow-hidden',ref:m},[b(dg,{onResize:y}),b('div',{class:'absolute-full',style:u.value},[b('div',{class:'scroll',style:v.value},[a])])]);}return a;};}});function ic(a){if(a===false){return 0;}if(a===true||a===void 0){return 1;}const b=parseInt(a,10);return isNaN(b)?0:b;}const dp=eq({name:'close-popup',beforeMount(b,{value:c}){const a={depth:ic(c),handler(c){a.depth!==0&&setTimeout(()=>{const d=mL(b);if(d!==void 0){mM(d,c,a.depth);}});},handlerKey(b){aM(b,13)===true&&a.handler(b);}};b.__qclosepopup=a;b.addEventListener('click',a.handler);b.addEventListener('keyup',a.handlerKey);},updated(b,{value:a,oldValue:c}){if(a!==c){b.__qclosepopup.depth=ic(a);}},beforeUnmount(a){const b=a.__qclosepopup;a.removeEventListener('click',b.handler);a.removeEventListener('keyup',b.handlerKey);delete a.__qclosepopup;}});function nP(b,a){if(typeof console!=='undefined'){console.warn(`[intlify] `+b);if(a){console.warn(a.stack);}}}const dq=typeof window!=='undefined';const a1=(a,b=false)=>!b?Symbol(a):Symbol.for(a);const nQ=(a,b,c)=>nR({l:a,k:b,s:c});const nR=(a)=>JSON.stringify(a).replace(/\u2028/g,'\\u2028').replace(/\u2029/g,'\\u2029').replace(/\u0027/g,'\\u0027');const F=(a)=>typeof a==='number'&&isFinite(a);const nS=(a)=>ii(a)==='[object Date]';const a2=(a)=>ii(a)==='[object RegExp]';const dr=(a)=>k(a)&&Object.keys(a).length===0;const O=Object.assign;const nT=Object.create;const s=(a=null)=>nT(a);let id;const aO=()=>{return(id||(id=typeof globalThis!=='undefined'?globalThis:typeof self!=='undefined'?self:typeof window!=='undefined'?window:typeof global!=='undefined'?global:s()));};function ie(a){return a.replace(/&/g,'&amp;').replace(/</g,'&lt;').replace(/>/g,'&gt;').replace(/"/g,'&quot;').replace(/'/g,'&apos;').replace(/\//g,'&#x2F;').replace(/=/g,'&#x3D;');}function ig(a){return a.replace(/&(?![a-zA-Z0-9#]{2,6};)/g,'&amp;').replace(/"/g,'&quot;').replace(/'/g,'&apos;').replace(/</g,'&lt;').replace(/>/g,'&gt;');}function nU(a){a=a.replace(/(\w+)\s*=\s*"([^"]*)"/g,(c,a,b)=>`${a}="${ig(b)}"`);a=a.replace(/(\w+)\s*=\s*'([^']*)'/g,(c,a,b)=>`${a}='${ig(b)}'`);const b=/\s*on\w+\s*=\s*["']?[^"'>]+["']?/gi;if(b.test(a)){a=a.replace(/(\s+)(on)(\w+\s*=)/gi,'$1&#111;n$3');}const c=[/(\s+(?:href|src|action|formaction)\s*=\s*["']?)\s*javascript:/gi,/(style\s*=\s*["'][^"']*url\s*\(\s*)javascript:/gi];c.forEach(b=>{a=a.replace(b,'$1javascript&#58;');});return a;}const nV=Object.prototype.hasOwnProperty;function aq(a,b){return nV.call(a,b);}const z=Array.isArray;const v=(a)=>typeof a==='function';const d=(a)=>typeof a==='string';const o=(a)=>typeof a==='boolean';const p=(a)=>a!==null&&typeof a==='object';const nW=(a)=>{return p(a)&&v(a.then)&&v(a.catch);};const ih=Object.prototype.toString;const ii=(a)=>ih.call(a);const k=(a)=>{if(!p(a))return false;const b=Object.getPrototypeOf(a);return b===null||b.constructor===Object;};const nX=(a)=>{return a==null?'':z(a)||(k(a)&&a.toString===ih)?JSON.stringify(a,null,2):String(a);};function nY(a,b=''){return a.reduce((a,c,d)=>(d===0?a+c:a+b+c),'');}function ds(a){let b=a;return()=>++b;}const dt=(a)=>!p(a)||z(a);function du(b,c){if(dt(b)||dt(c)){throw new Error('Invalid value');}const a=[{src:b,des:c}];while(a.length){const{src:b,des:c}=a.pop();Object.keys(b).forEach(d=>{if(d==='__proto__'){return;}if(p(b[d])&&!p(c[d])){c[d]=Array.isArray(b[d])?[]:s();}if(dt(c[d])||dt(b[d])){c[d]=b[d];}else{a.push({src:b[d],des:c[d]});}});}}function nZ(a,b,c){return{line:a,column:b,offset:c};}function dv(c,d,a){const b={start:c,end:d};if(a!=null){b.source=a;}return b;}const n$=/\{([0-9a-zA-Z]+)\}/g;function ij(b,...a){if(a.length===1&&n_(a[0])){a=a[0];}if(!a||!a.hasOwnProperty){a={};}return b.replace(n$,(c,b)=>{return a.hasOwnProperty(b)?a[b]:'';});}const ik=Object.assign;const il=(a)=>typeof a==='string';const n_=(a)=>a!==null&&typeof a==='object';function im(a,b=''){return a.reduce((a,c,d)=>(d===0?a+c:a+b+c),'');}const eU={USE_MODULO_SYNTAX:1,__EXTEND_POINT__:2};const n0={[eU.USE_MODULO_SYNTAX]:`Use modulo before '{{0}}'.`};function n1(a,b,...d){const e=ij(n0[a]||'',...(d||[]));const c={message:String(e),code:a};if(b){c.location=b;}return c;}const h={EXPECTED_TOKEN:1,INVALID_TOKEN_IN_PLACEHOLDER:2,UNTERMINATED_SINGLE_QUOTE_IN_PLACEHOLDER:3,UNKNOWN_ESCAPE_SEQUENCE:4,INVALID_UNICODE_ESCAPE_SEQUENCE:5,UNBALANCED_CLOSING_BRACE:6,UNTERMINATED_CLOSING_BRACE:7,EMPTY_PLACEHOLDER:8,NOT_ALLOW_NEST_PLACEHOLDER:9,INVALID_LINKED_FORMAT:10,MUST_HAVE_MESSAGES_IN_PLURAL:11,UNEXPECTED_EMPTY_LINKED_MODIFIER:12,UNEXPECTED_EMPTY_LINKED_KEY:13,UNEXPECTED_LEXICAL_ANALYSIS:14,UNHANDLED_CODEGEN_NODE_TYPE:15,UNHANDLED_MINIFIER_NODE_TYPE:16,__EXTEND_POINT__:17};const n2={[h.EXPECTED_TOKEN]:`Expected token: '{0}'`,[h.INVALID_TOKEN_IN_PLACEHOLDER]:`Invalid token in placeholder: '{0}'`,[h.UNTERMINATED_SINGLE_QUOTE_IN_PLACEHOLDER]:`Unterminated single quote in placeholder`,[h.UNKNOWN_ESCAPE_SEQUENCE]:`Unknown escape sequence: \\{0}`,[h.INVALID_UNICODE_ESCAPE_SEQUENCE]:`Invalid unicode escape sequence: {0}`,[h.UNBALANCED_CLOSING_BRACE]:`Unbalanced closing brace`,[h.UNTERMINATED_CLOSING_BRACE]:`Unterminated closing brace`,[h.EMPTY_PLACEHOLDER]:`Empty placeholder`,[h.NOT_ALLOW_NEST_PLACEHOLDER]:`Not allowed nest placeholder`,[h.INVALID_LINKED_FORMAT]:`Invalid linked format`,[h.MUST_HAVE_MESSAGES_IN_PLURAL]:`Plural must have messages`,[h.UNEXPECTED_EMPTY_LINKED_MODIFIER]:`Unexpected empty linked modifier`,[h.UNEXPECTED_EMPTY_LINKED_KEY]:`Unexpected empty linked key`,[h.UNEXPECTED_LEXICAL_ANALYSIS]:`Unexpected lexical analysis in token: '{0}'`,[h.UNHANDLED_CODEGEN_NODE_TYPE]:`unhandled codegen node type: '{0}'`,[h.UNHANDLED_MINIFIER_NODE_TYPE]:`unhandled mimifier node type: '{0}'`};function bJ(b,c,d={}){const{domain:e,messages:f,args:g}=d;const h=ij((f||n2)[b]||'',...(g||[]));const a=new SyntaxError(String(h));a.code=b;if(c){a.location=c;}a.domain=e;return a;}function n3(a){throw a;}const aP=' ';const n4='\r';const $='\n';const n5=String.fromCharCode(0x2028);const n6=String.fromCharCode(0x2029);function n7(k){const c=k;let a=0;let f=1;let d=1;let b=0;const e=(a)=>c[a]===n4&&c[a+1]===$;const l=(a)=>c[a]===$;const h=(a)=>c[a]===n6;const i=(a)=>c[a]===n5;const m=(a)=>e(a)||l(a)||h(a)||i(a);const n=()=>a;const o=()=>f;const p=()=>d;const q=()=>b;const g=(a)=>e(a)||h(a)||i(a)?$:c[a];const r=()=>g(a);const s=()=>g(a+b);function j(){b=0;if(m(a)){f++;d=0;}if(e(a)){a++;}a++;d++;return c[a];}function t(){if(e(a+b)){b++;}b++;return c[a+b];}function u(){a=0;f=1;d=1;b=0;}function v(a=0){b=a;}function w(){const c=a+b;while(c!==a){j();}b=0;}return{index:n,line:o,column:p,peekOffset:q,charAt:g,currentChar:r,currentPeek:s,next:j,peek:t,reset:u,resetPeek:v,skipToPeek:w};}const a3=undefined;const n8='.';const io="'";const n9='tokenizer';function oa(C,u={}){const v=u.location!==false;const g=n7(C);const p=()=>g.index();const a=()=>nZ(g.line(),g.column(),g.index());const l=a();const w=p();const e={currentType:14,offset:w,startLoc:l,endLoc:l,lastType:14,lastOffset:w,lastStartLoc:l,lastEndLoc:l,braceNest:0,inLinked:false,text:''};const x=()=>e;const{onError:y}=u;function d(c,a,b,...d){const e=x();a.column+=b;a.offset+=b;if(y){const b=v?dv(e.startLoc,a):null;const f=bJ(c,b,{domain:n9,args:d});y(f);}}function b(b,d,e){b.endLoc=a();b.currentType=d;const c={type:d};if(v){c.loc=dv(b.startLoc,b.endLoc);}if(e!=null){c.value=e;}return c;}const j=(a)=>b(a,14);function k(c,b){if(c.currentChar()===b){c.next();return b;}else{d(h.EXPECTED_TOKEN,a(),0,b);return'';}}function f(a){let b='';while(a.currentPeek()===aP||a.currentPeek()===$){b+=a.currentPeek();a.peek();}return b;}function c(a){const b=f(a);a.skipToPeek();return b;}function q(b){if(b===a3){return false;}const a=b.charCodeAt(0);return((a>=97&&a<=122)||(a>=65&&a<=90)||a===95);}function D(a){if(a===a3){return false;}const b=a.charCodeAt(0);return b>=48&&b<=57;}function E(a,b){const{currentType:c}=b;if(c!==2){return false;}f(a);const d=q(a.currentPeek());a.resetPeek();return d;}function F(a,b){const{currentType:c}=b;if(c!==2){return false;}f(a);const d=a.currentPeek()==='-'?a.peek():a.currentPeek();const e=D(d);a.resetPeek();return e;}function G(a,b){const{currentType:c}=b;if(c!==2){return false;}f(a);const d=a.currentPeek()===io;a.resetPeek();return d;}function H(a,b){const{currentType:c}=b;if(c!==8){return false;}f(a);const d=a.currentPeek()===".";a.resetPeek();return d;}function I(a,b){const{currentType:c}=b;if(c!==9){return false;}f(a);const d=q(a.currentPeek());a.resetPeek();return d;}function J(a,c){const{currentType:b}=c;if(!(b===8||b===12)){return false;}f(a);const d=a.currentPeek()===":";a.resetPeek();return d;}function K(a,c){const{currentType:d}=c;if(d!==10){return false;}const b=()=>{const c=a.currentPeek();if(c==="{"){return q(a.peek());}else if(c==="@"||c==="%"||c==="|"||c===":"||c==="."||c===aP||!c){return false;}else if(c===$){a.peek();return b();}else{return n(a,false);}};const e=b();a.resetPeek();return e;}function m(a){f(a);const b=a.currentPeek()==="|";a.resetPeek();return b;}function L(a){const b=f(a);const c=a.currentPeek()==="%"&&a.peek()==="{";a.resetPeek();return{isModulo:c,hasSpace:b.length>0};}function n(a,c=true){const b=(e=false,d='',f=false)=>{const c=a.currentPeek();if(c==="{"){return d==="%"?false:e;}else if(c==="@"||!c){return d==="%"?true:e;}else if(c==="%"){a.peek();return b(e,"%",true);}else if(c==="|"){return d==="%"||f?true:!(d===aP||d===$);}else if(c===aP){a.peek();return b(true,aP,f);}else if(c===$){a.peek();return b(true,$,f);}else{return true;}};const d=b();c&&a.resetPeek();return d;}function i(b,c){const a=b.currentChar();if(a===a3){return a3;}if(c(a)){b.next();return a;}return null;}function M(b){const a=b.charCodeAt(0);return((a>=97&&a<=122)||(a>=65&&a<=90)||(a>=48&&a<=57)||a===95||a===36);}function N(a){return i(a,M);}function O(b){const a=b.charCodeAt(0);return((a>=97&&a<=122)||(a>=65&&a<=90)||(a>=48&&a<=57)||a===95||a===36||a===45);}function P(a){return i(a,O);}function Q(b){const a=b.charCodeAt(0);return a>=48&&a<=57;}function R(a){return i(a,Q);}function S(b){const a=b.charCodeAt(0);return((a>=48&&a<=57)||(a>=65&&a<=70)||(a>=97&&a<=102));}function T(a){return i(a,S);}function z(c){let a='';let b='';while((a=R(c))){b+=a;}return b;}function U(b){c(b);const e=b.currentChar();if(e!=="%"){d(h.EXPECTED_TOKEN,a(),0,e);}b.next();return"%";}function A(a){let b='';while(true){const c=a.currentChar();if(c==="{"||c==="}"||c==="@"||c==="|"||!c){break;}else if(c==="%"){if(n(a)){b+=c;a.next();}else{break;}}else if(c===aP||c===$){if(n(a)){b+=c;a.next();}else if(m(a)){break;}else{b+=c;a.next();}}else{b+=c;a.next();}}return b;}function V(b){c(b);let e='';let f='';while((e=P(b))){f+=e;}if(b.currentChar()===a3){d(h.UNTERMINATED_CLOSING_BRACE,a(),0);}return f;}function W(b){c(b);let e='';if(b.currentChar()==='-'){b.next();e+=`-${z(b)}`;}else{e+=z(b);}if(b.currentChar()===a3){d(h.UNTERMINATED_CLOSING_BRACE,a(),0);}return e;}function X(a){return a!==io&&a!==$;}function Y(b){c(b);k(b,`\'`);let f='';let e='';while((f=i(b,X))){if(f==='\\'){e+=Z(b);}else{e+=f;}}const g=b.currentChar();if(g===$||g===a3){d(h.UNTERMINATED_SINGLE_QUOTE_IN_PLACEHOLDER,a(),0);if(g===$){b.next();k(b,`\'`);}return e;}k(b,`\'`);return e;}function Z(c){const b=c.currentChar();switch(b){case'\\':case`\'`:c.next();return`\\${b}`;case'u':return B(c,b,4);case'U':return B(c,b,6);default:d(h.UNKNOWN_ESCAPE_SEQUENCE,a(),0,b);return'';}}function B(b,c,f){k(b,c);let e='';for(let g=0;g<f;g++){const f=T(b);if(!f){d(h.INVALID_UNICODE_ESCAPE_SEQUENCE,a(),0,`\\${c}${e}${b.currentChar()}`);break;}e+=f;}return`\\${c}${e}`;}function _(a){return(a!=="{"&&a!=="}"&&a!==aP&&a!==$);}function aa(a){c(a);let b='';let d='';while((b=i(a,_))){d+=b;}return d;}function ab(c){let a='';let b='';while((a=N(c))){b+=a;}return b;}function ac(a){const b=(d)=>{const c=a.currentChar();if(c==="{"||c==="%"||c==="@"||c==="|"||c==="("||c===")"||!c){return d;}else if(c===aP){return d;}else if(c===$||c===n8){d+=c;a.next();return b(d);}else{d+=c;a.next();return b(d);}};return b('');}function r(a){c(a);const b=k(a,"|");c(a);return b;}function s(f,e){let g=null;const i=f.currentChar();switch(i){case"{":if(e.braceNest>=1){d(h.NOT_ALLOW_NEST_PLACEHOLDER,a(),0);}f.next();g=b(e,2,"{");c(f);e.braceNest++;return g;case"}":if(e.braceNest>0&&e.currentType===2){d(h.EMPTY_PLACEHOLDER,a(),0);}f.next();g=b(e,3,"}");e.braceNest--;e.braceNest>0&&c(f);if(e.inLinked&&e.braceNest===0){e.inLinked=false;}return g;case"@":if(e.braceNest>0){d(h.UNTERMINATED_CLOSING_BRACE,a(),0);}g=o(f,e)||j(e);e.braceNest=0;return g;default:{let i=true;let j=true;let k=true;if(m(f)){if(e.braceNest>0){d(h.UNTERMINATED_CLOSING_BRACE,a(),0);}g=b(e,1,r(f));e.braceNest=0;e.inLinked=false;return g;}if(e.braceNest>0&&(e.currentType===5||e.currentType===6||e.currentType===7)){d(h.UNTERMINATED_CLOSING_BRACE,a(),0);e.braceNest=0;return t(f,e);}if((i=E(f,e))){g=b(e,5,V(f));c(f);return g;}if((j=F(f,e))){g=b(e,6,W(f));c(f);return g;}if((k=G(f,e))){g=b(e,7,Y(f));c(f);return g;}if(!i&&!j&&!k){g=b(e,13,aa(f));d(h.INVALID_TOKEN_IN_PLACEHOLDER,a(),0,g.value);c(f);return g;}break;}}return g;}function o(e,f){const{currentType:g}=f;let i=null;const j=e.currentChar();if((g===8||g===9||g===12||g===10)&&(j===$||j===aP)){d(h.INVALID_LINKED_FORMAT,a(),0);}switch(j){case"@":e.next();i=b(f,8,"@");f.inLinked=true;return i;case".":c(e);e.next();return b(f,9,".");case":":c(e);e.next();return b(f,10,":");default:if(m(e)){i=b(f,1,r(e));f.braceNest=0;f.inLinked=false;return i;}if(H(e,f)||J(e,f)){c(e);return o(e,f);}if(I(e,f)){c(e);return b(f,12,ab(e));}if(K(e,f)){c(e);if(j==="{"){return s(e,f)||i;}else{return b(f,11,ac(e));}}if(g===8){d(h.INVALID_LINKED_FORMAT,a(),0);}f.braceNest=0;f.inLinked=false;return t(e,f);}}function t(e,c){let f={type:14};if(c.braceNest>0){return s(e,c)||j(c);}if(c.inLinked){return o(e,c)||j(c);}const g=e.currentChar();switch(g){case"{":return s(e,c)||j(c);case"}":d(h.UNBALANCED_CLOSING_BRACE,a(),0);e.next();return b(c,3,"}");case"@":return o(e,c)||j(c);default:{if(m(e)){f=b(c,1,r(e));c.braceNest=0;c.inLinked=false;return f;}const{isModulo:a,hasSpace:d}=L(e);if(a){return d?b(c,0,A(e)):b(c,4,U(e));}if(n(e)){return b(c,0,A(e));}break;}}return f;}function ad(){const{currentType:c,offset:d,startLoc:f,endLoc:h}=e;e.lastType=c;e.lastOffset=d;e.lastStartLoc=f;e.lastEndLoc=h;e.offset=p();e.startLoc=a();if(g.currentChar()===a3){return b(e,14);}return t(g,e);}return{nextToken:ad,currentOffset:p,currentPosition:a,context:x};}const ob='parser';const oc=/(?:\\\\|\\'|\\u([0-9a-fA-F]{4})|\\U([0-9a-fA-F]{6}))/g;function od(a,b,c){switch(a){case`\\\\`:return`\\`;case`\\\'`:return`\'`;default:{const a=parseInt(b||c,16);if(a<=0xd7ff||a>=0xe000){return String.fromCodePoint(a);}return'�';}}}function oe(d={}){const e=d.location!==false;const{onError:f,onWarn:g}=d;function a(c,d,g,b,...h){const a=c.currentPosition();a.offset+=b;a.column+=b;if(f){const b=e?dv(g,a):null;const c=bJ(d,b,{domain:ob,args:h});f(c);}}function m(c,d,f,b,...h){const a=c.currentPosition();a.offset+=b;a.column+=b;if(g){const b=e?dv(f,a):null;g(n1(d,b,h));}}function c(d,b,c){const a={type:d};if(e){a.start=b;a.end=b;a.loc={start:c,end:c};}return a;}function b(a,c,d,b){if(b){a.type=b;}if(e){a.end=c;if(a.loc){a.loc.end=d;}}}function n(a,f){const e=a.context();const d=c(3,e.offset,e.startLoc);d.value=f;b(d,a.currentOffset(),a.currentPosition());return d;}function i(a,e){const f=a.context();const{lastOffset:g,lastStartLoc:h}=f;const d=c(5,g,h);d.index=parseInt(e,10);a.nextToken();b(d,a.currentOffset(),a.currentPosition());return d;}function j(a,e,f){const g=a.context();const{lastOffset:h,lastStartLoc:i}=g;const d=c(4,h,i);d.key=e;if(f===true){d.modulo=true;}a.nextToken();b(d,a.currentOffset(),a.currentPosition());return d;}function k(a,e){const f=a.context();const{lastOffset:g,lastStartLoc:h}=f;const d=c(9,g,h);d.value=e.replace(oc,od);a.nextToken();b(d,a.currentOffset(),a.currentPosition());return d;}function o(d){const f=d.nextToken();const g=d.context();const{lastOffset:i,lastStartLoc:j}=g;const e=c(8,i,j);if(f.type!==12){a(d,h.UNEXPECTED_EMPTY_LINKED_MODIFIER,g.lastStartLoc,0);e.value='';b(e,i,j);return{nextConsumeToken:f,node:e};}if(f.value==null){a(d,h.UNEXPECTED_LEXICAL_ANALYSIS,g.lastStartLoc,0,ar(f));}e.value=f.value||'';b(e,d.currentOffset(),d.currentPosition());return{node:e};}function p(a,f){const e=a.context();const d=c(7,e.offset,e.startLoc);d.value=f;b(d,a.currentOffset(),a.currentPosition());return d;}function q(e){const g=e.context();const f=c(6,g.offset,g.startLoc);let d=e.nextToken();if(d.type===9){const a=o(e);f.modifier=a.node;d=a.nextConsumeToken||e.nextToken();}if(d.type!==10){a(e,h.UNEXPECTED_LEXICAL_ANALYSIS,g.lastStartLoc,0,ar(d));}d=e.nextToken();if(d.type===2){d=e.nextToken();}switch(d.type){case 11:if(d.value==null){a(e,h.UNEXPECTED_LEXICAL_ANALYSIS,g.lastStartLoc,0,ar(d));}f.key=p(e,d.value||'');break;case 5:if(d.value==null){a(e,h.UNEXPECTED_LEXICAL_ANALYSIS,g.lastStartLoc,0,ar(d));}f.key=j(e,d.value||'');break;case 6:if(d.value==null){a(e,h.UNEXPECTED_LEXICAL_ANALYSIS,g.lastStartLoc,0,ar(d));}f.key=i(e,d.value||'');break;case 7:if(d.value==null){a(e,h.UNEXPECTED_LEXICAL_ANALYSIS,g.lastStartLoc,0,ar(d));}f.key=k(e,d.value||'');break;default:{a(e,h.UNEXPECTED_EMPTY_LINKED_KEY,g.lastStartLoc,0);const i=e.context();const j=c(7,i.offset,i.startLoc);j.value='';b(j,i.offset,i.startLoc);f.key=j;b(f,i.offset,i.startLoc);return{nextConsumeToken:d,node:f};}}b(f,e.currentOffset(),e.currentPosition());return{node:f};}function l(e){const d=e.context();const o=d.currentType===1?e.currentOffset():d.offset;const p=d.currentType===1?d.endLoc:d.startLoc;const f=c(2,o,p);f.items=[];let l=null;let g=null;do{const b=l||e.nextToken();l=null;switch(b.type){case 0:if(b.value==null){a(e,h.UNEXPECTED_LEXICAL_ANALYSIS,d.lastStartLoc,0,ar(b));}f.items.push(n(e,b.value||''));break;case 6:if(b.value==null){a(e,h.UNEXPECTED_LEXICAL_ANALYSIS,d.lastStartLoc,0,ar(b));}f.items.push(i(e,b.value||''));break;case 4:g=true;break;case 5:if(b.value==null){a(e,h.UNEXPECTED_LEXICAL_ANALYSIS,d.lastStartLoc,0,ar(b));}f.items.push(j(e,b.value||'',!!g));if(g){m(e,eU.USE_MODULO_SYNTAX,d.lastStartLoc,0,ar(b));g=null;}break;case 7:if(b.value==null){a(e,h.UNEXPECTED_LEXICAL_ANALYSIS,d.lastStartLoc,0,ar(b));}f.items.push(k(e,b.value||''));break;case 8:{const a=q(e);f.items.push(a.node);l=a.nextConsumeToken||null;break;}}}while(d.currentType!==14&&d.currentType!==1);const r=d.currentType===1?d.lastOffset:e.currentOffset();const s=d.currentType===1?d.lastEndLoc:e.currentPosition();b(f,r,s);return f;}function r(d,j,g,i){const k=d.context();let f=i.items.length===0;const e=c(1,j,g);e.cases=[];e.cases.push(i);do{const a=l(d);if(!f){f=a.items.length===0;}e.cases.push(a);}while(k.currentType!==14);if(f){a(d,h.MUST_HAVE_MESSAGES_IN_PLURAL,g,0);}b(e,d.currentOffset(),d.currentPosition());return e;}function s(a){const b=a.context();const{offset:d,startLoc:e}=b;const c=l(a);if(b.currentType===14){return c;}else{return r(a,d,e,c);}}function t(j){const g=oa(j,ik({},d));const i=g.context();const f=c(0,i.offset,i.startLoc);if(e&&f.loc){f.loc.source=j;}f.body=s(g);if(d.onCacheKey){f.cacheKey=d.onCacheKey(j);}if(i.currentType!==14){a(g,h.UNEXPECTED_LEXICAL_ANALYSIS,i.lastStartLoc,0,j[i.offset]||'');}b(f,g.currentOffset(),g.currentPosition());return f;}return{parse:t};}function ar(b){if(b.type===14){return'EOF';}const a=(b.value||'').replace(/\r?\n/gu,'\\n');return a.length>10?a.slice(0,9)+'…':a;}function og(b,e={}){const a={ast:b,helpers:new Set()};const c=()=>a;const d=(b)=>{a.helpers.add(b);return b;};return{context:c,helper:d};}function ip(a,b){for(let c=0;c<a.length;c++){eV(a[c],b);}}function eV(b,a){switch(b.type){case 1:ip(b.cases,a);a.helper("plural");break;case 2:ip(b.items,a);break;case 6:{const c=b;eV(c.key,a);a.helper("linked");a.helper("type");break;}case 5:a.helper("interpolate");a.helper("list");break;case 4:a.helper("interpolate");a.helper("named");break;}}function oh(a,d={}){const b=og(a);b.helper("normalize");a.body&&eV(a.body,b);const c=b.context();a.helpers=Array.from(c.helpers);}function oi(b){const a=b.body;if(a.type===2){iq(a);}else{a.cases.forEach(a=>iq(a));}return b;}function iq(a){if(a.items.length===1){const b=a.items[0];if(b.type===3||b.type===9){a.static=b.value;delete b.value;}}else{const b=[];for(let c=0;c<a.items.length;c++){const d=a.items[c];if(!(d.type===3||d.type===9)){break;}if(d.value==null){break;}b.push(d.value);}if(b.length===a.items.length){a.static=im(b);for(let b=0;b<a.items.length;b++){const c=a.items[b];if(c.type===3||c.type===9){delete c.value;}}}}}const oj='minifier';function bK(a){a.t=a.type;switch(a.type){case 0:{const b=a;bK(b.body);b.b=b.body;delete b.body;break;}case 1:{const b=a;const c=b.cases;for(let a=0;a<c.length;a++){bK(c[a]);}b.c=c;delete b.cases;break;}case 2:{const b=a;const c=b.items;for(let a=0;a<c.length;a++){bK(c[a]);}b.i=c;delete b.items;if(b.static){b.s=b.static;delete b.static;}break;}case 3:case 9:case 8:case 7:{const b=a;if(b.value){b.v=b.value;delete b.value;}break;}case 6:{const b=a;bK(b.key);b.k=b.key;delete b.key;if(b.modifier){bK(b.modifier);b.m=b.modifier;delete b.modifier;}break;}case 5:{const b=a;b.i=b.index;delete b.index;break;}case 4:{const b=a;b.k=b.key;delete b.key;break;}default:{throw bJ(h.UNHANDLED_MINIFIER_NODE_TYPE,null,{domain:oj,args:[a.type]});}}delete a.type;}const ok='parser';function ol(c,d){const{sourceMap:p,filename:h,breakLineCode:e,needIndent:f}=d;const i=d.location!==false;const a={filename:h,code:'',column:1,line:1,offset:0,map:undefined,breakLineCode:e,needIndent:f,indentLevel:0};if(i&&c.loc){a.source=c.loc.source;}const j=()=>a;function g(b,c){a.code+=b;}function b(b,c=true){const a=c?e:'';g(f?a+`  `.repeat(b):a);}function k(c=true){const d=++a.indentLevel;c&&b(d);}function l(c=true){const d=--a.indentLevel;c&&b(d);}function m(){b(a.indentLevel);}const n=(a)=>`_${a}`;const o=()=>a.needIndent;return{context:j,push:g,indent:k,deindent:l,newline:m,helper:n,needIndent:o};}function om(a,b){const{helper:c}=a;a.push(`${c("linked")}(`);bL(a,b.key);if(b.modifier){a.push(`, `);bL(a,b.modifier);a.push(`, _type`);}else{a.push(`, undefined, _type`);}a.push(`)`);}function on(a,b){const{helper:e,needIndent:c}=a;a.push(`${e("normalize")}([`);a.indent(c());const d=b.items.length;for(let c=0;c<d;c++){bL(a,b.items[c]);if(c===d-1){break;}a.push(', ');}a.deindent(c());a.push('])');}function oo(a,b){const{helper:d,needIndent:c}=a;if(b.cases.length>1){a.push(`${d("plural")}([`);a.indent(c());const e=b.cases.length;for(let c=0;c<e;c++){bL(a,b.cases[c]);if(c===e-1){break;}a.push(', ');}a.deindent(c());a.push(`])`);}}function op(a,b){if(b.body){bL(a,b.body);}else{a.push('null');}}function bL(b,a){const{helper:c}=b;switch(a.type){case 0:op(b,a);break;case 1:oo(b,a);break;case 2:on(b,a);break;case 6:om(b,a);break;case 8:b.push(JSON.stringify(a.value),a);break;case 7:b.push(JSON.stringify(a.value),a);break;case 5:b.push(`${c("interpolate")}(${c("list")}(${a.index}))`,a);break;case 4:b.push(`${c("interpolate")}(${c("named")}(${JSON.stringify(a.key)}))`,a);break;case 9:b.push(JSON.stringify(a.value),a);break;case 3:b.push(JSON.stringify(a.value),a);break;default:{throw bJ(h.UNHANDLED_CODEGEN_NODE_TYPE,null,{domain:ok,args:[a.type]});}}}const oq=(c,a={})=>{const d=il(a.mode)?a.mode:'normal';const h=il(a.filename)?a.filename:'message.intl';const i=!!a.sourceMap;const j=a.breakLineCode!=null?a.breakLineCode:d==='arrow'?';':'\n';const e=a.needIndent?a.needIndent:d!=='arrow';const f=c.helpers||[];const b=ol(c,{mode:d,filename:h,sourceMap:i,breakLineCode:j,needIndent:e});b.push(d==='normal'?`function __msg__ (ctx) {`:`(ctx) => {`);b.indent(e);if(f.length>0){b.push(`const { ${im(f.map(a=>`${a}: _${a}`),', ')} } = ctx`);b.newline();}b.push(`return `);bL(b,c);b.deindent(e);b.push(`}`);delete c.helpers;const{code:k,map:g}=b.context();return{ast:c,code:k,map:g?g.toJSON():undefined};};function or(c,d={}){const a=ik({},d);const e=!!a.jit;const f=!!a.minify;const g=a.optimize==null?true:a.optimize;const h=oe(a);const b=h.parse(c);if(!e){oh(b,a);return oq(b,a);}else{g&&oi(b);f&&bK(b);return{ast:b,code:''};}}function os(){if(typeof __INTLIFY_PROD_DEVTOOLS__!=='boolean'){aO().__INTLIFY_PROD_DEVTOOLS__=false;}if(typeof __INTLIFY_JIT_COMPILATION__!=='boolean'){aO().__INTLIFY_JIT_COMPILATION__=false;}if(typeof __INTLIFY_DROP_MESSAGE_COMPILER__!=='boolean'){aO().__INTLIFY_DROP_MESSAGE_COMPILER__=false;}}function aC(a){return(p(a)&&eW(a)===0&&(aq(a,'b')||aq(a,'body')));}const ir=['b','body'];function ot(a){return a4(a,ir);}const is=['c','cases'];function ou(a){return a4(a,is,[]);}const it=['s','static'];function ov(a){return a4(a,it);}const iu=['i','items'];function ow(a){return a4(a,iu,[]);}const iv=['t','type'];function eW(a){return a4(a,iv);}const iw=['v','value'];function dw(b,c){const a=a4(b,iw);if(a!=null){return a;}else{throw cs(c);}}const ix=['m','modifier'];function ox(a){return a4(a,ix);}const iy=['k','key'];function oy(b){const a=a4(b,iy);if(a){return a;}else{throw cs(6);}}function a4(a,b,c){for(let c=0;c<b.length;c++){const d=b[c];if(aq(a,d)&&a[d]!=null){return a[d];}}return c;}const iz=[...ir,...is,...it,...iu,...iy,...ix,...iw,...iv];function cs(a){return new Error(`unhandled node type: ${a}`);}const a5=[];a5[0]={["w"]:[0],["i"]:[3,0],["["]:[4],["o"]:[7]};a5[1]={["w"]:[1],["."]:[2],["["]:[4],["o"]:[7]};a5[2]={["w"]:[2],["i"]:[3,0],["0"]:[3,0]};a5[3]={["i"]:[3,0],["0"]:[3,0],["w"]:[1,1],["."]:[2,1],["["]:[4,1],["o"]:[7,1]};a5[4]={["'"]:[5,0],["\""]:[6,0],["["]:[4,2],["]"]:[1,3],["o"]:8,["l"]:[4,0]};a5[5]={["'"]:[4,0],["o"]:8,["l"]:[5,0]};a5[6]={["\""]:[4,0],["o"]:8,["l"]:[6,0]};const oz=/^\s?(?:true|false|-?[\d.]+|'[^']*'|"[^"]*")\s?$/;function oA(a){return oz.test(a);}function oB(a){const b=a.charCodeAt(0);const c=a.charCodeAt(a.length-1);return b===c&&(b===0x22||b===0x27)?a.slice(1,-1):a;}function oC(a){if(a===undefined||a===null){return"o";}const b=a.charCodeAt(0);switch(b){case 0x5b:case 0x5d:case 0x2e:case 0x22:case 0x27:return a;case 0x5f:case 0x24:case 0x2d:return"i";case 0x09:case 0x0a:case 0x0d:case 0xa0:case 0xfeff:case 0x2028:case 0x2029:return"w";}return"i";}function oD(a){const b=a.trim();if(a.charAt(0)==='0'&&isNaN(parseInt(a))){return false;}return oA(b)?oB(b):"*"+b;}function oE(k){const l=[];let e=-1;let c=0;let f=0;let g;let a;let h;let m;let d;let i;let j;const b=[];b[0]=()=>{if(a===undefined){a=h;}else{a+=h;}};b[1]=()=>{if(a!==undefined){l.push(a);a=undefined;}};b[2]=()=>{b[0]();f++;};b[3]=()=>{if(f>0){f--;c=4;b[0]();}else{f=0;if(a===undefined){return false;}a=oD(a);if(a===false){return false;}else{b[1]();}}};function n(){const a=k[e+1];if((c===5&&a==="'")||(c===6&&a==="\"")){e++;h='\\'+a;b[0]();return true;}}while(c!==null){e++;g=k[e];if(g==='\\'&&n()){continue;}m=oC(g);j=a5[c];d=j[m]||j["l"]||8;if(d===8){return;}c=d[0];if(d[1]!==undefined){i=b[d[1]];if(i){h=g;if(i()===false){return;}}}if(c===7){return l;}}}const iA=new Map();function oF(a,b){return p(a)?a[b]:null;}function oG(e,c){if(!p(e)){return null;}let a=iA.get(c);if(!a){a=oE(c);if(a){iA.set(c,a);}}if(!a){return null;}const f=a.length;let b=e;let d=0;while(d<f){const c=a[d];if(iz.includes(c)&&aC(b)){return null;}const e=b[c];if(e===undefined){return null;}if(v(b)){return null;}b=e;d++;}return b;}const oH=(a)=>a;const oI=(a)=>'';const oJ='text';const oK=(a)=>a.length===0?'':nY(a);const oL=nX;function iB(a,b){a=Math.abs(a);if(b===2){return a?a>1?1:0:1;}return a?Math.min(a,2):0;}function oM(a){const b=F(a.pluralIndex)?a.pluralIndex:-1;return a.named&&(F(a.named.count)||F(a.named.n))?F(a.named.count)?a.named.count:F(a.named.n)?a.named.n:b:b;}function oN(b,a){if(!a.count){a.count=b;}if(!a.n){a.n=b;}}function oO(a={}){const b=a.locale;const e=oM(a);const i=p(a.pluralRules)&&d(b)&&v(a.pluralRules[b])?a.pluralRules[b]:iB;const j=p(a.pluralRules)&&d(b)&&v(a.pluralRules[b])?iB:undefined;const l=(a)=>{return a[i(e,a.length,j)];};const f=a.list||[];const m=(a)=>f[a];const c=a.named||s();F(a.pluralIndex)&&oN(e,c);const n=(a)=>c[a];function g(b){const c=v(a.messages)?a.messages(b):p(a.messages)?a.messages[b]:false;return!c?a.parent?a.parent.message(b):oI:c;}const o=(b)=>a.modifiers?a.modifiers[b]:oH;const q=k(a.processor)&&v(a.processor.normalize)?a.processor.normalize:oK;const r=k(a.processor)&&v(a.processor.interpolate)?a.processor.interpolate:oL;const t=k(a.processor)&&d(a.processor.type)?a.processor.type:oJ;const u=(k,...e)=>{const[b,i]=e;let c='text';let a='';if(e.length===1){if(p(b)){a=b.modifier||a;c=b.type||c;}else if(d(b)){a=b||a;}}else if(e.length===2){if(d(b)){a=b||a;}if(d(i)){c=i||c;}}const f=g(k)(h);const j=c==='vnode'&&z(f)&&a?f[0]:f;return a?o(a)(j,c):j;};const h={["list"]:m,["named"]:n,["plural"]:l,["linked"]:u,["message"]:g,["type"]:t,["interpolate"]:r,["normalize"]:q,["values"]:O(s(),f,c)};return h;}let ct=null;function oP(a){ct=a;}function oQ(a,b,c){ct&&ct.emit("i18n:init",{timestamp:Date.now(),i18n:a,version:b,meta:c});}const oR=oS("function:translate");function oS(a){return(b)=>ct&&ct.emit(a,b);}const iC=eU.__EXTEND_POINT__;const bs=ds(iC);const oT={NOT_FOUND_KEY:iC,FALLBACK_TO_TRANSLATE:bs(),CANNOT_FORMAT_NUMBER:bs(),FALLBACK_TO_NUMBER_FORMAT:bs(),CANNOT_FORMAT_DATE:bs(),FALLBACK_TO_DATE_FORMAT:bs(),EXPERIMENTAL_CUSTOM_MESSAGE_COMPILER:bs(),__EXTEND_POINT__:bs()};const iD=h.__EXTEND_POINT__;const bt=ds(iD);const at={INVALID_ARGUMENT:iD,INVALID_DATE_ARGUMENT:bt(),INVALID_ISO_DATE_ARGUMENT:bt(),NOT_SUPPORT_NON_STRING_MESSAGE:bt(),NOT_SUPPORT_LOCALE_PROMISE_VALUE:bt(),NOT_SUPPORT_LOCALE_ASYNC_FUNCTION:bt(),NOT_SUPPORT_LOCALE_TYPE:bt(),__EXTEND_POINT__:bt()};function aD(a){return bJ(a,null,undefined);}function eX(b,a){return a.locale!=null?iE(a.locale):iE(b.locale);}let eY;function iE(a){if(d(a)){return a;}else{if(v(a)){if(a.resolvedOnce&&eY!=null){return eY;}else if(a.constructor.name==='Function'){const b=a();if(nW(b)){throw aD(at.NOT_SUPPORT_LOCALE_PROMISE_VALUE);}return(eY=b);}else{throw aD(at.NOT_SUPPORT_LOCALE_ASYNC_FUNCTION);}}else{throw aD(at.NOT_SUPPORT_LOCALE_TYPE);}}}function oU(c,a,b){return[...new Set([b,...(z(a)?a:p(a)?Object.keys(a):d(a)?[a]:[b])])];}function iF(g,a,e){const f=d(e)?e:bM;const c=g;if(!c.__localeChainCache){c.__localeChainCache=new Map();}let b=c.__localeChainCache.get(f);if(!b){b=[];let g=[e];while(z(g)){g=iG(b,g,a);}const h=z(a)||!k(a)?a:a['default']?a['default']:null;g=d(h)?[h]:h;if(z(g)){iG(b,g,false);}c.__localeChainCache.set(f,b);}return b;}function iG(c,a,e){let b=true;for(let f=0;f<a.length&&o(b);f++){const g=a[f];if(d(g)){b=oV(c,a[f],e);}}return b;}function oV(c,d,e){let a;const b=d.split('-');do{const d=b.join('-');a=oW(c,d,e);b.splice(-1,1);}while(b.length&&a===true);return a;}function oW(d,a,b){let c=false;if(!d.includes(a)){c=true;if(a){c=a[a.length-1]!=='!';const e=a.replace(/!/g,'');d.push(e);if((z(b)||k(b))&&b[e]){c=b[e];}}}return c;}const oX='9.14.5';const dx=-1;const bM='en-US';const iH='';const iI=(a)=>`${a.charAt(0).toLocaleUpperCase()}${a.substr(1)}`;function oY(){return{upper:(a,b)=>{return b==='text'&&d(a)?a.toUpperCase():b==='vnode'&&p(a)&&'__v_isVNode'in a?a.children.toUpperCase():a;},lower:(a,b)=>{return b==='text'&&d(a)?a.toLowerCase():b==='vnode'&&p(a)&&'__v_isVNode'in a?a.children.toLowerCase():a;},capitalize:(a,b)=>{return(b==='text'&&d(a)?iI(a):b==='vnode'&&p(a)&&'__v_isVNode'in a?iI(a.children):a);}};}let iJ;function iK(a){iJ=a;}let iL;function oZ(a){iL=a;}let iM;function o$(a){iM=a;}let iN=null;const o_=(a)=>{iN=a;};const o0=()=>iN;let iO=null;const iP=(a)=>{iO=a;};const o1=()=>iO;let iQ=0;function o2(a={}){const i=v(a.onWarn)?a.onWarn:nP;const g=d(a.version)?a.version:oX;const f=d(a.locale)||v(a.locale)?a.locale:bM;const e=v(f)?bM:f;const j=z(a.fallbackLocale)||k(a.fallbackLocale)||d(a.fallbackLocale)||a.fallbackLocale===false?a.fallbackLocale:e;const l=k(a.messages)?a.messages:eZ(e);const m=k(a.datetimeFormats)?a.datetimeFormats:eZ(e);const n=k(a.numberFormats)?a.numberFormats:eZ(e);const q=O(s(),a.modifiers,oY());const r=a.pluralRules||s();const t=v(a.missing)?a.missing:null;const u=o(a.missingWarn)||a2(a.missingWarn)?a.missingWarn:true;const w=o(a.fallbackWarn)||a2(a.fallbackWarn)?a.fallbackWarn:true;const x=!!a.fallbackFormat;const y=!!a.unresolving;const A=v(a.postTranslation)?a.postTranslation:null;const B=k(a.processor)?a.processor:null;const C=o(a.warnHtmlMessage)?a.warnHtmlMessage:true;const D=!!a.escapeParameter;const E=v(a.messageCompiler)?a.messageCompiler:iJ;const F=v(a.messageResolver)?a.messageResolver:iL||oF;const G=v(a.localeFallbacker)?a.localeFallbacker:iM||oU;const H=p(a.fallbackContext)?a.fallbackContext:undefined;const b=a;const I=p(b.__datetimeFormatters)?b.__datetimeFormatters:new Map();const J=p(b.__numberFormatters)?b.__numberFormatters:new Map();const h=p(b.__meta)?b.__meta:{};iQ++;const c={version:g,cid:iQ,locale:f,fallbackLocale:j,messages:l,modifiers:q,pluralRules:r,missing:t,missingWarn:u,fallbackWarn:w,fallbackFormat:x,unresolving:y,postTranslation:A,processor:B,warnHtmlMessage:C,escapeParameter:D,messageCompiler:E,messageResolver:F,localeFallbacker:G,fallbackContext:H,onWarn:i,__meta:h};{c.datetimeFormats=m;c.numberFormats=n;c.__datetimeFormatters=I;c.__numberFormatters=J;}if(__INTLIFY_PROD_DEVTOOLS__){oQ(c,g,h);}return c;}const eZ=(a)=>({[a]:s()});function e$(b,a,e,g,f){const{missing:c,onWarn:h}=b;if(c!==null){const g=c(b,e,a,f);return d(g)?g:a;}else{return a;}}function cu(a,b,c){const d=a;d.__localeChainCache=new Map();a.localeFallbacker(a,c,b);}function o3(a,b){if(a===b)return false;return a.split('-')[0]===b.split('-')[0];}function o4(b,a){const c=a.indexOf(b);if(c===-1){return false;}for(let d=c+1;d<a.length;d++){if(o3(b,a[d])){return true;}}return false;}function e_(a){const b=(b)=>o5(b,a);return b;}function o5(b,c){const a=ot(c);if(a==null){throw cs(0);}const d=eW(a);if(d===1){const c=a;const d=ou(c);return b.plural(d.reduce((a,c)=>[...a,iR(b,c)],[]));}else{return iR(b,a);}}function iR(a,c){const b=ov(c);if(b!=null){return a.type==='text'?b:a.normalize([b]);}else{const b=ow(c).reduce((b,c)=>[...b,e0(a,c)],[]);return a.normalize(b);}}function e0(a,b){const c=eW(b);switch(c){case 3:{return dw(b,c);}case 9:{return dw(b,c);}case 4:{const d=b;if(aq(d,'k')&&d.k){return a.interpolate(a.named(d.k));}if(aq(d,'key')&&d.key){return a.interpolate(a.named(d.key));}throw cs(c);}case 5:{const d=b;if(aq(d,'i')&&F(d.i)){return a.interpolate(a.list(d.i));}if(aq(d,'index')&&F(d.index)){return a.interpolate(a.list(d.index));}throw cs(c);}case 6:{const c=b;const d=ox(c);const e=oy(c);return a.linked(e0(a,e),d?e0(a,d):undefined,a.type);}case 7:{return dw(b,c);}case 8:{return dw(b,c);}default:throw new Error(`unhandled node on format message part: ${c}`);}}const iS=(a)=>a;let bN=s();function iT(c,a={}){let b=false;const d=a.onError||n3;a.onError=(a)=>{b=true;d(a);};return{...or(c,a),detectError:b};}const o6=(b,a)=>{if(!d(b)){throw aD(at.NOT_SUPPORT_NON_STRING_MESSAGE);}{o(a.warnHtmlMessage)?a.warnHtmlMessage:true;const f=a.onCacheKey||iS;const c=f(b);const d=bN[c];if(d){return d;}const{code:g,detectError:h}=iT(b,a);const e=new Function(`return ${g}`)();return!h?(bN[c]=e):e;}};function o7(a,b){if(((__INTLIFY_JIT_COMPILATION__&&!__INTLIFY_DROP_MESSAGE_COMPILER__))&&d(a)){o(b.warnHtmlMessage)?b.warnHtmlMessage:true;const f=b.onCacheKey||iS;const c=f(a);const d=bN[c];if(d){return d;}const{ast:g,detectError:h}=iT(a,{...b,location:("production"!=='production'),jit:true});const e=e_(g);return!h?(bN[c]=e):e;}else{const b=a.cacheKey;if(b){const c=bN[b];if(c){return c;}return(bN[b]=e_(a));}else{return e_(a);}}}const iU=()=>'';const ak=(a)=>v(a);function iV(e,...t){const{fallbackFormat:j,postTranslation:k,unresolving:u,messageCompiler:l,fallbackLocale:v,messages:w}=e;const[c,b]=e1(...t);const x=o(b.missingWarn)?b.missingWarn:e.missingWarn;const y=o(b.fallbackWarn)?b.fallbackWarn:e.fallbackWarn;const m=o(b.escapeParameter)?b.escapeParameter:e.escapeParameter;const h=!!b.resolvedMessage;const n=d(b.default)||o(b.default)?!o(b.default)?b.default:(!l?()=>c:c):j?(!l?()=>c:c):'';const z=j||n!=='';const i=eX(e,b);m&&o8(b);let[A,g,B]=!h?iW(e,c,i,v,y,x):[c,i,w[i]||s()];let a=A;let p=c;if(!h&&!(d(a)||aC(a)||ak(a))){if(z){a=n;p=a;}}if(!h&&(!(d(a)||aC(a)||ak(a))||!d(g))){return u?dx:c;}let q=false;const C=()=>{q=true;};const D=!ak(a)?iX(e,c,g,a,p,C):a;if(q){return a;}const E=pb(e,g,B,b);const F=oO(E);const r=o9(e,D,F);let f=k?k(r,c):r;if(m&&d(f)){f=nU(f);}if(__INTLIFY_PROD_DEVTOOLS__){const b={timestamp:Date.now(),key:d(c)?c:ak(a)?a.key:'',locale:g||(ak(a)?a.locale:''),format:d(a)?a:ak(a)?a.source:'',message:f};b.meta=O({},e.__meta,o0()||{});oR(b);}return f;}function o8(a){if(z(a.list)){a.list=a.list.map(a=>d(a)?ie(a):a);}else if(p(a.named)){Object.keys(a.named).forEach(b=>{if(d(a.named[b])){a.named[b]=ie(a.named[b]);}});}}function iW(f,c,h,i,o,j){const{messages:k,onWarn:p,messageResolver:l,localeFallbacker:m}=f;const g=m(f,i,h);let e=s();let b;let a=null;const n='translate';for(let h=0;h<g.length;h++){b=g[h];e=k[b]||s();if((a=l(e,c))===null){a=e[c];}if(d(a)||aC(a)||ak(a)){break;}if(!o4(b,g)){const d=e$(f,c,b,j,n);if(d!==c){a=d;}}}return[a,b,e];}function iX(e,d,b,a,g,h){const{messageCompiler:f,warnHtmlMessage:i}=e;if(ak(a)){const c=a;c.locale=c.locale||b;c.key=c.key||d;return c;}if(f==null){const c=(()=>a);c.locale=b;c.key=d;return c;}const c=f(a,pa(e,b,g,a,i,h));c.locale=b;c.key=d;c.source=a;return c;}function o9(d,a,b){const c=a(b);return c;}function e1(...f){const[a,b,e]=f;const c=s();if(!d(a)&&!F(a)&&!ak(a)&&!aC(a)){throw aD(at.INVALID_ARGUMENT);}const g=F(a)?String(a):ak(a)?a:a;if(F(b)){c.plural=b;}else if(d(b)){c.default=b;}else if(k(b)&&!dr(b)){c.named=b;}else if(z(b)){c.list=b;}if(F(e)){c.plural=e;}else if(d(e)){c.default=e;}else if(k(e)){O(c,e);}return[g,c];}function pa(e,a,b,f,d,c){return{locale:a,key:b,warnHtmlMessage:d,onError:(a)=>{c&&c(a);{throw a;}},onCacheKey:(c)=>nQ(a,b,c)};}function pb(c,e,h,a){const{modifiers:i,pluralRules:j,messageResolver:f,fallbackLocale:k,fallbackWarn:l,missingWarn:m,fallbackContext:g}=c;const n=(b)=>{let a=f(h,b);if(a==null&&g){const[,,c]=iW(g,b,e,k,l,m);a=f(c,b);}if(d(a)||aC(a)){let d=false;const f=()=>{d=true;};const g=iX(c,b,e,a,b,f);return!d?g:iU;}else if(ak(a)){return a;}else{return iU;}};const b={locale:e,modifiers:i,pluralRules:j,messages:n};if(c.processor){b.processor=c.processor;}if(a.list){b.list=a.list;}if(a.named){b.named=a.named;}if(F(a.plural)){b.pluralIndex=a.plural;}return b;}function iY(a,...q){const{datetimeFormats:r,unresolving:s,fallbackLocale:t,onWarn:y,localeFallbacker:u}=a;const{__datetimeFormatters:l}=a;const[b,j,c,g]=e2(...q);const v=o(c.missingWarn)?c.missingWarn:a.missingWarn;o(c.fallbackWarn)?c.fallbackWarn:a.fallbackWarn;const w=!!c.part;const m=eX(a,c);const n=u(a,t,m);if(!d(b)||b===''){return new Intl.DateTimeFormat(m,g).format(j);}let p={};let e;let h=null;const x='datetime format';for(let c=0;c<n.length;c++){e=n[c];p=r[e]||{};h=p[b];if(k(h))break;e$(a,b,e,v,x);}if(!k(h)||!d(e)){return s?dx:b;}let i=`${e}__${b}`;if(!dr(g)){i=`${i}__${JSON.stringify(g)}`;}let f=l.get(i);if(!f){f=new Intl.DateTimeFormat(e,O({},h,g));l.set(i,f);}return!w?f.format(j):f.formatToParts(j);}const iZ=['localeMatcher','weekday','era','year','month','day','hour','minute','second','timeZoneName','formatMatcher','hour12','timeZone','dateStyle','timeStyle','calendar','dayPeriod','numberingSystem','hourCycle','fractionalSecondDigits'];function e2(...i){const[a,b,f,h]=i;const c=s();let g=s();let e;if(d(a)){const b=a.match(/(\d{4}-\d{2}-\d{2})(T|\s)?(.*)/);if(!b){throw aD(at.INVALID_ISO_DATE_ARGUMENT);}const c=b[3]?b[3].trim().startsWith('T')?`${b[1].trim()}${b[3].trim()}`:`${b[1].trim()}T${b[3].trim()}`:b[1].trim();e=new Date(c);try{e.toISOString();}catch(a){throw aD(at.INVALID_ISO_DATE_ARGUMENT);}}else if(nS(a)){if(isNaN(a.getTime())){throw aD(at.INVALID_DATE_ARGUMENT);}e=a;}else if(F(a)){e=a;}else{throw aD(at.INVALID_ARGUMENT);}if(d(b)){c.key=b;}else if(k(b)){Object.keys(b).forEach(a=>{if(iZ.includes(a)){g[a]=b[a];}else{c[a]=b[a];}});}if(d(f)){c.locale=f;}else if(k(f)){g=f;}if(k(h)){g=h;}return[c.key||'',e,c,g];}function i$(b,c,d){const a=b;for(const b in d){const d=`${c}__${b}`;if(!a.__datetimeFormatters.has(d)){continue;}a.__datetimeFormatters.delete(d);}}function i_(a,...q){const{numberFormats:r,unresolving:s,fallbackLocale:t,onWarn:y,localeFallbacker:u}=a;const{__numberFormatters:l}=a;const[b,j,c,g]=e3(...q);const v=o(c.missingWarn)?c.missingWarn:a.missingWarn;o(c.fallbackWarn)?c.fallbackWarn:a.fallbackWarn;const w=!!c.part;const m=eX(a,c);const n=u(a,t,m);if(!d(b)||b===''){return new Intl.NumberFormat(m,g).format(j);}let p={};let e;let h=null;const x='number format';for(let c=0;c<n.length;c++){e=n[c];p=r[e]||{};h=p[b];if(k(h))break;e$(a,b,e,v,x);}if(!k(h)||!d(e)){return s?dx:b;}let i=`${e}__${b}`;if(!dr(g)){i=`${i}__${JSON.stringify(g)}`;}let f=l.get(i);if(!f){f=new Intl.NumberFormat(e,O({},h,g));l.set(i,f);}return!w?f.format(j):f.formatToParts(j);}const i0=['localeMatcher','style','currency','currencyDisplay','currencySign','useGrouping','minimumIntegerDigits','minimumFractionDigits','maximumFractionDigits','minimumSignificantDigits','maximumSignificantDigits','compactDisplay','notation','signDisplay','unit','unitDisplay','roundingMode','roundingPriority','roundingIncrement','trailingZeroDisplay'];function e3(...h){const[f,a,c,g]=h;const b=s();let e=s();if(!F(f)){throw aD(at.INVALID_ARGUMENT);}const i=f;if(d(a)){b.key=a;}else if(k(a)){Object.keys(a).forEach(c=>{if(i0.includes(c)){e[c]=a[c];}else{b[c]=a[c];}});}if(d(c)){b.locale=c;}else if(k(c)){e=c;}if(k(g)){e=g;}return[b.key||'',i,b,e];}function i1(b,c,d){const a=b;for(const b in d){const d=`${c}__${b}`;if(!a.__numberFormatters.has(d)){continue;}a.__numberFormatters.delete(d);}}{os();}const pc='9.14.5';function pd(){if(typeof __VUE_I18N_FULL_INSTALL__!=='boolean'){aO().__VUE_I18N_FULL_INSTALL__=true;}if(typeof __VUE_I18N_LEGACY_API__!=='boolean'){aO().__VUE_I18N_LEGACY_API__=true;}if(typeof __INTLIFY_JIT_COMPILATION__!=='boolean'){aO().__INTLIFY_JIT_COMPILATION__=false;}if(typeof __INTLIFY_DROP_MESSAGE_COMPILER__!=='boolean'){aO().__INTLIFY_DROP_MESSAGE_COMPILER__=false;}if(typeof __INTLIFY_PROD_DEVTOOLS__!=='boolean'){aO().__INTLIFY_PROD_DEVTOOLS__=false;}}const i2=oT.__EXTEND_POINT__;const aQ=ds(i2);({FALLBACK_TO_ROOT:i2,NOT_SUPPORTED_PRESERVE:aQ(),NOT_SUPPORTED_FORMATTER:aQ(),NOT_SUPPORTED_PRESERVE_DIRECTIVE:aQ(),NOT_SUPPORTED_GET_CHOICE_INDEX:aQ(),COMPONENT_NAME_LEGACY_COMPATIBLE:aQ(),NOT_FOUND_PARENT_SCOPE:aQ(),IGNORE_OBJ_FLATTEN:aQ(),NOTICE_DROP_ALLOW_COMPOSITION:aQ(),NOTICE_DROP_TRANSLATE_EXIST_COMPATIBLE_FLAG:aQ()});const i3=at.__EXTEND_POINT__;const _=ds(i3);const K={UNEXPECTED_RETURN_TYPE:i3,INVALID_ARGUMENT:_(),MUST_BE_CALL_SETUP_TOP:_(),NOT_INSTALLED:_(),NOT_AVAILABLE_IN_LEGACY_MODE:_(),REQUIRED_VALUE:_(),INVALID_VALUE:_(),CANNOT_SETUP_VUE_DEVTOOLS_PLUGIN:_(),NOT_INSTALLED_WITH_PROVIDE:_(),UNEXPECTED_ERROR:_(),NOT_COMPATIBLE_LEGACY_VUE_I18N:_(),BRIDGE_SUPPORT_VUE_2_ONLY:_(),MUST_DEFINE_I18N_OPTION_IN_ALLOW_COMPOSITION:_(),NOT_AVAILABLE_COMPOSITION_IN_LEGACY:_(),__EXTEND_POINT__:_()};function M(a,...b){return bJ(a,null,undefined);}const e4=a1('__translateVNode');const e5=a1('__datetimeParts');const e6=a1('__numberParts');const i4=a1('__setPluralRules');const i5=a1('__injectWithOption');const e7=a1('__dispose');function cv(a){if(!p(a)){return a;}if(aC(a)){return a;}for(const b in a){if(!aq(a,b)){continue;}if(!b.includes('.')){if(p(a[b])){cv(a[b]);}}else{const c=b.split('.');const e=c.length-1;let d=a;let f=false;for(let a=0;a<e;a++){if(c[a]==='__proto__'){throw new Error(`unsafe key: ${c[a]}`);}if(!(c[a]in d)){d[c[a]]=s();}if(!p(d[c[a]])){f=true;break;}d=d[c[a]];}if(!f){if(!aC(d)){d[c[e]]=a[b];delete a[b];}else{if(!iz.includes(c[e])){delete a[b];}}}if(!aC(d)){const a=d[c[e]];if(p(a)){cv(a);}}}}return a;}function dy(e,f){const{messages:c,__i18n:b,messageResolver:g,flatJson:h}=f;const a=(k(c)?c:z(b)?s():{[e]:s()});if(z(b)){b.forEach(b=>{if('locale'in b&&'resource'in b){const{locale:c,resource:d}=b;if(c){a[c]=a[c]||s();du(d,a[c]);}else{du(d,a);}}else{d(b)&&du(JSON.parse(b),a);}});}if(g==null&&h){for(const b in a){if(aq(a,b)){cv(a[b]);}}}return a;}function i6(a){return a.type;}function i7(b,a,d){let c=p(a.messages)?a.messages:s();if('__i18nGlobal'in d){c=dy(b.locale.value,{messages:c,__i18n:d.__i18nGlobal});}const e=Object.keys(c);if(e.length){e.forEach(a=>{b.mergeLocaleMessage(a,c[a]);});}{if(p(a.datetimeFormats)){const c=Object.keys(a.datetimeFormats);if(c.length){c.forEach(c=>{b.mergeDateTimeFormat(c,a.datetimeFormats[c]);});}}if(p(a.numberFormats)){const c=Object.keys(a.numberFormats);if(c.length){c.forEach(c=>{b.mergeNumberFormat(c,a.numberFormats[c]);});}}}}function i8(a){return e(b7,null,a,0);}const i9='__INTLIFY_META__';const ja=()=>[];const pe=()=>false;let jb=0;function jc(a){return((e,b,c,d)=>{return a(b,c,i()||undefined,d);});}const pf=()=>{const a=i();let b=null;return a&&(b=i6(a)[i9])?{[i9]:b}:null;};function e8(b={},ax){const{__root:g,__injectWithOption:N}=b;const s=g===undefined;const G=b.flatJson;const t=dq?c:fO;const P=!!b.translateExistCompatible;let r=o(b.inheritLocale)?b.inheritLocale:true;const h=t(g&&r?g.locale.value:d(b.locale)?b.locale:bM);const j=t(g&&r?g.fallbackLocale.value:d(b.fallbackLocale)||z(b.fallbackLocale)||k(b.fallbackLocale)||b.fallbackLocale===false?b.fallbackLocale:h.value);const l=t(dy(h.value,b));const m=t(k(b.datetimeFormats)?b.datetimeFormats:{[h.value]:{}});const n=t(k(b.numberFormats)?b.numberFormats:{[h.value]:{}});let w=g?g.missingWarn:o(b.missingWarn)||a2(b.missingWarn)?b.missingWarn:true;let x=g?g.fallbackWarn:o(b.fallbackWarn)||a2(b.fallbackWarn)?b.fallbackWarn:true;let C=g?g.fallbackRoot:o(b.fallbackRoot)?b.fallbackRoot:true;let y=!!b.fallbackFormat;let H=v(b.missing)?b.missing:null;let A=v(b.missing)?jc(b.missing):null;let u=v(b.postTranslation)?b.postTranslation:null;let D=g?g.warnHtmlMessage:o(b.warnHtmlMessage)?b.warnHtmlMessage:true;let E=!!b.escapeParameter;const I=g?g.modifiers:k(b.modifiers)?b.modifiers:{};let B=b.pluralRules||(g&&g.pluralRules);let e;const Q=()=>{s&&iP(null);const a={version:pc,locale:h.value,fallbackLocale:j.value,messages:l.value,modifiers:I,pluralRules:B,missing:A===null?undefined:A,missingWarn:w,fallbackWarn:x,fallbackFormat:y,unresolving:true,postTranslation:u===null?undefined:u,warnHtmlMessage:D,escapeParameter:E,messageResolver:b.messageResolver,messageCompiler:b.messageCompiler,__meta:{framework:'vue'}};{a.datetimeFormats=m.value;a.numberFormats=n.value;a.__datetimeFormatters=k(e)?e.__datetimeFormatters:undefined;a.__numberFormatters=k(e)?e.__numberFormatters:undefined;}const c=o2(a);s&&iP(c);return c;};e=Q();cu(e,h.value,j.value);function R(){return[h.value,j.value,l.value,m.value,n.value];}const S=a({get:()=>h.value,set:a=>{h.value=a;e.locale=h.value;}});const T=a({get:()=>j.value,set:a=>{j.value=a;e.fallbackLocale=j.value;cu(e,h.value,a);}});const U=a(()=>l.value);const V=a(()=>m.value);const W=a(()=>n.value);function X(){return v(u)?u:null;}function Y(a){u=a;e.postTranslation=a;}function Z(){return H;}function $(a){if(a!==null){A=jc(a);}H=a;e.missing=A;}const q=(c,d,b,f,h,i)=>{R();let a;try{if(("production"!=='production')||__INTLIFY_PROD_DEVTOOLS__){o_(pf());}if(!s){e.fallbackContext=g?o1():undefined;}a=c(e);}finally{if(__INTLIFY_PROD_DEVTOOLS__);if(!s){e.fallbackContext=undefined;}}if((b!=='translate exists'&&F(a)&&a===dx)||(b==='translate exists'&&!a)){const[a,b]=d();return g&&C?f(g):h(a);}else if(i(a)){return a;}else{throw M(K.UNEXPECTED_RETURN_TYPE);}};function J(...a){return q(b=>Reflect.apply(iV,null,[b,...a]),()=>e1(...a),'translate',b=>Reflect.apply(b.t,b,[...a]),a=>a,a=>d(a));}function _(...b){const[c,d,a]=b;if(a&&!p(a)){throw M(K.INVALID_ARGUMENT);}return J(...[c,d,O({resolvedMessage:true},a||{})]);}function aa(...a){return q(b=>Reflect.apply(iY,null,[b,...a]),()=>e2(...a),'datetime format',b=>Reflect.apply(b.d,b,[...a]),()=>iH,a=>d(a));}function ab(...a){return q(b=>Reflect.apply(i_,null,[b,...a]),()=>e3(...a),'number format',b=>Reflect.apply(b.n,b,[...a]),()=>iH,a=>d(a));}function ac(a){return a.map(a=>d(a)||F(a)||o(a)?i8(String(a)):a);}const ad=(a)=>a;const ae={normalize:ac,interpolate:ad,type:'vnode'};function af(...a){return q(d=>{let c;const b=d;try{b.processor=ae;c=Reflect.apply(iV,null,[b,...a]);}finally{b.processor=null;}return c;},()=>e1(...a),'translate',b=>b[e4](...a),a=>[i8(a)],a=>z(a));}function ag(...a){return q(b=>Reflect.apply(i_,null,[b,...a]),()=>e3(...a),'number format',b=>b[e6](...a),ja,a=>d(a)||z(a));}function ah(...a){return q(b=>Reflect.apply(iY,null,[b,...a]),()=>e2(...a),'datetime format',b=>b[e5](...a),ja,a=>d(a)||z(a));}function ai(a){B=a;e.pluralRules=B;}function aj(a,b){return q(()=>{if(!a){return false;}const f=d(b)?b:h.value;const g=L(f);const c=e.messageResolver(g,a);return!P?aC(c)||ak(c)||d(c):c!=null;},()=>[a],'translate exists',c=>{return Reflect.apply(c.te,c,[a,b]);},pe,a=>o(a));}function al(c){let a=null;const b=iF(e,j.value,h.value);for(let d=0;d<b.length;d++){const g=l.value[b[d]]||{};const f=e.messageResolver(g,c);if(f!=null){a=f;break;}}return a;}function am(a){const b=al(a);return b!=null?b:g?g.tm(a)||{}:{};}function L(a){return(l.value[a]||{});}function an(a,b){if(G){const c={[a]:b};for(const a in c){if(aq(c,a)){cv(c[a]);}}b=c[a];}l.value[a]=b;e.messages=l.value;}function ao(a,c){l.value[a]=l.value[a]||{};const b={[a]:c};if(G){for(const a in b){if(aq(b,a)){cv(b[a]);}}}c=b[a];du(c,l.value[a]);e.messages=l.value;}function ap(a){return m.value[a]||{};}function ar(a,b){m.value[a]=b;e.datetimeFormats=m.value;i$(e,a,b);}function at(a,b){m.value[a]=O(m.value[a]||{},b);e.datetimeFormats=m.value;i$(e,a,b);}function au(a){return n.value[a]||{};}function av(a,b){n.value[a]=b;e.numberFormats=n.value;i1(e,a,b);}function aw(a,b){n.value[a]=O(n.value[a]||{},b);e.numberFormats=n.value;i1(e,a,b);}jb++;if(g&&dq){f(g.locale,(a)=>{if(r){h.value=a;e.locale=a;cu(e,h.value,j.value);}});f(g.fallbackLocale,(a)=>{if(r){j.value=a;e.fallbackLocale=a;cu(e,h.value,j.value);}});}const i={id:jb,locale:S,fallbackLocale:T,get inheritLocale(){return r;},set inheritLocale(a){r=a;if(a&&g){h.value=g.locale.value;j.value=g.fallbackLocale.value;cu(e,h.value,j.value);}},get availableLocales(){return Object.keys(l.value).sort();},messages:U,get modifiers(){return I;},get pluralRules(){return B||{};},get isGlobal(){return s;},get missingWarn(){return w;},set missingWarn(a){w=a;e.missingWarn=w;},get fallbackWarn(){return x;},set fallbackWarn(a){x=a;e.fallbackWarn=x;},get fallbackRoot(){return C;},set fallbackRoot(a){C=a;},get fallbackFormat(){return y;},set fallbackFormat(a){y=a;e.fallbackFormat=y;},get warnHtmlMessage(){return D;},set warnHtmlMessage(a){D=a;e.warnHtmlMessage=a;},get escapeParameter(){return E;},set escapeParameter(a){E=a;e.escapeParameter=a;},t:J,getLocaleMessage:L,setLocaleMessage:an,mergeLocaleMessage:ao,getPostTranslationHandler:X,setPostTranslationHandler:Y,getMissingHandler:Z,setMissingHandler:$,[i4]:ai};{i.datetimeFormats=V;i.numberFormats=W;i.rt=_;i.te=aj;i.tm=am;i.d=aa;i.n=ab;i.getDateTimeFormat=ap;i.setDateTimeFormat=ar;i.mergeDateTimeFormat=at;i.getNumberFormat=au;i.setNumberFormat=av;i.mergeNumberFormat=aw;i[i5]=N;i[e4]=af;i[e5]=ah;i[e6]=ag;}return i;}function pg(a){const c=d(a.locale)?a.locale:bM;const e=d(a.fallbackLocale)||z(a.fallbackLocale)||k(a.fallbackLocale)||a.fallbackLocale===false?a.fallbackLocale:c;const f=v(a.missing)?a.missing:undefined;const g=o(a.silentTranslationWarn)||a2(a.silentTranslationWarn)?!a.silentTranslationWarn:true;const h=o(a.silentFallbackWarn)||a2(a.silentFallbackWarn)?!a.silentFallbackWarn:true;const i=o(a.fallbackRoot)?a.fallbackRoot:true;const j=!!a.formatFallbackMessages;const l=k(a.modifiers)?a.modifiers:{};const m=a.pluralizationRules;const n=v(a.postTranslation)?a.postTranslation:undefined;const p=d(a.warnHtmlInMessage)?a.warnHtmlInMessage!=='off':true;const q=!!a.escapeParameterHtml;const r=o(a.sync)?a.sync:true;let b=a.messages;if(k(a.sharedMessages)){const c=a.sharedMessages;const d=Object.keys(c);b=d.reduce((a,b)=>{const d=a[b]||(a[b]={});O(d,c[b]);return a;},(b||{}));}const{__i18n:s,__root:t,__injectWithOption:u}=a;const w=a.datetimeFormats;const x=a.numberFormats;const y=a.flatJson;const A=a.translateExistCompatible;return{locale:c,fallbackLocale:e,messages:b,flatJson:y,datetimeFormats:w,numberFormats:x,missing:f,missingWarn:g,fallbackWarn:h,fallbackRoot:i,fallbackFormat:j,modifiers:l,pluralRules:m,postTranslation:n,warnHtmlMessage:p,escapeParameter:q,messageResolver:a.messageResolver,inheritLocale:r,translateExistCompatible:A,__i18n:s,__root:t,__injectWithOption:u};}function e9(a={},b){{const b=e8(pg(a));const{__extender:e}=a;const c={id:b.id,get locale(){return b.locale.value;},set locale(a){b.locale.value=a;},get fallbackLocale(){return b.fallbackLocale.value;},set fallbackLocale(a){b.fallbackLocale.value=a;},get messages(){return b.messages.value;},get datetimeFormats(){return b.datetimeFormats.value;},get numberFormats(){return b.numberFormats.value;},get availableLocales(){return b.availableLocales;},get formatter(){return{interpolate(){return[];}};},set formatter(a){},get missing(){return b.getMissingHandler();},set missing(a){b.setMissingHandler(a);},get silentTranslationWarn(){return o(b.missingWarn)?!b.missingWarn:b.missingWarn;},set silentTranslationWarn(a){b.missingWarn=o(a)?!a:a;},get silentFallbackWarn(){return o(b.fallbackWarn)?!b.fallbackWarn:b.fallbackWarn;},set silentFallbackWarn(a){b.fallbackWarn=o(a)?!a:a;},get modifiers(){return b.modifiers;},get formatFallbackMessages(){return b.fallbackFormat;},set formatFallbackMessages(a){b.fallbackFormat=a;},get postTranslation(){return b.getPostTranslationHandler();},set postTranslation(a){b.setPostTranslationHandler(a);},get sync(){return b.inheritLocale;},set sync(a){b.inheritLocale=a;},get warnHtmlInMessage(){return b.warnHtmlMessage?'warn':'off';},set warnHtmlInMessage(a){b.warnHtmlMessage=a!=='off';},get escapeParameterHtml(){return b.escapeParameter;},set escapeParameterHtml(a){b.escapeParameter=a;},get preserveDirectiveContent(){return true;},set preserveDirectiveContent(a){},get pluralizationRules(){return b.pluralRules||{};},__composer:b,t(...i){const[g,a,c]=i;const h={};let e=null;let f=null;if(!d(g)){throw M(K.INVALID_ARGUMENT);}const j=g;if(d(a)){h.locale=a;}else if(z(a)){e=a;}else if(k(a)){f=a;}if(z(c)){e=c;}else if(k(c)){f=c;}return Reflect.apply(b.t,b,[j,(e||f||{}),h]);},rt(...a){return Reflect.apply(b.rt,b,[...a]);},tc(...i){const[h,a,c]=i;const e={plural:1};let f=null;let g=null;if(!d(h)){throw M(K.INVALID_ARGUMENT);}const j=h;if(d(a)){e.locale=a;}else if(F(a)){e.plural=a;}else if(z(a)){f=a;}else if(k(a)){g=a;}if(d(c)){e.locale=c;}else if(z(c)){f=c;}else if(k(c)){g=c;}return Reflect.apply(b.t,b,[j,(f||g||{}),e]);},te(a,c){return b.te(a,c);},tm(a){return b.tm(a);},getLocaleMessage(a){return b.getLocaleMessage(a);},setLocaleMessage(a,c){b.setLocaleMessage(a,c);},mergeLocaleMessage(a,c){b.mergeLocaleMessage(a,c);},d(...a){return Reflect.apply(b.d,b,[...a]);},getDateTimeFormat(a){return b.getDateTimeFormat(a);},setDateTimeFormat(a,c){b.setDateTimeFormat(a,c);},mergeDateTimeFormat(a,c){b.mergeDateTimeFormat(a,c);},n(...a){return Reflect.apply(b.n,b,[...a]);},getNumberFormat(a){return b.getNumberFormat(a);},setNumberFormat(a,c){b.setNumberFormat(a,c);},mergeNumberFormat(a,c){b.mergeNumberFormat(a,c);},getChoiceIndex(a,b){return-1;}};c.__extender=e;return c;}}const fa={tag:{type:[String,Object]},locale:{type:String},scope:{type:String,validator:(a)=>a==='parent'||a==='global',default:'parent'},i18n:{type:Object}};function ph({slots:a},b){if(b.length===1&&b[0]==='default'){const b=a.default?a.default():[];return b.reduce((b,a)=>{return[...b,...(a.type===ag?a.children:[a])];},[]);}else{return b.reduce((b,c)=>{const d=a[c];if(d){b[c]=d();}return b;},s());}}function jd(a){return ag;}const pi=cQ({name:'i18n-t',props:O({keypath:{type:String,required:true},plural:{type:[Number,String],validator:(a)=>F(a)||!isNaN(a)}},fa),setup(a,c){const{slots:e,attrs:f}=c;const g=a.i18n||cw({useScope:a.scope,__useComponent:true});return()=>{const i=Object.keys(e).filter(a=>a!=='_');const h=s();if(a.locale){h.locale=a.locale;}if(a.plural!==undefined){h.plural=d(a.plural)?+a.plural:a.plural;}const j=ph(c,i);const k=g[e4](a.keypath,j,h);const l=O(s(),f);const m=d(a.tag)||p(a.tag)?a.tag:jd();return b(m,l,k);};}});const je=pi;function pj(a){return z(a)&&!d(a[0]);}function jf(a,c,e,f){const{slots:g,attrs:h}=c;return()=>{const c={part:true};let k=s();if(a.locale){c.locale=a.locale;}if(d(a.format)){c.key=a.format;}else if(p(a.format)){if(d(a.format.key)){c.key=a.format.key;}k=Object.keys(a.format).reduce((c,b)=>{return e.includes(b)?O(s(),c,{[b]:a.format[b]}):c;},s());}const i=f(...[a.value,c,k]);let j=[c.key];if(z(i)){j=i.map((a,c)=>{const d=g[a.type];const b=d?d({[a.type]:a.value,index:c,parts:i}):[a.value];if(pj(b)){b[0].key=`${a.type}-${c}`;}return b;});}else if(d(i)){j=[i];}const l=O(s(),h);const m=d(a.tag)||p(a.tag)?a.tag:jd();return b(m,l,j);};}const pk=cQ({name:'i18n-n',props:O({value:{type:Number,required:true},format:{type:[String,Object]}},fa),setup(a,b){const c=a.i18n||cw({useScope:a.scope,__useComponent:true});return jf(a,b,i0,(...a)=>c[e6](...a));}});const jg=pk;const pl=cQ({name:'i18n-d',props:O({value:{type:[Number,Date],required:true},format:{type:[String,Object]}},fa),setup(a,b){const c=a.i18n||cw({useScope:a.scope,__useComponent:true});return jf(a,b,iZ,(...a)=>c[e5](...a));}});const jh=pl;function pm(a,b){const c=a;if(a.mode==='composition'){return(c.__getInstance(b)||a.global);}else{const d=c.__getInstance(b);return d!=null?d.__composer:a.global.__composer;}}function pn(a){const b=(d)=>{const{instance:b,modifiers:g,value:e}=d;if(!b||!b.$){throw M(K.UNEXPECTED_ERROR);}const c=pm(a,b.$);const f=ji(e);return[Reflect.apply(c.t,c,[...jj(f)]),c];};const c=(c,d)=>{const[g,e]=b(d);if(dq&&a.global===e){c.__i18nWatcher=f(e.locale,()=>{d.instance&&d.instance.$forceUpdate();});}c.__composer=e;c.textContent=g;};const d=(a)=>{if(dq&&a.__i18nWatcher){a.__i18nWatcher();a.__i18nWatcher=undefined;delete a.__i18nWatcher;}if(a.__composer){a.__composer=undefined;delete a.__composer;}};const e=(a,{value:b})=>{if(a.__composer){const c=a.__composer;const d=ji(b);a.textContent=Reflect.apply(c.t,c,[...jj(d)]);}};const g=(a)=>{const[c]=b(a);return{textContent:c};};return{created:c,unmounted:d,beforeUpdate:e,getSSRProps:g};}function ji(a){if(d(a)){return{path:a};}else if(k(a)){if(!('path'in a)){throw M(K.REQUIRED_VALUE,'path');}return a;}else{throw M(K.INVALID_VALUE);}}function jj(f){const{path:g,locale:b,args:h,choice:c,plural:e}=f;const a={};const i=h||{};if(d(b)){a.locale=b;}if(F(c)){a.plural=c;}if(F(e)){a.plural=e;}return[g,i,a];}function po(a,d,...c){const b=k(c[0])?c[0]:{};const e=!!b.useI18nComponentName;const f=o(b.globalInstall)?b.globalInstall:true;if(f){[!e?je.name:'i18n','I18nT'].forEach(b=>a.component(b,je));[jg.name,'I18nN'].forEach(b=>a.component(b,jg));[jh.name,'I18nD'].forEach(b=>a.component(b,jh));}{a.directive('t',pn(d));}}function pp(b,c,a){return{beforeCreate(){const e=i();if(!e){throw M(K.UNEXPECTED_ERROR);}const d=this.$options;if(d.i18n){const e=d.i18n;if(d.__i18n){e.__i18n=d.__i18n;}e.__root=c;if(this===this.$root){this.$i18n=jk(b,e);}else{e.__injectWithOption=true;e.__extender=a.__vueI18nExtend;this.$i18n=e9(e);const b=this.$i18n;if(b.__extender){b.__disposer=b.__extender(this.$i18n);}}}else if(d.__i18n){if(this===this.$root){this.$i18n=jk(b,d);}else{this.$i18n=e9({__i18n:d.__i18n,__injectWithOption:true,__extender:a.__vueI18nExtend,__root:c});const b=this.$i18n;if(b.__extender){b.__disposer=b.__extender(this.$i18n);}}}else{this.$i18n=b;}if(d.__i18nGlobal){i7(c,d,d);}this.$t=(...a)=>this.$i18n.t(...a);this.$rt=(...a)=>this.$i18n.rt(...a);this.$tc=(...a)=>this.$i18n.tc(...a);this.$te=(a,b)=>this.$i18n.te(a,b);this.$d=(...a)=>this.$i18n.d(...a);this.$n=(...a)=>this.$i18n.n(...a);this.$tm=(a)=>this.$i18n.tm(a);a.__setInstance(e,this.$i18n);},mounted(){},unmounted(){const c=i();if(!c){throw M(K.UNEXPECTED_ERROR);}const b=this.$i18n;delete this.$t;delete this.$rt;delete this.$tc;delete this.$te;delete this.$d;delete this.$n;delete this.$tm;if(b.__disposer){b.__disposer();delete b.__disposer;delete b.__extender;}a.__deleteInstance(c);delete this.$i18n;}};}function jk(a,b){a.locale=b.locale||a.locale;a.fallbackLocale=b.fallbackLocale||a.fallbackLocale;a.missing=b.missing||a.missing;a.silentTranslationWarn=b.silentTranslationWarn||a.silentFallbackWarn;a.silentFallbackWarn=b.silentFallbackWarn||a.silentFallbackWarn;a.formatFallbackMessages=b.formatFallbackMessages||a.formatFallbackMessages;a.postTranslation=b.postTranslation||a.postTranslation;a.warnHtmlInMessage=b.warnHtmlInMessage||a.warnHtmlInMessage;a.escapeParameterHtml=b.escapeParameterHtml||a.escapeParameterHtml;a.sync=b.sync||a.sync;a.__composer[i4](b.pluralizationRules||a.pluralizationRules);const c=dy(a.locale,{messages:b.messages,__i18n:b.__i18n});Object.keys(c).forEach(b=>a.mergeLocaleMessage(b,c[b]));if(b.datetimeFormats){Object.keys(b.datetimeFormats).forEach(c=>a.mergeDateTimeFormat(c,b.datetimeFormats[c]));}if(b.numberFormats){Object.keys(b.numberFormats).forEach(c=>a.mergeNumberFormat(c,b.numberFormats[c]));}return a;}const pq=a1('global-vue-i18n');function pr(a={},m){const b=__VUE_I18N_LEGACY_API__&&o(a.legacy)?a.legacy:__VUE_I18N_LEGACY_API__;const e=o(a.globalInjection)?a.globalInjection:true;const f=__VUE_I18N_LEGACY_API__&&b?!!a.allowComposition:true;const c=new Map();const[g,d]=ps(a,b);const h=a1('');function i(a){return c.get(a)||null;}function j(a,b){c.set(a,b);}function l(a){c.delete(a);}{const a={get mode(){return __VUE_I18N_LEGACY_API__&&b?'legacy':'composition';},get allowComposition(){return f;},async install(c,...f){c.__VUE_I18N_SYMBOL__=h;c.provide(c.__VUE_I18N_SYMBOL__,a);if(k(f[0])){const b=f[0];a.__composerExtend=b.__composerExtend;a.__vueI18nExtend=b.__vueI18nExtend;}let g=null;if(!b&&e){g=pB(c,a.global);}if(__VUE_I18N_FULL_INSTALL__){po(c,a,...f);}if(__VUE_I18N_LEGACY_API__&&b){c.mixin(pp(d,d.__composer,a));}const i=c.unmount;c.unmount=()=>{g&&g();a.dispose();i();};},get global(){return d;},dispose(){g.stop();},__instances:c,__getInstance:i,__setInstance:j,__deleteInstance:l};return a;}}function cw(c={}){const a=i();if(a==null){throw M(K.MUST_BE_CALL_SETUP_TOP);}if(!a.isCE&&a.appContext.app!=null&&!a.appContext.app.__VUE_I18N_SYMBOL__){throw M(K.NOT_INSTALLED);}const e=pt(a);const d=pv(e);const g=i6(a);const h=pu(c,g);if(__VUE_I18N_LEGACY_API__){if(e.mode==='legacy'&&!c.__useComponent){if(!e.allowComposition){throw M(K.NOT_AVAILABLE_IN_LEGACY_MODE);}return pz(a,h,d,c);}}if(h==='global'){i7(d,c,g);return d;}if(h==='parent'){let b=pw(e,a,c.__useComponent);if(b==null){b=d;}return b;}const f=e;let b=f.__getInstance(a);if(b==null){const e=O({},c);if('__i18n'in g){e.__i18n=g.__i18n;}if(d){e.__root=d;}b=e8(e);if(f.__composerExtend){b[e7]=f.__composerExtend(b);}py(f,a,b);f.__setInstance(a,b);}return b;}function ps(b,c,d){const a=dL();{const d=__VUE_I18N_LEGACY_API__&&c?a.run(()=>e9(b)):a.run(()=>e8(b));if(d==null){throw M(K.UNEXPECTED_ERROR);}return[a,d];}}function pt(a){{const b=af(!a.isCE?a.appContext.app.__VUE_I18N_SYMBOL__:pq);if(!b){throw M(!a.isCE?K.UNEXPECTED_ERROR:K.NOT_INSTALLED_WITH_PROVIDE);}return b;}}function pu(a,b){return dr(a)?('__i18n'in b)?'local':'global':!a.useScope?'local':a.useScope;}function pv(a){return a.mode==='composition'?a.global:a.global.__composer;}function pw(c,d,e=false){let a=null;const f=d.root;let b=px(d,e);while(b!=null){const d=c;if(c.mode==='composition'){a=d.__getInstance(b);}else{if(__VUE_I18N_LEGACY_API__){const c=d.__getInstance(b);if(c!=null){a=c.__composer;if(e&&a&&!a[i5]){a=null;}}}}if(a!=null){break;}if(f===b){break;}b=b.parent;}return a;}function px(a,b=false){if(a==null){return null;}{return!b?a.parent:a.vnode.ctx||a.parent;}}function py(b,a,c){{L(()=>{},a);bC(()=>{const d=c;b.__deleteInstance(a);const e=d[e7];if(e){e();delete d[e7];}},a);}}function pz(j,r,h,e={}){const f=r==='local';const b=fO(null);if(f&&j.proxy&&!(j.proxy.$options.i18n||j.proxy.$options.__i18n)){throw M(K.MUST_DEFINE_I18N_OPTION_IN_ALLOW_COMPOSITION);}const q=o(e.inheritLocale)?e.inheritLocale:!d(e.locale);const g=c(!f||q?h.locale.value:d(e.locale)?e.locale:bM);const p=c(!f||q?h.fallbackLocale.value:d(e.fallbackLocale)||z(e.fallbackLocale)||k(e.fallbackLocale)||e.fallbackLocale===false?e.fallbackLocale:g.value);const i=c(dy(g.value,e));const l=c(k(e.datetimeFormats)?e.datetimeFormats:{[g.value]:{}});const m=c(k(e.numberFormats)?e.numberFormats:{[g.value]:{}});const s=f?h.missingWarn:o(e.missingWarn)||a2(e.missingWarn)?e.missingWarn:true;const t=f?h.fallbackWarn:o(e.fallbackWarn)||a2(e.fallbackWarn)?e.fallbackWarn:true;const u=f?h.fallbackRoot:o(e.fallbackRoot)?e.fallbackRoot:true;const w=!!e.fallbackFormat;const A=v(e.missing)?e.missing:null;const B=v(e.postTranslation)?e.postTranslation:null;const x=f?h.warnHtmlMessage:o(e.warnHtmlMessage)?e.warnHtmlMessage:true;const y=!!e.escapeParameter;const C=f?h.modifiers:k(e.modifiers)?e.modifiers:{};const D=e.pluralRules||(f&&h.pluralRules);function E(){return[g.value,p.value,i.value,l.value,m.value];}const F=a({get:()=>{return b.value?b.value.locale.value:g.value;},set:a=>{if(b.value){b.value.locale.value=a;}g.value=a;}});const G=a({get:()=>{return b.value?b.value.fallbackLocale.value:p.value;},set:a=>{if(b.value){b.value.fallbackLocale.value=a;}p.value=a;}});const H=a(()=>{if(b.value){return b.value.messages.value;}else{return i.value;}});const I=a(()=>l.value);const J=a(()=>m.value);function L(){return b.value?b.value.getPostTranslationHandler():B;}function N(a){if(b.value){b.value.setPostTranslationHandler(a);}}function O(){return b.value?b.value.getMissingHandler():A;}function P(a){if(b.value){b.value.setMissingHandler(a);}}function n(a){E();return a();}function Q(...a){return b.value?n(()=>Reflect.apply(b.value.t,null,[...a])):n(()=>'');}function R(...a){return b.value?Reflect.apply(b.value.rt,null,[...a]):'';}function S(...a){return b.value?n(()=>Reflect.apply(b.value.d,null,[...a])):n(()=>'');}function T(...a){return b.value?n(()=>Reflect.apply(b.value.n,null,[...a])):n(()=>'');}function U(a){return b.value?b.value.tm(a):{};}function V(a,c){return b.value?b.value.te(a,c):false;}function W(a){return b.value?b.value.getLocaleMessage(a):{};}function X(a,c){if(b.value){b.value.setLocaleMessage(a,c);i.value[a]=c;}}function Y(a,c){if(b.value){b.value.mergeLocaleMessage(a,c);}}function Z(a){return b.value?b.value.getDateTimeFormat(a):{};}function $(a,c){if(b.value){b.value.setDateTimeFormat(a,c);l.value[a]=c;}}function _(a,c){if(b.value){b.value.mergeDateTimeFormat(a,c);}}function aa(a){return b.value?b.value.getNumberFormat(a):{};}function ab(a,c){if(b.value){b.value.setNumberFormat(a,c);m.value[a]=c;}}function ac(a,c){if(b.value){b.value.mergeNumberFormat(a,c);}}const ad={get id(){return b.value?b.value.id:-1;},locale:F,fallbackLocale:G,messages:H,datetimeFormats:I,numberFormats:J,get inheritLocale(){return b.value?b.value.inheritLocale:q;},set inheritLocale(a){if(b.value){b.value.inheritLocale=a;}},get availableLocales(){return b.value?b.value.availableLocales:Object.keys(i.value);},get modifiers(){return(b.value?b.value.modifiers:C);},get pluralRules(){return(b.value?b.value.pluralRules:D);},get isGlobal(){return b.value?b.value.isGlobal:false;},get missingWarn(){return b.value?b.value.missingWarn:s;},set missingWarn(a){if(b.value){b.value.missingWarn=a;}},get fallbackWarn(){return b.value?b.value.fallbackWarn:t;},set fallbackWarn(a){if(b.value){b.value.missingWarn=a;}},get fallbackRoot(){return b.value?b.value.fallbackRoot:u;},set fallbackRoot(a){if(b.value){b.value.fallbackRoot=a;}},get fallbackFormat(){return b.value?b.value.fallbackFormat:w;},set fallbackFormat(a){if(b.value){b.value.fallbackFormat=a;}},get warnHtmlMessage(){return b.value?b.value.warnHtmlMessage:x;},set warnHtmlMessage(a){if(b.value){b.value.warnHtmlMessage=a;}},get escapeParameter(){return b.value?b.value.escapeParameter:y;},set escapeParameter(a){if(b.value){b.value.escapeParameter=a;}},t:Q,getPostTranslationHandler:L,setPostTranslationHandler:N,getMissingHandler:O,setMissingHandler:P,rt:R,d:S,n:T,tm:U,te:V,getLocaleMessage:W,setLocaleMessage:X,mergeLocaleMessage:Y,getDateTimeFormat:Z,setDateTimeFormat:$,mergeDateTimeFormat:_,getNumberFormat:aa,setNumberFormat:ab,mergeNumberFormat:ac};function ae(a){a.locale.value=g.value;a.fallbackLocale.value=p.value;Object.keys(i.value).forEach(b=>{a.mergeLocaleMessage(b,i.value[b]);});Object.keys(l.value).forEach(b=>{a.mergeDateTimeFormat(b,l.value[b]);});Object.keys(m.value).forEach(b=>{a.mergeNumberFormat(b,m.value[b]);});a.escapeParameter=y;a.fallbackFormat=w;a.fallbackRoot=u;a.fallbackWarn=t;a.missingWarn=s;a.warnHtmlMessage=x;}b4(()=>{if(j.proxy==null||j.proxy.$i18n==null){throw M(K.NOT_AVAILABLE_COMPOSITION_IN_LEGACY);}const a=(b.value=j.proxy.$i18n.__composer);if(r==='global'){g.value=a.locale.value;p.value=a.fallbackLocale.value;i.value=a.messages.value;l.value=a.datetimeFormats.value;m.value=a.numberFormats.value;}else if(f){ae(a);}});return ad;}const pA=['locale','fallbackLocale','availableLocales'];const jl=['t','rt','d','n','tm','te'];function pB(a,b){const c=Object.create(null);pA.forEach(d=>{const a=Object.getOwnPropertyDescriptor(b,d);if(!a){throw M(K.UNEXPECTED_ERROR);}const e=D(a.value)?{get(){return a.value.value;},set(b){a.value.value=b;}}:{get(){return a.get&&a.get();}};Object.defineProperty(c,d,e);});a.config.globalProperties.$i18n=c;jl.forEach(d=>{const c=Object.getOwnPropertyDescriptor(b,d);if(!c||!c.value){throw M(K.UNEXPECTED_ERROR);}Object.defineProperty(a.config.globalProperties,`$${d}`,c);});const d=()=>{delete a.config.globalProperties.$i18n;jl.forEach(b=>{delete a.config.globalProperties[`$${b}`];});};return d;}{pd();}if(__INTLIFY_JIT_COMPILATION__){iK(o7);}else{iK(o6);}oZ(oG);o$(iF);if(__INTLIFY_PROD_DEVTOOLS__){const a=aO();a.__INTLIFY__=true;oP(a.__INTLIFY_DEVTOOLS_GLOBAL_HOOK__);}var jM=false;let jm;const dz=(a)=>(jm=a);const jn=(Symbol());function fb(a){return(a&&typeof a==='object'&&Object.prototype.toString.call(a)==='[object Object]'&&typeof a.toJSON!=='function');}var bQ;(function(a){a["direct"]="direct";a["patchObject"]="patch object";a["patchFunction"]="patch function";})(bQ||(bQ={}));function pC(){const e=dL(true);const f=e.run(()=>c({}));let b=[];let d=[];const a=bZ({install(c){dz(a);{a._a=c;c.provide(jn,a);c.config.globalProperties.$pinia=a;d.forEach((a)=>b.push(a));d=[];}},use(a){if(!this._a&&!jM){d.push(a);}else{b.push(a);}return this;},_p:b,_a:null,_e:e,_s:new Map(),state:f,});return a;}const jo=()=>{};function jp(a,b,d,e=jo){a.push(b);const c=()=>{const c=a.indexOf(b);if(c>-1){a.splice(c,1);e();}};if(!d&&fu()){j_(c);}return c;}function bO(a,...b){a.slice().forEach((a)=>{a(...b);});}const pD=(a)=>a();const jq=Symbol();const fc=Symbol();function fd(a,b){if(a instanceof Map&&b instanceof Map){b.forEach((b,c)=>a.set(c,b));}else if(a instanceof Set&&b instanceof Set){b.forEach(a.add,a);}for(const c in b){if(!b.hasOwnProperty(c))continue;const d=b[c];const e=a[c];if(fb(e)&&fb(d)&&a.hasOwnProperty(c)&&!D(d)&&!a9(d)){a[c]=fd(e,d);}else{a[c]=d;}}return a;}const pE=Symbol();function pF(a){return!fb(a)||!a.hasOwnProperty(pE);}const{assign:a6}=Object;function pG(a){return!!(D(a)&&a.effect);}function pH(c,d,b,h){const{state:e,actions:i,getters:f}=d;const j=b.state.value[c];let g;function k(){if(!j&&(!("production"!=='production'))){{b.state.value[c]=e?e():{};}}const d=km(b.state.value[c]);return a6(d,i,Object.keys(f||{}).reduce((d,e)=>{d[e]=bZ(a(()=>{dz(b);const a=b._s.get(c);return f[e].call(a,a);}));return d;},{}));}g=jr(c,k,d,b,h,true);return g;}function jr(b,u,h={},a,B,i){let j;const q=a6({actions:{}},h);const v={deep:true};let k;let m;let n=[];let o=[];let p;const e=a.state.value[b];if(!i&&!e&&(!("production"!=='production'))){{a.state.value[b]={};}}c({});let r;function s(c){let d;k=m=false;if(typeof c==='function'){c(a.state.value[b]);d={type:bQ.patchFunction,storeId:b,events:p,};}else{fd(a.state.value[b],c);d={type:bQ.patchObject,payload:c,storeId:b,events:p,};}const e=(r=Symbol());w().then(()=>{if(r===e){k=true;}});m=true;bO(n,d,a.state.value[b]);}const x=i?function a(){const{state:a}=h;const b=a?a():{};this.$patch((a)=>{a6(a,b);});}:jo;function y(){j.stop();n=[];o=[];a._s.delete(b);}const t=(c,f='')=>{if(jq in c){c[fc]=f;return c;}const e=function(){dz(a);const i=Array.from(arguments);const g=[];const h=[];function j(a){g.push(a);}function k(a){h.push(a);}bO(o,{args:i,name:e[fc],store:d,after:j,onError:k,});let f;try{f=c.apply(this&&this.$id===b?this:d,i);}catch(a){bO(h,a);throw a;}if(f instanceof Promise){return f.then((a)=>{bO(g,a);return a;}).catch((a)=>{bO(h,a);return Promise.reject(a);});}bO(g,f);return f;};e[jq]=true;e[fc]=f;return e;};const z={_p:a,$id:b,$onAction:jp.bind(null,o),$patch:s,$reset:x,$subscribe(d,c={}){const e=jp(n,d,c.detached,()=>g());const g=j.run(()=>f(()=>a.state.value[b],(a)=>{if(c.flush==='sync'?m:k){d({storeId:b,type:bQ.direct,events:p,},a);}},a6({},v,c)));return e;},$dispose:y,};const d=ax(z);a._s.set(b,d);const A=(a._a&&a._a.runWithContext)||pD;const g=A(()=>a._e.run(()=>(j=dL()).run(()=>u({action:t}))));for(const c in g){const d=g[c];if((D(d)&&!pG(d))||a9(d)){if(!i){if(e&&pF(d)){if(D(d)){d.value=e[c];}else{fd(d,e[c]);}}{a.state.value[b][c]=d;}}}else if(typeof d==='function'){const a=t(d,c);{g[c]=a;}q.actions[c]=d;}else;}{a6(d,g);a6(l(d),g);}Object.defineProperty(d,'$state',{get:()=>(a.state.value[b]),set:(a)=>{s((b)=>{a6(b,a);});},});a._p.forEach((b)=>{{a6(d,j.run(()=>b({store:d,app:a._a,pinia:a,options:q,})));}});if(e&&i&&h.hydrate){h.hydrate(d.$state,e);}k=true;m=true;return d;}function pI(b,d,g){let a;let c;const e=typeof d==='function';if(typeof b==='string'){a=b;c=e?g:d;}else{c=b;a=b.id;}function f(b,h){const f=kW();b=(b)||(f?af(jn,null):null);if(b)dz(b);b=jm;if(!b._s.has(a)){if(e){jr(a,d,c,b);}else{pH(a,c,b);}}const g=b._s.get(a);return g;}f.$id=a;return f;}const js=pI('portal',()=>{const f=c('');const d=c([]);const m=c(null);const b=c(localStorage.getItem('apiKey')||'');const h=c(false);async function e(e,a={}){const c={...a.headers};if(b.value)c['X-API-Key']=b.value;const d=await fetch(e,{...a,headers:c});h.value=d.status===401;return d;}function n(a){b.value=a.trim();if(b.value){localStorage.setItem('apiKey',b.value);}else{localStorage.removeItem('apiKey');}h.value=false;if(g){l();j();}}async function o(){try{const b=await fetch('/api/ping');const a=await b.json();f.value=a.message||JSON.stringify(a);}catch(a){f.value='Ping failed';}}function p(){f.value='';}async function i(){const a=await e('/api/v1/players');d.value=a.ok?(await a.json())||[]:[];}async function q(a){const b=await e('/api/v1/players',{method:'POST',headers:{'Content-Type':'application/json'},body:JSON.stringify(a)});return await b.json();}async function r(a,b){const c=await e(`/api/v1/players/${a}`,{method:'PUT',headers:{'Content-Type':'application/json'},body:JSON.stringify(b)});return await c.json();}async function s(b){const a=await e(`/api/v1/players/${b}`,{method:'DELETE'});if(a.status===204){return{};}try{return await a.json();}catch{return{};}}let g=false;let a=null;const t=['player.created','player.updated','player.deleted','player.balance_changed'];function u(a){const b=d.value.filter(b=>b.id!==a.playerId);if(a.type!=='player.deleted'){const c=d.value.findIndex(b=>b.id===a.playerId);b.splice(c<0?b.length:c,0,a.player);}d.value=b;}async function v(b,c){const d=b.body.pipeThrough(new TextDecoderStream()).getReader();let a='';for(;;){const{value:e,done:f}=await d.read();if(f)return;a+=e.replace(/\r\n?/g,'\n');let b;while((b=a.indexOf('\n\n'))>=0){const d={type:'message',data:[],retry:0};for(const c of a.slice(0,b).split('\n')){const a=c.indexOf(':');if(a===0)continue;const e=a<0?c:c.slice(0,a);const b=a<0?'':c.slice(a+1).replace(/^ /,'');if(e==='event')d.type=b;else if(e==='data')d.data.push(b);else if(e==='retry'&&/^\d+$/.test(b))d.retry=Number(b);}a=a.slice(b+2);c(d.type,d.data.join('\n'),d.retry);}}}function j(){g=true;k();}function k(){if(a)return;const b=new AbortController();a=b;let d=3000;const c=()=>{if(a!==b)return;a=null;setTimeout(()=>g&&k(),d);};e('/api/v1/players/events',{signal:b.signal,headers:{Accept:'text/event-stream'}}).then(async e=>{if(e.status===401||e.status===403){if(a===b)a=null;return;}if(!e.ok)return c();i();await v(e,(b,c,a)=>{if(a)d=a;if(t.includes(b))u(JSON.parse(c));});c();}).catch(c);}function l(){g=false;a?.abort();a=null;}return{apiKey:b,unauthorized:h,setApiKey:n,pingResult:f,pingBackend:o,clearResult:p,players:d,player:m,fetchPlayers:i,createPlayer:q,updatePlayer:r,deletePlayer:s,watchPlayers:j,unwatchPlayers:l};});const pJ={true:'inset',item:'item-inset','item-thumbnail':'item-thumbnail-inset'};const fe={xs:2,sm:4,md:8,lg:16,xl:24};const jt=n({name:'QSeparator',props:{...ac,spaced:[Boolean,String],inset:[Boolean,String],vertical:Boolean,color:String,size:String},setup(c){const f=i();const g=ad(c,f.proxy.$q);const d=a(()=>(c.vertical===true?'vertical':'horizontal'));const e=a(()=>` q-separator--${d.value}`);const h=a(()=>(c.inset!==false?`${e.value}-${pJ[c.inset]}`:''));const j=a(()=>`q-separator${e.value}${h.value}`+(c.color!==void 0?` bg-${c.color}`:'')+(g.value===true?' q-separator--dark':''));const k=a(()=>{const a={};if(c.size!==void 0){a[c.vertical===true?'width':'height']=c.size;}if(c.spaced!==false){const d=c.spaced===true?`${fe.md}px`:c.spaced in fe?`${fe[c.spaced]}px`:c.spaced;const b=c.vertical===true?['Left','Right']:['Top','Bottom'];a[`margin${b[0]}`]=a[`margin${b[1]}`]=d;}return a;});return()=>b('hr',{class:j.value,style:k.value,'aria-orientation':d.value});}});const pK=n({name:'QTd',props:{props:Object,autoWidth:Boolean,noHover:Boolean},setup(c,{slots:d}){const f=i();const e=a(()=>'q-td'+(c.autoWidth===true?' q-table--col-auto-width':'')+(c.noHover===true?' q-td--no-hover':'')+' ');return()=>{if(c.props===void 0){return b('td',{class:e.value},r(d.default));}const h=f.vnode.key;const a=((c.props.colsMap!==void 0?c.props.colsMap[h]:null)||c.props.col);if(a===void 0)return;const{row:g}=c.props;return b('td',{class:e.value+a.__tdClass(g),style:a.__tdStyle(g)},r(d.default));};}});const pL=n({name:'QTh',props:{props:Object,autoWidth:Boolean},emits:['click'],setup(a,{slots:c,emit:f}){const d=i();const{proxy:{$q:g}}=d;const e=a=>{f('click',a);};return()=>{if(a.props===void 0){return b('th',{class:a.autoWidth===true?'q-table--col-auto-width':'',onClick:e},r(c.default));}let f,h;const i=d.vnode.key;if(i){f=a.props.colsMap[i];if(f===void 0)return;}else{f=a.props.col;}if(f.sortable===true){const a=f.align==='right'?'unshift':'push';h=er(c.default,[]);h[a](b(S,{class:f.__iconClass,name:g.iconSet.table.arrowUp}));}else{h=r(c.default);}const j={class:f.__thClass+(a.autoWidth===true?' q-table--col-auto-width':''),style:f.headerStyle,onClick:b=>{f.sortable===true&&a.props.sort(f);e(b);}};return b('th',j,h);};}});const pM=['horizontal','vertical','cell','none'];const pN=n({name:'QMarkupTable',props:{...ac,dense:Boolean,flat:Boolean,bordered:Boolean,square:Boolean,wrapCells:Boolean,separator:{type:String,default:'horizontal',validator:a=>pM.includes(a)}},setup(c,{slots:d}){const e=i();const f=ad(c,e.proxy.$q);const g=a(()=>'q-markup-table q-table__container q-table__card'+` q-table--${c.separator}-separator`+(f.value===true?' q-table--dark q-table__card--dark q-dark':'')+(c.dense===true?' q-table--dense':'')+(c.flat===true?' q-table--flat':'')+(c.bordered===true?' q-table--bordered':'')+(c.square===true?' q-table--square':'')+(c.wrapCells===false?' q-table--no-wrap':''));return()=>b('div',{class:g.value},[b('table',{class:'q-table'},r(d.default))]);}});function ju(a,c){return b('div',a,[b('table',{class:'q-table'},c)]);}const au=1000;const pO=['start','center','end','start-force','center-force','end-force'];const jv=Array.prototype.filter;const pP=window.getComputedStyle(document.body).overflowAnchor===void 0?aL:function(a,b){if(a===null)return;if(a._qOverflowAnimationFrame!==void 0){cancelAnimationFrame(a._qOverflowAnimationFrame);}a._qOverflowAnimationFrame=requestAnimationFrame(()=>{if(a===null)return;a._qOverflowAnimationFrame=void 0;const c=a.children||[];jv.call(c,a=>a.dataset&&a.dataset.qVsAnchor!==void 0).forEach(a=>{delete a.dataset.qVsAnchor;});const d=c[b];if(d?.dataset){d.dataset.qVsAnchor='';}});};function bP(a,b){return a+b;}function ff(c,e,f,g,d,k,h,i){const b=c===window?document.scrollingElement||document.documentElement:c,j=d===true?'offsetWidth':'offsetHeight',a={scrollStart:0,scrollViewSize:-h-i,scrollMaxSize:0,offsetStart:-h,offsetEnd:-i};if(d===true){if(c===window){a.scrollStart=window.pageXOffset||window.scrollX||document.body.scrollLeft||0;a.scrollViewSize+=document.documentElement.clientWidth;}else{a.scrollStart=b.scrollLeft;a.scrollViewSize+=b.clientWidth;}a.scrollMaxSize=b.scrollWidth;if(k===true){a.scrollStart=(cm===true?a.scrollMaxSize-a.scrollViewSize:0)-a.scrollStart;}}else{if(c===window){a.scrollStart=window.pageYOffset||window.scrollY||document.body.scrollTop||0;a.scrollViewSize+=document.documentElement.clientHeight;}else{a.scrollStart=b.scrollTop;a.scrollViewSize+=b.clientHeight;}a.scrollMaxSize=b.scrollHeight;}if(f!==null){for(let b=f.previousElementSibling;b!==null;b=b.previousElementSibling){if(b.classList.contains('q-virtual-scroll--skip')===false){a.offsetStart+=b[j];}}}if(g!==null){for(let b=g.nextElementSibling;b!==null;b=b.nextElementSibling){if(b.classList.contains('q-virtual-scroll--skip')===false){a.offsetEnd+=b[j];}}}if(e!==c){const g=b.getBoundingClientRect(),f=e.getBoundingClientRect();if(d===true){a.offsetStart+=f.left-g.left;a.offsetEnd-=f.width;}else{a.offsetStart+=f.top-g.top;a.offsetEnd-=f.height;}if(c!==window){a.offsetStart+=a.scrollStart;}a.offsetEnd+=a.scrollMaxSize-a.offsetStart;}return a;}function jw(b,a,c,d){if(a==='end'){a=(b===window?document.body:b)[c===true?'scrollWidth':'scrollHeight'];}if(b===window){if(c===true){if(d===true){a=(cm===true?document.body.scrollWidth-document.documentElement.clientWidth:0)-a;}window.scrollTo(a,window.pageYOffset||window.scrollY||document.body.scrollTop||0);}else{window.scrollTo(window.pageXOffset||window.scrollX||document.body.scrollLeft||0,a);}}else if(c===true){if(d===true){a=(cm===true?b.scrollWidth-b.offsetWidth:0)-a;}b.scrollLeft=a;}else{b.scrollTop=a;}}function cx(g,c,b,a){if(b>=a){return 0;}const h=c.length,e=Math.floor(b/au),f=Math.floor((a-1)/au)+1;let d=g.slice(e,f).reduce(bP,0);if(b%au!==0){d-=c.slice(e*au,b).reduce(bP,0);}if(a%au!==0&&a!==h){d-=c.slice(a,f*au).reduce(bP,0);}return d;}const jx={virtualScrollSliceSize:{type:[Number,String],default:10},virtualScrollSliceRatioBefore:{type:[Number,String],default:1},virtualScrollSliceRatioAfter:{type:[Number,String],default:1},virtualScrollItemSize:{type:[Number,String],default:24},virtualScrollStickySizeStart:{type:[Number,String],default:0},virtualScrollStickySizeEnd:{type:[Number,String],default:0},tableColspan:[Number,String]};const jy=Object.keys(jx);const fg={virtualScrollHorizontal:Boolean,onVirtualScroll:Function,...jx};function jz({virtualScrollLength:h,getVirtualScrollTarget:u,getVirtualScrollEl:A,virtualScrollItemSizeComputed:n}){const N=i();const{props:d,emit:O,proxy:B}=N;const{$q:o}=B;let l,k,C,j=[],e;const m=c(0);const p=c(0);const r=c({});const s=c(null);const t=c(null);const v=c(null);const g=c({from:0,to:0});const H=a(()=>(d.tableColspan!==void 0?d.tableColspan:100));if(n===void 0){n=a(()=>d.virtualScrollItemSize);}const I=a(()=>n.value+';'+d.virtualScrollHorizontal);const P=a(()=>I.value+';'+d.virtualScrollSliceRatioBefore+';'+d.virtualScrollSliceRatioAfter);f(P,()=>{q();});f(I,D);function D(){F(k,true);}function J(a){F(a===void 0?k:a);}function y(c,e){const a=u();if(a===void 0||a===null||a.nodeType===8)return;const b=ff(a,A(),s.value,t.value,d.virtualScrollHorizontal,o.lang.rtl,d.virtualScrollStickySizeStart,d.virtualScrollStickySizeEnd);C!==b.scrollViewSize&&q(b.scrollViewSize);z(a,b,Math.min(h.value-1,Math.max(0,parseInt(c,10)||0)),0,pO.indexOf(e)!==-1?e:(k!==-1&&c>k?'end':'start'));}function Q(){const f=u();if(f===void 0||f===null||f.nodeType===8)return;const a=ff(f,A(),s.value,t.value,d.virtualScrollHorizontal,o.lang.rtl,d.virtualScrollStickySizeStart,d.virtualScrollStickySizeEnd),i=h.value-1,r=a.scrollMaxSize-a.offsetStart-a.offsetEnd-p.value;if(l===a.scrollStart)return;if(a.scrollMaxSize<=0){z(f,a,0,0);return;}C!==a.scrollViewSize&&q(a.scrollViewSize);E(g.value.from);const n=Math.floor(a.scrollMaxSize-Math.max(a.scrollViewSize,a.offsetEnd)-Math.min(e[i],a.scrollViewSize/2));if(n>0&&Math.ceil(a.scrollStart)>=n){z(f,a,i,a.scrollMaxSize-a.offsetEnd-j.reduce(bP,0));return;}let c=0,b=a.scrollStart-a.offsetStart,k=b;if(b<=r&&b+a.scrollViewSize>=m.value){b-=m.value;c=g.value.from;k=b;}else{for(let a=0;b>=j[a]&&c<i;a++){b-=j[a];c+=au;}}while(b>0&&c<i){b-=e[c];if(b>-a.scrollViewSize){c++;k=b;}else{k=e[c]+b;}}z(f,a,c,k);}function z(u,c,f,w,n){const s=typeof n==='string'&&n.indexOf('-force')!==-1;const i=s===true?n.replace('-force',''):n;const x=i!==void 0?i:'start';let a=Math.max(0,f-r.value[x]),b=a+r.value.total;if(b>h.value){b=h.value;a=Math.max(0,b-r.value.total);}l=c.scrollStart;const q=a!==g.value.from||b!==g.value.to;if(q===false&&i===void 0){L(f);return;}const{activeElement:t}=document;const k=v.value;if(q===true&&k!==null&&k!==t&&k.contains(t)===true){k.addEventListener('focusout',K);setTimeout(()=>{k?.removeEventListener('focusout',K);});}pP(k,f-a);const y=i!==void 0?e.slice(a,f).reduce(bP,0):0;if(q===true){const d=b>=g.value.from&&a<=g.value.to?g.value.to:b;g.value={from:a,to:d};m.value=cx(j,e,0,a);p.value=cx(j,e,b,h.value);requestAnimationFrame(()=>{if(g.value.to!==b&&l===c.scrollStart){g.value={from:g.value.from,to:b};p.value=cx(j,e,b,h.value);}});}requestAnimationFrame(()=>{if(l!==c.scrollStart)return;if(q===true){E(a);}const h=e.slice(a,f).reduce(bP,0),b=h+c.offsetStart+m.value,j=b+e[f];let g=b+w;if(i!==void 0){const d=h-y;const a=c.scrollStart+d;g=s!==true&&a<b&&j<a+c.scrollViewSize?a:(i==='end'?j-c.scrollViewSize:b-(i==='start'?0:Math.round((c.scrollViewSize-e[f])/2)));}l=g;jw(u,g,d.virtualScrollHorizontal,o.lang.rtl);L(f);});}function E(b){const a=v.value;if(a){const c=jv.call(a.children,a=>a.classList&&a.classList.contains('q-virtual-scroll--skip')===false),i=c.length,k=d.virtualScrollHorizontal===true?a=>a.getBoundingClientRect().width:a=>a.offsetHeight;let f=b,h,g;for(let a=0;a<i;){h=k(c[a]);a++;while(a<i&&c[a].classList.contains('q-virtual-scroll--with-prev')===true){h+=k(c[a]);a++;}g=h-e[f];if(g!==0){e[f]+=g;j[Math.floor(f/au)]+=g;}f++;}}}function K(){v.value?.focus();}function F(a,b){const c=1*n.value;if(b===true||Array.isArray(e)===false){e=[];}const d=e.length;e.length=h.value;for(let a=h.value-1;a>=d;a--){e[a]=c;}const f=Math.floor((h.value-1)/au);j=[];for(let a=0;a<=f;a++){let b=0;const c=Math.min((a+1)*au,h.value);for(let d=a*au;d<c;d++){b+=e[d];}j.push(b);}k=-1;l=void 0;m.value=cx(j,e,0,g.value.from);p.value=cx(j,e,g.value.to,h.value);if(a>=0){E(g.value.from);w(()=>{y(a);});}else{G();}}function q(a){if(a===void 0&&typeof window!=='undefined'){const b=u();if(b!==void 0&&b!==null&&b.nodeType!==8){a=ff(b,A(),s.value,t.value,d.virtualScrollHorizontal,o.lang.rtl,d.virtualScrollStickySizeStart,d.virtualScrollStickySizeEnd).scrollViewSize;}}C=a;const b=parseFloat(d.virtualScrollSliceRatioBefore)||0;const g=parseFloat(d.virtualScrollSliceRatioAfter)||0;const e=1+b+g;const f=a===void 0||a<=0?1:Math.ceil(a/n.value);const c=Math.max(1,f,Math.ceil((d.virtualScrollSliceSize>0?d.virtualScrollSliceSize:10)/e));r.value={total:Math.ceil(c*e),start:Math.ceil(c*b),center:Math.ceil(c*(0.5+b)),end:Math.ceil(c*(1+b)),view:f};}function R(a,f){const c=d.virtualScrollHorizontal===true?'width':'height';const e={['--q-virtual-scroll-item-'+c]:n.value+'px'};return[a==='tbody'?b(a,{class:'q-virtual-scroll__padding',key:'before',ref:s},[b('tr',[b('td',{style:{[c]:`${m.value}px`,...e},colspan:H.value})])]):b(a,{class:'q-virtual-scroll__padding',key:'before',ref:s,style:{[c]:`${m.value}px`,...e}}),b(a,{class:'q-virtual-scroll__content',key:'content',ref:v,tabindex:-1},f.flat()),a==='tbody'?b(a,{class:'q-virtual-scroll__padding',key:'after',ref:t},[b('tr',[b('td',{style:{[c]:`${p.value}px`,...e},colspan:H.value})])]):b(a,{class:'q-virtual-scroll__padding',key:'after',ref:t,style:{[c]:`${p.value}px`,...e}})];}function L(a){if(k!==a){d.onVirtualScroll!==void 0&&O('virtualScroll',{index:a,from:g.value.from,to:g.value.to-1,direction:a<k?'decrease':'increase',ref:B});k=a;}}q();const G=eL(Q,o.platform.is.ios===true?120:35);b4(()=>{q();});let M=false;bf(()=>{M=true;});b3(()=>{if(M!==true)return;const a=u();if(l!==void 0&&a!==void 0&&a!==null&&a.nodeType!==8){jw(a,l,d.virtualScrollHorizontal,o.lang.rtl);}else{y(k);}});x(()=>{G.cancel();});Object.assign(B,{scrollTo:y,reset:D,refresh:J});return{virtualScrollSliceRange:g,virtualScrollSliceSizeComputed:r,setVirtualScrollSize:q,onVirtualScrollEvt:G,localResetVirtualScroll:F,padVirtualScroll:R,scrollTo:y,reset:D,refresh:J};}const pQ={list:hh,table:pN};const pR=['list','table','__qtable'];const pS=n({name:'QVirtualScroll',props:{...fg,type:{type:String,default:'list',validator:a=>pR.includes(a)},items:{type:Array,default:()=>[]},itemsFn:Function,itemsSize:Number,scrollTarget:eB},setup(d,{slots:h,attrs:m}){let e;const i=c(null);const j=a(()=>(d.itemsSize>=0&&d.itemsFn!==void 0?parseInt(d.itemsSize,10):(Array.isArray(d.items)?d.items.length:0)));const{virtualScrollSliceRange:g,localResetVirtualScroll:n,padVirtualScroll:s,onVirtualScrollEvt:o}=jz({virtualScrollLength:j,getVirtualScrollTarget:v,getVirtualScrollEl:q});const t=a(()=>{if(j.value===0){return[];}const a=(a,b)=>({index:g.value.from+b,item:a});return d.itemsFn===void 0?d.items.slice(g.value.from,g.value.to).map(a):d.itemsFn(g.value.from,g.value.to-g.value.from).map(a);});const p=a(()=>'q-virtual-scroll q-virtual-scroll'+(d.virtualScrollHorizontal===true?'--horizontal':'--vertical')+(d.scrollTarget!==void 0?'':' scroll'));const u=a(()=>(d.scrollTarget!==void 0?{}:{tabindex:0}));f(j,()=>{n();});f(()=>d.scrollTarget,()=>{l();k();});function q(){return i.value.$el||i.value;}function v(){return e;}function k(){e=eC(q(),d.scrollTarget);e.addEventListener('scroll',o,E.passive);}function l(){if(e!==void 0){e.removeEventListener('scroll',o,E.passive);e=void 0;}}function r(){let a=s(d.type==='list'?'div':'tbody',t.value.map(h.default));if(h.before!==void 0){a=h.before().concat(a);}return ap(h.after,a);}b4(()=>{n();});L(()=>{k();});b3(()=>{k();});bf(()=>{l();});x(()=>{l();});return()=>{if(h.default===void 0){console.error('QVirtualScroll: default scoped slot is required for rendering');return;}return d.type==='__qtable'?ju({ref:i,class:'q-table__middle '+p.value},r()):b(pQ[d.type],{...m,ref:i,class:[m.class,p.value],...u.value},r);};}});const pT=n({name:'QField',inheritAttrs:false,props:{...dk,tag:{type:String,default:'label'}},emits:eM,setup(){return eO(eN({tagProp:true}));}});const pU={xs:8,sm:10,md:14,lg:20,xl:24};const pV=n({name:'QChip',props:{...ac,...cg,dense:Boolean,icon:String,iconRight:String,iconRemove:String,iconSelected:String,label:[String,Number],color:String,textColor:String,modelValue:{type:Boolean,default:true},selected:{type:Boolean,default:null},square:Boolean,outline:Boolean,clickable:Boolean,removable:Boolean,removeAriaLabel:String,tabindex:[String,Number],disable:Boolean,ripple:{type:[Boolean,Object],default:true}},emits:['update:modelValue','update:selected','remove','click'],setup(c,{slots:k,emit:d}){const{proxy:{$q:e}}=i();const l=ad(c,e);const m=ch(c,pU);const n=a(()=>c.selected===true||c.icon!==void 0);const o=a(()=>(c.selected===true?c.iconSelected||e.iconSet.chip.selected:c.icon));const p=a(()=>c.iconRemove||e.iconSet.chip.remove);const f=a(()=>c.disable===false&&(c.clickable===true||c.selected!==null));const q=a(()=>{const a=c.outline===true?c.color||c.textColor:c.textColor;return'q-chip row inline no-wrap items-center'+(c.outline===false&&c.color!==void 0?` bg-${c.color}`:'')+(a?` text-${a} q-chip--colored`:'')+(c.disable===true?' disabled':'')+(c.dense===true?' q-chip--dense':'')+(c.outline===true?' q-chip--outline':'')+(c.selected===true?' q-chip--selected':'')+(f.value===true?' q-chip--clickable cursor-pointer non-selectable q-hoverable':'')+(c.square===true?' q-chip--square':'')+(l.value===true?' q-chip--dark q-dark':'');});const g=a(()=>{const a=c.disable===true?{tabindex:-1,'aria-disabled':'true'}:{tabindex:c.tabindex||0};const b={...a,role:'button','aria-hidden':'false','aria-label':c.removeAriaLabel||e.lang.label.remove};return{chip:a,remove:b};});function r(a){a.keyCode===13&&h(a);}function h(a){if(!c.disable){d('update:selected',!c.selected);d('click',a);}}function j(a){if(a.keyCode===void 0||a.keyCode===13){B(a);if(c.disable===false){d('update:modelValue',false);d('remove');}}}function s(){const a=[];f.value===true&&a.push(b('div',{class:'q-focus-helper'}));n.value===true&&a.push(b(S,{class:'q-chip__icon q-chip__icon--left',name:o.value}));const d=c.label!==void 0?[b('div',{class:'ellipsis'},[c.label])]:void 0;a.push(b('div',{class:'q-chip__content col row no-wrap items-center q-anchor--skip'},l1(k.default,d)));c.iconRight&&a.push(b(S,{class:'q-chip__icon q-chip__icon--right',name:c.iconRight}));c.removable===true&&a.push(b(S,{class:'q-chip__icon q-chip__icon--remove cursor-pointer',name:p.value,...g.value.remove,onClick:j,onKeyup:j}));return a;}return()=>{if(c.modelValue===false)return;const a={class:q.value,style:m.value};f.value===true&&Object.assign(a,g.value.chip,{onClick:h,onKeyup:r});return gX('div',a,s(),'ripple',c.ripple!==false&&c.disable!==true,()=>[[ew,c.ripple]]);};}});const pW=n({name:'QItemLabel',props:{overline:Boolean,caption:Boolean,header:Boolean,lines:[Number,String]},setup(c,{slots:e}){const d=a(()=>parseInt(c.lines,10));const f=a(()=>'q-item__label'+(c.overline===true?' q-item__label--overline text-overline':'')+(c.caption===true?' q-item__label--caption text-caption':'')+(c.header===true?' q-item__label--header':'')+(d.value===1?' ellipsis':''));const g=a(()=>{return c.lines!==void 0&&d.value>1?{overflow:'hidden',display:'-webkit-box','-webkit-box-orient':'vertical','-webkit-line-clamp':d.value}:null;});return()=>b('div',{style:g.value,class:f.value},r(e.default));}});function jA(e,a,c){if(c<=a){return a;}const d=(c-a+1);let b=a+(e-a)%d;if(b<a){b=d+b;}return b===0?0:b;}const jB=a=>['add','add-unique','toggle'].includes(a);const pX='.*+?^${}()|[]\\';const pY=Object.keys(dk);function fh(a,c){if(typeof a==='function')return a;const b=a!==void 0?a:c;return a=>((a!==null&&typeof a==='object'&&b in a)?a[b]:a);}const pZ=n({name:'QSelect',inheritAttrs:false,props:{...fg,...eP,...dk,modelValue:{required:true},multiple:Boolean,displayValue:[String,Number],displayValueHtml:Boolean,dropdownIcon:String,options:{type:Array,default:()=>[]},optionValue:[Function,String],optionLabel:[Function,String],optionDisable:[Function,String],hideSelected:Boolean,hideDropdownIcon:Boolean,fillInput:Boolean,maxValues:[Number,String],optionsDense:Boolean,optionsDark:{type:Boolean,default:null},optionsSelectedClass:String,optionsHtml:Boolean,optionsCover:Boolean,menuShrink:Boolean,menuAnchor:String,menuSelf:String,menuOffset:Array,popupContentClass:String,popupContentStyle:[String,Array,Object],popupNoRouteDismiss:Boolean,useInput:Boolean,useChips:Boolean,newValueMode:{type:String,validator:jB},mapOptions:Boolean,emitValue:Boolean,disableTabSelection:Boolean,inputDebounce:{type:[Number,String],default:500},inputClass:[Array,String,Object],inputStyle:[Array,String,Object],tabindex:{type:[String,Number],default:0},autocomplete:String,transitionShow:{},transitionHide:{},transitionDuration:{},behavior:{type:String,validator:a=>['default','menu','dialog'].includes(a),default:'default'},virtualScrollItemSize:fg.virtualScrollItemSize.type,onNewValue:Function,onFilter:Function},emits:[...eM,'add','remove','inputValue','keyup','keypress','keydown','popupShow','popupHide','filterAbort'],setup(d,{slots:m,emit:g}){const{proxy:O}=i();const{$q:K}=O;const h=c(false);const u=c(false);const j=c(-1);const l=c('');const L=c(false);const C=c(false);let q=null,r=null,V,p,P,s=null,W,ad,v,ae;const aK=c(null);const n=c(null);const D=c(null);const X=c(null);const af=c(null);const ag=h1(d);const ah=h2(aF);const y=a(()=>(Array.isArray(d.options)?d.options.length:0));const aL=a(()=>(d.virtualScrollItemSize===void 0?(d.optionsDense===true?24:48):d.virtualScrollItemSize));const{virtualScrollSliceRange:aO,virtualScrollSliceSizeComputed:ai,localResetVirtualScroll:Y,padVirtualScroll:aP,onVirtualScrollEvt:aj,scrollTo:ak,setVirtualScrollSize:al}=jz({virtualScrollLength:y,getVirtualScrollTarget:a$,getVirtualScrollEl:aD,virtualScrollItemSizeComputed:aL});const e=eN();const k=a(()=>{const a=d.mapOptions===true&&d.multiple!==true,b=d.modelValue!==void 0&&(d.modelValue!==null||a===true)?(d.multiple===true&&Array.isArray(d.modelValue)?d.modelValue:[d.modelValue]):[];if(d.mapOptions===true&&Array.isArray(d.options)===true){const e=d.mapOptions===true&&V!==void 0?V:[];const c=b.map(a=>aZ(a,e));return d.modelValue===null&&a===true?c.filter(a=>a!==null):c;}return b;});const aQ=a(()=>{const a={};pY.forEach(b=>{const c=d[b];if(c!==void 0){a[b]=c;}});return a;});const Q=a(()=>(d.optionsDark===null?e.isDark.value:d.optionsDark));const Z=a(()=>cn(k.value));const aR=a(()=>{let a='q-field__input q-placeholder col';if(d.hideSelected===true||k.value.length===0){return[a,d.inputClass];}a+=' q-field__input--padding';return d.inputClass===void 0?a:[a,d.inputClass];});const am=a(()=>(d.virtualScrollHorizontal===true?'q-virtual-scroll--horizontal':'')+(d.popupContentClass?' '+d.popupContentClass:''));const M=a(()=>y.value===0);const an=a(()=>k.value.map(a=>o.value(a)).join(', '));const ao=a(()=>(d.displayValue!==void 0?d.displayValue:an.value));const $=a(()=>(d.optionsHtml===true?()=>true:a=>a?.html===true));const aS=a(()=>(d.displayValueHtml===true||(d.displayValue===void 0&&(d.optionsHtml===true||k.value.some($.value)))));const aq=a(()=>(e.focused.value===true?d.tabindex:-1));const ar=a(()=>{const a={tabindex:d.tabindex,role:'combobox','aria-label':d.label,'aria-readonly':d.readonly===true?'true':'false','aria-autocomplete':d.useInput===true?'list':'none','aria-expanded':h.value===true?'true':'false','aria-controls':`${e.targetUid.value}_lb`};if(j.value>=0){a['aria-activedescendant']=`${e.targetUid.value}_${j.value}`;}return a;});const at=a(()=>({id:`${e.targetUid.value}_lb`,role:'listbox','aria-multiselectable':d.multiple===true?'true':'false'}));const au=a(()=>{return k.value.map((a,b)=>({index:b,opt:a,html:$.value(a),selected:true,removeAtIndex:aY,toggleOption:z,tabindex:aq.value}));});const aT=a(()=>{if(y.value===0){return[];}const{from:a,to:b}=aO.value;return d.options.slice(a,b).map((b,k)=>{const g=E.value(b)===true;const i=aa(b)===true;const f=a+k;const c={clickable:true,active:i,activeClass:aW.value,manualFocus:true,focused:false,disable:g,tabindex:-1,dense:d.optionsDense,dark:Q.value,role:'option','aria-selected':i===true?'true':'false',id:`${e.targetUid.value}_${f}`,onClick:()=>{z(b);}};if(g!==true){j.value===f&&(c.focused=true);if(K.platform.is.desktop===true){c.onMousemove=()=>{h.value===true&&F(f);};}}return{index:f,opt:b,html:$.value(b),label:o.value(b),selected:c.active,focused:c.focused,toggleOption:z,setOptionIndex:F,itemProps:c};});});const aU=a(()=>(d.dropdownIcon!==void 0?d.dropdownIcon:K.iconSet.arrow.dropdown));const aV=a(()=>d.optionsCover===false&&d.outlined!==true&&d.standout!==true&&d.borderless!==true&&d.rounded!==true);const aW=a(()=>(d.optionsSelectedClass!==void 0?d.optionsSelectedClass:(d.color!==void 0?`text-${d.color}`:'')));const t=a(()=>fh(d.optionValue,'value'));const o=a(()=>fh(d.optionLabel,'label'));const E=a(()=>fh(d.optionDisable,'disable'));const R=a(()=>k.value.map(t.value));const aX=a(()=>{const a={onInput:aF,onChange:ah,onKeydown:aC,onKeyup:az,onKeypress:aB,onFocus:ax,onClick(a){p===true&&aA(a);}};a.onCompositionstart=a.onCompositionupdate=a.onCompositionend=ah;return a;});f(k,a=>{V=a;if(d.useInput===true&&d.fillInput===true&&d.multiple!==true&&e.innerLoading.value!==true&&((u.value!==true&&h.value!==true)||Z.value!==true)){P!==true&&J();if(u.value===true||h.value===true){G('');}}},{immediate:true});f(()=>d.fillInput,J);f(h,ac);f(y,ba);function av(a){return d.emitValue===true?t.value(a):a;}function _(a){if(a!==-1&&a<k.value.length){if(d.multiple===true){const b=d.modelValue.slice();g('remove',{index:a,value:b.splice(a,1)[0]});g('update:modelValue',b);}else{g('update:modelValue',null);}}}function aY(a){_(a);e.focus();}function aw(b,e){const a=av(b);if(d.multiple!==true){d.fillInput===true&&N(o.value(b),true,true);g('update:modelValue',a);return;}if(k.value.length===0){g('add',{index:0,value:a});g('update:modelValue',d.multiple===true?[a]:a);return;}if(e===true&&aa(b)===true)return;if(d.maxValues!==void 0&&d.modelValue.length>=d.maxValues)return;const c=d.modelValue.slice();g('add',{index:c.length,value:a});c.push(a);g('update:modelValue',c);}function z(a,h){if(e.editable.value!==true||a===void 0||E.value(a)===true)return;const b=t.value(a);if(d.multiple!==true){if(h!==true){N(d.fillInput===true?o.value(a):'',true,true);A();}n.value?.focus();if(k.value.length===0||aN(t.value(k.value[0]),b)!==true){g('update:modelValue',d.emitValue===true?b:a);}return;}if(p!==true||L.value===true){e.focus();}ax();if(k.value.length===0){const c=d.emitValue===true?b:a;g('add',{index:0,value:c});g('update:modelValue',d.multiple===true?[c]:c);return;}const c=d.modelValue.slice(),f=R.value.findIndex(a=>aN(a,b));if(f!==-1){g('remove',{index:f,value:c.splice(f,1)[0]});}else{if(d.maxValues!==void 0&&c.length>=d.maxValues)return;const e=d.emitValue===true?b:a;g('add',{index:c.length,value:e});c.push(e);}g('update:modelValue',c);}function F(a){if(K.platform.is.desktop!==true)return;const b=a!==-1&&a<y.value?a:-1;if(j.value!==b){j.value=b;}}function T(a=1,b){if(h.value===true){let c=j.value;do{c=jA(c+a,-1,y.value-1);}while(c!==-1&&c!==j.value&&E.value(d.options[c])===true);if(j.value!==c){F(c);ak(c);if(b!==true&&d.useInput===true&&d.fillInput===true){U(c>=0?o.value(d.options[c]):W,true);}}}}function aZ(a,c){const b=b=>aN(t.value(b),a);return d.options.find(b)||c.find(b)||a;}function aa(a){const b=t.value(a);return R.value.find(a=>aN(a,b))!==void 0;}function ax(a){if(d.useInput===true&&n.value!==null&&(a===void 0||(n.value===a.target&&a.target.value===an.value))){n.value.select();}}function ay(a){if(aM(a,27)===true&&h.value===true){aA(a);A();J();}g('keyup',a);}function az(a){const{value:b}=a.target;if(a.keyCode!==void 0){ay(a);return;}a.target.value='';if(q!==null){clearTimeout(q);q=null;}if(r!==null){clearTimeout(r);r=null;}J();if(typeof b==='string'&&b.length!==0){const e=b.toLocaleLowerCase();const a=b=>{const a=d.options.find(a=>String(b.value(a)).toLocaleLowerCase()===e);if(a===void 0)return false;if(k.value.indexOf(a)===-1){z(a);}else{A();}return true;};const c=d=>{if(a(t)!==true&&d!==true&&a(o)!==true){G(b,true,()=>c(true));}};c();}else{e.clearValue(a);}}function aB(a){g('keypress',a);}function aC(a){g('keydown',a);if(c3(a)===true)return;const c=l.value.length!==0&&(d.newValueMode!==void 0||d.onNewValue!==void 0);const f=a.shiftKey!==true&&d.disableTabSelection!==true&&d.multiple!==true&&(j.value!==-1||c===true);if(a.keyCode===27){ab(a);return;}if(a.keyCode===9&&f===false){H();return;}if(a.target===void 0||a.target.id!==e.targetUid.value||e.editable.value!==true)return;if(a.keyCode===40&&e.innerLoading.value!==true&&h.value===false){B(a);I();return;}if(a.keyCode===8&&(d.useChips===true||d.clearable===true)&&d.hideSelected!==true&&l.value.length===0){if(d.multiple===true&&Array.isArray(d.modelValue)===true){_(d.modelValue.length-1);}else if(d.multiple!==true&&d.modelValue!==null){g('update:modelValue',null);}return;}if((a.keyCode===35||a.keyCode===36)&&(typeof l.value!=='string'||l.value.length===0)){B(a);j.value=-1;T(a.keyCode===36?1:-1,d.multiple);}if((a.keyCode===33||a.keyCode===34)&&ai.value!==void 0){B(a);j.value=Math.max(-1,Math.min(y.value,j.value+(a.keyCode===33?-1:1)*ai.value.view));T(a.keyCode===33?1:-1,d.multiple);}if(a.keyCode===38||a.keyCode===40){B(a);T(a.keyCode===38?-1:1,d.multiple);}const b=y.value;if(v===void 0||ae<Date.now()){v='';}if(b>0&&d.useInput!==true&&a.key!==void 0&&a.key.length===1&&a.altKey===false&&a.ctrlKey===false&&a.metaKey===false&&(a.keyCode!==32||v.length!==0)){h.value!==true&&I(a);const e=a.key.toLocaleLowerCase(),f=v.length===1&&v[0]===e;ae=Date.now()+1500;if(f===false){B(a);v+=e;}const g=new RegExp('^'+v.split('').map(a=>(pX.indexOf(a)!==-1?'\\'+a:a)).join('.*'),'i');let c=j.value;if(f===true||c<0||g.test(o.value(d.options[c]))!==true){do{c=jA(c+1,-1,b-1);}while(c!==j.value&&(E.value(d.options[c])===true||g.test(o.value(d.options[c]))!==true));}if(j.value!==c){w(()=>{F(c);ak(c);if(c>=0&&d.useInput===true&&d.fillInput===true){U(o.value(d.options[c]),true);}});}return;}if(a.keyCode!==13&&(a.keyCode!==32||d.useInput===true||v!=='')&&(a.keyCode!==9||f===false))return;a.keyCode!==9&&B(a);if(j.value!==-1&&j.value<b){z(d.options[j.value]);return;}if(c===true){const a=(b,a)=>{if(a){if(jB(a)!==true)return;}else{a=d.newValueMode;}N('',d.multiple!==true,true);if(b===void 0||b===null)return;const c=a==='toggle'?z:aw;c(b,a==='add-unique');if(d.multiple!==true){n.value?.focus();A();}};if(d.onNewValue!==void 0){g('newValue',l.value,a);}else{a(l.value);}if(d.multiple!==true)return;}if(h.value===true){H();}else if(e.innerLoading.value!==true){I();}}function aD(){return p===true?af.value:(D.value!==null&&D.value.contentEl!==null?D.value.contentEl:void 0);}function a$(){return aD();}function a_(){if(d.hideSelected===true){return[];}if(m['selected-item']!==void 0){return au.value.map(a=>m['selected-item'](a)).slice();}if(m.selected!==void 0){return[].concat(m.selected());}if(d.useChips===true){return au.value.map((a,c)=>b(pV,{key:'option-'+c,removable:e.editable.value===true&&E.value(a.opt)!==true,dense:true,textColor:d.color,tabindex:aq.value,onRemove(){a.removeAtIndex(c);}},()=>b('span',{class:'ellipsis',[a.html===true?'innerHTML':'textContent']:o.value(a.opt)})));}return[b('span',{class:'ellipsis',[aS.value===true?'innerHTML':'textContent']:ao.value})];}function aE(){if(M.value===true){return m['no-option']!==void 0?m['no-option']({inputValue:l.value}):void 0;}const c=m.option!==void 0?m.option:a=>{return b(ez,{key:a.index,...a.itemProps},()=>{return b(ey,()=>b(pW,()=>b('span',{[a.html===true?'innerHTML':'textContent']:a.label})));});};let a=aP('div',aT.value.map(c));if(m['before-options']!==void 0){a=m['before-options']().concat(a);}return ap(m['after-options'],a);}function a0(f,c){const g=c===true?{...ar.value,...e.splitAttrs.attributes.value}:void 0;const a={ref:c===true?n:void 0,key:'i_t',class:aR.value,style:d.inputStyle,value:l.value!==void 0?l.value:'',type:'search',...g,id:c===true?e.targetUid.value:void 0,maxlength:d.maxlength,autocomplete:d.autocomplete,'data-autofocus':f===true||d.autofocus===true||void 0,disabled:d.disable===true,readonly:d.readonly===true,...aX.value};if(f!==true&&p===true){if(Array.isArray(a.class)===true){a.class=[...a.class,'no-pointer-events'];}else{a.class+=' no-pointer-events';}}return b('input',a);}function aF(a){if(q!==null){clearTimeout(q);q=null;}if(r!==null){clearTimeout(r);r=null;}if(a&&a.target&&a.target.qComposing===true)return;U(a.target.value||'');P=true;W=l.value;if(e.focused.value!==true&&(p!==true||L.value===true)){e.focus();}if(d.onFilter!==void 0){q=setTimeout(()=>{q=null;G(l.value);},d.inputDebounce);}}function U(a,b){if(l.value!==a){l.value=a;if(b===true||d.inputDebounce===0||d.inputDebounce==='0'){g('inputValue',a);}else{r=setTimeout(()=>{r=null;g('inputValue',a);},d.inputDebounce);}}}function N(a,b,c){P=c!==true;if(d.useInput===true){U(a,true);if(b===true||c!==true){W=a;}b!==true&&G(a);}}function G(a,b,f){if(d.onFilter===void 0||(b!==true&&e.focused.value!==true))return;if(e.innerLoading.value===true){g('filterAbort');}else{e.innerLoading.value=true;C.value=true;}if(a!==''&&d.multiple!==true&&k.value.length!==0&&P!==true&&a===o.value(k.value[0])){a='';}const c=setTimeout(()=>{h.value===true&&(h.value=false);},10);s!==null&&clearTimeout(s);s=c;g('filter',a,(a,d)=>{if((b===true||e.focused.value===true)&&s===c){clearTimeout(s);typeof a==='function'&&a();C.value=false;w(()=>{e.innerLoading.value=false;if(e.editable.value===true){if(b===true){h.value===true&&A();}else if(h.value===true){ac(true);}else{h.value=true;}}typeof d==='function'&&w(()=>{d(O);});typeof f==='function'&&w(()=>{f(O);});});}},()=>{if(e.focused.value===true&&s===c){clearTimeout(s);e.innerLoading.value=false;C.value=false;}h.value===true&&(h.value=false);});}function a1(){return b(hI,{ref:D,class:am.value,style:d.popupContentStyle,modelValue:h.value,fit:d.menuShrink!==true,cover:d.optionsCover===true&&M.value!==true&&d.useInput!==true,anchor:d.menuAnchor,self:d.menuSelf,offset:d.menuOffset,dark:Q.value,noParentEvent:true,noRefocus:true,noFocus:true,noRouteDismiss:d.popupNoRouteDismiss,square:aV.value,transitionShow:d.transitionShow,transitionHide:d.transitionHide,transitionDuration:d.transitionDuration,separateClosePopup:true,...at.value,onScrollPassive:aj,onBeforeShow:aH,onBeforeHide:a2,onShow:a3},aE);}function a2(a){aI(a);H();}function a3(){al();}function a4(a){aA(a);n.value?.focus();L.value=true;window.scrollTo(window.pageXOffset||window.scrollX||document.body.scrollLeft||0,0);}function a5(a){aA(a);w(()=>{L.value=false;});}function a6(){const a=[b(pT,{class:`col-auto ${e.fieldClass.value}`,...aQ.value,for:e.targetUid.value,dark:Q.value,square:true,loading:C.value,itemAligned:false,filled:true,stackLabel:l.value.length!==0,...e.splitAttrs.listeners.value,onFocus:a4,onBlur:a5},{...m,rawControl:()=>e.getControl(true),before:void 0,after:void 0})];h.value===true&&a.push(b('div',{ref:af,class:am.value+' scroll',style:d.popupContentStyle,...at.value,onClick:ab,onScrollPassive:aj},aE()));return b(eT,{ref:X,modelValue:u.value,position:d.useInput===true?'top':void 0,transitionShow:ad,transitionHide:d.transitionHide,transitionDuration:d.transitionDuration,noRouteDismiss:d.popupNoRouteDismiss,onBeforeShow:aH,onBeforeHide:a7,onHide:a8,onShow:a9},()=>b('div',{class:'q-select__dialog'+(Q.value===true?' q-select__dialog--dark q-dark':'')+(L.value===true?' q-select__dialog--focused':'')},a));}function a7(a){aI(a);if(X.value!==null){X.value.__updateRefocusTarget(e.rootRef.value.querySelector('.q-field__native > [tabindex]:last-child'));}e.focused.value=false;}function a8(a){A();e.focused.value===false&&g('blur',a);J();}function a9(){const a=document.activeElement;if((a===null||a.id!==e.targetUid.value)&&n.value!==null&&n.value!==a){n.value.focus();}al();}function H(){if(u.value===true)return;j.value=-1;if(h.value===true){h.value=false;}if(e.focused.value===false){if(s!==null){clearTimeout(s);s=null;}if(e.innerLoading.value===true){g('filterAbort');e.innerLoading.value=false;C.value=false;}}}function I(a){if(e.editable.value!==true)return;if(p===true){e.onControlFocusin(a);u.value=true;w(()=>{e.focus();});}else{e.focus();}if(d.onFilter!==void 0){G(l.value);}else if(M.value!==true||m['no-option']!==void 0){h.value=true;}}function A(){u.value=false;H();}function J(){d.useInput===true&&N(d.multiple!==true&&d.fillInput===true&&k.value.length!==0?o.value(k.value[0])||'':'',true,true);}function ac(b){let a=-1;if(b===true){if(k.value.length!==0){const b=t.value(k.value[0]);a=d.options.findIndex(a=>aN(t.value(a),b));}Y(a);}F(a);}function ba(a,b){if(h.value===true&&e.innerLoading.value===false){Y(-1,true);w(()=>{if(h.value===true&&e.innerLoading.value===false){if(a>b){Y();}else{ac(true);}}});}}function aG(){if(u.value===false&&D.value!==null){D.value.updatePosition();}}function aH(a){a!==void 0&&aA(a);g('popupShow',a);e.hasPopupOpen=true;e.onControlFocusin(a);}function aI(a){a!==void 0&&aA(a);g('popupHide',a);e.hasPopupOpen=false;e.onControlFocusout(a);}function aJ(){p=K.platform.is.mobile!==true&&d.behavior!=='dialog'?false:d.behavior!=='menu'&&(d.useInput===true?m['no-option']!==void 0||d.onFilter!==void 0||M.value===false:true);ad=K.platform.is.ios===true&&p===true&&d.useInput===true?'fade':d.transitionShow;}d3(aJ);d4(aG);aJ();x(()=>{q!==null&&clearTimeout(q);r!==null&&clearTimeout(r);});Object.assign(O,{showPopup:I,hidePopup:A,removeAtIndex:_,add:aw,toggleOption:z,getOptionIndex:()=>j.value,setOptionIndex:F,moveOptionSelection:T,filter:G,updateMenuPosition:aG,updateInputValue:N,isOptionSelected:aa,getEmittingOptionValue:av,isOptionDisabled:(...a)=>E.value.apply(null,a)===true,getOptionValue:(...a)=>t.value.apply(null,a),getOptionLabel:(...a)=>o.value.apply(null,a)});Object.assign(e,{innerValue:k,fieldClass:a(()=>`q-select q-field--auto-height q-select--with${d.useInput!==true?'out':''}-input`+` q-select--with${d.useChips!==true?'out':''}-chips`+` q-select--${d.multiple===true?'multiple':'single'}`),inputRef:aK,targetRef:n,hasValue:Z,showPopup:I,floatingLabel:a(()=>(d.hideSelected!==true&&Z.value===true)||typeof l.value==='number'||l.value.length!==0||cn(d.displayValue)),getControlChild:()=>{if(e.editable.value!==false&&(u.value===true||M.value!==true||m['no-option']!==void 0)){return p===true?a6():a1();}else if(e.hasPopupOpen===true){e.hasPopupOpen=false;}},controlEvents:{onFocusin(a){e.onControlFocusin(a);},onFocusout(a){e.onControlFocusout(a,()=>{J();H();});},onClick(a){ab(a);if(p!==true&&h.value===true){H();n.value?.focus();return;}I(a);}},getControl:f=>{const c=a_();const a=f===true||u.value!==true||p!==true;if(d.useInput===true){c.push(a0(f,a));}else if(e.editable.value===true){const g=a===true?ar.value:void 0;c.push(b('input',{ref:a===true?n:void 0,key:'d_t',class:'q-select__focus-target',id:a===true?e.targetUid.value:void 0,value:ao.value,readonly:true,'data-autofocus':f===true||d.autofocus===true||void 0,...g,onKeydown:aC,onKeyup:ay,onKeypress:aB}));if(a===true&&typeof d.autocomplete==='string'&&d.autocomplete.length!==0){c.push(b('input',{class:'q-select__autocomplete-input',autocomplete:d.autocomplete,tabindex:-1,onKeyup:az}));}}if(ag.value!==void 0&&d.disable!==true&&R.value.length!==0){const a=R.value.map(a=>b('option',{value:a,selected:true}));c.push(b('select',{class:'hidden',name:ag.value,multiple:d.multiple},a));}const g=d.useInput===true||a!==true?void 0:e.splitAttrs.attributes.value;return b('div',{class:'q-field__native row items-center',...g,...e.splitAttrs.listeners.value},c);},getInnerAppend:()=>(d.loading!==true&&C.value!==true&&d.hideDropdownIcon!==true?[b(S,{class:'q-select__dropdown-icon'+(h.value===true?' rotate-180':''),name:aU.value})]:null)});return eO(e);}});const p$={xs:2,sm:4,md:6,lg:10,xl:14};function jC(a,b,c){return{transform:b===true?`translateX(${c.lang.rtl===true?'-':''}100%) scale3d(${-a},1,1)`:`scale3d(${a},1,1)`};}const p_=n({name:'QLinearProgress',props:{...ac,...cg,value:{type:Number,default:0},buffer:Number,color:String,trackColor:String,reverse:Boolean,stripe:Boolean,indeterminate:Boolean,query:Boolean,rounded:Boolean,animationSpeed:{type:[String,Number],default:2100},instantFeedback:Boolean},setup(c,{slots:j}){const{proxy:d}=i();const k=ad(c,d.$q);const g=ch(c,p$);const e=a(()=>c.indeterminate===true||c.query===true);const h=a(()=>c.reverse!==c.query);const l=a(()=>({...(g.value!==null?g.value:{}),'--q-linear-progress-speed':`${c.animationSpeed}ms`}));const m=a(()=>'q-linear-progress'+(c.color!==void 0?` text-${c.color}`:'')+(c.reverse===true||c.query===true?' q-linear-progress--reverse':'')+(c.rounded===true?' rounded-borders':''));const n=a(()=>jC(c.buffer!==void 0?c.buffer:1,h.value,d.$q));const f=a(()=>`with${c.instantFeedback===true?'out':''}-transition`);const o=a(()=>'q-linear-progress__track absolute-full'+` q-linear-progress__track--${f.value}`+` q-linear-progress__track--${k.value===true?'dark':'light'}`+(c.trackColor!==void 0?` bg-${c.trackColor}`:''));const p=a(()=>jC(e.value===true?1:c.value,h.value,d.$q));const q=a(()=>'q-linear-progress__model absolute-full'+` q-linear-progress__model--${f.value}`+` q-linear-progress__model--${e.value===true?'in':''}determinate`);const r=a(()=>({width:`${c.value*100}%`}));const s=a(()=>`q-linear-progress__stripe absolute-${c.reverse===true?'right':'left'}`+` q-linear-progress__stripe--${f.value}`);return()=>{const a=[b('div',{class:o.value,style:n.value}),b('div',{class:q.value,style:p.value})];c.stripe===true&&e.value===false&&a.push(b('div',{class:s.value,style:r.value}));return b('div',{class:m.value,style:l.value,role:'progressbar','aria-valuemin':0,'aria-valuemax':1,'aria-valuenow':c.indeterminate===true?void 0:c.value},ap(j.default,a));};}});function p0(e,f){const d=c(null);const g=a(()=>{if(e.disable===true){return null;}return b('span',{ref:d,class:'no-outline',tabindex:-1});});function h(a){const b=f.value;if(a?.qAvoidFocus===true)return;if(a?.type.indexOf('key')===0){if(document.activeElement!==b&&b?.contains(document.activeElement)===true){b.focus();}}else if(d.value!==null&&((a===void 0)||(b?.contains(a.target)===true))){d.value.focus();}}return{refocusTargetEl:g,refocusTarget:h};}const p1={xs:30,sm:35,md:40,lg:50,xl:60};const p2={...ac,...cg,...eP,modelValue:{required:true,default:null},val:{},trueValue:{default:true},falseValue:{default:false},indeterminateValue:{default:null},checkedIcon:String,uncheckedIcon:String,indeterminateIcon:String,toggleOrder:{type:String,validator:a=>a==='tf'||a==='ft'},toggleIndeterminate:Boolean,label:String,leftLabel:Boolean,color:String,keepColor:Boolean,dense:Boolean,disable:Boolean,tabindex:[String,Number]};const p3=['update:modelValue'];function p4(e,s){const{props:d,slots:m,emit:t,proxy:n}=i();const{$q:u}=n;const v=ad(d,u);const o=c(null);const{refocusTargetEl:p,refocusTarget:w}=p0(d,o);const x=ch(d,p1);const g=a(()=>d.val!==void 0&&Array.isArray(d.modelValue));const j=a(()=>{const a=l(d.val);return g.value===true?d.modelValue.findIndex(b=>l(b)===a):-1;});const f=a(()=>(g.value===true?j.value!==-1:l(d.modelValue)===l(d.trueValue)));const h=a(()=>(g.value===true?j.value===-1:l(d.modelValue)===l(d.falseValue)));const q=a(()=>f.value===false&&h.value===false);const y=a(()=>(d.disable===true?-1:d.tabindex||0));const z=a(()=>`q-${e} cursor-pointer no-outline row inline no-wrap items-center`+(d.disable===true?' disabled':'')+(v.value===true?` q-${e}--dark`:'')+(d.dense===true?` q-${e}--dense`:'')+(d.leftLabel===true?' reverse':''));const A=a(()=>{const a=f.value===true?'truthy':(h.value===true?'falsy':'indet');const b=d.color!==void 0&&(d.keepColor===true||(e==='toggle'?f.value===true:h.value!==true))?` text-${d.color}`:'';return`q-${e}__inner relative-position non-selectable q-${e}__inner--${a}${b}`;});const C=a(()=>{const a={type:'checkbox'};d.name!==void 0&&Object.assign(a,{'.checked':f.value,'^checked':f.value===true?'checked':void 0,name:d.name,value:g.value===true?d.val:d.trueValue});return a;});const D=nx(C);const E=a(()=>{const a={tabindex:y.value,role:e==='toggle'?'switch':'checkbox','aria-label':d.label,'aria-checked':q.value===true?'mixed':(f.value===true?'true':'false')};if(d.disable===true){a['aria-disabled']='true';}return a;});function k(a){if(a!==void 0){B(a);w(a);}if(d.disable!==true){t('update:modelValue',F(),a);}}function F(){if(g.value===true){if(f.value===true){const a=d.modelValue.slice();a.splice(j.value,1);return a;}return d.modelValue.concat([d.val]);}if(f.value===true){if(d.toggleOrder!=='ft'||d.toggleIndeterminate===false){return d.falseValue;}}else if(h.value===true){if(d.toggleOrder==='ft'||d.toggleIndeterminate===false){return d.trueValue;}}else{return d.toggleOrder!=='ft'?d.trueValue:d.falseValue;}return d.indeterminateValue;}function G(a){if(a.keyCode===13||a.keyCode===32){B(a);}}function H(a){if(a.keyCode===13||a.keyCode===32){k(a);}}const I=s(f,q);Object.assign(n,{toggle:k});return()=>{const c=I();d.disable!==true&&D(c,'unshift',` q-${e}__native absolute q-ma-none q-pa-none`);const a=[b('div',{class:A.value,style:x.value,'aria-hidden':'true'},c)];if(p.value!==null){a.push(p.value);}const f=d.label!==void 0?ap(m.default,[d.label]):r(m.default);f!==void 0&&a.push(b('div',{class:`q-${e}__label q-anchor--skip`},f));return b('div',{ref:o,class:z.value,...E.value,onClick:k,onKeydown:G,onKeyup:H},a);};}const p5=()=>b('div',{key:'svg',class:'q-checkbox__bg absolute'},[b('svg',{class:'q-checkbox__svg fit absolute-full',viewBox:'0 0 24 24'},[b('path',{class:'q-checkbox__truthy',fill:'none',d:'M1.73,12.91 8.1,19.28 22.79,4.59'}),b('path',{class:'q-checkbox__indet',d:'M4,14H20V10H4'})])]);const fi=n({name:'QCheckbox',props:p2,emits:p3,setup(c){const d=p5();function e(f,g){const e=a(()=>(f.value===true?c.checkedIcon:(g.value===true?c.indeterminateIcon:c.uncheckedIcon))||null);return()=>(e.value!==null?[b('div',{key:'icon',class:'q-checkbox__icon-container absolute-full flex flex-center no-wrap'},[b(S,{class:'q-checkbox__icon',name:e.value})])]:[d]);}return p4('checkbox',e);}});let cy=0;const p6={fullscreen:Boolean,noRouteFullscreenExit:Boolean};const p7=['update:fullscreen','fullscreen'];function p8(){const m=i();const{props:g,emit:n,proxy:a}=m;let d,h,j;const b=c(false);ex(m)===true&&f(()=>a.$route.fullPath,()=>{g.noRouteFullscreenExit!==true&&e();});f(()=>g.fullscreen,a=>{if(b.value!==a){k();}});f(b,a=>{n('update:fullscreen',a);n('fullscreen',a);});function k(){if(b.value===true){e();}else{l();}}function l(){if(b.value===true)return;b.value=true;j=a.$el.parentNode;j.replaceChild(h,a.$el);document.body.appendChild(a.$el);cy++;if(cy===1){document.body.classList.add('q-body--fullscreen-mixin');}d={handler:e};cp.add(d);}function e(){if(b.value!==true)return;if(d!==void 0){cp.remove(d);d=void 0;}j.replaceChild(a.$el,h);b.value=false;cy=Math.max(0,cy-1);if(cy===0){document.body.classList.remove('q-body--fullscreen-mixin');if(a.$el.scrollIntoView!==void 0){setTimeout(()=>{a.$el.scrollIntoView();});}}}b4(()=>{h=document.createElement('span');});L(()=>{g.fullscreen===true&&l();});x(e);Object.assign(a,{toggleFullscreen:k,setFullscreen:l,exitFullscreen:e});return{inFullscreen:b,toggleFullscreen:k};}function p9(a,b){return(new Date(a))-(new Date(b));}const qa={sortMethod:Function,binaryStateSort:Boolean,columnSortOrder:{type:String,validator:a=>a==='ad'||a==='da',default:'ad'}};function qb(b,d,c,e){const f=a(()=>{const{sortBy:a}=d.value;return a?c.value.find(b=>b.name===a)||null:null;});const g=a(()=>(b.sortMethod!==void 0?b.sortMethod:(d,f,g)=>{const a=c.value.find(a=>a.name===f);if(a===void 0||a.field===void 0){return d;}const b=g===true?-1:1,e=typeof a.field==='function'?b=>a.field(b):b=>b[a.field];return d.sort((f,g)=>{let c=e(f),d=e(g);if(a.rawSort!==void 0){return a.rawSort(c,d,f,g)*b;}if(c===null||c===void 0){return-1*b;}if(d===null||d===void 0){return 1*b;}if(a.sort!==void 0){return a.sort(c,d,f,g)*b;}if(eI(c)===true&&eI(d)===true){return(c-d)*b;}if(hM(c)===true&&hM(d)===true){return p9(c,d)*b;}if(typeof c==='boolean'&&typeof d==='boolean'){return(c-d)*b;}[c,d]=[c,d].map(a=>(a+'').toLocaleString().toLowerCase());return c<d?-1*b:(c===d?0:b);});}));function h(a){let g=b.columnSortOrder;if(eH(a)===true){if(a.sortOrder){g=a.sortOrder;}a=a.name;}else{const b=c.value.find(b=>b.name===a);if(b?.sortOrder){g=b.sortOrder;}}let{sortBy:h,descending:f}=d.value;if(h!==a){h=a;f=g==='da';}else if(b.binaryStateSort===true){f=!f;}else if(f===true){if(g==='ad'){h=null;}else{f=false;}}else{if(g==='ad'){f=true;}else{h=null;}}e({sortBy:h,descending:f,page:1});}return{columnToSort:f,computedSortMethod:g,sort:h};}const qc={filter:[String,Object],filterMethod:Function};function qd(b,c){const d=a(()=>(b.filterMethod!==void 0?b.filterMethod:(b,a,c,d)=>{const e=a?a.toLowerCase():'';return b.filter(a=>c.some(c=>{const b=d(c,a)+'';const f=(b==='undefined'||b==='null')?'':b.toLowerCase();return f.indexOf(e)!==-1;}));}));f(()=>b.filter,()=>{w(()=>{c({page:1},true);});},{deep:true});return{computedFilterMethod:d};}function qe(b,a){for(const c in a){if(a[c]!==b[c]){return false;}}return true;}function jD(a){if(a.page<1){a.page=1;}if(a.rowsPerPage!==void 0&&a.rowsPerPage<1){a.rowsPerPage=0;}return a;}const qf={pagination:Object,rowsPerPageOptions:{type:Array,default:()=>[5,7,10,15,20,25,50,0]},'onUpdate:pagination':[Function,Array]};function qg(j,k){const{props:b,emit:g}=j;const e=c(Object.assign({sortBy:null,descending:false,page:1,rowsPerPage:b.rowsPerPageOptions.length!==0?b.rowsPerPageOptions[0]:5},b.pagination));const d=a(()=>{const a=b['onUpdate:pagination']!==void 0?{...e.value,...b.pagination}:e.value;return jD(a);});const f=a(()=>d.value.rowsNumber!==void 0);function h(a){i({pagination:a,filter:b.filter});}function i(a={}){w(()=>{g('request',{pagination:a.pagination||d.value,filter:a.filter||b.filter,getCellValue:k});});}function l(c,i){const a=jD({...d.value,...c});if(qe(d.value,a)===true){if(f.value===true&&i===true){h(a);}return;}if(f.value===true){h(a);return;}if(b.pagination!==void 0&&b['onUpdate:pagination']!==void 0){g('update:pagination',a);}else{e.value=a;}}return{innerPagination:e,computedPagination:d,isServerSide:f,requestServerInteraction:i,setPagination:l};}function qh(j,i,b,k,c,l){const{props:d,emit:m,proxy:{$q:n}}=j;const g=a(()=>(k.value===true?b.value.rowsNumber||0:l.value));const o=a(()=>{const{page:a,rowsPerPage:c}=b.value;return(a-1)*c;});const h=a(()=>{const{page:a,rowsPerPage:c}=b.value;return a*c;});const p=a(()=>b.value.page===1);const e=a(()=>(b.value.rowsPerPage===0?1:Math.max(1,Math.ceil(g.value/b.value.rowsPerPage))));const q=a(()=>(h.value===0?true:b.value.page>=e.value));const r=a(()=>{const a=d.rowsPerPageOptions.includes(i.value.rowsPerPage)?d.rowsPerPageOptions:[i.value.rowsPerPage].concat(d.rowsPerPageOptions);return a.map(a=>({label:a===0?n.lang.table.allRows:''+a,value:a}));});f(e,(a,e)=>{if(a===e)return;const d=b.value.page;if(a&&!d){c({page:1});}else if(a<d){c({page:a});}});function s(){c({page:1});}function t(){const{page:a}=b.value;if(a>1){c({page:a-1});}}function u(){const{page:a,rowsPerPage:d}=b.value;if(h.value>0&&a*d<g.value){c({page:a+1});}}function v(){c({page:e.value});}if(d['onUpdate:pagination']!==void 0){m('update:pagination',{...b.value});}return{firstRowIndex:o,lastRowIndex:h,isFirstPage:p,isLastPage:q,pagesNumber:e,computedRowsPerPageOptions:r,computedRowsNumber:g,firstPage:s,prevPage:t,nextPage:u,lastPage:v};}const qi={selection:{type:String,default:'none',validator:a=>['single','multiple','none'].includes(a)},selected:{type:Array,default:()=>[]}};const qj=['update:selected','selection'];function qk(b,d,e,c){const f=a(()=>{const a={};b.selected.map(c.value).forEach(b=>{a[b]=true;});return a;});const i=a(()=>{return b.selection!=='none';});const g=a(()=>{return b.selection==='single';});const j=a(()=>{return b.selection==='multiple';});const h=a(()=>e.value.length!==0&&e.value.every(a=>f.value[c.value(a)]===true));const k=a(()=>h.value!==true&&e.value.some(a=>f.value[c.value(a)]===true));const l=a(()=>b.selected.length);function m(a){return f.value[a]===true;}function n(){d('update:selected',[]);}function o(f,a,e,h){d('selection',{rows:a,added:e,keys:f,evt:h});const i=g.value===true?(e===true?a:[]):(e===true?b.selected.concat(a):b.selected.filter(a=>f.includes(c.value(a))===false));d('update:selected',i);}return{hasSelectionMode:i,singleSelection:g,multipleSelection:j,allRowsSelected:h,someRowsSelected:k,rowsSelectedNumber:l,isRowSelected:m,clearSelection:n,updateSelection:o};}function jE(a){return Array.isArray(a)?a.slice():[];}const ql={expanded:Array};const qm=['update:expanded'];function qn(b,e){const a=c(jE(b.expanded));f(()=>b.expanded,b=>{a.value=jE(b);});function g(b){return a.value.includes(b);}function d(c){if(b.expanded!==void 0){e('update:expanded',c);}else{a.value=c;}}function h(e,f){const b=a.value.slice();const c=b.indexOf(e);if(f===true){if(c===-1){b.push(e);d(b);}}else if(c!==-1){b.splice(c,1);d(b);}}return{isRowExpanded:g,setExpanded:d,updateExpanded:h};}const qo={visibleColumns:Array};function qp(b,e,f){const c=a(()=>{if(b.columns!==void 0){return b.columns;}const a=b.rows[0];return a!==void 0?Object.keys(a).map(b=>({name:b,label:b.toUpperCase(),field:b,align:eI(a[b])?'right':'left',sortable:true})):[];});const d=a(()=>{const{sortBy:a,descending:d}=e.value;const f=b.visibleColumns!==void 0?c.value.filter(a=>a.required===true||b.visibleColumns.includes(a.name)===true):c.value;return f.map(b=>{const e=b.align||'right';const c=`text-${e}`;return{...b,align:e,__iconClass:`q-table__sort-icon q-table__sort-icon--${e}`,__thClass:c+(b.headerClasses!==void 0?' '+b.headerClasses:'')+(b.sortable===true?' sortable':'')+(b.name===a?` sorted ${d===true?'sort-desc':''}`:''),__tdStyle:b.style!==void 0?(typeof b.style!=='function'?()=>b.style:b.style):()=>null,__tdClass:b.classes!==void 0?(typeof b.classes!=='function'?()=>c+' '+b.classes:a=>c+' '+b.classes(a)):()=>c};});});const g=a(()=>{const a={};d.value.forEach(b=>{a[b.name]=b;});return a;});const h=a(()=>{return b.tableColspan!==void 0?b.tableColspan:d.value.length+(f.value===true?1:0);});return{colList:c,computedCols:d,computedColsMap:g,computedColspan:h};}const dA='q-table__bottom row items-center';const jF={};jy.forEach(a=>{jF[a]={};});const qq=n({name:'QTable',props:{rows:{type:Array,required:true},rowKey:{type:[String,Function],default:'id'},columns:Array,loading:Boolean,iconFirstPage:String,iconPrevPage:String,iconNextPage:String,iconLastPage:String,title:String,hideHeader:Boolean,grid:Boolean,gridHeader:Boolean,dense:Boolean,flat:Boolean,bordered:Boolean,square:Boolean,separator:{type:String,default:'horizontal',validator:a=>['horizontal','vertical','cell','none'].includes(a)},wrapCells:Boolean,virtualScroll:Boolean,virtualScrollTarget:{},...jF,noDataLabel:String,noResultsLabel:String,loadingLabel:String,selectedRowsLabel:Function,rowsPerPageLabel:String,paginationLabel:Function,color:{type:String,default:'grey-8'},titleClass:[String,Array,Object],tableStyle:[String,Array,Object],tableClass:[String,Array,Object],tableHeaderStyle:[String,Array,Object],tableHeaderClass:[String,Array,Object],tableRowStyleFn:Function,tableRowClassFn:Function,cardContainerClass:[String,Array,Object],cardContainerStyle:[String,Array,Object],cardStyle:[String,Array,Object],cardClass:[String,Array,Object],cardStyleFn:Function,cardClassFn:Function,hideBottom:Boolean,hideSelectedBanner:Boolean,hideNoData:Boolean,hidePagination:Boolean,onRowClick:Function,onRowDblclick:Function,onRowContextmenu:Function,...ac,...p6,...qo,...qc,...qf,...ql,...qi,...qa},emits:['request','virtualScroll',...p7,...qm,...qj],setup(d,{slots:e,emit:h}){const q=i();const{proxy:{$q:g}}=q;const j=ad(d,g);const{inFullscreen:P,toggleFullscreen:ag}=p8();const t=a(()=>(typeof d.rowKey==='function'?d.rowKey:a=>a[d.rowKey]));const A=c(null);const r=c(null);const B=a(()=>d.grid!==true&&d.virtualScroll===true);const Q=a(()=>' q-table__card'+(j.value===true?' q-table__card--dark q-dark':'')+(d.square===true?' q-table--square':'')+(d.flat===true?' q-table--flat':'')+(d.bordered===true?' q-table--bordered':''));const R=a(()=>`q-table__container q-table--${d.separator}-separator column no-wrap`+(d.grid===true?' q-table--grid':Q.value)+(j.value===true?' q-table--dark':'')+(d.dense===true?' q-table--dense':'')+(d.wrapCells===false?' q-table--no-wrap':'')+(P.value===true?' fullscreen scroll':''));const ah=a(()=>R.value+(d.loading===true?' q-table--loading':''));f(()=>d.tableStyle+d.tableClass+d.tableHeaderStyle+d.tableHeaderClass+R.value,()=>{B.value===true&&r.value?.reset();});const{innerPagination:T,computedPagination:m,isServerSide:C,requestServerInteraction:aj,setPagination:s}=qg(q,o);const{computedFilterMethod:ak}=qd(d,s);const{isRowExpanded:U,setExpanded:al,updateExpanded:am}=qn(d,h);const D=a(()=>{let a=d.rows;if(C.value===true||a.length===0){return a;}const{sortBy:b,descending:c}=m.value;if(d.filter){a=ak.value(a,d.filter,k.value,o);}if(ar.value!==null){a=at.value(d.rows===a?a.slice():a,b,c);}return a;});const V=a(()=>D.value.length);const l=a(()=>{let a=D.value;if(C.value===true){return a;}const{rowsPerPage:b}=m.value;if(b!==0){if(v.value===0&&d.rows!==a){if(a.length>w.value){a=a.slice(0,w.value);}}else{a=a.slice(v.value,w.value);}}return a;});const{hasSelectionMode:n,singleSelection:an,multipleSelection:W,allRowsSelected:ao,someRowsSelected:X,rowsSelectedNumber:E,isRowSelected:F,clearSelection:ap,updateSelection:u}=qk(d,h,l,t);const{colList:aq,computedCols:k,computedColsMap:Z,computedColspan:$}=qp(d,m,n);const{columnToSort:ar,computedSortMethod:at,sort:G}=qb(d,m,aq,s);const{firstRowIndex:v,lastRowIndex:w,isFirstPage:H,isLastPage:I,pagesNumber:x,computedRowsPerPageOptions:au,computedRowsNumber:y,firstPage:J,prevPage:K,nextPage:L,lastPage:M}=qh(q,T,m,C,s,V);const av=a(()=>l.value.length===0);const aw=a(()=>{const a={};jy.forEach(b=>{a[b]=d[b];});if(a.virtualScrollItemSize===void 0){a.virtualScrollItemSize=d.dense===true?28:48;}return a;});function ax(){B.value===true&&r.value.reset();}function ay(){if(d.grid===true){return aK();}const a=d.hideHeader!==true?ae:null;if(B.value===true){const f=e['top-row'];const g=e['bottom-row'];const c={default:a=>aa(a.item,e.body,a.index)};if(f!==void 0){const d=b('tbody',f({cols:k.value}));c.before=a===null?()=>d:()=>[a()].concat(d);}else if(a!==null){c.before=a;}if(g!==void 0){c.after=()=>b('tbody',g({cols:k.value}));}return b(pS,{ref:r,class:d.tableClass,style:d.tableStyle,...aw.value,scrollTarget:d.virtualScrollTarget,items:l.value,type:'__qtable',tableColspan:$.value,onVirtualScroll:aA},c);}const c=[aB()];if(a!==null){c.unshift(a());}return ju({class:['q-table__middle scroll',d.tableClass],style:d.tableStyle},c);}function az(a,c){if(r.value!==null){r.value.scrollTo(a,c);return;}a=parseInt(a,10);const b=A.value.querySelector(`tbody tr:nth-of-type(${a+1})`);if(b!==null){const c=A.value.querySelector('.q-table__middle.scroll');const e=b.offsetTop-d.virtualScrollStickySizeStart;const f=e<c.scrollTop?'decrease':'increase';c.scrollTop=e;h('virtualScroll',{index:a,from:0,to:T.value.rowsPerPage-1,direction:f});}}function aA(a){h('virtualScroll',a);}function _(){return[b(p_,{class:'q-table__linear-progress',color:d.color,dark:j.value,indeterminate:true,trackColor:'transparent'})];}function aa(a,l,f){const g=t.value(a),i=F(g);if(l!==void 0){const b={key:g,row:a,pageIndex:f,__trClass:i?'selected':''};if(d.tableRowStyleFn!==void 0){b.__trStyle=d.tableRowStyleFn(a);}if(d.tableRowClassFn!==void 0){const c=d.tableRowClassFn(a);if(c){b.__trClass=`${c} ${b.__trClass}`;}}return l(ab(b));}const p=e['body-cell'],m=k.value.map(c=>{const d=e[`body-cell-${c.name}`],h=d!==void 0?d:p;return h!==void 0?h(aC({key:g,row:a,pageIndex:f,col:c})):b('td',{class:c.__tdClass(a),style:c.__tdStyle(a)},o(c,a));});if(n.value===true){const c=e['body-selection'];const h=c!==void 0?c(aD({key:g,row:a,pageIndex:f})):[b(fi,{modelValue:i,color:d.color,dark:j.value,dense:d.dense,'onUpdate:modelValue':(b,c)=>{u([g],[a],b,c);}})];m.unshift(b('td',{class:'q-table--col-auto-width'},h));}const c={key:g,class:{selected:i}};if(d.onRowClick!==void 0){c.class['cursor-pointer']=true;c.onClick=b=>{h('rowClick',b,a,f);};}if(d.onRowDblclick!==void 0){c.class['cursor-pointer']=true;c.onDblclick=b=>{h('rowDblclick',b,a,f);};}if(d.onRowContextmenu!==void 0){c.class['cursor-pointer']=true;c.onContextmenu=b=>{h('rowContextmenu',b,a,f);};}if(d.tableRowStyleFn!==void 0){c.style=d.tableRowStyleFn(a);}if(d.tableRowClassFn!==void 0){const b=d.tableRowClassFn(a);if(b){c.class[b]=true;}}return b('tr',c,m);}function aB(){const f=e.body,c=e['top-row'],d=e['bottom-row'];let a=l.value.map((a,b)=>aa(a,f,b));if(c!==void 0){a=c({cols:k.value}).concat(a);}if(d!==void 0){a=a.concat(d({cols:k.value}));}return b('tbody',a);}function ab(a){N(a);a.cols=a.cols.map(b=>ai({...b},'value',()=>o(b,a.row)));return a;}function aC(a){N(a);ai(a,'value',()=>o(a.col,a.row));return a;}function aD(a){N(a);return a;}function N(a){Object.assign(a,{cols:k.value,colsMap:Z.value,sort:G,rowIndex:v.value+a.pageIndex,color:d.color,dark:j.value,dense:d.dense});n.value===true&&ai(a,'selected',()=>F(a.key),(b,c)=>{u([a.key],[a.row],b,c);});ai(a,'expand',()=>U(a.key),b=>{am(a.key,b);});}function o(a,b){const c=typeof a.field==='function'?a.field(b):b[a.field];return a.format!==void 0?a.format(c,b):c;}const p=a(()=>({pagination:m.value,pagesNumber:x.value,isFirstPage:H.value,isLastPage:I.value,firstPage:J,prevPage:K,nextPage:L,lastPage:M,inFullscreen:P.value,toggleFullscreen:ag}));function aE(){const c=e.top,f=e['top-left'],g=e['top-right'],h=e['top-selection'],j=n.value===true&&h!==void 0&&E.value>0,i='q-table__top relative-position row items-center';if(c!==void 0){return b('div',{class:i},[c(p.value)]);}let a;if(j===true){a=h(p.value).slice();}else{a=[];if(f!==void 0){a.push(b('div',{class:'q-table__control'},[f(p.value)]));}else if(d.title){a.push(b('div',{class:'q-table__control'},[b('div',{class:['q-table__title',d.titleClass]},d.title)]));}}if(g!==void 0){a.push(b('div',{class:'q-table__separator col'}));a.push(b('div',{class:'q-table__control'},[g(p.value)]));}if(a.length===0)return;return b('div',{class:i},a);}const ac=a(()=>(X.value===true?null:ao.value));function ae(){const a=aF();if(d.loading===true&&e.loading===void 0){a.push(b('tr',{class:'q-table__progress'},[b('th',{class:'relative-position',colspan:$.value},_())]));}return b('thead',a);}function aF(){const c=e.header,f=e['header-cell'];if(c!==void 0){return c(O({header:true})).slice();}const a=k.value.map(a=>{const c=e[`header-cell-${a.name}`],d=c!==void 0?c:f,g=O({col:a});return d!==void 0?d(g):b(pL,{key:a.name,props:g},()=>a.label);});if(an.value===true&&d.grid!==true){a.unshift(b('th',{class:'q-table--col-auto-width'},' '));}else if(W.value===true){const c=e['header-selection'];const f=c!==void 0?c(O({})):[b(fi,{color:d.color,modelValue:ac.value,dark:j.value,dense:d.dense,'onUpdate:modelValue':af})];a.unshift(b('th',{class:'q-table--col-auto-width'},f));}return[b('tr',{class:d.tableHeaderClass,style:d.tableHeaderStyle},a)];}function O(a){Object.assign(a,{cols:k.value,sort:G,colsMap:Z.value,color:d.color,dark:j.value,dense:d.dense});if(W.value===true){ai(a,'selected',()=>ac.value,af);}return a;}function af(a){if(X.value===true){a=false;}u(l.value.map(t.value),l.value,a);}const z=a(()=>{const a=[d.iconFirstPage||g.iconSet.table.firstPage,d.iconPrevPage||g.iconSet.table.prevPage,d.iconNextPage||g.iconSet.table.nextPage,d.iconLastPage||g.iconSet.table.lastPage];return g.lang.rtl===true?a.reverse():a;});function aG(){if(d.hideBottom===true)return;if(av.value===true){if(d.hideNoData===true)return;const a=d.loading===true?d.loadingLabel||g.lang.table.loading:(d.filter?d.noResultsLabel||g.lang.table.noResults:d.noDataLabel||g.lang.table.noData);const c=e['no-data'];const f=c!==void 0?[c({message:a,icon:g.iconSet.table.warning,filter:d.filter})]:[b(S,{class:'q-table__bottom-nodata-icon',name:g.iconSet.table.warning}),a];return b('div',{class:dA+' q-table__bottom--nodata'},f);}const c=e.bottom;if(c!==void 0){return b('div',{class:dA},[c(p.value)]);}const a=d.hideSelectedBanner!==true&&n.value===true&&E.value>0?[b('div',{class:'q-table__control'},[b('div',[(d.selectedRowsLabel||g.lang.table.selectedRecords)(E.value)])])]:[];if(d.hidePagination!==true){return b('div',{class:dA+' justify-end'},aI(a));}if(a.length!==0){return b('div',{class:dA},a);}}function aH(a){s({page:1,rowsPerPage:a.value});}function aI(f){let a;const{rowsPerPage:c}=m.value,h=d.paginationLabel||g.lang.table.pagination,i=e.pagination,k=d.rowsPerPageOptions.length>1;f.push(b('div',{class:'q-table__separator col'}));k===true&&f.push(b('div',{class:'q-table__control'},[b('span',{class:'q-table__bottom-item'},[d.rowsPerPageLabel||g.lang.table.recordsPerPage]),b(pZ,{class:'q-table__select inline q-table__bottom-item',color:d.color,modelValue:c,options:au.value,displayValue:c===0?g.lang.table.allRows:c,dark:j.value,borderless:true,dense:true,optionsDense:true,optionsCover:true,'onUpdate:modelValue':aH})]));if(i!==void 0){a=i(p.value);}else{a=[b('span',c!==0?{class:'q-table__bottom-item'}:{},[c?h(v.value+1,Math.min(w.value,y.value),y.value):h(1,V.value,y.value)])];if(c!==0&&x.value>1){const c={color:d.color,round:true,dense:true,flat:true};if(d.dense===true){c.size='sm';}x.value>2&&a.push(b(Y,{key:'pgFirst',...c,icon:z.value[0],disable:H.value,ariaLabel:g.lang.pagination.first,onClick:J}));a.push(b(Y,{key:'pgPrev',...c,icon:z.value[1],disable:H.value,ariaLabel:g.lang.pagination.prev,onClick:K}),b(Y,{key:'pgNext',...c,icon:z.value[2],disable:I.value,ariaLabel:g.lang.pagination.next,onClick:L}));x.value>2&&a.push(b(Y,{key:'pgLast',...c,icon:z.value[3],disable:I.value,ariaLabel:g.lang.pagination.last,onClick:M}));}}f.push(b('div',{class:'q-table__control'},a));return f;}function aJ(){const a=d.gridHeader===true?[b('table',{class:'q-table'},[ae()])]:(d.loading===true&&e.loading===void 0?_():void 0);return b('div',{class:'q-table__middle'},a);}function aK(){const a=e.item!==void 0?e.item:a=>{const f=a.cols.map(a=>b('div',{class:'q-table__grid-item-row'},[b('div',{class:'q-table__grid-item-title'},[a.label]),b('div',{class:'q-table__grid-item-value'},[a.value])]));if(n.value===true){const c=e['body-selection'];const g=c!==void 0?c(a):[b(fi,{modelValue:a.selected,color:d.color,dark:j.value,dense:d.dense,'onUpdate:modelValue':(b,c)=>{u([a.key],[a.row],b,c);}})];f.unshift(b('div',{class:'q-table__grid-item-row'},g),b(jt,{dark:j.value}));}const c={class:['q-table__grid-item-card'+Q.value,d.cardClass],style:d.cardStyle};if(d.cardStyleFn!==void 0){c.style=[c.style,d.cardStyleFn(a.row)];}if(d.cardClassFn!==void 0){const b=d.cardClassFn(a.row);if(b){c.class[0]+=` ${b}`;}}if(d.onRowClick!==void 0||d.onRowDblclick!==void 0||d.onRowContextmenu!==void 0){c.class[0]+=' cursor-pointer';if(d.onRowClick!==void 0){c.onClick=b=>{h('RowClick',b,a.row,a.pageIndex);};}if(d.onRowDblclick!==void 0){c.onDblclick=b=>{h('RowDblclick',b,a.row,a.pageIndex);};}if(d.onRowContextmenu!==void 0){c.onContextmenu=b=>{h('rowContextmenu',b,a.row,a.pageIndex);};}}return b('div',{class:'q-table__grid-item col-xs-12 col-sm-6 col-md-4 col-lg-3'+(a.selected===true?' q-table__grid-item--selected':'')},[b('div',c,f)]);};return b('div',{class:['q-table__grid-content row',d.cardContainerClass],style:d.cardContainerStyle},l.value.map((b,c)=>{return a(ab({key:t.value(b),row:b,pageIndex:c}));}));}Object.assign(q.proxy,{requestServerInteraction:aj,setPagination:s,firstPage:J,prevPage:K,nextPage:L,lastPage:M,isRowSelected:F,clearSelection:ap,isRowExpanded:U,setExpanded:al,sort:G,resetVirtualScroll:ax,scrollTo:az,getCellValue:o});l0(q.proxy,{filteredSortedRows:()=>D.value,computedRows:()=>l.value,computedRowsNumber:()=>y.value});return()=>{const a=[aE()];const c={ref:A,class:ah.value};if(d.grid===true){a.push(aJ());}else{Object.assign(c,{class:[c.class,d.cardClass],style:d.cardStyle});}a.push(ay(),aG());if(d.loading===true&&e.loading!==void 0){a.push(e.loading());}return b('div',c,a);};}});const qr={class:"text-h6"};const qs={class:"text-h6"};const qt={__name:'Players',setup(s){const d=js();const{t:g}=cw();const h=c(false);const f=c(false);const j=()=>({id:null,name:'',surname:'',balance:'0',currency:'EUR'});const b=c(j());const k=c(0);const n=a(()=>[{name:'id',label:g('id'),field:'id',align:'left'},{name:'name',label:g('name'),field:'name',align:'left'},{name:'surname',label:g('surname'),field:'surname',align:'left'},{name:'balance',label:g('balance'),field:a=>o(a),align:'right'},{name:'actions',label:g('actions'),field:'actions',align:'center'}]);function o(a){try{return new Intl.NumberFormat(undefined,{style:'currency',currency:a.currency}).format(a.balance);}catch{return`${a.balance} ${a.currency||''}`;}}function l(){b.value=j();f.value=false;}function p(a){b.value={...a,balance:String(a.balance)};f.value=true;h.value=true;}async function q(){let a;if(f.value){a=await d.updatePlayer(b.value.id,{name:b.value.name,surname:b.value.surname});}else{a=await d.createPlayer({name:b.value.name,surname:b.value.surname,balance:String(b.value.balance).trim(),currency:b.value.currency.trim().toUpperCase()});}if(!a?.error){h.value=false;l();await i();}}async function r(a){await d.deletePlayer(a.id);await i();}async function i(){await d.fetchPlayers();k.value++;}L(()=>{i();d.watchPlayers();});bC(()=>{d.unwatchPlayers();});return(c,a)=>{return(bi(),bj(dh,null,{default:m(()=>[e(a0,null,{default:m(()=>[aZ("div",qr,aV(c.$t('players')),1),e(Y,{color:"primary",icon:"add",label:c.$t('addPlayer'),onClick:a[0]||(a[0]=a=>(h.value=true)),class:"q-ml-sm"},null,8,["label"])]),_:1}),e(jt),e(a0,null,{default:m(()=>[(bi(),bj(qq,{rows:bb(d).players,columns:n.value,"row-key":"id",flat:"",dense:"",pagination:{rowsPerPage:5},"rows-per-page-label":c.$t('recordsPerPage'),key:k.value},{"body-cell-actions":m((a)=>[e(pK,null,{default:m(()=>[e(Y,{size:"sm",color:"primary",icon:"edit",flat:"",onClick:b=>(p(a.row))},null,8,["onClick"]),e(Y,{size:"sm",color:"negative",icon:"delete",flat:"",onClick:b=>(r(a.row))},null,8,["onClick"])]),_:2},1024)]),_:1},8,["rows","columns","rows-per-page-label"]))]),_:1}),eh(" Add/Edit Player Dialog "),e(eT,{modelValue:h.value,"onUpdate:modelValue":a[5]||(a[5]=a=>((h).value=a))},{default:m(()=>[e(dh,{style:{"min-width":"350px"}},{default:m(()=>[e(a0,null,{default:m(()=>[aZ("div",qs,aV(f.value?c.$t('editPlayer'):c.$t('addPlayer')),1)]),_:1}),e(a0,null,{default:m(()=>[e(co,{modelValue:b.value.name,"onUpdate:modelValue":a[1]||(a[1]=a=>((b.value.name)=a)),label:c.$t('name')},null,8,["modelValue","label"]),e(co,{modelValue:b.value.surname,"onUpdate:modelValue":a[2]||(a[2]=a=>((b.value.surname)=a)),label:c.$t('surname')},null,8,["modelValue","label"]),e(co,{modelValue:b.value.balance,"onUpdate:modelValue":a[3]||(a[3]=a=>((b.value.balance)=a)),label:c.$t('balance'),inputmode:"decimal",readonly:f.value},null,8,["modelValue","label","readonly"]),e(co,{modelValue:b.value.currency,"onUpdate:modelValue":a[4]||(a[4]=a=>((b.value.currency)=a)),label:c.$t('currency'),maxlength:"3",readonly:f.value},null,8,["modelValue","label","readonly"])]),_:1}),e(h3,{align:"right"},{default:m(()=>[bd(e(Y,{flat:"",label:c.$t('cancel'),onClick:l},null,8,["label"]),[[dp]]),e(Y,{flat:"",label:c.$t('save'),color:"primary",onClick:q},null,8,["label"])]),_:1})]),_:1})]),_:1},8,["modelValue"])]),_:1}));};}};const qO='';const qu={class:"text-h5 text-center"};const qv={class:"q-mb-md text-center"};const qw={class:"q-mt-md"};const qx={class:"text-h6"};const qy={class:"text-caption"};const qz={__name:'App',setup(l){const a=js();const{locale:k}=cw();const d=c('players');const b=c(false);const g=c('');function h(){g.value=a.apiKey;b.value=true;}function i(){a.setApiKey(g.value);b.value=false;}f(()=>a.unauthorized,a=>{if(a&&!b.value)h();});function j(a){k.value=a;}return(f,c)=>{return(bi(),bj(nO,{view:"lHh Lpr lFf"},{default:m(()=>[e(m9,{elevated:"",class:"bg-primary text-white"},{default:m(()=>[e(mZ,null,{default:m(()=>[e(l9,null,{default:m(()=>[e(S,{name:"apps",class:"q-mr-sm"}),cb(" "+aV(f.$t('title')),1)]),_:1}),e(Y,{flat:"",dense:"",round:"",icon:"key",title:f.$t('apiKey'),onClick:h},null,8,["title"]),e(Y,{flat:"",dense:"",round:"",icon:"language"},{default:m(()=>[e(hI,null,{default:m(()=>[e(hh,null,{default:m(()=>[bd((bi(),bj(ez,{clickable:"",onClick:c[0]||(c[0]=a=>(j('en')))},{default:m(()=>[e(ey,null,{default:m(()=>c[6]||(c[6]=[cb("English")])),_:1,__:[6]})]),_:1})),[[dp]]),bd((bi(),bj(ez,{clickable:"",onClick:c[1]||(c[1]=a=>(j('fr')))},{default:m(()=>[e(ey,null,{default:m(()=>c[7]||(c[7]=[cb("Français")])),_:1,__:[7]})]),_:1})),[[dp]])]),_:1})]),_:1})]),_:1})]),_:1}),e(m8,{modelValue:d.value,"onUpdate:modelValue":c[2]||(c[2]=a=>((d).value=a)),class:"bg-primary text-white",align:"left",dense:"","indicator-color":"yellow"},{default:m(()=>[e(hN,{name:"players",icon:"people",label:f.$t('players')},null,8,["label"]),e(hN,{name:"ping",icon:"wifi_tethering",label:f.$t('pingTab')},null,8,["label"])]),_:1},8,["modelValue"])]),_:1}),e(nl,null,{default:m(()=>[e(nk,{modelValue:d.value,"onUpdate:modelValue":c[3]||(c[3]=a=>((d).value=a)),animated:""},{default:m(()=>[e(hU,{name:"players"},{default:m(()=>[e(hQ,{class:"q-pa-md",style:{"min-height":"300px"}},{default:m(()=>[e(qt)]),_:1})]),_:1}),e(hU,{name:"ping"},{default:m(()=>[e(hQ,{class:"q-pa-md flex flex-center bg-grey-2",style:{"min-height":"300px"}},{default:m(()=>[e(dh,{class:"my-card"},{default:m(()=>[e(a0,{class:"bg-primary text-white"},{default:m(()=>[aZ("div",qu,aV(f.$t('welcome')),1)]),_:1}),e(a0,null,{default:m(()=>[aZ("div",qv,[e(Y,{color:"primary",onClick:bb(a).pingBackend,label:f.$t('ping'),unelevated:"",rounded:""},null,8,["onClick","label"]),e(Y,{color:"secondary",onClick:bb(a).clearResult,label:f.$t('clear'),unelevated:"",rounded:"",class:"q-ml-sm"},null,8,["onClick","label"])]),aZ("div",qw,[(bb(a).pingResult)?(bi(),bj(nj,{key:0,dense:"",class:"bg-green-2 text-primary text-center"},{default:m(()=>[cb(aV(bb(a).pingResult),1)]),_:1})):eh("v-if",true)])]),_:1})]),_:1})]),_:1})]),_:1})]),_:1},8,["modelValue"])]),_:1}),eh(" API key, asked for whenever the server rejects the one sent "),e(eT,{modelValue:b.value,"onUpdate:modelValue":c[5]||(c[5]=a=>((b).value=a))},{default:m(()=>[e(dh,{style:{"min-width":"350px"}},{default:m(()=>[e(a0,null,{default:m(()=>[aZ("div",qx,aV(f.$t('apiKey')),1),aZ("div",qy,aV(f.$t('apiKeyHint')),1)]),_:1}),e(a0,null,{default:m(()=>[e(co,{modelValue:g.value,"onUpdate:modelValue":c[4]||(c[4]=a=>((g).value=a)),label:f.$t('apiKey'),type:"password",autofocus:"",onKeyup:lW(i,["enter"])},null,8,["modelValue","label"])]),_:1}),e(h3,{align:"right"},{default:m(()=>[bd(e(Y,{flat:"",label:f.$t('cancel')},null,8,["label"]),[[dp]]),e(Y,{flat:"",label:f.$t('save'),color:"primary",onClick:i},null,8,["label"])]),_:1})]),_:1})]),_:1},8,["modelValue"])]),_:1}));};}};const fj=['sm','md','lg','xl'];const{passive:jG}=E;const qA=c1({width:0,height:0,name:'xs',sizes:{sm:600,md:1024,lg:1440,xl:1920},lt:{sm:true,md:true,lg:true,xl:true},gt:{xs:false,sm:false,md:false,lg:false},xs:true,sm:false,md:false,lg:false,xl:false},{setSizes:aL,setDebounce:aL,install({$q:b,onSSRHydrated:j}){b.screen=this;if(this.__installed===true){if(b.config.screen!==void 0){if(b.config.screen.bodyClasses===false){document.body.classList.remove(`screen--${this.name}`);}else{this.__update(true);}}return;}const{visualViewport:a}=window;const f=a||window;const c=document.scrollingElement||document.documentElement;const k=a===void 0||y.is.mobile===true?()=>[Math.max(window.innerWidth,c.clientWidth),Math.max(window.innerHeight,c.clientHeight)]:()=>[a.width*a.scale+window.innerWidth-c.clientWidth,a.height*a.scale+window.innerHeight-c.clientHeight];const g=b.config.screen?.bodyClasses===true;this.__update=d=>{const[b,c]=k();if(c!==this.height){this.height=c;}if(b!==this.width){this.width=b;}else if(d!==true){return;}let a=this.sizes;this.gt.xs=b>=a.sm;this.gt.sm=b>=a.md;this.gt.md=b>=a.lg;this.gt.lg=b>=a.xl;this.lt.sm=b<a.sm;this.lt.md=b<a.md;this.lt.lg=b<a.lg;this.lt.xl=b<a.xl;this.xs=this.lt.sm;this.sm=this.gt.xs===true&&this.lt.md===true;this.md=this.gt.sm===true&&this.lt.lg===true;this.lg=this.gt.md===true&&this.lt.xl===true;this.xl=this.gt.lg;a=(this.xs===true&&'xs')||(this.sm===true&&'sm')||(this.md===true&&'md')||(this.lg===true&&'lg')||'xl';if(a!==this.name){if(g===true){document.body.classList.remove(`screen--${this.name}`);document.body.classList.add(`screen--${a}`);}this.name=a;}};let d,e={},h=16;this.setSizes=a=>{fj.forEach(b=>{if(a[b]!==void 0){e[b]=a[b];}});};this.setDebounce=a=>{h=a;};const i=()=>{const a=getComputedStyle(document.body);if(a.getPropertyValue('--q-size-sm')){fj.forEach(b=>{this.sizes[b]=parseInt(a.getPropertyValue(`--q-size-${b}`),10);});}this.setSizes=a=>{fj.forEach(b=>{if(a[b]){this.sizes[b]=a[b];}});this.__update(true);};this.setDebounce=a=>{d!==void 0&&f.removeEventListener('resize',d,jG);d=a>0?eL(this.__update,a):this.__update;f.addEventListener('resize',d,jG);};this.setDebounce(h);if(Object.keys(e).length!==0){this.setSizes(e);e=void 0;}else{this.__update();}g===true&&this.name==='xs'&&document.body.classList.add('screen--xs');};if(aB.value===true){j.push(i);}else{i();}}});const T=c1({isActive:false,mode:false},{__media:void 0,set(a){T.mode=a;if(a==='auto'){if(T.__media===void 0){T.__media=window.matchMedia('(prefers-color-scheme: dark)');T.__updateMedia=()=>{T.set('auto');};T.__media.addListener(T.__updateMedia);}a=T.__media.matches;}else if(T.__media!==void 0){T.__media.removeListener(T.__updateMedia);T.__media=void 0;}T.isActive=a===true;document.body.classList.remove(`body--${a===true?'light':'dark'}`);document.body.classList.add(`body--${a===true?'dark':'light'}`);},toggle(){{T.set(T.isActive===false);}},install({$q:a,ssrContext:c}){const{dark:b}=a.config;a.dark=this;if(this.__installed!==true){this.set(b!==void 0?b:false);}}});function qB(a,b,c=document.body){if(typeof a!=='string'){throw new TypeError('Expected a string as propName');}if(typeof b!=='string'){throw new TypeError('Expected a string as value');}if(!(c instanceof Element)){throw new TypeError('Expected a DOM element');}c.style.setProperty(`--q-${a}`,b);}function jH(a){if(a.ios===true)return'ios';if(a.android===true)return'android';}function qC({is:a,has:d,within:e},c){const b=[a.desktop===true?'desktop':'mobile',`${d.touch===false?'no-':''}touch`];if(a.mobile===true){const c=jH(a);c!==void 0&&b.push('platform-'+c);}if(a.nativeMobile===true){const d=a.nativeMobileWrapper;b.push(d);b.push('native-mobile');if(a.ios===true&&(c[d]===void 0||c[d].iosStatusBarPadding!==false)){b.push('q-ios-padding');}}else if(a.electron===true){b.push('electron');}else if(a.bex===true){b.push('bex');}e.iframe===true&&b.push('within-iframe');return b;}function qD(){const{is:b}=y;const c=document.body.className;const a=new Set(c.replace(/ {2}/g,' ').split(' '));if(b.nativeMobile!==true&&b.electron!==true&&b.bex!==true){if(b.desktop===true){a.delete('mobile');a.delete('platform-ios');a.delete('platform-android');a.add('desktop');}else if(b.mobile===true){a.delete('desktop');a.add('mobile');a.delete('platform-ios');a.delete('platform-android');const c=jH(b);if(c!==void 0){a.add(`platform-${c}`);}}}if(y.has.touch===true){a.delete('no-touch');a.add('touch');}if(y.within.iframe===true){a.add('within-iframe');}const d=Array.from(a).join(' ');if(c!==d){document.body.className=d;}}function qE(a){for(const b in a){qB(b,a[b]);}}const qF={install(a){if(this.__installed===true)return;if(aB.value===true){qD();}else{const{$q:b}=a;b.config.brand!==void 0&&qE(b.config.brand);const c=qC(y,b.config);document.body.classList.add.apply(document.body.classList,c);}if(y.is.ios===true){document.body.addEventListener('touchstart',aL);}window.addEventListener('keydown',mf,true);}};const jI={isoName:'en-US',nativeName:'English (US)',label:{clear:'Clear',ok:'OK',cancel:'Cancel',close:'Close',set:'Set',select:'Select',reset:'Reset',remove:'Remove',update:'Update',create:'Create',search:'Search',filter:'Filter',refresh:'Refresh',expand:a=>(a?`Expand "${a}"`:'Expand'),collapse:a=>(a?`Collapse "${a}"`:'Collapse')},date:{days:'Sunday_Monday_Tuesday_Wednesday_Thursday_Friday_Saturday'.split('_'),daysShort:'Sun_Mon_Tue_Wed_Thu_Fri_Sat'.split('_'),months:'January_February_March_April_May_June_July_August_September_October_November_December'.split('_'),monthsShort:'Jan_Feb_Mar_Apr_May_Jun_Jul_Aug_Sep_Oct_Nov_Dec'.split('_'),firstDayOfWeek:0,format24h:false,pluralDay:'days',prevMonth:'Previous month',nextMonth:'Next month',prevYear:'Previous year',nextYear:'Next year',today:'Today',prevRangeYears:a=>`Previous ${a} years`,nextRangeYears:a=>`Next ${a} years`},table:{noData:'No data available',noResults:'No matching records found',loading:'Loading...',selectedRecords:a=>(a===1?'1 record selected.':(a===0?'No':a)+' records selected.'),recordsPerPage:'Records per page:',allRows:'All',pagination:(a,b,c)=>a+'-'+b+' of '+c,columns:'Columns'},pagination:{first:'First page',prev:'Previous page',next:'Next page',last:'Last page'},editor:{url:'URL',bold:'Bold',italic:'Italic',strikethrough:'Strikethrough',underline:'Underline',unorderedList:'Unordered List',orderedList:'Ordered List',subscript:'Subscript',superscript:'Superscript',hyperlink:'Hyperlink',toggleFullscreen:'Toggle Fullscreen',quote:'Quote',left:'Left align',center:'Center align',right:'Right align',justify:'Justify align',print:'Print',outdent:'Decrease indentation',indent:'Increase indentation',removeFormat:'Remove formatting',formatting:'Formatting',fontSize:'Font Size',align:'Align',hr:'Insert Horizontal Rule',undo:'Undo',redo:'Redo',heading1:'Heading 1',heading2:'Heading 2',heading3:'Heading 3',heading4:'Heading 4',heading5:'Heading 5',heading6:'Heading 6',paragraph:'Paragraph',code:'Code',size1:'Very small',size2:'A bit small',size3:'Normal',size4:'Medium-large',size5:'Big',size6:'Very big',size7:'Maximum',defaultFont:'Default Font',viewSource:'View Source'},tree:{noNodes:'No nodes available',noResults:'No matching nodes found'}};function jJ(){const a=Array.isArray(navigator.languages)===true&&navigator.languages.length!==0?navigator.languages[0]:navigator.language;if(typeof a==='string'){return a.split(/[-_]/).map((a,b)=>(b===0?a.toLowerCase():(b>1||a.length<4?a.toUpperCase():(a[0].toUpperCase()+a.slice(1).toLowerCase())))).join('-');}}const a7=c1({__qLang:{}},{getLocale:jJ,set(b=jI,c){const a={...b,rtl:b.rtl===true,getLocale:jJ};{a.set=a7.set;if(a7.__langConfig===void 0||a7.__langConfig.noHtmlAttrs!==true){const b=document.documentElement;b.setAttribute('dir',a.rtl===true?'rtl':'ltr');b.setAttribute('lang',a.isoName);}Object.assign(a7.__qLang,a);}},install({$q:b,lang:a,ssrContext:c}){{b.lang=a7.__qLang;a7.__langConfig=b.config.lang;if(this.__installed===true){a!==void 0&&this.set(a);}else{this.props=new Proxy(this.__qLang,{get(){return Reflect.get(...arguments);},ownKeys(a){return Reflect.ownKeys(a).filter(a=>a!=='set'&&a!=='getLocale');}});this.set(a||jI);}}}});const qG={name:'material-icons',type:{positive:'check_circle',negative:'warning',info:'info',warning:'priority_high'},arrow:{up:'arrow_upward',right:'arrow_forward',down:'arrow_downward',left:'arrow_back',dropdown:'arrow_drop_down'},chevron:{left:'chevron_left',right:'chevron_right'},colorPicker:{spectrum:'gradient',tune:'tune',palette:'style'},pullToRefresh:{icon:'refresh'},carousel:{left:'chevron_left',right:'chevron_right',up:'keyboard_arrow_up',down:'keyboard_arrow_down',navigationIcon:'lens'},chip:{remove:'cancel',selected:'check'},datetime:{arrowLeft:'chevron_left',arrowRight:'chevron_right',now:'access_time',today:'today'},editor:{bold:'format_bold',italic:'format_italic',strikethrough:'strikethrough_s',underline:'format_underlined',unorderedList:'format_list_bulleted',orderedList:'format_list_numbered',subscript:'vertical_align_bottom',superscript:'vertical_align_top',hyperlink:'link',toggleFullscreen:'fullscreen',quote:'format_quote',left:'format_align_left',center:'format_align_center',right:'format_align_right',justify:'format_align_justify',print:'print',outdent:'format_indent_decrease',indent:'format_indent_increase',removeFormat:'format_clear',formatting:'text_format',fontSize:'format_size',align:'format_align_left',hr:'remove',undo:'undo',redo:'redo',heading:'format_size',code:'code',size:'format_size',font:'font_download',viewSource:'code'},expansionItem:{icon:'keyboard_arrow_down',denseIcon:'arrow_drop_down'},fab:{icon:'add',activeIcon:'close'},field:{clear:'cancel',error:'error'},pagination:{first:'first_page',prev:'keyboard_arrow_left',next:'keyboard_arrow_right',last:'last_page'},rating:{icon:'grade'},stepper:{done:'check',active:'edit',error:'warning'},tabs:{left:'chevron_left',right:'chevron_right',up:'keyboard_arrow_up',down:'keyboard_arrow_down'},table:{arrowUp:'arrow_upward',warning:'warning',firstPage:'first_page',prevPage:'chevron_left',nextPage:'chevron_right',lastPage:'last_page'},tree:{icon:'play_arrow'},uploader:{done:'done',clear:'clear',add:'add_box',upload:'cloud_upload',removeQueue:'clear_all',removeUploaded:'done_all'}};const dB=c1({iconMapFn:null,__qIconSet:{}},{set(b,c){const a={...b};{a.set=dB.set;Object.assign(dB.__qIconSet,a);}},install({$q:a,iconSet:b,ssrContext:c}){{if(a.config.iconMapFn!==void 0){this.iconMapFn=a.config.iconMapFn;}a.iconSet=this.__qIconSet;ai(a,'iconMapFn',()=>this.iconMapFn,a=>{this.iconMapFn=a;});if(this.__installed===true){b!==void 0&&this.set(b);}else{this.props=new Proxy(this.__qIconSet,{get(){return Reflect.get(...arguments);},ownKeys(a){return Reflect.ownKeys(a).filter(a=>a!=='set');}});this.set(b||qG);}}}});const jK=[c5,qF,T,qA,cp,a7,dB];function jL(a,b){b.forEach(b=>{b.install(a);b.__installed=true;});}function qH(c,b,a){c.config.globalProperties.$q=a.$q;c.provide(m$,a.$q);jL(a,jK);b.components!==void 0&&Object.values(b.components).forEach(a=>{if(eH(a)===true&&a.name!==void 0){c.component(a.name,a);}});b.directives!==void 0&&Object.values(b.directives).forEach(a=>{if(eH(a)===true&&a.name!==void 0){c.directive(a.name,a);}});b.plugins!==void 0&&jL(a,Object.values(b.plugins).filter(a=>typeof a.install==='function'&&jK.includes(a)===false));if(aB.value===true){a.$q.onSSRHydrated=()=>{a.onSSRHydrated.forEach(a=>{a();});a.$q.onSSRHydrated=()=>{};};}}const qI=function(c,a={}){const b={version:'2.18.1'};if(hq===false){if(a.config!==void 0){Object.assign(c7,a.config);}b.config={...c7};mG();}else{b.config=a.config||{};}qH(c,a,{parentApp:c,$q:b,lang:a.lang,iconSet:a.iconSet,onSSRHydrated:[]});};const qJ={name:'Quasar',version:'2.18.1',install:qI,lang:a7,iconSet:dB};const qP='';const qK={config:{},plugins:{}};const qQ='';const qR='';const qL={en:{title:'Contoso Quasar App',welcome:'Welcome to Contoso!',ping:'Ping Backend',clear:'Clear',players:'Players',addPlayer:'Add Player',editPlayer:'Edit Player',name:'Name',surname:'Surname',balance:'Balance',currency:'Currency',cancel:'Cancel',save:'Save',pingTab:'Ping',id:'ID',actions:'Actions',recordsPerPage:'Records per page',apiKey:'API key',apiKeyHint:'Sent with every request; create one with go run . apikey create'},fr:{title:'Application Quasar Contoso',welcome:'Bienvenue chez Contoso !',ping:'Tester le backend',clear:'Effacer',players:'Joueurs',addPlayer:'Ajouter un joueur',editPlayer:'Modifier le joueur',name:'Prénom',surname:'Nom de famille',balance:'Solde',currency:'Devise',cancel:'Annuler',save:'Enregistrer',pingTab:'Ping',id:'ID',actions:'Actions',recordsPerPage:'Enregistrements par page',apiKey:'Clé d\'API',apiKeyHint:'Envoyée avec chaque requête ; créez-en une avec go run . apikey create'}};const qM=pr({legacy:false,locale:'en',fallbackLocale:'en',messages:qL});const qN=pC();lZ(qz).use(qJ,qK).use(qM).use(qN).mount('#app');
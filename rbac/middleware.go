@@ -0,0 +1,56 @@
+package rbac
+
+import (
+	"contoso/auth"
+	"contoso/elasticlog"
+	"contoso/problem"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// Enforcer checks the authenticated principal's roles against a Policy.
+type Enforcer struct {
+	policy *Policy
+	logger *elasticlog.Logger
+}
+
+// NewEnforcer creates an Enforcer for policy.
+func NewEnforcer(policy *Policy, logger *elasticlog.Logger) *Enforcer {
+	return &Enforcer{policy: policy, logger: logger}
+}
+
+// Require returns a handler that lets the request through only when the
+// principal holds permission. It must run after the auth middleware.
+func (e *Enforcer) Require(permission string) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		if err := e.Check(c, permission); err != nil {
+			return err
+		}
+		return c.Next()
+	}
+}
+
+// Check returns nil when the principal holds permission, and otherwise a
+// 403 problem after writing the denial to the audit log. Handlers use it
+// for permissions that depend on the request body.
+func (e *Enforcer) Check(c *fiber.Ctx, permission string) error {
+	p := auth.PrincipalFrom(c)
+	if p != nil && e.policy.Allows(p.Roles, permission) {
+		return nil
+	}
+	entry := map[string]interface{}{
+		"event":      "audit.access_denied",
+		"permission": permission,
+		"method":     c.Method(),
+		"path":       c.Path(),
+		"client":     c.IP(),
+		"requestId":  problem.RequestID(c),
+	}
+	if p != nil {
+		entry["principal"] = p.Subject
+		entry["principalKind"] = p.Kind
+		entry["roles"] = p.Roles
+	}
+	e.logger.Warn("Access denied", entry)
+	return problem.New(fiber.StatusForbidden, problem.CodeForbidden, "requires permission "+permission)
+}
@@ -0,0 +1,65 @@
+// Package rbac maps caller roles to permissions and enforces them per route.
+package rbac
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// Permissions checked by the API routes.
+const (
+	PlayersRead    = "players:read"
+	PlayersWrite   = "players:write"
+	PlayersBalance = "players:balance"
+	PlayersDelete  = "players:delete"
+	PlayersStatus  = "players:status"
+	WebhooksManage = "webhooks:manage"
+	AdminAccess    = "admin:access"
+)
+
+// Policy grants permissions to roles. A permission of "*" grants everything
+// and "players:*" grants every players permission.
+type Policy struct {
+	Roles map[string][]string `json:"roles"`
+}
+
+// DefaultPolicy is used when no policy file is configured.
+var DefaultPolicy = &Policy{
+	Roles: map[string][]string{
+		"support": {PlayersRead},
+		"finance": {PlayersRead, PlayersBalance},
+		"admin":   {"*"},
+	},
+}
+
+// LoadPolicy reads a policy from a JSON file of the form
+// {"roles": {"support": ["players:read"]}}.
+func LoadPolicy(path string) (*Policy, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var p Policy
+	if err := json.Unmarshal(data, &p); err != nil {
+		return nil, fmt.Errorf("rbac policy %s: %w", path, err)
+	}
+	if len(p.Roles) == 0 {
+		return nil, fmt.Errorf("rbac policy %s: no roles defined", path)
+	}
+	return &p, nil
+}
+
+// Allows reports whether any of roles grants permission.
+func (p *Policy) Allows(roles []string, permission string) bool {
+	resource, _, _ := strings.Cut(permission, ":")
+	for _, role := range roles {
+		for _, granted := range p.Roles[role] {
+			if granted == "*" || granted == permission || granted == resource+":*" {
+				return true
+			}
+		}
+	}
+	return false
+}
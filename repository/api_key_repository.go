@@ -0,0 +1,18 @@
+package repository
+
+import (
+	"contoso/models"
+	"errors"
+)
+
+// ErrAPIKeyNotFound is returned when no API key matches.
+var ErrAPIKeyNotFound = errors.New("api key not found")
+
+// APIKeyRepository stores hashed API keys.
+type APIKeyRepository interface {
+	CreateAPIKey(key *models.APIKey) (*models.APIKey, error)
+	// GetAPIKeyByHash returns the key, including revoked ones, with the given hash.
+	GetAPIKeyByHash(hash string) (*models.APIKey, error)
+	ListAPIKeys() ([]models.APIKey, error)
+	RevokeAPIKey(id string) error
+}
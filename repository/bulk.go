@@ -0,0 +1,64 @@
+package repository
+
+import (
+	"contoso/models"
+	"errors"
+)
+
+// ErrBulkAborted is returned by atomic bulk writes that were rolled back;
+// the per-item results say which operation failed.
+var ErrBulkAborted = errors.New("bulk write aborted, no changes were applied")
+
+// ErrNotExecuted marks items of an aborted atomic bulk write that did not
+// fail themselves.
+var ErrNotExecuted = errors.New("not executed because another operation failed")
+
+// ErrDuplicateBulkID is returned for operations that target an ID already
+// targeted earlier in the same bulk request.
+var ErrDuplicateBulkID = errors.New("id is targeted by an earlier operation in this request")
+
+// BulkItemResult is the outcome of one bulk operation, in request order.
+type BulkItemResult struct {
+	// Player is the created or updated player; nil for deletes.
+	Player *models.Player
+	Err    error
+}
+
+// checkBulkIDs returns a result slice for ops with duplicate targets
+// already failed. Ops are grouped by kind when applied, so a second
+// operation on the same player would not run in request order.
+func checkBulkIDs(ops []models.BulkOperation) []BulkItemResult {
+	results := make([]BulkItemResult, len(ops))
+	seen := make(map[string]bool)
+	for i, op := range ops {
+		if op.Op == models.BulkCreate {
+			continue
+		}
+		if seen[op.ID] {
+			results[i].Err = ErrDuplicateBulkID
+		}
+		seen[op.ID] = true
+	}
+	return results
+}
+
+// bulkFailed reports whether any item failed.
+func bulkFailed(results []BulkItemResult) bool {
+	for _, r := range results {
+		if r.Err != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// abortBulk marks every successful item as not executed, for atomic writes
+// that are being rolled back.
+func abortBulk(results []BulkItemResult) ([]BulkItemResult, error) {
+	for i := range results {
+		if results[i].Err == nil {
+			results[i] = BulkItemResult{Err: ErrNotExecuted}
+		}
+	}
+	return results, ErrBulkAborted
+}
@@ -0,0 +1,24 @@
+package repository
+
+import (
+	"contoso/models"
+	"time"
+)
+
+// IdempotencyRepository stores responses by idempotency key.
+type IdempotencyRepository interface {
+	// Reserve claims key for a new request. It returns nil when the key was
+	// free, expired or held by a pending request without a heartbeat for
+	// staleAfter; otherwise it returns the existing record and leaves it
+	// untouched.
+	Reserve(key, fingerprint string, ttl, staleAfter time.Duration) (*models.IdempotencyRecord, error)
+	// Heartbeat records that the request holding key is still running, so
+	// that Reserve does not take the key over as stale.
+	Heartbeat(key string) error
+	// Complete stores the response for a reserved key.
+	Complete(key string, status int, contentType string, body []byte) error
+	// Release frees a reserved key whose request produced nothing worth replaying.
+	Release(key string) error
+	// DeleteExpired removes expired records and returns how many there were.
+	DeleteExpired() (int64, error)
+}
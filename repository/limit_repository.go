@@ -0,0 +1,103 @@
+package repository
+
+import (
+	"contoso/models"
+	"contoso/money"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ErrLimitNotFound is returned when the player has no limit of the
+// requested kind and period.
+var ErrLimitNotFound = errors.New("limit not found")
+
+// LimitExceededError is returned for balance changes that would take the
+// player beyond Limit, which Used already counts towards since the period
+// began at Since.
+type LimitExceededError struct {
+	Limit     models.Limit
+	Used      money.Amount
+	Requested money.Amount
+	Since     time.Time
+	ResetsAt  time.Time
+}
+
+func (e *LimitExceededError) Error() string {
+	verb := "deposited"
+	if e.Limit.Kind == models.LimitLoss {
+		verb = "lost"
+	}
+	currency := e.Limit.Amount.Currency
+	return fmt.Sprintf("%s %s limit of %s exceeded: %s %s since %s and %s requested; the limit resets at %s",
+		e.Limit.Period, e.Limit.Kind, e.Limit.Amount,
+		money.Money{Amount: e.Used, Currency: currency}, verb, e.Since.Format(time.RFC3339),
+		money.Money{Amount: e.Requested, Currency: currency}, e.ResetsAt.Format(time.RFC3339))
+}
+
+// LimitRepository stores players' responsible-gaming limits, which the
+// player repositories enforce on every balance change. Raising or removing
+// a limit takes effect after the repository's cooling-off period.
+type LimitRepository interface {
+	// ListLimits returns the player's limits with what was used of them in
+	// the current period.
+	ListLimits(playerID string) ([]models.Limit, error)
+	// SetLimit sets or changes a limit, which must be in the currency of
+	// the player's balance.
+	SetLimit(playerID, kind, period string, amount money.Money) (*models.Limit, error)
+	// RemoveLimit schedules the removal of a limit.
+	RemoveLimit(playerID, kind, period string) (*models.Limit, error)
+}
+
+// checkLimits returns a *LimitExceededError if change would take the player
+// beyond one of limits, which are of change's kind. used returns what the
+// player deposited or lost, by that kind, since a period start.
+func checkLimits(limits []models.Limit, change money.Money, now time.Time, used func(since time.Time) (money.Amount, error)) error {
+	requested := change.Amount
+	if requested.Sign() < 0 {
+		requested = requested.Neg()
+	}
+	for _, l := range limits {
+		if !l.Settle(now) || l.Amount.Currency != change.Currency {
+			continue
+		}
+		since := models.PeriodStart(l.Period, now)
+		u, err := used(since)
+		if err != nil {
+			return err
+		}
+		if u.Add(requested).Cmp(l.Amount.Amount) > 0 {
+			return &LimitExceededError{
+				Limit:     l,
+				Used:      u,
+				Requested: requested,
+				Since:     since,
+				ResetsAt:  models.PeriodEnd(l.Period, now),
+			}
+		}
+	}
+	return nil
+}
+
+// changeLimit applies a set (amount non-nil) or removal to existing, the
+// player's stored limit of kind and period or nil, and returns the limit to
+// store.
+func changeLimit(existing *models.Limit, kind, period string, amount *money.Money, now time.Time, coolingOff time.Duration) (*models.Limit, error) {
+	if existing != nil && !existing.Settle(now) {
+		existing = nil
+	}
+	if amount == nil {
+		if existing == nil {
+			return nil, ErrLimitNotFound
+		}
+		existing.Remove(now, coolingOff)
+		return existing, nil
+	}
+	// A new limit, or one replacing a limit in another currency, restricts
+	// the player more than none, so it applies at once.
+	if existing == nil || existing.Amount.Currency != amount.Currency {
+		return &models.Limit{Kind: kind, Period: period, Amount: *amount}, nil
+	}
+	existing.Set(amount.Amount, now, coolingOff)
+	return existing, nil
+}
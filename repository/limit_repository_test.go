@@ -0,0 +1,206 @@
+package repository
+
+import (
+	"contoso/models"
+	"contoso/money"
+	"errors"
+	"testing"
+	"time"
+)
+
+func eur(value int64) money.Money {
+	return money.Money{Amount: money.New(value, 0), Currency: "EUR"}
+}
+
+func amountPtr(value int64) *money.Amount {
+	a := money.New(value, 0)
+	return &a
+}
+
+func TestCheckLimits(t *testing.T) {
+	now := time.Date(2026, 3, 18, 15, 4, 5, 0, time.UTC) // a Wednesday
+	errUsage := errors.New("usage unavailable")
+	daily := func(amount int64) models.Limit {
+		return models.Limit{Kind: models.LimitDeposit, Period: models.PeriodDaily, Amount: eur(amount)}
+	}
+	tests := []struct {
+		name      string
+		limits    []models.Limit
+		change    money.Money
+		used      int64
+		usedErr   error
+		wantErr   error
+		wantSince time.Time
+	}{
+		{name: "no limits", change: eur(1000)},
+		{name: "below the limit", limits: []models.Limit{daily(100)}, change: eur(40), used: 50},
+		{name: "up to the limit", limits: []models.Limit{daily(100)}, change: eur(50), used: 50},
+		{
+			name:      "beyond the limit",
+			limits:    []models.Limit{daily(100)},
+			change:    eur(51),
+			used:      50,
+			wantErr:   &LimitExceededError{},
+			wantSince: time.Date(2026, 3, 18, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:      "debit beyond a weekly loss limit",
+			limits:    []models.Limit{{Kind: models.LimitLoss, Period: models.PeriodWeekly, Amount: eur(100)}},
+			change:    eur(-60),
+			used:      50,
+			wantErr:   &LimitExceededError{},
+			wantSince: time.Date(2026, 3, 16, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:   "limit in another currency",
+			limits: []models.Limit{{Kind: models.LimitDeposit, Period: models.PeriodDaily, Amount: money.Money{Amount: money.New(10, 0), Currency: "USD"}}},
+			change: eur(50),
+		},
+		{
+			name: "removal that took effect",
+			limits: []models.Limit{{
+				Kind: models.LimitDeposit, Period: models.PeriodDaily, Amount: eur(10),
+				Pending: &models.PendingLimit{EffectiveAt: now.Add(-time.Hour)},
+			}},
+			change: eur(50),
+		},
+		{
+			name: "raise that took effect",
+			limits: []models.Limit{{
+				Kind: models.LimitDeposit, Period: models.PeriodDaily, Amount: eur(10),
+				Pending: &models.PendingLimit{Amount: amountPtr(100), EffectiveAt: now.Add(-time.Hour)},
+			}},
+			change: eur(50),
+		},
+		{
+			name: "raise still cooling off",
+			limits: []models.Limit{{
+				Kind: models.LimitDeposit, Period: models.PeriodDaily, Amount: eur(10),
+				Pending: &models.PendingLimit{Amount: amountPtr(100), EffectiveAt: now.Add(time.Hour)},
+			}},
+			change:    eur(50),
+			wantErr:   &LimitExceededError{},
+			wantSince: time.Date(2026, 3, 18, 0, 0, 0, 0, time.UTC),
+		},
+		{name: "usage lookup fails", limits: []models.Limit{daily(100)}, change: eur(1), usedErr: errUsage, wantErr: errUsage},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var since time.Time
+			used := func(s time.Time) (money.Amount, error) {
+				since = s
+				return money.New(tt.used, 0), tt.usedErr
+			}
+			err := checkLimits(tt.limits, tt.change, now, used)
+			var lerr *LimitExceededError
+			switch want := tt.wantErr.(type) {
+			case nil:
+				if err != nil {
+					t.Fatalf("checkLimits: %v", err)
+				}
+			case *LimitExceededError:
+				if !errors.As(err, &lerr) {
+					t.Fatalf("checkLimits error = %v, want a *LimitExceededError", err)
+				}
+				requested := tt.change.Amount
+				if requested.Sign() < 0 {
+					requested = requested.Neg()
+				}
+				if !lerr.Used.Equal(money.New(tt.used, 0)) || !lerr.Requested.Equal(requested) {
+					t.Errorf("used %s and requested %s, want %d and %s", lerr.Used, lerr.Requested, tt.used, requested)
+				}
+				if !lerr.Since.Equal(tt.wantSince) || !since.Equal(tt.wantSince) {
+					t.Errorf("since %s, usage counted since %s, want %s", lerr.Since, since, tt.wantSince)
+				}
+				if !lerr.ResetsAt.Equal(models.PeriodEnd(lerr.Limit.Period, now)) {
+					t.Errorf("resets at %s, want the end of the %s period", lerr.ResetsAt, lerr.Limit.Period)
+				}
+			default:
+				if !errors.Is(err, want) {
+					t.Fatalf("checkLimits error = %v, want %v", err, want)
+				}
+			}
+		})
+	}
+}
+
+func TestChangeLimit(t *testing.T) {
+	now := time.Date(2026, 3, 18, 15, 4, 5, 0, time.UTC)
+	const coolingOff = 24 * time.Hour
+	stored := func(amount int64, pending *models.PendingLimit) *models.Limit {
+		return &models.Limit{Kind: models.LimitDeposit, Period: models.PeriodDaily, Amount: eur(amount), Pending: pending}
+	}
+	set := func(m money.Money) *money.Money { return &m }
+	tests := []struct {
+		name     string
+		existing *models.Limit
+		amount   *money.Money
+		want     *models.Limit
+		wantErr  error
+	}{
+		{name: "new limit", amount: set(eur(100)), want: stored(100, nil)},
+		{name: "removing a missing limit", wantErr: ErrLimitNotFound},
+		{name: "lowering", existing: stored(100, nil), amount: set(eur(50)), want: stored(50, nil)},
+		{
+			name:     "lowering cancels a pending raise",
+			existing: stored(100, &models.PendingLimit{Amount: amountPtr(200), EffectiveAt: now.Add(time.Hour)}),
+			amount:   set(eur(50)),
+			want:     stored(50, nil),
+		},
+		{
+			name:     "raising cools off",
+			existing: stored(100, nil),
+			amount:   set(eur(200)),
+			want:     stored(100, &models.PendingLimit{Amount: amountPtr(200), EffectiveAt: now.Add(coolingOff)}),
+		},
+		{
+			name:     "another currency replaces at once",
+			existing: stored(100, nil),
+			amount:   set(money.Money{Amount: money.New(500, 0), Currency: "USD"}),
+			want:     &models.Limit{Kind: models.LimitDeposit, Period: models.PeriodDaily, Amount: money.Money{Amount: money.New(500, 0), Currency: "USD"}},
+		},
+		{
+			name:     "removal cools off",
+			existing: stored(100, nil),
+			want:     stored(100, &models.PendingLimit{EffectiveAt: now.Add(coolingOff)}),
+		},
+		{
+			name:     "removing a limit already removed",
+			existing: stored(100, &models.PendingLimit{EffectiveAt: now.Add(-time.Hour)}),
+			wantErr:  ErrLimitNotFound,
+		},
+		{
+			name:     "setting a limit already removed applies at once",
+			existing: stored(100, &models.PendingLimit{EffectiveAt: now.Add(-time.Hour)}),
+			amount:   set(eur(300)),
+			want:     stored(300, nil),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := changeLimit(tt.existing, models.LimitDeposit, models.PeriodDaily, tt.amount, now, coolingOff)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("changeLimit error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("changeLimit: %v", err)
+			}
+			if got.Kind != tt.want.Kind || got.Period != tt.want.Period ||
+				got.Amount.Currency != tt.want.Amount.Currency || !got.Amount.Amount.Equal(tt.want.Amount.Amount) {
+				t.Errorf("limit = %s %s %s, want %s %s %s", got.Period, got.Kind, got.Amount, tt.want.Period, tt.want.Kind, tt.want.Amount)
+			}
+			switch {
+			case got.Pending == nil && tt.want.Pending == nil:
+			case got.Pending == nil || tt.want.Pending == nil:
+				t.Errorf("pending = %+v, want %+v", got.Pending, tt.want.Pending)
+			case !got.Pending.EffectiveAt.Equal(tt.want.Pending.EffectiveAt) ||
+				(got.Pending.Amount == nil) != (tt.want.Pending.Amount == nil) ||
+				(got.Pending.Amount != nil && !got.Pending.Amount.Equal(*tt.want.Pending.Amount)):
+				t.Errorf("pending = %+v, want %+v", got.Pending, tt.want.Pending)
+			}
+		})
+	}
+}
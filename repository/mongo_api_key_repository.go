@@ -0,0 +1,89 @@
+package repository
+
+import (
+	"context"
+	"contoso/models"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+type MongoAPIKeyRepository struct {
+	collection *mongo.Collection
+}
+
+func NewMongoAPIKeyRepository(col *mongo.Collection) *MongoAPIKeyRepository {
+	return &MongoAPIKeyRepository{collection: col}
+}
+
+func (r *MongoAPIKeyRepository) CreateAPIKey(key *models.APIKey) (*models.APIKey, error) {
+	key.ID = ""
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	res, err := r.collection.InsertOne(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	key.ID = res.InsertedID.(primitive.ObjectID).Hex()
+	return key, nil
+}
+
+func (r *MongoAPIKeyRepository) GetAPIKeyByHash(hash string) (*models.APIKey, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	var raw bson.Raw
+	if err := r.collection.FindOne(ctx, bson.M{"hash": hash}).Decode(&raw); err != nil {
+		return nil, ErrAPIKeyNotFound
+	}
+	return decodeMongoAPIKey(raw)
+}
+
+func (r *MongoAPIKeyRepository) ListAPIKeys() ([]models.APIKey, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	cursor, err := r.collection.Find(ctx, bson.M{})
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+	var keys []models.APIKey
+	for cursor.Next(ctx) {
+		if key, err := decodeMongoAPIKey(cursor.Current); err == nil {
+			keys = append(keys, *key)
+		}
+	}
+	return keys, nil
+}
+
+func (r *MongoAPIKeyRepository) RevokeAPIKey(id string) error {
+	objID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return ErrInvalidID
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	res, err := r.collection.UpdateOne(ctx,
+		bson.M{"_id": objID, "revoked_at": bson.M{"$exists": false}},
+		bson.M{"$set": bson.M{"revoked_at": time.Now()}},
+	)
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return ErrAPIKeyNotFound
+	}
+	return nil
+}
+
+func decodeMongoAPIKey(raw bson.Raw) (*models.APIKey, error) {
+	var key models.APIKey
+	if err := bson.Unmarshal(raw, &key); err != nil {
+		return nil, err
+	}
+	if oid, ok := raw.Lookup("_id").ObjectIDOK(); ok {
+		key.ID = oid.Hex()
+	}
+	return &key, nil
+}
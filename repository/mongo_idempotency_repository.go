@@ -0,0 +1,91 @@
+package repository
+
+import (
+	"context"
+	"contoso/models"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type MongoIdempotencyRepository struct {
+	collection *mongo.Collection
+}
+
+func NewMongoIdempotencyRepository(col *mongo.Collection) *MongoIdempotencyRepository {
+	return &MongoIdempotencyRepository{collection: col}
+}
+
+func (r *MongoIdempotencyRepository) Reserve(key, fingerprint string, ttl, staleAfter time.Duration) (*models.IdempotencyRecord, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	now := time.Now()
+	// The upsert only matches records that may be taken over; for any
+	// other existing record it tries to insert a duplicate _id and fails.
+	_, err := r.collection.UpdateOne(ctx,
+		bson.M{"_id": key, "$or": bson.A{
+			bson.M{"expires_at": bson.M{"$lt": now}},
+			bson.M{"status": 0, "heartbeat_at": bson.M{"$lt": now.Add(-staleAfter)}},
+		}},
+		bson.M{"$set": bson.M{
+			"fingerprint":  fingerprint,
+			"status":       0,
+			"content_type": "",
+			"body":         nil,
+			"created_at":   now,
+			"heartbeat_at": now,
+			"expires_at":   now.Add(ttl),
+		}},
+		options.Update().SetUpsert(true),
+	)
+	if err == nil {
+		return nil, nil
+	}
+	if !mongo.IsDuplicateKeyError(err) {
+		return nil, err
+	}
+	var record models.IdempotencyRecord
+	if err := r.collection.FindOne(ctx, bson.M{"_id": key}).Decode(&record); err != nil {
+		return nil, err
+	}
+	return &record, nil
+}
+
+func (r *MongoIdempotencyRepository) Heartbeat(key string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	_, err := r.collection.UpdateOne(ctx,
+		bson.M{"_id": key, "status": 0},
+		bson.M{"$set": bson.M{"heartbeat_at": time.Now()}},
+	)
+	return err
+}
+
+func (r *MongoIdempotencyRepository) Complete(key string, status int, contentType string, body []byte) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	_, err := r.collection.UpdateOne(ctx,
+		bson.M{"_id": key, "status": 0},
+		bson.M{"$set": bson.M{"status": status, "content_type": contentType, "body": body}},
+	)
+	return err
+}
+
+func (r *MongoIdempotencyRepository) Release(key string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	_, err := r.collection.DeleteOne(ctx, bson.M{"_id": key, "status": 0})
+	return err
+}
+
+func (r *MongoIdempotencyRepository) DeleteExpired() (int64, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	res, err := r.collection.DeleteMany(ctx, bson.M{"expires_at": bson.M{"$lt": time.Now()}})
+	if err != nil {
+		return 0, err
+	}
+	return res.DeletedCount, nil
+}
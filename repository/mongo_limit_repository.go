@@ -0,0 +1,213 @@
+package repository
+
+import (
+	"context"
+	"contoso/models"
+	"contoso/money"
+	"errors"
+	"sort"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// MongoLimitRepository stores limits in player_limits. Usage is summed from
+// balance_changes, which creates, balance changes and transfers write.
+type MongoLimitRepository struct {
+	players    *mongo.Collection
+	limits     *mongo.Collection
+	ledger     *mongo.Collection
+	coolingOff time.Duration
+}
+
+func NewMongoLimitRepository(players, limits, ledger *mongo.Collection, coolingOff time.Duration) *MongoLimitRepository {
+	return &MongoLimitRepository{players: players, limits: limits, ledger: ledger, coolingOff: coolingOff}
+}
+
+// limitDocument is a limit as stored in player_limits.
+type limitDocument struct {
+	PlayerID      primitive.ObjectID `bson:"player_id"`
+	Kind          string             `bson:"kind"`
+	Period        string             `bson:"period"`
+	Amount        money.Money        `bson:"amount"`
+	PendingAmount *money.Amount      `bson:"pending_amount"`
+	PendingAt     *time.Time         `bson:"pending_at"`
+}
+
+func (d limitDocument) limit() models.Limit {
+	l := models.Limit{Kind: d.Kind, Period: d.Period, Amount: d.Amount}
+	if d.PendingAt != nil {
+		l.Pending = &models.PendingLimit{Amount: d.PendingAmount, EffectiveAt: d.PendingAt.UTC()}
+	}
+	return l
+}
+
+// balanceChangeDocument is an entry of balance_changes.
+type balanceChangeDocument struct {
+	PlayerID  primitive.ObjectID `bson:"player_id"`
+	Amount    money.Money        `bson:"amount"`
+	CreatedAt time.Time          `bson:"created_at"`
+}
+
+// periodOrder sorts limits from the shortest period.
+var periodOrder = map[string]int{models.PeriodDaily: 0, models.PeriodWeekly: 1, models.PeriodMonthly: 2}
+
+func (r *MongoLimitRepository) ListLimits(playerID string) ([]models.Limit, error) {
+	objID, err := primitive.ObjectIDFromHex(playerID)
+	if err != nil {
+		return nil, ErrInvalidID
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	n, err := r.players.CountDocuments(ctx, bson.M{"_id": objID})
+	if err != nil {
+		return nil, err
+	}
+	if n == 0 {
+		return nil, ErrPlayerNotFound
+	}
+	stored, err := mongoLimits(ctx, r.limits, objID, models.LimitDeposit, models.LimitLoss)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now().UTC()
+	limits := []models.Limit{}
+	for _, l := range stored {
+		if !l.Settle(now) {
+			continue
+		}
+		used, err := mongoLimitUsage(ctx, r.ledger, objID, l.Kind, l.Amount.Currency, models.PeriodStart(l.Period, now))
+		if err != nil {
+			return nil, err
+		}
+		resetsAt := models.PeriodEnd(l.Period, now)
+		l.Used, l.ResetsAt = &money.Money{Amount: used, Currency: l.Amount.Currency}, &resetsAt
+		limits = append(limits, l)
+	}
+	return limits, nil
+}
+
+func (r *MongoLimitRepository) SetLimit(playerID, kind, period string, amount money.Money) (*models.Limit, error) {
+	return r.change(playerID, kind, period, &amount)
+}
+
+func (r *MongoLimitRepository) RemoveLimit(playerID, kind, period string) (*models.Limit, error) {
+	return r.change(playerID, kind, period, nil)
+}
+
+// change sets the limit to amount, or removes it if amount is nil.
+func (r *MongoLimitRepository) change(playerID, kind, period string, amount *money.Money) (*models.Limit, error) {
+	objID, err := primitive.ObjectIDFromHex(playerID)
+	if err != nil {
+		return nil, ErrInvalidID
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	var l *models.Limit
+	err = withMongoTransaction(ctx, r.players.Database().Client(), func(sc mongo.SessionContext) error {
+		var player models.Player
+		if err := r.players.FindOne(sc, bson.M{"_id": objID}).Decode(&player); err != nil {
+			if errors.Is(err, mongo.ErrNoDocuments) {
+				return ErrPlayerNotFound
+			}
+			return err
+		}
+		if amount != nil && amount.Currency != player.Balance.Currency {
+			return ErrCurrencyMismatch
+		}
+		filter := bson.M{"player_id": objID, "kind": kind, "period": period}
+		var existing *models.Limit
+		var doc limitDocument
+		err := r.limits.FindOne(sc, filter).Decode(&doc)
+		switch {
+		case err == nil:
+			stored := doc.limit()
+			existing = &stored
+		case !errors.Is(err, mongo.ErrNoDocuments):
+			return err
+		}
+		if l, err = changeLimit(existing, kind, period, amount, time.Now().UTC(), r.coolingOff); err != nil {
+			return err
+		}
+		doc = limitDocument{PlayerID: objID, Kind: l.Kind, Period: l.Period, Amount: l.Amount}
+		if l.Pending != nil {
+			doc.PendingAmount, doc.PendingAt = l.Pending.Amount, &l.Pending.EffectiveAt
+		}
+		_, err = r.limits.ReplaceOne(sc, filter, doc, options.Replace().SetUpsert(true))
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return l, nil
+}
+
+// checkMongoLimits returns a *LimitExceededError if change would take the
+// player beyond one of their limits.
+func checkMongoLimits(ctx context.Context, limits, ledger *mongo.Collection, playerID primitive.ObjectID, change money.Money, now time.Time) error {
+	kind := models.LimitKindOf(change.Amount)
+	stored, err := mongoLimits(ctx, limits, playerID, kind)
+	if err != nil {
+		return err
+	}
+	return checkLimits(stored, change, now, func(since time.Time) (money.Amount, error) {
+		return mongoLimitUsage(ctx, ledger, playerID, kind, change.Currency, since)
+	})
+}
+
+// mongoLimits returns the player's stored limits of kinds.
+func mongoLimits(ctx context.Context, col *mongo.Collection, playerID primitive.ObjectID, kinds ...string) ([]models.Limit, error) {
+	cursor, err := col.Find(ctx, bson.M{"player_id": playerID, "kind": bson.M{"$in": kinds}})
+	if err != nil {
+		return nil, err
+	}
+	var docs []limitDocument
+	if err := cursor.All(ctx, &docs); err != nil {
+		return nil, err
+	}
+	limits := make([]models.Limit, len(docs))
+	for i, d := range docs {
+		limits[i] = d.limit()
+	}
+	sort.Slice(limits, func(i, j int) bool {
+		if limits[i].Kind != limits[j].Kind {
+			return limits[i].Kind < limits[j].Kind
+		}
+		return periodOrder[limits[i].Period] < periodOrder[limits[j].Period]
+	})
+	return limits, nil
+}
+
+// mongoLimitUsage returns how much the player deposited or lost, by kind,
+// in currency since since.
+func mongoLimitUsage(ctx context.Context, ledger *mongo.Collection, playerID primitive.ObjectID, kind, currency string, since time.Time) (money.Amount, error) {
+	direction := bson.M{"$gt": 0}
+	if kind == models.LimitLoss {
+		direction = bson.M{"$lt": 0}
+	}
+	cursor, err := ledger.Aggregate(ctx, mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{
+			"player_id":       playerID,
+			"amount.currency": currency,
+			"amount.amount":   direction,
+			"created_at":      bson.M{"$gte": since},
+		}}},
+		{{Key: "$group", Value: bson.M{"_id": nil, "used": bson.M{"$sum": bson.M{"$abs": "$amount.amount"}}}}},
+	})
+	if err != nil {
+		return money.Amount{}, err
+	}
+	var results []struct {
+		Used money.Amount `bson:"used"`
+	}
+	if err := cursor.All(ctx, &results); err != nil {
+		return money.Amount{}, err
+	}
+	if len(results) == 0 {
+		return money.Amount{}, nil
+	}
+	return results[0].Used, nil
+}
@@ -0,0 +1,86 @@
+package repository
+
+import (
+	"context"
+	"contoso/events"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// outboxEntry is an event as stored in the outbox collection.
+type outboxEntry struct {
+	events.Event  `bson:",inline"`
+	NextAttemptAt time.Time  `bson:"next_attempt_at"`
+	PublishedAt   *time.Time `bson:"published_at"`
+}
+
+// MongoOutboxRepository reads the outbox collection that
+// MongoPlayerRepository writes events to.
+type MongoOutboxRepository struct {
+	collection *mongo.Collection
+}
+
+func NewMongoOutboxRepository(col *mongo.Collection) *MongoOutboxRepository {
+	return &MongoOutboxRepository{collection: col}
+}
+
+func (r *MongoOutboxRepository) ClaimEvents(limit int, lease time.Duration) ([]events.Event, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	now := time.Now()
+	// One document at a time so each claim is atomic on its own.
+	var claimed []events.Event
+	for len(claimed) < limit {
+		var entry outboxEntry
+		err := r.collection.FindOneAndUpdate(ctx,
+			bson.M{"published_at": nil, "next_attempt_at": bson.M{"$lte": now}},
+			bson.M{"$set": bson.M{"next_attempt_at": now.Add(lease)}},
+			options.FindOneAndUpdate().SetSort(bson.D{{Key: "occurred_at", Value: 1}, {Key: "_id", Value: 1}}),
+		).Decode(&entry)
+		if err == mongo.ErrNoDocuments {
+			break
+		}
+		if err != nil {
+			return claimed, err
+		}
+		claimed = append(claimed, entry.Event)
+	}
+	return claimed, nil
+}
+
+func (r *MongoOutboxRepository) MarkPublished(ids []string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	_, err := r.collection.UpdateMany(ctx,
+		bson.M{"_id": bson.M{"$in": ids}},
+		bson.M{"$set": bson.M{"published_at": time.Now()}},
+	)
+	return err
+}
+
+func (r *MongoOutboxRepository) DeletePublishedBefore(t time.Time) (int64, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	res, err := r.collection.DeleteMany(ctx, bson.M{"published_at": bson.M{"$lt": t}})
+	if err != nil {
+		return 0, err
+	}
+	return res.DeletedCount, nil
+}
+
+// insertMongoOutbox writes evs to the outbox collection; ctx must carry the
+// transaction of the change they describe.
+func insertMongoOutbox(ctx context.Context, col *mongo.Collection, evs ...events.Event) error {
+	if len(evs) == 0 {
+		return nil
+	}
+	docs := make([]interface{}, len(evs))
+	for i, e := range evs {
+		docs[i] = outboxEntry{Event: e, NextAttemptAt: e.OccurredAt}
+	}
+	_, err := col.InsertMany(ctx, docs)
+	return err
+}
@@ -2,32 +2,72 @@ package repository
 
 import (
 	"context"
+	"contoso/events"
 	"contoso/models"
+	"contoso/money"
 	"errors"
+	"fmt"
 	"time"
 
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
+// MongoPlayerRepository stores players and writes an event to the outbox
+// collection in the same transaction as every change, so writes need a
+// replica set. Balance changes are checked against the player's limits and
+// recorded in the ledger collection. Deleting a player deletes their
+// wallets, limits and ledger entries too.
 type MongoPlayerRepository struct {
 	collection *mongo.Collection
+	outbox     *mongo.Collection
+	wallets    *mongo.Collection
+	limits     *mongo.Collection
+	ledger     *mongo.Collection
 }
 
-func NewMongoPlayerRepository(col *mongo.Collection) *MongoPlayerRepository {
-	return &MongoPlayerRepository{collection: col}
+func NewMongoPlayerRepository(col, outbox, wallets, limits, ledger *mongo.Collection) *MongoPlayerRepository {
+	return &MongoPlayerRepository{collection: col, outbox: outbox, wallets: wallets, limits: limits, ledger: ledger}
+}
+
+// dependents returns the collections holding per-player documents, which
+// are deleted with the player.
+func (r *MongoPlayerRepository) dependents() []*mongo.Collection {
+	return []*mongo.Collection{r.wallets, r.limits, r.ledger}
+}
+
+// withTransaction runs fn in a transaction on the players' database.
+func (r *MongoPlayerRepository) withTransaction(ctx context.Context, fn func(sc mongo.SessionContext) error) error {
+	return withMongoTransaction(ctx, r.collection.Database().Client(), fn)
 }
 
 func (r *MongoPlayerRepository) CreatePlayer(player *models.Player) (*models.Player, error) {
-	player.ID = ""
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
-	res, err := r.collection.InsertOne(ctx, player)
+	err := r.withTransaction(ctx, func(sc mongo.SessionContext) error {
+		// Cleared on every attempt so the insert generates an ObjectID.
+		player.ID = ""
+		player.Status = models.InitialStatus(player.Status)
+		res, err := r.collection.InsertOne(sc, player)
+		if err != nil {
+			return err
+		}
+		objID := res.InsertedID.(primitive.ObjectID)
+		player.ID = objID.Hex()
+		// The opening balance is a deposit like any other.
+		if !player.Balance.Amount.IsZero() {
+			doc := balanceChangeDocument{PlayerID: objID, Amount: player.Balance, CreatedAt: time.Now().UTC()}
+			if _, err := r.ledger.InsertOne(sc, doc); err != nil {
+				return err
+			}
+		}
+		return insertMongoOutbox(sc, r.outbox, events.New(events.PlayerCreated, player.ID, player))
+	})
 	if err != nil {
 		return nil, err
 	}
-	player.ID = res.InsertedID.(primitive.ObjectID).Hex()
 	return player, nil
 }
 
@@ -52,16 +92,42 @@ func (r *MongoPlayerRepository) GetPlayers() ([]models.Player, error) {
 	return players, nil
 }
 
+func (r *MongoPlayerRepository) StreamPlayers(fn func(player *models.Player) error) error {
+	ctx, cancel := context.WithTimeout(context.Background(), streamTimeout)
+	defer cancel()
+	cursor, err := r.collection.Find(ctx, bson.M{}, options.Find().SetSort(bson.M{"_id": 1}))
+	if err != nil {
+		return err
+	}
+	defer cursor.Close(ctx)
+	for cursor.Next(ctx) {
+		var player models.Player
+		if err := cursor.Decode(&player); err != nil {
+			return err
+		}
+		if oid, ok := cursor.Current.Lookup("_id").ObjectIDOK(); ok {
+			player.ID = oid.Hex()
+		}
+		if err := fn(&player); err != nil {
+			return err
+		}
+	}
+	return cursor.Err()
+}
+
 func (r *MongoPlayerRepository) GetPlayer(id string) (*models.Player, error) {
 	objID, err := primitive.ObjectIDFromHex(id)
 	if err != nil {
-		return nil, errors.New("invalid id")
+		return nil, ErrInvalidID
 	}
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
 	var player models.Player
 	if err := r.collection.FindOne(ctx, bson.M{"_id": objID}).Decode(&player); err != nil {
-		return nil, errors.New("player not found")
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, ErrPlayerNotFound
+		}
+		return nil, err
 	}
 	player.ID = objID.Hex()
 	return &player, nil
@@ -70,7 +136,7 @@ func (r *MongoPlayerRepository) GetPlayer(id string) (*models.Player, error) {
 func (r *MongoPlayerRepository) UpdatePlayer(id string, input *models.Player) (*models.Player, error) {
 	objID, err := primitive.ObjectIDFromHex(id)
 	if err != nil {
-		return nil, errors.New("invalid id")
+		return nil, ErrInvalidID
 	}
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
@@ -78,24 +144,607 @@ func (r *MongoPlayerRepository) UpdatePlayer(id string, input *models.Player) (*
 		"$set": bson.M{
 			"name":    input.Name,
 			"surname": input.Surname,
-			"balance": input.Balance,
 		},
 	}
-	_, err = r.collection.UpdateOne(ctx, bson.M{"_id": objID}, update)
+	err = r.withTransaction(ctx, func(sc mongo.SessionContext) error {
+		var updated struct {
+			Balance money.Money `bson:"balance"`
+			Status  string      `bson:"status"`
+		}
+		err := r.collection.FindOneAndUpdate(sc, bson.M{"_id": objID}, update,
+			options.FindOneAndUpdate().SetProjection(bson.M{"balance": 1, "status": 1}),
+		).Decode(&updated)
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return ErrPlayerNotFound
+		}
+		if err != nil {
+			return err
+		}
+		input.ID, input.Balance, input.Status = id, updated.Balance, updated.Status
+		return insertMongoOutbox(sc, r.outbox, events.New(events.PlayerUpdated, id, input))
+	})
 	if err != nil {
 		return nil, err
 	}
-	input.ID = id
 	return input, nil
 }
 
+func (r *MongoPlayerRepository) PatchPlayer(id string, expected *models.Player, patch *models.PlayerPatch) (*models.Player, error) {
+	objID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, ErrInvalidID
+	}
+	if patch.IsEmpty() {
+		return applyEmptyPatch(r, id, expected)
+	}
+	set := bson.M{}
+	if patch.Name != nil {
+		set["name"] = *patch.Name
+	}
+	if patch.Surname != nil {
+		set["surname"] = *patch.Surname
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	var player models.Player
+	err = r.withTransaction(ctx, func(sc mongo.SessionContext) error {
+		player = models.Player{}
+		err := r.collection.FindOneAndUpdate(sc,
+			bson.M{
+				"_id":              objID,
+				"name":             expected.Name,
+				"surname":          expected.Surname,
+				"balance.amount":   expected.Balance.Amount,
+				"balance.currency": expected.Balance.Currency,
+				"status":           expected.Status,
+			},
+			bson.M{"$set": set},
+			options.FindOneAndUpdate().SetReturnDocument(options.After),
+		).Decode(&player)
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			// Nothing matched: the player is gone or no longer has the
+			// state the patch was applied to.
+			n, err := r.collection.CountDocuments(sc, bson.M{"_id": objID}, options.Count().SetLimit(1))
+			if err != nil {
+				return err
+			}
+			if n > 0 {
+				return ErrPlayerChanged
+			}
+			return ErrPlayerNotFound
+		}
+		if err != nil {
+			return err
+		}
+		player.ID = objID.Hex()
+		return insertMongoOutbox(sc, r.outbox, events.New(events.PlayerUpdated, id, &player))
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &player, nil
+}
+
+func (r *MongoPlayerRepository) NewPlayerID() (string, error) {
+	return primitive.NewObjectID().Hex(), nil
+}
+
+func (r *MongoPlayerRepository) PutPlayer(player *models.Player) error {
+	objID, err := primitive.ObjectIDFromHex(player.ID)
+	if err != nil {
+		return ErrInvalidID
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	doc := *player
+	doc.ID = ""
+	_, err = r.collection.ReplaceOne(ctx, bson.M{"_id": objID}, &doc, options.Replace().SetUpsert(true))
+	return err
+}
+
 func (r *MongoPlayerRepository) DeletePlayer(id string) error {
 	objID, err := primitive.ObjectIDFromHex(id)
 	if err != nil {
-		return errors.New("invalid id")
+		return ErrInvalidID
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	return r.withTransaction(ctx, func(sc mongo.SessionContext) error {
+		res, err := r.collection.DeleteOne(sc, bson.M{"_id": objID})
+		if err != nil {
+			return err
+		}
+		if res.DeletedCount == 0 {
+			return ErrPlayerNotFound
+		}
+		for _, col := range r.dependents() {
+			if _, err := col.DeleteMany(sc, bson.M{"player_id": objID}); err != nil {
+				return err
+			}
+		}
+		return insertMongoOutbox(sc, r.outbox, events.New(events.PlayerDeleted, id, nil))
+	})
+}
+
+func (r *MongoPlayerRepository) AdjustBalance(id string, amount money.Money) (*models.Player, error) {
+	objID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, ErrInvalidID
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	var player models.Player
+	err = r.withTransaction(ctx, func(sc mongo.SessionContext) error {
+		player = models.Player{}
+		now := time.Now().UTC()
+		if err := checkMongoLimits(sc, r.limits, r.ledger, objID, amount, now); err != nil {
+			return err
+		}
+		filter := bson.M{
+			"_id":              objID,
+			"balance.currency": amount.Currency,
+			"status":           bson.M{"$nin": models.BalanceLockedStatuses},
+		}
+		// Debits must leave the balance at zero or more; see checkFunds
+		if amount.Amount.Sign() < 0 {
+			filter["balance.amount"] = bson.M{"$gte": amount.Amount.Neg()}
+		}
+		err := r.collection.FindOneAndUpdate(sc,
+			filter,
+			bson.M{"$inc": bson.M{"balance.amount": amount.Amount}},
+			options.FindOneAndUpdate().SetReturnDocument(options.After),
+		).Decode(&player)
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			// Unless another condition failed, the debit exceeded the balance.
+			return balanceRejection(sc, r.collection, objID, amount.Currency, ErrInsufficientFunds)
+		}
+		if err != nil {
+			return err
+		}
+		player.ID = objID.Hex()
+		if _, err := r.ledger.InsertOne(sc, balanceChangeDocument{PlayerID: objID, Amount: amount, CreatedAt: now}); err != nil {
+			return err
+		}
+		return insertMongoOutbox(sc, r.outbox, balanceChanged(&player, amount))
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &player, nil
+}
+
+func (r *MongoPlayerRepository) SetStatus(id string, change models.StatusChange) (*models.Player, error) {
+	objID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, ErrInvalidID
 	}
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
-	_, err = r.collection.DeleteOne(ctx, bson.M{"_id": objID})
+	var player models.Player
+	err = r.withTransaction(ctx, func(sc mongo.SessionContext) error {
+		player = models.Player{}
+		if err := r.collection.FindOne(sc, bson.M{"_id": objID}).Decode(&player); err != nil {
+			if errors.Is(err, mongo.ErrNoDocuments) {
+				return ErrPlayerNotFound
+			}
+			return err
+		}
+		if !models.CanTransition(player.Status, change.Status) {
+			return fmt.Errorf("%w from %s to %s", ErrInvalidStatusTransition, player.Status, change.Status)
+		}
+		if _, err := r.collection.UpdateOne(sc, bson.M{"_id": objID}, bson.M{"$set": bson.M{"status": change.Status}}); err != nil {
+			return err
+		}
+		previous := player.Status
+		player.ID, player.Status = objID.Hex(), change.Status
+		return insertMongoOutbox(sc, r.outbox, statusChanged(&player, previous, change.Reason))
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &player, nil
+}
+
+// balanceRejection explains why a balance update of the player with objID
+// in currency matched nothing: the player is missing, their status forbids
+// it or their balance is in another currency. Otherwise it returns
+// otherwise, the error for any further condition the update had.
+func balanceRejection(ctx context.Context, players *mongo.Collection, objID primitive.ObjectID, currency string, otherwise error) error {
+	var current models.Player
+	err := players.FindOne(ctx, bson.M{"_id": objID}).Decode(&current)
+	switch {
+	case errors.Is(err, mongo.ErrNoDocuments):
+		return ErrPlayerNotFound
+	case err != nil:
+		return err
+	case models.BalanceLocked(current.Status):
+		return ErrBalanceLocked
+	case current.Balance.Currency != currency:
+		return ErrCurrencyMismatch
+	}
+	return otherwise
+}
+
+func (r *MongoPlayerRepository) BulkWrite(ops []models.BulkOperation, atomic bool) ([]BulkItemResult, error) {
+	results := checkBulkIDs(ops)
+	ids := make([]primitive.ObjectID, len(ops))
+	for i, op := range ops {
+		if results[i].Err != nil {
+			continue
+		}
+		if op.Op == models.BulkCreate {
+			ids[i] = primitive.NewObjectID()
+			continue
+		}
+		objID, err := primitive.ObjectIDFromHex(op.ID)
+		if err != nil {
+			results[i].Err = ErrInvalidID
+			continue
+		}
+		ids[i] = objID
+	}
+	if atomic && bulkFailed(results) {
+		return abortBulk(results)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	if !atomic {
+		return r.bestEffortBulkWrite(ctx, ops, ids, results)
+	}
+	var attempt []BulkItemResult
+	err := r.withTransaction(ctx, func(sc mongo.SessionContext) error {
+		// WithTransaction may retry, so each attempt starts from clean results.
+		attempt = make([]BulkItemResult, len(ops))
+		if err := r.bulkWrite(sc, ops, ids, attempt, true); err != nil {
+			return err
+		}
+		if bulkFailed(attempt) {
+			return ErrBulkAborted
+		}
+		if err := r.deleteBulkDependents(sc, ops, ids, attempt); err != nil {
+			return err
+		}
+		if err := r.recordBulkOpeningBalances(sc, ops, ids, attempt); err != nil {
+			return err
+		}
+		return insertMongoOutbox(sc, r.outbox, bulkEvents(ops, attempt)...)
+	})
+	if errors.Is(err, ErrBulkAborted) {
+		return abortBulk(attempt)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return attempt, nil
+}
+
+func (r *MongoPlayerRepository) Stats(query models.PlayerStatsQuery) (*models.PlayerStats, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	// $bucket would merge balances below the first and above the last
+	// bound into one default bucket, so buckets are numbered with $switch
+	// instead: 0 below the first bound up to len(bounds) at or above the last.
+	branches := make(bson.A, len(query.Bounds))
+	for i, bound := range query.Bounds {
+		branches[i] = bson.M{"case": bson.M{"$lt": bson.A{"$balance.amount", bound}}, "then": i}
+	}
+	bucket := bson.M{"$switch": bson.M{"branches": branches, "default": len(query.Bounds)}}
+	facets := bson.M{
+		"summary": bson.A{bson.M{"$group": bson.M{
+			"_id":   nil,
+			"count": bson.M{"$sum": 1},
+			"total": bson.M{"$sum": "$balance.amount"},
+			"avg":   bson.M{"$avg": "$balance.amount"},
+			"min":   bson.M{"$min": "$balance.amount"},
+			"max":   bson.M{"$max": "$balance.amount"},
+		}}},
+		"buckets": bson.A{bson.M{"$group": bson.M{"_id": bucket, "count": bson.M{"$sum": 1}}}},
+	}
+	field, grouped := models.PlayerGroupFields[query.GroupBy]
+	if grouped {
+		facets["groups"] = bson.A{
+			bson.M{"$group": bson.M{
+				"_id":   "$" + field,
+				"count": bson.M{"$sum": 1},
+				"total": bson.M{"$sum": "$balance.amount"},
+				"avg":   bson.M{"$avg": "$balance.amount"},
+			}},
+			bson.M{"$sort": bson.D{{Key: "count", Value: -1}, {Key: "_id", Value: 1}}},
+			bson.M{"$limit": query.GroupLimit},
+		}
+	}
+	cursor, err := r.collection.Aggregate(ctx, bson.A{
+		bson.M{"$match": bson.M{"balance.currency": query.Currency}},
+		bson.M{"$facet": facets},
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+	var result []struct {
+		Summary []struct {
+			Count int64        `bson:"count"`
+			Total money.Amount `bson:"total"`
+			Avg   money.Amount `bson:"avg"`
+			Min   money.Amount `bson:"min"`
+			Max   money.Amount `bson:"max"`
+		} `bson:"summary"`
+		Buckets []struct {
+			Bucket int   `bson:"_id"`
+			Count  int64 `bson:"count"`
+		} `bson:"buckets"`
+		Groups []struct {
+			Key   string       `bson:"_id"`
+			Count int64        `bson:"count"`
+			Total money.Amount `bson:"total"`
+			Avg   money.Amount `bson:"avg"`
+		} `bson:"groups"`
+	}
+	if err := cursor.All(ctx, &result); err != nil {
+		return nil, err
+	}
+
+	// $facet always yields exactly one document; an empty summary means
+	// there are no players.
+	facet := result[0]
+	stats := &models.PlayerStats{Currency: query.Currency, Buckets: models.NewBalanceBuckets(query.Bounds)}
+	if len(facet.Summary) > 0 {
+		s := facet.Summary[0]
+		stats.Count, stats.TotalBalance, stats.AverageBalance = s.Count, s.Total, s.Avg.Round(2)
+		stats.MinBalance, stats.MaxBalance = s.Min, s.Max
+	}
+	for _, b := range facet.Buckets {
+		stats.Buckets[b.Bucket].Count = b.Count
+	}
+	if grouped {
+		stats.Groups = make([]models.PlayerStatsGroup, len(facet.Groups))
+		for i, g := range facet.Groups {
+			stats.Groups[i] = models.PlayerStatsGroup{Key: g.Key, Count: g.Count, TotalBalance: g.Total, AverageBalance: g.Avg.Round(2)}
+		}
+	}
+	stats.GeneratedAt = time.Now().UTC()
+	return stats, nil
+}
+
+func (r *MongoPlayerRepository) Leaderboard(currency string, offset, limit int) ([]models.RankedPlayer, int64, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	// Served by the balance.currency_1_balance.amount_-1__id_1 index, like
+	// the counts below.
+	filter := bson.M{"balance.currency": currency}
+	cursor, err := r.collection.Find(ctx, filter, options.Find().
+		SetSort(bson.D{{Key: "balance.amount", Value: -1}, {Key: "_id", Value: 1}}).
+		SetSkip(int64(offset)).
+		SetLimit(int64(limit)))
+	if err != nil {
+		return nil, 0, err
+	}
+	defer cursor.Close(ctx)
+	entries := []models.RankedPlayer{}
+	for cursor.Next(ctx) {
+		var e models.RankedPlayer
+		if err := cursor.Decode(&e.Player); err != nil {
+			return nil, 0, err
+		}
+		if oid, ok := cursor.Current.Lookup("_id").ObjectIDOK(); ok {
+			e.ID = oid.Hex()
+		}
+		entries = append(entries, e)
+	}
+	if err := cursor.Err(); err != nil {
+		return nil, 0, err
+	}
+	if len(entries) > 0 {
+		// Only the first entry can tie with players on earlier pages; every
+		// later one either ties with its predecessor or is preceded only by
+		// higher balances.
+		above, err := r.collection.CountDocuments(ctx, bson.M{
+			"balance.currency": currency,
+			"balance.amount":   bson.M{"$gt": entries[0].Balance.Amount},
+		})
+		if err != nil {
+			return nil, 0, err
+		}
+		entries[0].Rank = above + 1
+		for i := 1; i < len(entries); i++ {
+			if entries[i].Balance.Amount.Equal(entries[i-1].Balance.Amount) {
+				entries[i].Rank = entries[i-1].Rank
+			} else {
+				entries[i].Rank = int64(offset + i + 1)
+			}
+		}
+	}
+	total, err := r.collection.CountDocuments(ctx, filter)
+	if err != nil {
+		return nil, 0, err
+	}
+	return entries, total, nil
+}
+
+func (r *MongoPlayerRepository) Rank(id string) (*models.PlayerRank, error) {
+	player, err := r.GetPlayer(id)
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	filter := bson.M{"balance.currency": player.Balance.Currency}
+	above, err := r.collection.CountDocuments(ctx, bson.M{
+		"balance.currency": player.Balance.Currency,
+		"balance.amount":   bson.M{"$gt": player.Balance.Amount},
+	})
+	if err != nil {
+		return nil, err
+	}
+	total, err := r.collection.CountDocuments(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	return &models.PlayerRank{Rank: above + 1, Total: total, Player: *player}, nil
+}
+
+// errBulkRetry aborts a best-effort bulk transaction in which writes failed.
+var errBulkRetry = errors.New("bulk write failed, retrying without the failed items")
+
+// bestEffortBulkWrite applies every op it can, with their events, in one
+// transaction. A write error aborts a MongoDB transaction, so after one the
+// transaction is retried without the items that failed until it commits.
+func (r *MongoPlayerRepository) bestEffortBulkWrite(ctx context.Context, ops []models.BulkOperation, ids []primitive.ObjectID, results []BulkItemResult) ([]BulkItemResult, error) {
+	for {
+		var attempt []BulkItemResult
+		err := r.withTransaction(ctx, func(sc mongo.SessionContext) error {
+			attempt = append([]BulkItemResult(nil), results...)
+			if err := r.bulkWrite(sc, ops, ids, attempt, false); err != nil {
+				return err
+			}
+			for i, res := range attempt {
+				if res.Err != nil && results[i].Err == nil {
+					if _, ok := res.Err.(mongo.BulkWriteError); ok {
+						return errBulkRetry
+					}
+				}
+			}
+			if err := r.deleteBulkDependents(sc, ops, ids, attempt); err != nil {
+				return err
+			}
+			if err := r.recordBulkOpeningBalances(sc, ops, ids, attempt); err != nil {
+				return err
+			}
+			return insertMongoOutbox(sc, r.outbox, bulkEvents(ops, attempt)...)
+		})
+		if errors.Is(err, errBulkRetry) {
+			// Every retry has at least one more failed item, so this ends.
+			results = attempt
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		return attempt, nil
+	}
+}
+
+// deleteBulkDependents deletes the wallets, limits and ledger entries of the
+// players that ops deleted.
+func (r *MongoPlayerRepository) deleteBulkDependents(ctx context.Context, ops []models.BulkOperation, ids []primitive.ObjectID, results []BulkItemResult) error {
+	var deleted []primitive.ObjectID
+	for i, op := range ops {
+		if op.Op == models.BulkDelete && results[i].Err == nil {
+			deleted = append(deleted, ids[i])
+		}
+	}
+	if len(deleted) == 0 {
+		return nil
+	}
+	for _, col := range r.dependents() {
+		if _, err := col.DeleteMany(ctx, bson.M{"player_id": bson.M{"$in": deleted}}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// recordBulkOpeningBalances records the non-zero balances of the players
+// that ops created in the ledger, like other deposits.
+func (r *MongoPlayerRepository) recordBulkOpeningBalances(ctx context.Context, ops []models.BulkOperation, ids []primitive.ObjectID, results []BulkItemResult) error {
+	now := time.Now().UTC()
+	var docs []interface{}
+	for i, op := range ops {
+		if op.Op == models.BulkCreate && results[i].Err == nil && !op.Player.Balance.Amount.IsZero() {
+			docs = append(docs, balanceChangeDocument{PlayerID: ids[i], Amount: op.Player.Balance, CreatedAt: now})
+		}
+	}
+	if len(docs) == 0 {
+		return nil
+	}
+	_, err := r.ledger.InsertMany(ctx, docs)
+	return err
+}
+
+// bulkWrite sends ops that have no error yet as a single BulkWrite and
+// records per-item outcomes in results. ids holds the target of each op,
+// freshly generated for creates so they are known without a round trip.
+func (r *MongoPlayerRepository) bulkWrite(ctx context.Context, ops []models.BulkOperation, ids []primitive.ObjectID, results []BulkItemResult, ordered bool) error {
+	// BulkWrite only reports aggregate counts, so look up the targeted
+	// players first to report missing ones per item.
+	var targets []primitive.ObjectID
+	for i, op := range ops {
+		if results[i].Err == nil && op.Op != models.BulkCreate {
+			targets = append(targets, ids[i])
+		}
+	}
+	// existing maps the targeted players to their balance and status,
+	// which updates leave alone.
+	existing := make(map[primitive.ObjectID]models.Player)
+	if len(targets) > 0 {
+		cursor, err := r.collection.Find(ctx,
+			bson.M{"_id": bson.M{"$in": targets}},
+			options.Find().SetProjection(bson.M{"_id": 1, "balance": 1, "status": 1}),
+		)
+		if err != nil {
+			return err
+		}
+		var docs []struct {
+			ID      primitive.ObjectID `bson:"_id"`
+			Balance money.Money        `bson:"balance"`
+			Status  string             `bson:"status"`
+		}
+		if err := cursor.All(ctx, &docs); err != nil {
+			return err
+		}
+		for _, d := range docs {
+			existing[d.ID] = models.Player{Balance: d.Balance, Status: d.Status}
+		}
+	}
+
+	var writes []mongo.WriteModel
+	var index []int // position in writes -> position in ops
+	for i, op := range ops {
+		if results[i].Err != nil {
+			continue
+		}
+		current, found := existing[ids[i]]
+		if op.Op != models.BulkCreate && !found {
+			results[i].Err = ErrPlayerNotFound
+			continue
+		}
+		switch op.Op {
+		case models.BulkCreate:
+			writes = append(writes, mongo.NewInsertOneModel().SetDocument(bson.M{
+				"_id":     ids[i],
+				"name":    op.Player.Name,
+				"surname": op.Player.Surname,
+				"balance": op.Player.Balance,
+				"status":  models.InitialStatus(op.Player.Status),
+			}))
+			current = models.Player{Balance: op.Player.Balance, Status: models.InitialStatus(op.Player.Status)}
+		case models.BulkUpdate:
+			writes = append(writes, mongo.NewUpdateOneModel().
+				SetFilter(bson.M{"_id": ids[i]}).
+				SetUpdate(bson.M{"$set": bson.M{
+					"name":    op.Player.Name,
+					"surname": op.Player.Surname,
+				}}))
+		case models.BulkDelete:
+			writes = append(writes, mongo.NewDeleteOneModel().SetFilter(bson.M{"_id": ids[i]}))
+		}
+		index = append(index, i)
+		if op.Player != nil && op.Op != models.BulkDelete {
+			player := *op.Player
+			player.ID, player.Balance, player.Status = ids[i].Hex(), current.Balance, current.Status
+			results[i].Player = &player
+		}
+	}
+	if len(writes) == 0 || (ordered && bulkFailed(results)) {
+		return nil
+	}
+	_, err := r.collection.BulkWrite(ctx, writes, options.BulkWrite().SetOrdered(ordered))
+	var bwe mongo.BulkWriteException
+	if errors.As(err, &bwe) && bwe.WriteConcernError == nil {
+		for _, we := range bwe.WriteErrors {
+			results[index[we.Index]] = BulkItemResult{Err: we}
+		}
+		return nil
+	}
 	return err
 }
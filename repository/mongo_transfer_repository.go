@@ -0,0 +1,204 @@
+package repository
+
+import (
+	"context"
+	"contoso/models"
+	"contoso/money"
+	"errors"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// MongoTransferRepository moves money between player documents in a
+// transaction and records the legs in the transfer_legs collection. Like
+// balance changes, transfers count towards the sender's loss and the
+// recipient's deposit limits and are recorded in balance_changes.
+type MongoTransferRepository struct {
+	players *mongo.Collection
+	legs    *mongo.Collection
+	limits  *mongo.Collection
+	ledger  *mongo.Collection
+	outbox  *mongo.Collection
+}
+
+func NewMongoTransferRepository(players, legs, limits, ledger, outbox *mongo.Collection) *MongoTransferRepository {
+	return &MongoTransferRepository{players: players, legs: legs, limits: limits, ledger: ledger, outbox: outbox}
+}
+
+// transferLegDocument is a transfer leg as stored in transfer_legs.
+type transferLegDocument struct {
+	TransferID     string             `bson:"transfer_id"`
+	PlayerID       primitive.ObjectID `bson:"player_id"`
+	CounterpartyID primitive.ObjectID `bson:"counterparty_id"`
+	Amount         money.Money        `bson:"amount"`
+	CreatedAt      time.Time          `bson:"created_at"`
+}
+
+func (r *MongoTransferRepository) Transfer(id, from, to string, amount money.Money) (*models.Transfer, bool, error) {
+	fromID, err := primitive.ObjectIDFromHex(from)
+	if err != nil {
+		return nil, false, ErrInvalidID
+	}
+	toID, err := primitive.ObjectIDFromHex(to)
+	if err != nil {
+		return nil, false, ErrInvalidID
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	var t models.Transfer
+	var created bool
+	err = withMongoTransaction(ctx, r.players.Database().Client(), func(sc mongo.SessionContext) error {
+		t = models.Transfer{
+			ID:        id,
+			Amount:    amount,
+			CreatedAt: time.Now().UTC().Truncate(time.Millisecond),
+		}
+		created = false
+		// A repeated request finds the transfer it repeats. One running at
+		// the same time conflicts with this transaction, which is retried.
+		sent, err := r.sentLeg(sc, id)
+		if err != nil {
+			return err
+		}
+		if sent != nil {
+			players, err := r.current(sc, fromID, toID)
+			if err != nil {
+				return err
+			}
+			return sent.replay(&t, from, to, amount, players)
+		}
+		debit := money.Money{Amount: amount.Amount.Neg(), Currency: amount.Currency}
+		if err := checkMongoLimits(sc, r.limits, r.ledger, fromID, debit, t.CreatedAt); err != nil {
+			return err
+		}
+		if err := checkMongoLimits(sc, r.limits, r.ledger, toID, amount, t.CreatedAt); err != nil {
+			return err
+		}
+		if err := r.add(sc, fromID, debit, &t.From); err != nil {
+			return err
+		}
+		if err := r.add(sc, toID, amount, &t.To); err != nil {
+			return err
+		}
+		_, err = r.legs.InsertMany(sc, []interface{}{
+			transferLegDocument{TransferID: t.ID, PlayerID: fromID, CounterpartyID: toID, Amount: debit, CreatedAt: t.CreatedAt},
+			transferLegDocument{TransferID: t.ID, PlayerID: toID, CounterpartyID: fromID, Amount: amount, CreatedAt: t.CreatedAt},
+		})
+		if err != nil {
+			return err
+		}
+		_, err = r.ledger.InsertMany(sc, []interface{}{
+			balanceChangeDocument{PlayerID: fromID, Amount: debit, CreatedAt: t.CreatedAt},
+			balanceChangeDocument{PlayerID: toID, Amount: amount, CreatedAt: t.CreatedAt},
+		})
+		if err != nil {
+			return err
+		}
+		created = true
+		return insertMongoOutbox(sc, r.outbox, transferred(&t)...)
+	})
+	if mongo.IsDuplicateKeyError(err) {
+		// Committed meanwhile by a transfer between other players, as one
+		// between the same players would have conflicted instead.
+		return nil, false, ErrTransferIDReused
+	}
+	if err != nil {
+		return nil, false, err
+	}
+	return &t, created, nil
+}
+
+// sentLeg returns the sender's leg of the transfer with id, or nil if there
+// is none.
+func (r *MongoTransferRepository) sentLeg(ctx context.Context, id string) (*sentLeg, error) {
+	var doc transferLegDocument
+	err := r.legs.FindOne(ctx, bson.M{"transfer_id": id, "amount.amount": bson.M{"$lt": 0}}).Decode(&doc)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &sentLeg{from: doc.PlayerID.Hex(), to: doc.CounterpartyID.Hex(), amount: doc.Amount, createdAt: doc.CreatedAt.UTC()}, nil
+}
+
+// current returns the players with ids that still exist, by ID.
+func (r *MongoTransferRepository) current(ctx context.Context, ids ...primitive.ObjectID) (map[string]models.Player, error) {
+	cursor, err := r.players.Find(ctx, bson.M{"_id": bson.M{"$in": ids}})
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+	players := make(map[string]models.Player, len(ids))
+	for cursor.Next(ctx) {
+		var p models.Player
+		if err := cursor.Decode(&p); err != nil {
+			return nil, err
+		}
+		if oid, ok := cursor.Current.Lookup("_id").ObjectIDOK(); ok {
+			p.ID = oid.Hex()
+		}
+		players[p.ID] = p
+	}
+	return players, cursor.Err()
+}
+
+// add adds amount to the balance of player id without letting it go below
+// zero and decodes the player afterwards into player.
+func (r *MongoTransferRepository) add(ctx context.Context, id primitive.ObjectID, amount money.Money, player *models.Player) error {
+	err := r.players.FindOneAndUpdate(ctx,
+		bson.M{
+			"_id":              id,
+			"balance.currency": amount.Currency,
+			"balance.amount":   bson.M{"$gte": amount.Amount.Neg()},
+			"status":           bson.M{"$nin": models.BalanceLockedStatuses},
+		},
+		bson.M{"$inc": bson.M{"balance.amount": amount.Amount}},
+		options.FindOneAndUpdate().SetReturnDocument(options.After),
+	).Decode(player)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return balanceRejection(ctx, r.players, id, amount.Currency, ErrInsufficientFunds)
+	}
+	if err != nil {
+		return err
+	}
+	player.ID = id.Hex()
+	return nil
+}
+
+func (r *MongoTransferRepository) ListTransfers(playerID string, offset, limit int) ([]models.TransferLeg, error) {
+	objID, err := primitive.ObjectIDFromHex(playerID)
+	if err != nil {
+		return nil, ErrInvalidID
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	cursor, err := r.legs.Find(ctx,
+		bson.M{"player_id": objID},
+		options.Find().
+			SetSort(bson.D{{Key: "created_at", Value: -1}, {Key: "transfer_id", Value: -1}}).
+			SetSkip(int64(offset)).
+			SetLimit(int64(limit)),
+	)
+	if err != nil {
+		return nil, err
+	}
+	var docs []transferLegDocument
+	if err := cursor.All(ctx, &docs); err != nil {
+		return nil, err
+	}
+	legs := make([]models.TransferLeg, len(docs))
+	for i, d := range docs {
+		legs[i] = models.TransferLeg{
+			TransferID:     d.TransferID,
+			CounterpartyID: d.CounterpartyID.Hex(),
+			Amount:         d.Amount,
+			CreatedAt:      d.CreatedAt,
+		}
+	}
+	return legs, nil
+}
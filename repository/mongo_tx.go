@@ -0,0 +1,30 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// ErrTransactionsUnsupported is returned when MongoDB runs standalone;
+// multi-document transactions need a replica set or sharded cluster.
+var ErrTransactionsUnsupported = errors.New("mongodb transactions require a replica set")
+
+// withMongoTransaction runs fn in a transaction, retrying transient errors
+// as the driver recommends.
+func withMongoTransaction(ctx context.Context, client *mongo.Client, fn func(sc mongo.SessionContext) error) error {
+	session, err := client.StartSession()
+	if err != nil {
+		return err
+	}
+	defer session.EndSession(ctx)
+	_, err = session.WithTransaction(ctx, func(sc mongo.SessionContext) (interface{}, error) {
+		return nil, fn(sc)
+	})
+	var cmdErr mongo.CommandError
+	if errors.As(err, &cmdErr) && cmdErr.Code == 20 { // IllegalOperation
+		return ErrTransactionsUnsupported
+	}
+	return err
+}
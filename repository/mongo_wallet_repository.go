@@ -0,0 +1,194 @@
+package repository
+
+import (
+	"context"
+	"contoso/models"
+	"contoso/money"
+	"errors"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// MongoWalletRepository stores wallets in their own collection, one
+// document per player and currency; the primary wallet is the balance of
+// the player document.
+type MongoWalletRepository struct {
+	players *mongo.Collection
+	wallets *mongo.Collection
+	outbox  *mongo.Collection
+}
+
+func NewMongoWalletRepository(players, wallets, outbox *mongo.Collection) *MongoWalletRepository {
+	return &MongoWalletRepository{players: players, wallets: wallets, outbox: outbox}
+}
+
+// walletDocument is a wallet as stored in the wallets collection.
+type walletDocument struct {
+	PlayerID primitive.ObjectID `bson:"player_id"`
+	Balance  money.Money        `bson:"balance"`
+	OpenedAt time.Time          `bson:"opened_at"`
+}
+
+func (d walletDocument) wallet() models.Wallet {
+	openedAt := d.OpenedAt
+	return models.Wallet{Balance: d.Balance, OpenedAt: &openedAt}
+}
+
+func (r *MongoWalletRepository) OpenWallet(playerID, currency string) (*models.Wallet, error) {
+	objID, err := primitive.ObjectIDFromHex(playerID)
+	if err != nil {
+		return nil, ErrInvalidID
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	var doc walletDocument
+	err = withMongoTransaction(ctx, r.players.Database().Client(), func(sc mongo.SessionContext) error {
+		player, err := r.player(sc, objID)
+		if err != nil {
+			return err
+		}
+		if player.Balance.Currency == currency {
+			return ErrWalletExists
+		}
+		doc = walletDocument{
+			PlayerID: objID,
+			Balance:  money.Money{Currency: currency},
+			OpenedAt: time.Now().UTC().Truncate(time.Millisecond),
+		}
+		if _, err := r.wallets.InsertOne(sc, doc); err != nil {
+			if mongo.IsDuplicateKeyError(err) {
+				return ErrWalletExists
+			}
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	w := doc.wallet()
+	return &w, nil
+}
+
+func (r *MongoWalletRepository) ListWallets(playerID string) ([]models.Wallet, error) {
+	objID, err := primitive.ObjectIDFromHex(playerID)
+	if err != nil {
+		return nil, ErrInvalidID
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	player, err := r.player(ctx, objID)
+	if err != nil {
+		return nil, err
+	}
+	cursor, err := r.wallets.Find(ctx,
+		bson.M{"player_id": objID},
+		options.Find().SetSort(bson.M{"balance.currency": 1}),
+	)
+	if err != nil {
+		return nil, err
+	}
+	var docs []walletDocument
+	if err := cursor.All(ctx, &docs); err != nil {
+		return nil, err
+	}
+	wallets := []models.Wallet{{Balance: player.Balance, Primary: true}}
+	for _, d := range docs {
+		wallets = append(wallets, d.wallet())
+	}
+	return wallets, nil
+}
+
+func (r *MongoWalletRepository) Convert(playerID string, debit, credit money.Money) ([]models.Wallet, error) {
+	objID, err := primitive.ObjectIDFromHex(playerID)
+	if err != nil {
+		return nil, ErrInvalidID
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	var wallets []models.Wallet
+	err = withMongoTransaction(ctx, r.players.Database().Client(), func(sc mongo.SessionContext) error {
+		player, err := r.player(sc, objID)
+		if err != nil {
+			return err
+		}
+		if models.BalanceLocked(player.Status) {
+			return ErrBalanceLocked
+		}
+		from, err := r.add(sc, player, money.Money{Amount: debit.Amount.Neg(), Currency: debit.Currency})
+		if err != nil {
+			return err
+		}
+		to, err := r.add(sc, player, credit)
+		if err != nil {
+			return err
+		}
+		wallets = []models.Wallet{*from, *to}
+		return insertMongoOutbox(sc, r.outbox, currencyConverted(player, debit, credit))
+	})
+	if err != nil {
+		return nil, err
+	}
+	return wallets, nil
+}
+
+// player reads the player holding the wallets.
+func (r *MongoWalletRepository) player(ctx context.Context, objID primitive.ObjectID) (*models.Player, error) {
+	var player models.Player
+	if err := r.players.FindOne(ctx, bson.M{"_id": objID}).Decode(&player); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, ErrPlayerNotFound
+		}
+		return nil, err
+	}
+	player.ID = objID.Hex()
+	return &player, nil
+}
+
+// add adds amount to player's wallet in its currency, which is the player's
+// balance for the primary wallet, without letting it go below zero.
+func (r *MongoWalletRepository) add(ctx context.Context, player *models.Player, amount money.Money) (*models.Wallet, error) {
+	objID, _ := primitive.ObjectIDFromHex(player.ID)
+	update := bson.M{"$inc": bson.M{"balance.amount": amount.Amount}}
+	after := options.FindOneAndUpdate().SetReturnDocument(options.After)
+	if amount.Currency == player.Balance.Currency {
+		var updated models.Player
+		err := r.players.FindOneAndUpdate(ctx,
+			bson.M{"_id": objID, "balance.amount": bson.M{"$gte": amount.Amount.Neg()}},
+			update, after,
+		).Decode(&updated)
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, ErrInsufficientFunds
+		}
+		if err != nil {
+			return nil, err
+		}
+		player.Balance = updated.Balance
+		return &models.Wallet{Balance: updated.Balance, Primary: true}, nil
+	}
+	filter := bson.M{"player_id": objID, "balance.currency": amount.Currency}
+	var doc walletDocument
+	err := r.wallets.FindOneAndUpdate(ctx,
+		bson.M{"player_id": objID, "balance.currency": amount.Currency, "balance.amount": bson.M{"$gte": amount.Amount.Neg()}},
+		update, after,
+	).Decode(&doc)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		n, err := r.wallets.CountDocuments(ctx, filter)
+		if err != nil {
+			return nil, err
+		}
+		if n > 0 {
+			return nil, ErrInsufficientFunds
+		}
+		return nil, ErrWalletNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	w := doc.wallet()
+	return &w, nil
+}
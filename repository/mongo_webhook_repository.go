@@ -0,0 +1,308 @@
+package repository
+
+import (
+	"context"
+	"contoso/models"
+	"errors"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type MongoWebhookRepository struct {
+	webhooks   *mongo.Collection
+	deliveries *mongo.Collection
+}
+
+func NewMongoWebhookRepository(webhooks, deliveries *mongo.Collection) *MongoWebhookRepository {
+	return &MongoWebhookRepository{webhooks: webhooks, deliveries: deliveries}
+}
+
+func (r *MongoWebhookRepository) CreateWebhook(webhook *models.Webhook) (*models.Webhook, error) {
+	webhook.ID = ""
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	res, err := r.webhooks.InsertOne(ctx, webhook)
+	if err != nil {
+		return nil, err
+	}
+	webhook.ID = res.InsertedID.(primitive.ObjectID).Hex()
+	return webhook, nil
+}
+
+func (r *MongoWebhookRepository) GetWebhook(id string) (*models.Webhook, error) {
+	objID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, ErrInvalidID
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	var raw bson.Raw
+	if err := r.webhooks.FindOne(ctx, bson.M{"_id": objID}).Decode(&raw); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, ErrWebhookNotFound
+		}
+		return nil, err
+	}
+	return decodeMongoWebhook(raw)
+}
+
+func (r *MongoWebhookRepository) ListWebhooks() ([]models.Webhook, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	cursor, err := r.webhooks.Find(ctx, bson.M{}, options.Find().SetSort(bson.M{"_id": 1}))
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+	webhooks := []models.Webhook{}
+	for cursor.Next(ctx) {
+		webhook, err := decodeMongoWebhook(cursor.Current)
+		if err != nil {
+			return nil, err
+		}
+		webhooks = append(webhooks, *webhook)
+	}
+	return webhooks, cursor.Err()
+}
+
+func (r *MongoWebhookRepository) UpdateWebhook(id string, webhook *models.Webhook) (*models.Webhook, error) {
+	objID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, ErrInvalidID
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	var raw bson.Raw
+	err = r.webhooks.FindOneAndUpdate(ctx,
+		bson.M{"_id": objID},
+		bson.M{"$set": bson.M{
+			"url":         webhook.URL,
+			"events":      webhook.Events,
+			"description": webhook.Description,
+			"active":      webhook.Active,
+		}},
+		options.FindOneAndUpdate().SetReturnDocument(options.After),
+	).Decode(&raw)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, ErrWebhookNotFound
+		}
+		return nil, err
+	}
+	return decodeMongoWebhook(raw)
+}
+
+func (r *MongoWebhookRepository) DeleteWebhook(id string) error {
+	objID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return ErrInvalidID
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	res, err := r.webhooks.DeleteOne(ctx, bson.M{"_id": objID})
+	if err != nil {
+		return err
+	}
+	if res.DeletedCount == 0 {
+		return ErrWebhookNotFound
+	}
+	_, err = r.deliveries.DeleteMany(ctx, bson.M{"webhook_id": id})
+	return err
+}
+
+func (r *MongoWebhookRepository) EnqueueDeliveries(deliveries []models.WebhookDelivery) error {
+	if len(deliveries) == 0 {
+		return nil
+	}
+	docs := make([]interface{}, len(deliveries))
+	for i := range deliveries {
+		d := deliveries[i]
+		d.ID = ""
+		// $push needs an array to append attempts to.
+		if d.Attempts == nil {
+			d.Attempts = []models.WebhookAttempt{}
+		}
+		docs[i] = d
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	_, err := r.deliveries.InsertMany(ctx, docs, options.InsertMany().SetOrdered(false))
+	var bwe mongo.BulkWriteException
+	if errors.As(err, &bwe) && bwe.WriteConcernError == nil {
+		for _, we := range bwe.WriteErrors {
+			if we.Code != 11000 { // duplicate key
+				return err
+			}
+		}
+		return nil
+	}
+	return err
+}
+
+func (r *MongoWebhookRepository) ClaimDeliveries(limit int, lease time.Duration) ([]models.WebhookDelivery, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	now := time.Now()
+	// One document at a time so each claim is atomic on its own.
+	var claimed []models.WebhookDelivery
+	for len(claimed) < limit {
+		var raw bson.Raw
+		err := r.deliveries.FindOneAndUpdate(ctx,
+			bson.M{"status": models.DeliveryPending, "next_attempt_at": bson.M{"$lte": now}},
+			bson.M{"$set": bson.M{"next_attempt_at": now.Add(lease)}},
+			options.FindOneAndUpdate().
+				SetSort(bson.M{"next_attempt_at": 1}).
+				SetReturnDocument(options.After),
+		).Decode(&raw)
+		if err == mongo.ErrNoDocuments {
+			break
+		}
+		if err != nil {
+			return claimed, err
+		}
+		d, err := decodeMongoDelivery(raw)
+		if err != nil {
+			return claimed, err
+		}
+		claimed = append(claimed, *d)
+	}
+	return claimed, nil
+}
+
+func (r *MongoWebhookRepository) RecordAttempt(id string, attempt models.WebhookAttempt, status string, next *time.Time) error {
+	objID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return ErrInvalidID
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	update := bson.M{
+		"$push": bson.M{"attempts": attempt},
+		"$set":  bson.M{"status": status, "updated_at": time.Now()},
+	}
+	if next != nil {
+		update["$set"].(bson.M)["next_attempt_at"] = *next
+	} else {
+		update["$unset"] = bson.M{"next_attempt_at": ""}
+	}
+	res, err := r.deliveries.UpdateOne(ctx, bson.M{"_id": objID}, update)
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return ErrDeliveryNotFound
+	}
+	return nil
+}
+
+func (r *MongoWebhookRepository) GetDelivery(id string) (*models.WebhookDelivery, error) {
+	objID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, ErrInvalidID
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	var raw bson.Raw
+	if err := r.deliveries.FindOne(ctx, bson.M{"_id": objID}).Decode(&raw); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, ErrDeliveryNotFound
+		}
+		return nil, err
+	}
+	return decodeMongoDelivery(raw)
+}
+
+func (r *MongoWebhookRepository) ListDeliveries(webhookID, status string, limit int) ([]models.WebhookDelivery, error) {
+	filter := bson.M{}
+	if webhookID != "" {
+		if _, err := primitive.ObjectIDFromHex(webhookID); err != nil {
+			return nil, ErrInvalidID
+		}
+		filter["webhook_id"] = webhookID
+	}
+	if status != "" {
+		filter["status"] = status
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	cursor, err := r.deliveries.Find(ctx, filter,
+		options.Find().SetSort(bson.M{"_id": -1}).SetLimit(int64(limit)))
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+	deliveries := []models.WebhookDelivery{}
+	for cursor.Next(ctx) {
+		d, err := decodeMongoDelivery(cursor.Current)
+		if err != nil {
+			return nil, err
+		}
+		deliveries = append(deliveries, *d)
+	}
+	return deliveries, cursor.Err()
+}
+
+func (r *MongoWebhookRepository) Redeliver(id string) (*models.WebhookDelivery, error) {
+	objID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, ErrInvalidID
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	now := time.Now()
+	var raw bson.Raw
+	err = r.deliveries.FindOneAndUpdate(ctx,
+		bson.M{"_id": objID},
+		bson.M{"$set": bson.M{"status": models.DeliveryPending, "next_attempt_at": now, "updated_at": now}},
+		options.FindOneAndUpdate().SetReturnDocument(options.After),
+	).Decode(&raw)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, ErrDeliveryNotFound
+		}
+		return nil, err
+	}
+	return decodeMongoDelivery(raw)
+}
+
+func (r *MongoWebhookRepository) DeleteSucceededBefore(t time.Time) (int64, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	res, err := r.deliveries.DeleteMany(ctx, bson.M{
+		"status":     models.DeliverySucceeded,
+		"updated_at": bson.M{"$lt": t},
+	})
+	if err != nil {
+		return 0, err
+	}
+	return res.DeletedCount, nil
+}
+
+func decodeMongoWebhook(raw bson.Raw) (*models.Webhook, error) {
+	var webhook models.Webhook
+	if err := bson.Unmarshal(raw, &webhook); err != nil {
+		return nil, err
+	}
+	if oid, ok := raw.Lookup("_id").ObjectIDOK(); ok {
+		webhook.ID = oid.Hex()
+	}
+	return &webhook, nil
+}
+
+func decodeMongoDelivery(raw bson.Raw) (*models.WebhookDelivery, error) {
+	var d models.WebhookDelivery
+	if err := bson.Unmarshal(raw, &d); err != nil {
+		return nil, err
+	}
+	if oid, ok := raw.Lookup("_id").ObjectIDOK(); ok {
+		d.ID = oid.Hex()
+	}
+	if d.Attempts == nil {
+		d.Attempts = []models.WebhookAttempt{}
+	}
+	return &d, nil
+}
@@ -0,0 +1,58 @@
+package repository
+
+import (
+	"contoso/events"
+	"contoso/models"
+	"contoso/money"
+)
+
+// balanceChanged is the event for adding amount to player's balance.
+func balanceChanged(player *models.Player, amount money.Money) events.Event {
+	e := events.New(events.PlayerBalanceChanged, player.ID, player)
+	e.Amount = &amount
+	return e
+}
+
+// statusChanged is the event for moving player from status previous to
+// their current status.
+func statusChanged(player *models.Player, previous, reason string) events.Event {
+	e := events.New(events.PlayerStatusChanged, player.ID, player)
+	e.PreviousStatus, e.Reason = previous, reason
+	return e
+}
+
+// currencyConverted is the event for converting debit into credit between
+// two of player's wallets.
+func currencyConverted(player *models.Player, debit, credit money.Money) events.Event {
+	e := events.New(events.PlayerCurrencyConverted, player.ID, player)
+	e.Amount, e.Converted = &debit, &credit
+	return e
+}
+
+// transferred returns the balance change events for both legs of transfer.
+func transferred(transfer *models.Transfer) []events.Event {
+	debit := transfer.Amount
+	debit.Amount = debit.Amount.Neg()
+	from, to := balanceChanged(&transfer.From, debit), balanceChanged(&transfer.To, transfer.Amount)
+	from.TransferID, to.TransferID = transfer.ID, transfer.ID
+	return []events.Event{from, to}
+}
+
+// bulkEvents returns the events for the successful items of a bulk write.
+func bulkEvents(ops []models.BulkOperation, results []BulkItemResult) []events.Event {
+	var evs []events.Event
+	for i, res := range results {
+		if res.Err != nil {
+			continue
+		}
+		switch ops[i].Op {
+		case models.BulkCreate:
+			evs = append(evs, events.New(events.PlayerCreated, res.Player.ID, res.Player))
+		case models.BulkUpdate:
+			evs = append(evs, events.New(events.PlayerUpdated, ops[i].ID, res.Player))
+		case models.BulkDelete:
+			evs = append(evs, events.New(events.PlayerDeleted, ops[i].ID, nil))
+		}
+	}
+	return evs
+}
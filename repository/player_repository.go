@@ -2,13 +2,99 @@ package repository
 
 import (
 	"contoso/models"
+	"contoso/money"
+	"errors"
+	"time"
+)
+
+// streamTimeout bounds StreamPlayers, which runs for as long as the
+// consumer takes to write out every player.
+const streamTimeout = 30 * time.Minute
+
+var (
+	// ErrPlayerNotFound is returned when no player has the requested ID.
+	ErrPlayerNotFound = errors.New("player not found")
+	// ErrInvalidID is returned for IDs that are malformed for the backend.
+	ErrInvalidID = errors.New("invalid id")
+	// ErrCurrencyMismatch is returned for balance changes in a currency
+	// other than the balance's.
+	ErrCurrencyMismatch = errors.New("amount is not in the currency of the balance")
+	// ErrBalanceLocked is returned for balance changes of players whose
+	// status does not allow them.
+	ErrBalanceLocked = errors.New("the player's status does not allow balance changes")
+	// ErrInvalidStatusTransition is returned for status changes the
+	// transition table does not allow.
+	ErrInvalidStatusTransition = errors.New("invalid status transition")
+	// ErrPlayerChanged is returned by PatchPlayer when the player no longer
+	// has the state the patch was applied to.
+	ErrPlayerChanged = errors.New("the player was changed by another request, fetch it and patch again")
 )
 
 // PlayerRepository abstracts player CRUD operations.
 type PlayerRepository interface {
 	CreatePlayer(player *models.Player) (*models.Player, error)
 	GetPlayers() ([]models.Player, error)
+	// StreamPlayers calls fn for each player in ID order without loading
+	// them all into memory, stopping at the first error fn returns.
+	StreamPlayers(fn func(player *models.Player) error) error
 	GetPlayer(id string) (*models.Player, error)
 	UpdatePlayer(id string, player *models.Player) (*models.Player, error)
 	DeletePlayer(id string) error
+	// PatchPlayer updates only the fields set in patch and returns the
+	// result. The write only happens while the player still has the state
+	// of expected, the player the patch was applied to; otherwise it
+	// returns ErrPlayerChanged.
+	PatchPlayer(id string, expected *models.Player, patch *models.PlayerPatch) (*models.Player, error)
+	// AdjustBalance atomically adds amount (negative to debit) to the
+	// balance, returning ErrCurrencyMismatch if the balance is in another
+	// currency, ErrBalanceLocked if the player's status forbids it and
+	// ErrInsufficientFunds if a debit would leave the balance below zero.
+	AdjustBalance(id string, amount money.Money) (*models.Player, error)
+	// SetStatus moves the player to change.Status, returning
+	// ErrInvalidStatusTransition if the current status may not change to it.
+	SetStatus(id string, change models.StatusChange) (*models.Player, error)
+	// BulkWrite applies ops and returns one result per op. With atomic set,
+	// either every op is applied or none is and ErrBulkAborted is returned.
+	// Ops are applied grouped by kind, so an ID may appear only once.
+	BulkWrite(ops []models.BulkOperation, atomic bool) ([]BulkItemResult, error)
+	// Stats aggregates the balances of the players in query.Currency.
+	Stats(query models.PlayerStatsQuery) (*models.PlayerStats, error)
+	// Leaderboard returns limit players with balances in currency, highest
+	// first and ties by ID, after skipping offset, with the number of such
+	// players.
+	Leaderboard(currency string, offset, limit int) ([]models.RankedPlayer, int64, error)
+	// Rank returns the leaderboard rank of the player with id among the
+	// players in its currency: one more than the number of them with a
+	// higher balance.
+	Rank(id string) (*models.PlayerRank, error)
+}
+
+// PlayerCopier writes players as they are, for copying them from another
+// database. Unlike PlayerRepository writes, copies keep their status, record
+// no events and no balance changes, and are not checked against limits.
+type PlayerCopier interface {
+	// NewPlayerID reserves an ID for a player that PutPlayer writes later.
+	NewPlayerID() (string, error)
+	// PutPlayer creates or replaces the player with player.ID.
+	PutPlayer(player *models.Player) error
+}
+
+// applyEmptyPatch is PatchPlayer for a patch that changes nothing: it
+// writes nothing, but still returns ErrPlayerChanged unless the player
+// has the state of expected.
+func applyEmptyPatch(repo PlayerRepository, id string, expected *models.Player) (*models.Player, error) {
+	p, err := repo.GetPlayer(id)
+	if err != nil {
+		return nil, err
+	}
+	if !unchanged(expected, p) {
+		return nil, ErrPlayerChanged
+	}
+	return p, nil
+}
+
+// unchanged reports whether p still has the state of expected.
+func unchanged(expected, p *models.Player) bool {
+	return p.Name == expected.Name && p.Surname == expected.Surname && p.Status == expected.Status &&
+		p.Balance.Currency == expected.Balance.Currency && p.Balance.Amount.Equal(expected.Balance.Amount)
 }
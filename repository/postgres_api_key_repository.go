@@ -0,0 +1,85 @@
+package repository
+
+import (
+	"contoso/models"
+	"database/sql"
+	"strconv"
+
+	"github.com/lib/pq"
+)
+
+type PostgresAPIKeyRepository struct {
+	db *sql.DB
+}
+
+func NewPostgresAPIKeyRepository(db *sql.DB) *PostgresAPIKeyRepository {
+	return &PostgresAPIKeyRepository{db: db}
+}
+
+func (r *PostgresAPIKeyRepository) CreateAPIKey(key *models.APIKey) (*models.APIKey, error) {
+	var id int
+	err := r.db.QueryRow(
+		"INSERT INTO api_keys (name, prefix, hash, roles, created_at) VALUES ($1, $2, $3, $4, $5) RETURNING id",
+		key.Name, key.Prefix, key.Hash, pq.Array(key.Roles), key.CreatedAt,
+	).Scan(&id)
+	if err != nil {
+		return nil, err
+	}
+	key.ID = strconv.Itoa(id)
+	return key, nil
+}
+
+func (r *PostgresAPIKeyRepository) GetAPIKeyByHash(hash string) (*models.APIKey, error) {
+	row := r.db.QueryRow("SELECT id, name, prefix, hash, roles, created_at, revoked_at FROM api_keys WHERE hash = $1", hash)
+	key, err := scanPostgresAPIKey(row)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, ErrAPIKeyNotFound
+		}
+		return nil, err
+	}
+	return key, nil
+}
+
+func (r *PostgresAPIKeyRepository) ListAPIKeys() ([]models.APIKey, error) {
+	rows, err := r.db.Query("SELECT id, name, prefix, hash, roles, created_at, revoked_at FROM api_keys ORDER BY id")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var keys []models.APIKey
+	for rows.Next() {
+		if key, err := scanPostgresAPIKey(rows); err == nil {
+			keys = append(keys, *key)
+		}
+	}
+	return keys, nil
+}
+
+func (r *PostgresAPIKeyRepository) RevokeAPIKey(id string) error {
+	if !validPostgresID(id) {
+		return ErrInvalidID
+	}
+	res, err := r.db.Exec("UPDATE api_keys SET revoked_at = now() WHERE id = $1 AND revoked_at IS NULL", id)
+	if err != nil {
+		return err
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return ErrAPIKeyNotFound
+	}
+	return nil
+}
+
+func scanPostgresAPIKey(row interface{ Scan(...any) error }) (*models.APIKey, error) {
+	var key models.APIKey
+	var id int
+	var revokedAt sql.NullTime
+	if err := row.Scan(&id, &key.Name, &key.Prefix, &key.Hash, pq.Array(&key.Roles), &key.CreatedAt, &revokedAt); err != nil {
+		return nil, err
+	}
+	key.ID = strconv.Itoa(id)
+	if revokedAt.Valid {
+		key.RevokedAt = &revokedAt.Time
+	}
+	return &key, nil
+}
@@ -0,0 +1,78 @@
+package repository
+
+import (
+	"contoso/models"
+	"database/sql"
+	"time"
+)
+
+type PostgresIdempotencyRepository struct {
+	db *sql.DB
+}
+
+func NewPostgresIdempotencyRepository(db *sql.DB) *PostgresIdempotencyRepository {
+	return &PostgresIdempotencyRepository{db: db}
+}
+
+func (r *PostgresIdempotencyRepository) Reserve(key, fingerprint string, ttl, staleAfter time.Duration) (*models.IdempotencyRecord, error) {
+	now := time.Now()
+	// Insert, or take over a record that expired or whose request died;
+	// RETURNING yields no row when an active record holds the key.
+	var reserved string
+	err := r.db.QueryRow(`
+		INSERT INTO idempotency_keys (key, fingerprint, created_at, heartbeat_at, expires_at)
+		VALUES ($1, $2, $3, $3, $4)
+		ON CONFLICT (key) DO UPDATE SET
+			fingerprint = EXCLUDED.fingerprint, status = 0, content_type = '', body = NULL,
+			created_at = EXCLUDED.created_at, heartbeat_at = EXCLUDED.heartbeat_at, expires_at = EXCLUDED.expires_at
+		WHERE idempotency_keys.expires_at < $3
+			OR (idempotency_keys.status = 0 AND idempotency_keys.heartbeat_at < $5)
+		RETURNING key`,
+		key, fingerprint, now, now.Add(ttl), now.Add(-staleAfter),
+	).Scan(&reserved)
+	if err == nil {
+		return nil, nil
+	}
+	if err != sql.ErrNoRows {
+		return nil, err
+	}
+	var record models.IdempotencyRecord
+	err = r.db.QueryRow(
+		"SELECT key, fingerprint, status, content_type, body, created_at, heartbeat_at, expires_at FROM idempotency_keys WHERE key = $1",
+		key,
+	).Scan(&record.Key, &record.Fingerprint, &record.Status, &record.ContentType, &record.Body,
+		&record.CreatedAt, &record.HeartbeatAt, &record.ExpiresAt)
+	if err != nil {
+		return nil, err
+	}
+	return &record, nil
+}
+
+func (r *PostgresIdempotencyRepository) Heartbeat(key string) error {
+	_, err := r.db.Exec(
+		"UPDATE idempotency_keys SET heartbeat_at = $2 WHERE key = $1 AND status = 0",
+		key, time.Now(),
+	)
+	return err
+}
+
+func (r *PostgresIdempotencyRepository) Complete(key string, status int, contentType string, body []byte) error {
+	_, err := r.db.Exec(
+		"UPDATE idempotency_keys SET status = $2, content_type = $3, body = $4 WHERE key = $1 AND status = 0",
+		key, status, contentType, body,
+	)
+	return err
+}
+
+func (r *PostgresIdempotencyRepository) Release(key string) error {
+	_, err := r.db.Exec("DELETE FROM idempotency_keys WHERE key = $1 AND status = 0", key)
+	return err
+}
+
+func (r *PostgresIdempotencyRepository) DeleteExpired() (int64, error) {
+	res, err := r.db.Exec("DELETE FROM idempotency_keys WHERE expires_at < now()")
+	if err != nil {
+		return 0, err
+	}
+	return res.RowsAffected()
+}
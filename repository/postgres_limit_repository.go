@@ -0,0 +1,188 @@
+package repository
+
+import (
+	"contoso/models"
+	"contoso/money"
+	"database/sql"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+// PostgresLimitRepository stores limits in player_limits. Usage is summed
+// from balance_changes, which creates, balance changes and transfers
+// write.
+type PostgresLimitRepository struct {
+	db         *sql.DB
+	coolingOff time.Duration
+}
+
+func NewPostgresLimitRepository(db *sql.DB, coolingOff time.Duration) *PostgresLimitRepository {
+	return &PostgresLimitRepository{db: db, coolingOff: coolingOff}
+}
+
+// postgresQuerier is satisfied by *sql.DB and *sql.Tx.
+type postgresQuerier interface {
+	Query(query string, args ...interface{}) (*sql.Rows, error)
+	QueryRow(query string, args ...interface{}) *sql.Row
+}
+
+// postgresScanner is satisfied by *sql.Row and *sql.Rows.
+type postgresScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+const postgresLimitColumns = "kind, period, amount, currency, pending_amount, pending_at"
+
+func (r *PostgresLimitRepository) ListLimits(playerID string) ([]models.Limit, error) {
+	if !validPostgresID(playerID) {
+		return nil, ErrInvalidID
+	}
+	var exists bool
+	if err := r.db.QueryRow("SELECT EXISTS (SELECT 1 FROM players WHERE id = $1)", playerID).Scan(&exists); err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, ErrPlayerNotFound
+	}
+	stored, err := postgresLimits(r.db, playerID, models.LimitDeposit, models.LimitLoss)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now().UTC()
+	limits := []models.Limit{}
+	for _, l := range stored {
+		if !l.Settle(now) {
+			continue
+		}
+		used, err := postgresLimitUsage(r.db, playerID, l.Kind, l.Amount.Currency, models.PeriodStart(l.Period, now))
+		if err != nil {
+			return nil, err
+		}
+		resetsAt := models.PeriodEnd(l.Period, now)
+		l.Used, l.ResetsAt = &money.Money{Amount: used, Currency: l.Amount.Currency}, &resetsAt
+		limits = append(limits, l)
+	}
+	return limits, nil
+}
+
+func (r *PostgresLimitRepository) SetLimit(playerID, kind, period string, amount money.Money) (*models.Limit, error) {
+	return r.change(playerID, kind, period, &amount)
+}
+
+func (r *PostgresLimitRepository) RemoveLimit(playerID, kind, period string) (*models.Limit, error) {
+	return r.change(playerID, kind, period, nil)
+}
+
+// change sets the limit to amount, or removes it if amount is nil.
+func (r *PostgresLimitRepository) change(playerID, kind, period string, amount *money.Money) (*models.Limit, error) {
+	if !validPostgresID(playerID) {
+		return nil, ErrInvalidID
+	}
+	var l *models.Limit
+	err := withPostgresTransaction(r.db, func(tx *sql.Tx) error {
+		// Locking the player orders the change after running balance
+		// changes, which check the limits under the same lock.
+		var currency string
+		err := tx.QueryRow("SELECT currency FROM players WHERE id = $1 FOR UPDATE", playerID).Scan(&currency)
+		if err == sql.ErrNoRows {
+			return ErrPlayerNotFound
+		}
+		if err != nil {
+			return err
+		}
+		if amount != nil && amount.Currency != currency {
+			return ErrCurrencyMismatch
+		}
+		existing, err := scanPostgresLimit(tx.QueryRow(
+			"SELECT "+postgresLimitColumns+" FROM player_limits WHERE player_id = $1 AND kind = $2 AND period = $3",
+			playerID, kind, period,
+		))
+		if err != nil && err != sql.ErrNoRows {
+			return err
+		}
+		if l, err = changeLimit(existing, kind, period, amount, time.Now().UTC(), r.coolingOff); err != nil {
+			return err
+		}
+		var pendingAmount *money.Amount
+		var pendingAt *time.Time
+		if l.Pending != nil {
+			pendingAmount, pendingAt = l.Pending.Amount, &l.Pending.EffectiveAt
+		}
+		_, err = tx.Exec(
+			"INSERT INTO player_limits (player_id, "+postgresLimitColumns+") VALUES ($1, $2, $3, $4, $5, $6, $7) "+
+				"ON CONFLICT (player_id, kind, period) DO UPDATE SET amount = EXCLUDED.amount, currency = EXCLUDED.currency, "+
+				"pending_amount = EXCLUDED.pending_amount, pending_at = EXCLUDED.pending_at",
+			playerID, l.Kind, l.Period, l.Amount.Amount, l.Amount.Currency, pendingAmount, pendingAt,
+		)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return l, nil
+}
+
+// checkPostgresLimits returns a *LimitExceededError if change would take
+// the player beyond one of their limits.
+func checkPostgresLimits(tx *sql.Tx, playerID string, change money.Money, now time.Time) error {
+	kind := models.LimitKindOf(change.Amount)
+	limits, err := postgresLimits(tx, playerID, kind)
+	if err != nil {
+		return err
+	}
+	return checkLimits(limits, change, now, func(since time.Time) (money.Amount, error) {
+		return postgresLimitUsage(tx, playerID, kind, change.Currency, since)
+	})
+}
+
+// postgresLimits returns the player's stored limits of kinds.
+func postgresLimits(q postgresQuerier, playerID string, kinds ...string) ([]models.Limit, error) {
+	rows, err := q.Query(
+		"SELECT "+postgresLimitColumns+" FROM player_limits WHERE player_id = $1 AND kind = ANY($2) "+
+			"ORDER BY kind, CASE period WHEN 'daily' THEN 1 WHEN 'weekly' THEN 2 ELSE 3 END",
+		playerID, pq.Array(kinds),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var limits []models.Limit
+	for rows.Next() {
+		l, err := scanPostgresLimit(rows)
+		if err != nil {
+			return nil, err
+		}
+		limits = append(limits, *l)
+	}
+	return limits, rows.Err()
+}
+
+// postgresLimitUsage returns how much the player deposited or lost, by
+// kind, in currency since since.
+func postgresLimitUsage(q postgresQuerier, playerID, kind, currency string, since time.Time) (money.Amount, error) {
+	direction := "amount > 0"
+	if kind == models.LimitLoss {
+		direction = "amount < 0"
+	}
+	var used money.Amount
+	err := q.QueryRow(
+		"SELECT coalesce(sum(abs(amount)), 0) FROM balance_changes "+
+			"WHERE player_id = $1 AND currency = $2 AND created_at >= $3 AND "+direction,
+		playerID, currency, since,
+	).Scan(&used)
+	return used, err
+}
+
+func scanPostgresLimit(row postgresScanner) (*models.Limit, error) {
+	var l models.Limit
+	var pendingAmount *money.Amount
+	var pendingAt *time.Time
+	if err := row.Scan(&l.Kind, &l.Period, &l.Amount.Amount, &l.Amount.Currency, &pendingAmount, &pendingAt); err != nil {
+		return nil, err
+	}
+	if pendingAt != nil {
+		l.Pending = &models.PendingLimit{Amount: pendingAmount, EffectiveAt: pendingAt.UTC()}
+	}
+	return &l, nil
+}
@@ -0,0 +1,102 @@
+package repository
+
+import (
+	"contoso/events"
+	"database/sql"
+	"encoding/json"
+	"sort"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+// PostgresOutboxRepository reads the outbox table that
+// PostgresPlayerRepository writes events to.
+type PostgresOutboxRepository struct {
+	db *sql.DB
+}
+
+func NewPostgresOutboxRepository(db *sql.DB) *PostgresOutboxRepository {
+	return &PostgresOutboxRepository{db: db}
+}
+
+func (r *PostgresOutboxRepository) ClaimEvents(limit int, lease time.Duration) ([]events.Event, error) {
+	// SKIP LOCKED lets several relays claim disjoint batches.
+	rows, err := r.db.Query(`
+		UPDATE outbox SET next_attempt_at = $2
+		WHERE id IN (
+			SELECT id FROM outbox
+			WHERE published_at IS NULL AND next_attempt_at <= now()
+			ORDER BY seq
+			LIMIT $1
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING seq, payload`,
+		limit, time.Now().Add(lease),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	type claimed struct {
+		seq   int64
+		event events.Event
+	}
+	var batch []claimed
+	for rows.Next() {
+		var c claimed
+		var payload []byte
+		if err := rows.Scan(&c.seq, &payload); err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal(payload, &c.event); err != nil {
+			return nil, err
+		}
+		batch = append(batch, c)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	// RETURNING does not keep the subquery's order.
+	sort.Slice(batch, func(i, j int) bool { return batch[i].seq < batch[j].seq })
+	claimedEvents := make([]events.Event, len(batch))
+	for i, c := range batch {
+		claimedEvents[i] = c.event
+	}
+	return claimedEvents, nil
+}
+
+func (r *PostgresOutboxRepository) MarkPublished(ids []string) error {
+	_, err := r.db.Exec("UPDATE outbox SET published_at = now() WHERE id = ANY($1)", pq.Array(ids))
+	return err
+}
+
+func (r *PostgresOutboxRepository) DeletePublishedBefore(t time.Time) (int64, error) {
+	res, err := r.db.Exec("DELETE FROM outbox WHERE published_at < $1", t)
+	if err != nil {
+		return 0, err
+	}
+	return res.RowsAffected()
+}
+
+// insertPostgresOutbox writes evs to the outbox as part of tx, in order.
+func insertPostgresOutbox(tx *sql.Tx, evs ...events.Event) error {
+	if len(evs) == 0 {
+		return nil
+	}
+	ids, types, payloads := make([]string, len(evs)), make([]string, len(evs)), make([]string, len(evs))
+	occurredAt := make([]time.Time, len(evs))
+	for i, e := range evs {
+		payload, err := json.Marshal(e)
+		if err != nil {
+			return err
+		}
+		ids[i], types[i], payloads[i], occurredAt[i] = e.ID, e.Type, string(payload), e.OccurredAt
+	}
+	_, err := tx.Exec(
+		"INSERT INTO outbox (id, event_type, payload, occurred_at) "+
+			"SELECT i, t, p::jsonb, o FROM unnest($1::text[], $2::text[], $3::text[], $4::timestamptz[]) WITH ORDINALITY AS v(i, t, p, o, n) ORDER BY n",
+		pq.Array(ids), pq.Array(types), pq.Array(payloads), pq.Array(occurredAt),
+	)
+	return err
+}
@@ -1,12 +1,21 @@
 package repository
 
 import (
+	"context"
+	"contoso/events"
 	"contoso/models"
+	"contoso/money"
 	"database/sql"
-	"errors"
+	"fmt"
 	"strconv"
+	"strings"
+	"time"
+
+	"github.com/lib/pq"
 )
 
+// PostgresPlayerRepository stores players and writes an event to the outbox
+// table in the same transaction as every change.
 type PostgresPlayerRepository struct {
 	db *sql.DB
 }
@@ -16,20 +25,32 @@ func NewPostgresPlayerRepository(db *sql.DB) *PostgresPlayerRepository {
 }
 
 func (r *PostgresPlayerRepository) CreatePlayer(player *models.Player) (*models.Player, error) {
-	var id int
-	err := r.db.QueryRow(
-		"INSERT INTO players (name, surname, balance) VALUES ($1, $2, $3) RETURNING id",
-		player.Name, player.Surname, player.Balance,
-	).Scan(&id)
+	err := withPostgresTransaction(r.db, func(tx *sql.Tx) error {
+		var id int
+		err := tx.QueryRow(
+			"INSERT INTO players (name, surname, balance, currency, status) VALUES ($1, $2, $3, $4, $5) RETURNING id, status",
+			player.Name, player.Surname, player.Balance.Amount, player.Balance.Currency, models.InitialStatus(player.Status),
+		).Scan(&id, &player.Status)
+		if err != nil {
+			return err
+		}
+		player.ID = strconv.Itoa(id)
+		// The opening balance is a deposit like any other.
+		if !player.Balance.Amount.IsZero() {
+			if err := insertPostgresBalanceChange(tx, player.ID, player.Balance, time.Now().UTC()); err != nil {
+				return err
+			}
+		}
+		return insertPostgresOutbox(tx, events.New(events.PlayerCreated, player.ID, player))
+	})
 	if err != nil {
 		return nil, err
 	}
-	player.ID = strconv.Itoa(id)
 	return player, nil
 }
 
 func (r *PostgresPlayerRepository) GetPlayers() ([]models.Player, error) {
-	rows, err := r.db.Query("SELECT id, name, surname, balance FROM players")
+	rows, err := r.db.Query("SELECT id, name, surname, balance, currency, status FROM players")
 	if err != nil {
 		return nil, err
 	}
@@ -38,7 +59,7 @@ func (r *PostgresPlayerRepository) GetPlayers() ([]models.Player, error) {
 	for rows.Next() {
 		var p models.Player
 		var id int
-		if err := rows.Scan(&id, &p.Name, &p.Surname, &p.Balance); err == nil {
+		if err := rows.Scan(&id, &p.Name, &p.Surname, &p.Balance.Amount, &p.Balance.Currency, &p.Status); err == nil {
 			p.ID = strconv.Itoa(id)
 			players = append(players, p)
 		}
@@ -46,14 +67,39 @@ func (r *PostgresPlayerRepository) GetPlayers() ([]models.Player, error) {
 	return players, nil
 }
 
+func (r *PostgresPlayerRepository) StreamPlayers(fn func(player *models.Player) error) error {
+	ctx, cancel := context.WithTimeout(context.Background(), streamTimeout)
+	defer cancel()
+	rows, err := r.db.QueryContext(ctx, "SELECT id, name, surname, balance, currency, status FROM players ORDER BY id")
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var p models.Player
+		var id int
+		if err := rows.Scan(&id, &p.Name, &p.Surname, &p.Balance.Amount, &p.Balance.Currency, &p.Status); err != nil {
+			return err
+		}
+		p.ID = strconv.Itoa(id)
+		if err := fn(&p); err != nil {
+			return err
+		}
+	}
+	return rows.Err()
+}
+
 func (r *PostgresPlayerRepository) GetPlayer(id string) (*models.Player, error) {
+	if !validPostgresID(id) {
+		return nil, ErrInvalidID
+	}
 	var p models.Player
 	var intID int
-	err := r.db.QueryRow("SELECT id, name, surname, balance FROM players WHERE id = $1", id).
-		Scan(&intID, &p.Name, &p.Surname, &p.Balance)
+	err := r.db.QueryRow("SELECT id, name, surname, balance, currency, status FROM players WHERE id = $1", id).
+		Scan(&intID, &p.Name, &p.Surname, &p.Balance.Amount, &p.Balance.Currency, &p.Status)
 	if err != nil {
 		if err == sql.ErrNoRows {
-			return nil, errors.New("player not found")
+			return nil, ErrPlayerNotFound
 		}
 		return nil, err
 	}
@@ -62,18 +108,578 @@ func (r *PostgresPlayerRepository) GetPlayer(id string) (*models.Player, error)
 }
 
 func (r *PostgresPlayerRepository) UpdatePlayer(id string, input *models.Player) (*models.Player, error) {
-	_, err := r.db.Exec(
-		"UPDATE players SET name = $1, surname = $2, balance = $3 WHERE id = $4",
-		input.Name, input.Surname, input.Balance, id,
-	)
+	if !validPostgresID(id) {
+		return nil, ErrInvalidID
+	}
+	err := withPostgresTransaction(r.db, func(tx *sql.Tx) error {
+		err := tx.QueryRow(
+			"UPDATE players SET name = $1, surname = $2 WHERE id = $3 RETURNING balance, currency, status",
+			input.Name, input.Surname, id,
+		).Scan(&input.Balance.Amount, &input.Balance.Currency, &input.Status)
+		if err == sql.ErrNoRows {
+			return ErrPlayerNotFound
+		}
+		if err != nil {
+			return err
+		}
+		input.ID = id
+		return insertPostgresOutbox(tx, events.New(events.PlayerUpdated, id, input))
+	})
 	if err != nil {
 		return nil, err
 	}
-	input.ID = id
 	return input, nil
 }
 
+func (r *PostgresPlayerRepository) PatchPlayer(id string, expected *models.Player, patch *models.PlayerPatch) (*models.Player, error) {
+	if !validPostgresID(id) {
+		return nil, ErrInvalidID
+	}
+	if patch.IsEmpty() {
+		return applyEmptyPatch(r, id, expected)
+	}
+	var sets []string
+	var args []interface{}
+	add := func(column string, value interface{}) {
+		args = append(args, value)
+		sets = append(sets, column+" = $"+strconv.Itoa(len(args)))
+	}
+	if patch.Name != nil {
+		add("name", *patch.Name)
+	}
+	if patch.Surname != nil {
+		add("surname", *patch.Surname)
+	}
+	n := len(args)
+	args = append(args, id, expected.Name, expected.Surname, expected.Balance.Amount, expected.Balance.Currency, expected.Status)
+	query := "UPDATE players SET " + strings.Join(sets, ", ") +
+		fmt.Sprintf(" WHERE id = $%d AND name = $%d AND surname = $%d AND balance = $%d AND currency = $%d AND status = $%d",
+			n+1, n+2, n+3, n+4, n+5, n+6) +
+		" RETURNING id, name, surname, balance, currency, status"
+	var p models.Player
+	err := withPostgresTransaction(r.db, func(tx *sql.Tx) error {
+		var intID int
+		err := tx.QueryRow(query, args...).Scan(&intID, &p.Name, &p.Surname, &p.Balance.Amount, &p.Balance.Currency, &p.Status)
+		if err == sql.ErrNoRows {
+			// Nothing matched: the player is gone or no longer has the
+			// state the patch was applied to.
+			var exists bool
+			if err := tx.QueryRow("SELECT EXISTS (SELECT 1 FROM players WHERE id = $1)", id).Scan(&exists); err != nil {
+				return err
+			}
+			if exists {
+				return ErrPlayerChanged
+			}
+			return ErrPlayerNotFound
+		}
+		if err != nil {
+			return err
+		}
+		p.ID = strconv.Itoa(intID)
+		return insertPostgresOutbox(tx, events.New(events.PlayerUpdated, id, &p))
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &p, nil
+}
+
+func (r *PostgresPlayerRepository) NewPlayerID() (string, error) {
+	var id int
+	err := r.db.QueryRow("SELECT nextval(pg_get_serial_sequence('players', 'id'))").Scan(&id)
+	if err != nil {
+		return "", err
+	}
+	return strconv.Itoa(id), nil
+}
+
+func (r *PostgresPlayerRepository) PutPlayer(player *models.Player) error {
+	if !validPostgresID(player.ID) {
+		return ErrInvalidID
+	}
+	_, err := r.db.Exec(`
+		INSERT INTO players (id, name, surname, balance, currency, status) VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT (id) DO UPDATE SET name = EXCLUDED.name, surname = EXCLUDED.surname,
+			balance = EXCLUDED.balance, currency = EXCLUDED.currency, status = EXCLUDED.status`,
+		player.ID, player.Name, player.Surname, player.Balance.Amount, player.Balance.Currency, player.Status)
+	return err
+}
+
 func (r *PostgresPlayerRepository) DeletePlayer(id string) error {
-	_, err := r.db.Exec("DELETE FROM players WHERE id = $1", id)
+	if !validPostgresID(id) {
+		return ErrInvalidID
+	}
+	return withPostgresTransaction(r.db, func(tx *sql.Tx) error {
+		res, err := tx.Exec("DELETE FROM players WHERE id = $1", id)
+		if err != nil {
+			return err
+		}
+		if n, _ := res.RowsAffected(); n == 0 {
+			return ErrPlayerNotFound
+		}
+		return insertPostgresOutbox(tx, events.New(events.PlayerDeleted, id, nil))
+	})
+}
+
+func (r *PostgresPlayerRepository) AdjustBalance(id string, amount money.Money) (*models.Player, error) {
+	if !validPostgresID(id) {
+		return nil, ErrInvalidID
+	}
+	var p models.Player
+	err := withPostgresTransaction(r.db, func(tx *sql.Tx) error {
+		var balance money.Amount
+		var currency, status string
+		err := tx.QueryRow(
+			"SELECT balance, currency, status FROM players WHERE id = $1 FOR UPDATE", id,
+		).Scan(&balance, &currency, &status)
+		if err == sql.ErrNoRows {
+			return ErrPlayerNotFound
+		}
+		if err != nil {
+			return err
+		}
+		if models.BalanceLocked(status) {
+			return ErrBalanceLocked
+		}
+		if currency != amount.Currency {
+			return ErrCurrencyMismatch
+		}
+		// The row is locked, so the balance cannot change before the update
+		if err := checkFunds(balance, amount.Amount); err != nil {
+			return err
+		}
+		now := time.Now().UTC()
+		if err := checkPostgresLimits(tx, id, amount, now); err != nil {
+			return err
+		}
+		var intID int
+		err = tx.QueryRow(
+			"UPDATE players SET balance = balance + $1 WHERE id = $2 "+
+				"RETURNING id, name, surname, balance, currency, status",
+			amount.Amount, id,
+		).Scan(&intID, &p.Name, &p.Surname, &p.Balance.Amount, &p.Balance.Currency, &p.Status)
+		if err != nil {
+			return err
+		}
+		p.ID = strconv.Itoa(intID)
+		if err := insertPostgresBalanceChange(tx, id, amount, now); err != nil {
+			return err
+		}
+		return insertPostgresOutbox(tx, balanceChanged(&p, amount))
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &p, nil
+}
+
+func (r *PostgresPlayerRepository) SetStatus(id string, change models.StatusChange) (*models.Player, error) {
+	if !validPostgresID(id) {
+		return nil, ErrInvalidID
+	}
+	var p models.Player
+	err := withPostgresTransaction(r.db, func(tx *sql.Tx) error {
+		var intID int
+		err := tx.QueryRow(
+			"SELECT id, name, surname, balance, currency, status FROM players WHERE id = $1 FOR UPDATE", id,
+		).Scan(&intID, &p.Name, &p.Surname, &p.Balance.Amount, &p.Balance.Currency, &p.Status)
+		if err == sql.ErrNoRows {
+			return ErrPlayerNotFound
+		}
+		if err != nil {
+			return err
+		}
+		if !models.CanTransition(p.Status, change.Status) {
+			return fmt.Errorf("%w from %s to %s", ErrInvalidStatusTransition, p.Status, change.Status)
+		}
+		if _, err := tx.Exec("UPDATE players SET status = $1 WHERE id = $2", change.Status, id); err != nil {
+			return err
+		}
+		previous := p.Status
+		p.ID, p.Status = strconv.Itoa(intID), change.Status
+		return insertPostgresOutbox(tx, statusChanged(&p, previous, change.Reason))
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &p, nil
+}
+
+func (r *PostgresPlayerRepository) BulkWrite(ops []models.BulkOperation, atomic bool) ([]BulkItemResult, error) {
+	results := checkBulkIDs(ops)
+	for i, op := range ops {
+		if results[i].Err == nil && op.Op != models.BulkCreate && !validPostgresID(op.ID) {
+			results[i].Err = ErrInvalidID
+		}
+	}
+	if atomic && bulkFailed(results) {
+		return abortBulk(results)
+	}
+	tx, err := r.db.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	// Lock the targeted rows so they cannot disappear between the existence
+	// check and the writes.
+	var targets []int64
+	for i, op := range ops {
+		if results[i].Err == nil && op.Op != models.BulkCreate {
+			id, _ := strconv.ParseInt(op.ID, 10, 64)
+			targets = append(targets, id)
+		}
+	}
+	existing := make(map[string]bool)
+	if len(targets) > 0 {
+		rows, err := tx.Query("SELECT id FROM players WHERE id = ANY($1) FOR UPDATE", pq.Array(targets))
+		if err != nil {
+			return nil, err
+		}
+		for rows.Next() {
+			var id int
+			if err := rows.Scan(&id); err != nil {
+				rows.Close()
+				return nil, err
+			}
+			existing[strconv.Itoa(id)] = true
+		}
+		rows.Close()
+		if err := rows.Err(); err != nil {
+			return nil, err
+		}
+	}
+
+	var creates, updates, deletes []int
+	for i, op := range ops {
+		if results[i].Err != nil {
+			continue
+		}
+		if op.Op != models.BulkCreate && !existing[op.ID] {
+			results[i].Err = ErrPlayerNotFound
+			continue
+		}
+		switch op.Op {
+		case models.BulkCreate:
+			creates = append(creates, i)
+		case models.BulkUpdate:
+			updates = append(updates, i)
+		case models.BulkDelete:
+			deletes = append(deletes, i)
+		}
+	}
+	if atomic && bulkFailed(results) {
+		return abortBulk(results)
+	}
+
+	groups := []struct {
+		items []int
+		exec  func(tx *sql.Tx, ops []models.BulkOperation, items []int, results []BulkItemResult) error
+	}{
+		{creates, postgresBulkCreate},
+		{updates, postgresBulkUpdate},
+		{deletes, postgresBulkDelete},
+	}
+	for _, g := range groups {
+		if len(g.items) == 0 {
+			continue
+		}
+		if atomic {
+			if err := g.exec(tx, ops, g.items, results); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		if err := postgresBestEffort(tx, ops, g.items, results, g.exec); err != nil {
+			return nil, err
+		}
+	}
+	if err := insertPostgresOutbox(tx, bulkEvents(ops, results)...); err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// postgresBestEffort runs exec for all items in one statement. If that
+// fails it retries item by item, each behind a savepoint, so one bad row
+// only fails itself.
+func postgresBestEffort(tx *sql.Tx, ops []models.BulkOperation, items []int, results []BulkItemResult,
+	exec func(*sql.Tx, []models.BulkOperation, []int, []BulkItemResult) error) error {
+	if _, err := tx.Exec("SAVEPOINT bulk_group"); err != nil {
+		return err
+	}
+	if err := exec(tx, ops, items, results); err == nil {
+		_, err = tx.Exec("RELEASE SAVEPOINT bulk_group")
+		return err
+	}
+	if _, err := tx.Exec("ROLLBACK TO SAVEPOINT bulk_group"); err != nil {
+		return err
+	}
+	for _, i := range items {
+		if _, err := tx.Exec("SAVEPOINT bulk_item"); err != nil {
+			return err
+		}
+		if err := exec(tx, ops, []int{i}, results); err != nil {
+			results[i] = BulkItemResult{Err: err}
+			if _, err := tx.Exec("ROLLBACK TO SAVEPOINT bulk_item"); err != nil {
+				return err
+			}
+			continue
+		}
+		if _, err := tx.Exec("RELEASE SAVEPOINT bulk_item"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// postgresBulkCreate inserts the players of items with a single multi-row
+// INSERT; RETURNING yields the new IDs in input order.
+func postgresBulkCreate(tx *sql.Tx, ops []models.BulkOperation, items []int, results []BulkItemResult) error {
+	names, surnames, balances, currencies := bulkColumns(ops, items)
+	statuses := make([]string, len(items))
+	for n, i := range items {
+		statuses[n] = models.InitialStatus(ops[i].Player.Status)
+	}
+	// Non-zero opening balances go into the ledger like other deposits.
+	rows, err := tx.Query(
+		"WITH created AS (INSERT INTO players (name, surname, balance, currency, status) "+
+			"SELECT * FROM unnest($1::text[], $2::text[], $3::numeric[], $4::text[], $5::text[]) RETURNING id, balance, currency, status), "+
+			"deposits AS (INSERT INTO balance_changes (player_id, amount, currency) "+
+			"SELECT id, balance, currency FROM created WHERE balance <> 0) "+
+			"SELECT id, status FROM created ORDER BY id",
+		pq.Array(names), pq.Array(surnames), pq.Array(balances), pq.Array(currencies), pq.Array(statuses),
+	)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	for _, i := range items {
+		if !rows.Next() {
+			break
+		}
+		var id int
+		player := *ops[i].Player
+		if err := rows.Scan(&id, &player.Status); err != nil {
+			return err
+		}
+		player.ID = strconv.Itoa(id)
+		results[i].Player = &player
+	}
+	return rows.Err()
+}
+
+// postgresBulkUpdate replaces the players of items with a single
+// UPDATE ... FROM unnest.
+func postgresBulkUpdate(tx *sql.Tx, ops []models.BulkOperation, items []int, results []BulkItemResult) error {
+	names, surnames, _, _ := bulkColumns(ops, items)
+	ids := make([]string, len(items))
+	for n, i := range items {
+		ids[n] = ops[i].ID
+	}
+	// Like status, the balance only changes through adjustments and
+	// transfers, so the stored one is returned.
+	rows, err := tx.Query(
+		"UPDATE players AS p SET name = v.name, surname = v.surname "+
+			"FROM unnest($1::int[], $2::text[], $3::text[]) AS v(id, name, surname) "+
+			"WHERE p.id = v.id RETURNING p.id, p.balance, p.currency, p.status",
+		pq.Array(ids), pq.Array(names), pq.Array(surnames),
+	)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	stored := make(map[int]models.Player, len(items))
+	for rows.Next() {
+		var id int
+		var p models.Player
+		if err := rows.Scan(&id, &p.Balance.Amount, &p.Balance.Currency, &p.Status); err != nil {
+			return err
+		}
+		stored[id] = p
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+	for _, i := range items {
+		player := *ops[i].Player
+		player.ID = ops[i].ID
+		id, _ := strconv.Atoi(ops[i].ID)
+		player.Balance, player.Status = stored[id].Balance, stored[id].Status
+		results[i].Player = &player
+	}
+	return nil
+}
+
+// postgresBulkDelete removes the players of items with a single DELETE.
+func postgresBulkDelete(tx *sql.Tx, ops []models.BulkOperation, items []int, _ []BulkItemResult) error {
+	ids := make([]string, len(items))
+	for n, i := range items {
+		ids[n] = ops[i].ID
+	}
+	_, err := tx.Exec("DELETE FROM players WHERE id = ANY($1::int[])", pq.Array(ids))
 	return err
 }
+
+func (r *PostgresPlayerRepository) Stats(query models.PlayerStatsQuery) (*models.PlayerStats, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	// One snapshot for all three queries keeps the figures consistent.
+	tx, err := r.db.BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelRepeatableRead, ReadOnly: true})
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	stats := &models.PlayerStats{Currency: query.Currency, Buckets: models.NewBalanceBuckets(query.Bounds)}
+	err = tx.QueryRowContext(ctx,
+		"SELECT count(*), COALESCE(sum(balance), 0), COALESCE(round(avg(balance), 2), 0), COALESCE(min(balance), 0), COALESCE(max(balance), 0) FROM players WHERE currency = $1",
+		query.Currency,
+	).Scan(&stats.Count, &stats.TotalBalance, &stats.AverageBalance, &stats.MinBalance, &stats.MaxBalance)
+	if err != nil {
+		return nil, err
+	}
+
+	// width_bucket numbers the buckets from 0, below the first bound, to
+	// len(bounds), at or above the last.
+	rows, err := tx.QueryContext(ctx,
+		"SELECT width_bucket(balance, $1::numeric[]) AS bucket, count(*) FROM players WHERE currency = $2 GROUP BY bucket",
+		pq.Array(boundStrings(query.Bounds)), query.Currency,
+	)
+	if err != nil {
+		return nil, err
+	}
+	for rows.Next() {
+		var bucket int
+		var count int64
+		if err := rows.Scan(&bucket, &count); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		stats.Buckets[bucket].Count = count
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if column, ok := models.PlayerGroupFields[query.GroupBy]; ok {
+		rows, err := tx.QueryContext(ctx,
+			"SELECT "+column+", count(*), sum(balance), round(avg(balance), 2) FROM players WHERE currency = $1 GROUP BY 1 ORDER BY 2 DESC, 1 LIMIT $2",
+			query.Currency, query.GroupLimit,
+		)
+		if err != nil {
+			return nil, err
+		}
+		defer rows.Close()
+		stats.Groups = []models.PlayerStatsGroup{}
+		for rows.Next() {
+			var g models.PlayerStatsGroup
+			if err := rows.Scan(&g.Key, &g.Count, &g.TotalBalance, &g.AverageBalance); err != nil {
+				return nil, err
+			}
+			stats.Groups = append(stats.Groups, g)
+		}
+		if err := rows.Err(); err != nil {
+			return nil, err
+		}
+	}
+	stats.GeneratedAt = time.Now().UTC()
+	return stats, nil
+}
+
+func (r *PostgresPlayerRepository) Leaderboard(currency string, offset, limit int) ([]models.RankedPlayer, int64, error) {
+	// The players_leaderboard index yields the players of a currency in
+	// leaderboard order, so rank() and the limit stop after offset+limit rows.
+	rows, err := r.db.Query(
+		"SELECT id, name, surname, balance, currency, status, rank() OVER (ORDER BY balance DESC) FROM players "+
+			"WHERE currency = $1 ORDER BY balance DESC, id LIMIT $2 OFFSET $3",
+		currency, limit, offset,
+	)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+	entries := []models.RankedPlayer{}
+	for rows.Next() {
+		var e models.RankedPlayer
+		var id int
+		if err := rows.Scan(&id, &e.Name, &e.Surname, &e.Balance.Amount, &e.Balance.Currency, &e.Status, &e.Rank); err != nil {
+			return nil, 0, err
+		}
+		e.ID = strconv.Itoa(id)
+		entries = append(entries, e)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, err
+	}
+	var total int64
+	if err := r.db.QueryRow("SELECT count(*) FROM players WHERE currency = $1", currency).Scan(&total); err != nil {
+		return nil, 0, err
+	}
+	return entries, total, nil
+}
+
+func (r *PostgresPlayerRepository) Rank(id string) (*models.PlayerRank, error) {
+	if !validPostgresID(id) {
+		return nil, ErrInvalidID
+	}
+	var rank models.PlayerRank
+	var intID int
+	err := r.db.QueryRow(
+		"SELECT p.id, p.name, p.surname, p.balance, p.currency, p.status, "+
+			"(SELECT count(*) FROM players WHERE currency = p.currency AND balance > p.balance) + 1, "+
+			"(SELECT count(*) FROM players WHERE currency = p.currency) "+
+			"FROM players p WHERE p.id = $1",
+		id,
+	).Scan(&intID, &rank.Player.Name, &rank.Player.Surname, &rank.Player.Balance.Amount, &rank.Player.Balance.Currency, &rank.Player.Status, &rank.Rank, &rank.Total)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, ErrPlayerNotFound
+		}
+		return nil, err
+	}
+	rank.Player.ID = strconv.Itoa(intID)
+	return &rank, nil
+}
+
+// insertPostgresBalanceChange records a credit or debit of the player's
+// balance in the ledger that limits are checked against.
+func insertPostgresBalanceChange(tx *sql.Tx, playerID string, amount money.Money, at time.Time) error {
+	_, err := tx.Exec(
+		"INSERT INTO balance_changes (player_id, amount, currency, created_at) VALUES ($1, $2, $3, $4)",
+		playerID, amount.Amount, amount.Currency, at,
+	)
+	return err
+}
+
+// bulkColumns splits the players of items into column arrays for unnest.
+// Balances are passed as decimal strings so they reach NUMERIC exactly.
+func bulkColumns(ops []models.BulkOperation, items []int) (names, surnames, balances, currencies []string) {
+	for _, i := range items {
+		names = append(names, ops[i].Player.Name)
+		surnames = append(surnames, ops[i].Player.Surname)
+		balances = append(balances, ops[i].Player.Balance.Amount.String())
+		currencies = append(currencies, ops[i].Player.Balance.Currency)
+	}
+	return names, surnames, balances, currencies
+}
+
+// boundStrings passes bucket bounds to Postgres as exact decimal strings.
+func boundStrings(bounds []money.Amount) []string {
+	out := make([]string, len(bounds))
+	for i, b := range bounds {
+		out[i] = b.String()
+	}
+	return out
+}
+
+// validPostgresID rejects IDs that are not SERIAL values before they reach
+// the driver, whose error would otherwise leak the SQL type.
+func validPostgresID(id string) bool {
+	n, err := strconv.Atoi(id)
+	return err == nil && n > 0
+}
@@ -0,0 +1,170 @@
+package repository
+
+import (
+	"contoso/models"
+	"contoso/money"
+	"database/sql"
+	"strconv"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+// PostgresTransferRepository moves money between rows of players and
+// records the legs in transfer_legs. Like balance changes, transfers count
+// towards the sender's loss and the recipient's deposit limits and are
+// recorded in balance_changes.
+type PostgresTransferRepository struct {
+	db *sql.DB
+}
+
+func NewPostgresTransferRepository(db *sql.DB) *PostgresTransferRepository {
+	return &PostgresTransferRepository{db: db}
+}
+
+func (r *PostgresTransferRepository) Transfer(id, from, to string, amount money.Money) (*models.Transfer, bool, error) {
+	if !validPostgresID(from) || !validPostgresID(to) {
+		return nil, false, ErrInvalidID
+	}
+	var t models.Transfer
+	var created bool
+	err := withPostgresTransaction(r.db, func(tx *sql.Tx) error {
+		t = models.Transfer{ID: id, Amount: amount, CreatedAt: time.Now().UTC()}
+		created = false
+		// Locking both players in ID order keeps opposite transfers between
+		// the same players from deadlocking.
+		rows, err := tx.Query(
+			"SELECT id, name, surname, balance, currency, status FROM players WHERE id = ANY($1::int[]) ORDER BY id FOR UPDATE",
+			pq.Array([]string{from, to}),
+		)
+		if err != nil {
+			return err
+		}
+		players := make(map[string]models.Player, 2)
+		for rows.Next() {
+			var p models.Player
+			var id int
+			if err := rows.Scan(&id, &p.Name, &p.Surname, &p.Balance.Amount, &p.Balance.Currency, &p.Status); err != nil {
+				rows.Close()
+				return err
+			}
+			p.ID = strconv.Itoa(id)
+			players[p.ID] = p
+		}
+		rows.Close()
+		if err := rows.Err(); err != nil {
+			return err
+		}
+		// A repeated request finds the transfer it repeats; if both run at
+		// once, the lock above waits for the first to commit.
+		sent, err := postgresSentLeg(tx, id)
+		if err != nil {
+			return err
+		}
+		if sent != nil {
+			return sent.replay(&t, from, to, amount, players)
+		}
+		var ok bool
+		if t.From, ok = players[from]; !ok {
+			return ErrPlayerNotFound
+		}
+		if t.To, ok = players[to]; !ok {
+			return ErrPlayerNotFound
+		}
+		if models.BalanceLocked(t.From.Status) || models.BalanceLocked(t.To.Status) {
+			return ErrBalanceLocked
+		}
+		if t.From.Balance.Currency != amount.Currency || t.To.Balance.Currency != amount.Currency {
+			return ErrCurrencyMismatch
+		}
+		if t.From.Balance.Amount.Cmp(amount.Amount) < 0 {
+			return ErrInsufficientFunds
+		}
+		debit := money.Money{Amount: amount.Amount.Neg(), Currency: amount.Currency}
+		if err := checkPostgresLimits(tx, from, debit, t.CreatedAt); err != nil {
+			return err
+		}
+		if err := checkPostgresLimits(tx, to, amount, t.CreatedAt); err != nil {
+			return err
+		}
+		t.From.Balance.Amount = t.From.Balance.Amount.Sub(amount.Amount)
+		t.To.Balance.Amount = t.To.Balance.Amount.Add(amount.Amount)
+		_, err = tx.Exec(
+			"UPDATE players SET balance = CASE id WHEN $1 THEN balance - $3 ELSE balance + $3 END WHERE id IN ($1, $2)",
+			from, to, amount.Amount,
+		)
+		if err != nil {
+			return err
+		}
+		// A transfer with the same ID between other players is not held up
+		// by the lock, so the unique indexes catch it.
+		res, err := tx.Exec(
+			"INSERT INTO transfer_legs (transfer_id, player_id, counterparty_id, amount, currency, created_at) "+
+				"VALUES ($1, $2, $3, -$4::numeric, $5, $6), ($1, $3, $2, $4, $5, $6) ON CONFLICT DO NOTHING",
+			t.ID, from, to, amount.Amount, amount.Currency, t.CreatedAt,
+		)
+		if err != nil {
+			return err
+		}
+		if n, _ := res.RowsAffected(); n != 2 {
+			return ErrTransferIDReused
+		}
+		if err := insertPostgresBalanceChange(tx, from, debit, t.CreatedAt); err != nil {
+			return err
+		}
+		if err := insertPostgresBalanceChange(tx, to, amount, t.CreatedAt); err != nil {
+			return err
+		}
+		created = true
+		return insertPostgresOutbox(tx, transferred(&t)...)
+	})
+	if err != nil {
+		return nil, false, err
+	}
+	return &t, created, nil
+}
+
+// postgresSentLeg returns the sender's leg of the transfer with id, or nil
+// if there is none.
+func postgresSentLeg(q postgresQuerier, id string) (*sentLeg, error) {
+	var s sentLeg
+	var from, to int
+	err := q.QueryRow(
+		"SELECT player_id, counterparty_id, amount, currency, created_at FROM transfer_legs WHERE transfer_id = $1 AND amount < 0",
+		id,
+	).Scan(&from, &to, &s.amount.Amount, &s.amount.Currency, &s.createdAt)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	s.from, s.to, s.createdAt = strconv.Itoa(from), strconv.Itoa(to), s.createdAt.UTC()
+	return &s, nil
+}
+
+func (r *PostgresTransferRepository) ListTransfers(playerID string, offset, limit int) ([]models.TransferLeg, error) {
+	if !validPostgresID(playerID) {
+		return nil, ErrInvalidID
+	}
+	rows, err := r.db.Query(
+		"SELECT transfer_id, counterparty_id, amount, currency, created_at FROM transfer_legs "+
+			"WHERE player_id = $1 ORDER BY created_at DESC, transfer_id DESC OFFSET $2 LIMIT $3",
+		playerID, offset, limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	legs := []models.TransferLeg{}
+	for rows.Next() {
+		var leg models.TransferLeg
+		var counterparty int
+		if err := rows.Scan(&leg.TransferID, &counterparty, &leg.Amount.Amount, &leg.Amount.Currency, &leg.CreatedAt); err != nil {
+			return nil, err
+		}
+		leg.CounterpartyID = strconv.Itoa(counterparty)
+		legs = append(legs, leg)
+	}
+	return legs, rows.Err()
+}
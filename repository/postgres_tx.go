@@ -0,0 +1,17 @@
+package repository
+
+import "database/sql"
+
+// withPostgresTransaction runs fn in a transaction that is committed when
+// fn returns nil and rolled back otherwise.
+func withPostgresTransaction(db *sql.DB, fn func(tx *sql.Tx) error) error {
+	tx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	if err := fn(tx); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
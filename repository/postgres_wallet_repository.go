@@ -0,0 +1,177 @@
+package repository
+
+import (
+	"contoso/models"
+	"contoso/money"
+	"database/sql"
+	"strconv"
+	"time"
+)
+
+// PostgresWalletRepository stores wallets in the wallets table; the primary
+// wallet is the balance column of players.
+type PostgresWalletRepository struct {
+	db *sql.DB
+}
+
+func NewPostgresWalletRepository(db *sql.DB) *PostgresWalletRepository {
+	return &PostgresWalletRepository{db: db}
+}
+
+func (r *PostgresWalletRepository) OpenWallet(playerID, currency string) (*models.Wallet, error) {
+	if !validPostgresID(playerID) {
+		return nil, ErrInvalidID
+	}
+	var w models.Wallet
+	err := withPostgresTransaction(r.db, func(tx *sql.Tx) error {
+		// FOR SHARE keeps the player, and so their currency, until commit.
+		var primary string
+		err := tx.QueryRow("SELECT currency FROM players WHERE id = $1 FOR SHARE", playerID).Scan(&primary)
+		if err == sql.ErrNoRows {
+			return ErrPlayerNotFound
+		}
+		if err != nil {
+			return err
+		}
+		if primary == currency {
+			return ErrWalletExists
+		}
+		var openedAt time.Time
+		err = tx.QueryRow(
+			"INSERT INTO wallets (player_id, currency) VALUES ($1, $2) ON CONFLICT DO NOTHING RETURNING opened_at",
+			playerID, currency,
+		).Scan(&openedAt)
+		if err == sql.ErrNoRows {
+			return ErrWalletExists
+		}
+		if err != nil {
+			return err
+		}
+		w = models.Wallet{Balance: money.Money{Currency: currency}, OpenedAt: &openedAt}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &w, nil
+}
+
+func (r *PostgresWalletRepository) ListWallets(playerID string) ([]models.Wallet, error) {
+	if !validPostgresID(playerID) {
+		return nil, ErrInvalidID
+	}
+	rows, err := r.db.Query(
+		"SELECT balance, currency, opened_at FROM ("+
+			"SELECT balance, currency, NULL::timestamptz AS opened_at FROM players WHERE id = $1 "+
+			"UNION ALL SELECT balance, currency, opened_at FROM wallets WHERE player_id = $1"+
+			") w ORDER BY opened_at IS NOT NULL, currency",
+		playerID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var wallets []models.Wallet
+	for rows.Next() {
+		var w models.Wallet
+		var openedAt sql.NullTime
+		if err := rows.Scan(&w.Balance.Amount, &w.Balance.Currency, &openedAt); err != nil {
+			return nil, err
+		}
+		if openedAt.Valid {
+			w.OpenedAt = &openedAt.Time
+		} else {
+			w.Primary = true
+		}
+		wallets = append(wallets, w)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	if len(wallets) == 0 {
+		return nil, ErrPlayerNotFound
+	}
+	return wallets, nil
+}
+
+func (r *PostgresWalletRepository) Convert(playerID string, debit, credit money.Money) ([]models.Wallet, error) {
+	if !validPostgresID(playerID) {
+		return nil, ErrInvalidID
+	}
+	var wallets []models.Wallet
+	err := withPostgresTransaction(r.db, func(tx *sql.Tx) error {
+		var p models.Player
+		var intID int
+		err := tx.QueryRow(
+			"SELECT id, name, surname, balance, currency, status FROM players WHERE id = $1 FOR UPDATE", playerID,
+		).Scan(&intID, &p.Name, &p.Surname, &p.Balance.Amount, &p.Balance.Currency, &p.Status)
+		if err == sql.ErrNoRows {
+			return ErrPlayerNotFound
+		}
+		if err != nil {
+			return err
+		}
+		if models.BalanceLocked(p.Status) {
+			return ErrBalanceLocked
+		}
+		p.ID = strconv.Itoa(intID)
+		from, err := addToPostgresWallet(tx, &p, money.Money{Amount: debit.Amount.Neg(), Currency: debit.Currency})
+		if err != nil {
+			return err
+		}
+		to, err := addToPostgresWallet(tx, &p, credit)
+		if err != nil {
+			return err
+		}
+		wallets = []models.Wallet{*from, *to}
+		return insertPostgresOutbox(tx, currencyConverted(&p, debit, credit))
+	})
+	if err != nil {
+		return nil, err
+	}
+	return wallets, nil
+}
+
+// addToPostgresWallet adds amount to p's wallet in its currency, which is
+// p's balance for the primary wallet, without letting it go below zero.
+func addToPostgresWallet(tx *sql.Tx, p *models.Player, amount money.Money) (*models.Wallet, error) {
+	w := models.Wallet{Balance: money.Money{Currency: amount.Currency}}
+	if amount.Currency == p.Balance.Currency {
+		w.Primary = true
+		err := tx.QueryRow(
+			"UPDATE players SET balance = balance + $1 WHERE id = $2 AND balance + $1 >= 0 RETURNING balance",
+			amount.Amount, p.ID,
+		).Scan(&w.Balance.Amount)
+		if err == sql.ErrNoRows {
+			return nil, ErrInsufficientFunds
+		}
+		if err != nil {
+			return nil, err
+		}
+		p.Balance = w.Balance
+		return &w, nil
+	}
+	var openedAt time.Time
+	err := tx.QueryRow(
+		"UPDATE wallets SET balance = balance + $1 WHERE player_id = $2 AND currency = $3 AND balance + $1 >= 0 "+
+			"RETURNING balance, opened_at",
+		amount.Amount, p.ID, amount.Currency,
+	).Scan(&w.Balance.Amount, &openedAt)
+	if err == sql.ErrNoRows {
+		var exists bool
+		if err := tx.QueryRow(
+			"SELECT EXISTS (SELECT 1 FROM wallets WHERE player_id = $1 AND currency = $2)", p.ID, amount.Currency,
+		).Scan(&exists); err != nil {
+			return nil, err
+		}
+		if exists {
+			return nil, ErrInsufficientFunds
+		}
+		return nil, ErrWalletNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	w.OpenedAt = &openedAt
+	return &w, nil
+}
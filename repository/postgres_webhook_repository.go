@@ -0,0 +1,259 @@
+package repository
+
+import (
+	"contoso/models"
+	"database/sql"
+	"encoding/json"
+	"strconv"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+type PostgresWebhookRepository struct {
+	db *sql.DB
+}
+
+func NewPostgresWebhookRepository(db *sql.DB) *PostgresWebhookRepository {
+	return &PostgresWebhookRepository{db: db}
+}
+
+const webhookColumns = "id, url, events, description, active, secret, created_at"
+
+const deliveryColumns = "id, webhook_id, event_id, event_type, payload, status, attempts, next_attempt_at, created_at, updated_at"
+
+func (r *PostgresWebhookRepository) CreateWebhook(webhook *models.Webhook) (*models.Webhook, error) {
+	var id int
+	err := r.db.QueryRow(
+		"INSERT INTO webhooks (url, events, description, active, secret, created_at) VALUES ($1, $2, $3, $4, $5, $6) RETURNING id",
+		webhook.URL, pq.Array(webhook.Events), webhook.Description, webhook.Active, webhook.Secret, webhook.CreatedAt,
+	).Scan(&id)
+	if err != nil {
+		return nil, err
+	}
+	webhook.ID = strconv.Itoa(id)
+	return webhook, nil
+}
+
+func (r *PostgresWebhookRepository) GetWebhook(id string) (*models.Webhook, error) {
+	if !validPostgresID(id) {
+		return nil, ErrInvalidID
+	}
+	webhook, err := scanPostgresWebhook(r.db.QueryRow("SELECT "+webhookColumns+" FROM webhooks WHERE id = $1", id))
+	if err == sql.ErrNoRows {
+		return nil, ErrWebhookNotFound
+	}
+	return webhook, err
+}
+
+func (r *PostgresWebhookRepository) ListWebhooks() ([]models.Webhook, error) {
+	rows, err := r.db.Query("SELECT " + webhookColumns + " FROM webhooks ORDER BY id")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	webhooks := []models.Webhook{}
+	for rows.Next() {
+		webhook, err := scanPostgresWebhook(rows)
+		if err != nil {
+			return nil, err
+		}
+		webhooks = append(webhooks, *webhook)
+	}
+	return webhooks, rows.Err()
+}
+
+func (r *PostgresWebhookRepository) UpdateWebhook(id string, webhook *models.Webhook) (*models.Webhook, error) {
+	if !validPostgresID(id) {
+		return nil, ErrInvalidID
+	}
+	updated, err := scanPostgresWebhook(r.db.QueryRow(
+		"UPDATE webhooks SET url = $2, events = $3, description = $4, active = $5 WHERE id = $1 RETURNING "+webhookColumns,
+		id, webhook.URL, pq.Array(webhook.Events), webhook.Description, webhook.Active,
+	))
+	if err == sql.ErrNoRows {
+		return nil, ErrWebhookNotFound
+	}
+	return updated, err
+}
+
+func (r *PostgresWebhookRepository) DeleteWebhook(id string) error {
+	if !validPostgresID(id) {
+		return ErrInvalidID
+	}
+	// Deliveries go with it through ON DELETE CASCADE.
+	res, err := r.db.Exec("DELETE FROM webhooks WHERE id = $1", id)
+	if err != nil {
+		return err
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return ErrWebhookNotFound
+	}
+	return nil
+}
+
+func (r *PostgresWebhookRepository) EnqueueDeliveries(deliveries []models.WebhookDelivery) error {
+	if len(deliveries) == 0 {
+		return nil
+	}
+	n := len(deliveries)
+	webhookIDs, eventIDs, eventTypes := make([]string, n), make([]string, n), make([]string, n)
+	payloads, statuses := make([]string, n), make([]string, n)
+	nextAttempts, createdAt := make([]time.Time, n), make([]time.Time, n)
+	for i, d := range deliveries {
+		webhookIDs[i], eventIDs[i], eventTypes[i] = d.WebhookID, d.EventID, d.EventType
+		payloads[i], statuses[i] = string(d.Payload), d.Status
+		createdAt[i] = d.CreatedAt
+		nextAttempts[i] = d.CreatedAt
+		if d.NextAttemptAt != nil {
+			nextAttempts[i] = *d.NextAttemptAt
+		}
+	}
+	_, err := r.db.Exec(
+		"INSERT INTO webhook_deliveries (webhook_id, event_id, event_type, payload, status, next_attempt_at, created_at, updated_at) "+
+			"SELECT w, e, t, p::jsonb, s, n, c, c FROM unnest($1::int[], $2::text[], $3::text[], $4::text[], $5::text[], $6::timestamptz[], $7::timestamptz[]) AS v(w, e, t, p, s, n, c) "+
+			"ON CONFLICT (webhook_id, event_id) DO NOTHING",
+		pq.Array(webhookIDs), pq.Array(eventIDs), pq.Array(eventTypes), pq.Array(payloads), pq.Array(statuses),
+		pq.Array(nextAttempts), pq.Array(createdAt),
+	)
+	return err
+}
+
+func (r *PostgresWebhookRepository) ClaimDeliveries(limit int, lease time.Duration) ([]models.WebhookDelivery, error) {
+	// SKIP LOCKED lets several instances claim disjoint batches.
+	rows, err := r.db.Query(`
+		UPDATE webhook_deliveries SET next_attempt_at = $2
+		WHERE id IN (
+			SELECT id FROM webhook_deliveries
+			WHERE status = 'pending' AND next_attempt_at <= now()
+			ORDER BY next_attempt_at
+			LIMIT $1
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING `+deliveryColumns,
+		limit, time.Now().Add(lease),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return scanPostgresDeliveries(rows)
+}
+
+func (r *PostgresWebhookRepository) RecordAttempt(id string, attempt models.WebhookAttempt, status string, next *time.Time) error {
+	if !validPostgresID(id) {
+		return ErrInvalidID
+	}
+	entry, err := json.Marshal([]models.WebhookAttempt{attempt})
+	if err != nil {
+		return err
+	}
+	res, err := r.db.Exec(
+		"UPDATE webhook_deliveries SET attempts = attempts || $2::jsonb, status = $3, next_attempt_at = $4, updated_at = now() WHERE id = $1",
+		id, string(entry), status, next,
+	)
+	if err != nil {
+		return err
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return ErrDeliveryNotFound
+	}
+	return nil
+}
+
+func (r *PostgresWebhookRepository) GetDelivery(id string) (*models.WebhookDelivery, error) {
+	if !validPostgresID(id) {
+		return nil, ErrInvalidID
+	}
+	d, err := scanPostgresDelivery(r.db.QueryRow("SELECT "+deliveryColumns+" FROM webhook_deliveries WHERE id = $1", id))
+	if err == sql.ErrNoRows {
+		return nil, ErrDeliveryNotFound
+	}
+	return d, err
+}
+
+func (r *PostgresWebhookRepository) ListDeliveries(webhookID, status string, limit int) ([]models.WebhookDelivery, error) {
+	if webhookID != "" && !validPostgresID(webhookID) {
+		return nil, ErrInvalidID
+	}
+	rows, err := r.db.Query(
+		"SELECT "+deliveryColumns+" FROM webhook_deliveries"+
+			" WHERE ($1 = '' OR webhook_id::text = $1) AND ($2 = '' OR status = $2)"+
+			" ORDER BY id DESC LIMIT $3",
+		webhookID, status, limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return scanPostgresDeliveries(rows)
+}
+
+func (r *PostgresWebhookRepository) Redeliver(id string) (*models.WebhookDelivery, error) {
+	if !validPostgresID(id) {
+		return nil, ErrInvalidID
+	}
+	d, err := scanPostgresDelivery(r.db.QueryRow(
+		"UPDATE webhook_deliveries SET status = 'pending', next_attempt_at = now(), updated_at = now() WHERE id = $1 RETURNING "+deliveryColumns,
+		id,
+	))
+	if err == sql.ErrNoRows {
+		return nil, ErrDeliveryNotFound
+	}
+	return d, err
+}
+
+func (r *PostgresWebhookRepository) DeleteSucceededBefore(t time.Time) (int64, error) {
+	res, err := r.db.Exec("DELETE FROM webhook_deliveries WHERE status = 'succeeded' AND updated_at < $1", t)
+	if err != nil {
+		return 0, err
+	}
+	return res.RowsAffected()
+}
+
+func scanPostgresWebhook(row interface{ Scan(...any) error }) (*models.Webhook, error) {
+	var webhook models.Webhook
+	var id int
+	if err := row.Scan(&id, &webhook.URL, pq.Array(&webhook.Events), &webhook.Description, &webhook.Active, &webhook.Secret, &webhook.CreatedAt); err != nil {
+		return nil, err
+	}
+	webhook.ID = strconv.Itoa(id)
+	if webhook.Events == nil {
+		webhook.Events = []string{}
+	}
+	return &webhook, nil
+}
+
+func scanPostgresDelivery(row interface{ Scan(...any) error }) (*models.WebhookDelivery, error) {
+	var (
+		d                 models.WebhookDelivery
+		id, webhookID     int64
+		payload, attempts []byte
+		next              sql.NullTime
+	)
+	err := row.Scan(&id, &webhookID, &d.EventID, &d.EventType, &payload, &d.Status, &attempts, &next, &d.CreatedAt, &d.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+	d.ID = strconv.FormatInt(id, 10)
+	d.WebhookID = strconv.FormatInt(webhookID, 10)
+	d.Payload = payload
+	if err := json.Unmarshal(attempts, &d.Attempts); err != nil {
+		return nil, err
+	}
+	if next.Valid {
+		d.NextAttemptAt = &next.Time
+	}
+	return &d, nil
+}
+
+func scanPostgresDeliveries(rows *sql.Rows) ([]models.WebhookDelivery, error) {
+	defer rows.Close()
+	deliveries := []models.WebhookDelivery{}
+	for rows.Next() {
+		d, err := scanPostgresDelivery(rows)
+		if err != nil {
+			return nil, err
+		}
+		deliveries = append(deliveries, *d)
+	}
+	return deliveries, rows.Err()
+}
@@ -0,0 +1,50 @@
+package repository
+
+import (
+	"contoso/models"
+	"contoso/money"
+	"errors"
+	"time"
+)
+
+// ErrTransferIDReused is returned for a transfer whose ID was already used
+// for a transfer between other players or of another amount.
+var ErrTransferIDReused = errors.New("transfer id was already used for a different transfer")
+
+// TransferRepository moves money between players' balances and keeps both
+// legs of every transfer in each player's history. The history outlives
+// the players, so deleting a player keeps the legs of their transfers.
+type TransferRepository interface {
+	// Transfer debits from and credits to by amount in one transaction, as
+	// the transfer with id. It returns ErrInsufficientFunds if from's
+	// balance is too low, ErrCurrencyMismatch if either balance is in
+	// another currency and a *LimitExceededError if the debit or credit
+	// exceeds a limit. If the transfer with id was made already, it is
+	// returned again with created false, or ErrTransferIDReused if it
+	// differs.
+	Transfer(id, from, to string, amount money.Money) (transfer *models.Transfer, created bool, err error)
+	// ListTransfers returns limit legs of playerID's transfers, newest
+	// first, after skipping offset.
+	ListTransfers(playerID string, offset, limit int) ([]models.TransferLeg, error)
+}
+
+// sentLeg is the sender's leg of a stored transfer, which a repeated
+// request for the transfer finds.
+type sentLeg struct {
+	from, to  string
+	amount    money.Money // negative
+	createdAt time.Time
+}
+
+// replay fills in t, the transfer requested again, from s and the current
+// players, returning ErrTransferIDReused unless s moved amount from from
+// to to.
+func (s *sentLeg) replay(t *models.Transfer, from, to string, amount money.Money, players map[string]models.Player) error {
+	if s.from != from || s.to != to || s.amount.Currency != amount.Currency || !s.amount.Amount.Neg().Equal(amount.Amount) {
+		return ErrTransferIDReused
+	}
+	t.CreatedAt = s.createdAt
+	t.From, t.To = players[from], players[to]
+	t.From.ID, t.To.ID = from, to
+	return nil
+}
@@ -0,0 +1,43 @@
+package repository
+
+import (
+	"contoso/models"
+	"contoso/money"
+	"errors"
+)
+
+var (
+	// ErrWalletNotFound is returned when the player has no wallet in the
+	// requested currency.
+	ErrWalletNotFound = errors.New("wallet not found")
+	// ErrWalletExists is returned when opening a wallet in a currency the
+	// player already holds.
+	ErrWalletExists = errors.New("player already has a wallet in this currency")
+	// ErrInsufficientFunds is returned when a debit exceeds the balance.
+	ErrInsufficientFunds = errors.New("insufficient funds")
+)
+
+// checkFunds returns ErrInsufficientFunds if adding change to balance
+// leaves it below zero. Credits are always allowed, even to a balance that
+// is already negative.
+func checkFunds(balance, change money.Amount) error {
+	if change.Sign() < 0 && balance.Add(change).Sign() < 0 {
+		return ErrInsufficientFunds
+	}
+	return nil
+}
+
+// WalletRepository stores the wallets players hold in currencies other than
+// that of their balance, which is their primary wallet.
+type WalletRepository interface {
+	// OpenWallet opens an empty wallet in currency, returning
+	// ErrWalletExists if the player already holds that currency.
+	OpenWallet(playerID, currency string) (*models.Wallet, error)
+	// ListWallets returns the primary wallet followed by the others by
+	// currency.
+	ListWallets(playerID string) ([]models.Wallet, error)
+	// Convert takes debit out of the wallet in its currency and adds credit
+	// to the wallet in its currency in one transaction, and returns both
+	// wallets afterwards.
+	Convert(playerID string, debit, credit money.Money) ([]models.Wallet, error)
+}
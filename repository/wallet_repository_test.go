@@ -0,0 +1,28 @@
+package repository
+
+import (
+	"contoso/money"
+	"errors"
+	"testing"
+)
+
+func TestCheckFunds(t *testing.T) {
+	tests := []struct {
+		balance, change int64
+		want            error
+	}{
+		{balance: 1000, change: 250},
+		{balance: 1000, change: -250},
+		{balance: 1000, change: -1000},
+		{balance: 1000, change: -1001, want: ErrInsufficientFunds},
+		{balance: 0, change: -1, want: ErrInsufficientFunds},
+		{balance: -500, change: 200},
+		{balance: -500, change: -1, want: ErrInsufficientFunds},
+	}
+	for _, tt := range tests {
+		balance, change := money.New(tt.balance, -2), money.New(tt.change, -2)
+		if err := checkFunds(balance, change); !errors.Is(err, tt.want) {
+			t.Errorf("checkFunds(%s, %s) = %v, want %v", balance, change, err, tt.want)
+		}
+	}
+}
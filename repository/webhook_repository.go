@@ -0,0 +1,45 @@
+package repository
+
+import (
+	"contoso/models"
+	"errors"
+	"time"
+)
+
+var (
+	// ErrWebhookNotFound is returned when no webhook matches.
+	ErrWebhookNotFound = errors.New("webhook not found")
+	// ErrDeliveryNotFound is returned when no webhook delivery matches.
+	ErrDeliveryNotFound = errors.New("webhook delivery not found")
+)
+
+// WebhookRepository stores webhook subscriptions and their delivery queue.
+type WebhookRepository interface {
+	CreateWebhook(webhook *models.Webhook) (*models.Webhook, error)
+	GetWebhook(id string) (*models.Webhook, error)
+	ListWebhooks() ([]models.Webhook, error)
+	// UpdateWebhook replaces the URL, events, description and active flag.
+	UpdateWebhook(id string, webhook *models.Webhook) (*models.Webhook, error)
+	// DeleteWebhook removes the webhook and all of its deliveries.
+	DeleteWebhook(id string) error
+
+	// EnqueueDeliveries skips deliveries of an event already queued for
+	// the same webhook.
+	EnqueueDeliveries(deliveries []models.WebhookDelivery) error
+	// ClaimDeliveries returns up to limit pending deliveries that are due,
+	// oldest first, and moves their next attempt lease into the future so
+	// that other instances skip them meanwhile.
+	ClaimDeliveries(limit int, lease time.Duration) ([]models.WebhookDelivery, error)
+	// RecordAttempt appends attempt to the delivery's log and sets its
+	// status and next attempt time (nil unless still pending).
+	RecordAttempt(id string, attempt models.WebhookAttempt, status string, next *time.Time) error
+	GetDelivery(id string) (*models.WebhookDelivery, error)
+	// ListDeliveries returns the newest deliveries first; an empty
+	// webhookID or status matches all.
+	ListDeliveries(webhookID, status string, limit int) ([]models.WebhookDelivery, error)
+	// Redeliver queues a delivery again for immediate delivery.
+	Redeliver(id string) (*models.WebhookDelivery, error)
+	// DeleteSucceededBefore purges the log of deliveries that succeeded
+	// before t and returns how many there were.
+	DeleteSucceededBefore(t time.Time) (int64, error)
+}
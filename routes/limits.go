@@ -0,0 +1,39 @@
+package routes
+
+import (
+	"contoso/middleware"
+	"time"
+)
+
+// DefaultRateLimits are the per-route limits, keyed by route name. They can
+// be overridden per route with the RATE_LIMITS environment variable.
+var DefaultRateLimits = map[string][]middleware.Rate{
+	"players.list":    {{Requests: 300, Period: time.Minute}},
+	"players.get":     {{Requests: 600, Period: time.Minute}},
+	"players.create":  {{Requests: 30, Period: time.Minute}, {Requests: 2000, Period: 24 * time.Hour}},
+	"players.update":  {{Requests: 60, Period: time.Minute}},
+	"players.delete":  {{Requests: 30, Period: time.Minute}},
+	"players.balance": {{Requests: 120, Period: time.Minute}},
+	"players.bulk":    {{Requests: 10, Period: time.Minute}, {Requests: 500, Period: 24 * time.Hour}},
+	"players.export":  {{Requests: 10, Period: time.Minute}},
+	"players.search":  {{Requests: 120, Period: time.Minute}},
+	"players.events":  {{Requests: 30, Period: time.Minute}},
+	"players.import":  {{Requests: 5, Period: time.Minute}, {Requests: 100, Period: 24 * time.Hour}},
+	"players.stats":   {{Requests: 60, Period: time.Minute}},
+	"leaderboard":     {{Requests: 120, Period: time.Minute}},
+	"wallets.open":    {{Requests: 30, Period: time.Minute}},
+	"transfers":       {{Requests: 60, Period: time.Minute}},
+	"webhooks":        {{Requests: 60, Period: time.Minute}},
+}
+
+// RateLimits merges overrides over DefaultRateLimits.
+func RateLimits(overrides map[string][]middleware.Rate) map[string][]middleware.Rate {
+	limits := make(map[string][]middleware.Rate, len(DefaultRateLimits)+len(overrides))
+	for name, rates := range DefaultRateLimits {
+		limits[name] = rates
+	}
+	for name, rates := range overrides {
+		limits[name] = rates
+	}
+	return limits
+}
@@ -1,19 +1,186 @@
 package routes
 
 import (
+	"contoso/auth"
 	"contoso/controllers"
+	"contoso/elasticlog"
+	"contoso/events"
+	"contoso/middleware"
+	"contoso/money"
+	"contoso/rbac"
 	"contoso/repository"
+	"contoso/search"
+	"contoso/tlsconfig"
+	"contoso/webhooks"
+	"time"
+
 	"github.com/gofiber/fiber/v2"
 )
 
+// Dependencies carries what the route handlers and middleware need.
+type Dependencies struct {
+	Players repository.PlayerRepository
+	// Wallets holds the players' wallets, converted between at
+	// ExchangeRates; nil ExchangeRates disables conversions.
+	Wallets       repository.WalletRepository
+	ExchangeRates *money.Rates
+	// Transfers moves money between players and keeps their histories.
+	Transfers repository.TransferRepository
+	// Limits holds players' deposit and loss limits.
+	Limits repository.LimitRepository
+	// Certificates is nil when the server is not serving TLS.
+	Certificates *tlsconfig.CertReloader
+	// RequireClientCert guards the admin endpoints with mutual TLS.
+	RequireClientCert bool
+	// RateLimiter is nil when rate limiting is disabled.
+	RateLimiter *middleware.RateLimiter
+	// Authenticator is nil when authentication is disabled.
+	Authenticator *auth.Authenticator
+	// Enforcer is nil when authentication, and with it RBAC, is disabled.
+	Enforcer *rbac.Enforcer
+	// Idempotency replays responses to retried POSTs; nil disables it.
+	Idempotency *middleware.Idempotency
+	// Search is nil when no Elasticsearch is configured.
+	Search *search.Index
+	// Events streams player changes to live subscribers.
+	Events *events.Broker
+	// Webhooks stores webhook subscriptions and their deliveries, which
+	// WebhookDispatcher queues and sends.
+	Webhooks          repository.WebhookRepository
+	WebhookDispatcher *webhooks.Dispatcher
+	// StatsCacheTTL is how long player statistics are cached.
+	StatsCacheTTL time.Duration
+	// Logger records failures that happen after a response has started.
+	Logger *elasticlog.Logger
+}
+
+// guards are the per-route middleware factories shared by every version.
+type guards struct {
+	limit      func(name string) fiber.Handler
+	allow      func(permission string) fiber.Handler
+	idempotent fiber.Handler
+	authorize  controllers.Authorize
+}
+
 // RegisterRoutesFiber registers API routes on the provided Fiber app
-func RegisterRoutesFiber(app *fiber.App, playerRepo repository.PlayerRepository) {
+func RegisterRoutesFiber(app *fiber.App, deps Dependencies) {
+	g := guards{
+		limit: func(name string) fiber.Handler {
+			if deps.RateLimiter == nil {
+				return func(c *fiber.Ctx) error { return c.Next() }
+			}
+			return deps.RateLimiter.Route(name)
+		},
+		allow: func(permission string) fiber.Handler {
+			if deps.Enforcer == nil {
+				return func(c *fiber.Ctx) error { return c.Next() }
+			}
+			return deps.Enforcer.Require(permission)
+		},
+		idempotent: func(c *fiber.Ctx) error { return c.Next() },
+	}
+	if deps.Idempotency != nil {
+		g.idempotent = deps.Idempotency.Handler()
+	}
+	if deps.Enforcer != nil {
+		g.authorize = deps.Enforcer.Check
+	}
+
 	api := app.Group("/api")
 	api.Get("/ping", controllers.Ping)
+	// Everything registered below requires credentials
+	if deps.Authenticator != nil {
+		api.Use(deps.Authenticator.Middleware())
+	}
+
+	register := map[string]func(fiber.Router, Dependencies, guards){
+		"v1": registerV1,
+		"v2": registerV2,
+	}
+	for _, version := range versions {
+		group := api.Group("/" + version)
+		if d, ok := deprecations[version]; ok {
+			group.Use(middleware.Deprecated(d, deps.Logger))
+		}
+		register[version](group, deps, g)
+	}
+
+	// The routes from before versioning stay available as a deprecated
+	// alias of v1. The alias shares the /api prefix with the versioned
+	// groups, so its middleware has to skip their paths; ping is matched
+	// before it is reached.
+	legacy := middleware.Deprecated(deprecations[""], deps.Logger)
+	api.Use(func(c *fiber.Ctx) error {
+		if versionedPath(c.Path()) {
+			return c.Next()
+		}
+		return legacy(c)
+	})
+	registerV1(api, deps, g)
+}
+
+// registerV1 registers the v1 contract, which uses models.Player.
+func registerV1(r fiber.Router, deps Dependencies, g guards) {
+	r.Get("/me", controllers.GetCurrentPrincipal)
 	// Player CRUD routes
-	api.Get("/players", controllers.GetPlayers(playerRepo))
-	api.Get("/players/:id", controllers.GetPlayer(playerRepo))
-	api.Post("/players", controllers.CreatePlayer(playerRepo))
-	api.Put("/players/:id", controllers.UpdatePlayer(playerRepo))
-	api.Delete("/players/:id", controllers.DeletePlayer(playerRepo))
+	r.Get("/players", g.limit("players.list"), g.allow(rbac.PlayersRead), controllers.GetPlayers(deps.Players))
+	r.Get("/players/search", g.limit("players.search"), g.allow(rbac.PlayersRead), controllers.SearchPlayers(deps.Search))
+	r.Get("/players/export", g.limit("players.export"), g.allow(rbac.PlayersRead), controllers.ExportPlayers(deps.Players, deps.Logger))
+	r.Get("/players/events", g.limit("players.events"), g.allow(rbac.PlayersRead), controllers.PlayerEvents(deps.Events))
+	r.Get("/players/stats", g.limit("players.stats"), g.allow(rbac.PlayersRead), controllers.GetPlayerStats(deps.Players, deps.StatsCacheTTL))
+	r.Get("/players/:id", g.limit("players.get"), g.allow(rbac.PlayersRead), controllers.GetPlayer(deps.Players))
+	r.Post("/players/import", g.limit("players.import"), g.allow(rbac.PlayersWrite), g.idempotent, controllers.ImportPlayers(deps.Players, g.authorize))
+	r.Post("/players/bulk", g.limit("players.bulk"), g.allow(rbac.PlayersWrite), g.idempotent, controllers.BulkPlayers(deps.Players, g.authorize))
+	r.Post("/players", g.limit("players.create"), g.allow(rbac.PlayersWrite), g.idempotent, controllers.CreatePlayer(deps.Players, g.authorize))
+	r.Put("/players/:id", g.limit("players.update"), g.allow(rbac.PlayersWrite), controllers.UpdatePlayer(deps.Players))
+	r.Patch("/players/:id", g.limit("players.update"), g.allow(rbac.PlayersWrite), controllers.PatchPlayer(deps.Players))
+	r.Delete("/players/:id", g.limit("players.delete"), g.allow(rbac.PlayersDelete), controllers.DeletePlayer(deps.Players))
+	r.Get("/players/:id/rank", g.limit("players.get"), g.allow(rbac.PlayersRead), controllers.GetPlayerRank(deps.Players))
+	r.Post("/players/:id/balance", g.limit("players.balance"), g.allow(rbac.PlayersBalance), g.idempotent, controllers.AdjustBalance(deps.Players))
+	r.Post("/players/:id/status", g.limit("players.update"), g.allow(rbac.PlayersStatus), controllers.SetPlayerStatus(deps.Players))
+	r.Get("/players/:id/wallets", g.limit("players.get"), g.allow(rbac.PlayersRead), controllers.ListWallets(deps.Wallets))
+	r.Post("/players/:id/wallets", g.limit("wallets.open"), g.allow(rbac.PlayersBalance), g.idempotent, controllers.OpenWallet(deps.Wallets))
+	r.Post("/players/:id/wallets/convert", g.limit("players.balance"), g.allow(rbac.PlayersBalance), g.idempotent,
+		controllers.ConvertCurrency(deps.Wallets, deps.ExchangeRates))
+	r.Get("/players/:id/limits", g.limit("players.get"), g.allow(rbac.PlayersRead), controllers.ListLimits(deps.Limits))
+	r.Put("/players/:id/limits/:kind/:period", g.limit("players.update"), g.allow(rbac.PlayersBalance), controllers.SetLimit(deps.Limits))
+	r.Delete("/players/:id/limits/:kind/:period", g.limit("players.update"), g.allow(rbac.PlayersBalance), controllers.RemoveLimit(deps.Limits))
+	r.Get("/players/:id/transfers", g.limit("players.get"), g.allow(rbac.PlayersRead), controllers.ListPlayerTransfers(deps.Transfers))
+	r.Post("/transfers", g.limit("transfers"), g.allow(rbac.PlayersBalance), g.idempotent, controllers.CreateTransfer(deps.Transfers))
+	r.Get("/leaderboard", g.limit("leaderboard"), g.allow(rbac.PlayersRead), controllers.GetLeaderboard(deps.Players))
+
+	// Webhook routes
+	hooks := r.Group("/webhooks", g.limit("webhooks"), g.allow(rbac.WebhooksManage))
+	hooks.Get("/", controllers.ListWebhooks(deps.Webhooks))
+	hooks.Post("/", controllers.CreateWebhook(deps.Webhooks, deps.WebhookDispatcher))
+	hooks.Get("/dead-letters", controllers.ListDeadLetters(deps.Webhooks))
+	hooks.Get("/deliveries/:id", controllers.GetWebhookDelivery(deps.Webhooks))
+	hooks.Post("/deliveries/:id/redeliver", controllers.RedeliverWebhookDelivery(deps.Webhooks))
+	hooks.Get("/:id", controllers.GetWebhook(deps.Webhooks))
+	hooks.Put("/:id", controllers.UpdateWebhook(deps.Webhooks, deps.WebhookDispatcher))
+	hooks.Delete("/:id", controllers.DeleteWebhook(deps.Webhooks, deps.WebhookDispatcher))
+	hooks.Post("/:id/test", controllers.TestWebhook(deps.Webhooks, deps.WebhookDispatcher))
+	hooks.Get("/:id/deliveries", controllers.ListWebhookDeliveries(deps.Webhooks))
+
+	// Admin routes
+	admin := r.Group("/admin")
+	if deps.RequireClientCert {
+		admin.Use(middleware.RequireClientCert())
+	}
+	admin.Use(g.allow(rbac.AdminAccess))
+	admin.Get("/tls", controllers.GetTLSStatus(deps.Certificates))
+}
+
+// registerV2 registers the v2 contract, which uses models.PlayerV2. Bulk,
+// export, import, statistics, leaderboard, status, wallet, transfer, limit,
+// webhook and admin routes are only available in v1 so far.
+func registerV2(r fiber.Router, deps Dependencies, g guards) {
+	r.Get("/me", controllers.GetCurrentPrincipal)
+	r.Get("/players", g.limit("players.list"), g.allow(rbac.PlayersRead), controllers.GetPlayersV2(deps.Players))
+	r.Get("/players/:id", g.limit("players.get"), g.allow(rbac.PlayersRead), controllers.GetPlayerV2(deps.Players))
+	r.Post("/players", g.limit("players.create"), g.allow(rbac.PlayersWrite), g.idempotent, controllers.CreatePlayerV2(deps.Players, g.authorize))
+	r.Put("/players/:id", g.limit("players.update"), g.allow(rbac.PlayersWrite), controllers.UpdatePlayerV2(deps.Players))
+	r.Patch("/players/:id", g.limit("players.update"), g.allow(rbac.PlayersWrite), controllers.PatchPlayerV2(deps.Players))
+	r.Delete("/players/:id", g.limit("players.delete"), g.allow(rbac.PlayersDelete), controllers.DeletePlayer(deps.Players))
+	r.Post("/players/:id/balance", g.limit("players.balance"), g.allow(rbac.PlayersBalance), g.idempotent, controllers.AdjustBalanceV2(deps.Players))
 }
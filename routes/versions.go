@@ -0,0 +1,31 @@
+package routes
+
+import (
+	"contoso/middleware"
+	"strings"
+	"time"
+)
+
+// versions are the mounted API versions, each under /api/<version>.
+var versions = []string{"v1", "v2"}
+
+// deprecations marks API versions as deprecated, keyed by version; "" is
+// the unversioned /api alias. Adding "v1" here starts its retirement.
+var deprecations = map[string]middleware.Deprecation{
+	"": {
+		Since:     time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC),
+		Sunset:    time.Date(2027, 4, 30, 0, 0, 0, 0, time.UTC),
+		Successor: "/api/v1",
+	},
+}
+
+// versionedPath reports whether path is under one of the mounted versions.
+func versionedPath(path string) bool {
+	for _, v := range versions {
+		prefix := "/api/" + v
+		if path == prefix || strings.HasPrefix(path, prefix+"/") {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,208 @@
+// Package search keeps a players index in Elasticsearch in step with the
+// database and runs full-text queries against it.
+package search
+
+import (
+	"bytes"
+	"context"
+	"contoso/models"
+	"contoso/money"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/elastic/go-elasticsearch/v9"
+	"github.com/elastic/go-elasticsearch/v9/esapi"
+)
+
+// requestTimeout bounds single-document and query requests.
+const requestTimeout = 5 * time.Second
+
+// indexSettings folds case and accents so "zoe" finds "Zoë", and maps the
+// names as search_as_you_type for prefix matching.
+const indexSettings = `{
+  "settings": {
+    "analysis": {
+      "analyzer": {
+        "folding": {"tokenizer": "standard", "filter": ["lowercase", "asciifolding"]}
+      }
+    }
+  },
+  "mappings": {
+    "dynamic": "strict",
+    "properties": {
+      "name": {"type": "search_as_you_type", "analyzer": "folding"},
+      "surname": {"type": "search_as_you_type", "analyzer": "folding"},
+      "balance": {"type": "double"},
+      "currency": {"type": "keyword"},
+      "status": {"type": "keyword"}
+    }
+  }
+}`
+
+// document is the indexed form of a player; the player ID is the document
+// ID. The balance is sent as its decimal string, which Elasticsearch
+// indexes as a double but keeps exact in _source.
+type document struct {
+	Name     string       `json:"name"`
+	Surname  string       `json:"surname"`
+	Balance  money.Amount `json:"balance"`
+	Currency string       `json:"currency"`
+	Status   string       `json:"status"`
+}
+
+// Index is the players index. Alias names the index clients use; the
+// concrete index behind it is replaced on every reindex.
+type Index struct {
+	es    *elasticsearch.Client
+	alias string
+}
+
+// NewClient connects to Elasticsearch at url.
+func NewClient(url, username, password string) (*elasticsearch.Client, error) {
+	return elasticsearch.NewClient(elasticsearch.Config{
+		Addresses: []string{url},
+		Username:  username,
+		Password:  password,
+	})
+}
+
+// NewIndex returns the players index behind alias.
+func NewIndex(es *elasticsearch.Client, alias string) *Index {
+	return &Index{es: es, alias: alias}
+}
+
+// Ensure creates the first concrete index and its alias when the alias
+// does not exist yet.
+func (ix *Index) Ensure() error {
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	defer cancel()
+	res, err := ix.es.Indices.ExistsAlias([]string{ix.alias}, ix.es.Indices.ExistsAlias.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+	res.Body.Close()
+	if res.StatusCode == http.StatusOK {
+		return nil
+	}
+	name, err := ix.createIndex(ctx)
+	if err != nil {
+		return err
+	}
+	return ix.swapAlias(ctx, name, nil)
+}
+
+// Put adds or replaces a player.
+func (ix *Index) Put(player *models.Player) error {
+	body, err := json.Marshal(newDocument(player))
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	defer cancel()
+	return check(ix.es.Index(ix.alias, bytes.NewReader(body),
+		ix.es.Index.WithDocumentID(player.ID),
+		// Never let a write auto-create a plain index under the alias name
+		ix.es.Index.WithRequireAlias(true),
+		ix.es.Index.WithContext(ctx),
+	))
+}
+
+// Delete removes a player; deleting a missing player is not an error.
+func (ix *Index) Delete(id string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	defer cancel()
+	res, err := ix.es.Delete(ix.alias, id, ix.es.Delete.WithContext(ctx))
+	if err == nil && res.StatusCode == http.StatusNotFound {
+		res.Body.Close()
+		return nil
+	}
+	return check(res, err)
+}
+
+// Apply puts and deletes many players with one bulk request.
+func (ix *Index) Apply(puts []models.Player, deletes []string) error {
+	if len(puts) == 0 && len(deletes) == 0 {
+		return nil
+	}
+	var body bytes.Buffer
+	enc := json.NewEncoder(&body)
+	for i := range puts {
+		_ = enc.Encode(map[string]interface{}{"index": map[string]string{"_id": puts[i].ID}})
+		_ = enc.Encode(newDocument(&puts[i]))
+	}
+	for _, id := range deletes {
+		_ = enc.Encode(map[string]interface{}{"delete": map[string]string{"_id": id}})
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	defer cancel()
+	res, err := ix.es.Bulk(&body,
+		ix.es.Bulk.WithIndex(ix.alias),
+		ix.es.Bulk.WithRequireAlias(true),
+		ix.es.Bulk.WithContext(ctx),
+	)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	if res.IsError() {
+		return check(res, nil)
+	}
+	// Bulk requests succeed as a whole even when items fail
+	var result struct {
+		Errors bool `json:"errors"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&result); err != nil {
+		return err
+	}
+	if result.Errors {
+		return fmt.Errorf("elasticsearch: some bulk items failed")
+	}
+	return nil
+}
+
+// createIndex creates a new concrete index for the alias.
+func (ix *Index) createIndex(ctx context.Context) (string, error) {
+	name := ix.alias + "-" + time.Now().UTC().Format("20060102150405")
+	err := check(ix.es.Indices.Create(name,
+		ix.es.Indices.Create.WithBody(strings.NewReader(indexSettings)),
+		ix.es.Indices.Create.WithContext(ctx),
+	))
+	return name, err
+}
+
+// swapAlias points the alias at name and deletes the indices in old, in
+// one atomic request.
+func (ix *Index) swapAlias(ctx context.Context, name string, old []string) error {
+	actions := []map[string]interface{}{
+		{"add": map[string]interface{}{"index": name, "alias": ix.alias, "is_write_index": true}},
+	}
+	for _, o := range old {
+		actions = append(actions, map[string]interface{}{"remove_index": map[string]string{"index": o}})
+	}
+	body, err := json.Marshal(map[string]interface{}{"actions": actions})
+	if err != nil {
+		return err
+	}
+	return check(ix.es.Indices.UpdateAliases(bytes.NewReader(body), ix.es.Indices.UpdateAliases.WithContext(ctx)))
+}
+
+func newDocument(p *models.Player) document {
+	return document{Name: p.Name, Surname: p.Surname, Balance: p.Balance.Amount, Currency: p.Balance.Currency, Status: p.Status}
+}
+
+// check closes a response and turns error statuses into errors.
+func check(res *esapi.Response, err error) error {
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	if res.IsError() {
+		msg, _ := io.ReadAll(io.LimitReader(res.Body, 512))
+		return fmt.Errorf("elasticsearch: %s: %s", res.Status(), msg)
+	}
+	return nil
+}
@@ -0,0 +1,117 @@
+package search
+
+import (
+	"bytes"
+	"context"
+	"contoso/models"
+	"contoso/money"
+	"encoding/json"
+	"errors"
+)
+
+// MaxWindow is the deepest result Elasticsearch returns by default
+// (index.max_result_window); pages beyond it are rejected.
+const MaxWindow = 10000
+
+// ErrWindowTooLarge is returned for pages past MaxWindow.
+var ErrWindowTooLarge = errors.New("page is beyond the first 10000 results")
+
+// Query is a full-text player search. Page counts from 1.
+type Query struct {
+	Text string
+	Page int
+	Size int
+}
+
+// Hit is one matching player. Highlight maps a field to fragments with
+// the matched terms wrapped in <em>.
+type Hit struct {
+	Player    models.Player       `json:"player"`
+	Score     float64             `json:"score"`
+	Highlight map[string][]string `json:"highlight,omitempty"`
+}
+
+// Result is one page of hits, best match first.
+type Result struct {
+	Total int64 `json:"total"`
+	Page  int   `json:"page"`
+	Size  int   `json:"size"`
+	Hits  []Hit `json:"hits"`
+}
+
+// Search matches q.Text against names and surnames. Terms match as
+// prefixes and tolerate typos ("jhon smi" finds "John Smith").
+func (ix *Index) Search(q Query) (*Result, error) {
+	from := (q.Page - 1) * q.Size
+	if from+q.Size > MaxWindow {
+		return nil, ErrWindowTooLarge
+	}
+	body, err := json.Marshal(map[string]interface{}{
+		"from":             from,
+		"size":             q.Size,
+		"track_total_hits": true,
+		"query": map[string]interface{}{
+			"multi_match": map[string]interface{}{
+				"query": q.Text,
+				"type":  "bool_prefix",
+				"fields": []string{
+					"name", "name._2gram", "name._3gram",
+					"surname", "surname._2gram", "surname._3gram",
+				},
+				"fuzziness": "AUTO",
+				"operator":  "and",
+			},
+		},
+		"highlight": map[string]interface{}{
+			"fields": map[string]interface{}{"name": struct{}{}, "surname": struct{}{}},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	defer cancel()
+	res, err := ix.es.Search(
+		ix.es.Search.WithIndex(ix.alias),
+		ix.es.Search.WithBody(bytes.NewReader(body)),
+		ix.es.Search.WithContext(ctx),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	if res.IsError() {
+		return nil, check(res, nil)
+	}
+	var raw struct {
+		Hits struct {
+			Total struct {
+				Value int64 `json:"value"`
+			} `json:"total"`
+			Hits []struct {
+				ID        string              `json:"_id"`
+				Score     float64             `json:"_score"`
+				Source    document            `json:"_source"`
+				Highlight map[string][]string `json:"highlight"`
+			} `json:"hits"`
+		} `json:"hits"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&raw); err != nil {
+		return nil, err
+	}
+	result := &Result{Total: raw.Hits.Total.Value, Page: q.Page, Size: q.Size, Hits: []Hit{}}
+	for _, h := range raw.Hits.Hits {
+		result.Hits = append(result.Hits, Hit{
+			Player: models.Player{
+				ID:      h.ID,
+				Name:    h.Source.Name,
+				Surname: h.Source.Surname,
+				Balance: money.Money{Amount: h.Source.Balance, Currency: h.Source.Currency},
+				Status:  h.Source.Status,
+			},
+			Score:     h.Score,
+			Highlight: h.Highlight,
+		})
+	}
+	return result, nil
+}
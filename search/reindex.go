@@ -0,0 +1,103 @@
+package search
+
+import (
+	"bytes"
+	"context"
+	"contoso/models"
+	"contoso/repository"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync/atomic"
+	"time"
+
+	"github.com/elastic/go-elasticsearch/v9/esutil"
+)
+
+// reindexTimeout bounds a full rebuild.
+const reindexTimeout = 30 * time.Minute
+
+// Reindex rebuilds the index from repo into a new concrete index and then
+// switches the alias to it, so searches keep working throughout. Writes
+// made while it runs land in the old index and are lost with it; run it
+// when writes are quiet, or run it again.
+func (ix *Index) Reindex(repo repository.PlayerRepository) (int64, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), reindexTimeout)
+	defer cancel()
+	old, err := ix.concreteIndices(ctx)
+	if err != nil {
+		return 0, err
+	}
+	name, err := ix.createIndex(ctx)
+	if err != nil {
+		return 0, err
+	}
+	bi, err := esutil.NewBulkIndexer(esutil.BulkIndexerConfig{
+		Client: ix.es,
+		Index:  name,
+	})
+	if err != nil {
+		return 0, err
+	}
+	var failed atomic.Int64
+	err = repo.StreamPlayers(func(p *models.Player) error {
+		body, err := json.Marshal(newDocument(p))
+		if err != nil {
+			return err
+		}
+		return bi.Add(ctx, esutil.BulkIndexerItem{
+			Action:     "index",
+			DocumentID: p.ID,
+			Body:       bytes.NewReader(body),
+			OnFailure: func(context.Context, esutil.BulkIndexerItem, esutil.BulkIndexerResponseItem, error) {
+				failed.Add(1)
+			},
+		})
+	})
+	if cerr := bi.Close(ctx); err == nil {
+		err = cerr
+	}
+	if err == nil && failed.Load() > 0 {
+		err = fmt.Errorf("%d players could not be indexed", failed.Load())
+	}
+	if err != nil {
+		// Leave the alias alone and drop the partial index
+		_ = check(ix.es.Indices.Delete([]string{name}, ix.es.Indices.Delete.WithContext(ctx)))
+		return 0, err
+	}
+	if err := check(ix.es.Indices.Refresh(ix.es.Indices.Refresh.WithIndex(name), ix.es.Indices.Refresh.WithContext(ctx))); err != nil {
+		return 0, err
+	}
+	if err := ix.swapAlias(ctx, name, old); err != nil {
+		return 0, err
+	}
+	return int64(bi.Stats().NumIndexed), nil
+}
+
+// concreteIndices lists the indices the alias points at; none if it does
+// not exist yet.
+func (ix *Index) concreteIndices(ctx context.Context) ([]string, error) {
+	res, err := ix.es.Indices.GetAlias(
+		ix.es.Indices.GetAlias.WithName(ix.alias),
+		ix.es.Indices.GetAlias.WithContext(ctx),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	if res.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	if res.IsError() {
+		return nil, check(res, nil)
+	}
+	var indices map[string]json.RawMessage
+	if err := json.NewDecoder(res.Body).Decode(&indices); err != nil {
+		return nil, err
+	}
+	names := make([]string, 0, len(indices))
+	for name := range indices {
+		names = append(names, name)
+	}
+	return names, nil
+}
@@ -0,0 +1,141 @@
+package search
+
+import (
+	"contoso/elasticlog"
+	"contoso/models"
+	"contoso/money"
+	"contoso/repository"
+)
+
+// SyncedRepository updates the index after every successful write made
+// through the wrapped repository. Index failures are logged rather than
+// returned, since the write itself succeeded; `reindex` repairs drift.
+type SyncedRepository struct {
+	repository.PlayerRepository
+	index  *Index
+	logger *elasticlog.Logger
+}
+
+// NewSyncedRepository wraps repo so writes are mirrored into index.
+func NewSyncedRepository(repo repository.PlayerRepository, index *Index, logger *elasticlog.Logger) *SyncedRepository {
+	return &SyncedRepository{PlayerRepository: repo, index: index, logger: logger}
+}
+
+func (r *SyncedRepository) CreatePlayer(player *models.Player) (*models.Player, error) {
+	return r.put(r.PlayerRepository.CreatePlayer(player))
+}
+
+func (r *SyncedRepository) UpdatePlayer(id string, player *models.Player) (*models.Player, error) {
+	return r.put(r.PlayerRepository.UpdatePlayer(id, player))
+}
+
+func (r *SyncedRepository) PatchPlayer(id string, expected *models.Player, patch *models.PlayerPatch) (*models.Player, error) {
+	return r.put(r.PlayerRepository.PatchPlayer(id, expected, patch))
+}
+
+func (r *SyncedRepository) AdjustBalance(id string, amount money.Money) (*models.Player, error) {
+	return r.put(r.PlayerRepository.AdjustBalance(id, amount))
+}
+
+func (r *SyncedRepository) SetStatus(id string, change models.StatusChange) (*models.Player, error) {
+	return r.put(r.PlayerRepository.SetStatus(id, change))
+}
+
+func (r *SyncedRepository) DeletePlayer(id string) error {
+	if err := r.PlayerRepository.DeletePlayer(id); err != nil {
+		return err
+	}
+	if err := r.index.Delete(id); err != nil {
+		r.warn(err, id)
+	}
+	return nil
+}
+
+func (r *SyncedRepository) BulkWrite(ops []models.BulkOperation, atomic bool) ([]repository.BulkItemResult, error) {
+	results, err := r.PlayerRepository.BulkWrite(ops, atomic)
+	if err != nil {
+		return results, err
+	}
+	var puts []models.Player
+	var deletes []string
+	for i, res := range results {
+		switch {
+		case res.Err != nil:
+		case ops[i].Op == models.BulkDelete:
+			deletes = append(deletes, ops[i].ID)
+		case res.Player != nil:
+			puts = append(puts, *res.Player)
+		}
+	}
+	if err := r.index.Apply(puts, deletes); err != nil {
+		r.warn(err, "")
+	}
+	return results, nil
+}
+
+func (r *SyncedRepository) put(player *models.Player, err error) (*models.Player, error) {
+	if err != nil {
+		return nil, err
+	}
+	if err := r.index.Put(player); err != nil {
+		r.warn(err, player.ID)
+	}
+	return player, nil
+}
+
+func (r *SyncedRepository) warn(err error, id string) {
+	fields := map[string]interface{}{"error": err.Error()}
+	if id != "" {
+		fields["playerId"] = id
+	}
+	r.logger.Warn("Search index update failed", fields)
+}
+
+// SyncedWalletRepository re-indexes the player after conversions into or
+// out of their primary wallet, which is the indexed balance.
+type SyncedWalletRepository struct {
+	repository.WalletRepository
+	players *SyncedRepository
+}
+
+// NewSyncedWalletRepository wraps repo so balance changes reach the index
+// of players.
+func NewSyncedWalletRepository(repo repository.WalletRepository, players *SyncedRepository) *SyncedWalletRepository {
+	return &SyncedWalletRepository{WalletRepository: repo, players: players}
+}
+
+func (r *SyncedWalletRepository) Convert(playerID string, debit, credit money.Money) ([]models.Wallet, error) {
+	wallets, err := r.WalletRepository.Convert(playerID, debit, credit)
+	if err != nil {
+		return nil, err
+	}
+	for _, w := range wallets {
+		if w.Primary {
+			r.players.put(r.players.PlayerRepository.GetPlayer(playerID))
+			break
+		}
+	}
+	return wallets, nil
+}
+
+// SyncedTransferRepository re-indexes both players of every transfer.
+type SyncedTransferRepository struct {
+	repository.TransferRepository
+	players *SyncedRepository
+}
+
+// NewSyncedTransferRepository wraps repo so balance changes reach the index
+// of players.
+func NewSyncedTransferRepository(repo repository.TransferRepository, players *SyncedRepository) *SyncedTransferRepository {
+	return &SyncedTransferRepository{TransferRepository: repo, players: players}
+}
+
+func (r *SyncedTransferRepository) Transfer(id, from, to string, amount money.Money) (*models.Transfer, bool, error) {
+	t, created, err := r.TransferRepository.Transfer(id, from, to, amount)
+	if err != nil || !created {
+		return t, created, err
+	}
+	r.players.put(&t.From, nil)
+	r.players.put(&t.To, nil)
+	return t, created, nil
+}
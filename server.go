@@ -0,0 +1,281 @@
+package main
+
+import (
+	"context"
+	"contoso/auth"
+	"contoso/config"
+	_ "contoso/docs" // swaggo docs
+	"contoso/elasticlog"
+	"contoso/middleware"
+	"contoso/money"
+	"contoso/problem"
+	"contoso/rbac"
+	"contoso/repository"
+	"contoso/routes"
+	"contoso/tlsconfig"
+	"flag"
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/middleware/filesystem"
+	logger2 "github.com/gofiber/fiber/v2/middleware/logger"
+	"github.com/gofiber/fiber/v2/middleware/requestid"
+	"github.com/swaggo/fiber-swagger"
+	"net/http"
+	"os"
+	"time"
+)
+
+type elasticErrorWriter struct {
+	logger *elasticlog.Logger
+}
+
+type elasticInfoWriter struct {
+	logger *elasticlog.Logger
+}
+
+func (w *elasticErrorWriter) Write(p []byte) (n int, err error) {
+	n, err = os.Stderr.Write(p)
+	w.logger.Error(string(p), nil)
+	return n, err
+}
+
+func (w *elasticInfoWriter) Write(p []byte) (n int, err error) {
+	n, err = os.Stderr.Write(p)
+	w.logger.Info(string(p), nil)
+	return n, err
+}
+
+func startBackgroundService(logger *elasticlog.Logger) {
+	go func() {
+		for {
+			logger.Info("Background service heartbeat", map[string]interface{}{
+				"event": "heartbeat",
+				"time":  time.Now().Format(time.RFC3339),
+			})
+			time.Sleep(1 * time.Minute)
+		}
+	}()
+}
+
+// startIdempotencyPurge deletes expired idempotency records every hour.
+// MongoDB also expires them with a TTL index; Postgres relies on this.
+func startIdempotencyPurge(repo repository.IdempotencyRepository, logger *elasticlog.Logger) {
+	go func() {
+		for range time.Tick(time.Hour) {
+			n, err := repo.DeleteExpired()
+			if err != nil {
+				logger.Warn("Idempotency purge failed", map[string]interface{}{"error": err.Error()})
+				continue
+			}
+			if n > 0 {
+				logger.Debug("Idempotency records purged", map[string]interface{}{"count": n})
+			}
+		}
+	}()
+}
+
+// runServe starts the HTTP API and frontend server.
+func runServe(args []string) error {
+	fs := flag.NewFlagSet("serve", flag.ExitOnError)
+	cfg := config.Load()
+	fs.StringVar(&cfg.Addr, "addr", cfg.Addr, "listen address")
+	assetsFromDisk := fs.Bool("assets-from-disk", false, "serve public/ and docs/ from the working directory instead of the embedded copies")
+	_ = fs.Parse(args)
+
+	logger := newLogger(cfg)
+
+	// Start background service
+	startBackgroundService(logger)
+
+	logger.Info("Contoso backend started", map[string]interface{}{
+		"event": "startup",
+	})
+
+	// Choose repository based on configuration
+	backend := openBackend(cfg, logger)
+	if applied, err := backend.migrate(cfg); err != nil {
+		logger.Error("Database migration failed", map[string]interface{}{"error": err.Error()})
+		return err
+	} else if len(applied) > 0 {
+		logger.Info("Database migrations applied", map[string]interface{}{"migrations": applied})
+	}
+	startIdempotencyPurge(backend.idempotency, logger)
+	go backend.relay.Run(context.Background())
+	go backend.dispatcher.Run(context.Background())
+
+	app := fiber.New(fiber.Config{
+		// Handlers return errors; unknown ones are logged and answered with a
+		// generic problem so internal messages never reach clients.
+		ErrorHandler: func(c *fiber.Ctx, err error) error {
+			p, internal := problem.FromError(err)
+			if internal {
+				logger.Error("Fiber error", map[string]interface{}{
+					"error":     err.Error(),
+					"path":      c.Path(),
+					"method":    c.Method(),
+					"requestId": problem.RequestID(c),
+				})
+			}
+			return problem.Write(c, p)
+		},
+	})
+
+	// Tag every request with an ID, echoed in X-Request-ID and problem responses
+	app.Use(requestid.New())
+
+	// Add Fiber's logger middleware for endpoint and info logging, logging to both console and elastic
+	app.Use(logger2.New(logger2.Config{
+		Format:     "[${time}] ${status} - ${latency} ${method} ${path} ${locals:requestid}\n",
+		TimeFormat: time.RFC3339,
+		Output:     &elasticInfoWriter{logger: logger}, // log to both console and elastic
+	}))
+
+	app.Use(func(c *fiber.Ctx) error {
+		start := time.Now()
+		err := c.Next()
+		latency := time.Since(start)
+		status := c.Response().StatusCode()
+		entry := map[string]interface{}{
+			"method":    c.Method(),
+			"path":      c.Path(),
+			"status":    status,
+			"latency":   latency.String(),
+			"client":    c.IP(),
+			"requestId": problem.RequestID(c),
+		}
+		if p := auth.PrincipalFrom(c); p != nil {
+			entry["principal"] = p.Subject
+			entry["principalKind"] = p.Kind
+		}
+		switch {
+		case status >= 500:
+			logger.Error("HTTP request", entry)
+		case status >= 400:
+			logger.Warn("HTTP request", entry)
+		default:
+			logger.Info("HTTP request", entry)
+		}
+		return err
+	})
+
+	// Pass the repository to the routes/controllers
+	var certs *tlsconfig.CertReloader
+	if cfg.TLSEnabled() {
+		var err error
+		certs, err = tlsconfig.NewCertReloader(cfg.TLSCertFile, cfg.TLSKeyFile)
+		if err != nil {
+			logger.Error("Failed to load TLS certificate", map[string]interface{}{"error": err.Error()})
+			return err
+		}
+		go certs.Watch(cfg.TLSReloadInterval, nil, func(err error) {
+			if err != nil {
+				logger.Error("TLS certificate reload failed", map[string]interface{}{"error": err.Error()})
+				return
+			}
+			leaf, _ := certs.Leaf()
+			logger.Info("TLS certificate reloaded", map[string]interface{}{
+				"subject":  leaf.Subject.String(),
+				"notAfter": leaf.NotAfter.Format(time.RFC3339),
+			})
+		})
+	}
+	var limiter *middleware.RateLimiter
+	if cfg.RateLimitEnabled {
+		overrides, err := middleware.ParseRates(cfg.RateLimits)
+		if err != nil {
+			logger.Error("Invalid RATE_LIMITS", map[string]interface{}{"error": err.Error()})
+			return err
+		}
+		limiter = middleware.NewRateLimiter(routes.RateLimits(overrides), logger)
+	}
+	var authenticator *auth.Authenticator
+	var enforcer *rbac.Enforcer
+	if cfg.AuthEnabled {
+		var verifier *auth.JWTVerifier
+		if cfg.JWTHMACSecret != "" || cfg.JWTJWKSFile != "" {
+			var err error
+			verifier, err = auth.NewJWTVerifier(cfg.JWTHMACSecret, cfg.JWTJWKSFile, cfg.JWTIssuer, cfg.JWTAudience)
+			if err != nil {
+				logger.Error("Invalid JWT configuration", map[string]interface{}{"error": err.Error()})
+				return err
+			}
+		}
+		authenticator = auth.NewAuthenticator(backend.apiKeys, verifier, logger)
+
+		policy := rbac.DefaultPolicy
+		if cfg.RBACPolicyFile != "" {
+			var err error
+			policy, err = rbac.LoadPolicy(cfg.RBACPolicyFile)
+			if err != nil {
+				logger.Error("Invalid RBAC policy", map[string]interface{}{"error": err.Error()})
+				return err
+			}
+		}
+		enforcer = rbac.NewEnforcer(policy, logger)
+	} else {
+		logger.Warn("Authentication is disabled; the API is open to anyone who can reach it", nil)
+	}
+	var rates *money.Rates
+	if cfg.ExchangeRatesFile != "" {
+		var err error
+		rates, err = money.LoadRates(cfg.ExchangeRatesFile)
+		if err != nil {
+			logger.Error("Invalid exchange rates", map[string]interface{}{"error": err.Error()})
+			return err
+		}
+	}
+	routes.RegisterRoutesFiber(app, routes.Dependencies{
+		Players:           backend.players,
+		Wallets:           backend.wallets,
+		ExchangeRates:     rates,
+		Transfers:         backend.transfers,
+		Limits:            backend.limits,
+		Certificates:      certs,
+		RequireClientCert: certs != nil && cfg.TLSClientCAFile != "",
+		RateLimiter:       limiter,
+		Authenticator:     authenticator,
+		Enforcer:          enforcer,
+		Idempotency:       middleware.NewIdempotency(backend.idempotency, cfg.IdempotencyTTL, logger),
+		Search:            backend.search,
+		Events:            backend.broker,
+		Webhooks:          backend.webhooks,
+		WebhookDispatcher: backend.dispatcher,
+		StatsCacheTTL:     cfg.StatsCacheTTL,
+		Logger:            logger,
+	})
+
+	// Serve static files for frontend
+	assets := loadAssets(*assetsFromDisk)
+	publicFS := http.FS(subAssets(assets, "public"))
+	app.Use("/assets", filesystem.New(filesystem.Config{
+		Root: http.FS(subAssets(assets, "public/assets")),
+	}))
+
+	// Serve Swagger UI at /swagger/*
+	app.Get("/swagger/*", fiberSwagger.WrapHandler)
+
+	// Serve raw swagger.json for ReDoc and other tools
+	app.Get("/swagger/doc.json", func(c *fiber.Ctx) error {
+		return filesystem.SendFile(c, http.FS(assets), "docs/swagger.json")
+	})
+
+	// Serve ReDoc at /redoc, with its bundle at /redoc/redoc.standalone.js
+	redocFS := http.FS(subAssets(assets, "docs/redoc"))
+	app.Get("/redoc", func(c *fiber.Ctx) error {
+		return filesystem.SendFile(c, redocFS, "index.html")
+	})
+	app.Use("/redoc", filesystem.New(filesystem.Config{Root: redocFS}))
+
+	// Serve index.html for non-API routes (SPA fallback)
+	app.Use(func(c *fiber.Ctx) error {
+		if len(c.Path()) >= 4 && c.Path()[:4] == "/api" {
+			return problem.Respond(c, fiber.StatusNotFound, problem.CodeNotFound, "no route matches "+c.Path())
+		}
+		return filesystem.SendFile(c, publicFS, "index.html")
+	})
+
+	err := listen(app, cfg, certs, logger)
+	if err != nil {
+		logger.Error("Failed to start server", map[string]interface{}{"error": err.Error()})
+	}
+	return err
+}
@@ -0,0 +1,135 @@
+// Package tlsconfig builds the server TLS configuration and keeps the
+// certificate current when the files on disk are rotated.
+package tlsconfig
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+)
+
+// CertReloader serves the most recently loaded key pair and reloads it when
+// the certificate or key file changes, e.g. after cert-manager renews them.
+type CertReloader struct {
+	certFile string
+	keyFile  string
+
+	mu       sync.RWMutex
+	cert     *tls.Certificate
+	leaf     *x509.Certificate
+	stamp    string
+	loadedAt time.Time
+}
+
+// NewCertReloader loads the key pair once and fails if it is invalid.
+func NewCertReloader(certFile, keyFile string) (*CertReloader, error) {
+	r := &CertReloader{certFile: certFile, keyFile: keyFile}
+	if _, err := r.Reload(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// GetCertificate is suitable for tls.Config.GetCertificate.
+func (r *CertReloader) GetCertificate(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.cert, nil
+}
+
+// Leaf returns the parsed serving certificate and when it was loaded.
+func (r *CertReloader) Leaf() (*x509.Certificate, time.Time) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.leaf, r.loadedAt
+}
+
+// Reload re-reads the key pair if either file changed since the last load.
+// It reports whether a new certificate was installed; on error the
+// previous certificate stays in use.
+func (r *CertReloader) Reload() (bool, error) {
+	stamp, err := r.fileStamp()
+	if err != nil {
+		return false, err
+	}
+	r.mu.RLock()
+	unchanged := stamp == r.stamp
+	r.mu.RUnlock()
+	if unchanged {
+		return false, nil
+	}
+	cert, err := tls.LoadX509KeyPair(r.certFile, r.keyFile)
+	if err != nil {
+		return false, err
+	}
+	leaf, err := x509.ParseCertificate(cert.Certificate[0])
+	if err != nil {
+		return false, err
+	}
+	cert.Leaf = leaf
+	r.mu.Lock()
+	r.cert, r.leaf, r.stamp, r.loadedAt = &cert, leaf, stamp, time.Now()
+	r.mu.Unlock()
+	return true, nil
+}
+
+// Watch polls the files every interval until stop is closed. onReload is
+// called after every successful reload and every failed attempt.
+func (r *CertReloader) Watch(interval time.Duration, stop <-chan struct{}, onReload func(error)) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+			reloaded, err := r.Reload()
+			if (reloaded || err != nil) && onReload != nil {
+				onReload(err)
+			}
+		}
+	}
+}
+
+// fileStamp identifies the current contents of both files by size and
+// modification time; Stat follows symlinks, so Kubernetes secret volume
+// swaps are picked up too.
+func (r *CertReloader) fileStamp() (string, error) {
+	stamp := ""
+	for _, name := range []string{r.certFile, r.keyFile} {
+		info, err := os.Stat(name)
+		if err != nil {
+			return "", err
+		}
+		stamp += fmt.Sprintf("%d/%d;", info.ModTime().UnixNano(), info.Size())
+	}
+	return stamp, nil
+}
+
+// ServerConfig returns a TLS configuration using the reloader for the
+// serving certificate. When clientCAFile is set, client certificates are
+// requested and verified against it if presented; routes that need them
+// enforce their presence themselves.
+func ServerConfig(reloader *CertReloader, clientCAFile string) (*tls.Config, error) {
+	cfg := &tls.Config{
+		MinVersion:     tls.VersionTLS12,
+		GetCertificate: reloader.GetCertificate,
+	}
+	if clientCAFile != "" {
+		pem, err := os.ReadFile(clientCAFile)
+		if err != nil {
+			return nil, err
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, errors.New("no certificates found in client CA file")
+		}
+		cfg.ClientCAs = pool
+		cfg.ClientAuth = tls.VerifyClientCertIfGiven
+	}
+	return cfg, nil
+}
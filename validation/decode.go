@@ -0,0 +1,101 @@
+package validation
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// DecodeJSON strictly decodes a single JSON value from body into dst, a
+// pointer to a struct. Unknown fields and values of the wrong type are
+// reported as an *Error so they can be listed with the other field
+// failures; malformed JSON is returned as a plain error.
+func DecodeJSON(body []byte, dst interface{}) error {
+	if unknown := unknownFields(body, dst); len(unknown) > 0 {
+		out := &Error{}
+		for _, name := range unknown {
+			out.Fields = append(out.Fields, FieldError{Field: name, Rule: "unknown", Message: "is not a recognised field"})
+		}
+		return out
+	}
+	dec := json.NewDecoder(bytes.NewReader(body))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(dst); err != nil {
+		var typeErr *json.UnmarshalTypeError
+		switch {
+		case err == io.EOF:
+			return errors.New("request body is empty")
+		case errors.As(err, &typeErr) && typeErr.Field != "":
+			return &Error{Fields: []FieldError{{
+				Field:   typeErr.Field,
+				Rule:    "type",
+				Message: "must be a " + jsonTypeName(typeErr.Type),
+			}}}
+		}
+		return err
+	}
+	if dec.More() {
+		return errors.New("request body must contain a single JSON value")
+	}
+	return nil
+}
+
+// unknownFields lists the top-level keys of a JSON object that dst's struct
+// type does not declare. It returns nil when body is not an object.
+func unknownFields(body []byte, dst interface{}) []string {
+	var obj map[string]json.RawMessage
+	if json.Unmarshal(body, &obj) != nil {
+		return nil
+	}
+	// Types that decode themselves check their own fields.
+	if _, ok := dst.(json.Unmarshaler); ok {
+		return nil
+	}
+	t := reflect.TypeOf(dst)
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct {
+		return nil
+	}
+	known := map[string]bool{}
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		name, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+		if name == "-" || !f.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+		known[strings.ToLower(name)] = true
+	}
+	var unknown []string
+	for key := range obj {
+		// encoding/json matches field names case-insensitively
+		if !known[strings.ToLower(key)] {
+			unknown = append(unknown, key)
+		}
+	}
+	sort.Strings(unknown)
+	return unknown
+}
+
+func jsonTypeName(t reflect.Type) string {
+	switch t.Kind() {
+	case reflect.String:
+		return "string"
+	case reflect.Bool:
+		return "boolean"
+	case reflect.Float32, reflect.Float64, reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return "number"
+	case reflect.Slice, reflect.Array:
+		return "array"
+	}
+	return "object"
+}
@@ -0,0 +1,216 @@
+// Package validation checks request models against their `validate` struct
+// tags and reports failures per JSON field.
+package validation
+
+import (
+	"contoso/money"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"unicode"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// FieldError describes one failing field.
+type FieldError struct {
+	Field   string `json:"field"`
+	Rule    string `json:"rule"`
+	Message string `json:"message"`
+}
+
+// Error is returned by Struct when one or more fields fail validation.
+type Error struct {
+	Fields []FieldError `json:"fields"`
+}
+
+func (e *Error) Error() string {
+	msgs := make([]string, len(e.Fields))
+	for i, f := range e.Fields {
+		msgs[i] = f.Field + ": " + f.Message
+	}
+	return "validation failed: " + strings.Join(msgs, "; ")
+}
+
+var validate = newValidator()
+
+func newValidator() *validator.Validate {
+	v := validator.New(validator.WithRequiredStructEnabled())
+	// Report fields by their JSON names
+	v.RegisterTagNameFunc(func(f reflect.StructField) string {
+		name, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+		if name == "-" {
+			return ""
+		}
+		return name
+	})
+	_ = v.RegisterValidation("personname", isPersonName)
+	_ = v.RegisterValidation("currency", isCurrency)
+	_ = v.RegisterValidation("places", fitsCurrency)
+	_ = v.RegisterValidation("amountgte", amountGTE)
+	_ = v.RegisterValidation("amountlte", amountLTE)
+	_ = v.RegisterValidation("nonzero", isNonZeroAmount)
+	return v
+}
+
+// Struct validates s and returns an *Error listing every failing field.
+func Struct(s interface{}) error {
+	return fieldErrors(validate.Struct(s))
+}
+
+// Partial is Struct limited to the named fields, given by their Go names
+// relative to s, such as "Name" or "Player.Name".
+func Partial(s interface{}, fields ...string) error {
+	return fieldErrors(validate.StructPartial(s, fields...))
+}
+
+// fieldErrors converts the validator's errors to an *Error.
+func fieldErrors(err error) error {
+	if err == nil {
+		return nil
+	}
+	var verrs validator.ValidationErrors
+	if !errors.As(err, &verrs) {
+		return err
+	}
+	out := &Error{}
+	for _, fe := range verrs {
+		out.Fields = append(out.Fields, FieldError{
+			Field:   fieldPath(fe),
+			Rule:    fe.Tag(),
+			Message: message(fe),
+		})
+	}
+	return out
+}
+
+// fieldPath drops the struct name from the namespace ("Player.name" -> "name").
+func fieldPath(fe validator.FieldError) string {
+	_, path, ok := strings.Cut(fe.Namespace(), ".")
+	if !ok {
+		return fe.Field()
+	}
+	return path
+}
+
+func message(fe validator.FieldError) string {
+	switch fe.Tag() {
+	case "required", "required_unless":
+		return "is required"
+	case "excluded_if":
+		return "must not be set"
+	case "oneof":
+		return "must be one of: " + strings.ReplaceAll(fe.Param(), " ", ", ")
+	case "min":
+		if fe.Kind() == reflect.String {
+			return fmt.Sprintf("must be at least %s characters", fe.Param())
+		}
+		if fe.Kind() == reflect.Slice {
+			return fmt.Sprintf("must contain at least %s items", fe.Param())
+		}
+		return "must be at least " + fe.Param()
+	case "max":
+		if fe.Kind() == reflect.String {
+			return fmt.Sprintf("must be at most %s characters", fe.Param())
+		}
+		if fe.Kind() == reflect.Slice {
+			return fmt.Sprintf("must contain at most %s items", fe.Param())
+		}
+		return "must be at most " + fe.Param()
+	case "http_url":
+		return "must be an absolute http or https URL"
+	case "gte", "amountgte":
+		return "must be greater than or equal to " + fe.Param()
+	case "lte", "amountlte":
+		return "must be less than or equal to " + fe.Param()
+	case "ne":
+		return "must not be " + fe.Param()
+	case "nefield":
+		return "must differ from the " + strings.ToLower(fe.Param()[:1]) + fe.Param()[1:] + " field"
+	case "personname":
+		return "must contain only letters, spaces, hyphens, apostrophes and periods, starting with a letter and without surrounding whitespace"
+	case "currency":
+		return "must be a supported ISO 4217 currency code"
+	case "places":
+		return "must not have more decimal places than the currency"
+	case "nonzero":
+		return "must not be 0"
+	case "uuid":
+		return "must be a UUID in lowercase"
+	}
+	return "failed " + fe.Tag() + " validation"
+}
+
+// isPersonName accepts names like "Anne-Marie", "O'Brien" or "Jr." in any
+// script, rejecting blanks, digits and leading or trailing whitespace.
+func isPersonName(fl validator.FieldLevel) bool {
+	s := fl.Field().String()
+	if s == "" || strings.TrimSpace(s) != s || strings.Contains(s, "  ") {
+		return false
+	}
+	for i, r := range s {
+		if i == 0 && !unicode.IsLetter(r) {
+			return false
+		}
+		if !unicode.IsLetter(r) && !unicode.Is(unicode.Mn, r) && !strings.ContainsRune(" '’.-", r) {
+			return false
+		}
+	}
+	return true
+}
+
+func isCurrency(fl validator.FieldLevel) bool {
+	_, ok := money.Places(fl.Field().String())
+	return ok
+}
+
+// fitsCurrency accepts amounts with no more decimal places than the
+// currency in the sibling field named by the tag parameter, so 10.5 JPY
+// fails. Unsupported currencies are left to the currency rule.
+func fitsCurrency(fl validator.FieldLevel) bool {
+	amount, ok := amountOf(fl)
+	if !ok {
+		return false
+	}
+	places, ok := money.Places(fl.Parent().FieldByName(fl.Param()).String())
+	return !ok || amount.Places() <= places
+}
+
+func amountGTE(fl validator.FieldLevel) bool {
+	return compareAmount(fl, func(c int) bool { return c >= 0 })
+}
+
+func amountLTE(fl validator.FieldLevel) bool {
+	return compareAmount(fl, func(c int) bool { return c <= 0 })
+}
+
+func isNonZeroAmount(fl validator.FieldLevel) bool {
+	amount, ok := amountOf(fl)
+	return ok && !amount.IsZero()
+}
+
+// compareAmount compares the field's amount with the tag parameter and
+// passes the result to accept.
+func compareAmount(fl validator.FieldLevel, accept func(int) bool) bool {
+	amount, ok := amountOf(fl)
+	if !ok {
+		return false
+	}
+	limit, err := money.Parse(fl.Param())
+	if err != nil {
+		panic("validation: invalid amount parameter " + fl.Param())
+	}
+	return accept(amount.Cmp(limit))
+}
+
+// amountOf returns the amount of a money.Amount or money.Money field.
+func amountOf(fl validator.FieldLevel) (money.Amount, bool) {
+	switch v := fl.Field().Interface().(type) {
+	case money.Amount:
+		return v, true
+	case money.Money:
+		return v.Amount, true
+	}
+	return money.Amount{}, false
+}
@@ -0,0 +1,295 @@
+package webhooks
+
+import (
+	"bytes"
+	"context"
+	"contoso/elasticlog"
+	"contoso/events"
+	"contoso/models"
+	"contoso/repository"
+	"encoding/json"
+	"errors"
+	"io"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// TestEvent is the type of the event sent by SendTest.
+const TestEvent = "webhook.test"
+
+// Delivery tuning.
+const (
+	// requestTimeout bounds one delivery attempt.
+	requestTimeout = 10 * time.Second
+	// claimLease is how long a claimed delivery is hidden from other
+	// workers; it must comfortably exceed requestTimeout.
+	claimLease = time.Minute
+	// batchSize is the number of deliveries claimed, and sent
+	// concurrently, per poll.
+	batchSize    = 20
+	pollInterval = 2 * time.Second
+	// cacheTTL is how long the webhook list is reused when fanning out
+	// events, which bounds how stale it can be across instances.
+	cacheTTL = 10 * time.Second
+	// Retry delays double from backoffBase up to backoffMax.
+	backoffBase = 30 * time.Second
+	backoffMax  = 6 * time.Hour
+	// retention is how long the log of succeeded deliveries is kept.
+	retention = 7 * 24 * time.Hour
+	// responseLimit is how much of a response body is logged.
+	responseLimit = 512
+)
+
+// Dispatcher queues events for subscribed webhooks and delivers them. It
+// implements events.Publisher; Run must be started for queued deliveries
+// to be sent.
+type Dispatcher struct {
+	repo        repository.WebhookRepository
+	client      *http.Client
+	logger      *elasticlog.Logger
+	maxAttempts int
+
+	mu       sync.Mutex
+	cached   []models.Webhook
+	cachedAt time.Time
+}
+
+// NewDispatcher creates a dispatcher that gives up on a delivery, and
+// moves it to the dead letters, after maxAttempts failed attempts.
+func NewDispatcher(repo repository.WebhookRepository, maxAttempts int, logger *elasticlog.Logger) *Dispatcher {
+	return &Dispatcher{
+		repo: repo,
+		client: &http.Client{
+			Timeout: requestTimeout,
+			// A redirect is reported as the failure it is for a webhook
+			// rather than followed to wherever it points.
+			CheckRedirect: func(*http.Request, []*http.Request) error { return http.ErrUseLastResponse },
+		},
+		logger:      logger,
+		maxAttempts: maxAttempts,
+	}
+}
+
+// Publish queues e for every active webhook subscribed to its type. An
+// event published again is not queued twice for the same webhook.
+func (d *Dispatcher) Publish(e events.Event) error {
+	webhooks, err := d.webhooks()
+	if err != nil {
+		return err
+	}
+	var targets []models.Webhook
+	for _, w := range webhooks {
+		if w.Subscribes(e.Type) {
+			targets = append(targets, w)
+		}
+	}
+	return d.enqueue(e, targets)
+}
+
+// SendTest queues a webhook.test event for webhook alone, whatever its
+// subscriptions, and returns the event.
+func (d *Dispatcher) SendTest(webhook *models.Webhook) (events.Event, error) {
+	e := events.New(TestEvent, "", nil)
+	return e, d.enqueue(e, []models.Webhook{*webhook})
+}
+
+// Invalidate drops the cached webhook list after a subscription changed.
+func (d *Dispatcher) Invalidate() {
+	d.mu.Lock()
+	d.cached = nil
+	d.mu.Unlock()
+}
+
+func (d *Dispatcher) webhooks() ([]models.Webhook, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.cached != nil && time.Since(d.cachedAt) < cacheTTL {
+		return d.cached, nil
+	}
+	webhooks, err := d.repo.ListWebhooks()
+	if err != nil {
+		return nil, err
+	}
+	d.cached, d.cachedAt = webhooks, time.Now()
+	return webhooks, nil
+}
+
+func (d *Dispatcher) enqueue(e events.Event, targets []models.Webhook) error {
+	if len(targets) == 0 {
+		return nil
+	}
+	payload, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+	now := time.Now().UTC()
+	deliveries := make([]models.WebhookDelivery, len(targets))
+	for i, w := range targets {
+		deliveries[i] = models.WebhookDelivery{
+			WebhookID:     w.ID,
+			EventID:       e.ID,
+			EventType:     e.Type,
+			Payload:       payload,
+			Status:        models.DeliveryPending,
+			NextAttemptAt: &now,
+			CreatedAt:     now,
+			UpdatedAt:     now,
+		}
+	}
+	return d.repo.EnqueueDeliveries(deliveries)
+}
+
+// Run delivers queued deliveries until ctx is cancelled. Several
+// instances may run it against the same database.
+func (d *Dispatcher) Run(ctx context.Context) {
+	poll := time.NewTicker(pollInterval)
+	defer poll.Stop()
+	purge := time.NewTicker(time.Hour)
+	defer purge.Stop()
+	for {
+		// Keep draining while batches come back full.
+		for ctx.Err() == nil {
+			if d.deliverBatch() < batchSize {
+				break
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-purge.C:
+			d.purge()
+		case <-poll.C:
+		}
+	}
+}
+
+func (d *Dispatcher) deliverBatch() int {
+	claimed, err := d.repo.ClaimDeliveries(batchSize, claimLease)
+	if err != nil {
+		d.logger.Warn("Webhook delivery poll failed", map[string]interface{}{"error": err.Error()})
+		return 0
+	}
+	var wg sync.WaitGroup
+	for i := range claimed {
+		wg.Add(1)
+		go func(delivery *models.WebhookDelivery) {
+			defer wg.Done()
+			d.deliver(delivery)
+		}(&claimed[i])
+	}
+	wg.Wait()
+	return len(claimed)
+}
+
+func (d *Dispatcher) purge() {
+	n, err := d.repo.DeleteSucceededBefore(time.Now().Add(-retention))
+	if err != nil {
+		d.logger.Warn("Webhook delivery purge failed", map[string]interface{}{"error": err.Error()})
+		return
+	}
+	if n > 0 {
+		d.logger.Debug("Webhook deliveries purged", map[string]interface{}{"count": n})
+	}
+}
+
+// deliver makes one attempt and records its outcome.
+func (d *Dispatcher) deliver(delivery *models.WebhookDelivery) {
+	webhook, err := d.repo.GetWebhook(delivery.WebhookID)
+	var attempt models.WebhookAttempt
+	switch {
+	case errors.Is(err, repository.ErrWebhookNotFound):
+		attempt = models.WebhookAttempt{At: time.Now().UTC(), Error: "webhook was deleted"}
+		d.record(delivery, attempt, false, true)
+		return
+	case err != nil:
+		// Leave the lease to expire and try again then.
+		d.logger.Warn("Webhook lookup failed", map[string]interface{}{"error": err.Error(), "deliveryId": delivery.ID})
+		return
+	case !webhook.Active:
+		attempt = models.WebhookAttempt{At: time.Now().UTC(), Error: "webhook is disabled"}
+		d.record(delivery, attempt, false, true)
+		return
+	}
+	attempt = d.send(webhook, delivery)
+	ok := attempt.StatusCode >= 200 && attempt.StatusCode < 300
+	d.record(delivery, attempt, ok, false)
+}
+
+func (d *Dispatcher) send(webhook *models.Webhook, delivery *models.WebhookDelivery) (attempt models.WebhookAttempt) {
+	start := time.Now()
+	attempt.At = start.UTC()
+	defer func() { attempt.DurationMS = time.Since(start).Milliseconds() }()
+
+	req, err := http.NewRequest(http.MethodPost, webhook.URL, bytes.NewReader(delivery.Payload))
+	if err != nil {
+		attempt.Error = err.Error()
+		return attempt
+	}
+	ts := start.Unix()
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "Contoso-Webhooks/1.0")
+	req.Header.Set(HeaderID, delivery.ID)
+	req.Header.Set(HeaderEvent, delivery.EventType)
+	req.Header.Set(HeaderTimestamp, strconv.FormatInt(ts, 10))
+	req.Header.Set(HeaderSignature, Sign(webhook.Secret, ts, delivery.Payload))
+
+	res, err := d.client.Do(req)
+	if err != nil {
+		attempt.Error = err.Error()
+		return attempt
+	}
+	defer res.Body.Close()
+	body, _ := io.ReadAll(io.LimitReader(res.Body, responseLimit))
+	attempt.StatusCode = res.StatusCode
+	attempt.Response = string(body)
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		attempt.Error = "unexpected status " + res.Status
+	}
+	return attempt
+}
+
+// record stores attempt and schedules the next one, or dead-letters the
+// delivery when it may not be retried.
+func (d *Dispatcher) record(delivery *models.WebhookDelivery, attempt models.WebhookAttempt, ok, final bool) {
+	status, next := models.DeliverySucceeded, (*time.Time)(nil)
+	if !ok {
+		n := len(delivery.Attempts) + 1
+		if final || n >= d.maxAttempts {
+			status = models.DeliveryDead
+		} else {
+			status = models.DeliveryPending
+			at := time.Now().Add(Backoff(n))
+			next = &at
+		}
+	}
+	if err := d.repo.RecordAttempt(delivery.ID, attempt, status, next); err != nil {
+		d.logger.Warn("Webhook attempt not recorded", map[string]interface{}{"error": err.Error(), "deliveryId": delivery.ID})
+		return
+	}
+	if status == models.DeliveryDead {
+		d.logger.Warn("Webhook delivery dead-lettered", map[string]interface{}{
+			"deliveryId": delivery.ID,
+			"webhookId":  delivery.WebhookID,
+			"eventId":    delivery.EventID,
+			"attempts":   len(delivery.Attempts) + 1,
+			"error":      attempt.Error,
+		})
+	}
+}
+
+// Backoff returns the delay before retrying after the n-th failed attempt:
+// backoffBase doubled for every earlier failure, capped at backoffMax, with
+// up to 20% jitter either way so retries of a burst spread out.
+func Backoff(n int) time.Duration {
+	delay := backoffMax
+	if n < 20 {
+		if d := backoffBase << (n - 1); d < backoffMax {
+			delay = d
+		}
+	}
+	jitter := time.Duration(rand.Int63n(int64(delay)/5*2+1)) - delay/5
+	return delay + jitter
+}
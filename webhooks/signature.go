@@ -0,0 +1,74 @@
+// Package webhooks delivers player events to subscribed HTTP endpoints.
+package webhooks
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Headers sent with every delivery.
+const (
+	HeaderID        = "Webhook-Id"
+	HeaderEvent     = "Webhook-Event"
+	HeaderTimestamp = "Webhook-Timestamp"
+	HeaderSignature = "Webhook-Signature"
+)
+
+// secretPrefix marks webhook signing secrets.
+const secretPrefix = "whsec_"
+
+var (
+	ErrNoSignature      = errors.New("webhook signature missing")
+	ErrBadSignature     = errors.New("webhook signature mismatch")
+	ErrTimestampExpired = errors.New("webhook timestamp outside tolerance")
+)
+
+// NewSecret generates a signing secret with 256 bits of randomness.
+func NewSecret() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return secretPrefix + base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// Sign returns the Webhook-Signature value for body sent at timestamp: "v1="
+// and the hex HMAC-SHA256 of "<timestamp>.<body>" keyed with secret.
+// Binding the timestamp lets receivers reject replayed deliveries.
+func Sign(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return "v1=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify checks the signature and timestamp headers of a delivery. The
+// signature header may list several space-separated signatures; one match
+// is enough. Timestamps more than tolerance away from now are rejected.
+func Verify(secret, signature, timestamp string, body []byte, tolerance time.Duration) error {
+	if signature == "" || timestamp == "" {
+		return ErrNoSignature
+	}
+	ts, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return ErrNoSignature
+	}
+	if d := time.Since(time.Unix(ts, 0)); d > tolerance || d < -tolerance {
+		return ErrTimestampExpired
+	}
+	expected := Sign(secret, ts, body)
+	for _, candidate := range strings.Fields(signature) {
+		if hmac.Equal([]byte(candidate), []byte(expected)) {
+			return nil
+		}
+	}
+	return ErrBadSignature
+}